@@ -0,0 +1,26 @@
+package main
+
+import (
+	"os"
+
+	"github.com/justtrackio/gosoline/pkg/application"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sqs"
+)
+
+type redriveFlags struct {
+	ClientName           string `long:"client" cfg:"sqs.redrive.client_name" default:"default"`
+	DeadLetterQueueName  string `long:"dead-letter-queue" cfg:"sqs.redrive.dead_letter_queue_name" required:"true"`
+	DestinationQueueName string `long:"destination-queue" cfg:"sqs.redrive.destination_queue_name"`
+	MaxMessagesPerSecond int32  `long:"max-messages-per-second" cfg:"sqs.redrive.max_messages_per_second" default:"0"`
+}
+
+// moves the messages of a dead letter queue back to its source queue, e.g.
+//
+//	go run ./examples/cloud/aws/sqs-redrive --dead-letter-queue my-queue-dead --max-messages-per-second 10
+func main() {
+	application.RunModule("sqs-redrive", sqs.NewRedriveModule,
+		application.WithConfigFile("examples/cloud/aws/sqs-redrive/config.dist.yml", "yml"),
+		application.WithConfigFileFlag,
+		application.WithConfigFlags(os.Args, &redriveFlags{}),
+	)
+}
@@ -44,6 +44,8 @@ func Default(options ...Option) kernel.Kernel {
 		WithLoggerContextFieldsMessageEncoder,
 		WithLoggerContextFieldsResolver(log.ContextFieldsResolver),
 		WithLoggerHandlersFromConfig,
+		WithLoggerChannelLevelsFromConfig,
+		WithLoggerRedactionFromConfig,
 	}
 
 	options = append(defaults, options...)
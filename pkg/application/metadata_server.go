@@ -22,7 +22,14 @@ func init() {
 }
 
 type MetadataServerSettings struct {
-	Port int `cfg:"port" default:"8070"`
+	Port      int                             `cfg:"port" default:"8070"`
+	LogLevels MetadataServerLogLevelsSettings `cfg:"log_levels"`
+}
+
+// MetadataServerLogLevelsSettings enables the /log/levels endpoint. It is not authenticated, so only enable it if the
+// metadata server can't be reached from outside.
+type MetadataServerLogLevelsSettings struct {
+	Enabled bool `cfg:"enabled" default:"false"`
 }
 
 type MetadataServer struct {
@@ -73,6 +80,10 @@ func (s *MetadataServer) Run(ctx context.Context) error {
 	handler.HandleFunc("/config", s.handleConfig)
 	handler.HandleFunc("/memory", s.handleMemory)
 
+	if s.settings.LogLevels.Enabled {
+		handler.HandleFunc("/log/levels", s.handleLogLevels)
+	}
+
 	s.server.Handler = handler
 	go s.waitForStop(ctx)
 
@@ -122,6 +133,42 @@ func (s *MetadataServer) handleMemory(writer http.ResponseWriter, request *http.
 	s.formattedResponse(ctx, writer, request, memMstats)
 }
 
+// handleLogLevels lists (GET), sets (PUT/POST) or removes (DELETE) runtime log level overrides. The channel pattern
+// and level are passed as query parameters, e.g. PUT /log/levels?channel=stream.*&level=debug
+func (s *MetadataServer) handleLogLevels(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	channel := request.URL.Query().Get("channel")
+	level := request.URL.Query().Get("level")
+
+	switch request.Method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPost:
+		if err := log.SetChannelLevel(channel, level); err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		s.logger.Info(ctx, "changed log level of channels %q to %s", channel, level)
+	case http.MethodDelete:
+		if channel == "" {
+			log.ResetChannelLevels()
+			s.logger.Info(ctx, "removed all runtime log level overrides")
+
+			break
+		}
+
+		log.RemoveChannelLevel(channel)
+		s.logger.Info(ctx, "removed runtime log level override of channels %q", channel)
+	default:
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	s.formattedResponse(ctx, writer, request, log.ChannelLevels())
+}
+
 func (s *MetadataServer) formattedResponse(ctx context.Context, writer http.ResponseWriter, request *http.Request, response any) {
 	var err error
 	var bytes []byte
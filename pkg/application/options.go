@@ -10,6 +10,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/deployment"
 	taskRunner "github.com/justtrackio/gosoline/pkg/conc/task_runner"
 	"github.com/justtrackio/gosoline/pkg/exec"
 	"github.com/justtrackio/gosoline/pkg/fixtures"
@@ -198,6 +199,32 @@ func WithMetricsCalculatorModule(app *App) {
 	})
 }
 
+// WithDeploymentMetadata reads the metadata of the ecs task or ec2 instance the application is running on. The values are
+// available as config at deployment.* (e.g. {deployment.availability_zone}), are added as fields to the logger and as
+// default dimensions to all metrics unless metric.dimensions already configures them.
+func WithDeploymentMetadata(app *App) {
+	app.addSetupOption(func(ctx context.Context, config cfg.GosoConf, logger log.GosoLogger) error {
+		metadata, err := deployment.ProvideMetadata(ctx, config, logger)
+		if err != nil {
+			return fmt.Errorf("can not read deployment metadata: %w", err)
+		}
+
+		dimensions := make(map[string]any)
+		for key, value := range metadata.MetricDimensions() {
+			dimensions[key] = value
+		}
+
+		if err = config.Option(
+			cfg.WithConfigSetting("deployment", metadata.ConfigMap()),
+			cfg.WithConfigSetting("metric.dimensions", dimensions, cfg.SkipExisting),
+		); err != nil {
+			return fmt.Errorf("can not set deployment metadata config: %w", err)
+		}
+
+		return logger.Option(log.WithFields(metadata.LogFields()))
+	})
+}
+
 func WithExecBackoffInfinite(app *App) {
 	app.addConfigOption(func(config cfg.GosoConf) error {
 		return config.Option(cfg.WithConfigSetting("exec.backoff.type", "infinite"))
@@ -344,6 +371,32 @@ func WithLoggerHandlersFromConfig(app *App) {
 	})
 }
 
+func WithLoggerChannelLevelsFromConfig(app *App) {
+	app.addLoggerOption(func(config cfg.GosoConf, logger log.GosoLogger) error {
+		levels, err := log.NewChannelLevelsFromConfig(config)
+		if err != nil {
+			return fmt.Errorf("can not read channel levels from config: %w", err)
+		}
+
+		return logger.Option(log.WithChannelLevels(levels))
+	})
+}
+
+func WithLoggerRedactionFromConfig(app *App) {
+	app.addLoggerOption(func(config cfg.GosoConf, logger log.GosoLogger) error {
+		redactor, err := log.NewRedactorFromConfig(config)
+		if err != nil {
+			return fmt.Errorf("can not create log redactor from config: %w", err)
+		}
+
+		if redactor == nil {
+			return nil
+		}
+
+		return logger.Option(log.WithRedactor(redactor))
+	})
+}
+
 func WithLoggerMetricHandler(app *App) {
 	app.addLoggerOption(func(config cfg.GosoConf, logger log.GosoLogger) error {
 		metricHandler := metric.NewLoggerHandler()
@@ -380,6 +433,7 @@ func WithMetadataServer(app *App) {
 func WithMetrics(app *App) {
 	WithModuleFactory("metric-daemon", metric.NewDaemonModule)(app)
 	WithModuleFactory("prometheus-metrics-server", metric.NewPrometheusMetricsServerModule)(app)
+	WithModuleFactory("memory-metrics-server", metric.NewMemoryMetricsServerModule)(app)
 }
 
 func WithProducerDaemon(app *App) {
@@ -436,7 +490,7 @@ func WithTracing(app *App) {
 
 		options := []log.Option{
 			log.WithHandlers(tracingHandler),
-			log.WithContextFieldsResolver(tracing.ContextTraceFieldsResolver),
+			log.WithContextFieldsResolver(tracing.ContextTraceFieldsResolver, tracing.ContextBaggageFieldsResolver),
 		}
 
 		return logger.Option(options...)
@@ -445,6 +499,7 @@ func WithTracing(app *App) {
 	app.addSetupOption(func(ctx context.Context, config cfg.GosoConf, logger log.GosoLogger) error {
 		strategy := tracing.NewTraceIdErrorWarningStrategy(logger)
 		stream.AddDefaultEncodeHandler(tracing.NewMessageWithTraceEncoder(strategy))
+		stream.AddDefaultEncodeHandler(tracing.NewMessageWithBaggageEncoder())
 
 		return nil
 	})
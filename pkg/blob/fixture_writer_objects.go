@@ -0,0 +1,174 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/coffin"
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+)
+
+var _ fixtures.FixtureWriter = &objectFixtureWriter{}
+
+// ObjectFixture describes a single object of a blob store. The body of the object is either the inline Content or the
+// content of the File, which is read relative to the BasePath of the ObjectFixtureSettings.
+type ObjectFixture struct {
+	Key             string
+	Content         []byte
+	File            string
+	ContentType     string
+	ContentEncoding string
+}
+
+type ObjectFixtureSettings struct {
+	// StoreName is the name of the blob store the objects are written to.
+	StoreName string
+	// BasePath is the directory the File of the fixtures is relative to. It defaults to the working directory.
+	BasePath string
+	// Purge deletes all objects of the store before the fixtures are written.
+	Purge bool
+}
+
+type objectFixtureWriter struct {
+	logger      log.Logger
+	batchRunner BatchRunner
+	store       Store
+	settings    *ObjectFixtureSettings
+}
+
+// ObjectFixtureSetFactory creates a fixture set writing the given objects to the blob store, like the fixture sets of
+// the ddb or db-repo packages do for their models.
+func ObjectFixtureSetFactory(settings *ObjectFixtureSettings, data fixtures.NamedFixtures[*ObjectFixture], options ...fixtures.FixtureSetOption) fixtures.FixtureSetFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (fixtures.FixtureSet, error) {
+		var err error
+		var writer fixtures.FixtureWriter
+
+		if writer, err = NewObjectFixtureWriter(ctx, config, logger, settings); err != nil {
+			return nil, fmt.Errorf("failed to create blob object fixture writer for store %s: %w", settings.StoreName, err)
+		}
+
+		return fixtures.NewSimpleFixtureSet(data, writer, options...), nil
+	}
+}
+
+func NewObjectFixtureWriter(ctx context.Context, config cfg.Config, logger log.Logger, settings *ObjectFixtureSettings) (fixtures.FixtureWriter, error) {
+	logger = logger.WithFields(log.Fields{
+		"store-name": settings.StoreName,
+	})
+
+	batchRunner, err := NewBatchRunner(ctx, config, logger, settings.StoreName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create blob batch runner: %w", err)
+	}
+
+	store, err := NewStore(ctx, config, logger, settings.StoreName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create blob store: %w", err)
+	}
+
+	return NewObjectFixtureWriterWithInterfaces(logger, batchRunner, store, settings), nil
+}
+
+func NewObjectFixtureWriterWithInterfaces(logger log.Logger, batchRunner BatchRunner, store Store, settings *ObjectFixtureSettings) fixtures.FixtureWriter {
+	return &objectFixtureWriter{
+		logger:      logger,
+		batchRunner: batchRunner,
+		store:       store,
+		settings:    settings,
+	}
+}
+
+func (w *objectFixtureWriter) Write(ctx context.Context, fixtures []any) error {
+	if len(fixtures) == 0 && !w.settings.Purge {
+		return nil
+	}
+
+	batch, err := w.buildBatch(fixtures)
+	if err != nil {
+		return err
+	}
+
+	// the store sends all operations to the batch runner, so it has to run until the objects are written
+	runnerCtx, stopRunner := context.WithCancel(ctx)
+
+	cfn := coffin.New()
+	cfn.GoWithContext(runnerCtx, w.batchRunner.Run)
+	cfn.Go(func() error {
+		defer stopRunner()
+
+		if w.settings.Purge {
+			if err := w.store.DeletePrefix(ctx, ""); err != nil {
+				return fmt.Errorf("can not purge blob store %s: %w", w.settings.StoreName, err)
+			}
+		}
+
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := w.store.Write(batch); err != nil {
+			return fmt.Errorf("can not write objects: %w", err)
+		}
+
+		return nil
+	})
+
+	if err = cfn.Wait(); err != nil {
+		return fmt.Errorf("can not write blob fixtures: %w", err)
+	}
+
+	w.logger.Info(ctx, "loaded %d blob objects", len(batch))
+
+	return nil
+}
+
+func (w *objectFixtureWriter) buildBatch(fixtures []any) (Batch, error) {
+	batch := make(Batch, 0, len(fixtures))
+
+	for _, fixture := range fixtures {
+		object, ok := fixture.(*ObjectFixture)
+		if !ok {
+			return nil, fmt.Errorf("fixture of type %T is not a *blob.ObjectFixture", fixture)
+		}
+
+		body, err := w.readBody(object)
+		if err != nil {
+			return nil, err
+		}
+
+		batch = append(batch, &Object{
+			Key:             mdl.Box(object.Key),
+			Body:            StreamBytes(body),
+			ContentType:     mdl.NilIfEmpty(object.ContentType),
+			ContentEncoding: mdl.NilIfEmpty(object.ContentEncoding),
+		})
+	}
+
+	return batch, nil
+}
+
+func (w *objectFixtureWriter) readBody(object *ObjectFixture) ([]byte, error) {
+	if object.Key == "" {
+		return nil, fmt.Errorf("the blob object fixture has no key")
+	}
+
+	if object.File == "" {
+		return object.Content, nil
+	}
+
+	if object.Content != nil {
+		return nil, fmt.Errorf("the blob object fixture %s has both content and a file", object.Key)
+	}
+
+	body, err := os.ReadFile(filepath.Join(w.settings.BasePath, object.File))
+	if err != nil {
+		return nil, fmt.Errorf("can not read the file of the blob object fixture %s: %w", object.Key, err)
+	}
+
+	return body, nil
+}
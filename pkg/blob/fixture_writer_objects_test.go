@@ -0,0 +1,119 @@
+package blob_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/blob"
+	blobMocks "github.com/justtrackio/gosoline/pkg/blob/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func getObjectFixtureMocks(t *testing.T) (*blobMocks.BatchRunner, *blobMocks.Store) {
+	batchRunner := blobMocks.NewBatchRunner(t)
+	batchRunner.EXPECT().Run(mock.Anything).RunAndReturn(func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return nil
+	}).Once()
+
+	return batchRunner, blobMocks.NewStore(t)
+}
+
+func TestObjectFixtureWriter_Write(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	batchRunner, store := getObjectFixtureMocks(t)
+
+	basePath := t.TempDir()
+	err := os.WriteFile(filepath.Join(basePath, "report.csv"), []byte("id,name\n1,foo\n"), 0o644)
+	assert.NoError(t, err)
+
+	store.EXPECT().Write(mock.AnythingOfType("blob.Batch")).RunAndReturn(func(batch blob.Batch) error {
+		assert.Len(t, batch, 2)
+
+		assert.Equal(t, "config/settings.json", *batch[0].Key)
+		assert.Equal(t, []byte(`{"enabled":true}`), mustReadStream(t, batch[0].Body))
+		assert.Equal(t, "application/json", *batch[0].ContentType)
+		assert.Nil(t, batch[0].ContentEncoding)
+
+		assert.Equal(t, "reports/report.csv", *batch[1].Key)
+		assert.Equal(t, []byte("id,name\n1,foo\n"), mustReadStream(t, batch[1].Body))
+		assert.Nil(t, batch[1].ContentType)
+
+		return nil
+	}).Once()
+
+	writer := blob.NewObjectFixtureWriterWithInterfaces(logger, batchRunner, store, &blob.ObjectFixtureSettings{
+		StoreName: "test",
+		BasePath:  basePath,
+	})
+
+	err = writer.Write(t.Context(), []any{
+		&blob.ObjectFixture{
+			Key:         "config/settings.json",
+			Content:     []byte(`{"enabled":true}`),
+			ContentType: "application/json",
+		},
+		&blob.ObjectFixture{
+			Key:  "reports/report.csv",
+			File: "report.csv",
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestObjectFixtureWriter_Purge(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	batchRunner, store := getObjectFixtureMocks(t)
+
+	purge := store.EXPECT().DeletePrefix(mock.Anything, "").Return(nil).Once()
+	store.EXPECT().Write(mock.AnythingOfType("blob.Batch")).Return(nil).Once().NotBefore(purge)
+
+	writer := blob.NewObjectFixtureWriterWithInterfaces(logger, batchRunner, store, &blob.ObjectFixtureSettings{
+		StoreName: "test",
+		Purge:     true,
+	})
+
+	err := writer.Write(t.Context(), []any{
+		&blob.ObjectFixture{
+			Key:     "file.txt",
+			Content: []byte("content"),
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestObjectFixtureWriter_InvalidFixture(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	writer := blob.NewObjectFixtureWriterWithInterfaces(logger, blobMocks.NewBatchRunner(t), blobMocks.NewStore(t), &blob.ObjectFixtureSettings{
+		StoreName: "test",
+	})
+
+	err := writer.Write(t.Context(), []any{
+		&blob.ObjectFixture{
+			Key:     "file.txt",
+			Content: []byte("content"),
+			File:    "file.txt",
+		},
+	})
+	assert.EqualError(t, err, "the blob object fixture file.txt has both content and a file")
+
+	err = writer.Write(t.Context(), []any{
+		&blob.ObjectFixture{
+			Content: []byte("content"),
+		},
+	})
+	assert.EqualError(t, err, "the blob object fixture has no key")
+}
+
+func mustReadStream(t *testing.T, stream blob.Stream) []byte {
+	body, err := stream.ReadAll()
+	assert.NoError(t, err)
+
+	return body
+}
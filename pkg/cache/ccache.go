@@ -21,6 +21,9 @@ type Cache[T any] interface {
 	// Returns the item and whether a non expired item was found.
 	Get(key string) (T, bool)
 
+	// GetWithTtl works the same as [Get], but additionally returns the remaining ttl of the item.
+	GetWithTtl(key string) (T, time.Duration, bool)
+
 	// Set sets the value for key with the cache's default ttl.
 	Set(key string, value T)
 
@@ -108,23 +111,29 @@ func (c *cache[T]) SetX(key string, value T, ttl time.Duration) {
 }
 
 func (c *cache[T]) Get(key string) (T, bool) {
+	value, _, ok := c.GetWithTtl(key)
+
+	return value, ok
+}
+
+func (c *cache[T]) GetWithTtl(key string) (T, time.Duration, bool) {
 	item := c.base.Get(key)
 
 	if item == nil {
 		var noResult T
 
-		return noResult, false
+		return noResult, 0, false
 	}
 
 	if item.Expired() {
 		var noResult T
 
-		return noResult, false
+		return noResult, 0, false
 	}
 
 	value := item.Value().(T)
 
-	return value, true
+	return value, item.TTL(), true
 }
 
 func (c *cache[T]) Contains(key string) bool {
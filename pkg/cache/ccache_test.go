@@ -63,6 +63,21 @@ func TestCCache_SetX_Get(t *testing.T) {
 	})
 }
 
+func TestCCache_SetX_GetWithTtl(t *testing.T) {
+	c := cache.New[string](1, 0, 0)
+
+	c.SetX("key", "value", time.Hour)
+	value, ttl, ok := c.GetWithTtl("key")
+
+	assert.True(t, ok)
+	assert.Equal(t, "value", value)
+	assert.InDelta(t, time.Hour, ttl, float64(time.Second))
+
+	_, ttl, ok = c.GetWithTtl("missing")
+	assert.False(t, ok)
+	assert.Equal(t, time.Duration(0), ttl)
+}
+
 func TestCCache_Contains(t *testing.T) {
 	assert.NotPanics(t, func() {
 		c := cache.New[string](1, 0, time.Hour)
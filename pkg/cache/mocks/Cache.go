@@ -217,6 +217,71 @@ func (_c *Cache_Get_Call[T]) RunAndReturn(run func(string) (T, bool)) *Cache_Get
 	return _c
 }
 
+// GetWithTtl provides a mock function with given fields: key
+func (_m *Cache[T]) GetWithTtl(key string) (T, time.Duration, bool) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithTtl")
+	}
+
+	var r0 T
+	var r1 time.Duration
+	var r2 bool
+	if rf, ok := ret.Get(0).(func(string) (T, time.Duration, bool)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) T); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(T)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) time.Duration); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(2).(func(string) bool); ok {
+		r2 = rf(key)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+
+	return r0, r1, r2
+}
+
+// Cache_GetWithTtl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithTtl'
+type Cache_GetWithTtl_Call[T interface{}] struct {
+	*mock.Call
+}
+
+// GetWithTtl is a helper method to define mock.On call
+//   - key string
+func (_e *Cache_Expecter[T]) GetWithTtl(key interface{}) *Cache_GetWithTtl_Call[T] {
+	return &Cache_GetWithTtl_Call[T]{Call: _e.mock.On("GetWithTtl", key)}
+}
+
+func (_c *Cache_GetWithTtl_Call[T]) Run(run func(key string)) *Cache_GetWithTtl_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Cache_GetWithTtl_Call[T]) Return(_a0 T, _a1 time.Duration, _a2 bool) *Cache_GetWithTtl_Call[T] {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Cache_GetWithTtl_Call[T]) RunAndReturn(run func(string) (T, time.Duration, bool)) *Cache_GetWithTtl_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Mutate provides a mock function with given fields: key, mutate
 func (_m *Cache[T]) Mutate(key string, mutate func(*T) T) T {
 	ret := _m.Called(key, mutate)
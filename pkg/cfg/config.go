@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -373,7 +374,7 @@ func (c *config) augmentString(str string, args ...map[string]string) (string, e
 		str = groups[3]
 	}
 
-	if funk.Contains(flags, flagNoDecode) {
+	if slices.Contains(flags, flagNoDecode) {
 		return str, nil
 	}
 
@@ -1,6 +1,8 @@
 package clock
 
 import (
+	"context"
+	"fmt"
 	"sync"
 	"time"
 )
@@ -13,6 +15,12 @@ type FakeClock interface {
 	Clock
 	// Advance advances the FakeClock to a new point in time as well as any tickers and timers created from it.
 	Advance(d time.Duration)
+	// AdvanceAndWait advances the FakeClock like Advance and blocks until the tick of every timer, ticker and channel
+	// (Clock.After and Clock.Sleep) which fired was received. It only waits for the ticks to be taken from their
+	// channels, not for the receiving go routines to finish handling them, so tests still have to synchronize on the
+	// effects of a tick if they happen asynchronously. Timers and tickers stopped in the meantime are not waited for.
+	// An error is returned if the context is done before.
+	AdvanceAndWait(ctx context.Context, d time.Duration) error
 	// BlockUntil will block until the FakeClock has the given number of calls to Clock.Sleep or Clock.After.
 	BlockUntil(n int)
 	// BlockUntilTimers will block until the FakeClock has at least the given number of timers created (similar to BlockUntil).
@@ -43,6 +51,17 @@ type fakeSleeper struct {
 	remaining time.Duration
 }
 
+// A firedTick is a channel the FakeClock sent a tick to while advancing. The tick is delivered once the channel is
+// empty again or nobody is going to read it anymore because the timer or ticker was stopped.
+type firedTick struct {
+	c       chan time.Time
+	stopped func() bool
+}
+
+func (t firedTick) delivered() bool {
+	return len(t.c) == 0 || t.stopped()
+}
+
 // NewFakeClock creates a new FakeClock at a non-zero and fixed date.
 func NewFakeClock(options ...FakeClockOption) FakeClock {
 	return NewFakeClockAt(time.Date(1984, time.April, 4, 0, 0, 0, 0, time.UTC), options...)
@@ -65,43 +84,86 @@ func NewFakeClockAt(t time.Time, options ...FakeClockOption) FakeClock {
 }
 
 func (f *fakeClock) Advance(d time.Duration) {
+	f.advance(d)
+}
+
+func (f *fakeClock) AdvanceAndWait(ctx context.Context, d time.Duration) error {
+	pending := f.advance(d)
+
+	ticker := time.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		remaining := pending[:0]
+		for _, tick := range pending {
+			if !tick.delivered() {
+				remaining = append(remaining, tick)
+			}
+		}
+		pending = remaining
+
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d ticks were not received after advancing the clock by %v: %w", len(pending), d, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *fakeClock) advance(d time.Duration) []firedTick {
 	f.lck.Lock()
 	defer f.lck.Unlock()
 
 	f.now = f.now.Add(d)
+	fired := make([]firedTick, 0)
 
 	newSleepers := make([]*fakeSleeper, 0, len(f.sleepers))
 	for _, sleeper := range f.sleepers {
-		if keep := sleeper.advance(f.now, d); keep {
+		keep, tick := sleeper.advance(f.now, d)
+		if keep {
 			newSleepers = append(newSleepers, sleeper)
 		}
+
+		if tick {
+			fired = append(fired, firedTick{c: sleeper.c, stopped: func() bool { return false }})
+		}
 	}
 	f.sleepers = newSleepers
 
 	for _, timer := range f.timers {
-		timer.advance(f.now, d)
+		if timer.advance(f.now, d) {
+			fired = append(fired, firedTick{c: timer.c, stopped: timer.stopped})
+		}
 	}
 
 	for _, ticker := range f.tickers {
-		ticker.advance(f.now, d)
+		if ticker.advance(f.now, d) {
+			fired = append(fired, firedTick{c: ticker.c, stopped: ticker.stopped})
+		}
 	}
+
+	return fired
 }
 
-func (f *fakeSleeper) advance(t time.Time, d time.Duration) bool {
+func (f *fakeSleeper) advance(t time.Time, d time.Duration) (keep bool, tick bool) {
 	if f.remaining > d {
 		f.remaining -= d
 
-		return true
+		return true, false
 	}
 
 	if f.remaining == 0 {
-		return false
+		return false, false
 	}
 
 	f.remaining = 0
 	f.c <- t
 
-	return false
+	return false, true
 }
 
 func (f *fakeClock) Now() time.Time {
@@ -1,7 +1,9 @@
 package clock_test
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -201,3 +203,71 @@ func TestFakeClock_BlockUntilTickers(t *testing.T) {
 		}
 	}
 }
+
+func TestFakeClock_AdvanceAndWait(t *testing.T) {
+	c := clock.NewFakeClock()
+	ticker := c.NewTicker(time.Second)
+	timer := c.NewTimer(time.Minute)
+	processed := atomic.Int32{}
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for range 3 {
+			<-ticker.Chan()
+			processed.Add(1)
+		}
+
+		ticker.Stop()
+		processed.Add(1)
+
+		<-timer.Chan()
+		<-c.After(time.Second)
+	}()
+
+	for i := range 3 {
+		assert.NoError(t, c.AdvanceAndWait(t.Context(), time.Second))
+		// the tick was received, the go routine can only be blocked by the ticker, not by our assertion
+		assert.Eventually(t, func() bool {
+			return processed.Load() >= int32(i+1)
+		}, time.Second, time.Millisecond)
+	}
+
+	// wait for the ticker to be stopped, otherwise the next tick would never be received
+	assert.Eventually(t, func() bool {
+		return processed.Load() == 4
+	}, time.Second, time.Millisecond)
+
+	assert.NoError(t, c.AdvanceAndWait(t.Context(), time.Minute))
+	c.BlockUntil(1)
+	assert.NoError(t, c.AdvanceAndWait(t.Context(), time.Second))
+	<-done
+}
+
+func TestFakeClock_AdvanceAndWaitStopped(t *testing.T) {
+	c := clock.NewFakeClock()
+	ticker := c.NewTicker(time.Second)
+	timer := c.NewTimer(time.Second)
+
+	// nobody reads the ticks, but the timer and ticker are stopped, so nobody is going to
+	go func() {
+		c.BlockUntilTimers(0)
+		ticker.Stop()
+		timer.Stop()
+	}()
+
+	assert.NoError(t, c.AdvanceAndWait(t.Context(), time.Second))
+}
+
+func TestFakeClock_AdvanceAndWaitTimeout(t *testing.T) {
+	c := clock.NewFakeClock()
+	_ = c.NewTimer(time.Second)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+
+	err := c.AdvanceAndWait(ctx, time.Second)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "1 ticks were not received after advancing the clock by 1s: context deadline exceeded")
+}
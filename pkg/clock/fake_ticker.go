@@ -66,19 +66,19 @@ func (f *fakeTicker) Reset(d time.Duration) {
 	f.clock.blockOnTickers = f.clock.notifyBlockers(f.clock.blockOnTickers, f.clock.waitingTickers())
 }
 
-func (f *fakeTicker) advance(t time.Time, d time.Duration) {
+func (f *fakeTicker) advance(t time.Time, d time.Duration) bool {
 	f.lck.Lock()
 	defer f.lck.Unlock()
 
 	if f.duration == 0 {
 		// ticker was stopped
-		return
+		return false
 	}
 
 	if f.remaining > d {
 		f.remaining -= d
 
-		return
+		return false
 	}
 
 	f.remaining = f.duration
@@ -91,4 +91,13 @@ func (f *fakeTicker) advance(t time.Time, d time.Duration) {
 	}
 
 	f.c <- t
+
+	return true
+}
+
+func (f *fakeTicker) stopped() bool {
+	f.lck.Lock()
+	defer f.lck.Unlock()
+
+	return f.duration == 0
 }
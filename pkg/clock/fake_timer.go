@@ -10,6 +10,7 @@ type fakeTimer struct {
 	c         chan time.Time
 	lck       sync.Mutex
 	remaining time.Duration
+	isStopped bool
 }
 
 func (f *fakeClock) NewTimer(d time.Duration) Timer {
@@ -44,6 +45,7 @@ func (f *fakeTimer) Stop() bool {
 
 	oldRemaining := f.remaining
 	f.remaining = 0
+	f.isStopped = true
 
 	return oldRemaining != 0
 }
@@ -51,6 +53,7 @@ func (f *fakeTimer) Stop() bool {
 func (f *fakeTimer) Reset(d time.Duration) {
 	f.lck.Lock()
 	f.remaining = d
+	f.isStopped = false
 	f.lck.Unlock()
 
 	f.clock.lck.Lock()
@@ -64,22 +67,31 @@ func (f *fakeTimer) Reset(d time.Duration) {
 	f.clock.blockOnTimers = f.clock.notifyBlockers(f.clock.blockOnTimers, f.clock.waitingTimers())
 }
 
-func (f *fakeTimer) advance(t time.Time, d time.Duration) {
+func (f *fakeTimer) advance(t time.Time, d time.Duration) bool {
 	f.lck.Lock()
 	defer f.lck.Unlock()
 
 	if f.remaining > d {
 		f.remaining -= d
 
-		return
+		return false
 	}
 
 	if f.remaining == 0 {
-		return
+		return false
 	}
 
 	f.remaining = 0
 	f.sendTick(t)
+
+	return true
+}
+
+func (f *fakeTimer) stopped() bool {
+	f.lck.Lock()
+	defer f.lck.Unlock()
+
+	return f.isStopped
 }
 
 func (f *fakeTimer) sendTick(t time.Time) {
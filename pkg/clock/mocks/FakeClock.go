@@ -3,7 +3,10 @@
 package mocks
 
 import (
+	context "context"
+
 	clock "github.com/justtrackio/gosoline/pkg/clock"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -55,6 +58,53 @@ func (_c *FakeClock_Advance_Call) RunAndReturn(run func(time.Duration)) *FakeClo
 	return _c
 }
 
+// AdvanceAndWait provides a mock function with given fields: ctx, d
+func (_m *FakeClock) AdvanceAndWait(ctx context.Context, d time.Duration) error {
+	ret := _m.Called(ctx, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdvanceAndWait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
+		r0 = rf(ctx, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FakeClock_AdvanceAndWait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdvanceAndWait'
+type FakeClock_AdvanceAndWait_Call struct {
+	*mock.Call
+}
+
+// AdvanceAndWait is a helper method to define mock.On call
+//   - ctx context.Context
+//   - d time.Duration
+func (_e *FakeClock_Expecter) AdvanceAndWait(ctx interface{}, d interface{}) *FakeClock_AdvanceAndWait_Call {
+	return &FakeClock_AdvanceAndWait_Call{Call: _e.mock.On("AdvanceAndWait", ctx, d)}
+}
+
+func (_c *FakeClock_AdvanceAndWait_Call) Run(run func(ctx context.Context, d time.Duration)) *FakeClock_AdvanceAndWait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *FakeClock_AdvanceAndWait_Call) Return(_a0 error) *FakeClock_AdvanceAndWait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FakeClock_AdvanceAndWait_Call) RunAndReturn(run func(context.Context, time.Duration) error) *FakeClock_AdvanceAndWait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // After provides a mock function with given fields: d
 func (_m *FakeClock) After(d time.Duration) <-chan time.Time {
 	ret := _m.Called(d)
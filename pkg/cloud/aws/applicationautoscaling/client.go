@@ -0,0 +1,78 @@
+package applicationautoscaling
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awsCfg "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	gosoAws "github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+//go:generate go run github.com/vektra/mockery/v2 --name Client
+type Client interface {
+	PutScalingPolicy(ctx context.Context, params *applicationautoscaling.PutScalingPolicyInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.PutScalingPolicyOutput, error)
+	RegisterScalableTarget(ctx context.Context, params *applicationautoscaling.RegisterScalableTargetInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.RegisterScalableTargetOutput, error)
+}
+
+type ClientSettings struct {
+	gosoAws.ClientSettings
+}
+
+type ClientConfig struct {
+	Settings    ClientSettings
+	LoadOptions []func(options *awsCfg.LoadOptions) error
+}
+
+func (c ClientConfig) GetSettings() gosoAws.ClientSettings {
+	return c.Settings.ClientSettings
+}
+
+func (c ClientConfig) GetLoadOptions() []func(options *awsCfg.LoadOptions) error {
+	return c.LoadOptions
+}
+
+func (c ClientConfig) GetRetryOptions() []func(*retry.StandardOptions) {
+	return nil
+}
+
+type ClientOption func(cfg *ClientConfig)
+
+type clientAppCtxKey string
+
+func ProvideClient(ctx context.Context, config cfg.Config, logger log.Logger, name string, optFns ...ClientOption) (*applicationautoscaling.Client, error) {
+	return appctx.Provide(ctx, clientAppCtxKey(name), func() (*applicationautoscaling.Client, error) {
+		return NewClient(ctx, config, logger, name, optFns...)
+	})
+}
+
+func NewClient(ctx context.Context, config cfg.Config, logger log.Logger, name string, optFns ...ClientOption) (*applicationautoscaling.Client, error) {
+	clientCfg := &ClientConfig{}
+	if err := gosoAws.UnmarshalClientSettings(config, &clientCfg.Settings, "applicationautoscaling", name); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal application autoscaling client settings: %w", err)
+	}
+
+	for _, opt := range optFns {
+		opt(clientCfg)
+	}
+
+	var err error
+	var awsConfig aws.Config
+
+	if awsConfig, err = gosoAws.DefaultClientConfig(ctx, config, logger, clientCfg); err != nil {
+		return nil, fmt.Errorf("can not initialize config: %w", err)
+	}
+
+	client := applicationautoscaling.NewFromConfig(awsConfig, func(options *applicationautoscaling.Options) {
+		options.BaseEndpoint = gosoAws.NilIfEmpty(clientCfg.Settings.Endpoint)
+	})
+
+	gosoAws.LogNewClientCreated(ctx, logger, "applicationautoscaling", name, clientCfg.Settings.ClientSettings)
+
+	return client, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/smithy-go/logging"
 	"github.com/aws/smithy-go/middleware"
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/exec"
 	"github.com/justtrackio/gosoline/pkg/log"
 )
@@ -24,6 +25,7 @@ type ClientConfigAware interface {
 
 type ClientSettingsAware interface {
 	SetBackoff(backoff exec.BackoffSettings)
+	SetClientName(name string)
 }
 
 type Credentials struct {
@@ -41,21 +43,45 @@ type ClientHttpSettings struct {
 	Timeout time.Duration `cfg:"timeout" default:"0"`
 }
 
+// ClientRateLimitSettings configure a token bucket limiting the requests of a client. Throttled requests lower the rate
+// of the bucket, which recovers linearly back to the configured rate afterwards.
+type ClientRateLimitSettings struct {
+	Enabled bool `cfg:"enabled" default:"false"`
+	// Rate is the maximum number of requests per second.
+	Rate float64 `cfg:"rate" default:"100" validate:"gt=0"`
+	// Burst is the number of requests which can be sent at once after the client was idle.
+	Burst int `cfg:"burst" default:"10" validate:"min=1"`
+	// MinRate is the lowest rate a throttled client falls back to.
+	MinRate float64 `cfg:"min_rate" default:"1" validate:"gt=0"`
+	// DecreaseFactor is multiplied with the current rate for every throttled request.
+	DecreaseFactor float64 `cfg:"decrease_factor" default:"0.5" validate:"gt=0,lt=1"`
+	// RecoveryDuration is the time the rate needs to recover from min_rate back to rate.
+	RecoveryDuration time.Duration `cfg:"recovery_duration" default:"1m"`
+}
+
 type ClientSettings struct {
-	Region               string                  `cfg:"region" default:"eu-central-1"`
-	Endpoint             string                  `cfg:"endpoint" default:"http://localhost:4566"`
-	AssumeRole           string                  `cfg:"assume_role"`
-	Profile              string                  `cfg:"profile"`
-	Credentials          Credentials             `cfg:"credentials"`
-	CredentialsCacheOpts CredentialsCacheOptions `cfg:"credentials_cache"`
-	HttpClient           ClientHttpSettings      `cfg:"http_client"`
+	Region               string                   `cfg:"region" default:"eu-central-1"`
+	Endpoint             string                   `cfg:"endpoint" default:"http://localhost:4566"`
+	AssumeRole           string                   `cfg:"assume_role"`
+	Profile              string                   `cfg:"profile"`
+	Credentials          Credentials              `cfg:"credentials"`
+	CredentialsCacheOpts CredentialsCacheOptions  `cfg:"credentials_cache"`
+	HttpClient           ClientHttpSettings       `cfg:"http_client"`
+	RateLimit            ClientRateLimitSettings  `cfg:"rate_limit"`
+	Middleware           ClientMiddlewareSettings `cfg:"middleware"`
 	Backoff              exec.BackoffSettings
+	// ClientName is the name the settings were read for, it is set by UnmarshalClientSettings.
+	ClientName string
 }
 
 func (s *ClientSettings) SetBackoff(backoff exec.BackoffSettings) {
 	s.Backoff = backoff
 }
 
+func (s *ClientSettings) SetClientName(name string) {
+	s.ClientName = name
+}
+
 func (s *ClientSettings) LogFields() log.Fields {
 	return log.Fields{
 		"settings_region":                          s.Region,
@@ -64,6 +90,11 @@ func (s *ClientSettings) LogFields() log.Fields {
 		"settings_credentials_cache_expiry_window": s.CredentialsCacheOpts.ExpiryWindow,
 		"settings_credentials_cache_jitter_frac":   s.CredentialsCacheOpts.ExpiryWindowJitterFrac,
 		"settings_http_client_timeout":             s.HttpClient.Timeout,
+		"settings_rate_limit_enabled":              s.RateLimit.Enabled,
+		"settings_rate_limit_rate":                 s.RateLimit.Rate,
+		"settings_middleware_metrics":              s.Middleware.Metrics,
+		"settings_middleware_tracing":              s.Middleware.Tracing,
+		"settings_middleware_circuit_breaker":      s.Middleware.CircuitBreaker.Enabled,
 		"settings_backoff_max_attempts":            s.Backoff.MaxAttempts,
 		"settings_backoff_max_interval":            s.Backoff.MaxInterval,
 		"settings_backoff_initial_interval":        s.Backoff.InitialInterval,
@@ -95,6 +126,8 @@ func UnmarshalClientSettings(config cfg.Config, settings ClientSettingsAware, se
 		cfg.UnmarshalWithDefaultsFromKey("cloud.aws.defaults.region", "region"),
 		cfg.UnmarshalWithDefaultsFromKey("cloud.aws.defaults.endpoint", "endpoint"),
 		cfg.UnmarshalWithDefaultsFromKey("cloud.aws.defaults.http_client", "http_client"),
+		cfg.UnmarshalWithDefaultsFromKey("cloud.aws.defaults.rate_limit", "rate_limit"),
+		cfg.UnmarshalWithDefaultsFromKey("cloud.aws.defaults.middleware", "middleware"),
 		cfg.UnmarshalWithDefaultsFromKey("cloud.aws.defaults.assume_role", "assume_role"),
 		cfg.UnmarshalWithDefaultsFromKey("cloud.aws.defaults.profile", "profile"),
 		cfg.UnmarshalWithDefaultsFromKey(defaultsKey, "."),
@@ -112,6 +145,7 @@ func UnmarshalClientSettings(config cfg.Config, settings ClientSettingsAware, se
 	}
 
 	settings.SetBackoff(backoffSettings)
+	settings.SetClientName(name)
 
 	return nil
 }
@@ -177,6 +211,29 @@ func DefaultClientConfig(ctx context.Context, config cfg.Config, logger log.Logg
 		return stack.Finalize.Insert(AttemptLoggerRetryMiddleware(logger), "Retry", middleware.After)
 	})
 
+	if settings.RateLimit.Enabled {
+		rateLimiter := NewAdaptiveRateLimiter(clock.Provider, settings.RateLimit)
+
+		awsConfig.APIOptions = append(awsConfig.APIOptions, func(stack *middleware.Stack) error {
+			// placed behind the retry middleware, so every attempt has to wait for a token
+			if _, ok := stack.Finalize.Get("Retry"); !ok {
+				return stack.Finalize.Add(RateLimitMiddleware(rateLimiter), middleware.After)
+			}
+
+			return stack.Finalize.Insert(RateLimitMiddleware(rateLimiter), "Retry", middleware.After)
+		})
+	}
+
+	for _, entry := range clientMiddlewareFactories {
+		var apiOptions []func(stack *middleware.Stack) error
+
+		if apiOptions, err = entry.factory(ctx, config, logger, settings); err != nil {
+			return awsConfig, fmt.Errorf("can not create client middleware %s: %w", entry.name, err)
+		}
+
+		awsConfig.APIOptions = append(awsConfig.APIOptions, apiOptions...)
+	}
+
 	if settings.HttpClient.Timeout > 0 {
 		awsConfig.HTTPClient = awsHttp.NewBuildableClient().WithTimeout(settings.HttpClient.Timeout)
 	}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	awsMiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithyMiddleware "github.com/aws/smithy-go/middleware"
+	smithyHttp "github.com/aws/smithy-go/transport/http"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+)
+
+const (
+	MiddlewareNameCircuitBreaker = "CircuitBreaker"
+	MiddlewareNameTracing        = "Tracing"
+)
+
+func init() {
+	AddClientMiddlewareFactory("tracing", TracingMiddlewareFactory)
+	AddClientMiddlewareFactory("circuit_breaker", CircuitBreakerMiddlewareFactory)
+}
+
+type ClientMiddlewareSettings struct {
+	Metrics        bool                         `cfg:"metrics" default:"false"`
+	Tracing        bool                         `cfg:"tracing" default:"false"`
+	CircuitBreaker ClientCircuitBreakerSettings `cfg:"circuit_breaker"`
+}
+
+type ClientCircuitBreakerSettings struct {
+	Enabled     bool          `cfg:"enabled" default:"false"`
+	MaxFailures int64         `cfg:"max_failures" default:"10"`
+	RetryDelay  time.Duration `cfg:"retry_delay" default:"1m"`
+}
+
+// A ClientMiddlewareFactory returns the api options to install middlewares on the stack of every client created with
+// DefaultClientConfig. Packages which can't be imported by this package (like the metric package) register their
+// middlewares with AddClientMiddlewareFactory.
+type ClientMiddlewareFactory func(ctx context.Context, config cfg.Config, logger log.Logger, settings ClientSettings) ([]func(stack *smithyMiddleware.Stack) error, error)
+
+type clientMiddlewareFactoryEntry struct {
+	name    string
+	factory ClientMiddlewareFactory
+}
+
+var clientMiddlewareFactories []clientMiddlewareFactoryEntry
+
+// AddClientMiddlewareFactory registers a factory for all clients. Factories are applied in the order they were added.
+func AddClientMiddlewareFactory(name string, factory ClientMiddlewareFactory) {
+	clientMiddlewareFactories = append(clientMiddlewareFactories, clientMiddlewareFactoryEntry{
+		name:    name,
+		factory: factory,
+	})
+}
+
+// ErrCircuitOpen is returned for every request while the circuit breaker of a client is open.
+var ErrCircuitOpen = errors.New("request rejected, circuit breaker is open")
+
+func TracingMiddlewareFactory(ctx context.Context, config cfg.Config, logger log.Logger, settings ClientSettings) ([]func(stack *smithyMiddleware.Stack) error, error) {
+	if !settings.Middleware.Tracing {
+		return nil, nil
+	}
+
+	tracer, err := tracing.ProvideTracer(ctx, config, logger)
+	if err != nil {
+		return nil, fmt.Errorf("can not create tracer: %w", err)
+	}
+
+	return []func(stack *smithyMiddleware.Stack) error{
+		func(stack *smithyMiddleware.Stack) error {
+			return stack.Initialize.Add(TracingMiddleware(tracer), smithyMiddleware.Before)
+		},
+	}, nil
+}
+
+// TracingMiddleware wraps every request including all of its attempts in a sub span named after the service and
+// operation.
+func TracingMiddleware(tracer tracing.Tracer) smithyMiddleware.InitializeMiddleware {
+	return smithyMiddleware.InitializeMiddlewareFunc(MiddlewareNameTracing, func(
+		ctx context.Context,
+		input smithyMiddleware.InitializeInput,
+		next smithyMiddleware.InitializeHandler,
+	) (smithyMiddleware.InitializeOutput, smithyMiddleware.Metadata, error) {
+		ctx, span := tracer.StartSubSpan(ctx, fmt.Sprintf("%s.%s", awsMiddleware.GetServiceID(ctx), awsMiddleware.GetOperationName(ctx)))
+		defer span.Finish()
+
+		output, metadata, err := next.HandleInitialize(ctx, input)
+		if err != nil {
+			span.AddError(err)
+		}
+
+		return output, metadata, err
+	})
+}
+
+func CircuitBreakerMiddlewareFactory(_ context.Context, _ cfg.Config, logger log.Logger, settings ClientSettings) ([]func(stack *smithyMiddleware.Stack) error, error) {
+	if !settings.Middleware.CircuitBreaker.Enabled {
+		return nil, nil
+	}
+
+	breaker := NewCircuitBreaker(logger, clock.Provider, settings.ClientName, settings.Middleware.CircuitBreaker)
+
+	return []func(stack *smithyMiddleware.Stack) error{
+		func(stack *smithyMiddleware.Stack) error {
+			return stack.Initialize.Add(CircuitBreakerMiddleware(breaker), smithyMiddleware.After)
+		},
+	}, nil
+}
+
+// CircuitBreaker is shared by all requests of a client. It opens after MaxFailures requests failed in a row and
+// lets a single request pass every RetryDelay until a request succeeds again.
+type CircuitBreaker struct {
+	logger   log.Logger
+	clock    clock.Clock
+	settings ClientCircuitBreakerSettings
+
+	// state updated with atomics
+	recentFailures int64
+	nextRetryAt    int64
+}
+
+func NewCircuitBreaker(logger log.Logger, clock clock.Clock, clientName string, settings ClientCircuitBreakerSettings) *CircuitBreaker {
+	return &CircuitBreaker{
+		logger:   logger.WithChannel("aws-circuit-breaker-" + clientName),
+		clock:    clock,
+		settings: settings,
+	}
+}
+
+// Allow reports if a request may be sent.
+func (c *CircuitBreaker) Allow(ctx context.Context) bool {
+	recentFailures := atomic.LoadInt64(&c.recentFailures)
+	if recentFailures < c.settings.MaxFailures {
+		return true
+	}
+
+	// we have too many failures. check if we can retry anyway
+	nextRetryAt := atomic.LoadInt64(&c.nextRetryAt)
+	now := c.clock.Now().UnixMilli()
+	if nextRetryAt > now {
+		return false
+	}
+
+	// only the request winning the race gets to try to close the circuit again
+	canRetry := atomic.CompareAndSwapInt64(&c.nextRetryAt, nextRetryAt, now+c.settings.RetryDelay.Milliseconds())
+	if canRetry {
+		c.logger.Info(ctx, "trying to close circuit breaker again by trying single request")
+	}
+
+	return canRetry
+}
+
+// Record counts the result of a request. Canceled requests are neither counted as a failure nor as a success.
+func (c *CircuitBreaker) Record(ctx context.Context, err error) {
+	if exec.IsRequestCanceled(err) {
+		return
+	}
+
+	if !isRemoteFailure(err) {
+		if oldFailures := atomic.SwapInt64(&c.recentFailures, 0); oldFailures > 0 {
+			c.logger.Info(ctx, "reset failure counter of circuit breaker again")
+		}
+
+		return
+	}
+
+	// set up nextRetryAt before counting up the failures, so it is valid once the circuit opens
+	atomic.StoreInt64(&c.nextRetryAt, c.clock.Now().Add(c.settings.RetryDelay).UnixMilli())
+
+	if newFailures := atomic.AddInt64(&c.recentFailures, 1); newFailures == c.settings.MaxFailures {
+		c.logger.Warn(ctx, "circuit breaker triggered, stopping requests for %v", c.settings.RetryDelay)
+	}
+}
+
+// CircuitBreakerMiddleware rejects requests with ErrCircuitOpen while the circuit breaker is open. It is placed in
+// front of the retry middleware, so a request is only counted once after all of its attempts.
+func CircuitBreakerMiddleware(breaker *CircuitBreaker) smithyMiddleware.InitializeMiddleware {
+	return smithyMiddleware.InitializeMiddlewareFunc(MiddlewareNameCircuitBreaker, func(
+		ctx context.Context,
+		input smithyMiddleware.InitializeInput,
+		next smithyMiddleware.InitializeHandler,
+	) (smithyMiddleware.InitializeOutput, smithyMiddleware.Metadata, error) {
+		if !breaker.Allow(ctx) {
+			return smithyMiddleware.InitializeOutput{}, smithyMiddleware.Metadata{}, ErrCircuitOpen
+		}
+
+		output, metadata, err := next.HandleInitialize(ctx, input)
+		breaker.Record(ctx, err)
+
+		return output, metadata, err
+	})
+}
+
+// isRemoteFailure reports if the error was caused by the service being unavailable. Client errors like a missing
+// item or a failed condition are answers of a healthy service and don't count.
+func isRemoteFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var responseErr *smithyHttp.ResponseError
+	if errors.As(err, &responseErr) && responseErr.Response != nil {
+		return responseErr.HTTPStatusCode() >= http.StatusInternalServerError || responseErr.HTTPStatusCode() == http.StatusTooManyRequests
+	}
+
+	return true
+}
@@ -0,0 +1,68 @@
+package aws_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	smithyMiddleware "github.com/aws/smithy-go/middleware"
+	smithyHttp "github.com/aws/smithy-go/transport/http"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func responseError(status int) error {
+	return &smithyHttp.ResponseError{
+		Response: &smithyHttp.Response{Response: &http.Response{StatusCode: status}},
+		Err:      errors.New("response error"),
+	}
+}
+
+func TestCircuitBreakerMiddleware(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	fakeClock := clock.NewFakeClock()
+	breaker := aws.NewCircuitBreaker(logger, fakeClock, "default", aws.ClientCircuitBreakerSettings{
+		Enabled:     true,
+		MaxFailures: 2,
+		RetryDelay:  time.Minute,
+	})
+	mw := aws.CircuitBreakerMiddleware(breaker)
+
+	var calls int
+	var result error
+	handler := smithyMiddleware.InitializeHandlerFunc(func(ctx context.Context, input smithyMiddleware.InitializeInput) (smithyMiddleware.InitializeOutput, smithyMiddleware.Metadata, error) {
+		calls++
+
+		return smithyMiddleware.InitializeOutput{}, smithyMiddleware.Metadata{}, result
+	})
+	call := func() error {
+		_, _, err := mw.HandleInitialize(t.Context(), smithyMiddleware.InitializeInput{}, handler)
+
+		return err
+	}
+
+	// client errors and canceled requests don't count as failures
+	result = responseError(http.StatusNotFound)
+	assert.Error(t, call())
+	result = context.Canceled
+	assert.Error(t, call())
+
+	result = responseError(http.StatusServiceUnavailable)
+	assert.Error(t, call())
+	assert.Error(t, call())
+	assert.Equal(t, 4, calls)
+
+	assert.ErrorIs(t, call(), aws.ErrCircuitOpen)
+	assert.Equal(t, 4, calls)
+
+	// a single request is let through after the retry delay
+	fakeClock.Advance(time.Minute)
+	result = nil
+	assert.NoError(t, call())
+	assert.NoError(t, call())
+	assert.Equal(t, 6, calls)
+}
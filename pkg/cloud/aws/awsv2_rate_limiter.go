@@ -1,6 +1,16 @@
 package aws
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithyMiddleware "github.com/aws/smithy-go/middleware"
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
 
 type NopRateLimiter struct{}
 
@@ -19,3 +29,120 @@ func (n NopRateLimiter) AddTokens(_ uint) error {
 func alwaysSucceed() error {
 	return nil
 }
+
+// AdaptiveRateLimiter is a token bucket shared by all requests of a client. Every throttled request lowers the rate
+// by the decrease factor down to the min rate, the rate then recovers linearly to the configured rate.
+type AdaptiveRateLimiter struct {
+	lck        sync.Mutex
+	clock      clock.Clock
+	settings   ClientRateLimitSettings
+	rate       float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func NewAdaptiveRateLimiter(clock clock.Clock, settings ClientRateLimitSettings) *AdaptiveRateLimiter {
+	return &AdaptiveRateLimiter{
+		clock:      clock,
+		settings:   settings,
+		rate:       settings.Rate,
+		tokens:     float64(settings.Burst),
+		lastRefill: clock.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is canceled.
+func (l *AdaptiveRateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait, ok := l.take()
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-l.clock.After(wait):
+		}
+	}
+}
+
+// Throttled lowers the rate after a request was throttled.
+func (l *AdaptiveRateLimiter) Throttled() {
+	l.lck.Lock()
+	defer l.lck.Unlock()
+
+	l.refill()
+	l.rate = max(l.rate*l.settings.DecreaseFactor, l.settings.MinRate)
+}
+
+// Rate returns the current number of requests per second.
+func (l *AdaptiveRateLimiter) Rate() float64 {
+	l.lck.Lock()
+	defer l.lck.Unlock()
+
+	l.refill()
+
+	return l.rate
+}
+
+// take consumes a token if there is one or returns the time to wait until the next token is available.
+func (l *AdaptiveRateLimiter) take() (time.Duration, bool) {
+	l.lck.Lock()
+	defer l.lck.Unlock()
+
+	l.refill()
+
+	if l.tokens >= 1 {
+		l.tokens--
+
+		return 0, true
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second)), false
+}
+
+func (l *AdaptiveRateLimiter) refill() {
+	now := l.clock.Now()
+	elapsed := now.Sub(l.lastRefill)
+	l.lastRefill = now
+
+	if elapsed <= 0 {
+		return
+	}
+
+	l.tokens = min(l.tokens+elapsed.Seconds()*l.rate, float64(l.settings.Burst))
+
+	if l.settings.RecoveryDuration <= 0 {
+		l.rate = l.settings.Rate
+
+		return
+	}
+
+	recovered := (l.settings.Rate - l.settings.MinRate) * float64(elapsed) / float64(l.settings.RecoveryDuration)
+	l.rate = min(l.rate+recovered, l.settings.Rate)
+}
+
+// RateLimitMiddleware lets every attempt of a request wait for a token of the rate limiter and reports throttled
+// attempts back to it.
+func RateLimitMiddleware(rateLimiter *AdaptiveRateLimiter) smithyMiddleware.FinalizeMiddleware {
+	throttles := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	return smithyMiddleware.FinalizeMiddlewareFunc("RateLimiter", func(
+		ctx context.Context,
+		input smithyMiddleware.FinalizeInput,
+		next smithyMiddleware.FinalizeHandler,
+	) (smithyMiddleware.FinalizeOutput, smithyMiddleware.Metadata, error) {
+		if err := rateLimiter.Wait(ctx); err != nil {
+			return smithyMiddleware.FinalizeOutput{}, smithyMiddleware.Metadata{}, fmt.Errorf("can not wait for rate limit: %w", err)
+		}
+
+		output, metadata, err := next.HandleFinalize(ctx, input)
+
+		if err != nil && throttles.IsErrorThrottle(err) == aws.TrueTernary {
+			rateLimiter.Throttled()
+		}
+
+		return output, metadata, err
+	})
+}
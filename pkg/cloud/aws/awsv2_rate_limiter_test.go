@@ -0,0 +1,71 @@
+package aws_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateLimiter() (*aws.AdaptiveRateLimiter, clock.FakeClock) {
+	fakeClock := clock.NewFakeClock()
+	limiter := aws.NewAdaptiveRateLimiter(fakeClock, aws.ClientRateLimitSettings{
+		Rate:             10,
+		Burst:            2,
+		MinRate:          1,
+		DecreaseFactor:   0.5,
+		RecoveryDuration: 9 * time.Second,
+	})
+
+	return limiter, fakeClock
+}
+
+func TestAdaptiveRateLimiter_Wait(t *testing.T) {
+	limiter, fakeClock := newTestRateLimiter()
+	ctx := t.Context()
+
+	assert.NoError(t, limiter.Wait(ctx))
+	assert.NoError(t, limiter.Wait(ctx))
+
+	done := make(chan error)
+	go func() {
+		done <- limiter.Wait(ctx)
+	}()
+
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(100 * time.Millisecond)
+
+	assert.NoError(t, <-done)
+}
+
+func TestAdaptiveRateLimiter_WaitCanceled(t *testing.T) {
+	limiter, _ := newTestRateLimiter()
+	ctx, cancel := context.WithCancel(t.Context())
+
+	assert.NoError(t, limiter.Wait(ctx))
+	assert.NoError(t, limiter.Wait(ctx))
+
+	cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.Canceled)
+}
+
+func TestAdaptiveRateLimiter_ThrottledAndRecovery(t *testing.T) {
+	limiter, fakeClock := newTestRateLimiter()
+
+	limiter.Throttled()
+	assert.Equal(t, 5.0, limiter.Rate())
+
+	limiter.Throttled()
+	limiter.Throttled()
+	limiter.Throttled()
+	assert.Equal(t, 1.0, limiter.Rate())
+
+	fakeClock.Advance(3 * time.Second)
+	assert.InDelta(t, 4.0, limiter.Rate(), 0.001)
+
+	fakeClock.Advance(time.Minute)
+	assert.Equal(t, 10.0, limiter.Rate())
+}
@@ -81,6 +81,19 @@ func TestUnmarshalClientSettings(t *testing.T) {
 		HttpClient: aws.ClientHttpSettings{
 			Timeout: time.Second,
 		},
+		RateLimit: aws.ClientRateLimitSettings{
+			Rate:             100,
+			Burst:            10,
+			MinRate:          1,
+			DecreaseFactor:   0.5,
+			RecoveryDuration: time.Minute,
+		},
+		Middleware: aws.ClientMiddlewareSettings{
+			CircuitBreaker: aws.ClientCircuitBreakerSettings{
+				MaxFailures: 10,
+				RetryDelay:  time.Minute,
+			},
+		},
 		Backoff: exec.BackoffSettings{
 			CancelDelay:     0,
 			InitialInterval: time.Millisecond * 50,
@@ -88,6 +101,7 @@ func TestUnmarshalClientSettings(t *testing.T) {
 			MaxElapsedTime:  time.Minute * 10,
 			MaxInterval:     time.Second * 10,
 		},
+		ClientName: "default",
 	}, settings)
 
 	settings = &aws.ClientSettings{}
@@ -109,6 +123,19 @@ func TestUnmarshalClientSettings(t *testing.T) {
 		HttpClient: aws.ClientHttpSettings{
 			Timeout: time.Second * 2,
 		},
+		RateLimit: aws.ClientRateLimitSettings{
+			Rate:             100,
+			Burst:            10,
+			MinRate:          1,
+			DecreaseFactor:   0.5,
+			RecoveryDuration: time.Minute,
+		},
+		Middleware: aws.ClientMiddlewareSettings{
+			CircuitBreaker: aws.ClientCircuitBreakerSettings{
+				MaxFailures: 10,
+				RetryDelay:  time.Minute,
+			},
+		},
 		Backoff: exec.BackoffSettings{
 			CancelDelay:     0,
 			InitialInterval: time.Millisecond * 50,
@@ -116,5 +143,6 @@ func TestUnmarshalClientSettings(t *testing.T) {
 			MaxElapsedTime:  time.Minute * 10,
 			MaxInterval:     time.Second * 10,
 		},
+		ClientName: "metrics",
 	}, settings)
 }
@@ -0,0 +1,86 @@
+package cloudwatchlogs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awsCfg "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	gosoAws "github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+//go:generate go run github.com/vektra/mockery/v2 --name Client
+type Client interface {
+	GetQueryResults(ctx context.Context, params *cloudwatchlogs.GetQueryResultsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetQueryResultsOutput, error)
+	StartQuery(ctx context.Context, params *cloudwatchlogs.StartQueryInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartQueryOutput, error)
+	StopQuery(ctx context.Context, params *cloudwatchlogs.StopQueryInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StopQueryOutput, error)
+}
+
+type ClientSettings struct {
+	gosoAws.ClientSettings
+}
+
+type ClientConfig struct {
+	Settings     ClientSettings
+	LoadOptions  []func(options *awsCfg.LoadOptions) error
+	RetryOptions []func(*retry.StandardOptions)
+}
+
+func (c ClientConfig) GetSettings() gosoAws.ClientSettings {
+	return c.Settings.ClientSettings
+}
+
+func (c ClientConfig) GetLoadOptions() []func(options *awsCfg.LoadOptions) error {
+	return c.LoadOptions
+}
+
+func (c ClientConfig) GetRetryOptions() []func(*retry.StandardOptions) {
+	return c.RetryOptions
+}
+
+type ClientOption func(cfg *ClientConfig)
+
+type clientAppCtxKey string
+
+func ProvideClient(ctx context.Context, config cfg.Config, logger log.Logger, name string, optFns ...ClientOption) (*cloudwatchlogs.Client, error) {
+	return appctx.Provide(ctx, clientAppCtxKey(name), func() (*cloudwatchlogs.Client, error) {
+		return NewClient(ctx, config, logger, name, optFns...)
+	})
+}
+
+func NewClient(ctx context.Context, config cfg.Config, logger log.Logger, name string, optFns ...ClientOption) (*cloudwatchlogs.Client, error) {
+	clientCfg := &ClientConfig{}
+	if err := gosoAws.UnmarshalClientSettings(config, &clientCfg.Settings, "cloudwatchlogs", name); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cloudwatchlogs client settings: %w", err)
+	}
+
+	clientCfg.RetryOptions = []func(*retry.StandardOptions){
+		gosoAws.RetryWithRetryables([]retry.IsErrorRetryable{
+			&RetryOnConcurrentQueryLimit{},
+		}),
+	}
+
+	for _, opt := range optFns {
+		opt(clientCfg)
+	}
+
+	var err error
+	var awsConfig aws.Config
+
+	if awsConfig, err = gosoAws.DefaultClientConfig(ctx, config, logger, clientCfg); err != nil {
+		return nil, fmt.Errorf("can not initialize config: %w", err)
+	}
+
+	client := cloudwatchlogs.NewFromConfig(awsConfig, func(options *cloudwatchlogs.Options) {
+		options.BaseEndpoint = gosoAws.NilIfEmpty(clientCfg.Settings.Endpoint)
+	})
+
+	gosoAws.LogNewClientCreated(ctx, logger, "cloudwatchlogs", name, clientCfg.Settings.ClientSettings)
+
+	return client, nil
+}
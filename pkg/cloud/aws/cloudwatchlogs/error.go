@@ -0,0 +1,21 @@
+package cloudwatchlogs
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+type RetryOnConcurrentQueryLimit struct{}
+
+// IsErrorRetryable reacts on the limit of concurrently running insights queries of an account. The limit is freed as
+// soon as other queries finish, so starting the query is retried.
+func (r RetryOnConcurrentQueryLimit) IsErrorRetryable(err error) aws.Ternary {
+	var limitErr *types.LimitExceededException
+	if errors.As(err, &limitErr) {
+		return aws.TrueTernary
+	}
+
+	return aws.UnknownTernary
+}
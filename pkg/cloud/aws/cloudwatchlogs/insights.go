@@ -0,0 +1,259 @@
+package cloudwatchlogs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mapx"
+)
+
+const (
+	// InsightsMaxLimit is the maximum number of rows a single insights query returns.
+	InsightsMaxLimit = 10000
+
+	defaultInsightsPollInterval = time.Second
+)
+
+type InsightsQuery struct {
+	LogGroupNames []string
+	QueryString   string
+	// StartTime and EndTime are both inclusive and are truncated to seconds.
+	StartTime time.Time
+	EndTime   time.Time
+	// Limit is the maximum number of rows returned by a single query, it defaults to and is capped at InsightsMaxLimit.
+	Limit int32
+	// PollInterval is the interval in which the results of a running query are polled, it defaults to a second.
+	PollInterval time.Duration
+}
+
+// InsightsRow maps the fields of a result row to their values.
+type InsightsRow map[string]string
+
+type InsightsStatistics struct {
+	BytesScanned   float64
+	RecordsMatched float64
+	RecordsScanned float64
+}
+
+func (s *InsightsStatistics) add(other *InsightsStatistics) {
+	s.BytesScanned += other.BytesScanned
+	s.RecordsMatched += other.RecordsMatched
+	s.RecordsScanned += other.RecordsScanned
+}
+
+type InsightsResult struct {
+	Rows       []InsightsRow
+	Statistics InsightsStatistics
+}
+
+// An InsightsQuerier runs cloudwatch logs insights queries and waits for their results.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name InsightsQuerier
+type InsightsQuerier interface {
+	// Query starts the query and blocks until it is finished. The result is truncated to the limit of the query. The
+	// query is stopped if the context gets canceled.
+	Query(ctx context.Context, query *InsightsQuery) (*InsightsResult, error)
+	// QueryAll works like Query, but if the result hits the limit, the time range is split in halves which are queried
+	// separately until every part fits the limit. The rows are returned in chronological order of the parts. Only use it
+	// for queries returning log events, the rows of aggregating queries (stats) can't be merged this way.
+	QueryAll(ctx context.Context, query *InsightsQuery) (*InsightsResult, error)
+}
+
+type insightsQuerier struct {
+	logger log.Logger
+	client Client
+	clock  clock.Clock
+}
+
+func NewInsightsQuerier(ctx context.Context, config cfg.Config, logger log.Logger, clientName string, optFns ...ClientOption) (InsightsQuerier, error) {
+	client, err := ProvideClient(ctx, config, logger, clientName, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("can not create cloudwatchlogs client %s: %w", clientName, err)
+	}
+
+	return NewInsightsQuerierWithInterfaces(logger, client, clock.Provider), nil
+}
+
+func NewInsightsQuerierWithInterfaces(logger log.Logger, client Client, clock clock.Clock) InsightsQuerier {
+	return &insightsQuerier{
+		logger: logger,
+		client: client,
+		clock:  clock,
+	}
+}
+
+func (q *insightsQuerier) Query(ctx context.Context, query *InsightsQuery) (*InsightsResult, error) {
+	var err error
+	var start *cloudwatchlogs.StartQueryOutput
+	var out *cloudwatchlogs.GetQueryResultsOutput
+
+	input := &cloudwatchlogs.StartQueryInput{
+		LogGroupNames: query.LogGroupNames,
+		QueryString:   aws.String(query.QueryString),
+		StartTime:     aws.Int64(query.StartTime.Unix()),
+		EndTime:       aws.Int64(query.EndTime.Unix()),
+		Limit:         aws.Int32(insightsLimit(query)),
+	}
+
+	if start, err = q.client.StartQuery(ctx, input); err != nil {
+		return nil, fmt.Errorf("can not start insights query: %w", err)
+	}
+
+	queryId := aws.ToString(start.QueryId)
+
+	pollInterval := query.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultInsightsPollInterval
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			// the query would keep running and count against the concurrency limit, so we use a fresh context to stop it
+			if err = q.stop(context.WithoutCancel(ctx), queryId); err != nil {
+				return nil, fmt.Errorf("can not stop insights query %s: %w", queryId, err)
+			}
+
+			return nil, ctx.Err()
+		case <-q.clock.After(pollInterval):
+		}
+
+		if out, err = q.client.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{QueryId: aws.String(queryId)}); err != nil {
+			return nil, fmt.Errorf("can not get results of insights query %s: %w", queryId, err)
+		}
+
+		switch out.Status {
+		case types.QueryStatusScheduled, types.QueryStatusRunning:
+			continue
+		case types.QueryStatusComplete:
+			return buildInsightsResult(out), nil
+		default:
+			return nil, fmt.Errorf("insights query %s finished with status %s", queryId, out.Status)
+		}
+	}
+}
+
+func (q *insightsQuerier) QueryAll(ctx context.Context, query *InsightsQuery) (*InsightsResult, error) {
+	result, err := q.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Rows) < int(insightsLimit(query)) {
+		return result, nil
+	}
+
+	start := query.StartTime.Unix()
+	end := query.EndTime.Unix()
+
+	if start >= end {
+		return nil, fmt.Errorf("can not split insights query: there are more than %d rows within the second %s", insightsLimit(query), query.StartTime.Format(time.RFC3339))
+	}
+
+	q.logger.Debug(ctx, "insights query hit the limit of %d rows, splitting the time range %s - %s", insightsLimit(query), query.StartTime.Format(time.RFC3339), query.EndTime.Format(time.RFC3339))
+
+	// the time range is inclusive on both ends, so the halves must not share their boundary second
+	middle := start + (end-start)/2
+
+	lower := *query
+	lower.EndTime = time.Unix(middle, 0)
+
+	upper := *query
+	upper.StartTime = time.Unix(middle+1, 0)
+
+	merged := &InsightsResult{
+		Statistics: result.Statistics,
+	}
+
+	for _, part := range []*InsightsQuery{&lower, &upper} {
+		if result, err = q.QueryAll(ctx, part); err != nil {
+			return nil, err
+		}
+
+		merged.Rows = append(merged.Rows, result.Rows...)
+		merged.Statistics.add(&result.Statistics)
+	}
+
+	return merged, nil
+}
+
+func (q *insightsQuerier) stop(ctx context.Context, queryId string) error {
+	if _, err := q.client.StopQuery(ctx, &cloudwatchlogs.StopQueryInput{QueryId: aws.String(queryId)}); err != nil {
+		return err
+	}
+
+	q.logger.Info(ctx, "stopped insights query %s", queryId)
+
+	return nil
+}
+
+// UnmarshalInsightsRows decodes the rows into structs of type T. The fields of T are matched by their insights tag,
+// e.g. `insights:"@timestamp"`, nested fields like `insights:"ctx.user_id"` can be addressed by their full name.
+func UnmarshalInsightsRows[T any](rows []InsightsRow) ([]T, error) {
+	items := make([]T, len(rows))
+
+	for i, row := range rows {
+		values := mapx.NewMapX()
+		for field, value := range row {
+			values.Set(field, value)
+		}
+
+		ms, err := mapx.NewStruct(&items[i], &mapx.StructSettings{
+			FieldTag: "insights",
+			Casters: []mapx.MapStructCaster{
+				mapx.MapStructDurationCaster,
+				mapx.MapStructTimeCaster,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("can not create struct decoder for %T: %w", items[i], err)
+		}
+
+		if err = ms.Write(values); err != nil {
+			return nil, fmt.Errorf("can not decode row %d: %w", i, err)
+		}
+	}
+
+	return items, nil
+}
+
+func buildInsightsResult(out *cloudwatchlogs.GetQueryResultsOutput) *InsightsResult {
+	result := &InsightsResult{
+		Rows: make([]InsightsRow, 0, len(out.Results)),
+	}
+
+	for _, fields := range out.Results {
+		row := make(InsightsRow, len(fields))
+
+		for _, field := range fields {
+			row[aws.ToString(field.Field)] = aws.ToString(field.Value)
+		}
+
+		result.Rows = append(result.Rows, row)
+	}
+
+	if out.Statistics != nil {
+		result.Statistics = InsightsStatistics{
+			BytesScanned:   out.Statistics.BytesScanned,
+			RecordsMatched: out.Statistics.RecordsMatched,
+			RecordsScanned: out.Statistics.RecordsScanned,
+		}
+	}
+
+	return result
+}
+
+func insightsLimit(query *InsightsQuery) int32 {
+	if query.Limit <= 0 || query.Limit > InsightsMaxLimit {
+		return InsightsMaxLimit
+	}
+
+	return query.Limit
+}
@@ -0,0 +1,227 @@
+package cloudwatchlogs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoLogs "github.com/justtrackio/gosoline/pkg/cloud/aws/cloudwatchlogs"
+	logsMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/cloudwatchlogs/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRunInsightsQuerierTestSuite(t *testing.T) {
+	suite.Run(t, new(insightsQuerierTestSuite))
+}
+
+type insightsQuerierTestSuite struct {
+	suite.Suite
+	ctx     context.Context
+	client  *logsMocks.Client
+	clock   clock.FakeClock
+	querier gosoLogs.InsightsQuerier
+	query   *gosoLogs.InsightsQuery
+}
+
+func (s *insightsQuerierTestSuite) SetupTest() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+
+	s.ctx = s.T().Context()
+	s.client = logsMocks.NewClient(s.T())
+	s.clock = clock.NewFakeClock()
+	s.querier = gosoLogs.NewInsightsQuerierWithInterfaces(logger, s.client, s.clock)
+	s.query = &gosoLogs.InsightsQuery{
+		LogGroupNames: []string{"group"},
+		QueryString:   "fields @timestamp, @message",
+		StartTime:     time.Unix(100, 0),
+		EndTime:       time.Unix(110, 0),
+		Limit:         2,
+		PollInterval:  time.Second,
+	}
+}
+
+func (s *insightsQuerierTestSuite) TestQuery() {
+	s.expectStart("id", 100, 110)
+	s.expectResults("id", types.QueryStatusRunning)
+	s.expectResults("id", types.QueryStatusComplete, "a")
+	s.advance(2)
+
+	result, err := s.querier.Query(s.ctx, s.query)
+
+	s.NoError(err)
+	s.Equal(&gosoLogs.InsightsResult{
+		Rows: []gosoLogs.InsightsRow{
+			{"@timestamp": "2024-01-15 10:00:00.000", "@message": "a"},
+		},
+		Statistics: gosoLogs.InsightsStatistics{
+			BytesScanned:   10,
+			RecordsMatched: 1,
+			RecordsScanned: 5,
+		},
+	}, result)
+}
+
+func (s *insightsQuerierTestSuite) TestQuery_Failed() {
+	s.expectStart("id", 100, 110)
+	s.expectResults("id", types.QueryStatusFailed)
+	s.advance(1)
+
+	_, err := s.querier.Query(s.ctx, s.query)
+
+	s.EqualError(err, "insights query id finished with status Failed")
+}
+
+func (s *insightsQuerierTestSuite) TestQuery_Canceled() {
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	s.client.EXPECT().StartQuery(matcher.Context, s.startInput(100, 110)).Run(func(_ context.Context, _ *cloudwatchlogs.StartQueryInput, _ ...func(*cloudwatchlogs.Options)) {
+		cancel()
+	}).Return(&cloudwatchlogs.StartQueryOutput{
+		QueryId: aws.String("id"),
+	}, nil).Once()
+
+	s.client.EXPECT().StopQuery(matcher.Context, &cloudwatchlogs.StopQueryInput{
+		QueryId: aws.String("id"),
+	}).Return(&cloudwatchlogs.StopQueryOutput{}, nil).Once()
+
+	_, err := s.querier.Query(ctx, s.query)
+
+	s.ErrorIs(err, context.Canceled)
+}
+
+func (s *insightsQuerierTestSuite) TestQueryAll() {
+	s.expectStart("full", 100, 110)
+	s.expectResults("full", types.QueryStatusComplete, "a", "b")
+	s.expectStart("lower", 100, 105)
+	s.expectResults("lower", types.QueryStatusComplete, "a")
+	s.expectStart("upper", 106, 110)
+	s.expectResults("upper", types.QueryStatusComplete, "b", "c")
+	s.expectStart("upper-lower", 106, 108)
+	s.expectResults("upper-lower", types.QueryStatusComplete, "b")
+	s.expectStart("upper-upper", 109, 110)
+	s.expectResults("upper-upper", types.QueryStatusComplete, "c")
+	s.advance(5)
+
+	result, err := s.querier.QueryAll(s.ctx, s.query)
+
+	s.NoError(err)
+	s.Len(result.Rows, 3)
+	s.Equal([]string{"a", "b", "c"}, []string{result.Rows[0]["@message"], result.Rows[1]["@message"], result.Rows[2]["@message"]})
+	s.Equal(gosoLogs.InsightsStatistics{
+		BytesScanned:   50,
+		RecordsMatched: 7,
+		RecordsScanned: 25,
+	}, result.Statistics)
+}
+
+func (s *insightsQuerierTestSuite) TestQueryAll_SingleSecond() {
+	s.query.EndTime = s.query.StartTime
+
+	s.expectStart("id", 100, 100)
+	s.expectResults("id", types.QueryStatusComplete, "a", "b")
+	s.advance(1)
+
+	_, err := s.querier.QueryAll(s.ctx, s.query)
+
+	s.EqualError(err, "can not split insights query: there are more than 2 rows within the second "+s.query.StartTime.Format(time.RFC3339))
+}
+
+func (s *insightsQuerierTestSuite) advance(ticks int) {
+	go func() {
+		for i := 0; i < ticks; i++ {
+			s.clock.BlockUntil(1)
+			s.clock.Advance(time.Second)
+		}
+	}()
+}
+
+func (s *insightsQuerierTestSuite) startInput(start int64, end int64) *cloudwatchlogs.StartQueryInput {
+	return &cloudwatchlogs.StartQueryInput{
+		LogGroupNames: []string{"group"},
+		QueryString:   aws.String("fields @timestamp, @message"),
+		StartTime:     aws.Int64(start),
+		EndTime:       aws.Int64(end),
+		Limit:         aws.Int32(2),
+	}
+}
+
+func (s *insightsQuerierTestSuite) expectStart(queryId string, start int64, end int64) {
+	s.client.EXPECT().StartQuery(matcher.Context, s.startInput(start, end)).Return(&cloudwatchlogs.StartQueryOutput{
+		QueryId: aws.String(queryId),
+	}, nil).Once()
+}
+
+func (s *insightsQuerierTestSuite) expectResults(queryId string, status types.QueryStatus, messages ...string) {
+	results := make([][]types.ResultField, 0, len(messages))
+
+	for _, message := range messages {
+		results = append(results, []types.ResultField{
+			{Field: aws.String("@timestamp"), Value: aws.String("2024-01-15 10:00:00.000")},
+			{Field: aws.String("@message"), Value: aws.String(message)},
+		})
+	}
+
+	s.client.EXPECT().GetQueryResults(matcher.Context, &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: aws.String(queryId),
+	}).Return(&cloudwatchlogs.GetQueryResultsOutput{
+		Status:  status,
+		Results: results,
+		Statistics: &types.QueryStatistics{
+			BytesScanned:   10,
+			RecordsMatched: float64(len(messages)),
+			RecordsScanned: 5,
+		},
+	}, nil).Once()
+}
+
+type requestLog struct {
+	Timestamp time.Time     `insights:"@timestamp"`
+	Message   string        `insights:"@message"`
+	Status    int           `insights:"status"`
+	Latency   time.Duration `insights:"latency"`
+	UserId    string        `insights:"ctx.user_id"`
+	Missing   *string       `insights:"missing"`
+}
+
+func TestUnmarshalInsightsRows(t *testing.T) {
+	rows := []gosoLogs.InsightsRow{
+		{
+			"@timestamp":  "2024-01-15 10:00:00.123",
+			"@message":    "request done",
+			"status":      "200",
+			"latency":     "150ms",
+			"ctx.user_id": "u1",
+			"@ptr":        "ptr",
+		},
+	}
+
+	items, err := gosoLogs.UnmarshalInsightsRows[requestLog](rows)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []requestLog{
+		{
+			Timestamp: time.Date(2024, 1, 15, 10, 0, 0, 123000000, time.UTC),
+			Message:   "request done",
+			Status:    200,
+			Latency:   150 * time.Millisecond,
+			UserId:    "u1",
+		},
+	}, items)
+}
+
+func TestUnmarshalInsightsRows_InvalidValue(t *testing.T) {
+	rows := []gosoLogs.InsightsRow{
+		{"status": "ok"},
+	}
+
+	_, err := gosoLogs.UnmarshalInsightsRows[requestLog](rows)
+
+	assert.ErrorContains(t, err, "can not decode row 0")
+}
@@ -0,0 +1,282 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	netHttp "net/http"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	ec2Metadata "github.com/justtrackio/gosoline/pkg/cloud/aws/ec2/metadata"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/http"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
+)
+
+const (
+	// EnvEcsContainerMetadataUri is set by the ecs agent in every container of a task.
+	EnvEcsContainerMetadataUri = "ECS_CONTAINER_METADATA_URI_V4"
+
+	PlatformEc2   = "ec2"
+	PlatformEcs   = "ecs"
+	PlatformLocal = "local"
+)
+
+// Metadata describes where the application is running. Fields which are not known on the current platform are empty.
+type Metadata struct {
+	Platform         string `cfg:"platform"`
+	Region           string `cfg:"region"`
+	AvailabilityZone string `cfg:"availability_zone"`
+	InstanceId       string `cfg:"instance_id"`
+	InstanceType     string `cfg:"instance_type"`
+	Cluster          string `cfg:"cluster"`
+	TaskArn          string `cfg:"task_arn"`
+	TaskId           string `cfg:"task_id"`
+	TaskFamily       string `cfg:"task_family"`
+	TaskRevision     string `cfg:"task_revision"`
+	LaunchType       string `cfg:"launch_type"`
+	ContainerName    string `cfg:"container_name"`
+	// CpuLimit is the number of vCPUs of the container, or of the task if the container has no own limit.
+	CpuLimit float64 `cfg:"cpu_limit"`
+	// MemoryLimit is the memory in MiB of the container, or of the task if the container has no own limit.
+	MemoryLimit int64 `cfg:"memory_limit"`
+}
+
+// LogFields returns all known values of the metadata as log fields.
+func (m *Metadata) LogFields() log.Fields {
+	fields := log.Fields{
+		"deployment_platform": m.Platform,
+	}
+
+	for key, value := range map[string]string{
+		"aws_region":            m.Region,
+		"aws_availability_zone": m.AvailabilityZone,
+		"ec2_instance_id":       m.InstanceId,
+		"ecs_cluster":           m.Cluster,
+		"ecs_task_id":           m.TaskId,
+		"ecs_task_revision":     m.TaskRevision,
+		"ecs_container_name":    m.ContainerName,
+	} {
+		if value != "" {
+			fields[key] = value
+		}
+	}
+
+	return fields
+}
+
+// MetricDimensions returns the known values of the metadata which are suitable as metric dimensions. Values which change
+// with every deployment or instance (task and instance ids) are left out to keep the cardinality of the metrics low.
+func (m *Metadata) MetricDimensions() metric.Dimensions {
+	dimensions := metric.Dimensions{}
+
+	for key, value := range map[string]string{
+		"AvailabilityZone": m.AvailabilityZone,
+		"Cluster":          m.Cluster,
+	} {
+		if value != "" {
+			dimensions[key] = value
+		}
+	}
+
+	return dimensions
+}
+
+// ConfigMap returns the metadata as config values, all keys are always present so config values can reference them
+// with {deployment.<key>} on every platform.
+func (m *Metadata) ConfigMap() map[string]any {
+	return map[string]any{
+		"platform":          m.Platform,
+		"region":            m.Region,
+		"availability_zone": m.AvailabilityZone,
+		"instance_id":       m.InstanceId,
+		"instance_type":     m.InstanceType,
+		"cluster":           m.Cluster,
+		"task_arn":          m.TaskArn,
+		"task_id":           m.TaskId,
+		"task_family":       m.TaskFamily,
+		"task_revision":     m.TaskRevision,
+		"launch_type":       m.LaunchType,
+		"container_name":    m.ContainerName,
+		"cpu_limit":         m.CpuLimit,
+		"memory_limit":      m.MemoryLimit,
+	}
+}
+
+// A Reader reads the metadata of the ecs task or ec2 instance the application is running on.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name Reader
+type Reader interface {
+	ReadMetadata(ctx context.Context) (*Metadata, error)
+}
+
+type ecsLimits struct {
+	CPU    float64 `json:"CPU"`
+	Memory int64   `json:"Memory"`
+}
+
+type ecsTaskMetadata struct {
+	Cluster          string    `json:"Cluster"`
+	TaskARN          string    `json:"TaskARN"`
+	Family           string    `json:"Family"`
+	Revision         string    `json:"Revision"`
+	AvailabilityZone string    `json:"AvailabilityZone"`
+	LaunchType       string    `json:"LaunchType"`
+	Limits           ecsLimits `json:"Limits"`
+}
+
+type ecsContainerMetadata struct {
+	Name   string    `json:"Name"`
+	Limits ecsLimits `json:"Limits"`
+}
+
+type reader struct {
+	httpClient     http.Client
+	ec2Provider    ec2Metadata.Provider
+	ecsMetadataUri string
+}
+
+type metadataAppCtxKey struct{}
+
+// ProvideMetadata reads the metadata once per application.
+func ProvideMetadata(ctx context.Context, config cfg.Config, logger log.Logger) (*Metadata, error) {
+	return appctx.Provide(ctx, metadataAppCtxKey{}, func() (*Metadata, error) {
+		reader, err := NewReader(ctx, config, logger)
+		if err != nil {
+			return nil, fmt.Errorf("can not create deployment metadata reader: %w", err)
+		}
+
+		return reader.ReadMetadata(ctx)
+	})
+}
+
+func NewReader(ctx context.Context, config cfg.Config, logger log.Logger) (Reader, error) {
+	httpClient, err := http.ProvideHttpClient(ctx, config, logger, "deployment-metadata")
+	if err != nil {
+		return nil, fmt.Errorf("can not create http client: %w", err)
+	}
+
+	ec2Provider, err := ec2Metadata.ProvideProvider(ctx, config, logger)
+	if err != nil {
+		return nil, fmt.Errorf("can not create ec2 metadata provider: %w", err)
+	}
+
+	return NewReaderWithInterfaces(httpClient, ec2Provider, os.Getenv(EnvEcsContainerMetadataUri)), nil
+}
+
+func NewReaderWithInterfaces(httpClient http.Client, ec2Provider ec2Metadata.Provider, ecsMetadataUri string) Reader {
+	return &reader{
+		httpClient:     httpClient,
+		ec2Provider:    ec2Provider,
+		ecsMetadataUri: ecsMetadataUri,
+	}
+}
+
+func (r *reader) ReadMetadata(ctx context.Context) (*Metadata, error) {
+	if r.ecsMetadataUri != "" {
+		return r.readEcsMetadata(ctx)
+	}
+
+	return r.readEc2Metadata(ctx)
+}
+
+func (r *reader) readEcsMetadata(ctx context.Context) (*Metadata, error) {
+	task := &ecsTaskMetadata{}
+	if err := r.getJson(ctx, r.ecsMetadataUri+"/task", task); err != nil {
+		return nil, fmt.Errorf("can not read ecs task metadata: %w", err)
+	}
+
+	container := &ecsContainerMetadata{}
+	if err := r.getJson(ctx, r.ecsMetadataUri, container); err != nil {
+		return nil, fmt.Errorf("can not read ecs container metadata: %w", err)
+	}
+
+	metadata := &Metadata{
+		Platform:         PlatformEcs,
+		AvailabilityZone: task.AvailabilityZone,
+		Cluster:          clusterName(task.Cluster),
+		TaskArn:          task.TaskARN,
+		TaskFamily:       task.Family,
+		TaskRevision:     task.Revision,
+		LaunchType:       task.LaunchType,
+		ContainerName:    container.Name,
+		CpuLimit:         task.Limits.CPU,
+		MemoryLimit:      task.Limits.Memory,
+	}
+
+	if parsed, err := arn.Parse(task.TaskARN); err == nil {
+		metadata.Region = parsed.Region
+		metadata.TaskId = parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
+	}
+
+	// the container limits are in cpu units (1024 per vCPU) while the task limits are in vCPUs
+	if container.Limits.CPU > 0 {
+		metadata.CpuLimit = container.Limits.CPU / 1024
+	}
+
+	if container.Limits.Memory > 0 {
+		metadata.MemoryLimit = container.Limits.Memory
+	}
+
+	return metadata, nil
+}
+
+func (r *reader) readEc2Metadata(ctx context.Context) (*Metadata, error) {
+	metadata := &Metadata{
+		Platform: PlatformEc2,
+	}
+
+	for path, target := range map[string]*string{
+		ec2Metadata.PathInstanceId:                &metadata.InstanceId,
+		ec2Metadata.PathInstanceType:              &metadata.InstanceType,
+		ec2Metadata.PathPlacementAvailabilityZone: &metadata.AvailabilityZone,
+		ec2Metadata.PathPlacementRegion:           &metadata.Region,
+	} {
+		value, err := r.ec2Provider.GetMetadata(ctx, path)
+
+		if errors.Is(err, ec2Metadata.ErrNotAvailable) {
+			return &Metadata{Platform: PlatformLocal}, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("can not read ec2 metadata %s: %w", path, err)
+		}
+
+		*target = value
+	}
+
+	return metadata, nil
+}
+
+// clusterName returns the name of the cluster as the task metadata contains either the name or the arn of the cluster.
+func clusterName(cluster string) string {
+	if parsed, err := arn.Parse(cluster); err == nil {
+		return strings.TrimPrefix(parsed.Resource, "cluster/")
+	}
+
+	return cluster
+}
+
+func (r *reader) getJson(ctx context.Context, url string, target any) error {
+	req := r.httpClient.NewRequest().WithUrl(url)
+
+	res, err := r.httpClient.Get(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != netHttp.StatusOK {
+		return fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	if err = json.Unmarshal(res.Body, target); err != nil {
+		return fmt.Errorf("can not unmarshal response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package deployment_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/deployment"
+	ec2Metadata "github.com/justtrackio/gosoline/pkg/cloud/aws/ec2/metadata"
+	ec2MetadataMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/ec2/metadata/mocks"
+	"github.com/justtrackio/gosoline/pkg/http"
+	httpMocks "github.com/justtrackio/gosoline/pkg/http/mocks"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReadMetadata_Ecs(t *testing.T) {
+	httpClient := httpMocks.NewClient(t)
+	httpClient.EXPECT().NewRequest().Return(http.NewRequest(nil)).Twice()
+	httpClient.EXPECT().Get(matcher.Context, http.NewRequest(nil).WithUrl("http://169.254.170.2/v4/abc/task")).Return(&http.Response{
+		StatusCode: 200,
+		Body: []byte(`{
+			"Cluster": "arn:aws:ecs:eu-central-1:123456789012:cluster/production",
+			"TaskARN": "arn:aws:ecs:eu-central-1:123456789012:task/production/158d1c8083dd49d6b527399fd6414f5c",
+			"Family": "api",
+			"Revision": "26",
+			"AvailabilityZone": "eu-central-1b",
+			"LaunchType": "FARGATE",
+			"Limits": {"CPU": 0.5, "Memory": 1024}
+		}`),
+	}, nil).Once()
+	httpClient.EXPECT().Get(matcher.Context, http.NewRequest(nil).WithUrl("http://169.254.170.2/v4/abc")).Return(&http.Response{
+		StatusCode: 200,
+		Body:       []byte(`{"Name": "api", "Limits": {"CPU": 256}}`),
+	}, nil).Once()
+
+	reader := deployment.NewReaderWithInterfaces(httpClient, ec2MetadataMocks.NewProvider(t), "http://169.254.170.2/v4/abc")
+	metadata, err := reader.ReadMetadata(t.Context())
+
+	assert.NoError(t, err)
+	assert.Equal(t, &deployment.Metadata{
+		Platform:         deployment.PlatformEcs,
+		Region:           "eu-central-1",
+		AvailabilityZone: "eu-central-1b",
+		Cluster:          "production",
+		TaskArn:          "arn:aws:ecs:eu-central-1:123456789012:task/production/158d1c8083dd49d6b527399fd6414f5c",
+		TaskId:           "158d1c8083dd49d6b527399fd6414f5c",
+		TaskFamily:       "api",
+		TaskRevision:     "26",
+		LaunchType:       "FARGATE",
+		ContainerName:    "api",
+		CpuLimit:         0.25,
+		MemoryLimit:      1024,
+	}, metadata)
+	assert.Equal(t, metric.Dimensions{
+		"AvailabilityZone": "eu-central-1b",
+		"Cluster":          "production",
+	}, metadata.MetricDimensions())
+}
+
+func TestReadMetadata_Ec2(t *testing.T) {
+	provider := ec2MetadataMocks.NewProvider(t)
+	provider.EXPECT().GetMetadata(matcher.Context, ec2Metadata.PathInstanceId).Return("i-0123456789", nil).Once()
+	provider.EXPECT().GetMetadata(matcher.Context, ec2Metadata.PathInstanceType).Return("m7g.large", nil).Once()
+	provider.EXPECT().GetMetadata(matcher.Context, ec2Metadata.PathPlacementAvailabilityZone).Return("eu-central-1a", nil).Once()
+	provider.EXPECT().GetMetadata(matcher.Context, ec2Metadata.PathPlacementRegion).Return("eu-central-1", nil).Once()
+
+	reader := deployment.NewReaderWithInterfaces(httpMocks.NewClient(t), provider, "")
+	metadata, err := reader.ReadMetadata(t.Context())
+
+	assert.NoError(t, err)
+	assert.Equal(t, &deployment.Metadata{
+		Platform:         deployment.PlatformEc2,
+		Region:           "eu-central-1",
+		AvailabilityZone: "eu-central-1a",
+		InstanceId:       "i-0123456789",
+		InstanceType:     "m7g.large",
+	}, metadata)
+	assert.Equal(t, log.Fields{
+		"deployment_platform":   "ec2",
+		"aws_region":            "eu-central-1",
+		"aws_availability_zone": "eu-central-1a",
+		"ec2_instance_id":       "i-0123456789",
+	}, metadata.LogFields())
+}
+
+func TestReadMetadata_Local(t *testing.T) {
+	provider := ec2MetadataMocks.NewProvider(t)
+	provider.EXPECT().GetMetadata(matcher.Context, mock.AnythingOfType("string")).Return("", ec2Metadata.ErrNotAvailable).Once()
+
+	reader := deployment.NewReaderWithInterfaces(httpMocks.NewClient(t), provider, "")
+	metadata, err := reader.ReadMetadata(t.Context())
+
+	assert.NoError(t, err)
+	assert.Equal(t, &deployment.Metadata{Platform: deployment.PlatformLocal}, metadata)
+	assert.Equal(t, "", metadata.ConfigMap()["availability_zone"])
+}
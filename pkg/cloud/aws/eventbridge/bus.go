@@ -0,0 +1,151 @@
+package eventbridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	cloudAws "github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/reslife"
+)
+
+const (
+	DefaultBusName        = "default"
+	MaxBatchSize          = 10
+	MetadataKeyEventBuses = "cloud.aws.eventbridge.buses"
+)
+
+type Event struct {
+	Source     string
+	DetailType string
+	// Detail has to be a json object.
+	Detail    string
+	Resources []string
+	// Time is set by eventbridge to the time of the put if empty.
+	Time time.Time
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name EventBus
+type EventBus interface {
+	PutEvents(ctx context.Context, events []Event) error
+}
+
+type EventBusMetadata struct {
+	AwsClientName string `json:"aws_client_name"`
+	EventBusArn   string `json:"event_bus_arn"`
+	EventBusName  string `json:"event_bus_name"`
+}
+
+type EventBusSettings struct {
+	// EventBusName of the bus to put the events to, the arn of the bus is discovered on startup.
+	EventBusName string
+	ClientName   string
+}
+
+type eventBus struct {
+	logger      log.Logger
+	client      Client
+	eventBusArn string
+}
+
+func NewEventBus(ctx context.Context, config cfg.Config, logger log.Logger, settings *EventBusSettings) (*eventBus, error) {
+	var err error
+	var client Client
+
+	if settings.EventBusName == "" {
+		settings.EventBusName = DefaultBusName
+	}
+
+	if client, err = ProvideClient(ctx, config, logger, settings.ClientName); err != nil {
+		return nil, fmt.Errorf("can not create eventbridge client %s: %w", settings.ClientName, err)
+	}
+
+	bus := NewEventBusWithInterfaces(logger, client, "")
+	if err = reslife.AddLifeCycleer(ctx, NewLifecycleManager(settings, &bus.eventBusArn)); err != nil {
+		return nil, fmt.Errorf("can not add lifecycle manager: %w", err)
+	}
+
+	return bus, nil
+}
+
+func NewEventBusWithInterfaces(logger log.Logger, client Client, eventBusArn string) *eventBus {
+	return &eventBus{
+		logger:      logger,
+		client:      client,
+		eventBusArn: eventBusArn,
+	}
+}
+
+// PutEvents puts the events in batches of MaxBatchSize. Events rejected by eventbridge are not retried, instead an
+// error containing the reason of every rejected event is returned after all batches were put.
+func (b *eventBus) PutEvents(ctx context.Context, events []Event) error {
+	var result error
+
+	ctx = cloudAws.WithResourceTarget(ctx, b.eventBusArn)
+
+	for i, chunk := range funk.Chunk(events, MaxBatchSize) {
+		if err := b.putChunk(ctx, chunk); err != nil {
+			result = multierror.Append(result, fmt.Errorf("can not put events [%d, %d]: %w", i*MaxBatchSize, i*MaxBatchSize+len(chunk)-1, err))
+		}
+	}
+
+	return result
+}
+
+func (b *eventBus) putChunk(ctx context.Context, events []Event) error {
+	input := &eventbridge.PutEventsInput{
+		Entries: make([]types.PutEventsRequestEntry, len(events)),
+	}
+
+	for i, event := range events {
+		input.Entries[i] = types.PutEventsRequestEntry{
+			EventBusName: aws.String(b.eventBusArn),
+			Source:       aws.String(event.Source),
+			DetailType:   aws.String(event.DetailType),
+			Detail:       aws.String(event.Detail),
+			Resources:    event.Resources,
+		}
+
+		if !event.Time.IsZero() {
+			input.Entries[i].Time = aws.Time(event.Time)
+		}
+	}
+
+	out, err := b.client.PutEvents(ctx, input)
+
+	if exec.IsRequestCanceled(err) {
+		b.logger.WithFields(log.Fields{
+			"arn": b.eventBusArn,
+		}).Info(ctx, "request was canceled while putting events")
+
+		return fmt.Errorf("request was canceled while putting events: %w", err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not put events: %w", err)
+	}
+
+	if out.FailedEntryCount == 0 {
+		return nil
+	}
+
+	var result error
+
+	for i, entry := range out.Entries {
+		if entry.ErrorCode == nil {
+			continue
+		}
+
+		result = multierror.Append(result, fmt.Errorf("event %d was rejected with %s: %s", i, *entry.ErrorCode, aws.ToString(entry.ErrorMessage)))
+	}
+
+	return result
+}
@@ -0,0 +1,80 @@
+package eventbridge_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsEventbridge "github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/eventbridge"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/eventbridge/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const eventBusArn = "arn:aws:events:eu-central-1:000000000000:event-bus/events"
+
+func TestEventBus_PutEvents(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+
+	events := make([]eventbridge.Event, 12)
+	for i := range events {
+		events[i] = eventbridge.Event{
+			Source:     "gosoline",
+			DetailType: "created",
+			Detail:     fmt.Sprintf(`{"id":%d}`, i),
+		}
+	}
+
+	client.EXPECT().PutEvents(matcher.Context, mock.MatchedBy(func(input *awsEventbridge.PutEventsInput) bool {
+		return len(input.Entries) == 10 && *input.Entries[0].EventBusName == eventBusArn && *input.Entries[9].Detail == `{"id":9}`
+	})).Return(&awsEventbridge.PutEventsOutput{}, nil).Once()
+
+	client.EXPECT().PutEvents(matcher.Context, &awsEventbridge.PutEventsInput{
+		Entries: []types.PutEventsRequestEntry{
+			{
+				EventBusName: aws.String(eventBusArn),
+				Source:       aws.String("gosoline"),
+				DetailType:   aws.String("created"),
+				Detail:       aws.String(`{"id":10}`),
+			},
+			{
+				EventBusName: aws.String(eventBusArn),
+				Source:       aws.String("gosoline"),
+				DetailType:   aws.String("created"),
+				Detail:       aws.String(`{"id":11}`),
+			},
+		},
+	}).Return(&awsEventbridge.PutEventsOutput{}, nil).Once()
+
+	bus := eventbridge.NewEventBusWithInterfaces(logger, client, eventBusArn)
+	err := bus.PutEvents(t.Context(), events)
+	assert.NoError(t, err)
+}
+
+func TestEventBus_PutEventsFailedEntries(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+
+	client.EXPECT().PutEvents(matcher.Context, mock.Anything).Return(&awsEventbridge.PutEventsOutput{
+		FailedEntryCount: 1,
+		Entries: []types.PutEventsResultEntry{
+			{
+				EventId: aws.String("1"),
+			},
+			{
+				ErrorCode:    aws.String("InternalFailure"),
+				ErrorMessage: aws.String("try again"),
+			},
+		},
+	}, nil).Once()
+
+	bus := eventbridge.NewEventBusWithInterfaces(logger, client, eventBusArn)
+	err := bus.PutEvents(t.Context(), []eventbridge.Event{{}, {}})
+	assert.ErrorContains(t, err, "can not put events [0, 1]")
+	assert.ErrorContains(t, err, "event 1 was rejected with InternalFailure: try again")
+}
@@ -0,0 +1,128 @@
+package eventbridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/reslife"
+)
+
+type lifecycleManager struct {
+	logger      log.Logger
+	client      Client
+	settings    *EventBusSettings
+	eventBusArn *string
+}
+
+type LifecycleManager interface {
+	reslife.LifeCycleer
+	reslife.Creator
+	reslife.Initializer
+	reslife.Registerer
+}
+
+var _ LifecycleManager = (*lifecycleManager)(nil)
+
+func NewLifecycleManager(settings *EventBusSettings, eventBusArn *string) reslife.LifeCycleerFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (reslife.LifeCycleer, error) {
+		var err error
+		var client Client
+
+		if client, err = ProvideClient(ctx, config, logger, settings.ClientName); err != nil {
+			return nil, fmt.Errorf("can not create eventbridge client %s: %w", settings.ClientName, err)
+		}
+
+		return NewLifecycleManagerWithInterfaces(logger, client, settings, eventBusArn), nil
+	}
+}
+
+func NewLifecycleManagerWithInterfaces(logger log.Logger, client Client, settings *EventBusSettings, eventBusArn *string) LifecycleManager {
+	return &lifecycleManager{
+		logger:      logger,
+		client:      client,
+		settings:    settings,
+		eventBusArn: eventBusArn,
+	}
+}
+
+func (l *lifecycleManager) GetId() string {
+	return fmt.Sprintf("eventbridge/%s", l.settings.EventBusName)
+}
+
+// Create creates the event bus if it doesn't exist yet. The default bus exists in every account and is never created.
+func (l *lifecycleManager) Create(ctx context.Context) error {
+	if l.settings.EventBusName == DefaultBusName {
+		return nil
+	}
+
+	arn, err := l.describeEventBus(ctx)
+	if err != nil {
+		return err
+	}
+
+	if arn != "" {
+		return nil
+	}
+
+	input := &eventbridge.CreateEventBusInput{
+		Name: aws.String(l.settings.EventBusName),
+	}
+
+	if _, err = l.client.CreateEventBus(ctx, input); err != nil {
+		return fmt.Errorf("can not create event bus %s: %w", l.settings.EventBusName, err)
+	}
+
+	l.logger.Info(ctx, "created event bus %s", l.settings.EventBusName)
+
+	return nil
+}
+
+// Init discovers the arn of the event bus by its name.
+func (l *lifecycleManager) Init(ctx context.Context) error {
+	arn, err := l.describeEventBus(ctx)
+	if err != nil {
+		return err
+	}
+
+	if arn == "" {
+		return fmt.Errorf("event bus %s does not exist", l.settings.EventBusName)
+	}
+
+	*l.eventBusArn = arn
+
+	return nil
+}
+
+func (l *lifecycleManager) Register(ctx context.Context) (key string, metadata any, err error) {
+	metadata = EventBusMetadata{
+		AwsClientName: l.settings.ClientName,
+		EventBusArn:   *l.eventBusArn,
+		EventBusName:  l.settings.EventBusName,
+	}
+
+	return MetadataKeyEventBuses, metadata, nil
+}
+
+func (l *lifecycleManager) describeEventBus(ctx context.Context) (string, error) {
+	input := &eventbridge.DescribeEventBusInput{
+		Name: aws.String(l.settings.EventBusName),
+	}
+
+	out, err := l.client.DescribeEventBus(ctx, input)
+	if err != nil {
+		var errResourceNotFound *types.ResourceNotFoundException
+		if errors.As(err, &errResourceNotFound) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("can not describe event bus %s: %w", l.settings.EventBusName, err)
+	}
+
+	return aws.ToString(out.Arn), nil
+}
@@ -0,0 +1,66 @@
+package eventbridge_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsEventbridge "github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/eventbridge"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/eventbridge/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLifecycleManager_CreateAndInit(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+	settings := &eventbridge.EventBusSettings{
+		EventBusName: "events",
+	}
+
+	describeInput := &awsEventbridge.DescribeEventBusInput{
+		Name: aws.String("events"),
+	}
+
+	client.EXPECT().DescribeEventBus(matcher.Context, describeInput).Return(nil, &types.ResourceNotFoundException{}).Once()
+	client.EXPECT().CreateEventBus(matcher.Context, &awsEventbridge.CreateEventBusInput{
+		Name: aws.String("events"),
+	}).Return(&awsEventbridge.CreateEventBusOutput{}, nil).Once()
+	client.EXPECT().DescribeEventBus(matcher.Context, describeInput).Return(&awsEventbridge.DescribeEventBusOutput{
+		Arn: aws.String(eventBusArn),
+	}, nil).Once()
+
+	arn := ""
+	manager := eventbridge.NewLifecycleManagerWithInterfaces(logger, client, settings, &arn)
+
+	err := manager.Create(t.Context())
+	assert.NoError(t, err)
+
+	err = manager.Init(t.Context())
+	assert.NoError(t, err)
+	assert.Equal(t, eventBusArn, arn)
+}
+
+func TestLifecycleManager_InitMissingBus(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+	settings := &eventbridge.EventBusSettings{
+		EventBusName: eventbridge.DefaultBusName,
+	}
+
+	client.EXPECT().DescribeEventBus(matcher.Context, &awsEventbridge.DescribeEventBusInput{
+		Name: aws.String(eventbridge.DefaultBusName),
+	}).Return(nil, &types.ResourceNotFoundException{}).Once()
+
+	arn := ""
+	manager := eventbridge.NewLifecycleManagerWithInterfaces(logger, client, settings, &arn)
+
+	// the default bus is never created
+	err := manager.Create(t.Context())
+	assert.NoError(t, err)
+
+	err = manager.Init(t.Context())
+	assert.EqualError(t, err, "event bus default does not exist")
+}
@@ -0,0 +1,52 @@
+package firehose
+
+import (
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws"
+)
+
+type NamingSettings struct {
+	DeliveryStreamPattern string `cfg:"delivery_stream_pattern,nodecode" default:"{app.namespace}-{deliveryStreamName}"`
+	Delimiter             string `cfg:"delimiter" default:"-"`
+}
+
+func GetDeliveryStreamName(config cfg.Config, identity cfg.Identity, clientName string, deliveryStreamName string) (string, error) {
+	namingSettings, err := readNamingSettings(config, clientName)
+	if err != nil {
+		return "", err
+	}
+
+	if err = identity.PadFromConfig(config); err != nil {
+		return "", fmt.Errorf("failed to pad app identity from config: %w", err)
+	}
+
+	name, err := identity.Format(namingSettings.DeliveryStreamPattern, namingSettings.Delimiter, map[string]string{
+		"deliveryStreamName": deliveryStreamName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("firehose delivery stream naming failed: %w", err)
+	}
+
+	return name, nil
+}
+
+func readNamingSettings(config cfg.Config, clientName string) (*NamingSettings, error) {
+	if clientName == "" {
+		return nil, fmt.Errorf("the client name shouldn't be empty")
+	}
+
+	namingKey := fmt.Sprintf("%s.naming", aws.GetClientConfigKey("firehose", clientName))
+	defaultNamingKey := fmt.Sprintf("%s.naming", aws.GetClientConfigKey("firehose", "default"))
+
+	namingSettings := &NamingSettings{}
+	if err := config.UnmarshalKey(namingKey, namingSettings,
+		cfg.UnmarshalWithDefaultsFromKey(defaultNamingKey+".delivery_stream_pattern", "delivery_stream_pattern"),
+		cfg.UnmarshalWithDefaultsFromKey(defaultNamingKey+".delimiter", "delimiter"),
+	); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal firehose naming settings for %s: %w", namingKey, err)
+	}
+
+	return namingSettings, nil
+}
@@ -0,0 +1,34 @@
+package firehose_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/firehose"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDeliveryStreamName(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":       "test",
+			"name":      "producer",
+			"namespace": "{app.tags.project}.{app.env}.{app.tags.family}",
+			"tags": map[string]any{
+				"project": "justtrack",
+				"family":  "gosoline",
+			},
+		},
+	})
+
+	name, err := firehose.GetDeliveryStreamName(config, cfg.Identity{}, "default", "events")
+	assert.NoError(t, err)
+	assert.Equal(t, "justtrack-test-gosoline-events", name)
+
+	err = config.Option(cfg.WithConfigSetting("cloud.aws.firehose.clients.default.naming.delivery_stream_pattern", "{app.env}-{app.name}-{deliveryStreamName}"))
+	assert.NoError(t, err)
+
+	name, err = firehose.GetDeliveryStreamName(config, cfg.Identity{}, "other", "events")
+	assert.NoError(t, err)
+	assert.Equal(t, "test-producer-events", name)
+}
@@ -0,0 +1,307 @@
+package firehose
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/go-multierror"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
+)
+
+const (
+	// BatchSizeMax is the maximum number of records of a single PutRecordBatch request.
+	BatchSizeMax = 500
+	// BatchBytesMax is the maximum size of all records of a single PutRecordBatch request.
+	BatchBytesMax = 4 * 1024 * 1024
+	// RecordSizeMax is the maximum size of a single record before base64 encoding.
+	RecordSizeMax = 1000 * 1024
+
+	// errorCodeServiceUnavailable is returned for records which were throttled because the delivery stream exceeded its limits.
+	errorCodeServiceUnavailable = "ServiceUnavailableException"
+
+	metricNamePutRecordBatch          = "PutRecordBatch"
+	metricNamePutRecordBatchFailure   = "PutRecordBatchFailure"
+	metricNamePutRecordBatchThrottled = "PutRecordBatchThrottled"
+)
+
+type RecordWriterSettings struct {
+	cfg.ResourceIdentifier
+	ClientName         string
+	DeliveryStreamName string
+	// BatchSize is the maximum number of records per request, capped at BatchSizeMax.
+	BatchSize int
+	// BatchBytes is the maximum size of all records per request, capped at BatchBytesMax.
+	BatchBytes int
+	Backoff    exec.BackoffSettings
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name RecordWriter
+type RecordWriter interface {
+	PutRecord(ctx context.Context, record []byte) error
+	// PutRecordBatch writes the records in as many requests as needed and retries records which failed with a backoff.
+	PutRecordBatch(ctx context.Context, records [][]byte) error
+}
+
+type recordWriter struct {
+	logger             log.Logger
+	metricWriter       metric.Writer
+	clock              clock.Clock
+	client             Client
+	settings           *RecordWriterSettings
+	deliveryStreamName string
+}
+
+func NewRecordWriter(ctx context.Context, config cfg.Config, logger log.Logger, settings *RecordWriterSettings) (RecordWriter, error) {
+	var err error
+	var deliveryStreamName string
+	var client *firehose.Client
+
+	if deliveryStreamName, err = GetDeliveryStreamName(config, settings.ToIdentity(), settings.ClientName, settings.DeliveryStreamName); err != nil {
+		return nil, fmt.Errorf("can not get full delivery stream name: %w", err)
+	}
+
+	metricWriter := metric.NewWriter(getRecordWriterDefaultMetrics(deliveryStreamName)...)
+
+	if client, err = ProvideClient(ctx, config, logger, settings.ClientName); err != nil {
+		return nil, fmt.Errorf("failed to provide firehose client: %w", err)
+	}
+
+	return NewRecordWriterWithInterfaces(logger, metricWriter, clock.Provider, client, settings, deliveryStreamName), nil
+}
+
+func NewRecordWriterWithInterfaces(
+	logger log.Logger,
+	metricWriter metric.Writer,
+	clock clock.Clock,
+	client Client,
+	settings *RecordWriterSettings,
+	deliveryStreamName string,
+) RecordWriter {
+	if settings.BatchSize <= 0 || settings.BatchSize > BatchSizeMax {
+		settings.BatchSize = BatchSizeMax
+	}
+
+	if settings.BatchBytes <= 0 || settings.BatchBytes > BatchBytesMax {
+		settings.BatchBytes = BatchBytesMax
+	}
+
+	return &recordWriter{
+		logger:             logger,
+		metricWriter:       metricWriter,
+		clock:              clock,
+		client:             client,
+		settings:           settings,
+		deliveryStreamName: deliveryStreamName,
+	}
+}
+
+func (w *recordWriter) PutRecord(ctx context.Context, record []byte) error {
+	return w.PutRecordBatch(ctx, [][]byte{record})
+}
+
+func (w *recordWriter) PutRecordBatch(ctx context.Context, records [][]byte) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	ctx = log.AppendContextFields(ctx, log.Fields{
+		"delivery_stream_name": w.deliveryStreamName,
+	})
+
+	var errs error
+
+	for _, record := range records {
+		if len(record) > RecordSizeMax {
+			return fmt.Errorf("can not put records to delivery stream %s: record of %d bytes exceeds the maximum size of %d bytes", w.deliveryStreamName, len(record), RecordSizeMax)
+		}
+	}
+
+	for _, chunk := range w.chunk(records) {
+		if err := w.putRecordBatch(ctx, chunk); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if errs != nil {
+		return fmt.Errorf("can not put records to delivery stream %s: %w", w.deliveryStreamName, errs)
+	}
+
+	return nil
+}
+
+// chunk splits the records into batches which stay within the configured batch size and batch bytes.
+func (w *recordWriter) chunk(records [][]byte) [][]types.Record {
+	chunks := make([][]types.Record, 0, 1)
+	current := make([]types.Record, 0, min(len(records), w.settings.BatchSize))
+	currentBytes := 0
+
+	for _, record := range records {
+		if len(current) == w.settings.BatchSize || (len(current) > 0 && currentBytes+len(record) > w.settings.BatchBytes) {
+			chunks = append(chunks, current)
+			current = make([]types.Record, 0, w.settings.BatchSize)
+			currentBytes = 0
+		}
+
+		current = append(current, types.Record{Data: record})
+		currentBytes += len(record)
+	}
+
+	return append(chunks, current)
+}
+
+func (w *recordWriter) putRecordBatch(ctx context.Context, records []types.Record) error {
+	var err error
+	var failedRecords []types.Record
+	var throttled int
+	var reason string
+
+	attempt := 1
+	start := w.clock.Now()
+	backoffConfig := exec.NewExponentialBackOff(&w.settings.Backoff)
+
+	for {
+		if failedRecords, throttled, reason, err = w.putRecordBatchAndCollectFailed(ctx, records); err != nil {
+			return fmt.Errorf("can not write batch to delivery stream: %w", err)
+		}
+
+		w.writeMetrics(ctx, len(records), len(failedRecords), throttled)
+		took := w.clock.Since(start)
+
+		if len(failedRecords) == 0 && attempt == 1 {
+			return nil
+		}
+
+		if len(failedRecords) == 0 {
+			w.logger.Warn(ctx, "PutRecordBatch successful after %d attempts in %s", attempt, took)
+
+			return nil
+		}
+
+		w.logger.Warn(
+			ctx,
+			"PutRecordBatch failed %d of %d records with reason: %s: after %d attempts in %s",
+			len(failedRecords),
+			len(records),
+			reason,
+			attempt,
+			took,
+		)
+
+		sleep := backoffConfig.NextBackOff()
+		if sleep == backoff.Stop || (w.settings.Backoff.MaxAttempts > 0 && attempt >= w.settings.Backoff.MaxAttempts) {
+			return fmt.Errorf("failed to put %d records after %d attempts in %s: %s", len(failedRecords), attempt, took, reason)
+		}
+
+		records = failedRecords
+
+		// sleep some time before retrying to give the delivery stream some time to recover from throttling
+		w.clock.Sleep(sleep)
+		attempt++
+	}
+}
+
+func (w *recordWriter) putRecordBatchAndCollectFailed(ctx context.Context, records []types.Record) ([]types.Record, int, string, error) {
+	output, err := w.client.PutRecordBatch(ctx, &firehose.PutRecordBatchInput{
+		DeliveryStreamName: aws.String(w.deliveryStreamName),
+		Records:            records,
+	})
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("can not execute PutRecordBatchRequest: %w", err)
+	}
+
+	if aws.ToInt32(output.FailedPutCount) == 0 {
+		return nil, 0, "", nil
+	}
+
+	failedRecords := make([]types.Record, 0, aws.ToInt32(output.FailedPutCount))
+	errorCodes := make([]string, 0)
+	errorCounts := make(map[string]int)
+
+	for i, response := range output.RequestResponses {
+		if response.ErrorCode == nil {
+			continue
+		}
+
+		failedRecords = append(failedRecords, records[i])
+
+		if _, ok := errorCounts[*response.ErrorCode]; !ok {
+			errorCodes = append(errorCodes, *response.ErrorCode)
+		}
+
+		errorCounts[*response.ErrorCode]++
+	}
+
+	reasons := make([]string, 0, len(errorCodes))
+	for _, errorCode := range errorCodes {
+		reasons = append(reasons, fmt.Sprintf("%d %s errors", errorCounts[errorCode], errorCode))
+	}
+
+	return failedRecords, errorCounts[errorCodeServiceUnavailable], strings.Join(reasons, ", "), nil
+}
+
+func (w *recordWriter) writeMetrics(ctx context.Context, records int, failed int, throttled int) {
+	w.metricWriter.Write(ctx, metric.Data{
+		&metric.Datum{
+			MetricName: metricNamePutRecordBatch,
+			Dimensions: map[string]string{
+				"DeliveryStreamName": w.deliveryStreamName,
+			},
+			Value: float64(records - failed),
+		},
+		&metric.Datum{
+			MetricName: metricNamePutRecordBatchFailure,
+			Dimensions: map[string]string{
+				"DeliveryStreamName": w.deliveryStreamName,
+			},
+			Value: float64(failed),
+		},
+		&metric.Datum{
+			MetricName: metricNamePutRecordBatchThrottled,
+			Dimensions: map[string]string{
+				"DeliveryStreamName": w.deliveryStreamName,
+			},
+			Value: float64(throttled),
+		},
+	})
+}
+
+func getRecordWriterDefaultMetrics(deliveryStreamName string) metric.Data {
+	return metric.Data{
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNamePutRecordBatch,
+			Dimensions: map[string]string{
+				"DeliveryStreamName": deliveryStreamName,
+			},
+			Unit:  metric.UnitCount,
+			Value: 0.0,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNamePutRecordBatchFailure,
+			Dimensions: map[string]string{
+				"DeliveryStreamName": deliveryStreamName,
+			},
+			Unit:  metric.UnitCount,
+			Value: 0.0,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNamePutRecordBatchThrottled,
+			Dimensions: map[string]string{
+				"DeliveryStreamName": deliveryStreamName,
+			},
+			Unit:  metric.UnitCount,
+			Value: 0.0,
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package firehose_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoFirehose "github.com/justtrackio/gosoline/pkg/cloud/aws/firehose"
+	firehoseMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/firehose/mocks"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRecordWriterTestSuite(t *testing.T) {
+	suite.Run(t, new(recordWriterTestSuite))
+}
+
+type recordWriterTestSuite struct {
+	suite.Suite
+	ctx          context.Context
+	logger       logMocks.LoggerMock
+	metricWriter *metricMocks.Writer
+	clock        clock.FakeClock
+	client       *firehoseMocks.Client
+	settings     *gosoFirehose.RecordWriterSettings
+}
+
+func (s *recordWriterTestSuite) SetupTest() {
+	s.ctx = s.T().Context()
+	s.logger = logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	s.metricWriter = metricMocks.NewWriter(s.T())
+	s.clock = clock.NewFakeClock(clock.WithNonBlockingSleep)
+	s.client = firehoseMocks.NewClient(s.T())
+	s.settings = &gosoFirehose.RecordWriterSettings{
+		Backoff: exec.BackoffSettings{
+			InitialInterval: time.Second,
+			MaxAttempts:     3,
+			MaxElapsedTime:  time.Minute,
+			MaxInterval:     time.Second,
+		},
+	}
+}
+
+func (s *recordWriterTestSuite) writer() gosoFirehose.RecordWriter {
+	return gosoFirehose.NewRecordWriterWithInterfaces(s.logger, s.metricWriter, s.clock, s.client, s.settings, "stream")
+}
+
+func (s *recordWriterTestSuite) TestPutRecordBatch_Chunked() {
+	s.settings.BatchSize = 2
+	s.settings.BatchBytes = 5
+
+	s.expectPutRecordBatch([]string{"ab", "cd"}, nil)
+	s.expectPutRecordBatch([]string{"efg"}, nil)
+	s.expectPutRecordBatch([]string{"hijklm"}, nil)
+	s.expectMetrics(2, 0, 0)
+	s.expectMetrics(1, 0, 0)
+	s.expectMetrics(1, 0, 0)
+
+	err := s.writer().PutRecordBatch(s.ctx, [][]byte{[]byte("ab"), []byte("cd"), []byte("efg"), []byte("hijklm")})
+	s.NoError(err)
+}
+
+func (s *recordWriterTestSuite) TestPutRecordBatch_RetryThrottled() {
+	s.expectPutRecordBatch([]string{"a", "b", "c"}, []*string{nil, aws.String("ServiceUnavailableException"), aws.String("InternalFailure")})
+	s.expectPutRecordBatch([]string{"b", "c"}, nil)
+	s.expectMetrics(1, 2, 1)
+	s.expectMetrics(2, 0, 0)
+
+	err := s.writer().PutRecordBatch(s.ctx, [][]byte{[]byte("a"), []byte("b"), []byte("c")})
+	s.NoError(err)
+}
+
+func (s *recordWriterTestSuite) TestPutRecordBatch_MaxAttempts() {
+	for i := 0; i < 3; i++ {
+		s.expectPutRecordBatch([]string{"a"}, []*string{aws.String("ServiceUnavailableException")})
+		s.expectMetrics(0, 1, 1)
+	}
+
+	err := s.writer().PutRecordBatch(s.ctx, [][]byte{[]byte("a")})
+	s.ErrorContains(err, "failed to put 1 records after 3 attempts")
+}
+
+func (s *recordWriterTestSuite) TestPutRecordBatch_RecordTooLarge() {
+	err := s.writer().PutRecord(s.ctx, make([]byte, gosoFirehose.RecordSizeMax+1))
+	s.EqualError(err, "can not put records to delivery stream stream: record of 1024001 bytes exceeds the maximum size of 1024000 bytes")
+}
+
+func (s *recordWriterTestSuite) expectPutRecordBatch(data []string, errorCodes []*string) {
+	records := make([]types.Record, len(data))
+	responses := make([]types.PutRecordBatchResponseEntry, len(data))
+	failed := int32(0)
+
+	for i := range data {
+		records[i] = types.Record{Data: []byte(data[i])}
+
+		if errorCodes != nil && errorCodes[i] != nil {
+			responses[i].ErrorCode = errorCodes[i]
+			failed++
+		}
+	}
+
+	s.client.EXPECT().PutRecordBatch(matcher.Context, &firehose.PutRecordBatchInput{
+		DeliveryStreamName: aws.String("stream"),
+		Records:            records,
+	}).Return(&firehose.PutRecordBatchOutput{
+		FailedPutCount:   aws.Int32(failed),
+		RequestResponses: responses,
+	}, nil).Once()
+}
+
+func (s *recordWriterTestSuite) expectMetrics(put int, failed int, throttled int) {
+	dimensions := map[string]string{"DeliveryStreamName": "stream"}
+
+	s.metricWriter.EXPECT().Write(matcher.Context, metric.Data{
+		{MetricName: "PutRecordBatch", Dimensions: dimensions, Value: float64(put)},
+		{MetricName: "PutRecordBatchFailure", Dimensions: dimensions, Value: float64(failed)},
+		{MetricName: "PutRecordBatchThrottled", Dimensions: dimensions, Value: float64(throttled)},
+	}).Once()
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/conc"
-	"github.com/justtrackio/gosoline/pkg/ddb"
 	"github.com/justtrackio/gosoline/pkg/mdl"
 )
 
@@ -44,7 +43,7 @@ func (c nopCheckpoint) Release(_ context.Context) error {
 }
 
 type checkpoint struct {
-	repo                ddb.Repository
+	store               MetadataStore
 	clock               clock.Clock
 	lck                 conc.PoisonedLock
 	namespace           string
@@ -111,11 +110,9 @@ func (c *checkpoint) Persist(ctx context.Context) (shouldRelease bool, err error
 		record.FinishedAt = nil
 	}
 
-	qb := c.repo.PutItemBuilder().WithCondition(ddb.Eq("owningClientId", c.owningClientId))
-
-	if result, err := c.repo.PutItem(ctx, qb, record); err != nil {
+	if owned, err := c.store.PutCheckpointRecord(ctx, record, c.owningClientId); err != nil {
 		return false, fmt.Errorf("failed to persist checkpoint: %w", err)
-	} else if result.ConditionalCheckFailed {
+	} else if !owned {
 		return false, ErrCheckpointNoLongerOwned
 	}
 
@@ -124,18 +121,20 @@ func (c *checkpoint) Persist(ctx context.Context) (shouldRelease bool, err error
 
 func (c *checkpoint) Release(ctx context.Context) error {
 	return c.lck.PoisonIf(func() (bool, error) {
-		qb := c.repo.UpdateItemBuilder().
-			WithHash(c.namespace).
-			WithRange(c.shardId).
-			Remove("owningClientId").
-			Set("updatedAt", c.clock.Now()).
-			Set("ttl", mdl.Box(c.clock.Now().Add(ShardTimeout).Unix())).
-			Set("sequenceNumber", c.sequenceNumber).
-			Set("lastShardIterator", c.shardIterator).
-			WithCondition(ddb.Eq("owningClientId", c.owningClientId))
-		if result, err := c.repo.UpdateItem(ctx, qb, &CheckpointRecord{}); err != nil {
+		record := &CheckpointRecord{
+			BaseRecord: BaseRecord{
+				Namespace: c.namespace,
+				Resource:  string(c.shardId),
+				UpdatedAt: c.clock.Now(),
+				Ttl:       mdl.Box(c.clock.Now().Add(ShardTimeout).Unix()),
+			},
+			SequenceNumber:    c.sequenceNumber,
+			LastShardIterator: c.shardIterator,
+		}
+
+		if owned, err := c.store.ReleaseCheckpointRecord(ctx, record, c.owningClientId); err != nil {
 			return false, fmt.Errorf("failed to release checkpoint: %w", err)
-		} else if result.ConditionalCheckFailed {
+		} else if !owned {
 			return true, ErrCheckpointAlreadyReleased
 		}
 
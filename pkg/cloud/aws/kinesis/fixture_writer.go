@@ -0,0 +1,107 @@
+package kinesis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+)
+
+var _ fixtures.FixtureWriter = &recordFixtureWriter{}
+
+// RecordFixture is a single record put onto a kinesis stream. Data of type []byte or string is written as is, any other
+// value is encoded as json, e.g. a *stream.Message to seed the input of a consumer. Records without a partition key get
+// a random one.
+type RecordFixture struct {
+	PartitionKey    string
+	ExplicitHashKey string
+	Data            any
+}
+
+type recordFixtureWriter struct {
+	logger log.Logger
+	writer RecordWriter
+}
+
+func RecordFixtureSetFactory(settings *RecordWriterSettings, data fixtures.NamedFixtures[*RecordFixture], options ...fixtures.FixtureSetOption) fixtures.FixtureSetFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (fixtures.FixtureSet, error) {
+		var err error
+		var writer fixtures.FixtureWriter
+
+		isolated := *settings
+		isolated.StreamName = fixtures.IsolatedName(ctx, settings.StreamName)
+
+		if writer, err = NewRecordFixtureWriter(ctx, config, logger, &isolated); err != nil {
+			return nil, fmt.Errorf("failed to create kinesis fixture writer for stream %s: %w", isolated.StreamName, err)
+		}
+
+		return fixtures.NewSimpleFixtureSet(data, writer, options...), nil
+	}
+}
+
+func NewRecordFixtureWriter(ctx context.Context, config cfg.Config, logger log.Logger, settings *RecordWriterSettings) (fixtures.FixtureWriter, error) {
+	var err error
+	var writer RecordWriter
+
+	if writer, err = NewRecordWriter(ctx, config, logger, settings); err != nil {
+		return nil, fmt.Errorf("failed to create kinesis record writer: %w", err)
+	}
+
+	return NewRecordFixtureWriterWithInterfaces(logger, writer), nil
+}
+
+func NewRecordFixtureWriterWithInterfaces(logger log.Logger, writer RecordWriter) fixtures.FixtureWriter {
+	return &recordFixtureWriter{
+		logger: logger,
+		writer: writer,
+	}
+}
+
+func (w *recordFixtureWriter) Write(ctx context.Context, fixtures []any) error {
+	if len(fixtures) == 0 {
+		return nil
+	}
+
+	records := make([]*Record, 0, len(fixtures))
+
+	for _, fixture := range fixtures {
+		recordFixture, ok := fixture.(*RecordFixture)
+		if !ok {
+			return fmt.Errorf("fixture of type %T is not a *kinesis.RecordFixture", fixture)
+		}
+
+		data, err := encodeRecordFixtureData(recordFixture.Data)
+		if err != nil {
+			return fmt.Errorf("can not encode the data of the kinesis record fixture: %w", err)
+		}
+
+		records = append(records, &Record{
+			Data:            data,
+			PartitionKey:    mdl.NilIfEmpty(recordFixture.PartitionKey),
+			ExplicitHashKey: mdl.NilIfEmpty(recordFixture.ExplicitHashKey),
+		})
+	}
+
+	if err := w.writer.PutRecords(ctx, records); err != nil {
+		return fmt.Errorf("can not put kinesis fixtures: %w", err)
+	}
+
+	w.logger.Info(ctx, "loaded %d kinesis fixtures", len(records))
+
+	return nil
+}
+
+func encodeRecordFixtureData(data any) ([]byte, error) {
+	switch d := data.(type) {
+	case []byte:
+		return d, nil
+	case string:
+		return []byte(d), nil
+	default:
+		return json.Marshal(d)
+	}
+}
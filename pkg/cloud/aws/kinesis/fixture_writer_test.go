@@ -0,0 +1,69 @@
+package kinesis_test
+
+import (
+	"fmt"
+	"testing"
+
+	gosoKinesis "github.com/justtrackio/gosoline/pkg/cloud/aws/kinesis"
+	gosoKinesisMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/kinesis/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordFixtureWriter_Write(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	recordWriter := gosoKinesisMocks.NewRecordWriter(t)
+	recordWriter.EXPECT().PutRecords(matcher.Context, []*gosoKinesis.Record{
+		{
+			Data:         []byte("raw"),
+			PartitionKey: mdl.Box("user-1"),
+		},
+		{
+			Data:            []byte(`{"attributes":{"encoding":"application/json"},"body":"{}"}`),
+			ExplicitHashKey: mdl.Box("42"),
+		},
+		{
+			Data: []byte(`{"id":3}`),
+		},
+	}).Return(nil).Once()
+
+	writer := gosoKinesis.NewRecordFixtureWriterWithInterfaces(logger, recordWriter)
+
+	err := writer.Write(t.Context(), []any{
+		&gosoKinesis.RecordFixture{
+			PartitionKey: "user-1",
+			Data:         "raw",
+		},
+		&gosoKinesis.RecordFixture{
+			ExplicitHashKey: "42",
+			Data: map[string]any{
+				"attributes": map[string]string{"encoding": "application/json"},
+				"body":       "{}",
+			},
+		},
+		&gosoKinesis.RecordFixture{
+			Data: struct {
+				Id int `json:"id"`
+			}{Id: 3},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestRecordFixtureWriter_WriteError(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	recordWriter := gosoKinesisMocks.NewRecordWriter(t)
+	recordWriter.EXPECT().PutRecords(matcher.Context, []*gosoKinesis.Record{{Data: []byte("raw")}}).Return(fmt.Errorf("stream not found")).Once()
+
+	writer := gosoKinesis.NewRecordFixtureWriterWithInterfaces(logger, recordWriter)
+
+	err := writer.Write(t.Context(), []any{&gosoKinesis.RecordFixture{Data: []byte("raw")}})
+	assert.EqualError(t, err, "can not put kinesis fixtures: stream not found")
+
+	err = writer.Write(t.Context(), []any{&gosoKinesis.Record{}})
+	assert.EqualError(t, err, "fixture of type *kinesis.Record is not a *kinesis.RecordFixture")
+}
@@ -61,7 +61,7 @@ type Settings struct {
 	MaxBatchSize int `cfg:"max_batch_size" default:"10000" validate:"gt=0,lte=10000"`
 	// Time between reads from empty or fully caught up shards. This defines how fast the kinsumer begins its work. Min = 1ms
 	WaitTime time.Duration `cfg:"wait_time" default:"1s" validate:"min=1000000"`
-	// Time between writing checkpoints to ddb. This defines how much work you might lose. Min = 100ms
+	// Time between writing checkpoints to the metadata store. This defines how much work you might lose. Min = 100ms
 	PersistFrequency time.Duration `cfg:"persist_frequency" default:"5s" validate:"min=100000000"`
 	// How many PersistFrequency cycles do we wait until we no longer assume a client is owning a checkpoint?
 	CheckpointTimeoutPeriods int `cfg:"checkpoint_timeout_periods" default:"5" validate:"min=2"`
@@ -75,6 +75,8 @@ type Settings struct {
 	KeepShardOrder bool `cfg:"keep_shard_order" default:"true"`
 	// Healthcheck configures when we turn unhealthy and are killed
 	Healthcheck health.HealthCheckSettings `cfg:"healthcheck"`
+	// MetadataStore configures where client registrations and checkpoints are kept
+	MetadataStore MetadataStoreSettings `cfg:"metadata_store"`
 }
 
 func (s Settings) GetIdentity() cfg.Identity {
@@ -9,14 +9,13 @@ import (
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/conc"
-	"github.com/justtrackio/gosoline/pkg/ddb"
 	"github.com/justtrackio/gosoline/pkg/exec"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/mdl"
 )
 
-// ShardTimeout describes the timeout until we remove records about shards from ddb.
-// kinesis has a maximum retention time of 7 days, so we are safe to remove old data from ddb after 8 days (or 7 days and
+// ShardTimeout describes the timeout until we remove records about shards from the metadata store.
+// kinesis has a maximum retention time of 7 days, so we are safe to remove old data after 8 days (or 7 days and
 // 1 second...). We need this to clean up the database if there are old shards for a stream (i.e., after a stream was scaled
 // and the number of shards was reduced or a shard was replaced by different shards).
 const ShardTimeout = time.Hour * 24 * 8
@@ -101,7 +100,7 @@ type metadataRepository struct {
 	namespace         string
 	stream            Stream
 	clientId          ClientId
-	repo              ddb.Repository
+	store             MetadataStore
 	clientTimeout     time.Duration
 	checkpointTimeout time.Duration
 	releaseDelay      time.Duration
@@ -115,31 +114,12 @@ type metadataRepository struct {
 
 func NewMetadataRepository(ctx context.Context, config cfg.Config, logger log.Logger, stream Stream, clientId ClientId, settings Settings) (MetadataRepository, error) {
 	var err error
-	var repo ddb.Repository
-	var tableName string
+	var store MetadataStore
 	var namingSettings *KinsumerNamingSettings
 	var namespace string
 
-	if tableName, err = GetMetadataTableName(config, settings); err != nil {
-		return nil, fmt.Errorf("can not get metadata table name: %w", err)
-	}
-
-	ddbSettings := &ddb.Settings{
-		ClientName: settings.ClientName,
-		ModelId: mdl.ModelId{
-			Name: "metadata",
-		},
-		Main: ddb.MainSettings{
-			Model: &FullRecord{},
-		},
-		TableNamingSettings: ddb.TableNamingSettings{
-			TablePattern: tableName,
-		},
-		DisableTracing: true,
-	}
-
-	if repo, err = ddb.NewRepository(ctx, config, logger, ddbSettings); err != nil {
-		return nil, fmt.Errorf("can not create ddb repository: %w", err)
+	if store, err = NewMetadataStore(ctx, config, logger, settings); err != nil {
+		return nil, fmt.Errorf("can not create metadata store: %w", err)
 	}
 
 	// we need the app identity from the application we are running at, not the app id from the settings as this is the same
@@ -157,14 +137,14 @@ func NewMetadataRepository(ctx context.Context, config cfg.Config, logger log.Lo
 		return nil, fmt.Errorf("failed to format kinesis metadata namespace: %w", err)
 	}
 
-	return NewMetadataRepositoryWithInterfaces(logger, stream, clientId, repo, settings, namespace, clock.Provider), nil
+	return NewMetadataRepositoryWithInterfaces(logger, stream, clientId, store, settings, namespace, clock.Provider), nil
 }
 
 func NewMetadataRepositoryWithInterfaces(
 	logger log.Logger,
 	stream Stream,
 	clientId ClientId,
-	repo ddb.Repository,
+	store MetadataStore,
 	settings Settings,
 	namespace string,
 	clock clock.Clock,
@@ -184,7 +164,7 @@ func NewMetadataRepositoryWithInterfaces(
 		namespace:         namespace,
 		stream:            stream,
 		clientId:          clientId,
-		repo:              repo,
+		store:             store,
 		clientTimeout:     clientTimeout,
 		checkpointTimeout: checkpointTimeout,
 		releaseDelay:      settings.ReleaseDelay,
@@ -197,7 +177,7 @@ func NewMetadataRepositoryWithInterfaces(
 func (m *metadataRepository) RegisterClient(ctx context.Context) (clientIndex int, totalClients int, err error) {
 	namespace := m.getClientNamespace()
 
-	_, err = m.repo.PutItem(ctx, m.repo.PutItemBuilder(), &ClientRecord{
+	err = m.store.PutClientRecord(ctx, &ClientRecord{
 		BaseRecord: BaseRecord{
 			Namespace: namespace,
 			Resource:  string(m.clientId),
@@ -209,29 +189,25 @@ func (m *metadataRepository) RegisterClient(ctx context.Context) (clientIndex in
 		return 0, 0, fmt.Errorf("failed to register client: %w", err)
 	}
 
-	qb := m.repo.QueryBuilder().WithHash(namespace).WithConsistentRead(true)
-	clients := make([]ClientRecord, 0)
-	if _, err = m.repo.Query(ctx, qb, &clients); err != nil {
+	var clients []ClientRecord
+	if clients, err = m.store.ListClientRecords(ctx, namespace); err != nil {
 		return 0, 0, fmt.Errorf("failed to list clients: %w", err)
 	}
 
-	// find the index for the client when sorted by id (ddb returns items sorted by range by default)
+	// find the index for the client when sorted by id
 	for i, client := range clients {
 		if ClientId(client.Resource) == m.clientId {
 			return i, len(clients), nil
 		}
 	}
 
-	return 0, 0, fmt.Errorf("failed to find client just written to ddb")
+	return 0, 0, fmt.Errorf("failed to find client just written to the metadata store")
 }
 
 func (m *metadataRepository) DeregisterClient(ctx context.Context) error {
 	namespace := m.getClientNamespace()
 
-	qb := m.repo.DeleteItemBuilder().
-		WithHash(namespace).
-		WithRange(m.clientId)
-	if _, err := m.repo.DeleteItem(ctx, qb, &ClientRecord{}); err != nil {
+	if err := m.store.DeleteClientRecord(ctx, namespace, m.clientId); err != nil {
 		return fmt.Errorf("failed to deregister client: %w", err)
 	}
 
@@ -247,17 +223,13 @@ func (m *metadataRepository) IsShardFinished(ctx context.Context, shardId ShardI
 	}
 
 	namespace := m.getCheckpointNamespace()
-	getQb := m.repo.GetItemBuilder().
-		WithHash(namespace).
-		WithRange(shardId)
 
-	record := &CheckpointRecord{}
-	getResult, err := m.repo.GetItem(ctx, getQb, record)
+	record, err := m.store.GetCheckpointRecord(ctx, namespace, shardId, false)
 	if err != nil {
 		return false, fmt.Errorf("failed to check if shard is finished: %w", err)
 	}
 
-	if getResult.IsFound && record.FinishedAt != nil {
+	if record != nil && record.FinishedAt != nil {
 		m.finishedMap[shardId] = true
 
 		return true, nil
@@ -273,17 +245,12 @@ func (m *metadataRepository) AcquireShard(ctx context.Context, shardId ShardId)
 	timedOutBefore := m.clock.Now().Add(-m.checkpointTimeout)
 	namespace := m.getCheckpointNamespace()
 
-	getQb := m.repo.GetItemBuilder().
-		WithHash(namespace).
-		WithRange(shardId).
-		WithConsistentRead(true)
-	record := &CheckpointRecord{}
-	getResult, err := m.repo.GetItem(ctx, getQb, record)
+	record, err := m.store.GetCheckpointRecord(ctx, namespace, shardId, true)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read checkpoint record: %w", err)
 	}
 
-	if !getResult.IsFound {
+	if record == nil {
 		m.logger.Info(ctx, "trying to use unused shard %s", shardId)
 
 		record = &CheckpointRecord{
@@ -314,12 +281,9 @@ func (m *metadataRepository) AcquireShard(ctx context.Context, shardId ShardId)
 	delayedCtx, stop := exec.WithDelayedCancelContext(ctx, m.releaseDelay)
 	defer stop()
 
-	putQb := m.repo.PutItemBuilder().
-		WithCondition(ddb.AttributeNotExists("owningClientId").Or(ddb.Lte("updatedAt", timedOutBefore)))
-
-	if putResult, err := m.repo.PutItem(delayedCtx, putQb, record); err != nil {
+	if acquired, err := m.store.AcquireCheckpointRecord(delayedCtx, record, timedOutBefore); err != nil {
 		return nil, fmt.Errorf("failed to write checkpoint record: %w", err)
-	} else if putResult.ConditionalCheckFailed {
+	} else if !acquired {
 		m.logger.Info(delayedCtx, "failed to acquire shard %s", shardId)
 
 		return nil, nil
@@ -333,10 +297,9 @@ func (m *metadataRepository) AcquireShard(ctx context.Context, shardId ShardId)
 		record.UpdatedAt = m.clock.Now()
 		record.Ttl = mdl.Box(m.clock.Now().Add(ShardTimeout).Unix())
 
-		putQb := m.repo.PutItemBuilder().WithCondition(ddb.Eq("owningClientId", m.clientId))
-		if putResult, err := m.repo.PutItem(delayedCtx, putQb, record); err != nil {
+		if owned, err := m.store.PutCheckpointRecord(delayedCtx, record, m.clientId); err != nil {
 			return nil, fmt.Errorf("failed to release checkpoint record: %w", err)
-		} else if putResult.ConditionalCheckFailed {
+		} else if !owned {
 			m.logger.Info(delayedCtx, "failed to release shard %s", shardId)
 
 			return nil, nil
@@ -353,7 +316,7 @@ func (m *metadataRepository) AcquireShard(ctx context.Context, shardId ShardId)
 	}
 
 	return &checkpoint{
-		repo:                m.repo,
+		store:               m.store,
 		clock:               m.clock,
 		lck:                 conc.NewPoisonedLock(),
 		namespace:           namespace,
@@ -64,7 +64,7 @@ func (s *metadataRepositoryTestSuite) SetupTest() {
 		},
 	}
 	s.clock = clock.NewFakeClock()
-	s.metadataRepository = kinesis.NewMetadataRepositoryWithInterfaces(s.logger, s.stream, s.clientId, s.repo, s.settings, "gosoline-test-metadata-repository", s.clock)
+	s.metadataRepository = kinesis.NewMetadataRepositoryWithInterfaces(s.logger, s.stream, s.clientId, kinesis.NewDdbMetadataStoreWithInterfaces(s.repo), s.settings, "gosoline-test-metadata-repository", s.clock)
 }
 
 func (s *metadataRepositoryTestSuite) TestRegisterClient_PutItemError() {
@@ -93,7 +93,7 @@ func (s *metadataRepositoryTestSuite) TestRegisterClient_QueryInconsistent() {
 	clientIndex, totalClients, err := s.metadataRepository.RegisterClient(s.ctx)
 	s.Zero(clientIndex)
 	s.Zero(totalClients)
-	s.EqualError(err, "failed to find client just written to ddb")
+	s.EqualError(err, "failed to find client just written to the metadata store")
 }
 
 func (s *metadataRepositoryTestSuite) TestRegisterClient_Success() {
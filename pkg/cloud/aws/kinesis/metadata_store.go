@@ -0,0 +1,82 @@
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	MetadataStoreTypeDdb      = "ddb"
+	MetadataStoreTypeInMemory = "inMemory"
+	MetadataStoreTypePostgres = "postgres"
+	MetadataStoreTypeRedis    = "redis"
+)
+
+type MetadataStoreSettings struct {
+	// Type of the store keeping client registrations and checkpoints: ddb, redis, postgres, inMemory or a type added with AddMetadataStoreFactory
+	Type string `cfg:"type" default:"ddb"`
+	// Name of the redis or db client to use. The ddb store uses the client_name of the kinsumer.
+	ClientName string `cfg:"client_name" default:"default"`
+	// Name of the table used by the postgres store
+	TableName string `cfg:"table_name" default:"kinesis_metadata"`
+}
+
+// A MetadataStore persists the client registrations and shard checkpoints of the kinsumers of a stream. All writes to
+// a checkpoint are conditional to ensure a shard is owned by at most one client at a time.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name MetadataStore
+type MetadataStore interface {
+	// PutClientRecord creates or refreshes the registration of a client.
+	PutClientRecord(ctx context.Context, record *ClientRecord) error
+	// ListClientRecords returns the registered clients of a namespace sorted by their id.
+	ListClientRecords(ctx context.Context, namespace string) ([]ClientRecord, error)
+	DeleteClientRecord(ctx context.Context, namespace string, clientId ClientId) error
+	// GetCheckpointRecord returns the checkpoint of a shard or nil if the shard was never consumed.
+	GetCheckpointRecord(ctx context.Context, namespace string, shardId ShardId, consistentRead bool) (*CheckpointRecord, error)
+	// AcquireCheckpointRecord writes the record if the shard is not owned by any client or if its owner did not update
+	// it after timedOutBefore. It returns false if the shard is owned by another client.
+	AcquireCheckpointRecord(ctx context.Context, record *CheckpointRecord, timedOutBefore time.Time) (acquired bool, err error)
+	// PutCheckpointRecord writes the record if the shard is still owned by the given client. It returns false otherwise.
+	PutCheckpointRecord(ctx context.Context, record *CheckpointRecord, owner ClientId) (owned bool, err error)
+	// ReleaseCheckpointRecord removes the owner of the shard and stores the position of the record if the shard is
+	// still owned by the given client. The finished state of the stored checkpoint is kept. It returns false if the
+	// shard was not owned by the client.
+	ReleaseCheckpointRecord(ctx context.Context, record *CheckpointRecord, owner ClientId) (owned bool, err error)
+}
+
+type MetadataStoreFactory func(ctx context.Context, config cfg.Config, logger log.Logger, settings Settings) (MetadataStore, error)
+
+var metadataStoreFactories = map[string]MetadataStoreFactory{
+	MetadataStoreTypeDdb:      NewDdbMetadataStore,
+	MetadataStoreTypeInMemory: ProvideInMemoryMetadataStore,
+	MetadataStoreTypePostgres: NewPostgresMetadataStore,
+	MetadataStoreTypeRedis:    NewRedisMetadataStore,
+}
+
+// AddMetadataStoreFactory registers an additional backend which can be selected with the metadata_store.type setting.
+func AddMetadataStoreFactory(typ string, factory MetadataStoreFactory) {
+	metadataStoreFactories[typ] = factory
+}
+
+func NewMetadataStore(ctx context.Context, config cfg.Config, logger log.Logger, settings Settings) (MetadataStore, error) {
+	typ := settings.MetadataStore.Type
+	if typ == "" {
+		typ = MetadataStoreTypeDdb
+	}
+
+	factory, ok := metadataStoreFactories[typ]
+	if !ok {
+		return nil, fmt.Errorf("there is no kinesis metadata store of type %s", typ)
+	}
+
+	store, err := factory(ctx, config, logger, settings)
+	if err != nil {
+		return nil, fmt.Errorf("can not create kinesis metadata store of type %s: %w", typ, err)
+	}
+
+	return store, nil
+}
@@ -0,0 +1,145 @@
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+)
+
+type ddbMetadataStore struct {
+	repo ddb.Repository
+}
+
+// NewDdbMetadataStore keeps the metadata in a ddb table, named by the metadata naming settings of the kinsumer.
+func NewDdbMetadataStore(ctx context.Context, config cfg.Config, logger log.Logger, settings Settings) (MetadataStore, error) {
+	var err error
+	var repo ddb.Repository
+	var tableName string
+
+	if tableName, err = GetMetadataTableName(config, settings); err != nil {
+		return nil, fmt.Errorf("can not get metadata table name: %w", err)
+	}
+
+	ddbSettings := &ddb.Settings{
+		ClientName: settings.ClientName,
+		ModelId: mdl.ModelId{
+			Name: "metadata",
+		},
+		Main: ddb.MainSettings{
+			Model: &FullRecord{},
+		},
+		TableNamingSettings: ddb.TableNamingSettings{
+			TablePattern: tableName,
+		},
+		DisableTracing: true,
+	}
+
+	if repo, err = ddb.NewRepository(ctx, config, logger, ddbSettings); err != nil {
+		return nil, fmt.Errorf("can not create ddb repository: %w", err)
+	}
+
+	return NewDdbMetadataStoreWithInterfaces(repo), nil
+}
+
+func NewDdbMetadataStoreWithInterfaces(repo ddb.Repository) MetadataStore {
+	return &ddbMetadataStore{
+		repo: repo,
+	}
+}
+
+func (s *ddbMetadataStore) PutClientRecord(ctx context.Context, record *ClientRecord) error {
+	_, err := s.repo.PutItem(ctx, s.repo.PutItemBuilder(), record)
+
+	return err
+}
+
+func (s *ddbMetadataStore) ListClientRecords(ctx context.Context, namespace string) ([]ClientRecord, error) {
+	qb := s.repo.QueryBuilder().WithHash(namespace).WithConsistentRead(true)
+	clients := make([]ClientRecord, 0)
+
+	// ddb returns items sorted by range by default
+	if _, err := s.repo.Query(ctx, qb, &clients); err != nil {
+		return nil, err
+	}
+
+	return clients, nil
+}
+
+func (s *ddbMetadataStore) DeleteClientRecord(ctx context.Context, namespace string, clientId ClientId) error {
+	qb := s.repo.DeleteItemBuilder().
+		WithHash(namespace).
+		WithRange(clientId)
+
+	_, err := s.repo.DeleteItem(ctx, qb, &ClientRecord{})
+
+	return err
+}
+
+func (s *ddbMetadataStore) GetCheckpointRecord(ctx context.Context, namespace string, shardId ShardId, consistentRead bool) (*CheckpointRecord, error) {
+	qb := s.repo.GetItemBuilder().
+		WithHash(namespace).
+		WithRange(shardId)
+
+	if consistentRead {
+		qb = qb.WithConsistentRead(true)
+	}
+
+	record := &CheckpointRecord{}
+	result, err := s.repo.GetItem(ctx, qb, record)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.IsFound {
+		return nil, nil
+	}
+
+	return record, nil
+}
+
+func (s *ddbMetadataStore) AcquireCheckpointRecord(ctx context.Context, record *CheckpointRecord, timedOutBefore time.Time) (bool, error) {
+	qb := s.repo.PutItemBuilder().
+		WithCondition(ddb.AttributeNotExists("owningClientId").Or(ddb.Lte("updatedAt", timedOutBefore)))
+
+	result, err := s.repo.PutItem(ctx, qb, record)
+	if err != nil {
+		return false, err
+	}
+
+	return !result.ConditionalCheckFailed, nil
+}
+
+func (s *ddbMetadataStore) PutCheckpointRecord(ctx context.Context, record *CheckpointRecord, owner ClientId) (bool, error) {
+	qb := s.repo.PutItemBuilder().WithCondition(ddb.Eq("owningClientId", owner))
+
+	result, err := s.repo.PutItem(ctx, qb, record)
+	if err != nil {
+		return false, err
+	}
+
+	return !result.ConditionalCheckFailed, nil
+}
+
+func (s *ddbMetadataStore) ReleaseCheckpointRecord(ctx context.Context, record *CheckpointRecord, owner ClientId) (bool, error) {
+	qb := s.repo.UpdateItemBuilder().
+		WithHash(record.Namespace).
+		WithRange(ShardId(record.Resource)).
+		Remove("owningClientId").
+		Set("updatedAt", record.UpdatedAt).
+		Set("ttl", record.Ttl).
+		Set("sequenceNumber", record.SequenceNumber).
+		Set("lastShardIterator", record.LastShardIterator).
+		WithCondition(ddb.Eq("owningClientId", owner))
+
+	result, err := s.repo.UpdateItem(ctx, qb, &CheckpointRecord{})
+	if err != nil {
+		return false, err
+	}
+
+	return !result.ConditionalCheckFailed, nil
+}
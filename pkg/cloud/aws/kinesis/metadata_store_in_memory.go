@@ -0,0 +1,158 @@
+package kinesis
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type inMemoryMetadataStoreAppCtxKey int
+
+type inMemoryMetadataStore struct {
+	clock       clock.Clock
+	lck         sync.Mutex
+	clients     map[string]map[string]ClientRecord
+	checkpoints map[string]map[ShardId]CheckpointRecord
+}
+
+// ProvideInMemoryMetadataStore returns a store shared by all kinsumers of the application. As the metadata is lost
+// on shutdown and not visible to other instances, it is only suited for tests and single instance applications.
+func ProvideInMemoryMetadataStore(ctx context.Context, _ cfg.Config, _ log.Logger, _ Settings) (MetadataStore, error) {
+	return appctx.Provide(ctx, inMemoryMetadataStoreAppCtxKey(0), func() (MetadataStore, error) {
+		return NewInMemoryMetadataStore(clock.Provider), nil
+	})
+}
+
+func NewInMemoryMetadataStore(clock clock.Clock) MetadataStore {
+	return &inMemoryMetadataStore{
+		clock:       clock,
+		clients:     map[string]map[string]ClientRecord{},
+		checkpoints: map[string]map[ShardId]CheckpointRecord{},
+	}
+}
+
+func (s *inMemoryMetadataStore) PutClientRecord(_ context.Context, record *ClientRecord) error {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	if _, ok := s.clients[record.Namespace]; !ok {
+		s.clients[record.Namespace] = map[string]ClientRecord{}
+	}
+
+	s.clients[record.Namespace][record.Resource] = *record
+
+	return nil
+}
+
+func (s *inMemoryMetadataStore) ListClientRecords(_ context.Context, namespace string) ([]ClientRecord, error) {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	now := s.clock.Now().Unix()
+	clients := make([]ClientRecord, 0, len(s.clients[namespace]))
+
+	for id, client := range s.clients[namespace] {
+		if client.Ttl != nil && *client.Ttl < now {
+			delete(s.clients[namespace], id)
+
+			continue
+		}
+
+		clients = append(clients, client)
+	}
+
+	slices.SortFunc(clients, func(a, b ClientRecord) int {
+		return strings.Compare(a.Resource, b.Resource)
+	})
+
+	return clients, nil
+}
+
+func (s *inMemoryMetadataStore) DeleteClientRecord(_ context.Context, namespace string, clientId ClientId) error {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	delete(s.clients[namespace], string(clientId))
+
+	return nil
+}
+
+func (s *inMemoryMetadataStore) GetCheckpointRecord(_ context.Context, namespace string, shardId ShardId, _ bool) (*CheckpointRecord, error) {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	record, ok := s.checkpoints[namespace][shardId]
+	if !ok {
+		return nil, nil
+	}
+
+	return &record, nil
+}
+
+func (s *inMemoryMetadataStore) AcquireCheckpointRecord(_ context.Context, record *CheckpointRecord, timedOutBefore time.Time) (bool, error) {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	existing, ok := s.getCheckpoint(record)
+	if ok && existing.OwningClientId != "" && existing.UpdatedAt.After(timedOutBefore) {
+		return false, nil
+	}
+
+	s.putCheckpoint(*record)
+
+	return true, nil
+}
+
+func (s *inMemoryMetadataStore) PutCheckpointRecord(_ context.Context, record *CheckpointRecord, owner ClientId) (bool, error) {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	existing, ok := s.getCheckpoint(record)
+	if !ok || existing.OwningClientId != owner {
+		return false, nil
+	}
+
+	s.putCheckpoint(*record)
+
+	return true, nil
+}
+
+func (s *inMemoryMetadataStore) ReleaseCheckpointRecord(_ context.Context, record *CheckpointRecord, owner ClientId) (bool, error) {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	existing, ok := s.getCheckpoint(record)
+	if !ok || existing.OwningClientId != owner {
+		return false, nil
+	}
+
+	existing.OwningClientId = ""
+	existing.UpdatedAt = record.UpdatedAt
+	existing.Ttl = record.Ttl
+	existing.SequenceNumber = record.SequenceNumber
+	existing.LastShardIterator = record.LastShardIterator
+	s.putCheckpoint(existing)
+
+	return true, nil
+}
+
+func (s *inMemoryMetadataStore) getCheckpoint(record *CheckpointRecord) (CheckpointRecord, bool) {
+	existing, ok := s.checkpoints[record.Namespace][ShardId(record.Resource)]
+
+	return existing, ok
+}
+
+func (s *inMemoryMetadataStore) putCheckpoint(record CheckpointRecord) {
+	if _, ok := s.checkpoints[record.Namespace]; !ok {
+		s.checkpoints[record.Namespace] = map[ShardId]CheckpointRecord{}
+	}
+
+	s.checkpoints[record.Namespace][ShardId(record.Resource)] = record
+}
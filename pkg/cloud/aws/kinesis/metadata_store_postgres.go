@@ -0,0 +1,236 @@
+package kinesis
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/reslife"
+)
+
+const postgresMetadataTableSchema = `CREATE TABLE IF NOT EXISTS %s (
+	namespace VARCHAR(255) NOT NULL,
+	resource VARCHAR(255) NOT NULL,
+	owning_client_id VARCHAR(255) NOT NULL DEFAULT '',
+	sequence_number VARCHAR(255) NOT NULL DEFAULT '',
+	last_shard_iterator TEXT NOT NULL DEFAULT '',
+	finished_at TIMESTAMPTZ NULL,
+	updated_at TIMESTAMPTZ NOT NULL,
+	ttl BIGINT NOT NULL,
+	PRIMARY KEY (namespace, resource)
+)`
+
+type postgresMetadataRow struct {
+	Namespace         string     `db:"namespace"`
+	Resource          string     `db:"resource"`
+	OwningClientId    string     `db:"owning_client_id"`
+	SequenceNumber    string     `db:"sequence_number"`
+	LastShardIterator string     `db:"last_shard_iterator"`
+	FinishedAt        *time.Time `db:"finished_at"`
+	UpdatedAt         time.Time  `db:"updated_at"`
+	Ttl               int64      `db:"ttl"`
+}
+
+type postgresMetadataStore struct {
+	client    db.Client
+	clock     clock.Clock
+	tableName string
+}
+
+// NewPostgresMetadataStore keeps the metadata in a table of the db client configured by the metadata_store.client_name
+// setting. The client has to use the postgres driver; the table is created on startup if it doesn't exist yet.
+func NewPostgresMetadataStore(ctx context.Context, config cfg.Config, logger log.Logger, settings Settings) (MetadataStore, error) {
+	client, err := db.ProvideClient(ctx, config, logger, settings.MetadataStore.ClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create db client %s: %w", settings.MetadataStore.ClientName, err)
+	}
+
+	store := NewPostgresMetadataStoreWithInterfaces(client, clock.Provider, settings.MetadataStore.TableName)
+
+	if err = reslife.AddLifeCycleer(ctx, newPostgresMetadataStoreLifecycleManager(client, settings.MetadataStore.TableName)); err != nil {
+		return nil, fmt.Errorf("can not add lifecycle manager: %w", err)
+	}
+
+	return store, nil
+}
+
+func NewPostgresMetadataStoreWithInterfaces(client db.Client, clock clock.Clock, tableName string) MetadataStore {
+	return &postgresMetadataStore{
+		client:    client,
+		clock:     clock,
+		tableName: tableName,
+	}
+}
+
+func (s *postgresMetadataStore) PutClientRecord(ctx context.Context, record *ClientRecord) error {
+	query := fmt.Sprintf(`INSERT INTO %s (namespace, resource, updated_at, ttl) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (namespace, resource) DO UPDATE SET updated_at = EXCLUDED.updated_at, ttl = EXCLUDED.ttl`, s.tableName)
+
+	_, err := s.client.Exec(ctx, query, record.Namespace, record.Resource, record.UpdatedAt, mdl.EmptyIfNil(record.Ttl))
+
+	return err
+}
+
+func (s *postgresMetadataStore) ListClientRecords(ctx context.Context, namespace string) ([]ClientRecord, error) {
+	now := s.clock.Now().Unix()
+
+	if _, err := s.client.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE namespace = $1 AND ttl < $2`, s.tableName), namespace, now); err != nil {
+		return nil, err
+	}
+
+	rows := make([]postgresMetadataRow, 0)
+	query := fmt.Sprintf(`SELECT * FROM %s WHERE namespace = $1 ORDER BY resource`, s.tableName)
+
+	if err := s.client.Select(ctx, &rows, query, namespace); err != nil {
+		return nil, err
+	}
+
+	clients := make([]ClientRecord, len(rows))
+	for i, row := range rows {
+		clients[i] = ClientRecord{
+			BaseRecord: BaseRecord{
+				Namespace: row.Namespace,
+				Resource:  row.Resource,
+				UpdatedAt: row.UpdatedAt,
+				Ttl:       mdl.Box(row.Ttl),
+			},
+		}
+	}
+
+	return clients, nil
+}
+
+func (s *postgresMetadataStore) DeleteClientRecord(ctx context.Context, namespace string, clientId ClientId) error {
+	query := fmt.Sprintf(`DELETE FROM %s WHERE namespace = $1 AND resource = $2`, s.tableName)
+	_, err := s.client.Exec(ctx, query, namespace, string(clientId))
+
+	return err
+}
+
+func (s *postgresMetadataStore) GetCheckpointRecord(ctx context.Context, namespace string, shardId ShardId, _ bool) (*CheckpointRecord, error) {
+	row := &postgresMetadataRow{}
+	query := fmt.Sprintf(`SELECT * FROM %s WHERE namespace = $1 AND resource = $2`, s.tableName)
+
+	if err := s.client.Get(ctx, row, query, namespace, string(shardId)); errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &CheckpointRecord{
+		BaseRecord: BaseRecord{
+			Namespace: row.Namespace,
+			Resource:  row.Resource,
+			UpdatedAt: row.UpdatedAt,
+			Ttl:       mdl.Box(row.Ttl),
+		},
+		OwningClientId:    ClientId(row.OwningClientId),
+		SequenceNumber:    SequenceNumber(row.SequenceNumber),
+		LastShardIterator: ShardIterator(row.LastShardIterator),
+		FinishedAt:        row.FinishedAt,
+	}, nil
+}
+
+func (s *postgresMetadataStore) AcquireCheckpointRecord(ctx context.Context, record *CheckpointRecord, timedOutBefore time.Time) (bool, error) {
+	query := fmt.Sprintf(`INSERT INTO %[1]s (namespace, resource, owning_client_id, sequence_number, last_shard_iterator, finished_at, updated_at, ttl)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (namespace, resource) DO UPDATE SET
+			owning_client_id = EXCLUDED.owning_client_id,
+			sequence_number = EXCLUDED.sequence_number,
+			last_shard_iterator = EXCLUDED.last_shard_iterator,
+			finished_at = EXCLUDED.finished_at,
+			updated_at = EXCLUDED.updated_at,
+			ttl = EXCLUDED.ttl
+		WHERE %[1]s.owning_client_id = '' OR %[1]s.updated_at <= $9`, s.tableName)
+
+	return s.execAffectingOne(ctx, query,
+		record.Namespace,
+		record.Resource,
+		string(record.OwningClientId),
+		string(record.SequenceNumber),
+		string(record.LastShardIterator),
+		record.FinishedAt,
+		record.UpdatedAt,
+		mdl.EmptyIfNil(record.Ttl),
+		timedOutBefore,
+	)
+}
+
+func (s *postgresMetadataStore) PutCheckpointRecord(ctx context.Context, record *CheckpointRecord, owner ClientId) (bool, error) {
+	query := fmt.Sprintf(`UPDATE %s SET owning_client_id = $1, sequence_number = $2, last_shard_iterator = $3, finished_at = $4, updated_at = $5, ttl = $6
+		WHERE namespace = $7 AND resource = $8 AND owning_client_id = $9`, s.tableName)
+
+	return s.execAffectingOne(ctx, query,
+		string(record.OwningClientId),
+		string(record.SequenceNumber),
+		string(record.LastShardIterator),
+		record.FinishedAt,
+		record.UpdatedAt,
+		mdl.EmptyIfNil(record.Ttl),
+		record.Namespace,
+		record.Resource,
+		string(owner),
+	)
+}
+
+func (s *postgresMetadataStore) ReleaseCheckpointRecord(ctx context.Context, record *CheckpointRecord, owner ClientId) (bool, error) {
+	query := fmt.Sprintf(`UPDATE %s SET owning_client_id = '', sequence_number = $1, last_shard_iterator = $2, updated_at = $3, ttl = $4
+		WHERE namespace = $5 AND resource = $6 AND owning_client_id = $7`, s.tableName)
+
+	return s.execAffectingOne(ctx, query,
+		string(record.SequenceNumber),
+		string(record.LastShardIterator),
+		record.UpdatedAt,
+		mdl.EmptyIfNil(record.Ttl),
+		record.Namespace,
+		record.Resource,
+		string(owner),
+	)
+}
+
+func (s *postgresMetadataStore) execAffectingOne(ctx context.Context, query string, args ...any) (bool, error) {
+	result, err := s.client.Exec(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("can not get affected rows: %w", err)
+	}
+
+	return affected == 1, nil
+}
+
+type postgresMetadataStoreLifecycleManager struct {
+	client    db.Client
+	tableName string
+}
+
+func newPostgresMetadataStoreLifecycleManager(client db.Client, tableName string) reslife.LifeCycleerFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (reslife.LifeCycleer, error) {
+		return &postgresMetadataStoreLifecycleManager{
+			client:    client,
+			tableName: tableName,
+		}, nil
+	}
+}
+
+func (l *postgresMetadataStoreLifecycleManager) GetId() string {
+	return fmt.Sprintf("kinesis/metadata/postgres/%s", l.tableName)
+}
+
+func (l *postgresMetadataStoreLifecycleManager) Create(ctx context.Context) error {
+	if _, err := l.client.Exec(ctx, fmt.Sprintf(postgresMetadataTableSchema, l.tableName)); err != nil {
+		return fmt.Errorf("can not create kinesis metadata table %s: %w", l.tableName, err)
+	}
+
+	return nil
+}
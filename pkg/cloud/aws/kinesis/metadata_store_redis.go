@@ -0,0 +1,224 @@
+package kinesis
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	"github.com/spf13/cast"
+)
+
+const redisMetadataKeyPrefix = "kinesis-metadata"
+
+// every checkpoint is a hash with the fields owningClientId, updatedAt (in unix nanoseconds), sequenceNumber,
+// lastShardIterator and finishedAt. The scripts below check the owner and write the remaining arguments as fields.
+const (
+	redisScriptAcquireCheckpoint = `
+local owner = redis.call('HGET', KEYS[1], 'owningClientId')
+if owner and owner ~= '' and tonumber(redis.call('HGET', KEYS[1], 'updatedAt')) > tonumber(ARGV[1]) then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV, 3))
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 1`
+	redisScriptPutCheckpoint = `
+if redis.call('HGET', KEYS[1], 'owningClientId') ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV, 3))
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 1`
+)
+
+type redisMetadataStore struct {
+	client redis.Client
+	clock  clock.Clock
+}
+
+// NewRedisMetadataStore keeps the metadata in the redis configured by the metadata_store.client_name setting.
+func NewRedisMetadataStore(ctx context.Context, config cfg.Config, logger log.Logger, settings Settings) (MetadataStore, error) {
+	client, err := redis.ProvideClient(ctx, config, logger, settings.MetadataStore.ClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create redis client %s: %w", settings.MetadataStore.ClientName, err)
+	}
+
+	return NewRedisMetadataStoreWithInterfaces(client, clock.Provider), nil
+}
+
+func NewRedisMetadataStoreWithInterfaces(client redis.Client, clock clock.Clock) MetadataStore {
+	return &redisMetadataStore{
+		client: client,
+		clock:  clock,
+	}
+}
+
+func (s *redisMetadataStore) PutClientRecord(ctx context.Context, record *ClientRecord) error {
+	key := s.key(record.Namespace)
+	ttl := mdl.EmptyIfNil(record.Ttl)
+
+	if err := s.client.HSet(ctx, key, record.Resource, ttl); err != nil {
+		return err
+	}
+
+	// the key expires once the last client expired, expired clients are removed when listing the clients
+	if expiration := s.expiration(ttl); expiration > 0 {
+		if _, err := s.client.Expire(ctx, key, expiration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *redisMetadataStore) ListClientRecords(ctx context.Context, namespace string) ([]ClientRecord, error) {
+	key := s.key(namespace)
+
+	entries, err := s.client.HGetAll(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now().Unix()
+	clients := make([]ClientRecord, 0, len(entries))
+
+	for _, clientId := range slices.Sorted(maps.Keys(entries)) {
+		ttl, err := strconv.ParseInt(entries[clientId], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("can not parse ttl of client %s: %w", clientId, err)
+		}
+
+		if ttl < now {
+			if _, err := s.client.HDel(ctx, key, clientId); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		clients = append(clients, ClientRecord{
+			BaseRecord: BaseRecord{
+				Namespace: namespace,
+				Resource:  clientId,
+				Ttl:       mdl.Box(ttl),
+			},
+		})
+	}
+
+	return clients, nil
+}
+
+func (s *redisMetadataStore) DeleteClientRecord(ctx context.Context, namespace string, clientId ClientId) error {
+	_, err := s.client.HDel(ctx, s.key(namespace), string(clientId))
+
+	return err
+}
+
+func (s *redisMetadataStore) GetCheckpointRecord(ctx context.Context, namespace string, shardId ShardId, _ bool) (*CheckpointRecord, error) {
+	fields, err := s.client.HGetAll(ctx, s.key(namespace, string(shardId)))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	record := &CheckpointRecord{
+		BaseRecord: BaseRecord{
+			Namespace: namespace,
+			Resource:  string(shardId),
+			UpdatedAt: time.Unix(0, cast.ToInt64(fields["updatedAt"])),
+			Ttl:       mdl.Box(cast.ToInt64(fields["ttl"])),
+		},
+		OwningClientId:    ClientId(fields["owningClientId"]),
+		SequenceNumber:    SequenceNumber(fields["sequenceNumber"]),
+		LastShardIterator: ShardIterator(fields["lastShardIterator"]),
+	}
+
+	if finishedAt := fields["finishedAt"]; finishedAt != "" {
+		record.FinishedAt = mdl.Box(time.Unix(0, cast.ToInt64(finishedAt)))
+	}
+
+	return record, nil
+}
+
+func (s *redisMetadataStore) AcquireCheckpointRecord(ctx context.Context, record *CheckpointRecord, timedOutBefore time.Time) (bool, error) {
+	fields := s.checkpointFields(record, true)
+
+	return s.evalCheckpointScript(ctx, redisScriptAcquireCheckpoint, record, timedOutBefore.UnixNano(), fields)
+}
+
+func (s *redisMetadataStore) PutCheckpointRecord(ctx context.Context, record *CheckpointRecord, owner ClientId) (bool, error) {
+	fields := s.checkpointFields(record, true)
+
+	return s.evalCheckpointScript(ctx, redisScriptPutCheckpoint, record, string(owner), fields)
+}
+
+func (s *redisMetadataStore) ReleaseCheckpointRecord(ctx context.Context, record *CheckpointRecord, owner ClientId) (bool, error) {
+	fields := s.checkpointFields(&CheckpointRecord{
+		BaseRecord:        record.BaseRecord,
+		SequenceNumber:    record.SequenceNumber,
+		LastShardIterator: record.LastShardIterator,
+	}, false)
+
+	return s.evalCheckpointScript(ctx, redisScriptPutCheckpoint, record, string(owner), fields)
+}
+
+func (s *redisMetadataStore) evalCheckpointScript(ctx context.Context, script string, record *CheckpointRecord, condition any, fields []any) (bool, error) {
+	key := s.key(record.Namespace, record.Resource)
+	expiration := max(s.expiration(mdl.EmptyIfNil(record.Ttl)), time.Second)
+	args := append([]any{condition, int64(expiration.Seconds())}, fields...)
+
+	result, err := s.client.Eval(ctx, script, []string{key}, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return cast.ToInt64(result) == 1, nil
+}
+
+// checkpointFields returns the hash fields of a checkpoint as field value pairs. The finishedAt field is only written
+// if withFinishedAt is set to keep the finished state when releasing a checkpoint.
+func (s *redisMetadataStore) checkpointFields(record *CheckpointRecord, withFinishedAt bool) []any {
+	fields := []any{
+		"owningClientId", string(record.OwningClientId),
+		"updatedAt", record.UpdatedAt.UnixNano(),
+		"ttl", mdl.EmptyIfNil(record.Ttl),
+		"sequenceNumber", string(record.SequenceNumber),
+		"lastShardIterator", string(record.LastShardIterator),
+	}
+
+	if !withFinishedAt {
+		return fields
+	}
+
+	finishedAt := ""
+	if record.FinishedAt != nil {
+		finishedAt = strconv.FormatInt(record.FinishedAt.UnixNano(), 10)
+	}
+
+	return append(fields, "finishedAt", finishedAt)
+}
+
+// expiration converts the ttl of a record into an expiration relative to now as redis might not share our clock.
+func (s *redisMetadataStore) expiration(ttl int64) time.Duration {
+	return time.Unix(ttl, 0).Sub(s.clock.Now())
+}
+
+func (s *redisMetadataStore) key(parts ...string) string {
+	key := redisMetadataKeyPrefix
+
+	for _, part := range parts {
+		key += ":" + part
+	}
+
+	return key
+}
@@ -0,0 +1,202 @@
+package kinesis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/kinesis"
+	dbMocks "github.com/justtrackio/gosoline/pkg/db/mocks"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	baseRedis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type metadataStoreTestSuite struct {
+	suite.Suite
+
+	clock    clock.FakeClock
+	newStore func(clock clock.Clock) kinesis.MetadataStore
+	store    kinesis.MetadataStore
+}
+
+func TestInMemoryMetadataStore(t *testing.T) {
+	suite.Run(t, &metadataStoreTestSuite{
+		newStore: kinesis.NewInMemoryMetadataStore,
+	})
+}
+
+func TestRedisMetadataStore(t *testing.T) {
+	server := miniredis.RunT(t)
+
+	suite.Run(t, &metadataStoreTestSuite{
+		newStore: func(clock clock.Clock) kinesis.MetadataStore {
+			server.FlushAll()
+
+			logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+			baseClient := baseRedis.NewClient(&baseRedis.Options{Addr: server.Addr()})
+			client := redis.NewClientWithInterfaces(logger, baseClient, exec.NewDefaultExecutor(), tracing.NewNoopTracer(), &redis.Settings{}, "")
+
+			return kinesis.NewRedisMetadataStoreWithInterfaces(client, clock)
+		},
+	})
+}
+
+func (s *metadataStoreTestSuite) SetupTest() {
+	s.clock = clock.NewFakeClockAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.store = s.newStore(s.clock)
+}
+
+func (s *metadataStoreTestSuite) TestClients() {
+	ctx := s.T().Context()
+
+	for _, clientId := range []string{"c", "a", "b"} {
+		err := s.store.PutClientRecord(ctx, s.clientRecord(clientId, time.Minute))
+		s.NoError(err)
+	}
+
+	err := s.store.PutClientRecord(ctx, s.clientRecord("expired", -time.Minute))
+	s.NoError(err)
+
+	err = s.store.DeleteClientRecord(ctx, "clients", "b")
+	s.NoError(err)
+
+	clients, err := s.store.ListClientRecords(ctx, "clients")
+	s.NoError(err)
+	s.Len(clients, 2)
+	s.Equal("a", clients[0].Resource)
+	s.Equal("c", clients[1].Resource)
+}
+
+func (s *metadataStoreTestSuite) TestCheckpointOwnership() {
+	ctx := s.T().Context()
+	timedOutBefore := s.clock.Now().Add(-time.Minute)
+
+	record, err := s.store.GetCheckpointRecord(ctx, "checkpoints", "shard", true)
+	s.NoError(err)
+	s.Nil(record)
+
+	acquired, err := s.store.AcquireCheckpointRecord(ctx, s.checkpointRecord("client-a", "1", nil), timedOutBefore)
+	s.NoError(err)
+	s.True(acquired)
+
+	// the shard is still owned by client a
+	acquired, err = s.store.AcquireCheckpointRecord(ctx, s.checkpointRecord("client-b", "1", nil), timedOutBefore)
+	s.NoError(err)
+	s.False(acquired)
+
+	owned, err := s.store.PutCheckpointRecord(ctx, s.checkpointRecord("client-b", "2", nil), "client-b")
+	s.NoError(err)
+	s.False(owned)
+
+	finishedAt := s.clock.Now()
+	owned, err = s.store.PutCheckpointRecord(ctx, s.checkpointRecord("client-a", "2", &finishedAt), "client-a")
+	s.NoError(err)
+	s.True(owned)
+
+	owned, err = s.store.ReleaseCheckpointRecord(ctx, s.checkpointRecord("", "3", nil), "client-a")
+	s.NoError(err)
+	s.True(owned)
+
+	owned, err = s.store.ReleaseCheckpointRecord(ctx, s.checkpointRecord("", "3", nil), "client-a")
+	s.NoError(err)
+	s.False(owned)
+
+	record, err = s.store.GetCheckpointRecord(ctx, "checkpoints", "shard", true)
+	s.NoError(err)
+	s.Equal(kinesis.ClientId(""), record.OwningClientId)
+	s.Equal(kinesis.SequenceNumber("3"), record.SequenceNumber)
+	s.Equal(kinesis.ShardIterator("iterator-3"), record.LastShardIterator)
+	s.True(s.clock.Now().Equal(record.UpdatedAt))
+	s.Require().NotNil(record.FinishedAt, "releasing a checkpoint has to keep its finished state")
+	s.True(finishedAt.Equal(*record.FinishedAt))
+
+	// the released shard can be acquired by another client
+	acquired, err = s.store.AcquireCheckpointRecord(ctx, s.checkpointRecord("client-b", "3", nil), timedOutBefore)
+	s.NoError(err)
+	s.True(acquired)
+}
+
+func (s *metadataStoreTestSuite) TestCheckpointTakeOver() {
+	ctx := s.T().Context()
+
+	acquired, err := s.store.AcquireCheckpointRecord(ctx, s.checkpointRecord("client-a", "1", nil), s.clock.Now().Add(-time.Minute))
+	s.NoError(err)
+	s.True(acquired)
+
+	s.clock.Advance(time.Minute * 2)
+
+	acquired, err = s.store.AcquireCheckpointRecord(ctx, s.checkpointRecord("client-b", "1", nil), s.clock.Now().Add(-time.Minute))
+	s.NoError(err)
+	s.True(acquired)
+
+	owned, err := s.store.PutCheckpointRecord(ctx, s.checkpointRecord("client-a", "2", nil), "client-a")
+	s.NoError(err)
+	s.False(owned)
+}
+
+func (s *metadataStoreTestSuite) clientRecord(clientId string, ttl time.Duration) *kinesis.ClientRecord {
+	return &kinesis.ClientRecord{
+		BaseRecord: kinesis.BaseRecord{
+			Namespace: "clients",
+			Resource:  clientId,
+			UpdatedAt: s.clock.Now(),
+			Ttl:       mdl.Box(s.clock.Now().Add(ttl).Unix()),
+		},
+	}
+}
+
+func (s *metadataStoreTestSuite) checkpointRecord(owner kinesis.ClientId, sequenceNumber kinesis.SequenceNumber, finishedAt *time.Time) *kinesis.CheckpointRecord {
+	return &kinesis.CheckpointRecord{
+		BaseRecord: kinesis.BaseRecord{
+			Namespace: "checkpoints",
+			Resource:  "shard",
+			UpdatedAt: s.clock.Now(),
+			Ttl:       mdl.Box(s.clock.Now().Add(kinesis.ShardTimeout).Unix()),
+		},
+		OwningClientId:    owner,
+		SequenceNumber:    sequenceNumber,
+		LastShardIterator: kinesis.ShardIterator("iterator-" + sequenceNumber),
+		FinishedAt:        finishedAt,
+	}
+}
+
+func TestPostgresMetadataStore_AcquireCheckpointRecord(t *testing.T) {
+	client := dbMocks.NewClient(t)
+	fakeClock := clock.NewFakeClock()
+	store := kinesis.NewPostgresMetadataStoreWithInterfaces(client, fakeClock, "kinesis_metadata")
+
+	record := &kinesis.CheckpointRecord{
+		BaseRecord: kinesis.BaseRecord{
+			Namespace: "checkpoints",
+			Resource:  "shard",
+			UpdatedAt: fakeClock.Now(),
+			Ttl:       mdl.Box(fakeClock.Now().Add(kinesis.ShardTimeout).Unix()),
+		},
+		OwningClientId: "client",
+	}
+	timedOutBefore := fakeClock.Now().Add(-time.Minute)
+
+	client.EXPECT().Exec(matcher.Context, mock.AnythingOfType("string"), "checkpoints", "shard", "client", "", "", (*time.Time)(nil), record.UpdatedAt, *record.Ttl, timedOutBefore).
+		Return(sqlmock.NewResult(0, 1), nil).Once()
+
+	acquired, err := store.AcquireCheckpointRecord(t.Context(), record, timedOutBefore)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	client.EXPECT().Exec(matcher.Context, mock.AnythingOfType("string"), "checkpoints", "shard", "client", "", "", (*time.Time)(nil), record.UpdatedAt, *record.Ttl, timedOutBefore).
+		Return(sqlmock.NewResult(0, 0), nil).Once()
+
+	acquired, err = store.AcquireCheckpointRecord(t.Context(), record, timedOutBefore)
+	assert.NoError(t, err)
+	assert.False(t, acquired, "the shard should still be owned by another client")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/clock"
-	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
@@ -135,7 +135,7 @@ func (s *Service) waitForStreamBeingActive(ctx context.Context) error {
 				return fmt.Errorf("failed to describe kinesis stream %s: %w", s.fullStreamName, err)
 			}
 
-			if funk.Contains([]types.StreamStatus{types.StreamStatusActive, types.StreamStatusUpdating}, out.StreamDescriptionSummary.StreamStatus) {
+			if slices.Contains([]types.StreamStatus{types.StreamStatusActive, types.StreamStatusUpdating}, out.StreamDescriptionSummary.StreamStatus) {
 				return nil
 			}
 
@@ -0,0 +1,223 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/justtrackio/gosoline/pkg/cache"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type DataKey struct {
+	KeyId string
+	// Plaintext is the key used to encrypt data locally, it should never be persisted.
+	Plaintext []byte
+	// Ciphertext is the key encrypted by kms, it is stored next to the encrypted data and decrypted with KeyManager.Decrypt.
+	Ciphertext []byte
+}
+
+type DataKeyCacheSettings struct {
+	// Enabled reuses generated data keys for the same encryption context until they reach their max age or max usages.
+	Enabled   bool          `cfg:"enabled" default:"true"`
+	MaxAge    time.Duration `cfg:"max_age" default:"5m"`
+	MaxUsages int           `cfg:"max_usages" default:"1000"`
+	// DecryptTtl is the time decrypted values are cached, 0 disables the cache of decrypted values.
+	DecryptTtl time.Duration `cfg:"decrypt_ttl" default:"5m"`
+	// DecryptMaxSize is the maximum number of decrypted values in the cache.
+	DecryptMaxSize int64 `cfg:"decrypt_max_size" default:"1000"`
+}
+
+type KeyManagerSettings struct {
+	ClientName string `cfg:"client_name" default:"default"`
+	// KeyId is the id, arn or alias of the kms key used to encrypt values and generate data keys.
+	KeyId        string               `cfg:"key_id" validate:"required"`
+	DataKeyCache DataKeyCacheSettings `cfg:"data_key_cache"`
+}
+
+// A KeyManager encrypts and decrypts small values (up to 4KB) with kms and generates data keys for envelope encryption
+// of larger values.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name KeyManager
+type KeyManager interface {
+	Encrypt(ctx context.Context, plaintext []byte, encryptionContext map[string]string) ([]byte, error)
+	// Decrypt decrypts values encrypted by Encrypt as well as the ciphertext of data keys. The encryption context has to
+	// match the one used for encryption.
+	Decrypt(ctx context.Context, ciphertext []byte, encryptionContext map[string]string) ([]byte, error)
+	// GenerateDataKey returns a 256 bit data key, which is reused for the same encryption context if the data key
+	// cache is enabled.
+	GenerateDataKey(ctx context.Context, encryptionContext map[string]string) (*DataKey, error)
+}
+
+type cachedDataKey struct {
+	dataKey   *DataKey
+	createdAt time.Time
+	usages    int
+}
+
+type keyManager struct {
+	logger       log.Logger
+	client       Client
+	clock        clock.Clock
+	settings     *KeyManagerSettings
+	lck          sync.Mutex
+	dataKeys     map[string]*cachedDataKey
+	decryptCache cache.Cache[[]byte]
+}
+
+func NewKeyManager(ctx context.Context, config cfg.Config, logger log.Logger, settings *KeyManagerSettings) (KeyManager, error) {
+	client, err := ProvideClient(ctx, config, logger, settings.ClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create kms client %s: %w", settings.ClientName, err)
+	}
+
+	return NewKeyManagerWithInterfaces(logger, client, clock.Provider, settings), nil
+}
+
+func NewKeyManagerWithInterfaces(logger log.Logger, client Client, clock clock.Clock, settings *KeyManagerSettings) KeyManager {
+	manager := &keyManager{
+		logger:   logger,
+		client:   client,
+		clock:    clock,
+		settings: settings,
+		dataKeys: make(map[string]*cachedDataKey),
+	}
+
+	if settings.DataKeyCache.DecryptTtl > 0 {
+		manager.decryptCache = cache.New[[]byte](settings.DataKeyCache.DecryptMaxSize, 100, settings.DataKeyCache.DecryptTtl)
+	}
+
+	return manager
+}
+
+func (m *keyManager) Encrypt(ctx context.Context, plaintext []byte, encryptionContext map[string]string) ([]byte, error) {
+	input := &kms.EncryptInput{
+		KeyId:             aws.String(m.settings.KeyId),
+		Plaintext:         plaintext,
+		EncryptionContext: encryptionContext,
+	}
+
+	out, err := m.client.Encrypt(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("can not encrypt with key %s: %w", m.settings.KeyId, err)
+	}
+
+	return out.CiphertextBlob, nil
+}
+
+func (m *keyManager) Decrypt(ctx context.Context, ciphertext []byte, encryptionContext map[string]string) ([]byte, error) {
+	if m.decryptCache == nil {
+		return m.decrypt(ctx, ciphertext, encryptionContext)
+	}
+
+	key := base64.StdEncoding.EncodeToString(ciphertext) + "|" + encryptionContextKey(encryptionContext)
+
+	plaintext, err := m.decryptCache.ProvideWithError(key, func() ([]byte, error) {
+		return m.decrypt(ctx, ciphertext, encryptionContext)
+	})
+
+	// callers might wipe the plaintext after using it, so they must not get the cached slice
+	return bytes.Clone(plaintext), err
+}
+
+func (m *keyManager) decrypt(ctx context.Context, ciphertext []byte, encryptionContext map[string]string) ([]byte, error) {
+	input := &kms.DecryptInput{
+		KeyId:             aws.String(m.settings.KeyId),
+		CiphertextBlob:    ciphertext,
+		EncryptionContext: encryptionContext,
+	}
+
+	out, err := m.client.Decrypt(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("can not decrypt with key %s: %w", m.settings.KeyId, err)
+	}
+
+	return out.Plaintext, nil
+}
+
+func (m *keyManager) GenerateDataKey(ctx context.Context, encryptionContext map[string]string) (*DataKey, error) {
+	if !m.settings.DataKeyCache.Enabled {
+		return m.generateDataKey(ctx, encryptionContext)
+	}
+
+	key := encryptionContextKey(encryptionContext)
+
+	m.lck.Lock()
+	defer m.lck.Unlock()
+
+	cached, ok := m.dataKeys[key]
+	if ok && m.isUsable(cached) {
+		cached.usages++
+
+		return cloneDataKey(cached.dataKey), nil
+	}
+
+	dataKey, err := m.generateDataKey(ctx, encryptionContext)
+	if err != nil {
+		return nil, err
+	}
+
+	m.dataKeys[key] = &cachedDataKey{
+		dataKey:   dataKey,
+		createdAt: m.clock.Now(),
+		usages:    1,
+	}
+
+	return cloneDataKey(dataKey), nil
+}
+
+func (m *keyManager) generateDataKey(ctx context.Context, encryptionContext map[string]string) (*DataKey, error) {
+	input := &kms.GenerateDataKeyInput{
+		KeyId:             aws.String(m.settings.KeyId),
+		KeySpec:           types.DataKeySpecAes256,
+		EncryptionContext: encryptionContext,
+	}
+
+	out, err := m.client.GenerateDataKey(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("can not generate data key with key %s: %w", m.settings.KeyId, err)
+	}
+
+	return &DataKey{
+		KeyId:      aws.ToString(out.KeyId),
+		Plaintext:  out.Plaintext,
+		Ciphertext: out.CiphertextBlob,
+	}, nil
+}
+
+func (m *keyManager) isUsable(cached *cachedDataKey) bool {
+	if m.settings.DataKeyCache.MaxUsages > 0 && cached.usages >= m.settings.DataKeyCache.MaxUsages {
+		return false
+	}
+
+	return m.clock.Since(cached.createdAt) < m.settings.DataKeyCache.MaxAge
+}
+
+func cloneDataKey(dataKey *DataKey) *DataKey {
+	return &DataKey{
+		KeyId:      dataKey.KeyId,
+		Plaintext:  bytes.Clone(dataKey.Plaintext),
+		Ciphertext: bytes.Clone(dataKey.Ciphertext),
+	}
+}
+
+func encryptionContextKey(encryptionContext map[string]string) string {
+	pairs := make([]string, 0, len(encryptionContext))
+	for key, value := range encryptionContext {
+		pairs = append(pairs, fmt.Sprintf("%q=%q", key, value))
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,144 @@
+package kms_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoKms "github.com/justtrackio/gosoline/pkg/cloud/aws/kms"
+	kmsMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/kms/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRunKeyManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(keyManagerTestSuite))
+}
+
+type keyManagerTestSuite struct {
+	suite.Suite
+	ctx      context.Context
+	logger   logMocks.LoggerMock
+	client   *kmsMocks.Client
+	clock    clock.FakeClock
+	settings *gosoKms.KeyManagerSettings
+	manager  gosoKms.KeyManager
+}
+
+func (s *keyManagerTestSuite) SetupTest() {
+	s.ctx = s.T().Context()
+	s.logger = logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	s.client = kmsMocks.NewClient(s.T())
+	s.clock = clock.NewFakeClock()
+	s.settings = &gosoKms.KeyManagerSettings{
+		KeyId: "alias/test",
+		DataKeyCache: gosoKms.DataKeyCacheSettings{
+			Enabled:        true,
+			MaxAge:         time.Minute,
+			MaxUsages:      3,
+			DecryptTtl:     time.Minute,
+			DecryptMaxSize: 10,
+		},
+	}
+	s.manager = gosoKms.NewKeyManagerWithInterfaces(s.logger, s.client, s.clock, s.settings)
+}
+
+func (s *keyManagerTestSuite) TestEncrypt() {
+	s.client.EXPECT().Encrypt(matcher.Context, &kms.EncryptInput{
+		KeyId:             aws.String("alias/test"),
+		Plaintext:         []byte("secret"),
+		EncryptionContext: map[string]string{"purpose": "test"},
+	}).Return(&kms.EncryptOutput{
+		CiphertextBlob: []byte("encrypted"),
+	}, nil).Once()
+
+	ciphertext, err := s.manager.Encrypt(s.ctx, []byte("secret"), map[string]string{"purpose": "test"})
+
+	s.NoError(err)
+	s.Equal([]byte("encrypted"), ciphertext)
+}
+
+func (s *keyManagerTestSuite) TestDecrypt_Cached() {
+	s.client.EXPECT().Decrypt(matcher.Context, &kms.DecryptInput{
+		KeyId:             aws.String("alias/test"),
+		CiphertextBlob:    []byte("encrypted"),
+		EncryptionContext: map[string]string{"purpose": "test"},
+	}).Return(&kms.DecryptOutput{
+		Plaintext: []byte("secret"),
+	}, nil).Once()
+
+	plaintext, err := s.manager.Decrypt(s.ctx, []byte("encrypted"), map[string]string{"purpose": "test"})
+	s.NoError(err)
+	s.Equal([]byte("secret"), plaintext)
+
+	// wiping the returned plaintext must not affect the cache
+	clear(plaintext)
+
+	plaintext, err = s.manager.Decrypt(s.ctx, []byte("encrypted"), map[string]string{"purpose": "test"})
+	s.NoError(err)
+	s.Equal([]byte("secret"), plaintext)
+}
+
+func (s *keyManagerTestSuite) TestGenerateDataKey_MaxUsages() {
+	s.expectGenerateDataKey("key-1").Once()
+	s.expectGenerateDataKey("key-2").Once()
+
+	for i := 0; i < 3; i++ {
+		dataKey, err := s.manager.GenerateDataKey(s.ctx, map[string]string{"purpose": "test"})
+		s.NoError(err)
+		s.Equal([]byte("key-1"), dataKey.Plaintext)
+	}
+
+	dataKey, err := s.manager.GenerateDataKey(s.ctx, map[string]string{"purpose": "test"})
+	s.NoError(err)
+	s.Equal([]byte("key-2"), dataKey.Plaintext)
+}
+
+func (s *keyManagerTestSuite) TestGenerateDataKey_MaxAge() {
+	s.expectGenerateDataKey("key-1").Once()
+	s.expectGenerateDataKey("key-2").Once()
+
+	dataKey, err := s.manager.GenerateDataKey(s.ctx, map[string]string{"purpose": "test"})
+	s.NoError(err)
+	s.Equal([]byte("key-1"), dataKey.Plaintext)
+
+	s.clock.Advance(time.Minute)
+
+	dataKey, err = s.manager.GenerateDataKey(s.ctx, map[string]string{"purpose": "test"})
+	s.NoError(err)
+	s.Equal([]byte("key-2"), dataKey.Plaintext)
+}
+
+func (s *keyManagerTestSuite) TestGenerateDataKey_Disabled() {
+	s.settings.DataKeyCache.Enabled = false
+
+	s.expectGenerateDataKey("key-1").Once()
+	s.expectGenerateDataKey("key-2").Once()
+
+	for _, expected := range []string{"key-1", "key-2"} {
+		dataKey, err := s.manager.GenerateDataKey(s.ctx, map[string]string{"purpose": "test"})
+		s.NoError(err)
+		s.Equal(&gosoKms.DataKey{
+			KeyId:      "arn:key",
+			Plaintext:  []byte(expected),
+			Ciphertext: []byte("encrypted-" + expected),
+		}, dataKey)
+	}
+}
+
+func (s *keyManagerTestSuite) expectGenerateDataKey(plaintext string) *kmsMocks.Client_GenerateDataKey_Call {
+	return s.client.EXPECT().GenerateDataKey(matcher.Context, &kms.GenerateDataKeyInput{
+		KeyId:             aws.String("alias/test"),
+		KeySpec:           types.DataKeySpecAes256,
+		EncryptionContext: map[string]string{"purpose": "test"},
+	}).Return(&kms.GenerateDataKeyOutput{
+		KeyId:          aws.String("arn:key"),
+		Plaintext:      []byte(plaintext),
+		CiphertextBlob: []byte("encrypted-" + plaintext),
+	}, nil)
+}
@@ -0,0 +1,125 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	cloudAws "github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	// InvocationTypeRequestResponse invokes the function synchronously and waits for its response.
+	InvocationTypeRequestResponse = string(types.InvocationTypeRequestResponse)
+	// InvocationTypeEvent queues the invocation and returns as soon as lambda accepted the event.
+	InvocationTypeEvent = string(types.InvocationTypeEvent)
+
+	MaxPayloadSizeRequestResponse = 6 * 1024 * 1024
+	MaxPayloadSizeEvent           = 256 * 1024
+)
+
+//go:generate go run github.com/vektra/mockery/v2 --name Invoker
+type Invoker interface {
+	// Invoke calls the function with the given payload. For synchronous invocations the response payload of the function
+	// is returned, a function which failed to handle the payload results in a *FunctionError.
+	Invoke(ctx context.Context, payload []byte) ([]byte, error)
+}
+
+type InvokerSettings struct {
+	ClientName string
+	// FunctionName can be the name, the partial arn or the full arn of the function.
+	FunctionName string
+	// Qualifier selects a version or alias of the function; the latest version is invoked if empty.
+	Qualifier      string
+	InvocationType string
+}
+
+// FunctionError is returned if the function itself failed while handling the payload (in contrast to an error of the
+// lambda service, which is retried by the client).
+type FunctionError struct {
+	FunctionName string
+	Type         string
+	Payload      []byte
+}
+
+func (e *FunctionError) Error() string {
+	return fmt.Sprintf("function %s failed with %s: %s", e.FunctionName, e.Type, string(e.Payload))
+}
+
+type invoker struct {
+	logger   log.Logger
+	client   Client
+	settings *InvokerSettings
+}
+
+func NewInvoker(ctx context.Context, config cfg.Config, logger log.Logger, settings *InvokerSettings) (Invoker, error) {
+	client, err := ProvideClient(ctx, config, logger, settings.ClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create lambda client %s: %w", settings.ClientName, err)
+	}
+
+	return NewInvokerWithInterfaces(logger, client, settings)
+}
+
+func NewInvokerWithInterfaces(logger log.Logger, client Client, settings *InvokerSettings) (Invoker, error) {
+	if settings.FunctionName == "" {
+		return nil, fmt.Errorf("the function name of the invoker is required")
+	}
+
+	if settings.InvocationType == "" {
+		settings.InvocationType = InvocationTypeRequestResponse
+	}
+
+	if settings.InvocationType != InvocationTypeRequestResponse && settings.InvocationType != InvocationTypeEvent {
+		return nil, fmt.Errorf("invalid invocation type %s, has to be one of %s or %s", settings.InvocationType, InvocationTypeRequestResponse, InvocationTypeEvent)
+	}
+
+	return &invoker{
+		logger: logger.WithFields(log.Fields{
+			"lambda_function_name": settings.FunctionName,
+		}),
+		client:   client,
+		settings: settings,
+	}, nil
+}
+
+func (i *invoker) Invoke(ctx context.Context, payload []byte) ([]byte, error) {
+	input := &lambda.InvokeInput{
+		FunctionName:   aws.String(i.settings.FunctionName),
+		InvocationType: types.InvocationType(i.settings.InvocationType),
+		Payload:        payload,
+	}
+
+	if i.settings.Qualifier != "" {
+		input.Qualifier = aws.String(i.settings.Qualifier)
+	}
+
+	ctx = cloudAws.WithResourceTarget(ctx, i.settings.FunctionName)
+
+	out, err := i.client.Invoke(ctx, input)
+
+	if exec.IsRequestCanceled(err) {
+		i.logger.Info(ctx, "request was canceled while invoking function")
+
+		return nil, fmt.Errorf("request was canceled while invoking function %s: %w", i.settings.FunctionName, err)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("can not invoke function %s: %w", i.settings.FunctionName, err)
+	}
+
+	if out.FunctionError != nil {
+		return nil, &FunctionError{
+			FunctionName: i.settings.FunctionName,
+			Type:         *out.FunctionError,
+			Payload:      out.Payload,
+		}
+	}
+
+	return out.Payload, nil
+}
@@ -0,0 +1,96 @@
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsLambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/lambda"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/lambda/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestInvoker_Invoke(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+
+	client.EXPECT().Invoke(matcher.Context, &awsLambda.InvokeInput{
+		FunctionName:   aws.String("function"),
+		InvocationType: types.InvocationTypeRequestResponse,
+		Qualifier:      aws.String("live"),
+		Payload:        []byte(`{"id":1}`),
+	}).Return(&awsLambda.InvokeOutput{
+		Payload: []byte(`{"ok":true}`),
+	}, nil).Once()
+
+	invoker, err := lambda.NewInvokerWithInterfaces(logger, client, &lambda.InvokerSettings{
+		FunctionName: "function",
+		Qualifier:    "live",
+	})
+	assert.NoError(t, err)
+
+	response, err := invoker.Invoke(t.Context(), []byte(`{"id":1}`))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"ok":true}`, string(response))
+}
+
+func TestInvoker_InvokeFunctionError(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+
+	client.EXPECT().Invoke(matcher.Context, &awsLambda.InvokeInput{
+		FunctionName:   aws.String("function"),
+		InvocationType: types.InvocationTypeEvent,
+		Payload:        []byte(`{"id":1}`),
+	}).Return(&awsLambda.InvokeOutput{
+		FunctionError: aws.String("Unhandled"),
+		Payload:       []byte(`{"errorMessage":"boom"}`),
+	}, nil).Once()
+
+	invoker, err := lambda.NewInvokerWithInterfaces(logger, client, &lambda.InvokerSettings{
+		FunctionName:   "function",
+		InvocationType: lambda.InvocationTypeEvent,
+	})
+	assert.NoError(t, err)
+
+	_, err = invoker.Invoke(t.Context(), []byte(`{"id":1}`))
+
+	functionErr := &lambda.FunctionError{}
+	assert.ErrorAs(t, err, &functionErr)
+	assert.Equal(t, "Unhandled", functionErr.Type)
+	assert.Equal(t, `function function failed with Unhandled: {"errorMessage":"boom"}`, err.Error())
+}
+
+func TestInvoker_InvokeClientError(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+
+	client.EXPECT().Invoke(matcher.Context, mock.Anything).Return(nil, fmt.Errorf("throttled")).Once()
+
+	invoker, err := lambda.NewInvokerWithInterfaces(logger, client, &lambda.InvokerSettings{
+		FunctionName: "function",
+	})
+	assert.NoError(t, err)
+
+	_, err = invoker.Invoke(t.Context(), []byte(`{}`))
+	assert.EqualError(t, err, "can not invoke function function: throttled")
+}
+
+func TestInvoker_InvalidSettings(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+
+	_, err := lambda.NewInvokerWithInterfaces(logger, client, &lambda.InvokerSettings{})
+	assert.EqualError(t, err, "the function name of the invoker is required")
+
+	_, err = lambda.NewInvokerWithInterfaces(logger, client, &lambda.InvokerSettings{
+		FunctionName:   "function",
+		InvocationType: "DryRun",
+	})
+	assert.EqualError(t, err, "invalid invocation type DryRun, has to be one of RequestResponse or Event")
+}
@@ -22,6 +22,124 @@ func (_m *Service) EXPECT() *Service_Expecter {
 	return &Service_Expecter{mock: &_m.Mock}
 }
 
+// FindQueuesByTags provides a mock function with given fields: ctx, tags
+func (_m *Service) FindQueuesByTags(ctx context.Context, tags map[string][]string) ([]resourcegroupstaggingapi.Resource, error) {
+	ret := _m.Called(ctx, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQueuesByTags")
+	}
+
+	var r0 []resourcegroupstaggingapi.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string][]string) ([]resourcegroupstaggingapi.Resource, error)); ok {
+		return rf(ctx, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string][]string) []resourcegroupstaggingapi.Resource); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resourcegroupstaggingapi.Resource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string][]string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_FindQueuesByTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQueuesByTags'
+type Service_FindQueuesByTags_Call struct {
+	*mock.Call
+}
+
+// FindQueuesByTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags map[string][]string
+func (_e *Service_Expecter) FindQueuesByTags(ctx interface{}, tags interface{}) *Service_FindQueuesByTags_Call {
+	return &Service_FindQueuesByTags_Call{Call: _e.mock.On("FindQueuesByTags", ctx, tags)}
+}
+
+func (_c *Service_FindQueuesByTags_Call) Run(run func(ctx context.Context, tags map[string][]string)) *Service_FindQueuesByTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string][]string))
+	})
+	return _c
+}
+
+func (_c *Service_FindQueuesByTags_Call) Return(_a0 []resourcegroupstaggingapi.Resource, _a1 error) *Service_FindQueuesByTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_FindQueuesByTags_Call) RunAndReturn(run func(context.Context, map[string][]string) ([]resourcegroupstaggingapi.Resource, error)) *Service_FindQueuesByTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTablesByTags provides a mock function with given fields: ctx, tags
+func (_m *Service) FindTablesByTags(ctx context.Context, tags map[string][]string) ([]resourcegroupstaggingapi.Resource, error) {
+	ret := _m.Called(ctx, tags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTablesByTags")
+	}
+
+	var r0 []resourcegroupstaggingapi.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string][]string) ([]resourcegroupstaggingapi.Resource, error)); ok {
+		return rf(ctx, tags)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string][]string) []resourcegroupstaggingapi.Resource); ok {
+		r0 = rf(ctx, tags)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resourcegroupstaggingapi.Resource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string][]string) error); ok {
+		r1 = rf(ctx, tags)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_FindTablesByTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTablesByTags'
+type Service_FindTablesByTags_Call struct {
+	*mock.Call
+}
+
+// FindTablesByTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tags map[string][]string
+func (_e *Service_Expecter) FindTablesByTags(ctx interface{}, tags interface{}) *Service_FindTablesByTags_Call {
+	return &Service_FindTablesByTags_Call{Call: _e.mock.On("FindTablesByTags", ctx, tags)}
+}
+
+func (_c *Service_FindTablesByTags_Call) Run(run func(ctx context.Context, tags map[string][]string)) *Service_FindTablesByTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string][]string))
+	})
+	return _c
+}
+
+func (_c *Service_FindTablesByTags_Call) Return(_a0 []resourcegroupstaggingapi.Resource, _a1 error) *Service_FindTablesByTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_FindTablesByTags_Call) RunAndReturn(run func(context.Context, map[string][]string) ([]resourcegroupstaggingapi.Resource, error)) *Service_FindTablesByTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResources provides a mock function with given fields: ctx, filter
 func (_m *Service) GetResources(ctx context.Context, filter resourcegroupstaggingapi.Filter) ([]string, error) {
 	ret := _m.Called(ctx, filter)
@@ -3,17 +3,32 @@ package resourcegroupstaggingapi
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/justtrackio/gosoline/pkg/cache"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
+const (
+	ResourceTypeQueue = "sqs"
+	ResourceTypeTable = "dynamodb:table"
+)
+
 //go:generate go run github.com/vektra/mockery/v2 --name Service
 type Service interface {
 	GetResources(ctx context.Context, filter Filter) ([]string, error)
+	// FindQueuesByTags returns the sqs queues having all the tags. A tag without values matches any value.
+	FindQueuesByTags(ctx context.Context, tags map[string][]string) ([]Resource, error)
+	// FindTablesByTags returns the dynamodb tables having all the tags. A tag without values matches any value.
+	FindTablesByTags(ctx context.Context, tags map[string][]string) ([]Resource, error)
 }
 
 type Filter struct {
@@ -21,30 +36,129 @@ type Filter struct {
 	TagFilter      map[string][]string
 }
 
+type Resource struct {
+	Arn  string
+	Name string
+	Tags map[string]string
+}
+
+type ServiceSettings struct {
+	ClientName string `cfg:"client_name" default:"default"`
+	// CacheTtl is the time the resources of a filter are cached, 0 disables the cache.
+	CacheTtl time.Duration `cfg:"cache_ttl" default:"5m"`
+}
+
 type resourceManager struct {
 	client Client
 	logger log.Logger
+	cache  cache.Cache[[]types.ResourceTagMapping]
 }
 
+// NewService creates a service configured at the key resourcegroupstaggingapi.service.
 func NewService(ctx context.Context, config cfg.Config, logger log.Logger) (Service, error) {
-	client, err := ProvideClient(ctx, config, logger, "default")
+	settings := &ServiceSettings{}
+	if err := config.UnmarshalKey("resourcegroupstaggingapi.service", settings); err != nil {
+		return nil, fmt.Errorf("can not unmarshal resourcegroupstaggingapi service settings: %w", err)
+	}
+
+	client, err := ProvideClient(ctx, config, logger, settings.ClientName)
 	if err != nil {
 		return nil, fmt.Errorf("can not create client: %w", err)
 	}
 
-	return NewServiceWithInterfaces(client, logger), nil
+	return NewServiceWithInterfaces(client, logger, settings), nil
 }
 
-func NewServiceWithInterfaces(client Client, logger log.Logger) Service {
-	return &resourceManager{
+func NewServiceWithInterfaces(client Client, logger log.Logger, settings *ServiceSettings) Service {
+	manager := &resourceManager{
 		client: client,
 		logger: logger,
 	}
+
+	if settings.CacheTtl > 0 {
+		manager.cache = cache.New[[]types.ResourceTagMapping](1000, 100, settings.CacheTtl)
+	}
+
+	return manager
 }
 
 func (m *resourceManager) GetResources(ctx context.Context, filter Filter) ([]string, error) {
+	mappings, err := m.getResourceMappings(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	arns := make([]string, 0, len(mappings))
+	for _, rtm := range mappings {
+		arns = append(arns, aws.ToString(rtm.ResourceARN))
+	}
+
+	return arns, nil
+}
+
+func (m *resourceManager) FindQueuesByTags(ctx context.Context, tags map[string][]string) ([]Resource, error) {
+	return m.findResources(ctx, ResourceTypeQueue, tags, func(resource string) string {
+		return resource
+	})
+}
+
+func (m *resourceManager) FindTablesByTags(ctx context.Context, tags map[string][]string) ([]Resource, error) {
+	return m.findResources(ctx, ResourceTypeTable, tags, func(resource string) string {
+		return strings.TrimPrefix(resource, "table/")
+	})
+}
+
+func (m *resourceManager) findResources(ctx context.Context, resourceType string, tags map[string][]string, getName func(resource string) string) ([]Resource, error) {
+	filter := Filter{
+		ResourceFilter: []string{resourceType},
+		TagFilter:      tags,
+	}
+
+	mappings, err := m.getResourceMappings(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]Resource, 0, len(mappings))
+
+	for _, rtm := range mappings {
+		parsed, err := arn.Parse(aws.ToString(rtm.ResourceARN))
+		if err != nil {
+			return nil, fmt.Errorf("can not parse arn of %s resource: %w", resourceType, err)
+		}
+
+		resource := Resource{
+			Arn:  parsed.String(),
+			Name: getName(parsed.Resource),
+			Tags: make(map[string]string, len(rtm.Tags)),
+		}
+
+		for _, tag := range rtm.Tags {
+			resource.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+func (m *resourceManager) getResourceMappings(ctx context.Context, filter Filter) ([]types.ResourceTagMapping, error) {
+	if m.cache == nil {
+		return m.fetchResourceMappings(ctx, filter)
+	}
+
+	mappings, err := m.cache.ProvideWithError(buildCacheKey(filter), func() ([]types.ResourceTagMapping, error) {
+		return m.fetchResourceMappings(ctx, filter)
+	})
+
+	// the cached slice is shared, so callers get their own copy
+	return slices.Clone(mappings), err
+}
+
+func (m *resourceManager) fetchResourceMappings(ctx context.Context, filter Filter) ([]types.ResourceTagMapping, error) {
 	input := buildGetResourceInput(filter)
-	arns := make([]string, 0)
+	mappings := make([]types.ResourceTagMapping, 0)
 
 	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(m.client, input, func(options *resourcegroupstaggingapi.GetResourcesPaginatorOptions) {
 		options.StopOnDuplicateToken = true
@@ -58,12 +172,27 @@ func (m *resourceManager) GetResources(ctx context.Context, filter Filter) ([]st
 			return nil, fmt.Errorf("can not get next page of resources: %w", err)
 		}
 
-		for _, rtm := range output.ResourceTagMappingList {
-			arns = append(arns, *rtm.ResourceARN)
-		}
+		mappings = append(mappings, output.ResourceTagMappingList...)
 	}
 
-	return arns, nil
+	return mappings, nil
+}
+
+// buildCacheKey serializes the filter independent of the order of its tags and values.
+func buildCacheKey(filter Filter) string {
+	resourceFilter := slices.Clone(filter.ResourceFilter)
+	sort.Strings(resourceFilter)
+
+	tagFilters := make([]string, 0, len(filter.TagFilter))
+	for tag, values := range filter.TagFilter {
+		values = slices.Clone(values)
+		sort.Strings(values)
+
+		tagFilters = append(tagFilters, fmt.Sprintf("%q=%q", tag, values))
+	}
+	sort.Strings(tagFilters)
+
+	return fmt.Sprintf("%q|%s", resourceFilter, strings.Join(tagFilters, ","))
 }
 
 func buildGetResourceInput(filter Filter) *resourcegroupstaggingapi.GetResourcesInput {
@@ -2,6 +2,7 @@ package resourcegroupstaggingapi_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
@@ -9,6 +10,7 @@ import (
 	gosoRes "github.com/justtrackio/gosoline/pkg/cloud/aws/resourcegroupstaggingapi"
 	gosoResMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/resourcegroupstaggingapi/mocks"
 	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -26,7 +28,7 @@ func TestResourcesManager_GetResources(t *testing.T) {
 		}},
 	}, nil)
 
-	srv := gosoRes.NewServiceWithInterfaces(client, logger)
+	srv := gosoRes.NewServiceWithInterfaces(client, logger, &gosoRes.ServiceSettings{})
 	r, err := srv.GetResources(ctx, gosoRes.Filter{
 		ResourceFilter: nil,
 		TagFilter:      nil,
@@ -37,3 +39,108 @@ func TestResourcesManager_GetResources(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, r)
 }
+
+func TestResourcesManager_GetResourcesCached(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	client := gosoResMocks.NewClient(t)
+	client.EXPECT().GetResources(matcher.Context, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{"sqs"},
+	}, mock.Anything).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		PaginationToken: aws.String("page2"),
+		ResourceTagMappingList: []types.ResourceTagMapping{{
+			ResourceARN: aws.String("arn:aws:sqs:region:accountId:queue-a"),
+		}},
+	}, nil).Once()
+	client.EXPECT().GetResources(matcher.Context, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{"sqs"},
+		PaginationToken:     aws.String("page2"),
+	}, mock.Anything).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		PaginationToken: aws.String(""),
+		ResourceTagMappingList: []types.ResourceTagMapping{{
+			ResourceARN: aws.String("arn:aws:sqs:region:accountId:queue-b"),
+		}},
+	}, nil).Once()
+
+	srv := gosoRes.NewServiceWithInterfaces(client, logger, &gosoRes.ServiceSettings{
+		CacheTtl: time.Minute,
+	})
+
+	expected := []string{
+		"arn:aws:sqs:region:accountId:queue-a",
+		"arn:aws:sqs:region:accountId:queue-b",
+	}
+
+	for i := 0; i < 2; i++ {
+		r, err := srv.GetResources(ctx, gosoRes.Filter{
+			ResourceFilter: []string{"sqs"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, r)
+	}
+}
+
+func TestResourcesManager_FindQueuesByTags(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	client := gosoResMocks.NewClient(t)
+	client.EXPECT().GetResources(matcher.Context, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{"sqs"},
+		TagFilters: []types.TagFilter{{
+			Key:    aws.String("tenant"),
+			Values: []string{"acme"},
+		}},
+	}, mock.Anything).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []types.ResourceTagMapping{{
+			ResourceARN: aws.String("arn:aws:sqs:eu-central-1:123456789012:acme-events"),
+			Tags: []types.Tag{{
+				Key:   aws.String("tenant"),
+				Value: aws.String("acme"),
+			}},
+		}},
+	}, nil).Once()
+
+	srv := gosoRes.NewServiceWithInterfaces(client, logger, &gosoRes.ServiceSettings{})
+	queues, err := srv.FindQueuesByTags(ctx, map[string][]string{
+		"tenant": {"acme"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []gosoRes.Resource{{
+		Arn:  "arn:aws:sqs:eu-central-1:123456789012:acme-events",
+		Name: "acme-events",
+		Tags: map[string]string{"tenant": "acme"},
+	}}, queues)
+}
+
+func TestResourcesManager_FindTablesByTags(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	client := gosoResMocks.NewClient(t)
+	client.EXPECT().GetResources(matcher.Context, &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{"dynamodb:table"},
+		TagFilters: []types.TagFilter{{
+			Key: aws.String("tenant"),
+		}},
+	}, mock.Anything).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+		ResourceTagMappingList: []types.ResourceTagMapping{{
+			ResourceARN: aws.String("arn:aws:dynamodb:eu-central-1:123456789012:table/acme-users"),
+		}},
+	}, nil).Once()
+
+	srv := gosoRes.NewServiceWithInterfaces(client, logger, &gosoRes.ServiceSettings{})
+	tables, err := srv.FindTablesByTags(ctx, map[string][]string{
+		"tenant": nil,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []gosoRes.Resource{{
+		Arn:  "arn:aws:dynamodb:eu-central-1:123456789012:table/acme-users",
+		Name: "acme-users",
+		Tags: map[string]string{},
+	}}, tables)
+}
@@ -0,0 +1,194 @@
+package sfn
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/reslife"
+)
+
+//go:generate go run github.com/vektra/mockery/v2 --name ActivityHandler
+type ActivityHandler interface {
+	// Handle gets the json input of the task and returns its output, which is json encoded. Returning an error fails
+	// the task, see TaskError to control the reported error code.
+	Handle(ctx context.Context, input []byte) (output any, err error)
+}
+
+type ActivityHandlerFactory func(ctx context.Context, config cfg.Config, logger log.Logger) (ActivityHandler, error)
+
+type ActivityWorkerSettings struct {
+	ClientName string `cfg:"client_name" default:"default"`
+	// WorkerName is shown in the execution history of the state machine, it defaults to the app name.
+	WorkerName string `cfg:"worker_name"`
+	// HeartbeatInterval enables heartbeats while a task is handled, required if the task defines a heartbeat timeout.
+	HeartbeatInterval time.Duration `cfg:"heartbeat_interval" default:"0s"`
+}
+
+type activityWorker struct {
+	kernel.ApplicationStage
+
+	logger      log.Logger
+	client      Client
+	responder   TaskResponder
+	clock       clock.Clock
+	handler     ActivityHandler
+	activityArn string
+	settings    *ActivityWorkerSettings
+}
+
+// NewActivityWorker creates a module polling the tasks of the activity with the given id and handling them with the
+// handler. The worker is configured at the key sfn.activity_worker.<activityId>, the activity is named by the
+// activity_pattern of the client.
+func NewActivityWorker(activityId string, handlerFactory ActivityHandlerFactory) kernel.ModuleFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+		var err error
+		var appIdentity cfg.Identity
+		var activityName string
+		var client Client
+		var handler ActivityHandler
+
+		logger = logger.WithChannel("sfn-activity-worker")
+
+		key := fmt.Sprintf("sfn.activity_worker.%s", activityId)
+		settings := &ActivityWorkerSettings{}
+		if err = config.UnmarshalKey(key, settings); err != nil {
+			return nil, fmt.Errorf("can not unmarshal activity worker settings for key %s: %w", key, err)
+		}
+
+		if appIdentity, err = cfg.GetAppIdentity(config); err != nil {
+			return nil, fmt.Errorf("can not get app identity: %w", err)
+		}
+
+		if settings.WorkerName == "" {
+			settings.WorkerName = appIdentity.Name
+		}
+
+		if activityName, err = GetActivityName(config, appIdentity, settings.ClientName, activityId); err != nil {
+			return nil, fmt.Errorf("can not get activity name: %w", err)
+		}
+
+		if client, err = ProvideClient(ctx, config, logger, settings.ClientName); err != nil {
+			return nil, fmt.Errorf("can not create sfn client %s: %w", settings.ClientName, err)
+		}
+
+		if handler, err = handlerFactory(ctx, config, logger); err != nil {
+			return nil, fmt.Errorf("can not create handler for activity %s: %w", activityId, err)
+		}
+
+		responder := NewTaskResponderWithInterfaces(logger, client)
+		worker := NewActivityWorkerWithInterfaces(logger, client, responder, clock.Provider, handler, "", settings)
+
+		if err = reslife.AddLifeCycleer(ctx, NewActivityLifecycleManager(settings.ClientName, activityName, &worker.activityArn)); err != nil {
+			return nil, fmt.Errorf("can not add lifecycle manager: %w", err)
+		}
+
+		return worker, nil
+	}
+}
+
+func NewActivityWorkerWithInterfaces(
+	logger log.Logger,
+	client Client,
+	responder TaskResponder,
+	clock clock.Clock,
+	handler ActivityHandler,
+	activityArn string,
+	settings *ActivityWorkerSettings,
+) *activityWorker {
+	return &activityWorker{
+		logger:      logger,
+		client:      client,
+		responder:   responder,
+		clock:       clock,
+		handler:     handler,
+		activityArn: activityArn,
+		settings:    settings,
+	}
+}
+
+func (w *activityWorker) Run(ctx context.Context) error {
+	for {
+		input := &sfn.GetActivityTaskInput{
+			ActivityArn: aws.String(w.activityArn),
+			WorkerName:  aws.String(w.settings.WorkerName),
+		}
+
+		// the call is long polling for up to a minute and returns an empty task token if there was no task
+		out, err := w.client.GetActivityTask(ctx, input)
+
+		if ctx.Err() != nil || exec.IsRequestCanceled(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("can not get task of activity %s: %w", w.activityArn, err)
+		}
+
+		if aws.ToString(out.TaskToken) == "" {
+			continue
+		}
+
+		w.handleTask(ctx, *out.TaskToken, aws.ToString(out.Input))
+	}
+}
+
+func (w *activityWorker) handleTask(ctx context.Context, taskToken string, input string) {
+	handlerCtx, stop := context.WithCancel(ctx)
+	wg := &sync.WaitGroup{}
+
+	if w.settings.HeartbeatInterval > 0 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			w.sendHeartbeats(handlerCtx, taskToken)
+		}()
+	}
+
+	output, err := w.handler.Handle(handlerCtx, []byte(input))
+
+	stop()
+	wg.Wait()
+
+	// the result is reported even if the worker is stopping, as the task would time out otherwise
+	ctx = context.WithoutCancel(ctx)
+
+	if err != nil {
+		w.logger.Warn(ctx, "task of activity %s failed: %s", w.activityArn, err)
+
+		if err = w.responder.SendTaskFailure(ctx, taskToken, err); err != nil {
+			w.logger.Error(ctx, "can not report the failed task of activity %s: %w", w.activityArn, err)
+		}
+
+		return
+	}
+
+	if err = w.responder.SendTaskSuccess(ctx, taskToken, output); err != nil {
+		w.logger.Error(ctx, "can not report the finished task of activity %s: %w", w.activityArn, err)
+	}
+}
+
+func (w *activityWorker) sendHeartbeats(ctx context.Context, taskToken string) {
+	ticker := w.clock.NewTicker(w.settings.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+			if err := w.responder.SendTaskHeartbeat(ctx, taskToken); err != nil && ctx.Err() == nil {
+				w.logger.Warn(ctx, "can not send heartbeat for task of activity %s: %s", w.activityArn, err)
+			}
+		}
+	}
+}
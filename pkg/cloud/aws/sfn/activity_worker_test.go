@@ -0,0 +1,103 @@
+package sfn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsSfn "github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sfn"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sfn/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const activityArn = "arn:aws:states:eu-central-1:000000000000:activity:resize"
+
+func TestActivityWorker_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+	responder := mocks.NewTaskResponder(t)
+	handler := mocks.NewActivityHandler(t)
+
+	getTaskInput := &awsSfn.GetActivityTaskInput{
+		ActivityArn: aws.String(activityArn),
+		WorkerName:  aws.String("worker"),
+	}
+
+	client.EXPECT().GetActivityTask(matcher.Context, getTaskInput).Return(&awsSfn.GetActivityTaskOutput{}, nil).Once()
+	client.EXPECT().GetActivityTask(matcher.Context, getTaskInput).Return(&awsSfn.GetActivityTaskOutput{
+		TaskToken: aws.String("token-1"),
+		Input:     aws.String(`{"id":1}`),
+	}, nil).Once()
+	client.EXPECT().GetActivityTask(matcher.Context, getTaskInput).Return(&awsSfn.GetActivityTaskOutput{
+		TaskToken: aws.String("token-2"),
+		Input:     aws.String(`{"id":2}`),
+	}, nil).Once()
+	client.EXPECT().GetActivityTask(matcher.Context, getTaskInput).Run(func(_ context.Context, _ *awsSfn.GetActivityTaskInput, _ ...func(*awsSfn.Options)) {
+		cancel()
+	}).Return(nil, context.Canceled).Once()
+
+	handler.EXPECT().Handle(matcher.Context, []byte(`{"id":1}`)).Return(map[string]int{"id": 1}, nil).Once()
+	handler.EXPECT().Handle(matcher.Context, []byte(`{"id":2}`)).Return(nil, fmt.Errorf("boom")).Once()
+
+	responder.EXPECT().SendTaskSuccess(matcher.Context, "token-1", map[string]int{"id": 1}).Return(nil).Once()
+	responder.EXPECT().SendTaskFailure(matcher.Context, "token-2", fmt.Errorf("boom")).Return(nil).Once()
+
+	worker := sfn.NewActivityWorkerWithInterfaces(logger, client, responder, clock.NewFakeClock(), handler, activityArn, &sfn.ActivityWorkerSettings{
+		WorkerName: "worker",
+	})
+
+	err := worker.Run(ctx)
+	assert.NoError(t, err)
+}
+
+func TestActivityWorker_RunHeartbeats(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+	responder := mocks.NewTaskResponder(t)
+	handler := mocks.NewActivityHandler(t)
+	fakeClock := clock.NewFakeClock()
+
+	client.EXPECT().GetActivityTask(matcher.Context, mock.Anything).Return(&awsSfn.GetActivityTaskOutput{
+		TaskToken: aws.String("token"),
+		Input:     aws.String(`{}`),
+	}, nil).Once()
+	client.EXPECT().GetActivityTask(matcher.Context, mock.Anything).Run(func(_ context.Context, _ *awsSfn.GetActivityTaskInput, _ ...func(*awsSfn.Options)) {
+		cancel()
+	}).Return(nil, context.Canceled).Once()
+
+	heartbeat := make(chan struct{})
+	responder.EXPECT().SendTaskHeartbeat(matcher.Context, "token").Run(func(_ context.Context, _ string) {
+		close(heartbeat)
+	}).Return(nil).Once()
+
+	handler.EXPECT().Handle(matcher.Context, []byte(`{}`)).RunAndReturn(func(_ context.Context, _ []byte) (any, error) {
+		fakeClock.BlockUntilTickers(1)
+		fakeClock.Advance(time.Minute)
+		<-heartbeat
+
+		return "done", nil
+	}).Once()
+
+	responder.EXPECT().SendTaskSuccess(matcher.Context, "token", "done").Return(nil).Once()
+
+	worker := sfn.NewActivityWorkerWithInterfaces(logger, client, responder, fakeClock, handler, activityArn, &sfn.ActivityWorkerSettings{
+		WorkerName:        "worker",
+		HeartbeatInterval: time.Minute,
+	})
+
+	err := worker.Run(ctx)
+	assert.NoError(t, err)
+}
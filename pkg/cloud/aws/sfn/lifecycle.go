@@ -0,0 +1,137 @@
+package sfn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/reslife"
+)
+
+const (
+	MetadataKeyActivities    = "cloud.aws.sfn.activities"
+	MetadataKeyStateMachines = "cloud.aws.sfn.state_machines"
+)
+
+type ActivityMetadata struct {
+	AwsClientName string `json:"aws_client_name"`
+	ActivityArn   string `json:"activity_arn"`
+	ActivityName  string `json:"activity_name"`
+}
+
+type StateMachineMetadata struct {
+	AwsClientName    string `json:"aws_client_name"`
+	StateMachineArn  string `json:"state_machine_arn"`
+	StateMachineName string `json:"state_machine_name"`
+}
+
+type activityLifecycleManager struct {
+	service      *Service
+	clientName   string
+	activityName string
+	activityArn  *string
+}
+
+func NewActivityLifecycleManager(clientName string, activityName string, activityArn *string) reslife.LifeCycleerFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (reslife.LifeCycleer, error) {
+		service, err := NewService(ctx, config, logger, clientName)
+		if err != nil {
+			return nil, fmt.Errorf("can not create sfn service: %w", err)
+		}
+
+		return &activityLifecycleManager{
+			service:      service,
+			clientName:   clientName,
+			activityName: activityName,
+			activityArn:  activityArn,
+		}, nil
+	}
+}
+
+func (l *activityLifecycleManager) GetId() string {
+	return fmt.Sprintf("sfn/activity/%s", l.activityName)
+}
+
+func (l *activityLifecycleManager) Create(ctx context.Context) error {
+	_, err := l.service.CreateActivity(ctx, l.activityName)
+
+	return err
+}
+
+func (l *activityLifecycleManager) Init(ctx context.Context) error {
+	var err error
+
+	if *l.activityArn, err = l.service.GetActivityArn(ctx, l.activityName); err != nil {
+		return fmt.Errorf("can not get arn of activity %s: %w", l.activityName, err)
+	}
+
+	if *l.activityArn == "" {
+		return fmt.Errorf("activity %s does not exist", l.activityName)
+	}
+
+	return nil
+}
+
+func (l *activityLifecycleManager) Register(ctx context.Context) (key string, metadata any, err error) {
+	metadata = ActivityMetadata{
+		AwsClientName: l.clientName,
+		ActivityArn:   *l.activityArn,
+		ActivityName:  l.activityName,
+	}
+
+	return MetadataKeyActivities, metadata, nil
+}
+
+type stateMachineLifecycleManager struct {
+	service          *Service
+	clientName       string
+	stateMachineName string
+	stateMachineArn  *string
+}
+
+// NewStateMachineLifecycleManager only discovers existing state machines, the state machines themselves are expected to
+// be deployed together with their definition.
+func NewStateMachineLifecycleManager(clientName string, stateMachineName string, stateMachineArn *string) reslife.LifeCycleerFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (reslife.LifeCycleer, error) {
+		service, err := NewService(ctx, config, logger, clientName)
+		if err != nil {
+			return nil, fmt.Errorf("can not create sfn service: %w", err)
+		}
+
+		return &stateMachineLifecycleManager{
+			service:          service,
+			clientName:       clientName,
+			stateMachineName: stateMachineName,
+			stateMachineArn:  stateMachineArn,
+		}, nil
+	}
+}
+
+func (l *stateMachineLifecycleManager) GetId() string {
+	return fmt.Sprintf("sfn/state-machine/%s", l.stateMachineName)
+}
+
+func (l *stateMachineLifecycleManager) Init(ctx context.Context) error {
+	var err error
+
+	if *l.stateMachineArn, err = l.service.GetStateMachineArn(ctx, l.stateMachineName); err != nil {
+		return fmt.Errorf("can not get arn of state machine %s: %w", l.stateMachineName, err)
+	}
+
+	if *l.stateMachineArn == "" {
+		return fmt.Errorf("state machine %s does not exist", l.stateMachineName)
+	}
+
+	return nil
+}
+
+func (l *stateMachineLifecycleManager) Register(ctx context.Context) (key string, metadata any, err error) {
+	metadata = StateMachineMetadata{
+		AwsClientName:    l.clientName,
+		StateMachineArn:  *l.stateMachineArn,
+		StateMachineName: l.stateMachineName,
+	}
+
+	return MetadataKeyStateMachines, metadata, nil
+}
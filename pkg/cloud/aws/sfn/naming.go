@@ -0,0 +1,73 @@
+package sfn
+
+import (
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws"
+)
+
+type NamingSettings struct {
+	StateMachinePattern string `cfg:"state_machine_pattern,nodecode" default:"{app.namespace}-{stateMachineId}"`
+	ActivityPattern     string `cfg:"activity_pattern,nodecode" default:"{app.namespace}-{activityId}"`
+	Delimiter           string `cfg:"delimiter" default:"-"`
+}
+
+func GetStateMachineName(config cfg.Config, identity cfg.Identity, clientName string, stateMachineId string) (string, error) {
+	namingSettings, err := readNamingSettings(config, clientName)
+	if err != nil {
+		return "", err
+	}
+
+	if err = identity.PadFromConfig(config); err != nil {
+		return "", fmt.Errorf("failed to pad app identity from config: %w", err)
+	}
+
+	name, err := identity.Format(namingSettings.StateMachinePattern, namingSettings.Delimiter, map[string]string{
+		"stateMachineId": stateMachineId,
+	})
+	if err != nil {
+		return "", fmt.Errorf("sfn state machine naming failed: %w", err)
+	}
+
+	return name, nil
+}
+
+func GetActivityName(config cfg.Config, identity cfg.Identity, clientName string, activityId string) (string, error) {
+	namingSettings, err := readNamingSettings(config, clientName)
+	if err != nil {
+		return "", err
+	}
+
+	if err = identity.PadFromConfig(config); err != nil {
+		return "", fmt.Errorf("failed to pad app identity from config: %w", err)
+	}
+
+	name, err := identity.Format(namingSettings.ActivityPattern, namingSettings.Delimiter, map[string]string{
+		"activityId": activityId,
+	})
+	if err != nil {
+		return "", fmt.Errorf("sfn activity naming failed: %w", err)
+	}
+
+	return name, nil
+}
+
+func readNamingSettings(config cfg.Config, clientName string) (*NamingSettings, error) {
+	if clientName == "" {
+		return nil, fmt.Errorf("the client name shouldn't be empty")
+	}
+
+	namingKey := fmt.Sprintf("%s.naming", aws.GetClientConfigKey("sfn", clientName))
+	defaultNamingKey := fmt.Sprintf("%s.naming", aws.GetClientConfigKey("sfn", "default"))
+
+	namingSettings := &NamingSettings{}
+	if err := config.UnmarshalKey(namingKey, namingSettings,
+		cfg.UnmarshalWithDefaultsFromKey(defaultNamingKey+".state_machine_pattern", "state_machine_pattern"),
+		cfg.UnmarshalWithDefaultsFromKey(defaultNamingKey+".activity_pattern", "activity_pattern"),
+	); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sfn naming settings for %s: %w", namingKey, err)
+	}
+
+	return namingSettings, nil
+}
@@ -0,0 +1,50 @@
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sfn"
+	"github.com/stretchr/testify/assert"
+)
+
+func newNamingConfig(t *testing.T, settings map[string]any) cfg.Config {
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":       "test",
+			"name":      "worker",
+			"namespace": "{app.tags.project}.{app.env}.{app.tags.family}",
+			"tags": map[string]any{
+				"project": "justtrack",
+				"family":  "gosoline",
+			},
+		},
+	})
+
+	err := config.Option(cfg.WithConfigMap(settings))
+	assert.NoError(t, err)
+
+	return config
+}
+
+func TestGetActivityName(t *testing.T) {
+	config := newNamingConfig(t, map[string]any{})
+
+	name, err := sfn.GetActivityName(config, cfg.Identity{}, "default", "resize")
+	assert.NoError(t, err)
+	assert.Equal(t, "justtrack-test-gosoline-resize", name)
+}
+
+func TestGetStateMachineNameWithDefaultPattern(t *testing.T) {
+	config := newNamingConfig(t, map[string]any{
+		"cloud.aws.sfn.clients.default.naming.state_machine_pattern": "{app.env}-{app.name}-{stateMachineId}",
+	})
+
+	name, err := sfn.GetStateMachineName(config, cfg.Identity{}, "other", "import")
+	assert.NoError(t, err)
+	assert.Equal(t, "test-worker-import", name)
+
+	name, err = sfn.GetActivityName(config, cfg.Identity{}, "other", "resize")
+	assert.NoError(t, err)
+	assert.Equal(t, "justtrack-test-gosoline-resize", name)
+}
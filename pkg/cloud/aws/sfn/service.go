@@ -0,0 +1,87 @@
+package sfn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type Service struct {
+	logger log.Logger
+	client Client
+}
+
+func NewService(ctx context.Context, config cfg.Config, logger log.Logger, clientName string) (*Service, error) {
+	client, err := ProvideClient(ctx, config, logger, clientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create sfn client %s: %w", clientName, err)
+	}
+
+	return NewServiceWithInterfaces(logger, client), nil
+}
+
+func NewServiceWithInterfaces(logger log.Logger, client Client) *Service {
+	return &Service{
+		logger: logger,
+		client: client,
+	}
+}
+
+// CreateActivity returns the arn of the activity and creates it if it doesn't exist yet.
+func (s *Service) CreateActivity(ctx context.Context, name string) (string, error) {
+	input := &sfn.CreateActivityInput{
+		Name: aws.String(name),
+	}
+
+	out, err := s.client.CreateActivity(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("can not create activity %s: %w", name, err)
+	}
+
+	return aws.ToString(out.ActivityArn), nil
+}
+
+// GetActivityArn discovers the arn of the activity by its name. It returns an empty arn if there is no such activity.
+func (s *Service) GetActivityArn(ctx context.Context, name string) (string, error) {
+	paginator := sfn.NewListActivitiesPaginator(s.client, &sfn.ListActivitiesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("can not list activities: %w", err)
+		}
+
+		for _, activity := range out.Activities {
+			if aws.ToString(activity.Name) == name {
+				return aws.ToString(activity.ActivityArn), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// GetStateMachineArn discovers the arn of the state machine by its name. It returns an empty arn if there is no such
+// state machine.
+func (s *Service) GetStateMachineArn(ctx context.Context, name string) (string, error) {
+	paginator := sfn.NewListStateMachinesPaginator(s.client, &sfn.ListStateMachinesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("can not list state machines: %w", err)
+		}
+
+		for _, stateMachine := range out.StateMachines {
+			if aws.ToString(stateMachine.Name) == name {
+				return aws.ToString(stateMachine.StateMachineArn), nil
+			}
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,97 @@
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	cloudAws "github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/reslife"
+)
+
+type Execution struct {
+	ExecutionArn string
+	StartDate    time.Time
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name StateMachine
+type StateMachine interface {
+	// StartExecution starts an execution with the json encoded input. The name of the execution is optional, starting
+	// a second execution with the same name and input is idempotent.
+	StartExecution(ctx context.Context, name string, input any) (*Execution, error)
+}
+
+type StateMachineSettings struct {
+	Identity       cfg.Identity
+	ClientName     string
+	StateMachineId string
+}
+
+type stateMachine struct {
+	logger          log.Logger
+	client          Client
+	stateMachineArn string
+}
+
+// NewStateMachine creates a state machine for an existing state machine, its arn is discovered by name on startup.
+func NewStateMachine(ctx context.Context, config cfg.Config, logger log.Logger, settings *StateMachineSettings) (*stateMachine, error) {
+	var err error
+	var client Client
+	var name string
+
+	if name, err = GetStateMachineName(config, settings.Identity, settings.ClientName, settings.StateMachineId); err != nil {
+		return nil, fmt.Errorf("can not get state machine name: %w", err)
+	}
+
+	if client, err = ProvideClient(ctx, config, logger, settings.ClientName); err != nil {
+		return nil, fmt.Errorf("can not create sfn client %s: %w", settings.ClientName, err)
+	}
+
+	machine := NewStateMachineWithInterfaces(logger, client, "")
+	if err = reslife.AddLifeCycleer(ctx, NewStateMachineLifecycleManager(settings.ClientName, name, &machine.stateMachineArn)); err != nil {
+		return nil, fmt.Errorf("can not add lifecycle manager: %w", err)
+	}
+
+	return machine, nil
+}
+
+func NewStateMachineWithInterfaces(logger log.Logger, client Client, stateMachineArn string) *stateMachine {
+	return &stateMachine{
+		logger:          logger,
+		client:          client,
+		stateMachineArn: stateMachineArn,
+	}
+}
+
+func (m *stateMachine) StartExecution(ctx context.Context, name string, input any) (*Execution, error) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("can not marshal execution input: %w", err)
+	}
+
+	params := &sfn.StartExecutionInput{
+		StateMachineArn: aws.String(m.stateMachineArn),
+		Input:           aws.String(string(body)),
+	}
+
+	if name != "" {
+		params.Name = aws.String(name)
+	}
+
+	ctx = cloudAws.WithResourceTarget(ctx, m.stateMachineArn)
+
+	out, err := m.client.StartExecution(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("can not start execution of state machine %s: %w", m.stateMachineArn, err)
+	}
+
+	return &Execution{
+		ExecutionArn: aws.ToString(out.ExecutionArn),
+		StartDate:    aws.ToTime(out.StartDate),
+	}, nil
+}
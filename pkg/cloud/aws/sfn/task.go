@@ -0,0 +1,134 @@
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	// DefaultTaskErrorCode is reported for failed tasks if the error isn't a *TaskError.
+	DefaultTaskErrorCode = "gosoline.TaskFailed"
+
+	maxTaskErrorCodeLength  = 256
+	maxTaskErrorCauseLength = 32768
+)
+
+// TaskError reports a failed task with the given code, which can be matched by the retry and catch rules of the
+// state machine.
+type TaskError struct {
+	Code string
+	Err  error
+}
+
+func NewTaskError(code string, err error) *TaskError {
+	return &TaskError{
+		Code: code,
+		Err:  err,
+	}
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Err)
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name TaskResponder
+type TaskResponder interface {
+	// SendTaskSuccess reports the json encoded output for the task token.
+	SendTaskSuccess(ctx context.Context, taskToken string, output any) error
+	// SendTaskFailure reports the error for the task token, see TaskError to control the error code.
+	SendTaskFailure(ctx context.Context, taskToken string, err error) error
+	SendTaskHeartbeat(ctx context.Context, taskToken string) error
+}
+
+type taskResponder struct {
+	logger log.Logger
+	client Client
+}
+
+func NewTaskResponder(ctx context.Context, config cfg.Config, logger log.Logger, clientName string) (TaskResponder, error) {
+	client, err := ProvideClient(ctx, config, logger, clientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create sfn client %s: %w", clientName, err)
+	}
+
+	return NewTaskResponderWithInterfaces(logger, client), nil
+}
+
+func NewTaskResponderWithInterfaces(logger log.Logger, client Client) TaskResponder {
+	return &taskResponder{
+		logger: logger,
+		client: client,
+	}
+}
+
+func (r *taskResponder) SendTaskSuccess(ctx context.Context, taskToken string, output any) error {
+	body, err := json.Marshal(output)
+	if err != nil {
+		return fmt.Errorf("can not marshal task output: %w", err)
+	}
+
+	input := &sfn.SendTaskSuccessInput{
+		TaskToken: aws.String(taskToken),
+		Output:    aws.String(string(body)),
+	}
+
+	if _, err = r.client.SendTaskSuccess(ctx, input); err != nil {
+		return fmt.Errorf("can not send task success: %w", err)
+	}
+
+	return nil
+}
+
+func (r *taskResponder) SendTaskFailure(ctx context.Context, taskToken string, err error) error {
+	code := DefaultTaskErrorCode
+	cause := err.Error()
+
+	taskErr := &TaskError{}
+	if errors.As(err, &taskErr) {
+		code = taskErr.Code
+		cause = taskErr.Err.Error()
+	}
+
+	input := &sfn.SendTaskFailureInput{
+		TaskToken: aws.String(taskToken),
+		Error:     aws.String(truncate(code, maxTaskErrorCodeLength)),
+		Cause:     aws.String(truncate(cause, maxTaskErrorCauseLength)),
+	}
+
+	if _, err = r.client.SendTaskFailure(ctx, input); err != nil {
+		return fmt.Errorf("can not send task failure: %w", err)
+	}
+
+	return nil
+}
+
+func (r *taskResponder) SendTaskHeartbeat(ctx context.Context, taskToken string) error {
+	input := &sfn.SendTaskHeartbeatInput{
+		TaskToken: aws.String(taskToken),
+	}
+
+	if _, err := r.client.SendTaskHeartbeat(ctx, input); err != nil {
+		return fmt.Errorf("can not send task heartbeat: %w", err)
+	}
+
+	return nil
+}
+
+func truncate(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+
+	return value[:length]
+}
@@ -0,0 +1,54 @@
+package sfn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsSfn "github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sfn"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sfn/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskResponder_SendTaskSuccess(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+
+	client.EXPECT().SendTaskSuccess(matcher.Context, &awsSfn.SendTaskSuccessInput{
+		TaskToken: aws.String("token"),
+		Output:    aws.String(`{"id":1}`),
+	}).Return(&awsSfn.SendTaskSuccessOutput{}, nil).Once()
+
+	responder := sfn.NewTaskResponderWithInterfaces(logger, client)
+	err := responder.SendTaskSuccess(t.Context(), "token", map[string]int{"id": 1})
+	assert.NoError(t, err)
+}
+
+func TestTaskResponder_SendTaskFailure(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := mocks.NewClient(t)
+
+	client.EXPECT().SendTaskFailure(matcher.Context, &awsSfn.SendTaskFailureInput{
+		TaskToken: aws.String("token"),
+		Error:     aws.String(sfn.DefaultTaskErrorCode),
+		Cause:     aws.String("boom"),
+	}).Return(&awsSfn.SendTaskFailureOutput{}, nil).Once()
+
+	client.EXPECT().SendTaskFailure(matcher.Context, &awsSfn.SendTaskFailureInput{
+		TaskToken: aws.String("token"),
+		Error:     aws.String("Resize.InvalidImage"),
+		Cause:     aws.String("not a png"),
+	}).Return(&awsSfn.SendTaskFailureOutput{}, nil).Once()
+
+	responder := sfn.NewTaskResponderWithInterfaces(logger, client)
+
+	err := responder.SendTaskFailure(t.Context(), "token", fmt.Errorf("boom"))
+	assert.NoError(t, err)
+
+	taskErr := sfn.NewTaskError("Resize.InvalidImage", fmt.Errorf("not a png"))
+	err = responder.SendTaskFailure(t.Context(), "token", fmt.Errorf("can not resize: %w", taskErr))
+	assert.NoError(t, err)
+}
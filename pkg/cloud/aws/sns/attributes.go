@@ -1,13 +1,16 @@
 package sns
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sns/types"
-	"github.com/justtrackio/gosoline/pkg/encoding/json"
+)
+
+const (
+	AttributeSnsMessageGroupId         = "snsMessageGroupId"
+	AttributeSnsMessageDeduplicationId = "snsMessageDeduplicationId"
 )
 
 func buildAttributes(attributes []map[string]string) (map[string]types.MessageAttributeValue, error) {
@@ -56,12 +59,3 @@ func IsValidAttributeName(name string) bool {
 
 	return validAttributeRegex.MatchString(name)
 }
-
-func buildFilterPolicy(attributes map[string]string) (string, error) {
-	bytes, err := json.Marshal(attributes)
-	if err != nil {
-		return "", fmt.Errorf("can not marshal attributes to json: %w", err)
-	}
-
-	return string(bytes), nil
-}
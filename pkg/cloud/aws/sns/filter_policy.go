@@ -0,0 +1,262 @@
+package sns
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+)
+
+const (
+	// FilterPolicyMaxAttributes is the maximum number of attributes sns accepts in a single filter policy.
+	FilterPolicyMaxAttributes = 5
+	// FilterPolicyMaxCombinations is the maximum number of value combinations sns accepts in a single filter policy.
+	FilterPolicyMaxCombinations = 150
+	// FilterPolicyMaxNumericValue is the largest absolute value sns accepts for numeric matching.
+	FilterPolicyMaxNumericValue = 1_000_000_000
+)
+
+const (
+	NumericOperatorEquals             = "="
+	NumericOperatorGreaterThan        = ">"
+	NumericOperatorGreaterThanOrEqual = ">="
+	NumericOperatorLessThan           = "<"
+	NumericOperatorLessThanOrEqual    = "<="
+)
+
+// A FilterPolicy restricts the messages delivered to a subscription. Every attribute maps to a list of conditions of
+// which at least one has to match; all attributes have to match for a message to be delivered.
+type FilterPolicy map[string][]any
+
+// A NumericCondition compares a numeric message attribute against a value.
+type NumericCondition struct {
+	Operator string
+	Value    float64
+}
+
+func GreaterThan(value float64) NumericCondition {
+	return NumericCondition{Operator: NumericOperatorGreaterThan, Value: value}
+}
+
+func GreaterThanOrEqual(value float64) NumericCondition {
+	return NumericCondition{Operator: NumericOperatorGreaterThanOrEqual, Value: value}
+}
+
+func LessThan(value float64) NumericCondition {
+	return NumericCondition{Operator: NumericOperatorLessThan, Value: value}
+}
+
+func LessThanOrEqual(value float64) NumericCondition {
+	return NumericCondition{Operator: NumericOperatorLessThanOrEqual, Value: value}
+}
+
+// FilterPolicyFromAttributes builds a policy which only delivers messages with exactly the given attribute values.
+func FilterPolicyFromAttributes(attributes map[string]string) FilterPolicy {
+	builder := NewFilterPolicyBuilder()
+
+	for _, key := range slices.Sorted(maps.Keys(attributes)) {
+		builder.Equals(key, attributes[key])
+	}
+
+	return builder.policy
+}
+
+// Validate checks the policy against the restrictions sns imposes on filter policies. An invalid policy would
+// otherwise only be rejected by sns when subscribing or, even worse, silently never match any message.
+func (p FilterPolicy) Validate() error {
+	var errs []error
+
+	if len(p) > FilterPolicyMaxAttributes {
+		errs = append(errs, fmt.Errorf("a filter policy can contain at most %d attributes, got %d", FilterPolicyMaxAttributes, len(p)))
+	}
+
+	combinations := 1
+
+	for _, attribute := range slices.Sorted(maps.Keys(p)) {
+		conditions := p[attribute]
+		combinations *= max(len(conditions), 1)
+
+		if !IsValidAttributeName(attribute) {
+			errs = append(errs, fmt.Errorf("the attribute name %q is not a valid sns attribute name", attribute))
+		}
+
+		if len(conditions) == 0 {
+			errs = append(errs, fmt.Errorf("the attribute %q has no conditions", attribute))
+		}
+
+		for _, condition := range conditions {
+			if err := validateFilterCondition(condition); err != nil {
+				errs = append(errs, fmt.Errorf("invalid condition for attribute %q: %w", attribute, err))
+			}
+		}
+	}
+
+	if combinations > FilterPolicyMaxCombinations {
+		errs = append(errs, fmt.Errorf("a filter policy can contain at most %d combinations, got %d", FilterPolicyMaxCombinations, combinations))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p FilterPolicy) MarshalToString() (string, error) {
+	bytes, err := json.Marshal(map[string][]any(p))
+	if err != nil {
+		return "", fmt.Errorf("can not marshal filter policy to json: %w", err)
+	}
+
+	return string(bytes), nil
+}
+
+// FilterPolicyBuilder assembles a FilterPolicy condition by condition. Calling multiple methods for the same
+// attribute adds alternatives, i.e. the attribute matches if any of its conditions matches.
+type FilterPolicyBuilder struct {
+	policy FilterPolicy
+}
+
+func NewFilterPolicyBuilder() *FilterPolicyBuilder {
+	return &FilterPolicyBuilder{
+		policy: FilterPolicy{},
+	}
+}
+
+// Equals matches messages where the attribute has one of the given values.
+func (b *FilterPolicyBuilder) Equals(attribute string, values ...string) *FilterPolicyBuilder {
+	for _, value := range values {
+		b.add(attribute, value)
+	}
+
+	return b
+}
+
+// NumericEquals matches messages where the numeric attribute is equal to the given value.
+func (b *FilterPolicyBuilder) NumericEquals(attribute string, value float64) *FilterPolicyBuilder {
+	return b.NumericRange(attribute, NumericCondition{Operator: NumericOperatorEquals, Value: value})
+}
+
+// NumericRange matches messages where the numeric attribute satisfies all given conditions,
+// e.g. NumericRange("price", GreaterThan(0), LessThanOrEqual(100)).
+func (b *FilterPolicyBuilder) NumericRange(attribute string, conditions ...NumericCondition) *FilterPolicyBuilder {
+	numeric := make([]any, 0, len(conditions)*2)
+
+	for _, condition := range conditions {
+		numeric = append(numeric, condition.Operator, condition.Value)
+	}
+
+	return b.add(attribute, map[string]any{"numeric": numeric})
+}
+
+// AnythingBut matches messages where the attribute has none of the given values.
+func (b *FilterPolicyBuilder) AnythingBut(attribute string, values ...string) *FilterPolicyBuilder {
+	return b.add(attribute, map[string]any{"anything-but": values})
+}
+
+// Prefix matches messages where the attribute starts with the given prefix.
+func (b *FilterPolicyBuilder) Prefix(attribute string, prefix string) *FilterPolicyBuilder {
+	return b.add(attribute, map[string]any{"prefix": prefix})
+}
+
+// Exists matches messages which have (or, if exists is false, do not have) the attribute.
+func (b *FilterPolicyBuilder) Exists(attribute string, exists bool) *FilterPolicyBuilder {
+	return b.add(attribute, map[string]any{"exists": exists})
+}
+
+// Build validates and returns the policy.
+func (b *FilterPolicyBuilder) Build() (FilterPolicy, error) {
+	if err := b.policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter policy: %w", err)
+	}
+
+	return b.policy, nil
+}
+
+func (b *FilterPolicyBuilder) add(attribute string, condition any) *FilterPolicyBuilder {
+	b.policy[attribute] = append(b.policy[attribute], condition)
+
+	return b
+}
+
+func validateFilterCondition(condition any) error {
+	if _, ok := condition.(string); ok {
+		return nil
+	}
+
+	operators, ok := condition.(map[string]any)
+	if !ok || len(operators) != 1 {
+		return fmt.Errorf("expected a string or a single operator, got %v", condition)
+	}
+
+	for operator, value := range operators {
+		switch operator {
+		case "anything-but":
+			if values, ok := value.([]string); !ok || len(values) == 0 {
+				return fmt.Errorf("anything-but requires at least one value")
+			}
+		case "prefix":
+			if prefix, ok := value.(string); !ok || prefix == "" {
+				return fmt.Errorf("prefix requires a non empty prefix")
+			}
+		case "exists":
+			if _, ok := value.(bool); !ok {
+				return fmt.Errorf("exists requires a boolean")
+			}
+		case "numeric":
+			numeric, ok := value.([]any)
+			if !ok {
+				return fmt.Errorf("numeric requires a list of operators and values")
+			}
+
+			return validateNumericCondition(numeric)
+		default:
+			return fmt.Errorf("unknown operator %q", operator)
+		}
+	}
+
+	return nil
+}
+
+func validateNumericCondition(numeric []any) error {
+	if len(numeric) == 0 || len(numeric) > 4 || len(numeric)%2 != 0 {
+		return fmt.Errorf("numeric requires one or two operator value pairs")
+	}
+
+	lower, upper := math.Inf(-1), math.Inf(1)
+
+	for i := 0; i < len(numeric); i += 2 {
+		operator, _ := numeric[i].(string)
+		value, ok := numeric[i+1].(float64)
+
+		if !ok {
+			return fmt.Errorf("numeric value %v is not a number", numeric[i+1])
+		}
+
+		if math.Abs(value) > FilterPolicyMaxNumericValue {
+			return fmt.Errorf("numeric value %v is out of the supported range of ±%d", value, FilterPolicyMaxNumericValue)
+		}
+
+		switch operator {
+		case NumericOperatorEquals:
+			if len(numeric) != 2 {
+				return fmt.Errorf("numeric equality can not be combined with other operators")
+			}
+		case NumericOperatorGreaterThan, NumericOperatorGreaterThanOrEqual:
+			lower = value
+		case NumericOperatorLessThan, NumericOperatorLessThanOrEqual:
+			upper = value
+		default:
+			return fmt.Errorf("unknown numeric operator %q", operator)
+		}
+	}
+
+	if len(numeric) == 4 && (math.IsInf(lower, -1) || math.IsInf(upper, 1)) {
+		return fmt.Errorf("a numeric range requires a lower and an upper bound")
+	}
+
+	if lower >= upper {
+		return fmt.Errorf("the numeric range is empty, the lower bound %v is not below the upper bound %v", lower, upper)
+	}
+
+	return nil
+}
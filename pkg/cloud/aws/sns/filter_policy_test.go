@@ -0,0 +1,95 @@
+package sns_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterPolicyBuilder(t *testing.T) {
+	policy, err := sns.NewFilterPolicyBuilder().
+		Equals("model", "user", "account").
+		AnythingBut("type", "delete").
+		NumericRange("version", sns.GreaterThanOrEqual(1), sns.LessThan(10)).
+		Prefix("source", "api-").
+		Exists("tenant", true).
+		Build()
+	assert.NoError(t, err)
+
+	actual, err := policy.MarshalToString()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"model": ["user", "account"],
+		"type": [{"anything-but": ["delete"]}],
+		"version": [{"numeric": [">=", 1, "<", 10]}],
+		"source": [{"prefix": "api-"}],
+		"tenant": [{"exists": true}]
+	}`, actual)
+}
+
+func TestFilterPolicyFromAttributes(t *testing.T) {
+	policy := sns.FilterPolicyFromAttributes(map[string]string{
+		"model":   "user",
+		"version": "1",
+	})
+
+	assert.Equal(t, sns.FilterPolicy{
+		"model":   {"user"},
+		"version": {"1"},
+	}, policy)
+	assert.NoError(t, policy.Validate())
+}
+
+func TestFilterPolicyValidate(t *testing.T) {
+	tests := map[string]struct {
+		builder *sns.FilterPolicyBuilder
+		err     string
+	}{
+		"invalid attribute name": {
+			builder: sns.NewFilterPolicyBuilder().Equals("aws.model", "user"),
+			err:     `the attribute name "aws.model" is not a valid sns attribute name`,
+		},
+		"too many attributes": {
+			builder: sns.NewFilterPolicyBuilder().Equals("a", "1").Equals("b", "1").Equals("c", "1").Equals("d", "1").Equals("e", "1").Equals("f", "1"),
+			err:     "a filter policy can contain at most 5 attributes, got 6",
+		},
+		"too many combinations": {
+			builder: sns.NewFilterPolicyBuilder().
+				Equals("a", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13").
+				Equals("b", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"),
+			err: "a filter policy can contain at most 150 combinations, got 156",
+		},
+		"empty anything-but": {
+			builder: sns.NewFilterPolicyBuilder().AnythingBut("type"),
+			err:     "anything-but requires at least one value",
+		},
+		"empty prefix": {
+			builder: sns.NewFilterPolicyBuilder().Prefix("source", ""),
+			err:     "prefix requires a non empty prefix",
+		},
+		"empty numeric range": {
+			builder: sns.NewFilterPolicyBuilder().NumericRange("version", sns.GreaterThan(10), sns.LessThanOrEqual(10)),
+			err:     "the numeric range is empty",
+		},
+		"numeric range without upper bound": {
+			builder: sns.NewFilterPolicyBuilder().NumericRange("version", sns.GreaterThan(1), sns.GreaterThan(2)),
+			err:     "a numeric range requires a lower and an upper bound",
+		},
+		"numeric value out of range": {
+			builder: sns.NewFilterPolicyBuilder().NumericEquals("price", 2e9),
+			err:     "is out of the supported range",
+		},
+		"unknown numeric operator": {
+			builder: sns.NewFilterPolicyBuilder().NumericRange("price", sns.NumericCondition{Operator: "!=", Value: 1}),
+			err:     `unknown numeric operator "!="`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := test.builder.Build()
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
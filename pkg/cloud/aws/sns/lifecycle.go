@@ -48,7 +48,7 @@ func (l *lifecycleManager) GetId() string {
 func (l *lifecycleManager) Create(ctx context.Context) error {
 	var err error
 
-	if *l.topicArn, err = l.service.CreateTopic(ctx, l.settings.TopicName); err != nil {
+	if *l.topicArn, err = l.service.CreateTopic(ctx, l.settings.TopicName, l.settings.Fifo); err != nil {
 		return fmt.Errorf("can not create topic %s: %w", l.settings.TopicName, err)
 	}
 
@@ -11,12 +11,14 @@ type TopicNameSettingsAware interface {
 	GetIdentity() cfg.Identity
 	GetClientName() string
 	GetTopicId() string
+	IsFifoEnabled() bool
 }
 
 type TopicNameSettings struct {
-	Identity   cfg.Identity
-	ClientName string
-	TopicId    string
+	Identity    cfg.Identity
+	ClientName  string
+	TopicId     string
+	FifoEnabled bool
 }
 
 func (s TopicNameSettings) GetIdentity() cfg.Identity {
@@ -31,6 +33,10 @@ func (s TopicNameSettings) GetTopicId() string {
 	return s.TopicId
 }
 
+func (s TopicNameSettings) IsFifoEnabled() bool {
+	return s.FifoEnabled
+}
+
 type TopicNamingSettings struct {
 	TopicPattern   string `cfg:"topic_pattern,nodecode" default:"{app.namespace}-{topicId}"`
 	TopicDelimiter string `cfg:"topic_delimiter" default:"-"`
@@ -61,5 +67,9 @@ func GetTopicName(config cfg.Config, topicSettings TopicNameSettingsAware) (stri
 		return "", fmt.Errorf("sns topic naming failed: %w", err)
 	}
 
+	if topicSettings.IsFifoEnabled() {
+		name += FifoSuffix
+	}
+
 	return name, nil
 }
@@ -135,3 +135,11 @@ func (s *GetTopicNameTestSuite) TestCustomDelimiter() {
 	s.NoError(err)
 	s.Equal("justtrack.test.gosoline.group.event", name)
 }
+
+func (s *GetTopicNameTestSuite) TestFifo() {
+	s.settings.FifoEnabled = true
+
+	name, err := sns.GetTopicName(s.config, s.settings)
+	s.NoError(err)
+	s.Equal("justtrack-test-gosoline-group-event.fifo", name)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
@@ -34,7 +35,7 @@ func NewServiceWithInterfaces(logger log.Logger, client Client) *Service {
 	return &Service{logger: logger, client: client}
 }
 
-func (s *Service) CreateTopic(ctx context.Context, topicName string) (string, error) {
+func (s *Service) CreateTopic(ctx context.Context, topicName string, fifo FifoSettings) (string, error) {
 	s.logger.WithFields(log.Fields{
 		"name": topicName,
 	}).Info(ctx, "looking for sns topic")
@@ -43,6 +44,13 @@ func (s *Service) CreateTopic(ctx context.Context, topicName string) (string, er
 		Name: aws.String(topicName),
 	}
 
+	if fifo.Enabled {
+		input.Attributes = map[string]string{
+			"FifoTopic":                 strconv.FormatBool(true),
+			"ContentBasedDeduplication": strconv.FormatBool(fifo.ContentBasedDeduplication),
+		}
+	}
+
 	var err error
 	var out *sns.CreateTopicOutput
 
@@ -58,13 +66,19 @@ func (s *Service) CreateTopic(ctx context.Context, topicName string) (string, er
 	return *out.TopicArn, nil
 }
 
-func (s *Service) SubscribeSqs(ctx context.Context, queueArn string, topicArn string, attributes map[string]string) error {
+// SubscribeSqs subscribes the queue to the topic. The filter policy is validated before subscribing, an existing
+// subscription of the queue with a different filter policy is replaced.
+func (s *Service) SubscribeSqs(ctx context.Context, queueArn string, topicArn string, filterPolicy FilterPolicy) error {
 	ctx = cloudAws.WithResourceTarget(ctx, topicArn)
 
 	var err error
 	var exists bool
 
-	if exists, err = s.subscriptionExists(ctx, queueArn, topicArn, attributes); err != nil {
+	if err = filterPolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid filter policy for the subscription of sqs queue arn %s to topic arn %s: %w", queueArn, topicArn, err)
+	}
+
+	if exists, err = s.subscriptionExists(ctx, queueArn, topicArn, filterPolicy); err != nil {
 		return fmt.Errorf("can not check if the subscription exists already: %w", err)
 	}
 
@@ -84,8 +98,8 @@ func (s *Service) SubscribeSqs(ctx context.Context, queueArn string, topicArn st
 		Protocol:   aws.String("sqs"),
 	}
 
-	if len(attributes) > 0 {
-		policy, err := buildFilterPolicy(attributes)
+	if len(filterPolicy) > 0 {
+		policy, err := filterPolicy.MarshalToString()
 		if err != nil {
 			return fmt.Errorf("can not build filter policy: %w", err)
 		}
@@ -105,7 +119,7 @@ func (s *Service) SubscribeSqs(ctx context.Context, queueArn string, topicArn st
 	return nil
 }
 
-func (s *Service) subscriptionExists(ctx context.Context, queueArn string, topicArn string, attributes map[string]string) (bool, error) {
+func (s *Service) subscriptionExists(ctx context.Context, queueArn string, topicArn string, filterPolicy FilterPolicy) (bool, error) {
 	var ok bool
 	var err error
 	var subscriptions []types.Subscription
@@ -119,7 +133,7 @@ func (s *Service) subscriptionExists(ctx context.Context, queueArn string, topic
 			continue
 		}
 
-		if ok, err = s.subscriptionAttributesMatch(ctx, subscription.SubscriptionArn, attributes); err != nil {
+		if ok, err = s.subscriptionAttributesMatch(ctx, subscription.SubscriptionArn, filterPolicy); err != nil {
 			return false, err
 		}
 
@@ -168,7 +182,7 @@ func (t *Service) listSubscriptions(ctx context.Context, topicArn string) ([]typ
 	return subscriptions, nil
 }
 
-func (s *Service) subscriptionAttributesMatch(ctx context.Context, subscriptionArn *string, attributes map[string]string) (bool, error) {
+func (s *Service) subscriptionAttributesMatch(ctx context.Context, subscriptionArn *string, filterPolicy FilterPolicy) (bool, error) {
 	var ok bool
 	var err error
 	var subAttributes map[string]string
@@ -190,7 +204,7 @@ func (s *Service) subscriptionAttributesMatch(ctx context.Context, subscriptionA
 
 	// we have to marshal and unmarshal this to cover the behavior of getting float64 for all numbers,
 	// if we unmarshal something into a map[string]any
-	if expectedFilterPolicy, err = json.Marshal(attributes); err != nil {
+	if expectedFilterPolicy, err = json.Marshal(filterPolicy); err != nil {
 		return false, fmt.Errorf("can not marshal expected filter policy: %w", err)
 	}
 
@@ -198,11 +212,31 @@ func (s *Service) subscriptionAttributesMatch(ctx context.Context, subscriptionA
 		return false, fmt.Errorf("can not unmarshal expected filter policy: %w", err)
 	}
 
-	matches := reflect.DeepEqual(expectedAttributes, actualAttributes)
+	matches := reflect.DeepEqual(normalizeFilterPolicy(expectedAttributes), normalizeFilterPolicy(actualAttributes))
 
 	return matches, nil
 }
 
+// normalizeFilterPolicy wraps single values into lists, so {"model":"goso"} (the format of older subscriptions)
+// and {"model":["goso"]} are considered to be the same policy. Empty policies are equal to no policy.
+func normalizeFilterPolicy(policy map[string]any) map[string]any {
+	if len(policy) == 0 {
+		return nil
+	}
+
+	normalized := make(map[string]any, len(policy))
+
+	for key, value := range policy {
+		if _, ok := value.([]any); !ok {
+			value = []any{value}
+		}
+
+		normalized[key] = value
+	}
+
+	return normalized
+}
+
 func (s *Service) getSubscriptionAttributes(ctx context.Context, subscriptionArn *string) (map[string]string, error) {
 	input := &sns.GetSubscriptionAttributesInput{
 		SubscriptionArn: subscriptionArn,
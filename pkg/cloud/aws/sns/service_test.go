@@ -42,7 +42,7 @@ func (s *ServiceTestSuite) TestSubscribeSqs() {
 
 	subInput := &awsSns.SubscribeInput{
 		Attributes: map[string]string{
-			"FilterPolicy": `{"model":"goso","version":"1"}`,
+			"FilterPolicy": `{"model":["goso"],"version":["1"]}`,
 		},
 		TopicArn: aws.String("topicArn"),
 		Protocol: aws.String("sqs"),
@@ -50,10 +50,10 @@ func (s *ServiceTestSuite) TestSubscribeSqs() {
 	}
 	s.client.EXPECT().Subscribe(matcher.Context, subInput).Return(nil, nil).Once()
 
-	err := s.service.SubscribeSqs(s.ctx, "queueArn", "topicArn", map[string]string{
+	err := s.service.SubscribeSqs(s.ctx, "queueArn", "topicArn", gosoSns.FilterPolicyFromAttributes(map[string]string{
 		"model":   "goso",
 		"version": "1",
-	})
+	}))
 	s.NoError(err)
 }
 
@@ -78,10 +78,11 @@ func (s *ServiceTestSuite) TestSubscribeSqsExists() {
 	}
 	s.client.EXPECT().GetSubscriptionAttributes(matcher.Context, getAttributesInput).Return(getAttributesOutput, nil).Once()
 
-	err := s.service.SubscribeSqs(s.T().Context(), "queueArn", "topicArn", map[string]string{
+	// subscriptions created by older versions contain single values instead of lists
+	err := s.service.SubscribeSqs(s.T().Context(), "queueArn", "topicArn", gosoSns.FilterPolicyFromAttributes(map[string]string{
 		"model":   "goso",
 		"version": "1",
-	})
+	}))
 	s.NoError(err)
 }
 
@@ -112,7 +113,7 @@ func (s *ServiceTestSuite) TestSubscribeSqsExistsWithDifferentAttributes() {
 
 	subInput := &awsSns.SubscribeInput{
 		Attributes: map[string]string{
-			"FilterPolicy": `{"model":"goso"}`,
+			"FilterPolicy": `{"model":["goso"]}`,
 		},
 		Endpoint: aws.String("queueArn"),
 		Protocol: aws.String("sqs"),
@@ -120,10 +121,9 @@ func (s *ServiceTestSuite) TestSubscribeSqsExistsWithDifferentAttributes() {
 	}
 	s.client.EXPECT().Subscribe(matcher.Context, subInput).Return(nil, nil).Once()
 
-	err := s.service.SubscribeSqs(s.T().Context(), "queueArn", "topicArn", map[string]string{
-		// err := s.topic.SubscribeSqs(s.ctx, "queueArn", map[string]any{
+	err := s.service.SubscribeSqs(s.T().Context(), "queueArn", "topicArn", gosoSns.FilterPolicyFromAttributes(map[string]string{
 		"model": "goso",
-	})
+	}))
 	s.NoError(err)
 }
 
@@ -142,6 +142,21 @@ func (s *ServiceTestSuite) TestSubscribeSqsError() {
 	}
 	s.client.EXPECT().Subscribe(matcher.Context, subInput).Return(nil, subErr).Once()
 
-	err := s.service.SubscribeSqs(s.ctx, "queueArn", "topicArn", map[string]string{})
+	err := s.service.SubscribeSqs(s.ctx, "queueArn", "topicArn", nil)
 	s.EqualError(err, "could not subscribe to topic arn topicArn for sqs queue arn queueArn: subscribe error")
 }
+
+func (s *ServiceTestSuite) TestSubscribeSqsInvalidFilterPolicy() {
+	filterPolicy := gosoSns.NewFilterPolicyBuilder().
+		NumericRange("price", gosoSns.GreaterThan(10), gosoSns.LessThan(5)).
+		Prefix("model", "")
+
+	policy, err := filterPolicy.Build()
+	s.Error(err)
+	s.Nil(policy)
+
+	err = s.service.SubscribeSqs(s.ctx, "queueArn", "topicArn", gosoSns.FilterPolicy{
+		"price": {map[string]any{"numeric": []any{">", float64(10), "<", float64(5)}}},
+	})
+	s.ErrorContains(err, "the numeric range is empty")
+}
@@ -19,6 +19,7 @@ import (
 const (
 	MaxBatchSize      = 10
 	MetadataKeyTopics = "cloud.aws.sns.topics"
+	FifoSuffix        = ".fifo"
 )
 
 //go:generate go run github.com/vektra/mockery/v2 --name Topic
@@ -33,15 +34,22 @@ type TopicMetadata struct {
 	TopicName     string `json:"topic_name"`
 }
 
+type FifoSettings struct {
+	Enabled                   bool `cfg:"enabled" default:"false"`
+	ContentBasedDeduplication bool `cfg:"content_based_deduplication" default:"false"`
+}
+
 type TopicSettings struct {
 	TopicName  string
 	ClientName string
+	Fifo       FifoSettings
 }
 
 type snsTopic struct {
 	logger   log.Logger
 	client   Client
 	topicArn string
+	fifo     FifoSettings
 }
 
 func NewTopic(ctx context.Context, config cfg.Config, logger log.Logger, settings *TopicSettings) (*snsTopic, error) {
@@ -52,7 +60,7 @@ func NewTopic(ctx context.Context, config cfg.Config, logger log.Logger, setting
 		return nil, fmt.Errorf("can not create sns client %s: %w", settings.ClientName, err)
 	}
 
-	topic := NewTopicWithInterfaces(logger, client, "")
+	topic := NewTopicWithInterfaces(logger, client, "", settings.Fifo)
 	if err = reslife.AddLifeCycleer(ctx, NewLifecycleManager(settings, &topic.topicArn)); err != nil {
 		return nil, fmt.Errorf("can not add lifecycle manager: %w", err)
 	}
@@ -60,11 +68,12 @@ func NewTopic(ctx context.Context, config cfg.Config, logger log.Logger, setting
 	return topic, nil
 }
 
-func NewTopicWithInterfaces(logger log.Logger, client Client, topicArn string) *snsTopic {
+func NewTopicWithInterfaces(logger log.Logger, client Client, topicArn string, fifo FifoSettings) *snsTopic {
 	return &snsTopic{
 		logger:   logger,
 		client:   client,
 		topicArn: topicArn,
+		fifo:     fifo,
 	}
 }
 
@@ -74,10 +83,17 @@ func (t *snsTopic) Publish(ctx context.Context, msg string, attributes ...map[st
 		return fmt.Errorf("can not build message attributes: %w", err)
 	}
 
+	groupId, deduplicationId, err := t.getFifoIds(attributes)
+	if err != nil {
+		return err
+	}
+
 	input := &sns.PublishInput{
-		TopicArn:          &t.topicArn,
-		Message:           aws.String(msg),
-		MessageAttributes: inputAttributes,
+		TopicArn:               &t.topicArn,
+		Message:                aws.String(msg),
+		MessageAttributes:      inputAttributes,
+		MessageGroupId:         groupId,
+		MessageDeduplicationId: deduplicationId,
 	}
 
 	ctx = cloudAws.WithResourceTarget(ctx, t.topicArn)
@@ -169,12 +185,47 @@ func (t *snsTopic) computeEntries(messages []string, attributes []map[string]str
 			return nil, fmt.Errorf("could not build attributes for message %d: %w", i, err)
 		}
 
+		groupId, deduplicationId, err := t.getFifoIds([]map[string]string{attributes[i]})
+		if err != nil {
+			return nil, fmt.Errorf("message %d: %w", i, err)
+		}
+
 		result[i] = types.PublishBatchRequestEntry{
-			Id:                mdl.Box(strconv.Itoa(i)),
-			Message:           &messages[i],
-			MessageAttributes: messageAttributes,
+			Id:                     mdl.Box(strconv.Itoa(i)),
+			Message:                &messages[i],
+			MessageAttributes:      messageAttributes,
+			MessageGroupId:         groupId,
+			MessageDeduplicationId: deduplicationId,
 		}
 	}
 
 	return result, nil
 }
+
+// getFifoIds reads the message group and deduplication id from the message attributes. Messages published to a fifo
+// topic require a group id and, unless content based deduplication is enabled, a deduplication id.
+func (t *snsTopic) getFifoIds(attributes []map[string]string) (groupId *string, deduplicationId *string, err error) {
+	if !t.fifo.Enabled {
+		return nil, nil, nil
+	}
+
+	for _, attrs := range attributes {
+		if id, ok := attrs[AttributeSnsMessageGroupId]; ok {
+			groupId = aws.String(id)
+		}
+
+		if id, ok := attrs[AttributeSnsMessageDeduplicationId]; ok {
+			deduplicationId = aws.String(id)
+		}
+	}
+
+	if groupId == nil {
+		return nil, nil, fmt.Errorf("messages published to fifo topic %s require the attribute %s", t.topicArn, AttributeSnsMessageGroupId)
+	}
+
+	if deduplicationId == nil && !t.fifo.ContentBasedDeduplication {
+		return nil, nil, fmt.Errorf("messages published to fifo topic %s without content based deduplication require the attribute %s", t.topicArn, AttributeSnsMessageDeduplicationId)
+	}
+
+	return groupId, deduplicationId, nil
+}
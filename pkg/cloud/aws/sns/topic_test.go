@@ -32,7 +32,7 @@ func (s *TopicTestSuite) SetupTest() {
 
 	s.ctx = s.T().Context()
 	s.client = gosoSnsMocks.NewClient(s.T())
-	s.topic = gosoSns.NewTopicWithInterfaces(logger, s.client, "topicArn")
+	s.topic = gosoSns.NewTopicWithInterfaces(logger, s.client, "topicArn", gosoSns.FifoSettings{})
 }
 
 func (s *TopicTestSuite) TestPublish() {
@@ -106,3 +106,64 @@ func (s *TopicTestSuite) TestPublishBatch() {
 	err := s.topic.PublishBatch(s.ctx, messages, attributes)
 	s.NoError(err)
 }
+
+func (s *TopicTestSuite) TestPublishFifo() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	topic := gosoSns.NewTopicWithInterfaces(logger, s.client, "topicArn.fifo", gosoSns.FifoSettings{Enabled: true})
+
+	input := &awsSns.PublishInput{
+		TopicArn: aws.String("topicArn.fifo"),
+		Message:  aws.String("test"),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			gosoSns.AttributeSnsMessageGroupId: {
+				DataType:    aws.String("String"),
+				StringValue: aws.String("group"),
+			},
+			gosoSns.AttributeSnsMessageDeduplicationId: {
+				DataType:    aws.String("String"),
+				StringValue: aws.String("dedup"),
+			},
+		},
+		MessageGroupId:         aws.String("group"),
+		MessageDeduplicationId: aws.String("dedup"),
+	}
+
+	s.client.EXPECT().Publish(matcher.Context, input).Return(nil, nil).Once()
+
+	err := topic.Publish(s.ctx, "test", map[string]string{
+		gosoSns.AttributeSnsMessageGroupId:         "group",
+		gosoSns.AttributeSnsMessageDeduplicationId: "dedup",
+	})
+	s.NoError(err)
+}
+
+func (s *TopicTestSuite) TestPublishFifoMissingIds() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+
+	topic := gosoSns.NewTopicWithInterfaces(logger, s.client, "topicArn.fifo", gosoSns.FifoSettings{Enabled: true})
+	err := topic.Publish(s.ctx, "test")
+	s.ErrorContains(err, gosoSns.AttributeSnsMessageGroupId)
+
+	err = topic.Publish(s.ctx, "test", map[string]string{
+		gosoSns.AttributeSnsMessageGroupId: "group",
+	})
+	s.ErrorContains(err, gosoSns.AttributeSnsMessageDeduplicationId)
+
+	topic = gosoSns.NewTopicWithInterfaces(logger, s.client, "topicArn.fifo", gosoSns.FifoSettings{Enabled: true, ContentBasedDeduplication: true})
+	s.client.EXPECT().Publish(matcher.Context, &awsSns.PublishInput{
+		TopicArn: aws.String("topicArn.fifo"),
+		Message:  aws.String("test"),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			gosoSns.AttributeSnsMessageGroupId: {
+				DataType:    aws.String("String"),
+				StringValue: aws.String("group"),
+			},
+		},
+		MessageGroupId: aws.String("group"),
+	}).Return(nil, nil).Once()
+
+	err = topic.Publish(s.ctx, "test", map[string]string{
+		gosoSns.AttributeSnsMessageGroupId: "group",
+	})
+	s.NoError(err)
+}
@@ -22,6 +22,80 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// CancelMessageMoveTask provides a mock function with given fields: ctx, params, optFns
+func (_m *Client) CancelMessageMoveTask(ctx context.Context, params *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelMessageMoveTask")
+	}
+
+	var r0 *sqs.CancelMessageMoveTaskOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sqs.CancelMessageMoveTaskInput, ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sqs.CancelMessageMoveTaskInput, ...func(*sqs.Options)) *sqs.CancelMessageMoveTaskOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.CancelMessageMoveTaskOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sqs.CancelMessageMoveTaskInput, ...func(*sqs.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_CancelMessageMoveTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelMessageMoveTask'
+type Client_CancelMessageMoveTask_Call struct {
+	*mock.Call
+}
+
+// CancelMessageMoveTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.CancelMessageMoveTaskInput
+//   - optFns ...func(*sqs.Options)
+func (_e *Client_Expecter) CancelMessageMoveTask(ctx interface{}, params interface{}, optFns ...interface{}) *Client_CancelMessageMoveTask_Call {
+	return &Client_CancelMessageMoveTask_Call{Call: _e.mock.On("CancelMessageMoveTask",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *Client_CancelMessageMoveTask_Call) Run(run func(ctx context.Context, params *sqs.CancelMessageMoveTaskInput, optFns ...func(*sqs.Options))) *Client_CancelMessageMoveTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*sqs.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*sqs.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*sqs.CancelMessageMoveTaskInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_CancelMessageMoveTask_Call) Return(_a0 *sqs.CancelMessageMoveTaskOutput, _a1 error) *Client_CancelMessageMoveTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_CancelMessageMoveTask_Call) RunAndReturn(run func(context.Context, *sqs.CancelMessageMoveTaskInput, ...func(*sqs.Options)) (*sqs.CancelMessageMoveTaskOutput, error)) *Client_CancelMessageMoveTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateQueue provides a mock function with given fields: ctx, params, optFns
 func (_m *Client) CreateQueue(ctx context.Context, params *sqs.CreateQueueInput, optFns ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -392,6 +466,80 @@ func (_c *Client_GetQueueUrl_Call) RunAndReturn(run func(context.Context, *sqs.G
 	return _c
 }
 
+// ListMessageMoveTasks provides a mock function with given fields: ctx, params, optFns
+func (_m *Client) ListMessageMoveTasks(ctx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMessageMoveTasks")
+	}
+
+	var r0 *sqs.ListMessageMoveTasksOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sqs.ListMessageMoveTasksInput, ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sqs.ListMessageMoveTasksInput, ...func(*sqs.Options)) *sqs.ListMessageMoveTasksOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.ListMessageMoveTasksOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sqs.ListMessageMoveTasksInput, ...func(*sqs.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_ListMessageMoveTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMessageMoveTasks'
+type Client_ListMessageMoveTasks_Call struct {
+	*mock.Call
+}
+
+// ListMessageMoveTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.ListMessageMoveTasksInput
+//   - optFns ...func(*sqs.Options)
+func (_e *Client_Expecter) ListMessageMoveTasks(ctx interface{}, params interface{}, optFns ...interface{}) *Client_ListMessageMoveTasks_Call {
+	return &Client_ListMessageMoveTasks_Call{Call: _e.mock.On("ListMessageMoveTasks",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *Client_ListMessageMoveTasks_Call) Run(run func(ctx context.Context, params *sqs.ListMessageMoveTasksInput, optFns ...func(*sqs.Options))) *Client_ListMessageMoveTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*sqs.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*sqs.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*sqs.ListMessageMoveTasksInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_ListMessageMoveTasks_Call) Return(_a0 *sqs.ListMessageMoveTasksOutput, _a1 error) *Client_ListMessageMoveTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_ListMessageMoveTasks_Call) RunAndReturn(run func(context.Context, *sqs.ListMessageMoveTasksInput, ...func(*sqs.Options)) (*sqs.ListMessageMoveTasksOutput, error)) *Client_ListMessageMoveTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListQueues provides a mock function with given fields: ctx, params, optFns
 func (_m *Client) ListQueues(ctx context.Context, params *sqs.ListQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error) {
 	_va := make([]interface{}, len(optFns))
@@ -836,6 +984,80 @@ func (_c *Client_SetQueueAttributes_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// StartMessageMoveTask provides a mock function with given fields: ctx, params, optFns
+func (_m *Client) StartMessageMoveTask(ctx context.Context, params *sqs.StartMessageMoveTaskInput, optFns ...func(*sqs.Options)) (*sqs.StartMessageMoveTaskOutput, error) {
+	_va := make([]interface{}, len(optFns))
+	for _i := range optFns {
+		_va[_i] = optFns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartMessageMoveTask")
+	}
+
+	var r0 *sqs.StartMessageMoveTaskOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sqs.StartMessageMoveTaskInput, ...func(*sqs.Options)) (*sqs.StartMessageMoveTaskOutput, error)); ok {
+		return rf(ctx, params, optFns...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sqs.StartMessageMoveTaskInput, ...func(*sqs.Options)) *sqs.StartMessageMoveTaskOutput); ok {
+		r0 = rf(ctx, params, optFns...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqs.StartMessageMoveTaskOutput)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sqs.StartMessageMoveTaskInput, ...func(*sqs.Options)) error); ok {
+		r1 = rf(ctx, params, optFns...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_StartMessageMoveTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartMessageMoveTask'
+type Client_StartMessageMoveTask_Call struct {
+	*mock.Call
+}
+
+// StartMessageMoveTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *sqs.StartMessageMoveTaskInput
+//   - optFns ...func(*sqs.Options)
+func (_e *Client_Expecter) StartMessageMoveTask(ctx interface{}, params interface{}, optFns ...interface{}) *Client_StartMessageMoveTask_Call {
+	return &Client_StartMessageMoveTask_Call{Call: _e.mock.On("StartMessageMoveTask",
+		append([]interface{}{ctx, params}, optFns...)...)}
+}
+
+func (_c *Client_StartMessageMoveTask_Call) Run(run func(ctx context.Context, params *sqs.StartMessageMoveTaskInput, optFns ...func(*sqs.Options))) *Client_StartMessageMoveTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(*sqs.Options), len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(*sqs.Options))
+			}
+		}
+		run(args[0].(context.Context), args[1].(*sqs.StartMessageMoveTaskInput), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_StartMessageMoveTask_Call) Return(_a0 *sqs.StartMessageMoveTaskOutput, _a1 error) *Client_StartMessageMoveTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_StartMessageMoveTask_Call) RunAndReturn(run func(context.Context, *sqs.StartMessageMoveTaskInput, ...func(*sqs.Options)) (*sqs.StartMessageMoveTaskOutput, error)) *Client_StartMessageMoveTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClient(t interface {
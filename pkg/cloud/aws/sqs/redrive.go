@@ -0,0 +1,233 @@
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+)
+
+const (
+	RedriveStatusRunning    = "RUNNING"
+	RedriveStatusCompleted  = "COMPLETED"
+	RedriveStatusCancelling = "CANCELLING"
+	RedriveStatusCancelled  = "CANCELLED"
+	RedriveStatusFailed     = "FAILED"
+)
+
+type RedriveSettings struct {
+	ClientName string `cfg:"client_name" default:"default"`
+	// DeadLetterQueueName is the name of the queue the messages are moved from.
+	DeadLetterQueueName string `cfg:"dead_letter_queue_name" validate:"required"`
+	// DestinationQueueName defaults to the queue the messages were originally sent to.
+	DestinationQueueName string `cfg:"destination_queue_name"`
+	// MaxMessagesPerSecond limits the rate the messages are moved with, 0 lets sqs optimize the rate.
+	MaxMessagesPerSecond int32 `cfg:"max_messages_per_second" default:"0" validate:"min=0,max=500"`
+	// ProgressInterval is the interval in which the progress of the redrive is polled.
+	ProgressInterval time.Duration `cfg:"progress_interval" default:"10s"`
+}
+
+type RedriveProgress struct {
+	TaskHandle     string
+	Status         string
+	MessagesMoved  int64
+	MessagesToMove int64
+	FailureReason  string
+}
+
+func (p *RedriveProgress) IsFinished() bool {
+	return p.Status == RedriveStatusCompleted || p.Status == RedriveStatusCancelled || p.Status == RedriveStatusFailed
+}
+
+// A Redriver moves the messages of a dead letter queue back to their source queue using sqs message move tasks.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name Redriver
+type Redriver interface {
+	// Start starts a message move task and returns the handle of the task.
+	Start(ctx context.Context, settings *RedriveSettings) (string, error)
+	// Progress returns the progress of the most recent message move task of the dead letter queue.
+	Progress(ctx context.Context, deadLetterQueueName string) (*RedriveProgress, error)
+	Cancel(ctx context.Context, taskHandle string) error
+	// Redrive starts a message move task and blocks until it is finished, reporting its progress to onProgress in the
+	// configured interval. The task is cancelled if the context gets canceled.
+	Redrive(ctx context.Context, settings *RedriveSettings, onProgress func(progress *RedriveProgress)) (*RedriveProgress, error)
+}
+
+type redriver struct {
+	logger   log.Logger
+	client   Client
+	resolver PropertiesResolver
+	clock    clock.Clock
+}
+
+func NewRedriver(ctx context.Context, config cfg.Config, logger log.Logger, clientName string, optFns ...ClientOption) (Redriver, error) {
+	var err error
+	var client Client
+
+	if client, err = ProvideClient(ctx, config, logger, clientName, optFns...); err != nil {
+		return nil, fmt.Errorf("can not create sqs client %s: %w", clientName, err)
+	}
+
+	return NewRedriverWithInterfaces(logger, client, NewPropertiesResolverWithInterfaces(client), clock.Provider), nil
+}
+
+func NewRedriverWithInterfaces(logger log.Logger, client Client, resolver PropertiesResolver, clock clock.Clock) Redriver {
+	return &redriver{
+		logger:   logger,
+		client:   client,
+		resolver: resolver,
+		clock:    clock,
+	}
+}
+
+func (r *redriver) Start(ctx context.Context, settings *RedriveSettings) (string, error) {
+	var err error
+	var source, destination *Properties
+	var out *sqs.StartMessageMoveTaskOutput
+
+	if source, err = r.getProperties(ctx, settings.DeadLetterQueueName); err != nil {
+		return "", fmt.Errorf("can not get properties of dead letter queue: %w", err)
+	}
+
+	input := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(source.Arn),
+	}
+
+	if settings.DestinationQueueName != "" {
+		if destination, err = r.getProperties(ctx, settings.DestinationQueueName); err != nil {
+			return "", fmt.Errorf("can not get properties of destination queue: %w", err)
+		}
+
+		input.DestinationArn = aws.String(destination.Arn)
+	}
+
+	if settings.MaxMessagesPerSecond > 0 {
+		input.MaxNumberOfMessagesPerSecond = aws.Int32(settings.MaxMessagesPerSecond)
+	}
+
+	if out, err = r.client.StartMessageMoveTask(ctx, input); err != nil {
+		return "", fmt.Errorf("can not start message move task for queue %s: %w", settings.DeadLetterQueueName, err)
+	}
+
+	r.logger.Info(ctx, "started redrive of queue %s", settings.DeadLetterQueueName)
+
+	return mdl.EmptyIfNil(out.TaskHandle), nil
+}
+
+func (r *redriver) Progress(ctx context.Context, deadLetterQueueName string) (*RedriveProgress, error) {
+	var err error
+	var source *Properties
+	var out *sqs.ListMessageMoveTasksOutput
+
+	if source, err = r.getProperties(ctx, deadLetterQueueName); err != nil {
+		return nil, fmt.Errorf("can not get properties of dead letter queue: %w", err)
+	}
+
+	input := &sqs.ListMessageMoveTasksInput{
+		SourceArn:  aws.String(source.Arn),
+		MaxResults: aws.Int32(1),
+	}
+
+	if out, err = r.client.ListMessageMoveTasks(ctx, input); err != nil {
+		return nil, fmt.Errorf("can not list message move tasks of queue %s: %w", deadLetterQueueName, err)
+	}
+
+	if len(out.Results) == 0 {
+		return nil, fmt.Errorf("there is no message move task for queue %s", deadLetterQueueName)
+	}
+
+	task := out.Results[0]
+
+	return &RedriveProgress{
+		TaskHandle:     mdl.EmptyIfNil(task.TaskHandle),
+		Status:         mdl.EmptyIfNil(task.Status),
+		MessagesMoved:  task.ApproximateNumberOfMessagesMoved,
+		MessagesToMove: mdl.EmptyIfNil(task.ApproximateNumberOfMessagesToMove),
+		FailureReason:  mdl.EmptyIfNil(task.FailureReason),
+	}, nil
+}
+
+func (r *redriver) Cancel(ctx context.Context, taskHandle string) error {
+	input := &sqs.CancelMessageMoveTaskInput{
+		TaskHandle: aws.String(taskHandle),
+	}
+
+	if _, err := r.client.CancelMessageMoveTask(ctx, input); err != nil {
+		return fmt.Errorf("can not cancel message move task: %w", err)
+	}
+
+	return nil
+}
+
+func (r *redriver) Redrive(ctx context.Context, settings *RedriveSettings, onProgress func(progress *RedriveProgress)) (*RedriveProgress, error) {
+	var err error
+	var taskHandle string
+	var progress *RedriveProgress
+
+	if taskHandle, err = r.Start(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	ticker := r.clock.NewTicker(settings.ProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// the task would keep running without us, so we use a fresh context to stop it
+			if err = r.Cancel(context.WithoutCancel(ctx), taskHandle); err != nil {
+				return progress, fmt.Errorf("can not cancel redrive of queue %s: %w", settings.DeadLetterQueueName, err)
+			}
+
+			return progress, ctx.Err()
+		case <-ticker.Chan():
+		}
+
+		if progress, err = r.Progress(ctx, settings.DeadLetterQueueName); err != nil {
+			return nil, fmt.Errorf("can not get redrive progress: %w", err)
+		}
+
+		if onProgress != nil {
+			onProgress(progress)
+		}
+
+		if !progress.IsFinished() {
+			continue
+		}
+
+		if progress.Status != RedriveStatusCompleted {
+			return progress, fmt.Errorf("redrive of queue %s finished with status %s: %s", settings.DeadLetterQueueName, progress.Status, progress.FailureReason)
+		}
+
+		return progress, nil
+	}
+}
+
+func (r *redriver) getProperties(ctx context.Context, name string) (*Properties, error) {
+	var err error
+	var url, arn string
+
+	if url, err = r.resolver.GetUrl(ctx, name); err != nil {
+		return nil, err
+	}
+
+	if url == "" {
+		return nil, fmt.Errorf("queue %s does not exist", name)
+	}
+
+	if arn, err = r.resolver.GetArn(ctx, url); err != nil {
+		return nil, err
+	}
+
+	return &Properties{
+		Name: name,
+		Url:  url,
+		Arn:  arn,
+	}, nil
+}
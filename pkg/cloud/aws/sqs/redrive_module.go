@@ -0,0 +1,60 @@
+package sqs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const redriveConfigKey = "sqs.redrive"
+
+type redriveModule struct {
+	kernel.EssentialModule
+	kernel.ApplicationStage
+
+	logger   log.Logger
+	redriver Redriver
+	settings *RedriveSettings
+}
+
+// NewRedriveModule creates a module moving the messages of a dead letter queue back to their source queue, configured
+// at the key sqs.redrive. The module finishes once all messages are moved, so it is meant to be used as a cli command.
+func NewRedriveModule(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+	var err error
+	var redriver Redriver
+
+	settings := &RedriveSettings{}
+	if err = config.UnmarshalKey(redriveConfigKey, settings); err != nil {
+		return nil, fmt.Errorf("can not unmarshal sqs redrive settings: %w", err)
+	}
+
+	if redriver, err = NewRedriver(ctx, config, logger, settings.ClientName); err != nil {
+		return nil, fmt.Errorf("can not create sqs redriver: %w", err)
+	}
+
+	return NewRedriveModuleWithInterfaces(logger, redriver, settings), nil
+}
+
+func NewRedriveModuleWithInterfaces(logger log.Logger, redriver Redriver, settings *RedriveSettings) kernel.Module {
+	return &redriveModule{
+		logger:   logger.WithChannel("sqs-redrive"),
+		redriver: redriver,
+		settings: settings,
+	}
+}
+
+func (m *redriveModule) Run(ctx context.Context) error {
+	progress, err := m.redriver.Redrive(ctx, m.settings, func(progress *RedriveProgress) {
+		m.logger.Info(ctx, "redrive of queue %s is %s: moved %d of %d messages", m.settings.DeadLetterQueueName, progress.Status, progress.MessagesMoved, progress.MessagesToMove)
+	})
+	if err != nil {
+		return fmt.Errorf("can not redrive queue %s: %w", m.settings.DeadLetterQueueName, err)
+	}
+
+	m.logger.Info(ctx, "redrive of queue %s finished, moved %d messages", m.settings.DeadLetterQueueName, progress.MessagesMoved)
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package sqs_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoSqs "github.com/justtrackio/gosoline/pkg/cloud/aws/sqs"
+	sqsMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/sqs/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRunRedriverTestSuite(t *testing.T) {
+	suite.Run(t, new(redriverTestSuite))
+}
+
+type redriverTestSuite struct {
+	suite.Suite
+	ctx      context.Context
+	client   *sqsMocks.Client
+	resolver *sqsMocks.PropertiesResolver
+	clock    clock.FakeClock
+	redriver gosoSqs.Redriver
+	settings *gosoSqs.RedriveSettings
+}
+
+func (s *redriverTestSuite) SetupTest() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+
+	s.ctx = s.T().Context()
+	s.client = sqsMocks.NewClient(s.T())
+	s.resolver = sqsMocks.NewPropertiesResolver(s.T())
+	s.clock = clock.NewFakeClock()
+	s.redriver = gosoSqs.NewRedriverWithInterfaces(logger, s.client, s.resolver, s.clock)
+	s.settings = &gosoSqs.RedriveSettings{
+		DeadLetterQueueName:  "queue-dead",
+		MaxMessagesPerSecond: 50,
+		ProgressInterval:     time.Second,
+	}
+
+	s.resolver.EXPECT().GetUrl(matcher.Context, "queue-dead").Return("https://sqs/queue-dead", nil)
+	s.resolver.EXPECT().GetArn(matcher.Context, "https://sqs/queue-dead").Return("arn:queue-dead", nil)
+}
+
+func (s *redriverTestSuite) TestRedrive() {
+	s.client.EXPECT().StartMessageMoveTask(matcher.Context, &sqs.StartMessageMoveTaskInput{
+		SourceArn:                    aws.String("arn:queue-dead"),
+		MaxNumberOfMessagesPerSecond: aws.Int32(50),
+	}).Return(&sqs.StartMessageMoveTaskOutput{
+		TaskHandle: aws.String("handle"),
+	}, nil).Once()
+
+	listInput := &sqs.ListMessageMoveTasksInput{
+		SourceArn:  aws.String("arn:queue-dead"),
+		MaxResults: aws.Int32(1),
+	}
+	s.client.EXPECT().ListMessageMoveTasks(matcher.Context, listInput).Return(&sqs.ListMessageMoveTasksOutput{
+		Results: []types.ListMessageMoveTasksResultEntry{
+			{
+				TaskHandle:                        aws.String("handle"),
+				Status:                            aws.String(gosoSqs.RedriveStatusRunning),
+				ApproximateNumberOfMessagesMoved:  5,
+				ApproximateNumberOfMessagesToMove: aws.Int64(10),
+			},
+		},
+	}, nil).Once()
+	s.client.EXPECT().ListMessageMoveTasks(matcher.Context, listInput).Return(&sqs.ListMessageMoveTasksOutput{
+		Results: []types.ListMessageMoveTasksResultEntry{
+			{
+				TaskHandle:                        aws.String("handle"),
+				Status:                            aws.String(gosoSqs.RedriveStatusCompleted),
+				ApproximateNumberOfMessagesMoved:  10,
+				ApproximateNumberOfMessagesToMove: aws.Int64(10),
+			},
+		},
+	}, nil).Once()
+
+	go func() {
+		for i := 0; i < 2; i++ {
+			s.clock.BlockUntilTickers(1)
+			s.clock.Advance(time.Second)
+		}
+	}()
+
+	moved := make([]int64, 0)
+	progress, err := s.redriver.Redrive(s.ctx, s.settings, func(progress *gosoSqs.RedriveProgress) {
+		moved = append(moved, progress.MessagesMoved)
+	})
+
+	s.NoError(err)
+	s.Equal([]int64{5, 10}, moved)
+	s.Equal(&gosoSqs.RedriveProgress{
+		TaskHandle:     "handle",
+		Status:         gosoSqs.RedriveStatusCompleted,
+		MessagesMoved:  10,
+		MessagesToMove: 10,
+	}, progress)
+}
+
+func (s *redriverTestSuite) TestRedrive_Canceled() {
+	ctx, cancel := context.WithCancel(s.ctx)
+
+	s.client.EXPECT().StartMessageMoveTask(matcher.Context, &sqs.StartMessageMoveTaskInput{
+		SourceArn:                    aws.String("arn:queue-dead"),
+		MaxNumberOfMessagesPerSecond: aws.Int32(50),
+	}).Run(func(_ context.Context, _ *sqs.StartMessageMoveTaskInput, _ ...func(*sqs.Options)) {
+		cancel()
+	}).Return(&sqs.StartMessageMoveTaskOutput{
+		TaskHandle: aws.String("handle"),
+	}, nil).Once()
+
+	s.client.EXPECT().CancelMessageMoveTask(matcher.Context, &sqs.CancelMessageMoveTaskInput{
+		TaskHandle: aws.String("handle"),
+	}).Return(&sqs.CancelMessageMoveTaskOutput{}, nil).Once()
+
+	_, err := s.redriver.Redrive(ctx, s.settings, nil)
+	s.ErrorIs(err, context.Canceled)
+}
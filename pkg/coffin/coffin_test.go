@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/coffin"
 	"github.com/stretchr/testify/assert"
 )
@@ -52,24 +53,21 @@ func TestCoffin_WithContext(t *testing.T) {
 
 func runCoffinWithContextIteration(t *testing.T) {
 	cfn, ctx := coffin.WithContext(t.Context())
-	c := make(chan struct{})
+	fakeClock := clock.NewFakeClock()
 	errStop := errors.New("please stop")
+	count := 0
 
 	cfn.GoWithContext(ctx, func(ctx context.Context) error {
 		nestedCfn, cfnCtx := coffin.WithContext(ctx)
 
 		nestedCfn.GoWithContext(cfnCtx, func(ctx context.Context) error {
-			ticker := time.NewTicker(time.Millisecond)
+			ticker := fakeClock.NewTicker(time.Millisecond)
 			defer ticker.Stop()
-			count := 0
 
 			for {
 				select {
-				case <-ticker.C:
+				case <-ticker.Chan():
 					count++
-					if count == 3 {
-						close(c)
-					}
 				case <-ctx.Done():
 					return nil
 				}
@@ -84,11 +82,17 @@ func runCoffinWithContextIteration(t *testing.T) {
 		return err
 	})
 
-	<-c
+	fakeClock.BlockUntilTickers(1)
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, fakeClock.AdvanceAndWait(t.Context(), time.Millisecond))
+	}
+
 	cfn.Kill(errStop)
 	err := cfn.Wait()
 
 	assert.Equal(t, errStop, err)
+	assert.Equal(t, 3, count)
 }
 
 func TestCoffin_WithContext_Cancel(t *testing.T) {
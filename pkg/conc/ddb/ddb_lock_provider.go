@@ -132,8 +132,9 @@ func (m *ddbLockProvider) acquire(acquireCtx context.Context, lockCtx context.Co
 		}).Debug(ctx, "acquired lock")
 
 		// make sure we do not use the same context on the lock as when we acquire it - TryAcquireIn passes a context which gets canceled once we
-		// return, and we don't want to store that on our lock.
-		lock = NewDdbLockFromInterfaces(m, m.clock, m.logger, lockCtx, resource, token, expires)
+		// return, and we don't want to store that on our lock. The lock gets its own copy of the logger fields, as it is
+		// used by the watcher goroutine while the caller might still mutate the fields of its context.
+		lock = NewDdbLockFromInterfaces(m, m.clock, m.logger, log.ForkContext(lockCtx), resource, token, expires)
 		go lock.runWatcher()
 
 		return nil, nil
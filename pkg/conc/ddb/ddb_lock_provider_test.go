@@ -13,6 +13,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/ddb"
 	ddbMocks "github.com/justtrackio/gosoline/pkg/ddb/mocks"
 	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
 	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
 	"github.com/justtrackio/gosoline/pkg/test/matcher"
 	"github.com/justtrackio/gosoline/pkg/uuid"
@@ -315,6 +316,30 @@ func (s *ddbLockProviderTestSuite) TestDdbLockProvider_TryAcquireInReturnsLockTh
 	s.NoError(lock.Release())
 }
 
+func (s *ddbLockProviderTestSuite) TestDdbLockProvider_AcquireThenReleaseKeepsForkedContextFields() {
+	s.ctx = log.AppendContextFields(s.ctx, map[string]any{
+		"request_id": "abc",
+	})
+
+	l := s.testAcquireLock(false, false)
+
+	// the caller keeps using its context, the lock must not see these changes
+	log.MutateContextFields(s.ctx, map[string]any{
+		"request_id": "def",
+	})
+
+	s.getReleaseQueryBuilder(&ddb.DeleteItemResult{}, nil).Run(func(ctx context.Context, qb ddb.DeleteItemBuilder, item any) {
+		s.Equal(map[string]any{
+			"request_id": "abc",
+		}, log.LocalOnlyContextFieldsResolver(ctx))
+	}).Once()
+
+	s.NoError(l.Release())
+	s.Equal(map[string]any{
+		"request_id": "def",
+	}, log.LocalOnlyContextFieldsResolver(s.ctx))
+}
+
 func TestDdbLockProvider(t *testing.T) {
 	suite.Run(t, new(ddbLockProviderTestSuite))
 }
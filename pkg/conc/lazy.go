@@ -5,6 +5,7 @@ import (
 )
 
 // Lazy provides a thread-safe way of creating a resource on-demand, allowing you to provide needed data with a parameter
+//go:generate go run github.com/vektra/mockery/v2 --name Lazy
 type Lazy[T any, ARG any] interface {
 	Get(arg ARG) (T, error)
 }
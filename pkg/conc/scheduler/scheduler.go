@@ -19,6 +19,9 @@ const (
 
 //go:generate go run github.com/vektra/mockery/v2 --name Scheduler
 type Scheduler[T any] interface {
+	// ScheduleJob adds the job to the next batch and waits for its result. The batch is executed with the context passed
+	// to Run as it combines jobs of different callers, so provider has to capture ctx itself if it needs its values
+	// (like logger fields).
 	ScheduleJob(ctx context.Context, key string, provider func() (T, error)) (T, error)
 	Run(ctx context.Context) error
 }
@@ -71,10 +74,14 @@ func NewScheduler[T any](config cfg.Config, batchRunner BatchRunner[T], name str
 }
 
 func NewSchedulerWithSettings[T any](batchRunner BatchRunner[T], metricWriter metric.Writer, name string, settings Settings) Scheduler[T] {
+	return NewSchedulerWithInterfaces(batchRunner, clock.Provider, metricWriter, name, settings)
+}
+
+func NewSchedulerWithInterfaces[T any](batchRunner BatchRunner[T], clock clock.Clock, metricWriter metric.Writer, name string, settings Settings) Scheduler[T] {
 	return &scheduler[T]{
 		batchRunner:  batchRunner,
 		batchTimeout: settings.BatchTimeout,
-		clock:        clock.Provider,
+		clock:        clock,
 		metricWriter: metricWriter,
 		runnerCount:  settings.RunnerCount,
 		maxBatchSize: settings.MaxBatchSize,
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/coffin"
 	"github.com/justtrackio/gosoline/pkg/conc/scheduler"
 	taskRunner "github.com/justtrackio/gosoline/pkg/conc/task_runner"
@@ -72,3 +73,54 @@ func TestScheduler(t *testing.T) {
 	err = cfn.Wait()
 	assert.NoError(t, err)
 }
+
+func TestScheduler_BatchTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	fakeClock := clock.NewFakeClock()
+	batches := make(chan []string, 2)
+
+	batchRunner := func(ctx context.Context, keys []string, providers []func() (int, error)) (map[string]int, error) {
+		batches <- keys
+
+		results := map[string]int{}
+		for i, key := range keys {
+			results[key], _ = providers[i]()
+		}
+
+		return results, nil
+	}
+	taskScheduler := scheduler.NewSchedulerWithInterfaces[int](batchRunner, fakeClock, metricMocks.NewWriterMockedAll(), "test", scheduler.Settings{
+		BatchTimeout: time.Second,
+		RunnerCount:  1,
+		MaxBatchSize: 2,
+	})
+
+	cfn := coffin.New()
+	cfn.GoWithContext(ctx, taskScheduler.Run)
+
+	results := make(chan int, 3)
+	for _, key := range []string{"1", "2", "3"} {
+		cfn.Go(func() error {
+			result, err := taskScheduler.ScheduleJob(ctx, key, func() (int, error) {
+				return strconv.Atoi(key)
+			})
+			results <- result
+
+			return err
+		})
+	}
+
+	// a full batch is executed right away
+	assert.Len(t, <-batches, 2)
+
+	// the remaining job waits for the batch timeout
+	fakeClock.BlockUntilTickers(1)
+	assert.Empty(t, batches)
+	assert.NoError(t, fakeClock.AdvanceAndWait(ctx, time.Second))
+	assert.Len(t, <-batches, 1)
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, []int{<-results, <-results, <-results})
+
+	cancel()
+	assert.NoError(t, cfn.Wait())
+}
@@ -77,13 +77,17 @@ func Provide(ctx context.Context) (TaskRunner, error) {
 }
 
 // RunTask gets the TaskRunner from the context and uses it to run the given task.
+// The logger context fields of ctx are copied to the context the task is run with.
 func RunTask(ctx context.Context, task kernel.Module) error {
 	taskRunner, err := Provide(ctx)
 	if err != nil {
 		return fmt.Errorf("could not find task runner: %w", err)
 	}
 
-	err = taskRunner.RunTask(task)
+	err = taskRunner.RunTask(contextFieldsTask{
+		Module: task,
+		ctx:    ctx,
+	})
 	if err != nil {
 		return fmt.Errorf("could not run task on task runner: %w", err)
 	}
@@ -91,6 +95,16 @@ func RunTask(ctx context.Context, task kernel.Module) error {
 	return nil
 }
 
+// contextFieldsTask runs a task with the logger context fields of the context the task was submitted with.
+type contextFieldsTask struct {
+	kernel.Module
+	ctx context.Context
+}
+
+func (t contextFieldsTask) Run(ctx context.Context) error {
+	return t.Module.Run(log.CopyContextFields(t.ctx, ctx))
+}
+
 func newTaskRunner() (*taskRunner, error) {
 	return &taskRunner{
 		pendingTasks: make(chan kernel.Module, 100),
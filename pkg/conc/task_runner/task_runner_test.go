@@ -10,6 +10,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/coffin"
 	taskRunner "github.com/justtrackio/gosoline/pkg/conc/task_runner"
 	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +50,32 @@ func TestTaskRunner(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, int32(100), callCount.Load())
 }
+
+func TestTaskRunnerContextFields(t *testing.T) {
+	ctx := appctx.WithContainer(t.Context())
+	runner, err := taskRunner.Provide(ctx)
+	assert.NoError(t, err)
+
+	runCtx, cancel := context.WithCancel(ctx)
+
+	cfn := coffin.New()
+	cfn.GoWithContext(runCtx, runner.Run)
+
+	fields := make(chan map[string]any, 1)
+	task := kernel.NewModuleFunc(func(ctx context.Context) error {
+		fields <- log.ContextFieldsResolver(ctx)
+
+		return nil
+	})
+
+	taskCtx := log.AppendContextFields(ctx, map[string]any{
+		"request_id": "abc",
+	})
+	err = taskRunner.RunTask(taskCtx, task)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"request_id": "abc"}, <-fields)
+
+	cancel()
+	assert.NoError(t, cfn.Wait())
+}
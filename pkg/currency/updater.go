@@ -196,7 +196,7 @@ func (s *updaterService) EnsureHistoricalExchangeRates(ctx context.Context) erro
 
 	s.logger.Info(ctx, "updating %d historical exchange rates", len(keyValues))
 
-	err := s.store.PutBatch(ctx, keyValues)
+	err := kvstore.PutBatch(ctx, s.store, keyValues)
 	if err != nil {
 		return fmt.Errorf("error setting historical exchange rates: %w", err)
 	}
@@ -0,0 +1,160 @@
+package db_repo
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+)
+
+const (
+	cursorTypeInt    = "int"
+	cursorTypeUint   = "uint"
+	cursorTypeFloat  = "float"
+	cursorTypeString = "string"
+	cursorTypeBool   = "bool"
+	cursorTypeTime   = "time"
+)
+
+// cursorValue keeps the type of a value, so it is bound with the same type when querying the next page.
+type cursorValue struct {
+	Type  string `json:"t"`
+	Value string `json:"v"`
+}
+
+// EncodeCursor encodes the sort values of the last row of a page into an opaque cursor. The values have to be given in
+// the order of the OrderBy calls of the query builder.
+func EncodeCursor(values ...any) (string, error) {
+	encoded := make([]cursorValue, len(values))
+
+	for i, value := range values {
+		var err error
+
+		if encoded[i], err = encodeCursorValue(value); err != nil {
+			return "", fmt.Errorf("can not encode cursor value %d: %w", i, err)
+		}
+	}
+
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		return "", fmt.Errorf("can not marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor returns the sort values encoded into the cursor.
+func DecodeCursor(cursor string) ([]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("can not decode cursor: %w", err)
+	}
+
+	encoded := make([]cursorValue, 0)
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("can not unmarshal cursor: %w", err)
+	}
+
+	values := make([]any, len(encoded))
+
+	for i, value := range encoded {
+		if values[i], err = decodeCursorValue(value); err != nil {
+			return nil, fmt.Errorf("can not decode cursor value %d: %w", i, err)
+		}
+	}
+
+	return values, nil
+}
+
+func encodeCursorValue(value any) (cursorValue, error) {
+	if t, ok := value.(*time.Time); ok && t != nil {
+		value = *t
+	}
+
+	if t, ok := value.(time.Time); ok {
+		return cursorValue{Type: cursorTypeTime, Value: t.Format(time.RFC3339Nano)}, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cursorValue{Type: cursorTypeInt, Value: strconv.FormatInt(rv.Int(), 10)}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cursorValue{Type: cursorTypeUint, Value: strconv.FormatUint(rv.Uint(), 10)}, nil
+	case reflect.Float32, reflect.Float64:
+		return cursorValue{Type: cursorTypeFloat, Value: strconv.FormatFloat(rv.Float(), 'g', -1, 64)}, nil
+	case reflect.String:
+		return cursorValue{Type: cursorTypeString, Value: rv.String()}, nil
+	case reflect.Bool:
+		return cursorValue{Type: cursorTypeBool, Value: strconv.FormatBool(rv.Bool())}, nil
+	case reflect.Invalid, reflect.Ptr:
+		return cursorValue{}, fmt.Errorf("nil values can not be used as sort values of a cursor")
+	default:
+		return cursorValue{}, fmt.Errorf("unsupported type %T", value)
+	}
+}
+
+func decodeCursorValue(value cursorValue) (any, error) {
+	switch value.Type {
+	case cursorTypeInt:
+		return strconv.ParseInt(value.Value, 10, 64)
+	case cursorTypeUint:
+		return strconv.ParseUint(value.Value, 10, 64)
+	case cursorTypeFloat:
+		return strconv.ParseFloat(value.Value, 64)
+	case cursorTypeString:
+		return value.Value, nil
+	case cursorTypeBool:
+		return strconv.ParseBool(value.Value)
+	case cursorTypeTime:
+		return time.Parse(time.RFC3339Nano, value.Value)
+	default:
+		return nil, fmt.Errorf("unknown type %q", value.Type)
+	}
+}
+
+// keysetCondition builds the condition selecting all rows after the given sort values. For the order a ASC, b DESC it
+// is (a > ?) OR (a = ? AND b < ?).
+func keysetCondition(orders []order, values []any) (string, []any, error) {
+	if len(orders) == 0 {
+		return "", nil, fmt.Errorf("keyset pagination requires the query to be ordered")
+	}
+
+	if len(values) != len(orders) {
+		return "", nil, fmt.Errorf("the cursor has %d values, but the query is ordered by %d fields", len(values), len(orders))
+	}
+
+	conditions := make([]string, len(orders))
+	args := make([]any, 0)
+
+	for i, o := range orders {
+		operator := ">"
+
+		direction, _ := o.direction.(string)
+		if strings.EqualFold(direction, "DESC") {
+			operator = "<"
+		}
+
+		parts := make([]string, 0, i+1)
+
+		for j := 0; j < i; j++ {
+			parts = append(parts, fmt.Sprintf("%s = ?", orders[j].field))
+			args = append(args, values[j])
+		}
+
+		parts = append(parts, fmt.Sprintf("%s %s ?", o.field, operator))
+		args = append(args, values[i])
+
+		conditions[i] = fmt.Sprintf("(%s)", strings.Join(parts, " AND "))
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(conditions, " OR ")), args, nil
+}
@@ -0,0 +1,93 @@
+package db_repo_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 30, 15, 123, time.UTC)
+
+	cursor, err := db_repo.EncodeCursor("foo", mdl.Box(uint(42)), -3, 1.5, true, &now)
+	assert.NoError(t, err)
+
+	values, err := db_repo.DecodeCursor(cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, []any{"foo", uint64(42), int64(-3), 1.5, true, now}, values)
+}
+
+func TestCursor_Errors(t *testing.T) {
+	_, err := db_repo.EncodeCursor((*uint)(nil))
+	assert.EqualError(t, err, "can not encode cursor value 0: nil values can not be used as sort values of a cursor")
+
+	_, err = db_repo.EncodeCursor([]string{"foo"})
+	assert.EqualError(t, err, "can not encode cursor value 0: unsupported type []string")
+
+	_, err = db_repo.DecodeCursor("!")
+	assert.ErrorContains(t, err, "can not decode cursor")
+}
+
+func TestRepository_QueryPageAfter(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	dbc, repo := getTimedMocks(t, now, myTestModel)
+
+	cursor, err := db_repo.EncodeCursor("foo", id42)
+	assert.NoError(t, err)
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id24, &now, &now)
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `my_test_models` WHERE (id > ?) AND (((name < ?) OR (name = ? AND id > ?))) ORDER BY name DESC,id ASC LIMIT 10")).
+		WithArgs(0, "foo", "foo", uint64(42)).
+		WillReturnRows(rows)
+
+	qb := db_repo.NewQueryBuilder()
+	qb.Where("id > ?", 0)
+	qb.OrderBy("name", "DESC")
+	qb.OrderBy("id", "ASC")
+	qb.PageAfter(cursor, 10)
+
+	result := make([]*MyTestModel, 0)
+	err = repo.Query(t.Context(), qb, &result)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestRepository_QueryPageAfterFirstPage(t *testing.T) {
+	dbc, repo := getMocks(t, myTestModel)
+
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `my_test_models` ORDER BY id ASC LIMIT 10")).
+		WillReturnRows(goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}))
+
+	qb := db_repo.NewQueryBuilder()
+	qb.OrderBy("id", "ASC")
+	qb.PageAfter("", 10)
+
+	result := make([]*MyTestModel, 0)
+	err := repo.Query(t.Context(), qb, &result)
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestRepository_QueryPageAfterMismatchingCursor(t *testing.T) {
+	_, repo := getMocks(t, myTestModel)
+
+	cursor, err := db_repo.EncodeCursor("foo", id42)
+	assert.NoError(t, err)
+
+	qb := db_repo.NewQueryBuilder()
+	qb.OrderBy("id", "ASC")
+	qb.PageAfter(cursor, 10)
+
+	result := make([]*MyTestModel, 0)
+	err = repo.Query(t.Context(), qb, &result)
+
+	assert.EqualError(t, err, "can not build keyset condition: the cursor has 2 values, but the query is ordered by 1 fields")
+}
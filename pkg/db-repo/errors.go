@@ -76,3 +76,25 @@ func (e TooManyResultsError) Error() string {
 func IsTooManyResultsError(err error) bool {
 	return errors.As(err, &TooManyResultsError{})
 }
+
+type StaleEntityError struct {
+	id      uint
+	modelId string
+	version int64
+}
+
+func NewStaleEntityError(id uint, modelId string, version int64) StaleEntityError {
+	return StaleEntityError{
+		id:      id,
+		modelId: modelId,
+		version: version,
+	}
+}
+
+func (e StaleEntityError) Error() string {
+	return fmt.Sprintf("model of type %s with id %d is stale: version %d was modified or deleted concurrently", e.modelId, e.id, e.version)
+}
+
+func IsStaleEntityError(err error) bool {
+	return errors.As(err, &StaleEntityError{})
+}
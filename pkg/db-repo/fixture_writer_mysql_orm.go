@@ -51,7 +51,11 @@ func NewMysqlOrmFixtureWriter(ctx context.Context, config cfg.Config, logger log
 	if dbSettings, err = db.ReadSettings(config, "default"); err != nil {
 		return nil, fmt.Errorf("can not create repo: %w", err)
 	}
-	dbSettings.Parameters["FOREIGN_KEY_CHECKS"] = "0"
+
+	// postgres rejects unknown session parameters
+	if dbSettings.Driver == db.DriverMysql {
+		dbSettings.Parameters["FOREIGN_KEY_CHECKS"] = "0"
+	}
 
 	if repo, err = NewWithDbSettings(ctx, config, logger, dbSettings, repoSettings); err != nil {
 		return nil, fmt.Errorf("can not create repo: %w", err)
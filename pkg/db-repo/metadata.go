@@ -14,11 +14,18 @@ const (
 
 type NullMode int
 
+const DefaultBatchSize = 500
+
 type Metadata struct {
 	ModelId    mdl.ModelId
 	TableName  string
 	PrimaryKey string
 	Mappings   FieldMappings
+	// BatchSize is the count of rows written per statement by CreateBatch and UpsertBatch, defaults to DefaultBatchSize.
+	BatchSize int
+	// TenantColumn restricts all operations to the rows of the tenant of the context if set, see WithTenant. The
+	// column has to be a string field of the model.
+	TenantColumn string
 }
 
 type FieldMappings map[string]FieldMapping
@@ -13,6 +13,8 @@ const (
 	MetricNameDbAccessSuccess = "DbAccessSuccess"
 	MetricNameDbAccessFailure = "DbAccessFailure"
 	MetricNameDbAccessLatency = "DbAccessLatency"
+	// MetricNameDbAccessBatchSize is the average count of models written by a batch operation.
+	MetricNameDbAccessBatchSize = "DbAccessBatchSize"
 )
 
 type metricRepository struct {
@@ -71,6 +73,33 @@ func (r metricRepository) Query(ctx context.Context, qb *QueryBuilder, result an
 	return err
 }
 
+func (r metricRepository) CreateBatch(ctx context.Context, values []ModelBased) error {
+	start := time.Now()
+	err := r.Repository.CreateBatch(ctx, values)
+	r.writeMetric(ctx, CreateBatch, err, start)
+	r.writeBatchSize(ctx, CreateBatch, len(values))
+
+	return err
+}
+
+func (r metricRepository) UpsertBatch(ctx context.Context, values []ModelBased) error {
+	start := time.Now()
+	err := r.Repository.UpsertBatch(ctx, values)
+	r.writeMetric(ctx, UpsertBatch, err, start)
+	r.writeBatchSize(ctx, UpsertBatch, len(values))
+
+	return err
+}
+
+func (r metricRepository) WithTx(ctx context.Context, do func(txRepo Repository) error) error {
+	return r.Repository.WithTx(ctx, func(txRepo Repository) error {
+		return do(metricRepository{
+			Repository: txRepo,
+			output:     r.output,
+		})
+	})
+}
+
 func (r metricRepository) writeMetric(ctx context.Context, op string, err error, start time.Time) {
 	latencyNano := time.Since(start)
 	metricName := MetricNameDbAccessSuccess
@@ -105,6 +134,19 @@ func (r metricRepository) writeMetric(ctx context.Context, op string, err error,
 	})
 }
 
+func (r metricRepository) writeBatchSize(ctx context.Context, op string, size int) {
+	r.output.WriteOne(ctx, &metric.Datum{
+		Timestamp:  time.Now(),
+		MetricName: MetricNameDbAccessBatchSize,
+		Dimensions: map[string]string{
+			"Operation": op,
+			"ModelId":   r.GetModelId(),
+		},
+		Unit:  metric.UnitCountAverage,
+		Value: float64(size),
+	})
+}
+
 func getDefaultRepositoryMetrics(modelIdString string) []*metric.Datum {
 	defaults := make([]*metric.Datum, 0)
 
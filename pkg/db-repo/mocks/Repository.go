@@ -127,6 +127,53 @@ func (_c *Repository_Create_Call) RunAndReturn(run func(context.Context, db_repo
 	return _c
 }
 
+// CreateBatch provides a mock function with given fields: ctx, values
+func (_m *Repository) CreateBatch(ctx context.Context, values []db_repo.ModelBased) error {
+	ret := _m.Called(ctx, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_CreateBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBatch'
+type Repository_CreateBatch_Call struct {
+	*mock.Call
+}
+
+// CreateBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - values []db_repo.ModelBased
+func (_e *Repository_Expecter) CreateBatch(ctx interface{}, values interface{}) *Repository_CreateBatch_Call {
+	return &Repository_CreateBatch_Call{Call: _e.mock.On("CreateBatch", ctx, values)}
+}
+
+func (_c *Repository_CreateBatch_Call) Run(run func(ctx context.Context, values []db_repo.ModelBased)) *Repository_CreateBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *Repository_CreateBatch_Call) Return(_a0 error) *Repository_CreateBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_CreateBatch_Call) RunAndReturn(run func(context.Context, []db_repo.ModelBased) error) *Repository_CreateBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, value
 func (_m *Repository) Delete(ctx context.Context, value db_repo.ModelBased) error {
 	ret := _m.Called(ctx, value)
@@ -174,6 +221,64 @@ func (_c *Repository_Delete_Call) RunAndReturn(run func(context.Context, db_repo
 	return _c
 }
 
+// Exec provides a mock function with given fields: ctx, query, args
+func (_m *Repository) Exec(ctx context.Context, query string, args ...interface{}) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, query)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...interface{}) error); ok {
+		r0 = rf(ctx, query, args...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type Repository_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query string
+//   - args ...interface{}
+func (_e *Repository_Expecter) Exec(ctx interface{}, query interface{}, args ...interface{}) *Repository_Exec_Call {
+	return &Repository_Exec_Call{Call: _e.mock.On("Exec",
+		append([]interface{}{ctx, query}, args...)...)}
+}
+
+func (_c *Repository_Exec_Call) Run(run func(ctx context.Context, query string, args ...interface{})) *Repository_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Repository_Exec_Call) Return(_a0 error) *Repository_Exec_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_Exec_Call) RunAndReturn(run func(context.Context, string, ...interface{}) error) *Repository_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetadata provides a mock function with no fields
 func (_m *Repository) GetMetadata() db_repo.Metadata {
 	ret := _m.Called()
@@ -452,6 +557,100 @@ func (_c *Repository_Update_Call) RunAndReturn(run func(context.Context, db_repo
 	return _c
 }
 
+// UpsertBatch provides a mock function with given fields: ctx, values
+func (_m *Repository) UpsertBatch(ctx context.Context, values []db_repo.ModelBased) error {
+	ret := _m.Called(ctx, values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_UpsertBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertBatch'
+type Repository_UpsertBatch_Call struct {
+	*mock.Call
+}
+
+// UpsertBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - values []db_repo.ModelBased
+func (_e *Repository_Expecter) UpsertBatch(ctx interface{}, values interface{}) *Repository_UpsertBatch_Call {
+	return &Repository_UpsertBatch_Call{Call: _e.mock.On("UpsertBatch", ctx, values)}
+}
+
+func (_c *Repository_UpsertBatch_Call) Run(run func(ctx context.Context, values []db_repo.ModelBased)) *Repository_UpsertBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *Repository_UpsertBatch_Call) Return(_a0 error) *Repository_UpsertBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_UpsertBatch_Call) RunAndReturn(run func(context.Context, []db_repo.ModelBased) error) *Repository_UpsertBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function with given fields: ctx, do
+func (_m *Repository) WithTx(ctx context.Context, do func(db_repo.Repository) error) error {
+	ret := _m.Called(ctx, do)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(db_repo.Repository) error) error); ok {
+		r0 = rf(ctx, do)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type Repository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - do func(db_repo.Repository) error
+func (_e *Repository_Expecter) WithTx(ctx interface{}, do interface{}) *Repository_WithTx_Call {
+	return &Repository_WithTx_Call{Call: _e.mock.On("WithTx", ctx, do)}
+}
+
+func (_c *Repository_WithTx_Call) Run(run func(ctx context.Context, do func(db_repo.Repository) error)) *Repository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(db_repo.Repository) error))
+	})
+	return _c
+}
+
+func (_c *Repository_WithTx_Call) Return(_a0 error) *Repository_WithTx_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_WithTx_Call) RunAndReturn(run func(context.Context, func(db_repo.Repository) error) error) *Repository_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRepository(t interface {
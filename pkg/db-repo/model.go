@@ -6,7 +6,10 @@ import (
 	"github.com/justtrackio/gosoline/pkg/mdl"
 )
 
-const ColumnUpdatedAt = "updated_at"
+const (
+	ColumnUpdatedAt = "updated_at"
+	ColumnVersion   = "version"
+)
 
 //go:generate go run github.com/vektra/mockery/v2 --name ModelBased
 type ModelBased interface {
@@ -55,6 +58,25 @@ func (m *Timestamps) GetCreatedAt() *time.Time {
 	return m.CreatedAt
 }
 
+// Versioned models are updated with optimistic locking: an update only succeeds if the version column still has the
+// version the model was read with and increments it. Embed Versioning to get a version column.
+type Versioned interface {
+	GetVersion() int64
+	SetVersion(version int64)
+}
+
+type Versioning struct {
+	Version int64
+}
+
+func (v *Versioning) GetVersion() int64 {
+	return v.Version
+}
+
+func (v *Versioning) SetVersion(version int64) {
+	v.Version = version
+}
+
 func EmptyTimestamps() Timestamps {
 	return Timestamps{
 		UpdatedAt: &time.Time{},
@@ -24,6 +24,11 @@ type (
 	Notifier        interface {
 		Send(ctx context.Context, notificationType string, value ModelBased) error
 	}
+	// TxNotifier is called within the transaction writing the model, so the notification is only persisted if the
+	// transaction is committed and the write is rolled back if the notification fails.
+	TxNotifier interface {
+		SendTx(ctx context.Context, txRepo Repository, notificationType string, value ModelBased) error
+	}
 )
 
 type notifier struct {
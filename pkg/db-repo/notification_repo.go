@@ -8,18 +8,28 @@ import (
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
+type pendingNotification struct {
+	ctx          context.Context
+	callbackType string
+	value        ModelBased
+}
+
 type notifyingRepository struct {
 	Repository
 
-	logger    log.Logger
-	notifiers NotificationMap
+	logger      log.Logger
+	notifiers   NotificationMap
+	txNotifiers map[string][]TxNotifier
+	// pending collects the notifications of a transaction, they are sent after the transaction was committed
+	pending *[]pendingNotification
 }
 
 func NewNotifyingRepository(logger log.Logger, base Repository) *notifyingRepository {
 	return &notifyingRepository{
-		Repository: base,
-		logger:     logger,
-		notifiers:  make(NotificationMap),
+		Repository:  base,
+		logger:      logger,
+		notifiers:   make(NotificationMap),
+		txNotifiers: make(map[string][]TxNotifier),
 	}
 }
 
@@ -37,28 +47,112 @@ func (r *notifyingRepository) AddNotifier(t string, c Notifier) {
 	r.notifiers[t] = append(r.notifiers[t], c)
 }
 
+func (r *notifyingRepository) AddTxNotifierAll(c TxNotifier) {
+	for _, t := range NotificationTypes {
+		r.AddTxNotifier(t, c)
+	}
+}
+
+// AddTxNotifier adds a notifier which is called in the transaction of the write, see TxNotifier. Writes of a model
+// type with tx notifiers are wrapped in a transaction if they don't run in one already.
+func (r *notifyingRepository) AddTxNotifier(t string, c TxNotifier) {
+	r.txNotifiers[t] = append(r.txNotifiers[t], c)
+}
+
 func (r *notifyingRepository) Create(ctx context.Context, value ModelBased) error {
-	if err := r.Repository.Create(ctx, value); err != nil {
+	return r.write(ctx, Create, value, func(repo Repository) error {
+		return repo.Create(ctx, value)
+	})
+}
+
+func (r *notifyingRepository) Update(ctx context.Context, value ModelBased) error {
+	return r.write(ctx, Update, value, func(repo Repository) error {
+		return repo.Update(ctx, value)
+	})
+}
+
+func (r *notifyingRepository) Delete(ctx context.Context, value ModelBased) error {
+	return r.write(ctx, Delete, value, func(repo Repository) error {
+		return repo.Delete(ctx, value)
+	})
+}
+
+func (r *notifyingRepository) write(ctx context.Context, callbackType string, value ModelBased, op func(repo Repository) error) error {
+	var err error
+
+	// we are either already inside a transaction or don't need one, as there are no tx notifiers
+	if r.pending != nil || len(r.txNotifiers[callbackType]) == 0 {
+		err = r.writeAndNotifyTx(ctx, r.Repository, callbackType, value, op)
+	} else {
+		err = r.Repository.WithTx(ctx, func(txRepo Repository) error {
+			return r.writeAndNotifyTx(ctx, txRepo, callbackType, value, op)
+		})
+	}
+
+	if err != nil {
 		return err
 	}
 
-	return r.doCallback(ctx, Create, value)
+	return r.doCallback(ctx, callbackType, value)
 }
 
-func (r *notifyingRepository) Update(ctx context.Context, value ModelBased) error {
-	if err := r.Repository.Update(ctx, value); err != nil {
+func (r *notifyingRepository) writeAndNotifyTx(ctx context.Context, repo Repository, callbackType string, value ModelBased, op func(repo Repository) error) error {
+	if err := op(repo); err != nil {
 		return err
 	}
 
-	return r.doCallback(ctx, Update, value)
+	for _, c := range r.txNotifiers[callbackType] {
+		if err := c.SendTx(ctx, repo, callbackType, value); err != nil {
+			r.logger.Warn(ctx, "%T notifier errored out with: %v", c, err)
+
+			return fmt.Errorf("can not execute the tx notifier for %s: %w", callbackType, err)
+		}
+	}
+
+	return nil
 }
 
-func (r *notifyingRepository) Delete(ctx context.Context, value ModelBased) error {
-	if err := r.Repository.Delete(ctx, value); err != nil {
+// CreateBatch is not supported, as the notifications would miss the ids of the created models.
+func (r *notifyingRepository) CreateBatch(_ context.Context, _ []ModelBased) error {
+	return fmt.Errorf("the notifying repository of %s does not support batch writes, as the ids of created models are unknown", r.GetModelId())
+}
+
+// UpsertBatch is not supported, as it is unknown which models were created and which were updated.
+func (r *notifyingRepository) UpsertBatch(_ context.Context, _ []ModelBased) error {
+	return fmt.Errorf("the notifying repository of %s does not support batch writes, as the ids of created models are unknown", r.GetModelId())
+}
+
+func (r *notifyingRepository) WithTx(ctx context.Context, do func(txRepo Repository) error) error {
+	pending := r.pending
+	outermost := pending == nil
+
+	if outermost {
+		pending = &[]pendingNotification{}
+	}
+
+	err := r.Repository.WithTx(ctx, func(txRepo Repository) error {
+		return do(&notifyingRepository{
+			Repository:  txRepo,
+			logger:      r.logger,
+			notifiers:   r.notifiers,
+			txNotifiers: r.txNotifiers,
+			pending:     pending,
+		})
+	})
+
+	if err != nil || !outermost {
 		return err
 	}
 
-	return r.doCallback(ctx, Delete, value)
+	var errors error
+
+	for _, notification := range *pending {
+		if err := r.doCallback(notification.ctx, notification.callbackType, notification.value); err != nil {
+			errors = multierror.Append(errors, err)
+		}
+	}
+
+	return errors
 }
 
 func (r *notifyingRepository) doCallback(ctx context.Context, callbackType string, value ModelBased) error {
@@ -66,6 +160,16 @@ func (r *notifyingRepository) doCallback(ctx context.Context, callbackType strin
 		return nil
 	}
 
+	if r.pending != nil {
+		*r.pending = append(*r.pending, pendingNotification{
+			ctx:          ctx,
+			callbackType: callbackType,
+			value:        value,
+		})
+
+		return nil
+	}
+
 	var errors error
 
 	for _, c := range r.notifiers[callbackType] {
@@ -44,3 +44,32 @@ func (r OperationValidatingRepository) Delete(ctx context.Context, value ModelBa
 
 	return r.Repository.Delete(ctx, value)
 }
+
+func (r OperationValidatingRepository) CreateBatch(ctx context.Context, values []ModelBased) error {
+	for _, value := range values {
+		if err := r.validator.IsValid(ctx, value, Create); err != nil {
+			return err
+		}
+	}
+
+	return r.Repository.CreateBatch(ctx, values)
+}
+
+func (r OperationValidatingRepository) UpsertBatch(ctx context.Context, values []ModelBased) error {
+	for _, value := range values {
+		if err := r.validator.IsValid(ctx, value, UpsertBatch); err != nil {
+			return err
+		}
+	}
+
+	return r.Repository.UpsertBatch(ctx, values)
+}
+
+func (r OperationValidatingRepository) WithTx(ctx context.Context, do func(txRepo Repository) error) error {
+	return r.Repository.WithTx(ctx, func(txRepo Repository) error {
+		return do(&OperationValidatingRepository{
+			Repository: txRepo,
+			validator:  r.validator,
+		})
+	})
+}
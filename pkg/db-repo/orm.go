@@ -13,6 +13,8 @@ import (
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
+const callbackIgnoreCreatedAt = "gosoline:ignore_created_at_if_needed"
+
 type OrmMigrationSetting struct {
 	TablePrefixed bool `cfg:"table_prefixed" default:"true"`
 }
@@ -62,13 +64,14 @@ func NewOrmWithDbSettings(ctx context.Context, config cfg.Config, logger log.Log
 }
 
 func NewOrmWithInterfaces(dbClient gorm.SQLCommon, settings OrmSettings) (*gorm.DB, error) {
-	orm, err := gorm.Open(settings.Driver, dbClient)
+	orm, err := gorm.Open(ormDialect(settings.Driver), dbClient)
 	if err != nil {
 		return nil, fmt.Errorf("could not create gorm: %w", err)
 	}
 
 	orm.LogMode(false)
 	orm.SetLogger(&noopLogger{})
+	removeWriteTransactions(orm)
 	orm = orm.Set("gorm:auto_preload", true)
 	orm = orm.Set("gorm:save_associations", false)
 
@@ -88,3 +91,31 @@ func NewOrmWithInterfaces(dbClient gorm.SQLCommon, settings OrmSettings) (*gorm.
 
 	return orm, nil
 }
+
+// removeWriteTransactions keeps gorm from running every create, update and delete in a transaction of its own, which it
+// does for clients implementing Begin. Only Repository.WithTx opens transactions, all other writes run on the connection
+// like any other query and thus use the statement cache.
+func removeWriteTransactions(orm *gorm.DB) {
+	// every call of Callback replaces the callbacks of the orm with a copy, so we have to use a single one. A processor
+	// stores the name of the callback it removes, so we need a new one for every callback.
+	callbacks := orm.Callback()
+
+	for _, processor := range []func() *gorm.CallbackProcessor{callbacks.Create, callbacks.Update, callbacks.Delete} {
+		processor().Remove("gorm:begin_transaction")
+		processor().Remove("gorm:commit_or_rollback_transaction")
+	}
+}
+
+// ormDialect returns the name of the gorm dialect for the driver. All drivers speaking the postgres protocol share the
+// postgres dialect, so they use the same placeholders and read the ids of created rows with RETURNING. The sqlite dialect
+// of gorm is registered as sqlite3.
+func ormDialect(driver string) string {
+	switch driver {
+	case db.DriverNamePostgres, db.DriverNameRedshift, db.DriverNameCrateDb:
+		return db.DriverNamePostgres
+	case db.DriverNameSqlite:
+		return db.DriverNameSqlite3
+	default:
+		return driver
+	}
+}
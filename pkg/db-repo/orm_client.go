@@ -55,3 +55,19 @@ func (c *OrmClient) Query(query string, args ...any) (*sql.Rows, error) {
 func (c *OrmClient) QueryRow(query string, args ...any) *sql.Row {
 	return c.client.QueryRow(context.Background(), query, args...)
 }
+
+// Begin starts a transaction. gorm needs it for its sqlDb interface, but it isn't used for writes, see
+// removeWriteTransactions.
+func (c *OrmClient) Begin() (*sql.Tx, error) {
+	return c.BeginTx(context.Background(), nil)
+}
+
+// BeginTx starts a transaction for gorm.DB.BeginTx, which is used by Repository.WithTx.
+func (c *OrmClient) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	tx, err := c.client.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Tx, nil
+}
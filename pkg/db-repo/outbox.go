@@ -0,0 +1,105 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+)
+
+const ConfigKeyOutbox = "db_repo.outbox"
+
+// OutboxEvent is a row of the outbox table: a model event waiting to be published by the outbox relay.
+type OutboxEvent struct {
+	Id        uint      `db:"id"`
+	ModelId   string    `db:"model_id"`
+	Type      string    `db:"type"`
+	Version   int       `db:"version"`
+	Body      string    `db:"body"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type OutboxSettings struct {
+	Table string              `cfg:"table" default:"outbox_events"`
+	Relay OutboxRelaySettings `cfg:"relay"`
+}
+
+type OutboxRelaySettings struct {
+	// ClientName is the db client of the outbox table, it has to be the same as the one of the repositories writing to it.
+	ClientName string        `cfg:"client_name" default:"default"`
+	Output     string        `cfg:"output" default:"outbox"`
+	BatchSize  int           `cfg:"batch_size" default:"100"`
+	Interval   time.Duration `cfg:"interval" default:"1s"`
+}
+
+func ReadOutboxSettings(config cfg.Config) (*OutboxSettings, error) {
+	settings := &OutboxSettings{}
+
+	if err := config.UnmarshalKey(ConfigKeyOutbox, settings); err != nil {
+		return nil, fmt.Errorf("can not read outbox settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// outboxNotifier writes the notifications to the outbox table in the transaction of the model. As the events are only
+// visible once the transaction got committed, they always match the committed state of the model.
+type outboxNotifier struct {
+	notifier
+	clock       clock.Clock
+	table       string
+	transformer mdl.TransformerResolver
+}
+
+var _ TxNotifier = &outboxNotifier{}
+
+func NewOutboxNotifier(_ context.Context, config cfg.Config, logger log.Logger, modelId mdl.ModelId, version int, transformer mdl.TransformerResolver) (*outboxNotifier, error) {
+	if err := modelId.PadFromConfig(config); err != nil {
+		return nil, fmt.Errorf("can not pad model id from config: %w", err)
+	}
+
+	settings, err := ReadOutboxSettings(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOutboxNotifierWithInterfaces(logger, clock.Provider, modelId, version, transformer, settings.Table), nil
+}
+
+func NewOutboxNotifierWithInterfaces(logger log.Logger, clock clock.Clock, modelId mdl.ModelId, version int, transformer mdl.TransformerResolver, table string) *outboxNotifier {
+	return &outboxNotifier{
+		notifier:    newNotifier(logger, modelId, version),
+		clock:       clock,
+		table:       table,
+		transformer: transformer,
+	}
+}
+
+func (n *outboxNotifier) SendTx(ctx context.Context, txRepo Repository, notificationType string, value ModelBased) error {
+	out := n.transformer("api", n.version, value)
+
+	body, err := json.Marshal(out)
+	if err != nil {
+		n.writeMetric(ctx, err)
+
+		return fmt.Errorf("can not marshal notification on %s for model %s with id %d: %w", notificationType, n.modelId, mdl.EmptyIfNil(value.GetId()), err)
+	}
+
+	qry := fmt.Sprintf("INSERT INTO %s (model_id, type, version, body, created_at) VALUES (?, ?, ?, ?, ?)", n.table)
+
+	if err = txRepo.Exec(ctx, qry, n.modelId.String(), notificationType, n.version, string(body), n.clock.Now().UTC()); err != nil {
+		n.writeMetric(ctx, err)
+
+		return fmt.Errorf("can not write notification on %s for model %s with id %d to the outbox: %w", notificationType, n.modelId, mdl.EmptyIfNil(value.GetId()), err)
+	}
+
+	n.logger.Info(ctx, "wrote notification on %s to the outbox, for model %s with id %d", notificationType, n.modelId, mdl.EmptyIfNil(value.GetId()))
+	n.writeMetric(ctx, nil)
+
+	return nil
+}
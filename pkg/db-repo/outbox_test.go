@@ -0,0 +1,91 @@
+package db_repo_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/stretchr/testify/assert"
+)
+
+const outboxInsert = "INSERT INTO outbox_events (model_id, type, version, body, created_at) VALUES (?, ?, ?, ?, ?)"
+
+func getOutboxRepo(t *testing.T) (goSqlMock.Sqlmock, db_repo.Repository, time.Time) {
+	dbc, repo := getMocks(t, myTestModel)
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	transformer := func(view string, version int, in any) any {
+		return map[string]any{
+			"id": *in.(db_repo.ModelBased).GetId(),
+		}
+	}
+
+	modelId := mdl.ModelId{
+		Application:   "application",
+		Name:          "myTestModel",
+		DomainPattern: "{app.name}",
+	}
+
+	notifier := db_repo.NewOutboxNotifierWithInterfaces(logger, clock.NewFakeClockAt(now), modelId, 1, transformer, "outbox_events")
+	notifyingRepo := db_repo.NewNotifyingRepository(logger, repo)
+	notifyingRepo.AddTxNotifierAll(notifier)
+
+	return dbc, notifyingRepo, now
+}
+
+func TestOutboxNotifier_Delete(t *testing.T) {
+	dbc, repo, now := getOutboxRepo(t)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectExec(regexp.QuoteMeta(outboxInsert)).WithArgs("application.myTestModel", db_repo.Delete, 1, `{"id":1}`, now).WillReturnResult(goSqlMock.NewResult(1, 1))
+	dbc.ExpectCommit()
+
+	err := repo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id1}})
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestOutboxNotifier_RollbackOnError(t *testing.T) {
+	dbc, repo, _ := getOutboxRepo(t)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectExec(regexp.QuoteMeta(outboxInsert)).WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg()).WillReturnError(fmt.Errorf("table is missing"))
+	dbc.ExpectRollback()
+
+	err := repo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id1}})
+
+	assert.ErrorContains(t, err, "table is missing")
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestOutboxNotifier_WithTx(t *testing.T) {
+	dbc, repo, now := getOutboxRepo(t)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectExec(regexp.QuoteMeta(outboxInsert)).WithArgs("application.myTestModel", db_repo.Delete, 1, `{"id":1}`, now).WillReturnResult(goSqlMock.NewResult(1, 1))
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id42).WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectExec(regexp.QuoteMeta(outboxInsert)).WithArgs("application.myTestModel", db_repo.Delete, 1, `{"id":42}`, now).WillReturnResult(goSqlMock.NewResult(2, 1))
+	dbc.ExpectCommit()
+
+	err := repo.WithTx(t.Context(), func(txRepo db_repo.Repository) error {
+		if err := txRepo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id1}}); err != nil {
+			return err
+		}
+
+		return txRepo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id42}})
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
@@ -10,6 +10,11 @@ type page struct {
 	limit  int
 }
 
+type keysetPage struct {
+	cursor string
+	limit  int
+}
+
 type order struct {
 	field     string
 	direction any
@@ -23,6 +28,7 @@ type QueryBuilder struct {
 	groupBy []string
 	orderBy []order
 	page    *page
+	keyset  *keysetPage
 }
 
 func NewQueryBuilder() *QueryBuilder {
@@ -74,6 +80,20 @@ func (qb *QueryBuilder) Page(offset int, size int) db.QueryBuilder {
 		offset: offset,
 		limit:  size,
 	}
+	qb.keyset = nil
+
+	return qb
+}
+
+// PageAfter selects size rows following the row the cursor was created for with EncodeCursor. An empty cursor selects
+// the first page. Instead of an offset, the sort values of the cursor are used in the WHERE clause, so the query
+// needs an order which is unique for every row, e.g. by ending with the primary key.
+func (qb *QueryBuilder) PageAfter(cursor string, size int) db.QueryBuilder {
+	qb.keyset = &keysetPage{
+		cursor: cursor,
+		limit:  size,
+	}
+	qb.page = nil
 
 	return qb
 }
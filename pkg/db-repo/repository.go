@@ -2,15 +2,18 @@ package db_repo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/jinzhu/gorm"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/coffin"
 	"github.com/justtrackio/gosoline/pkg/db"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/mdl"
@@ -19,15 +22,17 @@ import (
 )
 
 const (
-	Create = "create"
-	Read   = "read"
-	Update = "update"
-	Delete = "delete"
-	Query  = "query"
+	Create      = "create"
+	CreateBatch = "create_batch"
+	Read        = "read"
+	Update      = "update"
+	UpsertBatch = "upsert_batch"
+	Delete      = "delete"
+	Query       = "query"
 )
 
 var (
-	operations     = []string{Create, Read, Update, Delete, Query}
+	operations     = []string{Create, CreateBatch, Read, Update, UpsertBatch, Delete, Query}
 	ErrCrossQuery  = fmt.Errorf("cross querying wrong model from repo")
 	ErrCrossCreate = fmt.Errorf("cross creating wrong model from repo")
 	ErrCrossRead   = fmt.Errorf("cross reading wrong model from repo")
@@ -58,12 +63,26 @@ type Repository interface {
 	Create(ctx context.Context, value ModelBased) error
 	Update(ctx context.Context, value ModelBased) error
 	Delete(ctx context.Context, value ModelBased) error
+	// CreateBatch inserts the values with multi-row INSERT statements of up to Metadata.BatchSize rows each. The ids of
+	// created models are not set, read the models again if you need them.
+	CreateBatch(ctx context.Context, values []ModelBased) error
+	// UpsertBatch works like CreateBatch, but updates all columns except the creation date of rows which already exist
+	// instead of failing with a duplicate entry error.
+	UpsertBatch(ctx context.Context, values []ModelBased) error
+	// WithTx runs all operations of the repository passed to do in a single transaction. The transaction is committed
+	// if do returns without an error and rolled back otherwise. Calling WithTx on the repository passed to do runs
+	// do in the already open transaction.
+	WithTx(ctx context.Context, do func(txRepo Repository) error) error
+	// Exec runs a statement on the table of another model, e.g. to write to the outbox table in the same transaction
+	// as the model. Placeholders are written as ? for all databases.
+	Exec(ctx context.Context, query string, args ...any) error
 }
 
 type repository struct {
 	logger          log.Logger
 	tracer          tracing.Tracer
 	orm             *gorm.DB
+	inTx            bool
 	clock           clock.Clock
 	metadata        Metadata
 	noDeleteRefresh bool
@@ -85,7 +104,7 @@ func New(ctx context.Context, config cfg.Config, logger log.Logger, settings Set
 	orm.Callback().
 		Update().
 		After("gorm:update_time_stamp").
-		Register("gosoline:ignore_created_at_if_needed", ignoreCreatedAtIfNeeded)
+		Register(callbackIgnoreCreatedAt, ignoreCreatedAtIfNeeded)
 	clk := clock.Provider
 
 	if err := settings.Metadata.ModelId.PadFromConfig(config); err != nil {
@@ -109,7 +128,7 @@ func NewWithDbSettings(ctx context.Context, config cfg.Config, logger log.Logger
 	orm.Callback().
 		Update().
 		After("gorm:update_time_stamp").
-		Register("gosoline:ignore_created_at_if_needed", ignoreCreatedAtIfNeeded)
+		Register(callbackIgnoreCreatedAt, ignoreCreatedAtIfNeeded)
 
 	clk := clock.Provider
 
@@ -144,7 +163,11 @@ func (r *repository) Create(ctx context.Context, value ModelBased) error {
 	ctx, span := r.startSubSpan(ctx, "Create")
 	defer span.Finish()
 
-	now := r.clock.Now()
+	if err := r.assignTenant(ctx, value); err != nil {
+		return err
+	}
+
+	now := r.now()
 	value.SetUpdatedAt(&now)
 	value.SetCreatedAt(&now)
 
@@ -185,7 +208,12 @@ func (r *repository) Read(ctx context.Context, id *uint, out ModelBased) error {
 	_, span := r.startSubSpan(ctx, "Get")
 	defer span.Finish()
 
-	err := r.orm.First(out, *id).Error
+	orm, err := r.scopeToTenant(ctx, r.orm)
+	if err != nil {
+		return err
+	}
+
+	err = orm.First(out, *id).Error
 
 	if gorm.IsRecordNotFoundError(err) {
 		return NewRecordNotFoundError(*id, modelId, err)
@@ -204,10 +232,29 @@ func (r *repository) Update(ctx context.Context, value ModelBased) error {
 	ctx, span := r.startSubSpan(ctx, "UpdateItem")
 	defer span.Finish()
 
-	now := r.clock.Now()
+	if err := r.assignTenant(ctx, value); err != nil {
+		return err
+	}
+
+	if err := r.checkTenant(ctx, value); err != nil {
+		return err
+	}
+
+	now := r.now()
 	value.SetUpdatedAt(&now)
 
-	err := r.orm.Save(value).Error
+	var err error
+	if versioned, ok := value.(Versioned); ok {
+		err = r.updateVersioned(ctx, value, versioned)
+	} else {
+		err = r.orm.Save(value).Error
+	}
+
+	if IsStaleEntityError(err) {
+		r.logger.Warn(ctx, "could not update model of type %s with id %d: %s", modelId, mdl.EmptyIfNil(value.GetId()), err.Error())
+
+		return err
+	}
 
 	if db.IsDuplicateEntryError(err) {
 		r.logger.Warn(ctx, "could not update model of type %s with id %d due to duplicate entry error: %s", modelId, mdl.EmptyIfNil(value.GetId()), err.Error())
@@ -235,6 +282,49 @@ func (r *repository) Update(ctx context.Context, value ModelBased) error {
 	return r.Read(ctx, value.GetId(), value)
 }
 
+// updateVersioned writes all columns of the value like Save does, but only if the version column still matches the
+// version of the value. Save can't be used, as it falls back to inserting the value if no row was updated.
+func (r *repository) updateVersioned(ctx context.Context, value ModelBased, versioned Versioned) error {
+	orm, err := r.scopeToTenant(ctx, r.orm)
+	if err != nil {
+		return err
+	}
+
+	version := versioned.GetVersion()
+	scope := r.orm.NewScope(value)
+	attrs := make(map[string]any)
+
+	for _, field := range scope.Fields() {
+		if field.IsPrimaryKey || !field.IsNormal || field.IsIgnored {
+			continue
+		}
+
+		// same as for Save, an empty created at must not overwrite the stored one
+		if field.Name == "CreatedAt" && (field.IsBlank || isZeroTime(field.Field.Interface())) {
+			continue
+		}
+
+		attrs[field.DBName] = field.Field.Interface()
+	}
+
+	attrs[ColumnVersion] = version + 1
+
+	result := orm.Model(value).Where(fmt.Sprintf("%s = ?", scope.Quote(ColumnVersion)), version).Updates(attrs)
+	if result.Error != nil {
+		versioned.SetVersion(version)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		versioned.SetVersion(version)
+
+		return NewStaleEntityError(mdl.EmptyIfNil(value.GetId()), r.GetModelId(), version)
+	}
+
+	return nil
+}
+
 func (r *repository) Delete(ctx context.Context, value ModelBased) error {
 	if !r.isQueryableModel(value) {
 		return fmt.Errorf("table %q: %w", r.orm.NewScope(value).TableName(), ErrCrossDelete)
@@ -245,6 +335,10 @@ func (r *repository) Delete(ctx context.Context, value ModelBased) error {
 	_, span := r.startSubSpan(ctx, "Delete")
 	defer span.Finish()
 
+	if err := r.checkTenant(ctx, value); err != nil {
+		return err
+	}
+
 	err := r.refreshAssociations(value, Delete)
 	if err != nil {
 		r.logger.Error(ctx, "could not delete associations of model type %s with id %d: %w", modelId, *value.GetId(), err)
@@ -252,7 +346,12 @@ func (r *repository) Delete(ctx context.Context, value ModelBased) error {
 		return err
 	}
 
-	err = r.orm.Delete(value).Error
+	orm, err := r.scopeToTenant(ctx, r.orm)
+	if err != nil {
+		return err
+	}
+
+	err = orm.Delete(value).Error
 	if err != nil {
 		r.logger.Error(ctx, "could not delete model of type %s with id %d: %w", modelId, *value.GetId(), err)
 	}
@@ -262,6 +361,61 @@ func (r *repository) Delete(ctx context.Context, value ModelBased) error {
 	return err
 }
 
+func (r *repository) WithTx(ctx context.Context, do func(txRepo Repository) error) (err error) {
+	if r.inTx {
+		return do(r)
+	}
+
+	txOrm := r.orm.BeginTx(ctx, nil)
+
+	if errors.Is(txOrm.Error, gorm.ErrCantStartTransaction) {
+		return fmt.Errorf("the db client of the repository for %s does not support transactions", r.GetModelId())
+	}
+
+	if txOrm.Error != nil {
+		return fmt.Errorf("can not begin tx: %w", txOrm.Error)
+	}
+
+	defer func() {
+		if rec := coffin.ResolveRecovery(recover()); rec != nil {
+			err = multierror.Append(err, fmt.Errorf("panic: %w", rec))
+		}
+
+		if err == nil {
+			return
+		}
+
+		if errRollback := txOrm.Rollback().Error; errRollback != nil {
+			err = multierror.Append(err, fmt.Errorf("can not rollback tx: %w", errRollback))
+		}
+	}()
+
+	txRepo := *r
+	txRepo.orm = txOrm
+	txRepo.inTx = true
+
+	if err = do(&txRepo); err != nil {
+		return err
+	}
+
+	if err = txOrm.Commit().Error; err != nil {
+		return fmt.Errorf("can not commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) Exec(ctx context.Context, query string, args ...any) error {
+	_, span := r.startSubSpan(ctx, "Exec")
+	defer span.Finish()
+
+	if err := r.orm.Exec(query, args...).Error; err != nil {
+		return fmt.Errorf("can not execute statement: %w", err)
+	}
+
+	return nil
+}
+
 func (r *repository) isQueryableModel(model any) bool {
 	tableName := r.orm.NewScope(model).TableName()
 
@@ -293,7 +447,10 @@ func (r *repository) Query(ctx context.Context, qb *QueryBuilder, result any) er
 	_, span := r.startSubSpan(ctx, "Query")
 	defer span.Finish()
 
-	db := r.orm.New()
+	db, err := r.scopeToTenant(ctx, r.orm.New())
+	if err != nil {
+		return err
+	}
 
 	for _, j := range qb.joins {
 		db = db.Joins(j)
@@ -323,6 +480,12 @@ func (r *repository) Query(ctx context.Context, qb *QueryBuilder, result any) er
 		db = db.Limit(qb.page.limit)
 	}
 
+	if qb.keyset != nil {
+		if db, err = r.applyKeyset(db, qb); err != nil {
+			return err
+		}
+	}
+
 	db = db.Table(r.GetMetadata().TableName)
 
 	err = db.Find(result).Error
@@ -334,6 +497,26 @@ func (r *repository) Query(ctx context.Context, qb *QueryBuilder, result any) er
 	return err
 }
 
+func (r *repository) applyKeyset(db *gorm.DB, qb *QueryBuilder) (*gorm.DB, error) {
+	db = db.Limit(qb.keyset.limit)
+
+	if qb.keyset.cursor == "" {
+		return db, nil
+	}
+
+	values, err := DecodeCursor(qb.keyset.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, args, err := keysetCondition(qb.orderBy, values)
+	if err != nil {
+		return nil, fmt.Errorf("can not build keyset condition: %w", err)
+	}
+
+	return db.Where(condition, args...), nil
+}
+
 func (r *repository) Count(ctx context.Context, qb *QueryBuilder, model ModelBased) (int, error) {
 	_, span := r.startSubSpan(ctx, "Count")
 	defer span.Finish()
@@ -342,7 +525,10 @@ func (r *repository) Count(ctx context.Context, qb *QueryBuilder, model ModelBas
 		Count int
 	}{}
 
-	db := r.orm.New()
+	db, err := r.scopeToTenant(ctx, r.orm.New())
+	if err != nil {
+		return 0, err
+	}
 
 	for _, j := range qb.joins {
 		db = db.Joins(j)
@@ -357,7 +543,7 @@ func (r *repository) Count(ctx context.Context, qb *QueryBuilder, model ModelBas
 	key := scope.PrimaryKey()
 	sel := fmt.Sprintf("COUNT(DISTINCT %s.%s) AS count", tableName, key)
 
-	err := db.Table(tableName).Select(sel).Scan(&result).Error
+	err = db.Table(tableName).Select(sel).Scan(&result).Error
 
 	return result.Count, err
 }
@@ -480,6 +666,18 @@ func (r *repository) GetMetadata() Metadata {
 	return r.metadata
 }
 
+// now returns the current time for the timestamps of a model. Postgres ignores the location of values for columns
+// without time zone, so the timestamps are converted to UTC to match the session time zone of the connection.
+func (r *repository) now() time.Time {
+	now := r.clock.Now()
+
+	if r.orm.Dialect().GetName() == db.DriverNamePostgres {
+		return now.UTC()
+	}
+
+	return now
+}
+
 func (r *repository) startSubSpan(ctx context.Context, action string) (context.Context, tracing.Span) {
 	modelName := r.GetModelId()
 	spanName := fmt.Sprintf("db_repo.%v.%v", modelName, action)
@@ -503,12 +701,23 @@ func readIdsFromReflectValue(values reflect.Value) []string {
 
 func ignoreCreatedAtIfNeeded(scope *gorm.Scope) {
 	// if you perform an update and do not specify the CreatedAt field on your data, gorm will set it to time.Time{}
-	// (0000-00-00 00:00:00 in mysql). To avoid this, we mark the field as ignored if it is empty
+	// (0000-00-00 00:00:00 in mysql, 0001-01-01 00:00:00 in postgres). To avoid this, we mark the field as ignored if it is empty
 	if m, ok := getModel(scope.Value); ok && (m.GetCreatedAt() == nil || m.GetCreatedAt().Equal(time.Time{})) {
 		scope.Search.Omit("CreatedAt")
 	}
 }
 
+func isZeroTime(value any) bool {
+	switch t := value.(type) {
+	case time.Time:
+		return t.IsZero()
+	case *time.Time:
+		return t == nil || t.IsZero()
+	default:
+		return false
+	}
+}
+
 func getModel(value any) (TimestampAware, bool) {
 	if value == nil {
 		return nil, false
@@ -0,0 +1,164 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jinzhu/gorm"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/funk"
+)
+
+const columnCreatedAt = "created_at"
+
+func (r *repository) CreateBatch(ctx context.Context, values []ModelBased) error {
+	return r.writeBatch(ctx, CreateBatch, values)
+}
+
+func (r *repository) UpsertBatch(ctx context.Context, values []ModelBased) error {
+	return r.writeBatch(ctx, UpsertBatch, values)
+}
+
+func (r *repository) writeBatch(ctx context.Context, op string, values []ModelBased) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	for _, value := range values {
+		if !r.isQueryableModel(value) {
+			return fmt.Errorf("table %q: %w", r.orm.NewScope(value).TableName(), ErrCrossCreate)
+		}
+	}
+
+	ctx, span := r.startSubSpan(ctx, op)
+	defer span.Finish()
+
+	_, scoped, err := r.tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the update of a conflicting row can't be restricted to a tenant
+	if scoped && op == UpsertBatch {
+		return fmt.Errorf("upserting models of type %s is not supported for a single tenant", r.GetModelId())
+	}
+
+	for _, value := range values {
+		if err := r.assignTenant(ctx, value); err != nil {
+			return err
+		}
+	}
+
+	now := r.now()
+	for _, value := range values {
+		value.SetUpdatedAt(&now)
+		value.SetCreatedAt(&now)
+	}
+
+	batchSize := r.metadata.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	chunks := funk.Chunk(values, batchSize)
+
+	write := func(orm *gorm.DB) error {
+		for _, chunk := range chunks {
+			qry, args, err := r.buildBatchStatement(chunk, op == UpsertBatch)
+			if err != nil {
+				return err
+			}
+
+			if err := orm.Exec(qry, args...).Error; err != nil {
+				if db.IsDuplicateEntryError(err) {
+					return &db.DuplicateEntryError{
+						Err: err,
+					}
+				}
+
+				return fmt.Errorf("can not write batch of %d models: %w", len(chunk), err)
+			}
+		}
+
+		return nil
+	}
+
+	// a single statement is atomic already, more need a transaction to not leave a partially written batch behind
+	if len(chunks) == 1 {
+		err = write(r.orm)
+	} else {
+		err = r.WithTx(ctx, func(txRepo Repository) error {
+			return write(txRepo.(*repository).orm)
+		})
+	}
+
+	if err != nil {
+		r.logger.Error(ctx, "could not write batch of %d models of type %s: %w", len(values), r.GetModelId(), err)
+
+		return err
+	}
+
+	r.logger.Info(ctx, "wrote %d models of type %s with %s", len(values), r.GetModelId(), op)
+
+	return nil
+}
+
+// buildBatchStatement builds a multi-row INSERT statement for the values. The primary key is only written if it is set
+// for the values, so the database can assign it otherwise.
+func (r *repository) buildBatchStatement(values []ModelBased, upsert bool) (string, []any, error) {
+	withIds := values[0].GetId() != nil
+	dialect := db.GetDialect(r.orm.Dialect().GetName())
+	scope := r.orm.NewScope(values[0])
+
+	columns := make([]string, 0)
+	keyColumns := make([]string, 0)
+	updateColumns := make([]string, 0)
+
+	for _, field := range scope.Fields() {
+		if !field.IsNormal || field.IsIgnored {
+			continue
+		}
+
+		switch {
+		case field.IsPrimaryKey && !withIds:
+			continue
+		case field.IsPrimaryKey:
+			keyColumns = append(keyColumns, field.DBName)
+		case field.DBName != columnCreatedAt:
+			updateColumns = append(updateColumns, field.DBName)
+		}
+
+		columns = append(columns, field.DBName)
+	}
+
+	quotedColumns := funk.Map(columns, dialect.QuoteIdentifier)
+	insert := squirrel.Insert(scope.TableName()).Columns(quotedColumns...)
+
+	for _, value := range values {
+		if (value.GetId() != nil) != withIds {
+			return "", nil, fmt.Errorf("can not mix models with and without id in a batch of %s", r.GetModelId())
+		}
+
+		valueScope := r.orm.NewScope(value)
+		row := make([]any, len(columns))
+
+		for i, column := range columns {
+			field, ok := valueScope.FieldByName(column)
+			if !ok {
+				return "", nil, fmt.Errorf("model of type %T has no column %s", value, column)
+			}
+
+			row[i] = field.Field.Interface()
+		}
+
+		insert = insert.Values(row...)
+	}
+
+	if upsert {
+		insert = insert.Suffix(dialect.UpsertSuffix(keyColumns, updateColumns))
+	}
+
+	// gorm replaces the question marks with the placeholders of the dialect
+	return insert.PlaceholderFormat(squirrel.Question).ToSql()
+}
@@ -1,17 +1,24 @@
 package db_repo_test
 
 import (
+	"context"
 	"database/sql/driver"
+	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
 	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
 	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
 	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/exec"
 	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
 	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/tracing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type MyTestModel struct {
@@ -23,6 +30,8 @@ const (
 	manyToMany  = "manyToMany"
 	oneOfMany   = "oneOfMany"
 	hasMany     = "hasMany"
+	versioned   = "versioned"
+	tenantModel = "tenantModel"
 )
 
 var MyTestModelMetadata = db_repo.Metadata{
@@ -96,11 +105,31 @@ type Ones struct {
 	HasManyId *uint
 }
 
+type VersionedModel struct {
+	db_repo.Model
+	db_repo.Versioning
+	Name *string
+}
+
+var VersionedModelMetadata = db_repo.Metadata{
+	ModelId: mdl.ModelId{
+		Application: "application",
+		Name:        "versionedModel",
+	},
+	TableName:  "versioned_models",
+	PrimaryKey: "versioned_models.id",
+	Mappings: db_repo.FieldMappings{
+		"versionedModel.id": db_repo.NewFieldMapping("versioned_models.id"),
+	},
+}
+
 var metadatas = map[string]db_repo.Metadata{
 	"myTestModel": MyTestModelMetadata,
 	"manyToMany":  ManyToManyMetadata,
 	"oneOfMany":   OneOfManyMetadata,
 	"hasMany":     HasManyMetadata,
+	"versioned":   VersionedModelMetadata,
+	"tenantModel": TenantModelMetadata,
 }
 
 type idMatcher struct{}
@@ -120,9 +149,7 @@ func TestRepository_Create(t *testing.T) {
 	dbc, repo := getTimedMocks(t, now, myTestModel)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("INSERT INTO `my_test_models` \\(`id`,`updated_at`,`created_at`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(id1, &now, &now).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	model := MyTestModel{
 		Model: db_repo.Model{
@@ -144,6 +171,39 @@ func TestRepository_Create(t *testing.T) {
 	assert.Equal(t, &now, model.CreatedAt, "CreatedAt should match")
 }
 
+func TestRepository_CreatePostgres(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	now := time.Unix(1549964818, 0).In(time.FixedZone("CET", 3600))
+	utcNow := now.UTC()
+
+	sqlDb, dbc, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	orm, err := db_repo.NewOrmWithInterfaces(sqlDb, db_repo.OrmSettings{
+		Driver: "postgres",
+	})
+	assert.NoError(t, err)
+
+	repo := db_repo.NewWithInterfaces(logger, tracing.NewLocalTracer(), orm, clock.NewFakeClockAt(now), MyTestModelMetadata)
+
+	dbc.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "my_test_models" ("updated_at","created_at") VALUES ($1,$2) RETURNING "my_test_models"."id"`)).
+		WithArgs(&utcNow, &utcNow).
+		WillReturnRows(goSqlMock.NewRows([]string{"id"}).AddRow(1))
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &utcNow, &utcNow)
+	dbc.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "my_test_models" WHERE "my_test_models"."id" = $1 AND (("my_test_models"."id" = 1)) ORDER BY "my_test_models"."id" ASC LIMIT 1`)).
+		WithArgs(id1).
+		WillReturnRows(rows)
+
+	model := MyTestModel{}
+	err = repo.Create(t.Context(), &model)
+	assert.NoError(t, err, "there should not be an error")
+	assert.NoError(t, dbc.ExpectationsWereMet())
+
+	assert.Equal(t, id1, model.Id, "the id should be read with RETURNING")
+	assert.Equal(t, &utcNow, model.CreatedAt, "timestamps should be written in UTC")
+}
+
 func TestRepository_CreateManyToManyNoRelation(t *testing.T) {
 	now := time.Unix(1549964818, 0)
 	dbc, repo := getTimedMocks(t, now, manyToMany)
@@ -151,12 +211,8 @@ func TestRepository_CreateManyToManyNoRelation(t *testing.T) {
 	result := goSqlMock.NewResult(0, 1)
 	delRes := goSqlMock.NewResult(0, 0)
 
-	dbc.ExpectBegin()
 	dbc.ExpectExec("INSERT INTO `many_to_manies` \\(`id`,`updated_at`,`created_at`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(id1, &now, &now).WillReturnResult(result)
-	dbc.ExpectCommit()
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `many_of_manies` WHERE \\(`many_to_many_id` IN \\(\\?\\)\\)").WithArgs(id1).WillReturnResult(delRes)
-	dbc.ExpectCommit()
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
 	dbc.ExpectQuery("SELECT \\* FROM `many_to_manies` WHERE `many_to_manies`\\.`id` = \\? AND \\(\\(`many_to_manies`\\.`id` = 1\\)\\) ORDER BY `many_to_manies`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
@@ -186,17 +242,13 @@ func TestRepository_CreateManyToMany(t *testing.T) {
 	result := goSqlMock.NewResult(0, 1)
 	delRes := goSqlMock.NewResult(0, 0)
 
-	dbc.ExpectBegin()
 	dbc.ExpectExec("INSERT INTO `many_to_manies` \\(`id`,`updated_at`,`created_at`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(id1, &now, &now).WillReturnResult(result)
-	dbc.ExpectCommit()
 	dbc.ExpectExec(
 		"INSERT INTO `many_of_manies` \\((`my_test_model_id`|`many_to_many_id`),(`many_to_many_id`|`my_test_model_id`)\\) "+
 			"SELECT \\?,\\? FROM DUAL WHERE NOT EXISTS \\(SELECT \\* FROM `many_of_manies` "+"WHERE (`my_test_model_id`|`many_to_many_id`) = \\? AND (`my_test_model_id`|`many_to_many_id`) = \\?\\)",
 	).WithArgs(idMatcher{}, idMatcher{}, idMatcher{}, idMatcher{}).WillReturnResult(result)
 
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `many_of_manies`  WHERE \\(`my_test_model_id` NOT IN \\(\\?\\)\\) AND \\(`many_to_many_id` IN \\(\\?\\)\\)").WithArgs(id42, id1).WillReturnResult(delRes)
-	dbc.ExpectCommit()
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
 	dbc.ExpectQuery("SELECT \\* FROM `many_to_manies` WHERE `many_to_manies`\\.`id` = \\? AND \\(\\(`many_to_manies`\\.`id` = 1\\)\\) ORDER BY `many_to_manies`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
@@ -231,9 +283,7 @@ func TestRepository_CreateManyToOneNoRelation(t *testing.T) {
 	dbc, repo := getTimedMocks(t, now, oneOfMany)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("INSERT INTO `one_of_manies` \\(`id`,`updated_at`,`created_at`,`my_test_model_id`\\) VALUES \\(\\?,\\?,\\?,\\?\\)").WithArgs(id1, &now, &now, (*uint)(nil)).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	model := OneOfMany{
 		Model: db_repo.Model{
@@ -261,9 +311,7 @@ func TestRepository_CreateManyToOne(t *testing.T) {
 
 	result := goSqlMock.NewResult(0, 1)
 
-	dbc.ExpectBegin()
 	dbc.ExpectExec("INSERT INTO `one_of_manies` \\(`id`,`updated_at`,`created_at`,`my_test_model_id`\\) VALUES \\(\\?,\\?,\\?,\\?\\)").WithArgs(id1, &now, &now, id42).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "my_test_model_id"}).AddRow(id1, &now, &now, id42)
 	dbc.ExpectQuery("SELECT \\* FROM `one_of_manies` WHERE `one_of_manies`\\.`id` = \\? AND \\(\\(`one_of_manies`\\.`id` = 1\\)\\) ORDER BY `one_of_manies`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
@@ -298,9 +346,7 @@ func TestRepository_CreateHasManyNoRelation(t *testing.T) {
 
 	result := goSqlMock.NewResult(0, 1)
 	delResult := goSqlMock.NewResult(0, 0)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("INSERT INTO `has_manies` \\(`id`,`updated_at`,`created_at`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(id1, &now, &now).WillReturnResult(result)
-	dbc.ExpectCommit()
 	dbc.ExpectExec("DELETE FROM manies WHERE has_many_id = 1").WillReturnResult(delResult)
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
@@ -332,11 +378,9 @@ func TestRepository_CreateHasMany(t *testing.T) {
 	result := goSqlMock.NewResult(0, 1)
 	delResult := goSqlMock.NewResult(0, 0)
 
-	dbc.ExpectBegin()
 	dbc.ExpectExec("INSERT INTO `has_manies` \\(`updated_at`,`created_at`\\) VALUES \\(\\?,\\?\\)").WithArgs(&now, &now).WillReturnResult(result)
 	dbc.ExpectExec("INSERT INTO `ones` \\(`updated_at`,`created_at`,`has_many_id`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), 0).WillReturnResult(result)
 	dbc.ExpectExec("INSERT INTO `ones` \\(`updated_at`,`created_at`,`has_many_id`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), 0).WillReturnResult(result)
-	dbc.ExpectCommit()
 	dbc.ExpectExec("DELETE FROM manies WHERE has_many_id = 0 AND id NOT IN \\(0,0\\)").WillReturnResult(delResult)
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
@@ -368,9 +412,7 @@ func TestRepository_Update(t *testing.T) {
 
 	result := goSqlMock.NewResult(0, 1)
 
-	dbc.ExpectBegin()
 	dbc.ExpectExec("UPDATE `my_test_models` SET `updated_at` = \\? WHERE `my_test_models`\\.`id` = \\?").WithArgs(goSqlMock.AnyArg(), id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
 	dbc.ExpectQuery("SELECT \\* FROM `my_test_models` WHERE `my_test_models`\\.`id` = \\? AND \\(\\(`my_test_models`\\.`id` = 1\\)\\) ORDER BY `my_test_models`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
@@ -390,17 +432,68 @@ func TestRepository_Update(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRepository_UpdateVersioned(t *testing.T) {
+	dbc, repo := getMocks(t, versioned)
+	now := time.Unix(1549964818, 0)
+
+	result := goSqlMock.NewResult(0, 1)
+
+	dbc.ExpectExec(regexp.QuoteMeta("UPDATE `versioned_models` SET `name` = ?, `updated_at` = ?, `version` = ?  WHERE `versioned_models`.`id` = ? AND ((`version` = ?))")).
+		WithArgs("updated", goSqlMock.AnyArg(), int64(4), id1, int64(3)).
+		WillReturnResult(result)
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "version", "name"}).AddRow(id1, &now, &now, 4, "updated")
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `versioned_models` WHERE `versioned_models`.`id` = ? AND ((`versioned_models`.`id` = 1)) ORDER BY `versioned_models`.`id` ASC LIMIT 1")).WithArgs(id1).WillReturnRows(rows)
+
+	model := VersionedModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+		Versioning: db_repo.Versioning{
+			Version: 3,
+		},
+		Name: mdl.Box("updated"),
+	}
+
+	err := repo.Update(t.Context(), &model)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), model.Version)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestRepository_UpdateVersionedStale(t *testing.T) {
+	dbc, repo := getMocks(t, versioned)
+
+	dbc.ExpectExec(regexp.QuoteMeta("UPDATE `versioned_models` SET `name` = ?, `updated_at` = ?, `version` = ?  WHERE `versioned_models`.`id` = ? AND ((`version` = ?))")).
+		WithArgs("updated", goSqlMock.AnyArg(), int64(4), id1, int64(3)).
+		WillReturnResult(goSqlMock.NewResult(0, 0))
+
+	model := VersionedModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+		Versioning: db_repo.Versioning{
+			Version: 3,
+		},
+		Name: mdl.Box("updated"),
+	}
+
+	err := repo.Update(t.Context(), &model)
+
+	assert.True(t, db_repo.IsStaleEntityError(err))
+	assert.EqualError(t, err, "model of type .versionedModel with id 1 is stale: version 3 was modified or deleted concurrently")
+	assert.Equal(t, int64(3), model.Version, "the version should be restored after a failed update")
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
 func TestRepository_UpdateManyToManyNoRelation(t *testing.T) {
 	dbc, repo := getMocks(t, manyToMany)
 	now := time.Unix(1549964818, 0)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("UPDATE `many_to_manies` SET `updated_at` = \\? WHERE `many_to_manies`\\.`id` = \\?").WithArgs(goSqlMock.AnyArg(), id1).WillReturnResult(result)
-	dbc.ExpectCommit()
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `many_of_manies`  WHERE \\(`many_to_many_id` IN \\(\\?\\)\\)").WithArgs(id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
 	dbc.ExpectQuery("SELECT \\* FROM `many_to_manies` WHERE `many_to_manies`\\.`id` = \\? AND \\(\\(`many_to_manies`\\.`id` = 1\\)\\) ORDER BY `many_to_manies`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
@@ -427,16 +520,12 @@ func TestRepository_UpdateManyToMany(t *testing.T) {
 
 	result := goSqlMock.NewResult(0, 1)
 
-	dbc.ExpectBegin()
 	dbc.ExpectExec("UPDATE `many_to_manies` SET `updated_at` = \\? WHERE `many_to_manies`\\.`id` = \\?").WithArgs(goSqlMock.AnyArg(), id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 	dbc.ExpectExec(
 		"INSERT INTO `many_of_manies` \\((`my_test_model_id`|`many_to_many_id`),(`many_to_many_id`|`my_test_model_id`)\\) "+
 			"SELECT \\?,\\? FROM DUAL WHERE NOT EXISTS \\(SELECT \\* FROM `many_of_manies` "+"WHERE (`my_test_model_id`|`many_to_many_id`) = \\? AND (`my_test_model_id`|`many_to_many_id`) = \\?\\)",
 	).WithArgs(idMatcher{}, idMatcher{}, idMatcher{}, idMatcher{}).WillReturnResult(result)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `many_of_manies`  WHERE \\(`my_test_model_id` NOT IN \\(\\?\\)\\) AND \\(`many_to_many_id` IN \\(\\?\\)\\)").WithArgs(id42, id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
 	dbc.ExpectQuery("SELECT \\* FROM `many_to_manies` WHERE `many_to_manies`\\.`id` = \\? AND \\(\\(`many_to_manies`\\.`id` = 1\\)\\) ORDER BY `many_to_manies`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
@@ -469,9 +558,7 @@ func TestRepository_UpdateManyToOneNoRelation(t *testing.T) {
 	now := time.Unix(1549964818, 0)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("UPDATE `one_of_manies` SET `updated_at` = \\?, `my_test_model_id` = \\?  WHERE `one_of_manies`\\.`id` = \\?").WithArgs(goSqlMock.AnyArg(), (*uint)(nil), id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
 	dbc.ExpectQuery("SELECT \\* FROM `one_of_manies` WHERE `one_of_manies`\\.`id` = \\? AND \\(\\(`one_of_manies`\\.`id` = 1\\)\\) ORDER BY `one_of_manies`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
@@ -498,9 +585,7 @@ func TestRepository_UpdateManyToOne(t *testing.T) {
 	now := time.Unix(1549964818, 0)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("UPDATE `one_of_manies` SET `updated_at` = \\?, `my_test_model_id` = \\?  WHERE `one_of_manies`\\.`id` = \\?").WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
 	dbc.ExpectQuery("SELECT \\* FROM `one_of_manies` WHERE `one_of_manies`\\.`id` = \\? AND \\(\\(`one_of_manies`\\.`id` = 1\\)\\) ORDER BY `one_of_manies`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
@@ -533,12 +618,10 @@ func TestRepository_UpdateHasMany(t *testing.T) {
 
 	result := goSqlMock.NewResult(0, 1)
 	delResult := goSqlMock.NewResult(0, 0)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("UPDATE `has_manies` SET `updated_at` = \\? WHERE `has_manies`\\.`id` = \\?").WithArgs(goSqlMock.AnyArg(), id1).WillReturnResult(result)
 	dbc.ExpectExec("INSERT INTO `ones` \\(`updated_at`,`created_at`,`has_many_id`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), *id1).WillReturnResult(result)
 	dbc.ExpectExec("INSERT INTO `ones` \\(`updated_at`,`created_at`,`has_many_id`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), *id1).WillReturnResult(result)
 	dbc.ExpectExec("INSERT INTO `ones` \\(`updated_at`,`created_at`,`has_many_id`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), *id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 	dbc.ExpectExec("DELETE FROM manies WHERE has_many_id = 1 AND id NOT IN \\(0,0,0\\)").WillReturnResult(delResult)
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
@@ -570,9 +653,7 @@ func TestRepository_UpdateHasManyNoRelation(t *testing.T) {
 	result := goSqlMock.NewResult(0, 1)
 	delResult := goSqlMock.NewResult(0, 0)
 
-	dbc.ExpectBegin()
 	dbc.ExpectExec("UPDATE `has_manies` SET `updated_at` = \\? WHERE `has_manies`\\.`id` = \\?").WithArgs(goSqlMock.AnyArg(), id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 	dbc.ExpectExec("DELETE FROM manies WHERE has_many_id = 1").WillReturnResult(delResult)
 
 	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
@@ -599,9 +680,7 @@ func TestRepository_Delete(t *testing.T) {
 	dbc, repo := getMocks(t, myTestModel)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	model := MyTestModel{
 		Model: db_repo.Model{
@@ -618,17 +697,216 @@ func TestRepository_Delete(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestRepository_DeleteManyToManyNoRelation(t *testing.T) {
-	dbc, repo := getMocks(t, manyToMany)
+func TestRepository_WithTx(t *testing.T) {
+	dbc, repo := getMocks(t, myTestModel)
 
 	result := goSqlMock.NewResult(0, 1)
 	dbc.ExpectBegin()
-	dbc.ExpectExec("DELETE FROM `many_of_manies`  WHERE \\(`many_to_many_id` IN \\(\\?\\)\\)").WithArgs(id1).WillReturnResult(result)
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id1).WillReturnResult(result)
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id42).WillReturnResult(result)
+	dbc.ExpectCommit()
+
+	err := repo.WithTx(t.Context(), func(txRepo db_repo.Repository) error {
+		if err := txRepo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id1}}); err != nil {
+			return err
+		}
+
+		// nested calls run in the transaction which is already open
+		return txRepo.WithTx(t.Context(), func(txRepo db_repo.Repository) error {
+			return txRepo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id42}})
+		})
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestRepository_WithTxOrmClient(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	sqlDb, dbc, err := goSqlMock.New()
+	require.NoError(t, err)
+
+	client := db.NewClientWithInterfaces(logger, sqlx.NewDb(sqlDb, "mysql"), exec.NewDefaultExecutor())
+	orm, err := db_repo.NewOrmWithInterfaces(db_repo.NewOrmClientWithInterfaces(client), db_repo.OrmSettings{
+		Driver: "mysql",
+	})
+	require.NoError(t, err)
+
+	repo := db_repo.NewWithInterfaces(logger, tracing.NewLocalTracer(), orm, clock.NewFakeClock(), metadatas[myTestModel])
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id1).WillReturnResult(goSqlMock.NewResult(0, 1))
 	dbc.ExpectCommit()
+
+	err = repo.WithTx(t.Context(), func(txRepo db_repo.Repository) error {
+		return txRepo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id1}})
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestRepository_CreateOrmClientWithoutTx(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	now := time.Unix(1549964818, 0)
+
+	sqlDb, dbc, err := goSqlMock.New()
+	require.NoError(t, err)
+
+	client := db.NewClientWithInterfaces(logger, sqlx.NewDb(sqlDb, "mysql"), exec.NewDefaultExecutor())
+	orm, err := db_repo.NewOrmWithInterfaces(db_repo.NewOrmClientWithInterfaces(client), db_repo.OrmSettings{
+		Driver: "mysql",
+	})
+	require.NoError(t, err)
+
+	repo := db_repo.NewWithInterfaces(logger, tracing.NewLocalTracer(), orm, clock.NewFakeClockAt(now), metadatas[myTestModel])
+
+	// the client supports transactions, but only WithTx should open them
+	dbc.ExpectExec("INSERT INTO `my_test_models` \\(`id`,`updated_at`,`created_at`\\) VALUES \\(\\?,\\?,\\?\\)").WithArgs(id1, &now, &now).WillReturnResult(goSqlMock.NewResult(0, 1))
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
+	dbc.ExpectQuery("SELECT \\* FROM `my_test_models`").WithArgs(id1).WillReturnRows(rows)
+
+	err = repo.Create(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id1}})
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet(), "a single write should not be run in a transaction")
+}
+
+func TestNotifyingRepository_WithTxRollback(t *testing.T) {
+	dbc, repo := getMocks(t, myTestModel)
+
 	dbc.ExpectBegin()
-	dbc.ExpectExec("DELETE FROM `many_to_manies`  WHERE `many_to_manies`\\.`id` = \\?").WithArgs(id1).WillReturnResult(result)
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectRollback()
+
+	notifier := &recordingNotifier{}
+	notifyingRepo := db_repo.NewNotifyingRepository(logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t)), repo)
+	notifyingRepo.AddNotifierAll(notifier)
+
+	err := notifyingRepo.WithTx(t.Context(), func(txRepo db_repo.Repository) error {
+		if err := txRepo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id1}}); err != nil {
+			return err
+		}
+
+		return fmt.Errorf("validation failed")
+	})
+
+	assert.EqualError(t, err, "validation failed")
+	assert.NoError(t, dbc.ExpectationsWereMet())
+	assert.Empty(t, notifier.sent, "notifications of a rolled back transaction should not be sent")
+}
+
+func TestNotifyingRepository_WithTx(t *testing.T) {
+	dbc, repo := getMocks(t, myTestModel)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("DELETE FROM `my_test_models`  WHERE `my_test_models`\\.`id` = \\?").WithArgs(id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectCommit()
+
+	notifier := &recordingNotifier{}
+	notifyingRepo := db_repo.NewNotifyingRepository(logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t)), repo)
+	notifyingRepo.AddNotifierAll(notifier)
+
+	err := notifyingRepo.WithTx(t.Context(), func(txRepo db_repo.Repository) error {
+		err := txRepo.Delete(t.Context(), &MyTestModel{Model: db_repo.Model{Id: id1}})
+		assert.Empty(t, notifier.sent, "notifications should be sent after the commit")
+
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+	assert.Equal(t, []string{db_repo.Delete}, notifier.sent)
+}
+
+func TestRepository_CreateBatch(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	dbc, repo := getBatchMocks(t, "mysql", now)
+
+	result := goSqlMock.NewResult(0, 2)
+	dbc.ExpectBegin()
+	dbc.ExpectExec(regexp.QuoteMeta("INSERT INTO my_test_models (`updated_at`,`created_at`) VALUES (?,?),(?,?)")).WithArgs(&now, &now, &now, &now).WillReturnResult(result)
+	dbc.ExpectExec(regexp.QuoteMeta("INSERT INTO my_test_models (`updated_at`,`created_at`) VALUES (?,?)")).WithArgs(&now, &now).WillReturnResult(result)
 	dbc.ExpectCommit()
 
+	err := repo.CreateBatch(t.Context(), []db_repo.ModelBased{&MyTestModel{}, &MyTestModel{}, &MyTestModel{}})
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestRepository_UpsertBatch(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	dbc, repo := getBatchMocks(t, "mysql", now)
+
+	dbc.ExpectExec(regexp.QuoteMeta("INSERT INTO my_test_models (`id`,`updated_at`,`created_at`) VALUES (?,?,?),(?,?,?) ON DUPLICATE KEY UPDATE `updated_at` = VALUES(`updated_at`)")).
+		WithArgs(id1, &now, &now, id42, &now, &now).
+		WillReturnResult(goSqlMock.NewResult(0, 3))
+
+	err := repo.UpsertBatch(t.Context(), []db_repo.ModelBased{
+		&MyTestModel{Model: db_repo.Model{Id: id1}},
+		&MyTestModel{Model: db_repo.Model{Id: id42}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestRepository_UpsertBatchPostgres(t *testing.T) {
+	now := time.Unix(1549964818, 0).UTC()
+	dbc, repo := getBatchMocks(t, "postgres", now)
+
+	dbc.ExpectExec(regexp.QuoteMeta(`INSERT INTO my_test_models ("id","updated_at","created_at") VALUES ($1,$2,$3) ON CONFLICT ("id") DO UPDATE SET "updated_at" = EXCLUDED."updated_at"`)).
+		WithArgs(id1, &now, &now).
+		WillReturnResult(goSqlMock.NewResult(0, 1))
+
+	err := repo.UpsertBatch(t.Context(), []db_repo.ModelBased{&MyTestModel{Model: db_repo.Model{Id: id1}}})
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestRepository_UpsertBatchMixedIds(t *testing.T) {
+	dbc, repo := getBatchMocks(t, "mysql", time.Unix(1549964818, 0))
+
+	err := repo.UpsertBatch(t.Context(), []db_repo.ModelBased{&MyTestModel{Model: db_repo.Model{Id: id1}}, &MyTestModel{}})
+	assert.EqualError(t, err, "can not mix models with and without id in a batch of .myTestModel")
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func getBatchMocks(t *testing.T, driver string, now time.Time) (goSqlMock.Sqlmock, db_repo.Repository) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	sqlDb, dbc, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	orm, err := db_repo.NewOrmWithInterfaces(sqlDb, db_repo.OrmSettings{
+		Driver: driver,
+	})
+	assert.NoError(t, err)
+
+	metadata := MyTestModelMetadata
+	metadata.BatchSize = 2
+
+	return dbc, db_repo.NewWithInterfaces(logger, tracing.NewLocalTracer(), orm, clock.NewFakeClockAt(now), metadata)
+}
+
+type recordingNotifier struct {
+	sent []string
+}
+
+func (n *recordingNotifier) Send(_ context.Context, notificationType string, _ db_repo.ModelBased) error {
+	n.sent = append(n.sent, notificationType)
+
+	return nil
+}
+
+func TestRepository_DeleteManyToManyNoRelation(t *testing.T) {
+	dbc, repo := getMocks(t, manyToMany)
+
+	result := goSqlMock.NewResult(0, 1)
+	dbc.ExpectExec("DELETE FROM `many_of_manies`  WHERE \\(`many_to_many_id` IN \\(\\?\\)\\)").WithArgs(id1).WillReturnResult(result)
+	dbc.ExpectExec("DELETE FROM `many_to_manies`  WHERE `many_to_manies`\\.`id` = \\?").WithArgs(id1).WillReturnResult(result)
+
 	model := ManyToMany{
 		Model: db_repo.Model{
 			Id: id1,
@@ -648,12 +926,8 @@ func TestRepository_DeleteManyToMany(t *testing.T) {
 	dbc, repo := getMocks(t, manyToMany)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `many_of_manies`  WHERE \\(`many_to_many_id` IN \\(\\?\\)\\)").WithArgs(id1).WillReturnResult(result)
-	dbc.ExpectCommit()
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `many_to_manies`  WHERE `many_to_manies`\\.`id` = \\?").WithArgs(id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	model := ManyToMany{
 		Model: db_repo.Model{
@@ -681,9 +955,7 @@ func TestRepository_DeleteManyToOneNoRelation(t *testing.T) {
 	dbc, repo := getMocks(t, oneOfMany)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `one_of_manies`  WHERE `one_of_manies`\\.`id` = \\?").WithArgs(id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	model := OneOfMany{
 		Model: db_repo.Model{
@@ -704,9 +976,7 @@ func TestRepository_DeleteManyToOne(t *testing.T) {
 	dbc, repo := getMocks(t, oneOfMany)
 
 	result := goSqlMock.NewResult(0, 1)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `one_of_manies`  WHERE `one_of_manies`\\.`id` = \\?").WithArgs(id1).WillReturnResult(result)
-	dbc.ExpectCommit()
 
 	model := OneOfMany{
 		Model: db_repo.Model{
@@ -736,9 +1006,7 @@ func TestRepository_DeleteHasMany(t *testing.T) {
 	parentResult := goSqlMock.NewResult(0, 1)
 
 	dbc.ExpectExec("DELETE FROM manies WHERE has_many_id = 1").WillReturnResult(childResult)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `has_manies`  WHERE `has_manies`\\.`id` = ?").WithArgs(id1).WillReturnResult(parentResult)
-	dbc.ExpectCommit()
 
 	model := HasMany{
 		Model: db_repo.Model{
@@ -774,9 +1042,7 @@ func TestRepository_DeleteHasManyNoRelation(t *testing.T) {
 	parentResult := goSqlMock.NewResult(0, 1)
 
 	dbc.ExpectExec("DELETE FROM manies WHERE has_many_id = 1").WillReturnResult(childResult)
-	dbc.ExpectBegin()
 	dbc.ExpectExec("DELETE FROM `has_manies`  WHERE `has_manies`\\.`id` = ?").WithArgs(id1).WillReturnResult(parentResult)
-	dbc.ExpectCommit()
 
 	model := HasMany{
 		Model: db_repo.Model{
@@ -0,0 +1,145 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+//go:generate go run github.com/vektra/mockery/v2 --name TypedRepositoryReadOnly
+type TypedRepositoryReadOnly[T ModelBased] interface {
+	Read(ctx context.Context, id *uint) (T, error)
+	Query(ctx context.Context, qb *QueryBuilder) ([]T, error)
+	Count(ctx context.Context, qb *QueryBuilder) (int, error)
+
+	GetModelId() string
+	GetModelName() string
+	GetMetadata() Metadata
+}
+
+// TypedRepository is a Repository for models of type T, which is a pointer to the model struct. Instead of filling
+// result values passed by the caller, it returns the read models.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name TypedRepository
+type TypedRepository[T ModelBased] interface {
+	TypedRepositoryReadOnly[T]
+	Create(ctx context.Context, value T) error
+	Update(ctx context.Context, value T) error
+	Delete(ctx context.Context, value T) error
+	CreateBatch(ctx context.Context, values []T) error
+	UpsertBatch(ctx context.Context, values []T) error
+	WithTx(ctx context.Context, do func(txRepo TypedRepository[T]) error) error
+	// Untyped returns the wrapped repository, e.g. to pass it to code which isn't generic yet.
+	Untyped() Repository
+}
+
+type typedRepository[T ModelBased] struct {
+	repo Repository
+}
+
+func NewTypedRepository[T ModelBased](ctx context.Context, config cfg.Config, logger log.Logger, s Settings) (TypedRepository[T], error) {
+	repo, err := New(ctx, config, logger, s)
+	if err != nil {
+		return nil, fmt.Errorf("can not create repository: %w", err)
+	}
+
+	return NewTypedRepositoryWithInterfaces[T](repo), nil
+}
+
+// NewTypedRepositoryWithInterfaces wraps any Repository, including the notifying, metric and validating
+// repositories, so their behavior stays in place.
+func NewTypedRepositoryWithInterfaces[T ModelBased](repo Repository) TypedRepository[T] {
+	return &typedRepository[T]{
+		repo: repo,
+	}
+}
+
+func (r *typedRepository[T]) Read(ctx context.Context, id *uint) (T, error) {
+	model := NewModel[T]()
+
+	if err := r.repo.Read(ctx, id, model); err != nil {
+		return *new(T), err
+	}
+
+	return model, nil
+}
+
+func (r *typedRepository[T]) Query(ctx context.Context, qb *QueryBuilder) ([]T, error) {
+	result := make([]T, 0)
+
+	if err := r.repo.Query(ctx, qb, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *typedRepository[T]) Count(ctx context.Context, qb *QueryBuilder) (int, error) {
+	return r.repo.Count(ctx, qb, NewModel[T]())
+}
+
+func (r *typedRepository[T]) GetModelId() string {
+	return r.repo.GetModelId()
+}
+
+func (r *typedRepository[T]) GetModelName() string {
+	return r.repo.GetModelName()
+}
+
+func (r *typedRepository[T]) GetMetadata() Metadata {
+	return r.repo.GetMetadata()
+}
+
+func (r *typedRepository[T]) Create(ctx context.Context, value T) error {
+	return r.repo.Create(ctx, value)
+}
+
+func (r *typedRepository[T]) Update(ctx context.Context, value T) error {
+	return r.repo.Update(ctx, value)
+}
+
+func (r *typedRepository[T]) Delete(ctx context.Context, value T) error {
+	return r.repo.Delete(ctx, value)
+}
+
+func (r *typedRepository[T]) CreateBatch(ctx context.Context, values []T) error {
+	return r.repo.CreateBatch(ctx, toModelBased(values))
+}
+
+func (r *typedRepository[T]) UpsertBatch(ctx context.Context, values []T) error {
+	return r.repo.UpsertBatch(ctx, toModelBased(values))
+}
+
+func (r *typedRepository[T]) WithTx(ctx context.Context, do func(txRepo TypedRepository[T]) error) error {
+	return r.repo.WithTx(ctx, func(txRepo Repository) error {
+		return do(NewTypedRepositoryWithInterfaces[T](txRepo))
+	})
+}
+
+func (r *typedRepository[T]) Untyped() Repository {
+	return r.repo
+}
+
+// NewModel returns a pointer to a new, empty model of type T. T has to be a pointer to a struct.
+func NewModel[T ModelBased]() T {
+	modelType := reflect.TypeOf((*T)(nil)).Elem()
+
+	if modelType.Kind() != reflect.Ptr {
+		panic(fmt.Errorf("the model type %s has to be a pointer to a struct", modelType))
+	}
+
+	return reflect.New(modelType.Elem()).Interface().(T)
+}
+
+func toModelBased[T ModelBased](values []T) []ModelBased {
+	models := make([]ModelBased, len(values))
+
+	for i, value := range values {
+		models[i] = value
+	}
+
+	return models
+}
@@ -0,0 +1,63 @@
+package db_repo_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypedRepository_Read(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	dbc, untyped := getTimedMocks(t, now, myTestModel)
+	repo := db_repo.NewTypedRepositoryWithInterfaces[*MyTestModel](untyped)
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &now, &now)
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `my_test_models` WHERE (`my_test_models`.`id` = 1) ORDER BY `my_test_models`.`id` ASC LIMIT 1")).WillReturnRows(rows)
+
+	model, err := repo.Read(t.Context(), id1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, id1, model.Id)
+	assert.Equal(t, &now, model.CreatedAt)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestTypedRepository_ReadNotFound(t *testing.T) {
+	dbc, untyped := getMocks(t, myTestModel)
+	repo := db_repo.NewTypedRepositoryWithInterfaces[*MyTestModel](untyped)
+
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `my_test_models` WHERE (`my_test_models`.`id` = 1) ORDER BY `my_test_models`.`id` ASC LIMIT 1")).
+		WillReturnRows(goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}))
+
+	model, err := repo.Read(t.Context(), id1)
+
+	assert.Nil(t, model)
+	assert.True(t, db_repo.IsRecordNotFoundError(err))
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestTypedRepository_Query(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	dbc, untyped := getTimedMocks(t, now, myTestModel)
+	repo := db_repo.NewTypedRepositoryWithInterfaces[*MyTestModel](untyped)
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).
+		AddRow(id1, &now, &now).
+		AddRow(id42, &now, &now)
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `my_test_models` WHERE (id > ?)")).WithArgs(0).WillReturnRows(rows)
+
+	qb := db_repo.NewQueryBuilder()
+	qb.Where("id > ?", 0)
+
+	models, err := repo.Query(t.Context(), qb)
+
+	assert.NoError(t, err)
+	assert.Len(t, models, 2)
+	assert.Equal(t, id1, models[0].Id)
+	assert.Equal(t, id42, models[1].Id)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
@@ -0,0 +1,107 @@
+package db_repo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	_ "github.com/justtrackio/gosoline/pkg/db/sqlite"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sqliteTestSchema = "CREATE TABLE `local_test_models` (\n" +
+	"  `id` int unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(255) NOT NULL,\n" +
+	"  `updated_at` datetime NOT NULL,\n" +
+	"  `created_at` datetime NOT NULL,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  KEY `name` (`name`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+
+type LocalTestModel struct {
+	db_repo.Model
+	Name string
+}
+
+var LocalTestModelMetadata = db_repo.Metadata{
+	ModelId: mdl.ModelId{
+		Application: "application",
+		Name:        "localTestModel",
+	},
+	TableName:  "local_test_models",
+	PrimaryKey: "local_test_models.id",
+}
+
+func getSqliteRepo(t *testing.T) db_repo.Repository {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	connection, err := db.NewConnectionWithInterfaces(logger, &db.Settings{
+		Driver: db.DriverNameSqlite,
+		Parameters: map[string]string{
+			"mode": db.SqliteModeMemory,
+		},
+		Uri: db.SettingsUri{
+			Database: t.Name(),
+		},
+	}, tracing.NewNoopTracer())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, connection.Close())
+	})
+
+	_, err = connection.Exec(db.AdjustSqliteSchema(sqliteTestSchema))
+	require.NoError(t, err)
+
+	orm, err := db_repo.NewOrmWithInterfaces(connection, db_repo.OrmSettings{
+		Driver: db.DriverNameSqlite,
+	})
+	require.NoError(t, err)
+
+	testClock := clock.NewFakeClockAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	return db_repo.NewWithInterfaces(logger, tracing.NewLocalTracer(), orm, testClock, LocalTestModelMetadata)
+}
+
+func TestRepository_Sqlite(t *testing.T) {
+	ctx := t.Context()
+	repo := getSqliteRepo(t)
+
+	alice := &LocalTestModel{Name: "alice"}
+	bob := &LocalTestModel{Name: "bob"}
+
+	assert.NoError(t, repo.Create(ctx, alice))
+	assert.NoError(t, repo.Create(ctx, bob))
+	assert.Equal(t, mdl.Box(uint(1)), alice.Id)
+	assert.Equal(t, mdl.Box(uint(2)), bob.Id)
+
+	read := &LocalTestModel{}
+	assert.NoError(t, repo.Read(ctx, alice.Id, read))
+	assert.Equal(t, "alice", read.Name)
+
+	read.Name = "carol"
+	assert.NoError(t, repo.Update(ctx, read))
+
+	result := make([]*LocalTestModel, 0)
+	qb := db_repo.NewQueryBuilder()
+	qb.Where("name = ?", "carol")
+
+	assert.NoError(t, repo.Query(ctx, qb, &result))
+	assert.Len(t, result, 1)
+	assert.Equal(t, alice.Id, result[0].Id)
+
+	count, err := repo.Count(ctx, db_repo.NewQueryBuilder(), &LocalTestModel{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	assert.NoError(t, repo.Delete(ctx, bob))
+
+	err = repo.Read(ctx, bob.Id, &LocalTestModel{})
+	assert.True(t, db_repo.IsRecordNotFoundError(err))
+}
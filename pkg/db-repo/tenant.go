@@ -0,0 +1,108 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+)
+
+var ErrMissingTenant = fmt.Errorf("the context contains no tenant id")
+
+type (
+	tenantCtxKey         struct{}
+	tenantUnscopedCtxKey struct{}
+)
+
+// WithTenant returns a context restricting all operations of repositories for models with a Metadata.TenantColumn to
+// the rows of the given tenant.
+func WithTenant(ctx context.Context, tenantId string) context.Context {
+	return context.WithValue(ctx, tenantCtxKey{}, tenantId)
+}
+
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenantId, ok := ctx.Value(tenantCtxKey{}).(string)
+
+	return tenantId, ok
+}
+
+// WithoutTenantScope returns a context allowing operations across all tenants, e.g. for admin endpoints or
+// maintenance jobs. Without it, operations on models with a tenant column fail if the context contains no tenant.
+func WithoutTenantScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantUnscopedCtxKey{}, true)
+}
+
+// tenantScope returns the tenant the operation is restricted to. scoped is false if the model has no tenant column or
+// the scope got disabled with WithoutTenantScope.
+func (r *repository) tenantScope(ctx context.Context) (tenantId string, scoped bool, err error) {
+	if r.metadata.TenantColumn == "" {
+		return "", false, nil
+	}
+
+	if unscoped, _ := ctx.Value(tenantUnscopedCtxKey{}).(bool); unscoped {
+		return "", false, nil
+	}
+
+	if tenantId, ok := TenantFromContext(ctx); ok {
+		return tenantId, true, nil
+	}
+
+	return "", false, fmt.Errorf("model of type %s is scoped by tenant: %w", r.GetModelId(), ErrMissingTenant)
+}
+
+func (r *repository) scopeToTenant(ctx context.Context, orm *gorm.DB) (*gorm.DB, error) {
+	tenantId, scoped, err := r.tenantScope(ctx)
+	if err != nil || !scoped {
+		return orm, err
+	}
+
+	column := fmt.Sprintf("%s.%s", orm.Dialect().Quote(r.metadata.TableName), orm.Dialect().Quote(r.metadata.TenantColumn))
+
+	return orm.Where(fmt.Sprintf("%s = ?", column), tenantId), nil
+}
+
+// assignTenant sets the tenant column of the value to the tenant of the context, so a model can't be moved to another
+// tenant.
+func (r *repository) assignTenant(ctx context.Context, value ModelBased) error {
+	tenantId, scoped, err := r.tenantScope(ctx)
+	if err != nil || !scoped {
+		return err
+	}
+
+	if err = r.orm.NewScope(value).SetColumn(r.metadata.TenantColumn, tenantId); err != nil {
+		return fmt.Errorf("can not set tenant column %s of model %s: %w", r.metadata.TenantColumn, r.GetModelId(), err)
+	}
+
+	return nil
+}
+
+// checkTenant ensures the stored row of the value belongs to the tenant of the context. Rows of other tenants are
+// reported as not found, so their ids can't be probed.
+func (r *repository) checkTenant(ctx context.Context, value ModelBased) error {
+	if _, scoped, err := r.tenantScope(ctx); err != nil || !scoped {
+		return err
+	}
+
+	orm, err := r.scopeToTenant(ctx, r.orm.New())
+	if err != nil {
+		return err
+	}
+
+	id := mdl.EmptyIfNil(value.GetId())
+	scope := r.orm.NewScope(value)
+	count := 0
+
+	err = orm.Table(r.metadata.TableName).
+		Where(fmt.Sprintf("%s.%s = ?", scope.QuotedTableName(), scope.Quote(scope.PrimaryKey())), id).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("can not check the tenant of model %s with id %d: %w", r.GetModelId(), id, err)
+	}
+
+	if count == 0 {
+		return NewRecordNotFoundError(id, r.GetModelId(), gorm.ErrRecordNotFound)
+	}
+
+	return nil
+}
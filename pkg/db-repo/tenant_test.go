@@ -0,0 +1,143 @@
+package db_repo_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/stretchr/testify/assert"
+)
+
+type TenantModel struct {
+	db_repo.Model
+	TenantId string
+}
+
+var TenantModelMetadata = db_repo.Metadata{
+	ModelId: mdl.ModelId{
+		Application: "application",
+		Name:        "tenantModel",
+	},
+	TableName:    "tenant_models",
+	PrimaryKey:   "tenant_models.id",
+	TenantColumn: "tenant_id",
+	Mappings: db_repo.FieldMappings{
+		"tenantModel.id": db_repo.NewFieldMapping("tenant_models.id"),
+	},
+}
+
+func TestTenant_Read(t *testing.T) {
+	dbc, repo := getMocks(t, tenantModel)
+	ctx := db_repo.WithTenant(t.Context(), "acme")
+
+	rows := goSqlMock.NewRows([]string{"id", "tenant_id"}).AddRow(id1, "acme")
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tenant_models` WHERE (`tenant_models`.`tenant_id` = ?) AND (`tenant_models`.`id` = 1) ORDER BY `tenant_models`.`id` ASC LIMIT 1")).
+		WithArgs("acme").
+		WillReturnRows(rows)
+
+	model := &TenantModel{}
+	err := repo.Read(ctx, id1, model)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", model.TenantId)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestTenant_MissingTenant(t *testing.T) {
+	dbc, repo := getMocks(t, tenantModel)
+
+	err := repo.Read(t.Context(), id1, &TenantModel{})
+	assert.ErrorIs(t, err, db_repo.ErrMissingTenant)
+
+	err = repo.Query(t.Context(), db_repo.NewQueryBuilder(), &[]TenantModel{})
+	assert.ErrorIs(t, err, db_repo.ErrMissingTenant)
+
+	err = repo.Delete(t.Context(), &TenantModel{Model: db_repo.Model{Id: id1}})
+	assert.ErrorIs(t, err, db_repo.ErrMissingTenant)
+
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestTenant_WithoutTenantScope(t *testing.T) {
+	dbc, repo := getMocks(t, tenantModel)
+	ctx := db_repo.WithoutTenantScope(db_repo.WithTenant(t.Context(), "acme"))
+
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tenant_models`")).
+		WillReturnRows(goSqlMock.NewRows([]string{"id", "tenant_id"}).AddRow(id1, "acme").AddRow(id42, "other"))
+
+	result := make([]TenantModel, 0)
+	err := repo.Query(ctx, db_repo.NewQueryBuilder(), &result)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestTenant_Query(t *testing.T) {
+	dbc, repo := getMocks(t, tenantModel)
+	ctx := db_repo.WithTenant(t.Context(), "acme")
+
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tenant_models` WHERE (`tenant_models`.`tenant_id` = ?) AND (id = ?)")).
+		WithArgs("acme", 42).
+		WillReturnRows(goSqlMock.NewRows([]string{"id", "tenant_id"}))
+
+	qb := db_repo.NewQueryBuilder()
+	qb.Where("id = ?", 42)
+
+	result := make([]TenantModel, 0)
+	err := repo.Query(ctx, qb, &result)
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestTenant_CreateAssignsTenant(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	dbc, repo := getTimedMocks(t, now, tenantModel)
+	ctx := db_repo.WithTenant(t.Context(), "acme")
+
+	dbc.ExpectExec(regexp.QuoteMeta("INSERT INTO `tenant_models` (`id`,`updated_at`,`created_at`,`tenant_id`) VALUES (?,?,?,?)")).
+		WithArgs(id1, &now, &now, "acme").
+		WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tenant_models` WHERE `tenant_models`.`id` = ? AND ((`tenant_models`.`tenant_id` = ?) AND (`tenant_models`.`id` = 1))")).
+		WithArgs(id1, "acme").
+		WillReturnRows(goSqlMock.NewRows([]string{"id", "tenant_id"}).AddRow(id1, "acme"))
+
+	model := &TenantModel{
+		Model:    db_repo.Model{Id: id1},
+		TenantId: "other",
+	}
+	err := repo.Create(ctx, model)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", model.TenantId)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestTenant_UpdateOtherTenant(t *testing.T) {
+	dbc, repo := getMocks(t, tenantModel)
+	ctx := db_repo.WithTenant(t.Context(), "acme")
+
+	dbc.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tenant_models` WHERE (`tenant_models`.`tenant_id` = ?) AND (`tenant_models`.`id` = ?)")).
+		WithArgs("acme", uint(1)).
+		WillReturnRows(goSqlMock.NewRows([]string{"count"}).AddRow(0))
+
+	err := repo.Update(ctx, &TenantModel{Model: db_repo.Model{Id: id1}})
+
+	assert.True(t, db_repo.IsRecordNotFoundError(err), "rows of other tenants should not be found")
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestTenant_UpsertBatch(t *testing.T) {
+	dbc, repo := getMocks(t, tenantModel)
+	ctx := db_repo.WithTenant(context.Background(), "acme")
+
+	err := repo.UpsertBatch(ctx, []db_repo.ModelBased{&TenantModel{}})
+
+	assert.EqualError(t, err, "upserting models of type .tenantModel is not supported for a single tenant")
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
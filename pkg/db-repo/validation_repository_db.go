@@ -39,3 +39,32 @@ func (r validationRepository) Update(ctx context.Context, value ModelBased) erro
 
 	return err
 }
+
+func (r validationRepository) CreateBatch(ctx context.Context, values []ModelBased) error {
+	for _, value := range values {
+		if err := r.validator.IsValid(ctx, value); err != nil {
+			return err
+		}
+	}
+
+	return r.Repository.CreateBatch(ctx, values)
+}
+
+func (r validationRepository) UpsertBatch(ctx context.Context, values []ModelBased) error {
+	for _, value := range values {
+		if err := r.validator.IsValid(ctx, value); err != nil {
+			return err
+		}
+	}
+
+	return r.Repository.UpsertBatch(ctx, values)
+}
+
+func (r validationRepository) WithTx(ctx context.Context, do func(txRepo Repository) error) error {
+	return r.Repository.WithTx(ctx, func(txRepo Repository) error {
+		return do(&validationRepository{
+			Repository: txRepo,
+			validator:  r.validator,
+		})
+	})
+}
@@ -76,6 +76,7 @@ type (
 		Select(ctx context.Context, dest any, query string, args ...any) error
 		NamedSelect(ctx context.Context, dest any, query string, arg any) error
 		Get(ctx context.Context, dest any, query string, args ...any) error
+		BeginTx(ctx context.Context, ops *sql.TxOptions) (*sqlx.Tx, error)
 		WithTx(ctx context.Context, ops *sql.TxOptions, do func(ctx context.Context, tx *sqlx.Tx) error) error
 		Close() error
 	}
@@ -83,6 +84,7 @@ type (
 	ClientSqlx struct {
 		logger   log.Logger
 		db       *sqlx.DB
+		runner   queryRunner
 		executor exec.Executor
 	}
 
@@ -126,7 +128,7 @@ func NewClientWithSettings(ctx context.Context, config cfg.Config, logger log.Lo
 		executor   = exec.NewDefaultExecutor()
 	)
 
-	if connection, err = ProvideConnectionFromSettings(ctx, logger, name, settings); err != nil {
+	if connection, err = ProvideConnectionFromSettings(ctx, config, logger, name, settings); err != nil {
 		return nil, fmt.Errorf("can not connect to sql database: %w", err)
 	}
 
@@ -138,6 +140,15 @@ func NewClientWithSettings(ctx context.Context, config cfg.Config, logger log.Lo
 
 	client := NewClientWithInterfaces(logger, connection, executor)
 
+	if settings.StatementCache.Enabled {
+		statements, err := ProvideStatementCache(ctx, name, settings, connection)
+		if err != nil {
+			return nil, fmt.Errorf("can not create statement cache for sql client %s: %w", name, err)
+		}
+
+		ClientWithStatementCache(statements)(client)
+	}
+
 	for _, option := range options {
 		option(client)
 	}
@@ -149,6 +160,7 @@ func NewClientWithInterfaces(logger log.Logger, connection *sqlx.DB, executor ex
 	return &ClientSqlx{
 		logger:   logger,
 		db:       connection,
+		runner:   connection,
 		executor: executor,
 	}
 }
@@ -240,7 +252,7 @@ func (c *ClientSqlx) Exec(ctx context.Context, query string, args ...any) (sql.R
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		return c.db.ExecContext(ctx, query, args...)
+		return c.runner.ExecContext(ctx, query, args...)
 	})
 	if err != nil {
 		return nil, err
@@ -332,7 +344,7 @@ func (c *ClientSqlx) Query(ctx context.Context, query string, args ...any) (*sql
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		return c.db.QueryContext(ctx, query, args...)
+		return c.runner.QueryContext(ctx, query, args...)
 	})
 	if err != nil {
 		return nil, err
@@ -345,7 +357,7 @@ func (c *ClientSqlx) QueryRow(ctx context.Context, query string, args ...any) *s
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		return c.db.QueryRowContext(ctx, query, args...), nil
+		return c.runner.QueryRowContext(ctx, query, args...), nil
 	})
 	if err != nil {
 		return nil
@@ -479,6 +491,13 @@ func (c *ClientSqlx) Close() error {
 	return c.db.Close()
 }
 
+// ClientWithStatementCache executes the statements of Exec, Query and QueryRow with the prepared statements of the cache.
+func ClientWithStatementCache(statements *StatementCache) ClientOption {
+	return func(client *ClientSqlx) {
+		client.runner = statements
+	}
+}
+
 func ClientWithExecutor(executor exec.Executor) ClientOption {
 	return func(c *ClientSqlx) {
 		c.executor = executor
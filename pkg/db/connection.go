@@ -10,7 +10,9 @@ import (
 	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
 	"github.com/justtrackio/gosoline/pkg/reslife"
+	"github.com/justtrackio/gosoline/pkg/tracing"
 )
 
 type connectionCtxKey string
@@ -23,7 +25,7 @@ func ProvideConnection(ctx context.Context, config cfg.Config, logger log.Logger
 		return nil, err
 	}
 
-	return ProvideConnectionFromSettings(ctx, logger, name, settings)
+	return ProvideConnectionFromSettings(ctx, config, logger, name, settings)
 }
 
 func NewConnection(ctx context.Context, config cfg.Config, logger log.Logger, name string) (*sqlx.DB, error) {
@@ -35,24 +37,36 @@ func NewConnection(ctx context.Context, config cfg.Config, logger log.Logger, na
 		return nil, err
 	}
 
-	if con, err = NewConnectionFromSettings(ctx, logger, name, settings); err != nil {
+	if con, err = NewConnectionFromSettings(ctx, config, logger, name, settings); err != nil {
 		return nil, err
 	}
 
 	return con, nil
 }
 
-func ProvideConnectionFromSettings(ctx context.Context, logger log.Logger, name string, settings *Settings) (*sqlx.DB, error) {
+func ProvideConnectionFromSettings(ctx context.Context, config cfg.Config, logger log.Logger, name string, settings *Settings) (*sqlx.DB, error) {
 	return appctx.Provide(ctx, connectionCtxKey(fmt.Sprint(settings)), func() (*sqlx.DB, error) {
-		return NewConnectionFromSettings(ctx, logger, name, settings)
+		return NewConnectionFromSettings(ctx, config, logger, name, settings)
 	})
 }
 
-func NewConnectionFromSettings(ctx context.Context, logger log.Logger, name string, settings *Settings) (*sqlx.DB, error) {
+func NewConnectionFromSettings(ctx context.Context, config cfg.Config, logger log.Logger, name string, settings *Settings) (*sqlx.DB, error) {
 	var err error
 	var connection *sqlx.DB
+	var tracer tracing.Tracer = tracing.NewNoopTracer()
 
-	if connection, err = NewConnectionWithInterfaces(logger, settings); err != nil {
+	if settings.Tracing.Enabled {
+		if tracer, err = tracing.ProvideTracer(ctx, config, logger); err != nil {
+			return nil, fmt.Errorf("can not create tracer: %w", err)
+		}
+	}
+
+	hooks := getQueryHooks(name)
+	if settings.QueryLog.Enabled {
+		hooks = append(hooks, newQueryLogHook(logger, metric.NewWriter(), name, settings.QueryLog))
+	}
+
+	if connection, err = NewConnectionWithInterfaces(logger, settings, tracer, hooks...); err != nil {
 		return nil, fmt.Errorf("can not create connection: %w", err)
 	}
 
@@ -64,12 +78,16 @@ func NewConnectionFromSettings(ctx context.Context, logger log.Logger, name stri
 		return nil, fmt.Errorf("can not run migrations: %w", err)
 	}
 
-	publishConnectionMetrics(connection)
+	if settings.Metrics.Enabled {
+		publishConnectionMetrics(connection, name, settings.Metrics.Interval)
+	}
 
 	return connection, nil
 }
 
-func NewConnectionWithInterfaces(logger log.Logger, settings *Settings) (*sqlx.DB, error) {
+// NewConnectionWithInterfaces connects to the database described by settings. If tracing is enabled for the connection,
+// the queries are recorded as spans of the given tracer. The hooks are called for every statement of the connection.
+func NewConnectionWithInterfaces(logger log.Logger, settings *Settings, tracer tracing.Tracer, hooks ...QueryHook) (*sqlx.DB, error) {
 	drv, err := GetDriver(logger, settings.Driver)
 	if err != nil {
 		return nil, fmt.Errorf("could not get dsn provider for driver %s", settings.Driver)
@@ -82,7 +100,17 @@ func NewConnectionWithInterfaces(logger log.Logger, settings *Settings) (*sqlx.D
 		return nil, fmt.Errorf("could not get driver from %s connection factory: %w", settings.Driver, err)
 	}
 
+	if settings.Tracing.Enabled {
+		hooks = append(hooks, newTracingHook(tracer, settings))
+	}
+
+	if len(hooks) > 0 {
+		genDriver = newHookedDriver(genDriver, hooks...)
+	}
+
 	metricDriverId := newMetricDriver(genDriver)
+	// sqlx derives the bind variables from the name of the driver, which is unknown for the wrapping metric driver
+	sqlx.BindDriver(metricDriverId, GetDialect(settings.Driver).BindType())
 
 	db, err := sqlx.Connect(metricDriverId, dsn)
 	if err != nil {
@@ -94,6 +122,13 @@ func NewConnectionWithInterfaces(logger log.Logger, settings *Settings) (*sqlx.D
 	db.SetMaxIdleConns(settings.MaxIdleConnections)
 	db.SetMaxOpenConns(settings.MaxOpenConnections)
 
+	if settings.Driver == DriverNameSqlite && settings.Parameters["mode"] == SqliteModeMemory {
+		// an in-memory database is gone as soon as its last connection got closed
+		db.SetConnMaxIdleTime(0)
+		db.SetConnMaxLifetime(0)
+		db.SetMaxIdleConns(max(settings.MaxIdleConnections, 1))
+	}
+
 	return db, nil
 }
 
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
-	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
@@ -147,7 +147,7 @@ func (d *dataExporter) ExportAllTables(ctx context.Context, dbName string) (data
 			return nil, fmt.Errorf("failed to scan row for table name in database %s: %w", dbName, err)
 		}
 
-		if funk.Contains(d.ignoredModels, model) {
+		if slices.Contains(d.ignoredModels, model) {
 			continue
 		}
 
@@ -4,10 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/justtrackio/gosoline/pkg/cfg"
-	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
@@ -78,7 +78,7 @@ func (i *dataImporter) ImportDatabase(ctx context.Context, database string, data
 
 	for table, rows := range data {
 		// discard fixtures for tables that do not exist in destination database
-		if !funk.Contains(tables, table) {
+		if !slices.Contains(tables, table) {
 			continue
 		}
 
@@ -0,0 +1,148 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// Dialect describes the differences in the SQL syntax of the supported databases.
+type Dialect interface {
+	// PlaceholderFormat returns the format of bind variables for queries built with squirrel.
+	PlaceholderFormat() squirrel.PlaceholderFormat
+	// BindType returns the sqlx bind type used to rebind queries and named queries.
+	BindType() int
+	// QuoteIdentifier quotes the name of a table or column, so it can contain keywords of the database.
+	QuoteIdentifier(identifier string) string
+	// SupportsReturning reports if INSERT, UPDATE and DELETE statements can return the affected rows with RETURNING.
+	SupportsReturning() bool
+	// UpsertSuffix returns the clause to append to an INSERT statement to update the given columns of rows conflicting
+	// on the key columns instead of failing. Without update columns, conflicting rows are left untouched.
+	UpsertSuffix(keyColumns []string, updateColumns []string) string
+}
+
+var dialects = map[string]Dialect{
+	DriverMysql:        mysqlDialect{},
+	DriverNamePostgres: postgresDialect{},
+	DriverNameRedshift: postgresDialect{},
+	DriverNameCrateDb:  postgresDialect{},
+	DriverNameSqlite:   sqliteDialect{},
+	// gorm and the cgo sqlite driver call the dialect sqlite3
+	DriverNameSqlite3: sqliteDialect{},
+}
+
+func AddDialect(driverName string, dialect Dialect) {
+	dialects[driverName] = dialect
+}
+
+// GetDialect returns the dialect of the driver. Drivers without a dialect of their own use the MySQL dialect.
+func GetDialect(driverName string) Dialect {
+	if dialect, ok := dialects[driverName]; ok {
+		return dialect
+	}
+
+	return mysqlDialect{}
+}
+
+type mysqlDialect struct{}
+
+func (d mysqlDialect) PlaceholderFormat() squirrel.PlaceholderFormat {
+	return squirrel.Question
+}
+
+func (d mysqlDialect) BindType() int {
+	return sqlx.QUESTION
+}
+
+func (d mysqlDialect) QuoteIdentifier(identifier string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
+
+func (d mysqlDialect) SupportsReturning() bool {
+	return false
+}
+
+func (d mysqlDialect) UpsertSuffix(keyColumns []string, updateColumns []string) string {
+	// mysql detects the conflict on any unique key, so it has no use for the key columns
+	if len(updateColumns) == 0 {
+		if len(keyColumns) == 0 {
+			return ""
+		}
+
+		// assigning a column to itself turns the statement into a no-op for conflicting rows
+		column := d.QuoteIdentifier(keyColumns[0])
+
+		return fmt.Sprintf("ON DUPLICATE KEY UPDATE %s = %s", column, column)
+	}
+
+	assignments := make([]string, len(updateColumns))
+	for i, column := range updateColumns {
+		column = d.QuoteIdentifier(column)
+		assignments[i] = fmt.Sprintf("%s = VALUES(%s)", column, column)
+	}
+
+	return fmt.Sprintf("ON DUPLICATE KEY UPDATE %s", strings.Join(assignments, ", "))
+}
+
+type postgresDialect struct{}
+
+func (d postgresDialect) PlaceholderFormat() squirrel.PlaceholderFormat {
+	return squirrel.Dollar
+}
+
+func (d postgresDialect) BindType() int {
+	return sqlx.DOLLAR
+}
+
+func (d postgresDialect) QuoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+func (d postgresDialect) SupportsReturning() bool {
+	return true
+}
+
+func (d postgresDialect) UpsertSuffix(keyColumns []string, updateColumns []string) string {
+	keys := make([]string, len(keyColumns))
+	for i, column := range keyColumns {
+		keys[i] = d.QuoteIdentifier(column)
+	}
+
+	if len(updateColumns) == 0 {
+		return fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", strings.Join(keys, ", "))
+	}
+
+	assignments := make([]string, len(updateColumns))
+	for i, column := range updateColumns {
+		column = d.QuoteIdentifier(column)
+		assignments[i] = fmt.Sprintf("%s = EXCLUDED.%s", column, column)
+	}
+
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(keys, ", "), strings.Join(assignments, ", "))
+}
+
+type sqliteDialect struct{}
+
+func (d sqliteDialect) PlaceholderFormat() squirrel.PlaceholderFormat {
+	return squirrel.Question
+}
+
+func (d sqliteDialect) BindType() int {
+	return sqlx.QUESTION
+}
+
+func (d sqliteDialect) QuoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+// SupportsReturning is false, as the sqlite dialect of gorm reads the ids of created rows with LastInsertId.
+func (d sqliteDialect) SupportsReturning() bool {
+	return false
+}
+
+// UpsertSuffix uses the same syntax as postgres, which sqlite supports since 3.24.
+func (d sqliteDialect) UpsertSuffix(keyColumns []string, updateColumns []string) string {
+	return postgresDialect{}.UpsertSuffix(keyColumns, updateColumns)
+}
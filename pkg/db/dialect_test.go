@@ -0,0 +1,52 @@
+package db_test
+
+import (
+	"testing"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDialect_Mysql(t *testing.T) {
+	dialect := db.GetDialect(db.DriverMysql)
+
+	qry, _, err := squirrel.Select("*").From("users").Where(squirrel.Eq{"id": 1}).PlaceholderFormat(dialect.PlaceholderFormat()).ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM users WHERE id = ?", qry)
+
+	assert.Equal(t, "`order`", dialect.QuoteIdentifier("order"))
+	assert.False(t, dialect.SupportsReturning())
+	assert.Equal(t, "ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `updated_at` = VALUES(`updated_at`)", dialect.UpsertSuffix([]string{"id"}, []string{"name", "updated_at"}))
+	assert.Equal(t, "ON DUPLICATE KEY UPDATE `id` = `id`", dialect.UpsertSuffix([]string{"id"}, nil))
+}
+
+func TestDialect_Postgres(t *testing.T) {
+	dialect := db.GetDialect(db.DriverNamePostgres)
+
+	qry, _, err := squirrel.Select("*").From("users").Where(squirrel.Eq{"id": 1}).Where(squirrel.Eq{"name": "foo"}).PlaceholderFormat(dialect.PlaceholderFormat()).ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM users WHERE id = $1 AND name = $2", qry)
+
+	assert.Equal(t, `"order"`, dialect.QuoteIdentifier("order"))
+	assert.True(t, dialect.SupportsReturning())
+	assert.Equal(t, `ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name", "updated_at" = EXCLUDED."updated_at"`, dialect.UpsertSuffix([]string{"id"}, []string{"name", "updated_at"}))
+	assert.Equal(t, `ON CONFLICT ("id") DO NOTHING`, dialect.UpsertSuffix([]string{"id"}, nil))
+}
+
+func TestDialect_Sqlite(t *testing.T) {
+	dialect := db.GetDialect(db.DriverNameSqlite)
+
+	qry, _, err := squirrel.Select("*").From("users").Where(squirrel.Eq{"id": 1}).PlaceholderFormat(dialect.PlaceholderFormat()).ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT * FROM users WHERE id = ?", qry)
+
+	assert.Equal(t, `"order"`, dialect.QuoteIdentifier("order"))
+	assert.False(t, dialect.SupportsReturning())
+	assert.Equal(t, `ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name"`, dialect.UpsertSuffix([]string{"id"}, []string{"name"}))
+	assert.Equal(t, dialect, db.GetDialect(db.DriverNameSqlite3), "the gorm name of the dialect should resolve to the same dialect")
+}
+
+func TestDialect_Unknown(t *testing.T) {
+	assert.Equal(t, db.GetDialect(db.DriverMysql), db.GetDialect("unknown"), "drivers without a dialect should fall back to mysql")
+}
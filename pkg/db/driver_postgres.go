@@ -0,0 +1,58 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/justtrackio/gosoline/pkg/log"
+	_ "github.com/lib/pq"
+)
+
+const DriverNamePostgres = "postgres"
+
+func init() {
+	AddDriverFactory(DriverNamePostgres, NewPostgresDriver)
+}
+
+func NewPostgresDriver(logger log.Logger) (Driver, error) {
+	return &postgresDriver{}, nil
+}
+
+type postgresDriver struct{}
+
+func (m *postgresDriver) GetDSN(settings *Settings) string {
+	dsn := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(settings.Uri.User, settings.Uri.Password),
+		Host:   fmt.Sprintf("%s:%d", settings.Uri.Host, settings.Uri.Port),
+		Path:   settings.Uri.Database,
+	}
+
+	qry := dsn.Query()
+	for k, v := range settings.Parameters {
+		qry.Set(k, v)
+	}
+
+	// timestamps without time zone are read as UTC, so use UTC for the session as well unless configured otherwise
+	if _, ok := settings.Parameters["timezone"]; !ok {
+		qry.Set("timezone", "UTC")
+	}
+
+	if settings.Timeouts.Timeout > 0 {
+		qry.Set("connect_timeout", fmt.Sprintf("%d", int(settings.Timeouts.Timeout.Seconds())))
+	}
+
+	dsn.RawQuery = qry.Encode()
+
+	return dsn.String()
+}
+
+func (m *postgresDriver) GetMigrationDriver(db *sql.DB, database string, migrationsTable string) (database.Driver, error) {
+	return postgres.WithInstance(db, &postgres.Config{
+		MigrationsTable: migrationsTable,
+		DatabaseName:    database,
+	})
+}
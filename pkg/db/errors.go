@@ -6,8 +6,13 @@ import (
 
 	"github.com/VividCortex/mysqlerr"
 	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"github.com/lib/pq"
 )
 
+// pgUniqueViolation is the SQLSTATE postgres reports if a unique constraint is violated.
+const pgUniqueViolation = "23505"
+
 type DuplicateEntryError struct {
 	Err error
 }
@@ -43,5 +48,17 @@ func IsDuplicateEntryError(err error) bool {
 		return mysqlErr.Number == mysqlerr.ER_DUP_ENTRY
 	}
 
+	pqErr := &pq.Error{}
+
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == pgUniqueViolation
+	}
+
+	pgErr := &pgconn.PgError{}
+
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgUniqueViolation
+	}
+
 	return errors.Is(err, &DuplicateEntryError{})
 }
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// HealthCheckModule reports the application as unhealthy as long as the connection can't be pinged. It doesn't do any
+// work on its own, so it stops with the application.
+type HealthCheckModule struct {
+	kernel.BackgroundModule
+	kernel.EssentialStage
+
+	logger  log.Logger
+	conn    pinger
+	name    string
+	timeout time.Duration
+}
+
+var _ kernel.HealthCheckedModule = &HealthCheckModule{}
+
+// HealthCheckModuleFactory adds a health check module for every configured db connection. The modules use the same
+// connections as the rest of the application, see ProvideConnection.
+func HealthCheckModuleFactory(ctx context.Context, config cfg.Config, logger log.Logger) (map[string]kernel.ModuleFactory, error) {
+	modules := map[string]kernel.ModuleFactory{}
+
+	if !config.IsSet("db") {
+		return modules, nil
+	}
+
+	connections, err := config.GetStringMap("db")
+	if err != nil {
+		return nil, fmt.Errorf("can not read db connections: %w", err)
+	}
+
+	for name := range connections {
+		moduleName := fmt.Sprintf("db-health-check-%s", name)
+		modules[moduleName] = NewHealthCheckModule(name)
+	}
+
+	return modules, nil
+}
+
+func NewHealthCheckModule(name string) kernel.ModuleFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+		settings, err := ReadSettings(config, name)
+		if err != nil {
+			return nil, err
+		}
+
+		conn, err := ProvideConnectionFromSettings(ctx, config, logger, name, settings)
+		if err != nil {
+			return nil, fmt.Errorf("can not create connection %s: %w", name, err)
+		}
+
+		return NewHealthCheckModuleWithInterfaces(logger, conn, name, settings.HealthCheck.Timeout), nil
+	}
+}
+
+func NewHealthCheckModuleWithInterfaces(logger log.Logger, conn pinger, name string, timeout time.Duration) *HealthCheckModule {
+	return &HealthCheckModule{
+		logger:  logger.WithChannel("db-health-check"),
+		conn:    conn,
+		name:    name,
+		timeout: timeout,
+	}
+}
+
+func (m *HealthCheckModule) Run(ctx context.Context) error {
+	<-ctx.Done()
+
+	return nil
+}
+
+func (m *HealthCheckModule) IsHealthy(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	if err := m.conn.PingContext(ctx); err != nil {
+		m.logger.Warn(ctx, "can not ping db connection %s: %s", m.name, err.Error())
+
+		return false, nil
+	}
+
+	return true, nil
+}
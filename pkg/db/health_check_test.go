@@ -0,0 +1,33 @@
+package db_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthCheckModule_IsHealthy(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	conn, dbc, err := goSqlMock.New(goSqlMock.MonitorPingsOption(true))
+	assert.NoError(t, err)
+
+	module := db.NewHealthCheckModuleWithInterfaces(logger, conn, "default", time.Second)
+
+	dbc.ExpectPing()
+	healthy, err := module.IsHealthy(t.Context())
+	assert.NoError(t, err)
+	assert.True(t, healthy)
+
+	dbc.ExpectPing().WillReturnError(fmt.Errorf("too many connections"))
+	healthy, err = module.IsHealthy(t.Context())
+	assert.NoError(t, err)
+	assert.False(t, healthy)
+
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
@@ -0,0 +1,404 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+)
+
+const (
+	OperationExec  = "exec"
+	OperationQuery = "query"
+)
+
+var (
+	_ driver.Conn               = &hookedConn{}
+	_ driver.ConnPrepareContext = &hookedConn{}
+	_ driver.ConnBeginTx        = &hookedConn{}
+	_ driver.ExecerContext      = &hookedConn{}
+	_ driver.QueryerContext     = &hookedConn{}
+	_ driver.Pinger             = &hookedConn{}
+	_ driver.SessionResetter    = &hookedConn{}
+	_ driver.Validator          = &hookedConn{}
+	_ driver.NamedValueChecker  = &hookedConn{}
+	_ driver.StmtExecContext    = &hookedStmt{}
+	_ driver.StmtQueryContext   = &hookedStmt{}
+	_ driver.RowsNextResultSet  = &hookedRows{}
+
+	queryHookLck sync.Mutex
+	queryHooks   = map[string][]QueryHook{}
+)
+
+// QueryEvent describes a statement sent to the database. Duration, Rows and Err are set before AfterQuery is called.
+type QueryEvent struct {
+	Operation string
+	Statement string
+	Start     time.Time
+	Duration  time.Duration
+	// Rows is the amount of rows affected by an exec or read by a query, -1 if unknown.
+	Rows int64
+	Err  error
+	// Skipped is set if the driver can't execute the statement directly. database/sql prepares the statement and
+	// executes it again, which is reported as another event.
+	Skipped bool
+}
+
+// QueryHook is notified about every statement executed on a connection.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name QueryHook
+type QueryHook interface {
+	// BeforeQuery is called before the statement is sent to the database. The returned context is used to execute the
+	// statement and passed to AfterQuery.
+	BeforeQuery(ctx context.Context, event *QueryEvent) context.Context
+	// AfterQuery is called once the statement got executed. The rows of a query are only counted once they got closed,
+	// so AfterQuery is called after closing the rows for queries.
+	AfterQuery(ctx context.Context, event *QueryEvent)
+}
+
+// AddQueryHook adds a hook to all connections with the given name created afterward.
+func AddQueryHook(name string, hook QueryHook) {
+	queryHookLck.Lock()
+	defer queryHookLck.Unlock()
+
+	queryHooks[name] = append(queryHooks[name], hook)
+}
+
+func getQueryHooks(name string) []QueryHook {
+	queryHookLck.Lock()
+	defer queryHookLck.Unlock()
+
+	return append([]QueryHook{}, queryHooks[name]...)
+}
+
+// hookedDriver calls the hooks for every statement executed on its connections.
+type hookedDriver struct {
+	driver.Driver
+
+	hooks []QueryHook
+}
+
+func newHookedDriver(drv driver.Driver, hooks ...QueryHook) driver.Driver {
+	return &hookedDriver{
+		Driver: drv,
+		hooks:  hooks,
+	}
+}
+
+func (d *hookedDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hookedConn{
+		Conn:   conn,
+		driver: d,
+	}, nil
+}
+
+func (d *hookedDriver) before(ctx context.Context, operation string, query string) (context.Context, *QueryEvent) {
+	event := &QueryEvent{
+		Operation: operation,
+		Statement: query,
+		Start:     time.Now(),
+		Rows:      -1,
+	}
+
+	for _, hook := range d.hooks {
+		ctx = hook.BeforeQuery(ctx, event)
+	}
+
+	return ctx, event
+}
+
+// executed records the result of the statement. The driver.ErrSkip returned by drivers which can't execute a statement
+// directly is not recorded as error, the statement is reported again once it got prepared.
+func (d *hookedDriver) executed(event *QueryEvent, err error) {
+	event.Duration = time.Since(event.Start)
+
+	if errors.Is(err, driver.ErrSkip) {
+		event.Skipped = true
+
+		return
+	}
+
+	event.Err = err
+}
+
+func (d *hookedDriver) after(ctx context.Context, event *QueryEvent) {
+	for _, hook := range d.hooks {
+		hook.AfterQuery(ctx, event)
+	}
+}
+
+func (d *hookedDriver) finishExec(ctx context.Context, event *QueryEvent, result driver.Result, err error) {
+	d.executed(event, err)
+
+	if err == nil {
+		if rowsAffected, err := result.RowsAffected(); err == nil {
+			event.Rows = rowsAffected
+		}
+	}
+
+	d.after(ctx, event)
+}
+
+func (d *hookedDriver) finishQuery(ctx context.Context, event *QueryEvent, rows driver.Rows, err error) (driver.Rows, error) {
+	d.executed(event, err)
+
+	if err != nil {
+		d.after(ctx, event)
+
+		return rows, err
+	}
+
+	return &hookedRows{
+		Rows:   rows,
+		driver: d,
+		ctx:    ctx,
+		event:  event,
+	}, nil
+}
+
+type hookedConn struct {
+	driver.Conn
+
+	driver *hookedDriver
+}
+
+func (c *hookedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *hookedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var err error
+	var stmt driver.Stmt
+
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = preparer.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &hookedStmt{
+		Stmt:   stmt,
+		driver: c.driver,
+		query:  query,
+	}, nil
+}
+
+func (c *hookedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+
+	//nolint:staticcheck // fallback for drivers without context support, like database/sql does it
+	return c.Conn.Begin()
+}
+
+func (c *hookedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	ctx, event := c.driver.before(ctx, OperationExec, query)
+	result, err := execer.ExecContext(ctx, query, args)
+	c.driver.finishExec(ctx, event, result, err)
+
+	return result, err
+}
+
+func (c *hookedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+
+	ctx, event := c.driver.before(ctx, OperationQuery, query)
+	rows, err := queryer.QueryContext(ctx, query, args)
+
+	return c.driver.finishQuery(ctx, event, rows, err)
+}
+
+func (c *hookedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+
+	return nil
+}
+
+func (c *hookedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+
+	return nil
+}
+
+func (c *hookedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+
+	return true
+}
+
+func (c *hookedConn) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+
+	// database/sql falls back to its default conversion
+	return driver.ErrSkip
+}
+
+type hookedStmt struct {
+	driver.Stmt
+
+	driver *hookedDriver
+	query  string
+}
+
+func (s *hookedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	var err error
+	var result driver.Result
+
+	ctx, event := s.driver.before(ctx, OperationExec, s.query)
+
+	if execer, ok := s.Stmt.(driver.StmtExecContext); ok {
+		result, err = execer.ExecContext(ctx, args)
+	} else {
+		//nolint:staticcheck // fallback for drivers without context support, like database/sql does it
+		result, err = s.Stmt.Exec(namedValuesToValues(args))
+	}
+
+	s.driver.finishExec(ctx, event, result, err)
+
+	return result, err
+}
+
+func (s *hookedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	var err error
+	var rows driver.Rows
+
+	ctx, event := s.driver.before(ctx, OperationQuery, s.query)
+
+	if queryer, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		rows, err = queryer.QueryContext(ctx, args)
+	} else {
+		//nolint:staticcheck // fallback for drivers without context support, like database/sql does it
+		rows, err = s.Stmt.Query(namedValuesToValues(args))
+	}
+
+	return s.driver.finishQuery(ctx, event, rows, err)
+}
+
+// hookedRows counts the rows read and calls the hooks once the rows got closed.
+type hookedRows struct {
+	driver.Rows
+
+	driver *hookedDriver
+	ctx    context.Context
+	event  *QueryEvent
+	count  int64
+	err    error
+}
+
+func (r *hookedRows) Next(dest []driver.Value) error {
+	err := r.Rows.Next(dest)
+
+	switch {
+	case err == nil:
+		r.count++
+	case !errors.Is(err, io.EOF):
+		r.err = err
+	}
+
+	return err
+}
+
+func (r *hookedRows) HasNextResultSet() bool {
+	if rows, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return rows.HasNextResultSet()
+	}
+
+	return false
+}
+
+func (r *hookedRows) NextResultSet() error {
+	if rows, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return rows.NextResultSet()
+	}
+
+	return io.EOF
+}
+
+func (r *hookedRows) ColumnTypeScanType(index int) reflect.Type {
+	if rows, ok := r.Rows.(driver.RowsColumnTypeScanType); ok {
+		return rows.ColumnTypeScanType(index)
+	}
+
+	return reflect.TypeOf(new(any)).Elem()
+}
+
+func (r *hookedRows) ColumnTypeDatabaseTypeName(index int) string {
+	if rows, ok := r.Rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		return rows.ColumnTypeDatabaseTypeName(index)
+	}
+
+	return ""
+}
+
+func (r *hookedRows) ColumnTypeLength(index int) (int64, bool) {
+	if rows, ok := r.Rows.(driver.RowsColumnTypeLength); ok {
+		return rows.ColumnTypeLength(index)
+	}
+
+	return 0, false
+}
+
+func (r *hookedRows) ColumnTypeNullable(index int) (bool, bool) {
+	if rows, ok := r.Rows.(driver.RowsColumnTypeNullable); ok {
+		return rows.ColumnTypeNullable(index)
+	}
+
+	return false, false
+}
+
+func (r *hookedRows) ColumnTypePrecisionScale(index int) (int64, int64, bool) {
+	if rows, ok := r.Rows.(driver.RowsColumnTypePrecisionScale); ok {
+		return rows.ColumnTypePrecisionScale(index)
+	}
+
+	return 0, 0, false
+}
+
+func (r *hookedRows) Close() error {
+	err := r.Rows.Close()
+
+	r.event.Rows = r.count
+	r.event.Err = errors.Join(r.err, err)
+	r.driver.after(r.ctx, r.event)
+
+	return err
+}
+
+func namedValuesToValues(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+
+	return values
+}
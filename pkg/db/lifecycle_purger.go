@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/tracing"
 )
 
 var tableExcludes = []string{
@@ -37,7 +39,7 @@ func NewLifeCyclePurgerWithSettings(logger log.Logger, settings *Settings) (*Lif
 	var err error
 	var db *sqlx.DB
 
-	if db, err = NewConnectionWithInterfaces(logger, settings); err != nil {
+	if db, err = NewConnectionWithInterfaces(logger, settings, tracing.NewNoopTracer()); err != nil {
 		return nil, fmt.Errorf("could not connect to database: %w", err)
 	}
 
@@ -76,13 +78,20 @@ func (p LifeCyclePurger) Purge(ctx context.Context) (err error) {
 func (p LifeCyclePurger) getTables(ctx context.Context) ([]string, error) {
 	var tables []string
 
-	err := p.db.SelectContext(ctx, &tables, "SELECT TABLE_NAME FROM information_schema.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_TYPE = 'BASE TABLE'", p.settings.Uri.Database)
+	qry := "SELECT TABLE_NAME FROM information_schema.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_TYPE = 'BASE TABLE'"
+
+	// postgres uses the database as catalog, the tables of the connection are the ones of its current schema
+	if p.settings.Driver == DriverNamePostgres {
+		qry = "SELECT table_name FROM information_schema.tables WHERE table_catalog = ? AND table_schema = current_schema() AND table_type = 'BASE TABLE'"
+	}
+
+	err := p.db.SelectContext(ctx, &tables, p.db.Rebind(qry), p.settings.Uri.Database)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tables of database: %w", err)
 	}
 
 	tables = funk.Filter(tables, func(s string) bool {
-		return !funk.Contains(tableExcludes, s)
+		return !slices.Contains(tableExcludes, s)
 	})
 
 	return tables, nil
@@ -113,16 +122,7 @@ func (p LifeCyclePurger) deleteTables(ctx context.Context, tables []string) erro
 		}
 	}()
 
-	// Build a single multi-statement query with all DELETEs
-	// This reduces round trips and improves performance significantly
-	var statements []string
-	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 0")
-	for _, table := range tables {
-		statements = append(statements, fmt.Sprintf("TRUNCATE TABLE `%s`", table))
-	}
-	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 1")
-
-	query := strings.Join(statements, "; ")
+	query := p.purgeQuery(tables)
 	if _, err = tx.ExecContext(ctx, query); err != nil {
 		return fmt.Errorf("failed to purge tables: %w", err)
 	}
@@ -135,3 +135,25 @@ func (p LifeCyclePurger) deleteTables(ctx context.Context, tables []string) erro
 
 	return nil
 }
+
+func (p LifeCyclePurger) purgeQuery(tables []string) string {
+	dialect := GetDialect(p.settings.Driver)
+
+	// postgres truncates all tables at once, so it doesn't matter if they reference each other
+	if p.settings.Driver == DriverNamePostgres {
+		quoted := funk.Map(tables, dialect.QuoteIdentifier)
+
+		return fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", strings.Join(quoted, ", "))
+	}
+
+	// Build a single multi-statement query with all DELETEs
+	// This reduces round trips and improves performance significantly
+	var statements []string
+	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 0")
+	for _, table := range tables {
+		statements = append(statements, fmt.Sprintf("TRUNCATE TABLE %s", dialect.QuoteIdentifier(table)))
+	}
+	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 1")
+
+	return strings.Join(statements, "; ")
+}
@@ -12,7 +12,9 @@ import (
 )
 
 const (
-	metricNameDbConnectionCount = "DbConnectionCount"
+	metricNameDbConnectionCount        = "DbConnectionCount"
+	metricNameDbConnectionWaitCount    = "DbConnectionWaitCount"
+	metricNameDbConnectionWaitDuration = "DbConnectionWaitDuration"
 )
 
 type metricDriver struct {
@@ -49,44 +51,80 @@ func (m *metricDriver) Open(dsn string) (driver.Conn, error) {
 	return m.Driver.Open(dsn)
 }
 
-func publishConnectionMetrics(conn *sqlx.DB) {
-	output := metric.NewWriter()
+type connectionStatsProvider interface {
+	Stats() sql.DBStats
+}
+
+// connectionMetrics writes the stats of the connection pool. The wait count and duration of the stats are totals since
+// the pool was opened, so only the change since the last write is published.
+type connectionMetrics struct {
+	conn         connectionStatsProvider
+	metricWriter metric.Writer
+	name         string
+	lastStats    sql.DBStats
+}
+
+func newConnectionMetrics(conn connectionStatsProvider, metricWriter metric.Writer, name string) *connectionMetrics {
+	return &connectionMetrics{
+		conn:         conn,
+		metricWriter: metricWriter,
+		name:         name,
+		lastStats:    conn.Stats(),
+	}
+}
+
+func publishConnectionMetrics(conn *sqlx.DB, name string, interval time.Duration) {
+	metrics := newConnectionMetrics(conn, metric.NewWriter(), name)
 
 	go func() {
 		for {
-			stats := conn.Stats()
-
-			output.Write(context.Background(), metric.Data{
-				&metric.Datum{
-					Priority:   metric.PriorityHigh,
-					MetricName: metricNameDbConnectionCount,
-					Dimensions: map[string]string{
-						"Type": "open",
-					},
-					Unit:  metric.UnitCountAverage,
-					Value: float64(stats.OpenConnections),
-				},
-				&metric.Datum{
-					Priority:   metric.PriorityHigh,
-					MetricName: metricNameDbConnectionCount,
-					Dimensions: map[string]string{
-						"Type": "inUse",
-					},
-					Unit:  metric.UnitCountAverage,
-					Value: float64(stats.InUse),
-				},
-				&metric.Datum{
-					Priority:   metric.PriorityHigh,
-					MetricName: metricNameDbConnectionCount,
-					Dimensions: map[string]string{
-						"Type": "idle",
-					},
-					Unit:  metric.UnitCountAverage,
-					Value: float64(stats.Idle),
-				},
-			})
-
-			time.Sleep(time.Minute)
+			time.Sleep(interval)
+			metrics.write(context.Background())
 		}
 	}()
 }
+
+func (m *connectionMetrics) write(ctx context.Context) {
+	stats := m.conn.Stats()
+	waitCount := stats.WaitCount - m.lastStats.WaitCount
+	waitDuration := stats.WaitDuration - m.lastStats.WaitDuration
+	m.lastStats = stats
+
+	m.metricWriter.Write(ctx, metric.Data{
+		m.countDatum("open", stats.OpenConnections),
+		m.countDatum("inUse", stats.InUse),
+		m.countDatum("idle", stats.Idle),
+		m.countDatum("maxOpen", stats.MaxOpenConnections),
+		&metric.Datum{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNameDbConnectionWaitCount,
+			Dimensions: map[string]string{
+				"Name": m.name,
+			},
+			Unit:  metric.UnitCount,
+			Value: float64(waitCount),
+		},
+		&metric.Datum{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNameDbConnectionWaitDuration,
+			Dimensions: map[string]string{
+				"Name": m.name,
+			},
+			Unit:  metric.UnitMilliseconds,
+			Value: float64(waitDuration.Milliseconds()),
+		},
+	})
+}
+
+func (m *connectionMetrics) countDatum(typ string, value int) *metric.Datum {
+	return &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: metricNameDbConnectionCount,
+		Dimensions: map[string]string{
+			"Name": m.name,
+			"Type": typ,
+		},
+		Unit:  metric.UnitCountAverage,
+		Value: float64(value),
+	}
+}
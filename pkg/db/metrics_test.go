@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type statsProvider struct {
+	stats sql.DBStats
+}
+
+func (p *statsProvider) Stats() sql.DBStats {
+	return p.stats
+}
+
+func TestConnectionMetrics_Write(t *testing.T) {
+	conn := &statsProvider{
+		stats: sql.DBStats{
+			WaitCount:    3,
+			WaitDuration: time.Second,
+		},
+	}
+	writer := metricMocks.NewWriter(t)
+	metrics := newConnectionMetrics(conn, writer, "default")
+
+	conn.stats = sql.DBStats{
+		MaxOpenConnections: 10,
+		OpenConnections:    10,
+		InUse:              8,
+		Idle:               2,
+		WaitCount:          5,
+		WaitDuration:       1500 * time.Millisecond,
+	}
+
+	var written metric.Data
+	writer.EXPECT().Write(matcher.Context, mock.Anything).Run(func(_ context.Context, batch metric.Data) {
+		written = batch
+	}).Once()
+
+	metrics.write(t.Context())
+
+	values := map[string]float64{}
+	for _, datum := range written {
+		assert.Equal(t, "default", datum.Dimensions["Name"])
+		values[datum.MetricName+"/"+datum.Dimensions["Type"]] = datum.Value
+	}
+
+	assert.Equal(t, map[string]float64{
+		"DbConnectionCount/open":    10,
+		"DbConnectionCount/inUse":   8,
+		"DbConnectionCount/idle":    2,
+		"DbConnectionCount/maxOpen": 10,
+		"DbConnectionWaitCount/":    2,
+		"DbConnectionWaitDuration/": 500,
+	}, values)
+}
@@ -10,12 +10,13 @@ import (
 )
 
 type MigrationSettings struct {
-	Application    string `cfg:"application" default:"{app.name}"`
-	Enabled        bool   `cfg:"enabled" default:"false"`
-	Reset          bool   `cfg:"reset" default:"false"`
-	Path           string `cfg:"path"`
-	PrefixedTables bool   `cfg:"prefixed_tables" default:"false"`
-	Provider       string `cfg:"provider" default:"goose"`
+	Application    string        `cfg:"application" default:"{app.name}"`
+	Enabled        bool          `cfg:"enabled" default:"false"`
+	Reset          bool          `cfg:"reset" default:"false"`
+	Path           string        `cfg:"path"`
+	PrefixedTables bool          `cfg:"prefixed_tables" default:"false"`
+	Provider       string        `cfg:"provider" default:"goose"`
+	LockTimeout    time.Duration `cfg:"lock_timeout" default:"5m"`
 }
 
 type MigrationProvider func(ctx context.Context, logger log.Logger, settings *Settings, db *sql.DB) error
@@ -74,6 +75,10 @@ func resetMigrations(ctx context.Context, logger log.Logger, settings *Settings,
 
 	logger.Info(ctx, "resetting database %s to rerun migrations", settings.Uri.Database)
 
+	if settings.Driver == DriverNameSqlite {
+		return resetSqliteMigrations(db)
+	}
+
 	sql := fmt.Sprintf("DROP DATABASE IF EXISTS %s", settings.Uri.Database)
 	if _, err := db.Exec(sql); err != nil {
 		return fmt.Errorf("can not drop database %s: %w", settings.Uri.Database, err)
@@ -91,3 +96,36 @@ func resetMigrations(ctx context.Context, logger log.Logger, settings *Settings,
 
 	return nil
 }
+
+// resetSqliteMigrations drops all tables, as the database of sqlite is the file itself and can't be dropped.
+func resetSqliteMigrations(db *sql.DB) error {
+	var err error
+	var rows *sql.Rows
+	var tables []string
+
+	if rows, err = db.Query("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'"); err != nil {
+		return fmt.Errorf("can not list tables: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var table string
+		if err = rows.Scan(&table); err != nil {
+			return fmt.Errorf("can not scan table name: %w", err)
+		}
+
+		tables = append(tables, table)
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("can not list tables: %w", err)
+	}
+
+	for _, table := range tables {
+		if _, err = db.Exec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`", table)); err != nil {
+			return fmt.Errorf("can not drop table %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
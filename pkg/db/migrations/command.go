@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	ActionUp      = "up"
+	ActionUpTo    = "up-to"
+	ActionDown    = "down"
+	ActionDownTo  = "down-to"
+	ActionStatus  = "status"
+	ActionVersion = "version"
+)
+
+type Command struct {
+	Action string
+	// Version is the target version of the up-to and down-to actions.
+	Version int64
+}
+
+// ParseCommand parses the positional arguments of a migrations cli, e.g. "up", "down-to 20240101120000" or "status".
+func ParseCommand(args []string) (Command, error) {
+	if len(args) == 0 {
+		return Command{}, fmt.Errorf("missing action, expected one of %s, %s, %s, %s, %s or %s", ActionUp, ActionUpTo, ActionDown, ActionDownTo, ActionStatus, ActionVersion)
+	}
+
+	cmd := Command{
+		Action: args[0],
+	}
+
+	switch cmd.Action {
+	case ActionUp, ActionDown, ActionStatus, ActionVersion:
+		if len(args) != 1 {
+			return Command{}, fmt.Errorf("the action %s does not take any arguments", cmd.Action)
+		}
+	case ActionUpTo, ActionDownTo:
+		if len(args) != 2 {
+			return Command{}, fmt.Errorf("the action %s expects exactly one version", cmd.Action)
+		}
+
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return Command{}, fmt.Errorf("can not parse version %q: %w", args[1], err)
+		}
+
+		cmd.Version = version
+	default:
+		return Command{}, fmt.Errorf("unknown action %s", cmd.Action)
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,62 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/db/migrations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommand(t *testing.T) {
+	for name, test := range map[string]struct {
+		args     []string
+		expected migrations.Command
+		err      string
+	}{
+		"up": {
+			args:     []string{"up"},
+			expected: migrations.Command{Action: migrations.ActionUp},
+		},
+		"down-to": {
+			args:     []string{"down-to", "20240101120000"},
+			expected: migrations.Command{Action: migrations.ActionDownTo, Version: 20240101120000},
+		},
+		"status": {
+			args:     []string{"status"},
+			expected: migrations.Command{Action: migrations.ActionStatus},
+		},
+		"missing action": {
+			args: []string{},
+			err:  "missing action, expected one of up, up-to, down, down-to, status or version",
+		},
+		"unknown action": {
+			args: []string{"redo"},
+			err:  "unknown action redo",
+		},
+		"unexpected argument": {
+			args: []string{"up", "3"},
+			err:  "the action up does not take any arguments",
+		},
+		"missing version": {
+			args: []string{"up-to"},
+			err:  "the action up-to expects exactly one version",
+		},
+		"invalid version": {
+			args: []string{"up-to", "latest"},
+			err:  `can not parse version "latest": strconv.ParseInt: parsing "latest": invalid syntax`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cmd, err := migrations.ParseCommand(test.args)
+
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, cmd)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pressly/goose/v3"
+)
+
+// GoFunc runs a Go migration inside the transaction of the migration.
+type GoFunc func(ctx context.Context, tx *sql.Tx) error
+
+type goMigration struct {
+	version int64
+	up      GoFunc
+	down    GoFunc
+}
+
+var goMigrations = struct {
+	lck        sync.Mutex
+	migrations map[string]map[int64]goMigration
+}{
+	migrations: map[string]map[int64]goMigration{},
+}
+
+// AddGoMigration registers a migration implemented in Go for the db client with the given name. The migration is run
+// in the order of its version together with the SQL migrations of the client. The down function is optional, a
+// migration without it is only removed from the version table when migrating down.
+func AddGoMigration(client string, version int64, up GoFunc, down GoFunc) error {
+	goMigrations.lck.Lock()
+	defer goMigrations.lck.Unlock()
+
+	if _, ok := goMigrations.migrations[client]; !ok {
+		goMigrations.migrations[client] = map[int64]goMigration{}
+	}
+
+	if _, ok := goMigrations.migrations[client][version]; ok {
+		return fmt.Errorf("there is already a go migration with version %d for the db client %s", version, client)
+	}
+
+	goMigrations.migrations[client][version] = goMigration{
+		version: version,
+		up:      up,
+		down:    down,
+	}
+
+	return nil
+}
+
+// getGoMigrations builds new goose migrations on every call, as goose keeps state in the migrations of a provider.
+func getGoMigrations(client string) []*goose.Migration {
+	goMigrations.lck.Lock()
+	defer goMigrations.lck.Unlock()
+
+	migrations := make([]*goose.Migration, 0, len(goMigrations.migrations[client]))
+	for _, migration := range goMigrations.migrations[client] {
+		migrations = append(migrations, goose.NewGoMigration(migration.version, newGooseFunc(migration.up), newGooseFunc(migration.down)))
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations
+}
+
+func newGooseFunc(f GoFunc) *goose.GoFunc {
+	if f == nil {
+		return nil
+	}
+
+	return &goose.GoFunc{
+		RunTx: f,
+	}
+}
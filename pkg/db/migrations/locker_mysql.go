@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pressly/goose/v3/lock"
+)
+
+type mysqlSessionLocker struct {
+	name    string
+	timeout time.Duration
+}
+
+// NewMysqlSessionLocker creates a goose session locker using a named MySQL lock. The lock is bound to the connection
+// and released by the server if the connection is lost, so a crashed migration run never blocks the next one.
+func NewMysqlSessionLocker(name string, timeout time.Duration) lock.SessionLocker {
+	return &mysqlSessionLocker{
+		name:    name,
+		timeout: timeout,
+	}
+}
+
+func (l *mysqlSessionLocker) SessionLock(ctx context.Context, conn *sql.Conn) error {
+	var locked sql.NullInt64
+
+	row := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", l.name, int64(l.timeout.Seconds()))
+	if err := row.Scan(&locked); err != nil {
+		return fmt.Errorf("can not acquire lock %s: %w", l.name, err)
+	}
+
+	if !locked.Valid || locked.Int64 != 1 {
+		return fmt.Errorf("can not acquire lock %s within %s, there is another migration running", l.name, l.timeout)
+	}
+
+	return nil
+}
+
+func (l *mysqlSessionLocker) SessionUnlock(ctx context.Context, conn *sql.Conn) error {
+	var released sql.NullInt64
+
+	row := conn.QueryRowContext(ctx, "SELECT RELEASE_LOCK(?)", l.name)
+	if err := row.Scan(&released); err != nil {
+		return fmt.Errorf("can not release lock %s: %w", l.name, err)
+	}
+
+	if !released.Valid || released.Int64 != 1 {
+		return fmt.Errorf("can not release lock %s as it is not held by this session", l.name)
+	}
+
+	return nil
+}
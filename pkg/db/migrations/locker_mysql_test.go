@@ -0,0 +1,58 @@
+package migrations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/justtrackio/gosoline/pkg/db/migrations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMysqlSessionLocker(t *testing.T) {
+	ctx := t.Context()
+
+	sdb, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+
+	conn, err := sdb.Conn(ctx)
+	assert.NoError(t, err)
+
+	locker := migrations.NewMysqlSessionLocker("db.goose_db_version", time.Minute)
+
+	mock.ExpectQuery("SELECT GET_LOCK(?, ?)").
+		WithArgs("db.goose_db_version", int64(60)).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(1))
+	mock.ExpectQuery("SELECT RELEASE_LOCK(?)").
+		WithArgs("db.goose_db_version").
+		WillReturnRows(sqlmock.NewRows([]string{"released"}).AddRow(1))
+
+	err = locker.SessionLock(ctx, conn)
+	assert.NoError(t, err)
+
+	err = locker.SessionUnlock(ctx, conn)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMysqlSessionLocker_Timeout(t *testing.T) {
+	ctx := t.Context()
+
+	sdb, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+
+	conn, err := sdb.Conn(ctx)
+	assert.NoError(t, err)
+
+	locker := migrations.NewMysqlSessionLocker("db.goose_db_version", time.Second)
+
+	mock.ExpectQuery("SELECT GET_LOCK(?, ?)").
+		WithArgs("db.goose_db_version", int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(0))
+
+	err = locker.SessionLock(ctx, conn)
+	assert.EqualError(t, err, "can not acquire lock db.goose_db_version within 1s, there is another migration running")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
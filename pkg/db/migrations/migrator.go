@@ -0,0 +1,240 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/database"
+	"github.com/pressly/goose/v3/lock"
+)
+
+const (
+	versionTable     = "goose_db_version"
+	lockPollInterval = 5
+)
+
+//go:generate go run github.com/vektra/mockery/v2 --name Migrator
+type Migrator interface {
+	// Up applies all pending migrations.
+	Up(ctx context.Context) error
+	// UpTo applies all pending migrations up to and including the given version.
+	UpTo(ctx context.Context, version int64) error
+	// Down rolls back the most recently applied migration.
+	Down(ctx context.Context) error
+	// DownTo rolls back all migrations with a version greater than the given version.
+	DownTo(ctx context.Context, version int64) error
+	// Status returns the state of every known migration.
+	Status(ctx context.Context) ([]*goose.MigrationStatus, error)
+	// Version returns the version of the most recently applied migration.
+	Version(ctx context.Context) (int64, error)
+	// Close closes the connection of the migrator.
+	Close() error
+}
+
+type migrator struct {
+	logger   log.Logger
+	provider *goose.Provider
+}
+
+// NewMigrator creates a migrator for the db client with the given name. It runs the SQL migrations found at the
+// configured migrations path together with the Go migrations added for the client with AddGoMigration. Concurrent runs
+// are prevented by a lock in the database for MySQL and PostgreSQL.
+func NewMigrator(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Migrator, error) {
+	var err error
+	var settings *db.Settings
+	var provider *goose.Provider
+
+	if settings, err = db.ReadSettings(config, name); err != nil {
+		return nil, err
+	}
+
+	// the connection is not shared with the application, so the migrations don't run automatically a second time
+	connection, err := db.NewConnectionWithInterfaces(logger, settings, tracing.NewNoopTracer())
+	if err != nil {
+		return nil, fmt.Errorf("can not create connection: %w", err)
+	}
+
+	if provider, err = newGooseProvider(name, settings, connection.DB); err != nil {
+		return nil, fmt.Errorf("can not create migration provider for db client %s: %w", name, err)
+	}
+
+	return NewMigratorWithInterfaces(logger, provider), nil
+}
+
+func NewMigratorWithInterfaces(logger log.Logger, provider *goose.Provider) Migrator {
+	return &migrator{
+		logger:   logger.WithChannel("db-migrations"),
+		provider: provider,
+	}
+}
+
+func (m *migrator) Up(ctx context.Context) error {
+	results, err := m.provider.Up(ctx)
+	m.logResults(ctx, results)
+
+	if err != nil {
+		return fmt.Errorf("can not apply migrations: %w", err)
+	}
+
+	return nil
+}
+
+func (m *migrator) UpTo(ctx context.Context, version int64) error {
+	results, err := m.provider.UpTo(ctx, version)
+	m.logResults(ctx, results)
+
+	if err != nil {
+		return fmt.Errorf("can not apply migrations up to version %d: %w", version, err)
+	}
+
+	return nil
+}
+
+func (m *migrator) Down(ctx context.Context) error {
+	result, err := m.provider.Down(ctx)
+	if result != nil {
+		m.logResults(ctx, []*goose.MigrationResult{result})
+	}
+
+	if err != nil {
+		return fmt.Errorf("can not roll back migration: %w", err)
+	}
+
+	return nil
+}
+
+func (m *migrator) DownTo(ctx context.Context, version int64) error {
+	results, err := m.provider.DownTo(ctx, version)
+	m.logResults(ctx, results)
+
+	if err != nil {
+		return fmt.Errorf("can not roll back migrations down to version %d: %w", version, err)
+	}
+
+	return nil
+}
+
+func (m *migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	status, err := m.provider.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can not get status of migrations: %w", err)
+	}
+
+	return status, nil
+}
+
+func (m *migrator) Version(ctx context.Context) (int64, error) {
+	version, err := m.provider.GetDBVersion(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("can not get version of database: %w", err)
+	}
+
+	return version, nil
+}
+
+func (m *migrator) Close() error {
+	return m.provider.Close()
+}
+
+func (m *migrator) logResults(ctx context.Context, results []*goose.MigrationResult) {
+	for _, result := range results {
+		m.logger.Info(ctx, "%s", result)
+	}
+
+	if len(results) == 0 {
+		m.logger.Info(ctx, "no migrations to apply")
+	}
+}
+
+func newGooseProvider(name string, settings *db.Settings, connection *sql.DB) (*goose.Provider, error) {
+	var err error
+	var dialect database.Dialect
+	var store database.Store
+	var fsys fs.FS
+	var locker lock.SessionLocker
+
+	if dialect, err = gooseDialect(settings.Driver); err != nil {
+		return nil, err
+	}
+
+	table := versionTable
+	if settings.Migrations.PrefixedTables {
+		application := strings.ToLower(settings.Migrations.Application)
+		application = strings.ReplaceAll(application, "-", "_")
+		table = fmt.Sprintf("%s_%s", application, versionTable)
+	}
+
+	if store, err = database.NewStore(dialect, table); err != nil {
+		return nil, fmt.Errorf("can not create version store: %w", err)
+	}
+
+	if settings.Migrations.Path != "" {
+		fsys = os.DirFS(settings.Migrations.Path)
+	}
+
+	if fsys != nil && dialect == database.DialectSQLite3 {
+		fsys = db.NewSqliteSchemaFS(fsys)
+	}
+
+	options := []goose.ProviderOption{
+		goose.WithStore(store),
+		goose.WithAllowOutofOrder(true),
+		goose.WithGoMigrations(getGoMigrations(name)...),
+	}
+
+	if locker, err = sessionLocker(dialect, settings, table); err != nil {
+		return nil, fmt.Errorf("can not create session locker: %w", err)
+	}
+
+	if locker != nil {
+		options = append(options, goose.WithSessionLocker(locker))
+	}
+
+	return goose.NewProvider("", connection, fsys, options...)
+}
+
+func gooseDialect(driver string) (database.Dialect, error) {
+	switch driver {
+	case db.DriverMysql:
+		return database.DialectMySQL, nil
+	case db.DriverNamePostgres:
+		return database.DialectPostgres, nil
+	case db.DriverNameRedshift:
+		return database.DialectRedshift, nil
+	case db.DriverNameSqlite:
+		return database.DialectSQLite3, nil
+	default:
+		return "", fmt.Errorf("there are no migrations available for the driver %s", driver)
+	}
+}
+
+// sessionLocker returns the locker for the dialect or nil, if the dialect doesn't support locks.
+func sessionLocker(dialect database.Dialect, settings *db.Settings, table string) (lock.SessionLocker, error) {
+	name := fmt.Sprintf("%s.%s", settings.Uri.Database, table)
+
+	switch dialect {
+	case database.DialectMySQL:
+		return NewMysqlSessionLocker(name, settings.Migrations.LockTimeout), nil
+	case database.DialectPostgres:
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte(name))
+		attempts := max(uint64(settings.Migrations.LockTimeout.Seconds())/lockPollInterval, 1)
+
+		return lock.NewPostgresSessionLocker(
+			lock.WithLockID(int64(hash.Sum64()>>1)),
+			lock.WithLockTimeout(lockPollInterval, attempts),
+		)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,100 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/pressly/goose/v3"
+)
+
+type module struct {
+	kernel.EssentialModule
+	kernel.EssentialStage
+
+	logger   log.Logger
+	migrator Migrator
+	command  Command
+}
+
+// NewModule creates a one-shot module running the command against the db client with the given name. As an essential
+// module, the kernel stops as soon as the command is done. Use it with cli.Run or as the only module of an application:
+//
+//	cmd, err := migrations.ParseCommand(flag.Args())
+//	...
+//	cli.Run(migrations.NewModule("default", cmd))
+func NewModule(name string, command Command) kernel.ModuleFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+		migrator, err := NewMigrator(ctx, config, logger, name)
+		if err != nil {
+			return nil, fmt.Errorf("can not create migrator: %w", err)
+		}
+
+		return NewModuleWithInterfaces(logger, migrator, command), nil
+	}
+}
+
+func NewModuleWithInterfaces(logger log.Logger, migrator Migrator, command Command) kernel.Module {
+	return &module{
+		logger:   logger.WithChannel("db-migrations"),
+		migrator: migrator,
+		command:  command,
+	}
+}
+
+func (m *module) Run(ctx context.Context) (err error) {
+	defer func() {
+		if closeErr := m.migrator.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("can not close migrator: %w", closeErr)
+		}
+	}()
+
+	switch m.command.Action {
+	case ActionUp:
+		return m.migrator.Up(ctx)
+	case ActionUpTo:
+		return m.migrator.UpTo(ctx, m.command.Version)
+	case ActionDown:
+		return m.migrator.Down(ctx)
+	case ActionDownTo:
+		return m.migrator.DownTo(ctx, m.command.Version)
+	case ActionStatus:
+		return m.status(ctx)
+	case ActionVersion:
+		return m.version(ctx)
+	default:
+		return fmt.Errorf("unknown action %s", m.command.Action)
+	}
+}
+
+func (m *module) status(ctx context.Context) error {
+	status, err := m.migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range status {
+		if migration.State == goose.StateApplied {
+			m.logger.Info(ctx, "%-7s %d %s (applied at %s)", migration.State, migration.Source.Version, migration.Source.Path, migration.AppliedAt)
+
+			continue
+		}
+
+		m.logger.Info(ctx, "%-7s %d %s", migration.State, migration.Source.Version, migration.Source.Path)
+	}
+
+	return nil
+}
+
+func (m *module) version(ctx context.Context) error {
+	version, err := m.migrator.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info(ctx, "database is at version %d", version)
+
+	return nil
+}
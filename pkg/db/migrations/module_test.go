@@ -0,0 +1,49 @@
+package migrations_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/db/migrations"
+	"github.com/justtrackio/gosoline/pkg/db/migrations/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModule_UpTo(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	migrator := mocks.NewMigrator(t)
+	migrator.EXPECT().UpTo(matcher.Context, int64(3)).Return(nil).Once()
+	migrator.EXPECT().Close().Return(nil).Once()
+
+	module := migrations.NewModuleWithInterfaces(logger, migrator, migrations.Command{
+		Action:  migrations.ActionUpTo,
+		Version: 3,
+	})
+
+	err := module.Run(t.Context())
+	assert.NoError(t, err)
+}
+
+func TestModule_DownFails(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	migrator := mocks.NewMigrator(t)
+	migrator.EXPECT().Down(matcher.Context).Return(fmt.Errorf("lock timeout")).Once()
+	migrator.EXPECT().Close().Return(fmt.Errorf("already closed")).Once()
+
+	module := migrations.NewModuleWithInterfaces(logger, migrator, migrations.Command{
+		Action: migrations.ActionDown,
+	})
+
+	err := module.Run(t.Context())
+	assert.EqualError(t, err, "lock timeout", "the error of the command should not be hidden by the error of closing the migrator")
+}
+
+func TestAddGoMigration_Duplicate(t *testing.T) {
+	err := migrations.AddGoMigration("test", 1, nil, nil)
+	assert.NoError(t, err)
+
+	err = migrations.AddGoMigration("test", 1, nil, nil)
+	assert.EqualError(t, err, "there is already a go migration with version 1 for the db client test")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"os"
 
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/pressly/goose/v3"
@@ -18,7 +19,16 @@ func runMigrationGoose(ctx context.Context, logger log.Logger, settings *Setting
 		return fmt.Errorf("can not set db dialect: %w", err)
 	}
 
-	if err = goose.Up(db, settings.Migrations.Path, goose.WithAllowMissing()); err != nil {
+	path := settings.Migrations.Path
+
+	if settings.Driver == DriverNameSqlite {
+		goose.SetBaseFS(NewSqliteSchemaFS(os.DirFS(path)))
+		defer goose.SetBaseFS(nil)
+
+		path = "."
+	}
+
+	if err = goose.Up(db, path, goose.WithAllowMissing()); err != nil {
 		return fmt.Errorf("can not run up migrations from path %s: %w", settings.Migrations.Path, err)
 	}
 
@@ -26,6 +26,65 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// BeginTx provides a mock function with given fields: ctx, ops
+func (_m *Client) BeginTx(ctx context.Context, ops *sql.TxOptions) (*sqlx.Tx, error) {
+	ret := _m.Called(ctx, ops)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginTx")
+	}
+
+	var r0 *sqlx.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.TxOptions) (*sqlx.Tx, error)); ok {
+		return rf(ctx, ops)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *sql.TxOptions) *sqlx.Tx); ok {
+		r0 = rf(ctx, ops)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlx.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *sql.TxOptions) error); ok {
+		r1 = rf(ctx, ops)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_BeginTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTx'
+type Client_BeginTx_Call struct {
+	*mock.Call
+}
+
+// BeginTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ops *sql.TxOptions
+func (_e *Client_Expecter) BeginTx(ctx interface{}, ops interface{}) *Client_BeginTx_Call {
+	return &Client_BeginTx_Call{Call: _e.mock.On("BeginTx", ctx, ops)}
+}
+
+func (_c *Client_BeginTx_Call) Run(run func(ctx context.Context, ops *sql.TxOptions)) *Client_BeginTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*sql.TxOptions))
+	})
+	return _c
+}
+
+func (_c *Client_BeginTx_Call) Return(_a0 *sqlx.Tx, _a1 error) *Client_BeginTx_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_BeginTx_Call) RunAndReturn(run func(context.Context, *sql.TxOptions) (*sqlx.Tx, error)) *Client_BeginTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BindNamed provides a mock function with given fields: query, arg
 func (_m *Client) BindNamed(query string, arg interface{}) (string, []interface{}, error) {
 	ret := _m.Called(query, arg)
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
+)
+
+const (
+	metricNameDbQueryCount = "DbQueryCount"
+	queryLogCallerUnknown  = "unknown"
+)
+
+// queryLogCallerSkipPrefixes are the packages between the code issuing a query and the driver. The first function of
+// the stack outside of these packages is reported as the caller of a query.
+var queryLogCallerSkipPrefixes = []string{
+	"runtime.",
+	"database/sql.",
+	"github.com/jmoiron/sqlx.",
+	"github.com/jinzhu/gorm.",
+	"github.com/Masterminds/squirrel.",
+	"github.com/justtrackio/gosoline/pkg/db.",
+	"github.com/justtrackio/gosoline/pkg/db-repo.",
+	"github.com/justtrackio/gosoline/pkg/dbx.",
+}
+
+type queryCallerCtxKey struct{}
+
+// queryLogHook logs statements running longer than the slow query threshold and counts the queries per calling
+// function. Every statement is logged with debug level if the log level of the channel db.queries.<name> is set to
+// debug or trace at runtime, see log.SetChannelLevel.
+type queryLogHook struct {
+	logger       log.Logger
+	metricWriter metric.Writer
+	channel      string
+	name         string
+	settings     SettingsQueryLog
+}
+
+func newQueryLogHook(logger log.Logger, metricWriter metric.Writer, name string, settings SettingsQueryLog) QueryHook {
+	channel := fmt.Sprintf("db.queries.%s", name)
+
+	return &queryLogHook{
+		logger:       logger.WithChannel(channel),
+		metricWriter: metricWriter,
+		channel:      channel,
+		name:         name,
+		settings:     settings,
+	}
+}
+
+func (h *queryLogHook) BeforeQuery(ctx context.Context, _ *QueryEvent) context.Context {
+	if !h.settings.CountPerCaller {
+		return ctx
+	}
+
+	return context.WithValue(ctx, queryCallerCtxKey{}, queryCaller())
+}
+
+func (h *queryLogHook) AfterQuery(ctx context.Context, event *QueryEvent) {
+	if event.Skipped {
+		return
+	}
+
+	if h.settings.SlowQueryThreshold > 0 && event.Duration >= h.settings.SlowQueryThreshold {
+		h.logger.Warn(ctx, "slow %s on connection %s took %s: %s", event.Operation, h.name, event.Duration.Round(time.Millisecond), SanitizeStatement(event.Statement))
+	} else if h.logAll() {
+		h.logger.Debug(ctx, "%s on connection %s took %s: %s", event.Operation, h.name, event.Duration, SanitizeStatement(event.Statement))
+	}
+
+	if caller, ok := ctx.Value(queryCallerCtxKey{}).(string); ok {
+		h.metricWriter.WriteOne(ctx, &metric.Datum{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNameDbQueryCount,
+			Dimensions: map[string]string{
+				"Name":   h.name,
+				"Caller": caller,
+			},
+			Unit:  metric.UnitCount,
+			Value: 1.0,
+		})
+	}
+}
+
+// logAll checks if logging every statement got enabled at runtime. Sanitizing every statement just to drop the log
+// afterward would be too expensive.
+func (h *queryLogHook) logAll() bool {
+	level, ok := log.ChannelLevelOverride(h.channel)
+	if !ok {
+		return false
+	}
+
+	priority, _ := log.LevelPriority(level)
+
+	return priority <= log.PriorityDebug
+}
+
+func queryCaller() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+
+		if !hasAnyPrefix(frame.Function, queryLogCallerSkipPrefixes) {
+			return frame.Function
+		}
+
+		if !more {
+			return queryLogCallerUnknown
+		}
+	}
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/log"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryLogHook_SlowQuery(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithTestingT(t))
+	logger.EXPECT().Warn(matcher.Context, "slow %s on connection %s took %s: %s", "exec", "default", mock.Anything, "DELETE FROM orders WHERE id = ?").Return().Once()
+
+	db := openHookedDb(t, newQueryLogHook(logger, metricMocks.NewWriter(t), "default", SettingsQueryLog{
+		SlowQueryThreshold: time.Nanosecond,
+	}))
+
+	_, err := db.ExecContext(t.Context(), "DELETE FROM orders WHERE id = 5")
+	assert.NoError(t, err)
+}
+
+func TestQueryLogHook_LogAll(t *testing.T) {
+	defer log.ResetChannelLevels()
+
+	logger := logMocks.NewLoggerMock(logMocks.WithTestingT(t))
+	db := openHookedDb(t, newQueryLogHook(logger, metricMocks.NewWriter(t), "default", SettingsQueryLog{
+		SlowQueryThreshold: time.Hour,
+	}))
+
+	// nothing is logged without enabling the channel at runtime
+	_, err := db.ExecContext(t.Context(), "DELETE FROM orders")
+	assert.NoError(t, err)
+
+	assert.NoError(t, log.SetChannelLevel("db.queries.*", log.LevelDebug))
+	logger.EXPECT().Debug(matcher.Context, "%s on connection %s took %s: %s", "query", "default", mock.Anything, "SELECT id FROM orders WHERE status = ?").Return().Once()
+
+	rows, err := db.QueryContext(t.Context(), "SELECT id FROM orders WHERE status = 'open'")
+	require.NoError(t, err)
+	assert.NoError(t, rows.Close())
+}
+
+func TestQueryLogHook_CountPerCaller(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithTestingT(t))
+	writer := metricMocks.NewWriter(t)
+	writer.EXPECT().WriteOne(mock.Anything, &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: metricNameDbQueryCount,
+		Dimensions: map[string]string{
+			"Name": "default",
+			// the functions of this package are skipped, so the caller is the test runner
+			"Caller": "testing.tRunner",
+		},
+		Unit:  metric.UnitCount,
+		Value: 1.0,
+	}).Once()
+
+	db := openHookedDb(t, newQueryLogHook(logger, writer, "default", SettingsQueryLog{
+		CountPerCaller: true,
+	}))
+
+	_, err := db.ExecContext(context.Background(), "DELETE FROM orders")
+	assert.NoError(t, err)
+}
+
+func openHookedDb(t *testing.T, hooks ...QueryHook) *sql.DB {
+	name := uuid.New().NewV4()
+	sql.Register(name, newHookedDriver(fakeDriver{}, hooks...))
+
+	db, err := sql.Open(name, "")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, db.Close())
+	})
+
+	return db
+}
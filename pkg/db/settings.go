@@ -8,20 +8,25 @@ import (
 )
 
 type Settings struct {
-	Charset               string            `cfg:"charset"                 default:"utf8mb4"`
-	Collation             string            `cfg:"collation"               default:"utf8mb4_general_ci"`
-	ConnectionMaxIdleTime time.Duration     `cfg:"connection_max_idletime" default:"120s"`
-	ConnectionMaxLifetime time.Duration     `cfg:"connection_max_lifetime" default:"120s"`
-	Driver                string            `cfg:"driver"`
-	MaxIdleConnections    int               `cfg:"max_idle_connections"    default:"2"` // 0 or negative number=no idle connections, sql driver default=2
-	MaxOpenConnections    int               `cfg:"max_open_connections"    default:"0"` // 0 or negative number=unlimited, sql driver default=0
-	Migrations            MigrationSettings `cfg:"migrations"`
-	MultiStatements       bool              `cfg:"multi_statements"        default:"true"`
-	Parameters            map[string]string `cfg:"parameters"`
-	ParseTime             bool              `cfg:"parse_time"              default:"true"`
-	Retry                 SettingsRetry     `cfg:"retry"`
-	Timeouts              SettingsTimeout   `cfg:"timeouts"`
-	Uri                   SettingsUri       `cfg:"uri"`
+	Charset               string                 `cfg:"charset"                 default:"utf8mb4"`
+	Collation             string                 `cfg:"collation"               default:"utf8mb4_general_ci"`
+	ConnectionMaxIdleTime time.Duration          `cfg:"connection_max_idletime" default:"120s"`
+	ConnectionMaxLifetime time.Duration          `cfg:"connection_max_lifetime" default:"120s"`
+	Driver                string                 `cfg:"driver"`
+	HealthCheck           SettingsHealthCheck    `cfg:"health_check"`
+	MaxIdleConnections    int                    `cfg:"max_idle_connections"    default:"2"` // 0 or negative number=no idle connections, sql driver default=2
+	MaxOpenConnections    int                    `cfg:"max_open_connections"    default:"0"` // 0 or negative number=unlimited, sql driver default=0
+	Metrics               SettingsMetrics        `cfg:"metrics"`
+	Migrations            MigrationSettings      `cfg:"migrations"`
+	MultiStatements       bool                   `cfg:"multi_statements"        default:"true"`
+	Parameters            map[string]string      `cfg:"parameters"`
+	ParseTime             bool                   `cfg:"parse_time"              default:"true"`
+	QueryLog              SettingsQueryLog       `cfg:"query_log"`
+	Retry                 SettingsRetry          `cfg:"retry"`
+	StatementCache        SettingsStatementCache `cfg:"statement_cache"`
+	Timeouts              SettingsTimeout        `cfg:"timeouts"`
+	Tracing               SettingsTracing        `cfg:"tracing"`
+	Uri                   SettingsUri            `cfg:"uri"`
 }
 
 type SettingsUri struct {
@@ -36,6 +41,32 @@ type SettingsRetry struct {
 	Enabled bool `cfg:"enabled" default:"false"`
 }
 
+type SettingsMetrics struct {
+	Enabled  bool          `cfg:"enabled"  default:"true"` // periodically writes the stats of the connection pool
+	Interval time.Duration `cfg:"interval" default:"1m"`
+}
+
+type SettingsStatementCache struct {
+	Enabled bool `cfg:"enabled" default:"false"` // executes the statements of the client with cached prepared statements
+	Size    int  `cfg:"size"    default:"100"`   // count of prepared statements kept per connection
+}
+
+type SettingsHealthCheck struct {
+	Timeout time.Duration `cfg:"timeout" default:"5s"` // timeout of the ping checking the connection
+}
+
+type SettingsQueryLog struct {
+	Enabled            bool          `cfg:"enabled"              default:"true"`
+	SlowQueryThreshold time.Duration `cfg:"slow_query_threshold" default:"1s"`    // statements running longer are logged as warning, 0 disables the slow query log
+	CountPerCaller     bool          `cfg:"count_per_caller"     default:"false"` // writes the DbQueryCount metric with the function issuing the query as dimension
+}
+
+type SettingsTracing struct {
+	Enabled bool `cfg:"enabled" default:"false"` // records queries as spans of the trace found in their context
+	// RecordStatement adds the statement to the spans, with all string and number literals replaced by "?".
+	RecordStatement bool `cfg:"record_statement" default:"true"`
+}
+
 type SettingsTimeout struct {
 	ReadTimeout  time.Duration `cfg:"readTimeout"  default:"0"` // I/O read timeout. The value must be a decimal number with a unit suffix ("ms", "s", "m", "h"), such as "30s", "0.5m" or "1m30s".
 	WriteTimeout time.Duration `cfg:"writeTimeout" default:"0"` // I/O write timeout. The value must be a decimal number with a unit suffix ("ms", "s", "m", "h"), such as "30s", "0.5m" or "1m30s".
@@ -0,0 +1,82 @@
+// Package sqlite registers the pure Go sqlite driver for pkg/db. It is kept in its own package so only applications
+// using sqlite pull in the driver:
+//
+//	import _ "github.com/justtrackio/gosoline/pkg/db/sqlite"
+package sqlite
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/golang-migrate/migrate/v4/database"
+	migrateSqlite "github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/log"
+	_ "modernc.org/sqlite"
+)
+
+func init() {
+	db.AddDriverFactory(db.DriverNameSqlite, NewDriver)
+}
+
+func NewDriver(logger log.Logger) (db.Driver, error) {
+	return &driver{}, nil
+}
+
+type driver struct{}
+
+// GetDSN uses the database of the uri as path of the database file. With the parameter mode set to memory, the
+// database is kept in memory instead and shared by all connections of the pool.
+func (m *driver) GetDSN(settings *db.Settings) string {
+	dsn := url.URL{
+		Scheme: "file",
+		Opaque: settings.Uri.Database,
+	}
+
+	qry := dsn.Query()
+	for k, v := range settings.Parameters {
+		qry.Set(k, v)
+	}
+
+	if settings.Parameters["mode"] == db.SqliteModeMemory {
+		qry.Set("cache", "shared")
+	}
+
+	qry.Add("_pragma", "foreign_keys(1)")
+	qry.Add("_pragma", "busy_timeout(5000)")
+
+	dsn.RawQuery = qry.Encode()
+
+	return dsn.String()
+}
+
+func (m *driver) GetMigrationDriver(sqlDb *sql.DB, database string, migrationsTable string) (database.Driver, error) {
+	driver, err := migrateSqlite.WithInstance(sqlDb, &migrateSqlite.Config{
+		MigrationsTable: migrationsTable,
+		DatabaseName:    database,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &migrationDriver{
+		Driver: driver,
+	}, nil
+}
+
+// migrationDriver adjusts the MySQL schema of the migrations to sqlite before running them.
+type migrationDriver struct {
+	database.Driver
+}
+
+func (d *migrationDriver) Run(migration io.Reader) error {
+	statements, err := io.ReadAll(migration)
+	if err != nil {
+		return fmt.Errorf("can not read migration: %w", err)
+	}
+
+	return d.Driver.Run(bytes.NewBufferString(db.AdjustSqliteSchema(string(statements))))
+}
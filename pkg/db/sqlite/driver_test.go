@@ -0,0 +1,77 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/db/sqlite"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+)
+
+const usersSchema = "CREATE TABLE `users` (\n" +
+	"  `id` int unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(255) NOT NULL,\n" +
+	"  `status` enum('active','inactive') NOT NULL DEFAULT 'active',\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  UNIQUE KEY `name` (`name`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+
+func TestDriver_GetDsn(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	driver, err := sqlite.NewDriver(logger)
+	assert.NoError(t, err)
+
+	dsn := driver.GetDSN(&db.Settings{
+		Uri: db.SettingsUri{
+			Database: "/tmp/gosoline.db",
+		},
+	})
+	assert.Equal(t, "file:/tmp/gosoline.db?_pragma=foreign_keys%281%29&_pragma=busy_timeout%285000%29", dsn)
+
+	dsn = driver.GetDSN(&db.Settings{
+		Parameters: map[string]string{
+			"mode": db.SqliteModeMemory,
+		},
+		Uri: db.SettingsUri{
+			Database: "gosoline",
+		},
+	})
+	assert.Equal(t, "file:gosoline?_pragma=foreign_keys%281%29&_pragma=busy_timeout%285000%29&cache=shared&mode=memory", dsn)
+}
+
+func TestDriver_InMemory(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	connection, err := db.NewConnectionWithInterfaces(logger, &db.Settings{
+		Driver: db.DriverNameSqlite,
+		Parameters: map[string]string{
+			"mode": db.SqliteModeMemory,
+		},
+		Uri: db.SettingsUri{
+			Database: t.Name(),
+		},
+		MaxOpenConnections: 2,
+	}, tracing.NewNoopTracer())
+	assert.NoError(t, err)
+
+	defer func() {
+		assert.NoError(t, connection.Close())
+	}()
+
+	_, err = connection.Exec(db.AdjustSqliteSchema(usersSchema))
+	assert.NoError(t, err)
+
+	_, err = connection.Exec("INSERT INTO users (name) VALUES (?), (?)", "alice", "bob")
+	assert.NoError(t, err)
+
+	_, err = connection.Exec("INSERT INTO users (name) VALUES (?)", "alice")
+	assert.ErrorContains(t, err, "UNIQUE constraint failed")
+
+	var names []string
+	err = connection.Select(&names, "SELECT name FROM users WHERE status = ? ORDER BY id", "active")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, names)
+}
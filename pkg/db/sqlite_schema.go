@@ -0,0 +1,154 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"regexp"
+	"strings"
+)
+
+// The sqlite driver itself is registered by importing pkg/db/sqlite, the names and the schema adjustments are kept here,
+// so the dialects and migrations can support sqlite without depending on the driver.
+const (
+	DriverNameSqlite  = "sqlite"
+	DriverNameSqlite3 = "sqlite3"
+	// SqliteModeMemory keeps the database in memory, set it as parameter mode of the connection.
+	SqliteModeMemory = "memory"
+)
+
+var (
+	sqliteTableOptions     = regexp.MustCompile(`(?i)\)(\s*,?\s*(ENGINE|(DEFAULT\s+)?(CHARSET|CHARACTER\s+SET)|COLLATE|AUTO_INCREMENT|ROW_FORMAT|COMMENT)\s*=\s*('[^']*'|\w+))+`)
+	sqliteColumnCharset    = regexp.MustCompile(`(?i)\s+(CHARACTER\s+SET|CHARSET)\s+\w+`)
+	sqliteColumnCollate    = regexp.MustCompile(`(?i)\s+COLLATE\s+utf8\w*`)
+	sqliteOnUpdate         = regexp.MustCompile(`(?i)\s+ON\s+UPDATE\s+CURRENT_TIMESTAMP(\(\d*\))?`)
+	sqliteEnum             = regexp.MustCompile(`(?i)\bENUM\s*\([^)]*\)`)
+	sqliteUnsigned         = regexp.MustCompile(`(?i)\s+UNSIGNED\b`)
+	sqliteCreateTable      = regexp.MustCompile("(?is)(CREATE\\s+TABLE\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?`?(\\w+)`?\\s*\\()(.*?)\\)\\s*(;|\\z)")
+	sqliteAutoIncrement    = regexp.MustCompile("(?i)(^|,)(\\s*)(`?(\\w+)`?)\\s+[^,]*?\\bAUTO_INCREMENT\\b[^,]*")
+	sqliteInlineIndex      = regexp.MustCompile("(?i),\\s*(UNIQUE\\s+)?(?:KEY|INDEX)\\s+`?(\\w+)`?\\s*\\(((?:[^()]|\\(\\d+\\))*)\\)")
+	sqliteUniqueConstraint = regexp.MustCompile("(?i)\\bUNIQUE\\s+(?:KEY|INDEX)\\s*(?:`?\\w+`?\\s*)?\\(")
+	sqliteIndexLength      = regexp.MustCompile(`\(\d+\)`)
+)
+
+// NewSqliteSchemaFS returns a file system applying AdjustSqliteSchema to all .sql files, so goose migrations written for
+// MySQL can be used with sqlite as well.
+func NewSqliteSchemaFS(fsys fs.FS) fs.FS {
+	return sqliteSchemaFS{
+		fsys: fsys,
+	}
+}
+
+type sqliteSchemaFS struct {
+	fsys fs.FS
+}
+
+func (f sqliteSchemaFS) Open(name string) (fs.File, error) {
+	file, err := f.fsys.Open(name)
+	if err != nil || !strings.HasSuffix(name, ".sql") {
+		return file, err
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("can not stat migration %s: %w", name, err)
+	}
+
+	statements, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("can not read migration %s: %w", name, err)
+	}
+
+	adjusted := []byte(AdjustSqliteSchema(string(statements)))
+
+	return &sqliteSchemaFile{
+		Reader: bytes.NewReader(adjusted),
+		info: sqliteSchemaFileInfo{
+			FileInfo: info,
+			size:     int64(len(adjusted)),
+		},
+	}, nil
+}
+
+type sqliteSchemaFile struct {
+	*bytes.Reader
+	info fs.FileInfo
+}
+
+func (f *sqliteSchemaFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *sqliteSchemaFile) Close() error {
+	return nil
+}
+
+type sqliteSchemaFileInfo struct {
+	fs.FileInfo
+	size int64
+}
+
+func (i sqliteSchemaFileInfo) Size() int64 {
+	return i.size
+}
+
+// AdjustSqliteSchema rewrites the common MySQL specific parts of DDL statements, so migrations written for MySQL can be
+// used with sqlite as well:
+//   - table options like ENGINE and CHARSET as well as column charsets and utf8 collations are removed
+//   - AUTO_INCREMENT columns become INTEGER PRIMARY KEY AUTOINCREMENT columns
+//   - indexes defined in CREATE TABLE become CREATE INDEX statements, unique keys become UNIQUE constraints
+//   - ON UPDATE CURRENT_TIMESTAMP and UNSIGNED are removed, ENUM columns become TEXT columns
+func AdjustSqliteSchema(statements string) string {
+	statements = sqliteTableOptions.ReplaceAllString(statements, ")")
+	statements = sqliteColumnCharset.ReplaceAllString(statements, "")
+	statements = sqliteColumnCollate.ReplaceAllString(statements, "")
+	statements = sqliteOnUpdate.ReplaceAllString(statements, "")
+	statements = sqliteEnum.ReplaceAllString(statements, "TEXT")
+	statements = sqliteUnsigned.ReplaceAllString(statements, "")
+
+	return sqliteCreateTable.ReplaceAllStringFunc(statements, adjustSqliteCreateTable)
+}
+
+func adjustSqliteCreateTable(statement string) string {
+	match := sqliteCreateTable.FindStringSubmatch(statement)
+	prefix, table, body, terminator := match[1], match[2], match[3], match[4]
+
+	for _, column := range sqliteAutoIncrement.FindAllStringSubmatch(body, -1) {
+		body = strings.Replace(body, column[0], fmt.Sprintf("%s%s%s INTEGER PRIMARY KEY AUTOINCREMENT", column[1], column[2], column[3]), 1)
+
+		primaryKey := regexp.MustCompile(fmt.Sprintf("(?i),\\s*PRIMARY\\s+KEY\\s*\\(\\s*`?%s`?\\s*\\)", regexp.QuoteMeta(column[4])))
+		body = primaryKey.ReplaceAllString(body, "")
+	}
+
+	indexes := make([]string, 0)
+
+	body = sqliteInlineIndex.ReplaceAllStringFunc(body, func(definition string) string {
+		index := sqliteInlineIndex.FindStringSubmatch(definition)
+		columns := sqliteIndexLength.ReplaceAllString(index[3], "")
+
+		if index[1] != "" {
+			return fmt.Sprintf(", UNIQUE (%s)", columns)
+		}
+
+		indexes = append(indexes, fmt.Sprintf("CREATE INDEX `%s_%s` ON `%s` (%s);", table, index[2], table, columns))
+
+		return ""
+	})
+
+	body = sqliteUniqueConstraint.ReplaceAllString(body, "UNIQUE (")
+
+	if terminator == "" {
+		terminator = ";"
+	}
+
+	adjusted := fmt.Sprintf("%s%s)%s", prefix, body, terminator)
+
+	for _, index := range indexes {
+		adjusted = fmt.Sprintf("%s\n%s", adjusted, index)
+	}
+
+	return adjusted
+}
@@ -0,0 +1,55 @@
+package db_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/stretchr/testify/assert"
+)
+
+const sqliteMysqlSchema = "CREATE TABLE `users` (\n" +
+	"  `id` int unsigned NOT NULL AUTO_INCREMENT,\n" +
+	"  `name` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL,\n" +
+	"  `status` enum('active','inactive') NOT NULL DEFAULT 'active',\n" +
+	"  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n" +
+	"  PRIMARY KEY (`id`),\n" +
+	"  UNIQUE KEY `name` (`name`),\n" +
+	"  KEY `status` (`status`(8))\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;"
+
+func TestAdjustSqliteSchema(t *testing.T) {
+	expected := "CREATE TABLE `users` (\n" +
+		"  `id` INTEGER PRIMARY KEY AUTOINCREMENT,\n" +
+		"  `name` varchar(255) NOT NULL,\n" +
+		"  `status` TEXT NOT NULL DEFAULT 'active',\n" +
+		"  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, UNIQUE (`name`)\n" +
+		");\n" +
+		"CREATE INDEX `users_status` ON `users` (`status`);"
+
+	assert.Equal(t, expected, db.AdjustSqliteSchema(sqliteMysqlSchema))
+}
+
+func TestSqliteSchemaFS(t *testing.T) {
+	fsys := db.NewSqliteSchemaFS(fstest.MapFS{
+		"001_users.sql": &fstest.MapFile{Data: []byte(sqliteMysqlSchema)},
+		"README.md":     &fstest.MapFile{Data: []byte("ENGINE=InnoDB")},
+	})
+
+	migration, err := fs.ReadFile(fsys, "001_users.sql")
+	assert.NoError(t, err)
+	assert.Equal(t, db.AdjustSqliteSchema(sqliteMysqlSchema), string(migration))
+
+	info, err := fs.Stat(fsys, "001_users.sql")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(migration)), info.Size())
+
+	readme, err := fs.ReadFile(fsys, "README.md")
+	assert.NoError(t, err)
+	assert.Equal(t, "ENGINE=InnoDB", string(readme), "only migrations should be adjusted")
+
+	entries, err := fs.ReadDir(fsys, ".")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+}
@@ -0,0 +1,268 @@
+package db
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/metric"
+)
+
+const (
+	metricNameDbStatementCacheHit  = "DbStatementCacheHit"
+	metricNameDbStatementCacheMiss = "DbStatementCacheMiss"
+)
+
+type statementCacheCtxKey string
+
+type statementConn interface {
+	queryRunner
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// queryRunner executes statements, either directly on the connection or with a prepared statement of the StatementCache.
+type queryRunner interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// StatementCache keeps the least recently used prepared statements of a connection, so frequently executed statements
+// aren't parsed by the database again and again. database/sql prepares a statement lazily on every connection of the
+// pool it is executed on.
+type StatementCache struct {
+	lck          sync.Mutex
+	conn         statementConn
+	metricWriter metric.Writer
+	name         string
+	size         int
+	order        *list.List
+	entries      map[string]*list.Element
+}
+
+// cachedStatement counts the executions using the statement, so an evicted statement is only closed once it isn't in
+// use anymore. The statement is nil for queries which couldn't be prepared.
+type cachedStatement struct {
+	query   string
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+var _ queryRunner = &StatementCache{}
+
+func ProvideStatementCache(ctx context.Context, name string, settings *Settings, conn statementConn) (*StatementCache, error) {
+	return appctx.Provide(ctx, statementCacheCtxKey(fmt.Sprint(settings)), func() (*StatementCache, error) {
+		return NewStatementCacheWithInterfaces(conn, metric.NewWriter(), name, settings.StatementCache.Size), nil
+	})
+}
+
+func NewStatementCacheWithInterfaces(conn statementConn, metricWriter metric.Writer, name string, size int) *StatementCache {
+	return &StatementCache{
+		conn:         conn,
+		metricWriter: metricWriter,
+		name:         name,
+		size:         size,
+		order:        list.New(),
+		entries:      make(map[string]*list.Element),
+	}
+}
+
+func (c *StatementCache) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	entry := c.acquire(ctx, query)
+	if entry == nil {
+		return c.conn.ExecContext(ctx, query, args...)
+	}
+
+	defer c.release(entry)
+
+	return entry.stmt.ExecContext(ctx, args...)
+}
+
+// QueryContext executes the query with the cached statement. The rows stay valid if the statement gets evicted before
+// they are closed, as database/sql only closes the statement once all of its rows got closed.
+func (c *StatementCache) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	entry := c.acquire(ctx, query)
+	if entry == nil {
+		return c.conn.QueryContext(ctx, query, args...)
+	}
+
+	defer c.release(entry)
+
+	return entry.stmt.QueryContext(ctx, args...)
+}
+
+func (c *StatementCache) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	entry := c.acquire(ctx, query)
+	if entry == nil {
+		return c.conn.QueryRowContext(ctx, query, args...)
+	}
+
+	defer c.release(entry)
+
+	return entry.stmt.QueryRowContext(ctx, args...)
+}
+
+// Len returns the count of cached statements, including the queries which couldn't be prepared.
+func (c *StatementCache) Len() int {
+	c.lck.Lock()
+	defer c.lck.Unlock()
+
+	return c.order.Len()
+}
+
+// Close closes all cached statements which are not in use, the others are closed once their executions finished.
+func (c *StatementCache) Close() error {
+	c.lck.Lock()
+
+	closeable := make([]*cachedStatement, 0, c.order.Len())
+	for c.order.Len() > 0 {
+		if entry := c.evict(c.order.Back()); entry != nil {
+			closeable = append(closeable, entry)
+		}
+	}
+
+	c.lck.Unlock()
+
+	return closeStatements(closeable)
+}
+
+// acquire returns the cached statement of the query and prepares it on a miss. It returns nil if the query can't be
+// prepared, e.g. for statements the database doesn't support to prepare, so the query is executed directly. Such
+// queries are remembered with an entry without a statement, so they aren't prepared again on every execution.
+func (c *StatementCache) acquire(ctx context.Context, query string) *cachedStatement {
+	c.lck.Lock()
+
+	if element, ok := c.entries[query]; ok {
+		entry := element.Value.(*cachedStatement)
+		c.order.MoveToFront(element)
+
+		if entry.stmt == nil {
+			c.lck.Unlock()
+
+			return nil
+		}
+
+		entry.refs++
+		c.lck.Unlock()
+
+		c.writeMetric(ctx, metricNameDbStatementCacheHit)
+
+		return entry
+	}
+
+	c.lck.Unlock()
+	c.writeMetric(ctx, metricNameDbStatementCacheMiss)
+
+	// preparing takes a round trip to the database, so we don't hold the lock while doing it
+	stmt, err := c.conn.PrepareContext(ctx, query)
+	if err != nil {
+		// the query is remembered without a statement below
+		stmt = nil
+	}
+
+	c.lck.Lock()
+
+	closeable := make([]*cachedStatement, 0, 1)
+
+	if element, ok := c.entries[query]; ok {
+		// another execution prepared the same query in the meantime
+		entry := element.Value.(*cachedStatement)
+		c.order.MoveToFront(element)
+
+		if stmt != nil {
+			closeable = append(closeable, &cachedStatement{stmt: stmt})
+		}
+
+		if entry.stmt == nil {
+			entry = nil
+		} else {
+			entry.refs++
+		}
+
+		c.lck.Unlock()
+
+		_ = closeStatements(closeable)
+
+		return entry
+	}
+
+	entry := &cachedStatement{
+		query: query,
+		stmt:  stmt,
+	}
+	c.entries[query] = c.order.PushFront(entry)
+
+	for c.order.Len() > c.size {
+		if evicted := c.evict(c.order.Back()); evicted != nil {
+			closeable = append(closeable, evicted)
+		}
+	}
+
+	if stmt != nil {
+		entry.refs = 1
+	} else {
+		entry = nil
+	}
+
+	c.lck.Unlock()
+
+	_ = closeStatements(closeable)
+
+	return entry
+}
+
+func (c *StatementCache) release(entry *cachedStatement) {
+	c.lck.Lock()
+
+	entry.refs--
+	closeable := entry.evicted && entry.refs == 0
+
+	c.lck.Unlock()
+
+	if closeable {
+		_ = entry.stmt.Close()
+	}
+}
+
+// evict removes the element from the cache and returns its statement if it can be closed right away. It has to be
+// called with the lock held.
+func (c *StatementCache) evict(element *list.Element) *cachedStatement {
+	entry := c.order.Remove(element).(*cachedStatement)
+	delete(c.entries, entry.query)
+	entry.evicted = true
+
+	if entry.stmt == nil || entry.refs > 0 {
+		return nil
+	}
+
+	return entry
+}
+
+func (c *StatementCache) writeMetric(ctx context.Context, metricName string) {
+	c.metricWriter.WriteOne(ctx, &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: metricName,
+		Dimensions: map[string]string{
+			"Name": c.name,
+		},
+		Unit:  metric.UnitCount,
+		Value: 1.0,
+	})
+}
+
+func closeStatements(entries []*cachedStatement) error {
+	var errs error
+
+	for _, entry := range entries {
+		if err := entry.stmt.Close(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("can not close statement: %w", err))
+		}
+	}
+
+	return errs
+}
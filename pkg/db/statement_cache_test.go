@@ -0,0 +1,98 @@
+package db_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func expectStatementCacheMetric(writer *metricMocks.Writer, metricName string, times int) {
+	writer.EXPECT().WriteOne(mock.Anything, mock.MatchedBy(func(datum *metric.Datum) bool {
+		return datum.MetricName == metricName && datum.Dimensions["Name"] == "default"
+	})).Times(times)
+}
+
+func TestStatementCache_Hit(t *testing.T) {
+	conn, dbc, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	writer := metricMocks.NewWriter(t)
+	expectStatementCacheMetric(writer, "DbStatementCacheMiss", 1)
+	expectStatementCacheMetric(writer, "DbStatementCacheHit", 1)
+
+	prepared := dbc.ExpectPrepare(regexp.QuoteMeta("UPDATE users SET name = ? WHERE id = ?"))
+	prepared.ExpectExec().WithArgs("a", 1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	prepared.ExpectExec().WithArgs("b", 2).WillReturnResult(goSqlMock.NewResult(0, 1))
+
+	cache := db.NewStatementCacheWithInterfaces(conn, writer, "default", 10)
+
+	_, err = cache.ExecContext(t.Context(), "UPDATE users SET name = ? WHERE id = ?", "a", 1)
+	assert.NoError(t, err)
+
+	_, err = cache.ExecContext(t.Context(), "UPDATE users SET name = ? WHERE id = ?", "b", 2)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, cache.Len())
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestStatementCache_Eviction(t *testing.T) {
+	conn, dbc, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	writer := metricMocks.NewWriter(t)
+	expectStatementCacheMetric(writer, "DbStatementCacheMiss", 3)
+
+	first := dbc.ExpectPrepare(regexp.QuoteMeta("SELECT * FROM users WHERE id = ?")).WillBeClosed()
+	first.ExpectQuery().WithArgs(1).WillReturnRows(goSqlMock.NewRows([]string{"id"}).AddRow(1))
+
+	second := dbc.ExpectPrepare(regexp.QuoteMeta("SELECT * FROM orders WHERE id = ?"))
+	second.ExpectQuery().WithArgs(2).WillReturnRows(goSqlMock.NewRows([]string{"id"}).AddRow(2))
+
+	// the least recently used statement is evicted and closed once the cache is full
+	third := dbc.ExpectPrepare(regexp.QuoteMeta("SELECT * FROM items WHERE id = ?"))
+	third.ExpectQuery().WithArgs(3).WillReturnRows(goSqlMock.NewRows([]string{"id"}).AddRow(3))
+
+	cache := db.NewStatementCacheWithInterfaces(conn, writer, "default", 2)
+
+	for i, table := range []string{"users", "orders", "items"} {
+		rows, err := cache.QueryContext(t.Context(), fmt.Sprintf("SELECT * FROM %s WHERE id = ?", table), i+1)
+		assert.NoError(t, err)
+		assert.NoError(t, rows.Close())
+	}
+
+	assert.Equal(t, 2, cache.Len())
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestStatementCache_PrepareError(t *testing.T) {
+	conn, dbc, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	writer := metricMocks.NewWriter(t)
+	expectStatementCacheMetric(writer, "DbStatementCacheMiss", 1)
+
+	dbc.ExpectPrepare(regexp.QuoteMeta("LOCK TABLES users WRITE")).WillReturnError(fmt.Errorf("not supported in the prepared statement protocol"))
+	dbc.ExpectExec(regexp.QuoteMeta("LOCK TABLES users WRITE")).WillReturnResult(goSqlMock.NewResult(0, 0))
+	// the second execution neither prepares the statement again nor counts as a miss
+	dbc.ExpectExec(regexp.QuoteMeta("LOCK TABLES users WRITE")).WillReturnResult(goSqlMock.NewResult(0, 0))
+
+	cache := db.NewStatementCacheWithInterfaces(conn, writer, "default", 10)
+
+	_, err = cache.ExecContext(t.Context(), "LOCK TABLES users WRITE")
+	assert.NoError(t, err, "statements which can't be prepared should be executed directly")
+
+	_, err = cache.ExecContext(t.Context(), "LOCK TABLES users WRITE")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, cache.Len())
+	assert.NoError(t, cache.Close())
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/tracing"
+)
+
+var (
+	statementLiterals   = regexp.MustCompile(`'(?:[^'\\]|\\.|'')*'|\$\d+|\b\d+(?:\.\d+)?\b`)
+	statementWhitespace = regexp.MustCompile(`\s+`)
+)
+
+type tracingSpanCtxKey struct{}
+
+// tracingHook records the queries of a connection as sub spans of the span found in the context of the query.
+// Queries without a span in their context, e.g. the ones of the migrations, are not traced.
+type tracingHook struct {
+	tracer   tracing.Tracer
+	settings SettingsTracing
+	system   string
+	database string
+}
+
+func newTracingHook(tracer tracing.Tracer, settings *Settings) QueryHook {
+	return &tracingHook{
+		tracer:   tracer,
+		settings: settings.Tracing,
+		system:   settings.Driver,
+		database: settings.Uri.Database,
+	}
+}
+
+func newTracedDriver(drv driver.Driver, tracer tracing.Tracer, settings *Settings) driver.Driver {
+	return newHookedDriver(drv, newTracingHook(tracer, settings))
+}
+
+func (h *tracingHook) BeforeQuery(ctx context.Context, event *QueryEvent) context.Context {
+	if tracing.GetSpanFromContext(ctx) == nil {
+		return ctx
+	}
+
+	ctx, span := h.tracer.StartSubSpan(ctx, "db."+event.Operation)
+	span.AddAnnotation("db.system", h.system)
+	span.AddAnnotation("db.name", h.database)
+	span.AddAnnotation("db.operation", event.Operation)
+
+	if h.settings.RecordStatement {
+		span.AddMetadata("db.statement", SanitizeStatement(event.Statement))
+	}
+
+	return context.WithValue(ctx, tracingSpanCtxKey{}, span)
+}
+
+func (h *tracingHook) AfterQuery(ctx context.Context, event *QueryEvent) {
+	span, ok := ctx.Value(tracingSpanCtxKey{}).(tracing.Span)
+	if !ok {
+		return
+	}
+
+	if event.Err != nil {
+		span.AddError(event.Err)
+	}
+
+	switch {
+	case event.Skipped || event.Err != nil || event.Rows < 0:
+	case event.Operation == OperationQuery:
+		span.AddMetadata("db.rows", int(event.Rows))
+	default:
+		span.AddMetadata("db.rows_affected", event.Rows)
+	}
+
+	span.Finish()
+}
+
+// SanitizeStatement replaces all string and number literals of the statement with "?" and collapses its whitespace,
+// so statements can be recorded without leaking the data they contain.
+func SanitizeStatement(statement string) string {
+	statement = statementLiterals.ReplaceAllStringFunc(statement, func(literal string) string {
+		// keep positional placeholders like $1
+		if strings.HasPrefix(literal, "$") {
+			return literal
+		}
+
+		return "?"
+	})
+	statement = statementWhitespace.ReplaceAllString(statement, " ")
+
+	return strings.TrimSpace(statement)
+}
@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	tracingMocks "github.com/justtrackio/gosoline/pkg/tracing/mocks"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeStatement(t *testing.T) {
+	for statement, expected := range map[string]string{
+		"SELECT * FROM users WHERE id = 1":                          "SELECT * FROM users WHERE id = ?",
+		"SELECT * FROM t1 WHERE name = 'it''s' AND score > 1.5":     "SELECT * FROM t1 WHERE name = ? AND score > ?",
+		"UPDATE users\n\tSET name = 'a\\'b'\n\tWHERE id = $1":       "UPDATE users SET name = ? WHERE id = $1",
+		"INSERT INTO logs (msg, level) VALUES (?, ?), ('error', 3)": "INSERT INTO logs (msg, level) VALUES (?, ?), (?, ?)",
+	} {
+		assert.Equal(t, expected, SanitizeStatement(statement), statement)
+	}
+}
+
+func TestTracedDriver_Exec(t *testing.T) {
+	span := tracingMocks.NewSpan(t)
+	span.EXPECT().AddAnnotation("db.system", "fake").Once()
+	span.EXPECT().AddAnnotation("db.name", "orders").Once()
+	span.EXPECT().AddAnnotation("db.operation", "exec").Once()
+	span.EXPECT().AddMetadata("db.statement", "DELETE FROM orders WHERE id = ?").Once()
+	span.EXPECT().AddMetadata("db.rows_affected", int64(3)).Once()
+	span.EXPECT().Finish().Once()
+
+	ctx := tracing.ContextWithSpan(context.Background(), span)
+
+	tracer := tracingMocks.NewTracer(t)
+	tracer.EXPECT().StartSubSpan(ctx, "db.exec").Return(ctx, span).Once()
+
+	db := openTracedDb(t, tracer)
+
+	_, err := db.ExecContext(ctx, "DELETE FROM orders WHERE id = 5")
+	assert.NoError(t, err)
+}
+
+func TestTracedDriver_Query(t *testing.T) {
+	span := tracingMocks.NewSpan(t)
+	span.EXPECT().AddAnnotation(mock.Anything, mock.Anything).Times(3)
+	span.EXPECT().AddMetadata("db.statement", "SELECT id FROM orders WHERE status = ?").Once()
+	span.EXPECT().AddMetadata("db.rows", 2).Once()
+	span.EXPECT().Finish().Once()
+
+	ctx := tracing.ContextWithSpan(context.Background(), span)
+
+	tracer := tracingMocks.NewTracer(t)
+	tracer.EXPECT().StartSubSpan(ctx, "db.query").Return(ctx, span).Once()
+
+	db := openTracedDb(t, tracer)
+
+	rows, err := db.QueryContext(ctx, "SELECT id FROM orders WHERE status = 'open'")
+	require.NoError(t, err)
+
+	count := 0
+	for rows.Next() {
+		count++
+	}
+
+	assert.Equal(t, 2, count)
+	assert.NoError(t, rows.Close())
+}
+
+func TestTracedDriver_Error(t *testing.T) {
+	span := tracingMocks.NewSpan(t)
+	span.EXPECT().AddAnnotation(mock.Anything, mock.Anything).Times(3)
+	span.EXPECT().AddMetadata("db.statement", "FAIL").Once()
+	span.EXPECT().AddError(errFakeStatement).Once()
+	span.EXPECT().Finish().Once()
+
+	ctx := tracing.ContextWithSpan(context.Background(), span)
+
+	tracer := tracingMocks.NewTracer(t)
+	tracer.EXPECT().StartSubSpan(ctx, "db.exec").Return(ctx, span).Once()
+
+	db := openTracedDb(t, tracer)
+
+	_, err := db.ExecContext(ctx, "FAIL")
+	assert.ErrorIs(t, err, errFakeStatement)
+}
+
+func TestTracedDriver_WithoutSpan(t *testing.T) {
+	tracer := tracingMocks.NewTracer(t)
+	db := openTracedDb(t, tracer)
+
+	_, err := db.ExecContext(context.Background(), "DELETE FROM orders")
+	assert.NoError(t, err)
+}
+
+func openTracedDb(t *testing.T, tracer tracing.Tracer) *sql.DB {
+	settings := &Settings{
+		Driver: "fake",
+		Uri: SettingsUri{
+			Database: "orders",
+		},
+		Tracing: SettingsTracing{
+			Enabled:         true,
+			RecordStatement: true,
+		},
+	}
+
+	name := uuid.New().NewV4()
+	sql.Register(name, newTracedDriver(fakeDriver{}, tracer, settings))
+
+	db, err := sql.Open(name, "")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, db.Close())
+	})
+
+	return db
+}
+
+var errFakeStatement = fmt.Errorf("fake statement failed")
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if query == "FAIL" {
+		return nil, errFakeStatement
+	}
+
+	return driver.RowsAffected(3), nil
+}
+
+func (fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{remaining: 2}, nil
+}
+
+type fakeRows struct {
+	remaining int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"id"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.remaining == 0 {
+		return io.EOF
+	}
+
+	r.remaining--
+	dest[0] = int64(r.remaining)
+
+	return nil
+}
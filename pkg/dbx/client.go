@@ -17,6 +17,7 @@ import (
 var ErrNotFound = errors.New("dbx: not found")
 
 // Client is the main entry point to the package. It is used to create query builders.
+//go:generate go run github.com/vektra/mockery/v2 --name Client
 type Client[T any] interface {
 	// Delete creates a new DELETE query builder.
 	//
@@ -60,16 +61,22 @@ type client[T any] struct {
 // It takes a context, a config, a logger, a client name and a table name as arguments.
 // The client name is the name of the database client to use, as configured in your application's configuration file.
 // The table name is the name of the database table.
+// The placeholders of the queries match the driver of the database client.
 // It returns a new client or an error if the client could not be created.
 func NewClient[T any](ctx context.Context, config cfg.Config, logger log.Logger, clientName string, table string) (*client[T], error) {
 	var err error
 	var client db.Client
+	var settings *db.Settings
+
+	if settings, err = db.ReadSettings(config, clientName); err != nil {
+		return nil, fmt.Errorf("unable to read settings: %w", err)
+	}
 
 	if client, err = db.ProvideClient(ctx, config, logger, clientName); err != nil {
 		return nil, fmt.Errorf("unable to create client: %w", err)
 	}
 
-	return NewClientWithInterfaces[T](client, table, Question)
+	return NewClientWithInterfaces[T](client, table, db.GetDialect(settings.Driver).PlaceholderFormat())
 }
 
 // NewClientWithInterfaces creates a new dbx client with a given database client.
@@ -2,6 +2,7 @@ package ddb
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
@@ -52,11 +53,21 @@ func (b *putItemBuilder) Build(item any) (*dynamodb.PutItemInput, error) {
 		return nil, fmt.Errorf("the provided old value has to be a pointer")
 	}
 
-	var err error
+	version, versioned, err := readVersion(b.metadata, item)
+	if err != nil {
+		return nil, err
+	}
+
 	expr := expression.Expression{}
+	condition := b.condition
 
-	if b.condition != nil {
-		expr, err = expression.NewBuilder().WithCondition(*b.condition).Build()
+	if versioned {
+		versionCond := versionCondition(b.metadata, version, condition)
+		condition = &versionCond
+	}
+
+	if condition != nil {
+		expr, err = expression.NewBuilder().WithCondition(*condition).Build()
 	}
 
 	if err != nil {
@@ -77,6 +88,10 @@ func (b *putItemBuilder) Build(item any) (*dynamodb.PutItemInput, error) {
 		return nil, err
 	}
 
+	if versioned {
+		marshalled[b.metadata.Version.Field] = &types.AttributeValueMemberN{Value: strconv.FormatInt(version+1, 10)}
+	}
+
 	input.Item = marshalled
 
 	return input, err
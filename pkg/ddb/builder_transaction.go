@@ -0,0 +1,183 @@
+package ddb
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/go-multierror"
+)
+
+// maxTransactItems is the maximum number of items DynamoDB accepts in a single transaction.
+const maxTransactItems = 100
+
+// TransactWriteBuilder collects write operations against one or more repositories
+// which are executed all-or-nothing by TransactionRepository.TransactWrite.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name TransactWriteBuilder
+type TransactWriteBuilder interface {
+	Put(qb PutItemBuilder, item any) TransactWriteBuilder
+	Update(ub UpdateItemBuilder, item any) TransactWriteBuilder
+	Delete(db DeleteItemBuilder, item any) TransactWriteBuilder
+	ConditionCheck(cb ConditionCheckBuilder, item any) TransactWriteBuilder
+	// WithIdempotencyToken sets the ClientRequestToken of the transaction. Repeating a transaction with the same
+	// token within ten minutes succeeds without applying the writes a second time.
+	WithIdempotencyToken(token string) TransactWriteBuilder
+	Items() []TransactWriteItemBuilder
+	Build() (*dynamodb.TransactWriteItemsInput, error)
+}
+
+type transactWriteBuilder struct {
+	items []TransactWriteItemBuilder
+	token *string
+}
+
+func NewTransactWriteBuilder() TransactWriteBuilder {
+	return &transactWriteBuilder{
+		items: make([]TransactWriteItemBuilder, 0),
+	}
+}
+
+func (b *transactWriteBuilder) Put(qb PutItemBuilder, item any) TransactWriteBuilder {
+	b.items = append(b.items, &TransactPutItem{
+		Builder: qb,
+		Item:    item,
+	})
+
+	return b
+}
+
+func (b *transactWriteBuilder) Update(ub UpdateItemBuilder, item any) TransactWriteBuilder {
+	b.items = append(b.items, &TransactUpdateItem{
+		Builder: ub,
+		Item:    item,
+	})
+
+	return b
+}
+
+func (b *transactWriteBuilder) Delete(db DeleteItemBuilder, item any) TransactWriteBuilder {
+	b.items = append(b.items, &TransactDeleteItem{
+		Builder: db,
+		Item:    item,
+	})
+
+	return b
+}
+
+func (b *transactWriteBuilder) ConditionCheck(cb ConditionCheckBuilder, item any) TransactWriteBuilder {
+	b.items = append(b.items, &TransactConditionCheck{
+		Builder: cb,
+		Item:    item,
+	})
+
+	return b
+}
+
+func (b *transactWriteBuilder) WithIdempotencyToken(token string) TransactWriteBuilder {
+	b.token = &token
+
+	return b
+}
+
+func (b *transactWriteBuilder) Items() []TransactWriteItemBuilder {
+	return b.items
+}
+
+func (b *transactWriteBuilder) Build() (*dynamodb.TransactWriteItemsInput, error) {
+	var result error
+
+	if len(b.items) > maxTransactItems {
+		return nil, fmt.Errorf("a transaction can contain at most %d items, got %d", maxTransactItems, len(b.items))
+	}
+
+	transactItems := make([]types.TransactWriteItem, 0, len(b.items))
+
+	for i, itemBuilder := range b.items {
+		item, err := itemBuilder.Build()
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("can not build transact write item %d: %w", i, err))
+
+			continue
+		}
+
+		transactItems = append(transactItems, *item)
+	}
+
+	if result != nil {
+		return nil, result
+	}
+
+	input := &dynamodb.TransactWriteItemsInput{
+		ClientRequestToken:     b.token,
+		TransactItems:          transactItems,
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	}
+
+	return input, nil
+}
+
+// TransactGetBuilder collects reads against one or more repositories which are executed
+// as a single consistent snapshot by TransactionRepository.TransactGet.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name TransactGetBuilder
+type TransactGetBuilder interface {
+	Get(qb GetItemBuilder, item any) TransactGetBuilder
+	Items() []TransactGetItemBuilder
+	Build() (*dynamodb.TransactGetItemsInput, error)
+}
+
+type transactGetBuilder struct {
+	items []TransactGetItemBuilder
+}
+
+func NewTransactGetBuilder() TransactGetBuilder {
+	return &transactGetBuilder{
+		items: make([]TransactGetItemBuilder, 0),
+	}
+}
+
+func (b *transactGetBuilder) Get(qb GetItemBuilder, item any) TransactGetBuilder {
+	b.items = append(b.items, &TransactGetItem{
+		Builder: qb,
+		Item:    item,
+	})
+
+	return b
+}
+
+func (b *transactGetBuilder) Items() []TransactGetItemBuilder {
+	return b.items
+}
+
+func (b *transactGetBuilder) Build() (*dynamodb.TransactGetItemsInput, error) {
+	var result error
+
+	if len(b.items) > maxTransactItems {
+		return nil, fmt.Errorf("a transaction can contain at most %d items, got %d", maxTransactItems, len(b.items))
+	}
+
+	transactItems := make([]types.TransactGetItem, 0, len(b.items))
+
+	for i, itemBuilder := range b.items {
+		item, err := itemBuilder.Build()
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("can not build transact get item %d: %w", i, err))
+
+			continue
+		}
+
+		transactItems = append(transactItems, item)
+	}
+
+	if result != nil {
+		return nil, result
+	}
+
+	input := &dynamodb.TransactGetItemsInput{
+		TransactItems:          transactItems,
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	}
+
+	return input, nil
+}
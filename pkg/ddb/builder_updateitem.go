@@ -35,6 +35,8 @@ type updateItemBuilder struct {
 	condition     *expression.ConditionBuilder
 	updateBuilder *expression.UpdateBuilder
 	returnType    types.ReturnValue
+	// versionIncremented is set once the increment of the version attribute was added to the update
+	versionIncremented bool
 }
 
 func NewUpdateItemBuilder(metadata *Metadata) UpdateItemBuilder {
@@ -154,7 +156,20 @@ func (b *updateItemBuilder) Build(item any) (*dynamodb.UpdateItemInput, error) {
 		return nil, fmt.Errorf("value for returning the updated item is not a pointer")
 	}
 
-	expr, err := b.buildExpression()
+	version, versioned, err := readVersion(b.metadata, item)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := b.condition
+
+	if versioned {
+		versionCond := versionCondition(b.metadata, version, condition)
+		condition = &versionCond
+		b.incrementVersion()
+	}
+
+	expr, err := b.buildExpression(condition)
 	if err != nil {
 		return nil, err
 	}
@@ -173,19 +188,25 @@ func (b *updateItemBuilder) Build(item any) (*dynamodb.UpdateItemInput, error) {
 	return input, err
 }
 
-func (b *updateItemBuilder) buildExpression() (expression.Expression, error) {
-	if b.updateBuilder == nil && b.condition == nil {
-		return expression.Expression{}, nil
+// incrementVersion adds the increment of the version attribute to the update once. It doesn't depend on the version of
+// the item, so the builder can still be built multiple times.
+func (b *updateItemBuilder) incrementVersion() {
+	if b.versionIncremented {
+		return
 	}
 
-	exprBuilder := expression.NewBuilder()
+	field := expression.Name(b.metadata.Version.Field)
+	b.update(func() expression.UpdateBuilder {
+		return b.updateBuilder.Set(field, expression.Plus(expression.IfNotExists(field, expression.Value(0)), expression.Value(1)))
+	})
+	b.versionIncremented = true
+}
 
-	if b.updateBuilder != nil {
-		exprBuilder = exprBuilder.WithUpdate(*b.updateBuilder)
-	}
+func (b *updateItemBuilder) buildExpression(condition *expression.ConditionBuilder) (expression.Expression, error) {
+	exprBuilder := expression.NewBuilder().WithUpdate(*b.updateBuilder)
 
-	if b.condition != nil {
-		exprBuilder = exprBuilder.WithCondition(*b.condition)
+	if condition != nil {
+		exprBuilder = exprBuilder.WithCondition(*condition)
 	}
 
 	return exprBuilder.Build()
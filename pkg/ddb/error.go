@@ -28,3 +28,25 @@ func (t TableNotFoundError) Error() string {
 func (t TableNotFoundError) Unwrap() error {
 	return t.err
 }
+
+func IsVersionConflictError(err error) bool {
+	return errors.As(err, &VersionConflictError{})
+}
+
+// VersionConflictError is returned by PutItem and UpdateItem for models with a version attribute if the item was
+// modified concurrently, i.e., the version in the table no longer matches the version of the written item.
+type VersionConflictError struct {
+	TableName string
+	Version   int64
+}
+
+func NewVersionConflictError(tableName string, version int64) VersionConflictError {
+	return VersionConflictError{
+		TableName: tableName,
+		Version:   version,
+	}
+}
+
+func (e VersionConflictError) Error() string {
+	return fmt.Sprintf("item in ddb table %s was modified concurrently: expected version %d", e.TableName, e.Version)
+}
@@ -19,8 +19,11 @@ func DynamoDbFixtureSetFactory[T any](settings *Settings, data fixtures.NamedFix
 		var err error
 		var writer fixtures.FixtureWriter
 
-		if writer, err = NewDynamoDbFixtureWriter(ctx, config, logger, settings); err != nil {
-			return nil, fmt.Errorf("failed to create dynamodb fixture writer for %s: %w", settings.ModelId.String(), err)
+		isolated := *settings
+		isolated.ModelId.Name = fixtures.IsolatedName(ctx, settings.ModelId.Name)
+
+		if writer, err = NewDynamoDbFixtureWriter(ctx, config, logger, &isolated); err != nil {
+			return nil, fmt.Errorf("failed to create dynamodb fixture writer for %s: %w", isolated.ModelId.String(), err)
 		}
 
 		return fixtures.NewSimpleFixtureSet(data, writer, options...), nil
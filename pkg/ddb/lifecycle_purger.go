@@ -74,13 +74,10 @@ func (s *LifeCyclePurger) purgeDropTable(ctx context.Context, table *types.Table
 
 	gsi := funk.NilIfEmpty(funk.Map(table.GlobalSecondaryIndexes, func(index types.GlobalSecondaryIndexDescription) types.GlobalSecondaryIndex {
 		return types.GlobalSecondaryIndex{
-			IndexName:  index.IndexName,
-			KeySchema:  index.KeySchema,
-			Projection: index.Projection,
-			ProvisionedThroughput: &types.ProvisionedThroughput{
-				ReadCapacityUnits:  index.ProvisionedThroughput.ReadCapacityUnits,
-				WriteCapacityUnits: index.ProvisionedThroughput.WriteCapacityUnits,
-			},
+			IndexName:             index.IndexName,
+			KeySchema:             index.KeySchema,
+			Projection:            index.Projection,
+			ProvisionedThroughput: describedProvisionedThroughput(table, index.ProvisionedThroughput),
 		}
 	}))
 	lsi := funk.NilIfEmpty(funk.Map(table.LocalSecondaryIndexes, func(index types.LocalSecondaryIndexDescription) types.LocalSecondaryIndex {
@@ -97,10 +94,8 @@ func (s *LifeCyclePurger) purgeDropTable(ctx context.Context, table *types.Table
 		TableName:              table.TableName,
 		GlobalSecondaryIndexes: gsi,
 		LocalSecondaryIndexes:  lsi,
-		ProvisionedThroughput: &types.ProvisionedThroughput{
-			ReadCapacityUnits:  table.ProvisionedThroughput.ReadCapacityUnits,
-			WriteCapacityUnits: table.ProvisionedThroughput.WriteCapacityUnits,
-		},
+		BillingMode:            describedBillingMode(table),
+		ProvisionedThroughput:  describedProvisionedThroughput(table, table.ProvisionedThroughput),
 	}
 
 	if _, err = s.client.CreateTable(ctx, input); err != nil {
@@ -110,6 +105,25 @@ func (s *LifeCyclePurger) purgeDropTable(ctx context.Context, table *types.Table
 	return nil
 }
 
+func describedBillingMode(table *types.TableDescription) types.BillingMode {
+	if table.BillingModeSummary == nil || table.BillingModeSummary.BillingMode == "" {
+		return types.BillingModeProvisioned
+	}
+
+	return table.BillingModeSummary.BillingMode
+}
+
+func describedProvisionedThroughput(table *types.TableDescription, throughput *types.ProvisionedThroughputDescription) *types.ProvisionedThroughput {
+	if describedBillingMode(table) == types.BillingModePayPerRequest || throughput == nil {
+		return nil
+	}
+
+	return &types.ProvisionedThroughput{
+		ReadCapacityUnits:  throughput.ReadCapacityUnits,
+		WriteCapacityUnits: throughput.WriteCapacityUnits,
+	}
+}
+
 func (s *LifeCyclePurger) purgeScan(ctx context.Context, keyFields []types.KeySchemaElement) error {
 	cfn := coffin.New()
 
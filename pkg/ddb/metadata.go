@@ -1,6 +1,10 @@
 package ddb
 
-import "slices"
+import (
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
 
 const (
 	tagKey    = "key"
@@ -23,12 +27,14 @@ type FieldAware interface {
 }
 
 type Metadata struct {
-	TableName  string
-	Attributes Attributes
-	TimeToLive metadataTtl
-	Main       metadataMain
-	Local      metaLocal
-	Global     metaGlobal
+	TableName   string
+	Attributes  Attributes
+	TimeToLive  metadataTtl
+	Version     metadataVersion
+	BillingMode types.BillingMode
+	Main        metadataMain
+	Local       metaLocal
+	Global      metaGlobal
 }
 
 func (d *Metadata) Index(name string) FieldAware {
@@ -108,6 +114,7 @@ func (f metadataFields) GetFields() []string {
 type metadataCapacity struct {
 	ReadCapacityUnits  int64
 	WriteCapacityUnits int64
+	AutoScaling        AutoScalingSettings
 }
 
 type metadataMain struct {
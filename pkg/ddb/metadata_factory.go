@@ -51,6 +51,11 @@ func (f *MetadataFactory) GetMetadata() (*Metadata, error) {
 		return nil, fmt.Errorf("can not get ttl for table %s: %w", f.tableName, err)
 	}
 
+	version, err := f.getVersion(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("can not get version for table %s: %w", f.tableName, err)
+	}
+
 	mainFields, err := f.getFields(f.settings.Main.Model, tagKey, tagKey)
 	if err != nil {
 		return nil, fmt.Errorf("can not get fields for main table %s: %w", f.tableName, err)
@@ -67,14 +72,17 @@ func (f *MetadataFactory) GetMetadata() (*Metadata, error) {
 	}
 
 	metadata := &Metadata{
-		TableName:  f.tableName,
-		Attributes: attributes,
-		TimeToLive: ttl,
+		TableName:   f.tableName,
+		Attributes:  attributes,
+		TimeToLive:  ttl,
+		Version:     version,
+		BillingMode: f.settings.Main.BillingMode,
 		Main: metadataMain{
 			metadataFields: mainFields,
 			metadataCapacity: metadataCapacity{
 				ReadCapacityUnits:  f.settings.Main.ReadCapacityUnits,
 				WriteCapacityUnits: f.settings.Main.WriteCapacityUnits,
+				AutoScaling:        f.settings.Main.AutoScaling,
 			},
 		},
 		Local:  local,
@@ -214,6 +222,7 @@ func (f *MetadataFactory) getGlobalSecondaryIndices(settings []GlobalSettings) (
 			metadataCapacity: metadataCapacity{
 				ReadCapacityUnits:  gs.ReadCapacityUnits,
 				WriteCapacityUnits: gs.WriteCapacityUnits,
+				AutoScaling:        gs.AutoScaling,
 			},
 		}
 	}
@@ -240,6 +249,31 @@ func (f *MetadataFactory) getTimeToLive(attributes Attributes) (metadataTtl, err
 	return data, nil
 }
 
+func (f *MetadataFactory) getVersion(attributes Attributes) (metadataVersion, error) {
+	data := metadataVersion{
+		Enabled: false,
+	}
+
+	version, err := attributes.GetByTag("version", "enabled")
+	if err != nil {
+		return data, err
+	}
+
+	if version == nil {
+		return data, nil
+	}
+
+	if version.Type != types.ScalarAttributeTypeN {
+		return data, fmt.Errorf("the attribute of the version field '%s' has to be of type N but instead is of type %s", version.FieldName, version.Type)
+	}
+
+	data.Enabled = true
+	data.Field = version.AttributeName
+	data.FieldName = version.FieldName
+
+	return data, nil
+}
+
 func ReadAttributes(model any) (Attributes, error) {
 	t := findBaseType(model)
 	attributes := make(Attributes)
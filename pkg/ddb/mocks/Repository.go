@@ -9,6 +9,8 @@ import (
 	mdl "github.com/justtrackio/gosoline/pkg/mdl"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -83,6 +85,65 @@ func (_c *Repository_BatchDeleteItems_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// BatchExecuteStatement provides a mock function with given fields: ctx, statements
+func (_m *Repository) BatchExecuteStatement(ctx context.Context, statements []ddb.Statement) (*ddb.BatchExecuteStatementResult, error) {
+	ret := _m.Called(ctx, statements)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchExecuteStatement")
+	}
+
+	var r0 *ddb.BatchExecuteStatementResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ddb.Statement) (*ddb.BatchExecuteStatementResult, error)); ok {
+		return rf(ctx, statements)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ddb.Statement) *ddb.BatchExecuteStatementResult); ok {
+		r0 = rf(ctx, statements)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ddb.BatchExecuteStatementResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ddb.Statement) error); ok {
+		r1 = rf(ctx, statements)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_BatchExecuteStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchExecuteStatement'
+type Repository_BatchExecuteStatement_Call struct {
+	*mock.Call
+}
+
+// BatchExecuteStatement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - statements []ddb.Statement
+func (_e *Repository_Expecter) BatchExecuteStatement(ctx interface{}, statements interface{}) *Repository_BatchExecuteStatement_Call {
+	return &Repository_BatchExecuteStatement_Call{Call: _e.mock.On("BatchExecuteStatement", ctx, statements)}
+}
+
+func (_c *Repository_BatchExecuteStatement_Call) Run(run func(ctx context.Context, statements []ddb.Statement)) *Repository_BatchExecuteStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ddb.Statement))
+	})
+	return _c
+}
+
+func (_c *Repository_BatchExecuteStatement_Call) Return(_a0 *ddb.BatchExecuteStatementResult, _a1 error) *Repository_BatchExecuteStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_BatchExecuteStatement_Call) RunAndReturn(run func(context.Context, []ddb.Statement) (*ddb.BatchExecuteStatementResult, error)) *Repository_BatchExecuteStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BatchGetItems provides a mock function with given fields: ctx, qb, result
 func (_m *Repository) BatchGetItems(ctx context.Context, qb ddb.BatchGetItemsBuilder, result interface{}) (*ddb.OperationResult, error) {
 	ret := _m.Called(ctx, qb, result)
@@ -249,6 +310,53 @@ func (_c *Repository_BatchPutItems_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ConditionCheckBuilder provides a mock function with no fields
+func (_m *Repository) ConditionCheckBuilder() ddb.ConditionCheckBuilder {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConditionCheckBuilder")
+	}
+
+	var r0 ddb.ConditionCheckBuilder
+	if rf, ok := ret.Get(0).(func() ddb.ConditionCheckBuilder); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ddb.ConditionCheckBuilder)
+		}
+	}
+
+	return r0
+}
+
+// Repository_ConditionCheckBuilder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConditionCheckBuilder'
+type Repository_ConditionCheckBuilder_Call struct {
+	*mock.Call
+}
+
+// ConditionCheckBuilder is a helper method to define mock.On call
+func (_e *Repository_Expecter) ConditionCheckBuilder() *Repository_ConditionCheckBuilder_Call {
+	return &Repository_ConditionCheckBuilder_Call{Call: _e.mock.On("ConditionCheckBuilder")}
+}
+
+func (_c *Repository_ConditionCheckBuilder_Call) Run(run func()) *Repository_ConditionCheckBuilder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Repository_ConditionCheckBuilder_Call) Return(_a0 ddb.ConditionCheckBuilder) *Repository_ConditionCheckBuilder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_ConditionCheckBuilder_Call) RunAndReturn(run func() ddb.ConditionCheckBuilder) *Repository_ConditionCheckBuilder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteItem provides a mock function with given fields: ctx, db, item
 func (_m *Repository) DeleteItem(ctx context.Context, db ddb.DeleteItemBuilder, item interface{}) (*ddb.DeleteItemResult, error) {
 	ret := _m.Called(ctx, db, item)
@@ -356,6 +464,67 @@ func (_c *Repository_DeleteItemBuilder_Call) RunAndReturn(run func() ddb.DeleteI
 	return _c
 }
 
+// ExecuteStatement provides a mock function with given fields: ctx, statement, params, result
+func (_m *Repository) ExecuteStatement(ctx context.Context, statement string, params []interface{}, result interface{}) (*ddb.ExecuteStatementResult, error) {
+	ret := _m.Called(ctx, statement, params, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteStatement")
+	}
+
+	var r0 *ddb.ExecuteStatementResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []interface{}, interface{}) (*ddb.ExecuteStatementResult, error)); ok {
+		return rf(ctx, statement, params, result)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []interface{}, interface{}) *ddb.ExecuteStatementResult); ok {
+		r0 = rf(ctx, statement, params, result)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ddb.ExecuteStatementResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []interface{}, interface{}) error); ok {
+		r1 = rf(ctx, statement, params, result)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository_ExecuteStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteStatement'
+type Repository_ExecuteStatement_Call struct {
+	*mock.Call
+}
+
+// ExecuteStatement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - statement string
+//   - params []interface{}
+//   - result interface{}
+func (_e *Repository_Expecter) ExecuteStatement(ctx interface{}, statement interface{}, params interface{}, result interface{}) *Repository_ExecuteStatement_Call {
+	return &Repository_ExecuteStatement_Call{Call: _e.mock.On("ExecuteStatement", ctx, statement, params, result)}
+}
+
+func (_c *Repository_ExecuteStatement_Call) Run(run func(ctx context.Context, statement string, params []interface{}, result interface{})) *Repository_ExecuteStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]interface{}), args[3].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Repository_ExecuteStatement_Call) Return(_a0 *ddb.ExecuteStatementResult, _a1 error) *Repository_ExecuteStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Repository_ExecuteStatement_Call) RunAndReturn(run func(context.Context, string, []interface{}, interface{}) (*ddb.ExecuteStatementResult, error)) *Repository_ExecuteStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetItem provides a mock function with given fields: ctx, qb, result
 func (_m *Repository) GetItem(ctx context.Context, qb ddb.GetItemBuilder, result interface{}) (*ddb.GetItemResult, error) {
 	ret := _m.Called(ctx, qb, result)
@@ -829,6 +998,53 @@ func (_c *Repository_ScanBuilder_Call) RunAndReturn(run func() ddb.ScanBuilder)
 	return _c
 }
 
+// SetTtl provides a mock function with given fields: item, ttl
+func (_m *Repository) SetTtl(item interface{}, ttl time.Duration) error {
+	ret := _m.Called(item, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTtl")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, time.Duration) error); ok {
+		r0 = rf(item, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository_SetTtl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTtl'
+type Repository_SetTtl_Call struct {
+	*mock.Call
+}
+
+// SetTtl is a helper method to define mock.On call
+//   - item interface{}
+//   - ttl time.Duration
+func (_e *Repository_Expecter) SetTtl(item interface{}, ttl interface{}) *Repository_SetTtl_Call {
+	return &Repository_SetTtl_Call{Call: _e.mock.On("SetTtl", item, ttl)}
+}
+
+func (_c *Repository_SetTtl_Call) Run(run func(item interface{}, ttl time.Duration)) *Repository_SetTtl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Repository_SetTtl_Call) Return(_a0 error) *Repository_SetTtl_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Repository_SetTtl_Call) RunAndReturn(run func(interface{}, time.Duration) error) *Repository_SetTtl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateItem provides a mock function with given fields: ctx, ub, item
 func (_m *Repository) UpdateItem(ctx context.Context, ub ddb.UpdateItemBuilder, item interface{}) (*ddb.UpdateItemResult, error) {
 	ret := _m.Called(ctx, ub, item)
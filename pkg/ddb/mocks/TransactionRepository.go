@@ -22,6 +22,65 @@ func (_m *TransactionRepository) EXPECT() *TransactionRepository_Expecter {
 	return &TransactionRepository_Expecter{mock: &_m.Mock}
 }
 
+// TransactGet provides a mock function with given fields: ctx, tb
+func (_m *TransactionRepository) TransactGet(ctx context.Context, tb ddb.TransactGetBuilder) (*ddb.OperationResult, error) {
+	ret := _m.Called(ctx, tb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransactGet")
+	}
+
+	var r0 *ddb.OperationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ddb.TransactGetBuilder) (*ddb.OperationResult, error)); ok {
+		return rf(ctx, tb)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ddb.TransactGetBuilder) *ddb.OperationResult); ok {
+		r0 = rf(ctx, tb)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ddb.OperationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ddb.TransactGetBuilder) error); ok {
+		r1 = rf(ctx, tb)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_TransactGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransactGet'
+type TransactionRepository_TransactGet_Call struct {
+	*mock.Call
+}
+
+// TransactGet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tb ddb.TransactGetBuilder
+func (_e *TransactionRepository_Expecter) TransactGet(ctx interface{}, tb interface{}) *TransactionRepository_TransactGet_Call {
+	return &TransactionRepository_TransactGet_Call{Call: _e.mock.On("TransactGet", ctx, tb)}
+}
+
+func (_c *TransactionRepository_TransactGet_Call) Run(run func(ctx context.Context, tb ddb.TransactGetBuilder)) *TransactionRepository_TransactGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ddb.TransactGetBuilder))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_TransactGet_Call) Return(_a0 *ddb.OperationResult, _a1 error) *TransactionRepository_TransactGet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_TransactGet_Call) RunAndReturn(run func(context.Context, ddb.TransactGetBuilder) (*ddb.OperationResult, error)) *TransactionRepository_TransactGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransactGetItems provides a mock function with given fields: ctx, items
 func (_m *TransactionRepository) TransactGetItems(ctx context.Context, items []ddb.TransactGetItemBuilder) (*ddb.OperationResult, error) {
 	ret := _m.Called(ctx, items)
@@ -81,6 +140,65 @@ func (_c *TransactionRepository_TransactGetItems_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// TransactWrite provides a mock function with given fields: ctx, tb
+func (_m *TransactionRepository) TransactWrite(ctx context.Context, tb ddb.TransactWriteBuilder) (*ddb.OperationResult, error) {
+	ret := _m.Called(ctx, tb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransactWrite")
+	}
+
+	var r0 *ddb.OperationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ddb.TransactWriteBuilder) (*ddb.OperationResult, error)); ok {
+		return rf(ctx, tb)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ddb.TransactWriteBuilder) *ddb.OperationResult); ok {
+		r0 = rf(ctx, tb)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ddb.OperationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ddb.TransactWriteBuilder) error); ok {
+		r1 = rf(ctx, tb)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_TransactWrite_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransactWrite'
+type TransactionRepository_TransactWrite_Call struct {
+	*mock.Call
+}
+
+// TransactWrite is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tb ddb.TransactWriteBuilder
+func (_e *TransactionRepository_Expecter) TransactWrite(ctx interface{}, tb interface{}) *TransactionRepository_TransactWrite_Call {
+	return &TransactionRepository_TransactWrite_Call{Call: _e.mock.On("TransactWrite", ctx, tb)}
+}
+
+func (_c *TransactionRepository_TransactWrite_Call) Run(run func(ctx context.Context, tb ddb.TransactWriteBuilder)) *TransactionRepository_TransactWrite_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ddb.TransactWriteBuilder))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_TransactWrite_Call) Return(_a0 *ddb.OperationResult, _a1 error) *TransactionRepository_TransactWrite_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_TransactWrite_Call) RunAndReturn(run func(context.Context, ddb.TransactWriteBuilder) (*ddb.OperationResult, error)) *TransactionRepository_TransactWrite_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransactWriteItems provides a mock function with given fields: ctx, items
 func (_m *TransactionRepository) TransactWriteItems(ctx context.Context, items []ddb.TransactWriteItemBuilder) (*ddb.OperationResult, error) {
 	ret := _m.Called(ctx, items)
@@ -52,8 +52,14 @@ type Repository interface {
 	Query(ctx context.Context, qb QueryBuilder, result any) (*QueryResult, error)
 	Scan(ctx context.Context, sb ScanBuilder, result any) (*ScanResult, error)
 	UpdateItem(ctx context.Context, ub UpdateItemBuilder, item any) (*UpdateItemResult, error)
+	// SetTtl sets the ttl attribute of the item, so the item expires after the given duration.
+	SetTtl(item any, ttl time.Duration) error
+	// ExecuteStatement runs a PartiQL statement, see StatementTablePlaceholder to reference the table of the repository.
+	ExecuteStatement(ctx context.Context, statement string, params []any, result any) (*ExecuteStatementResult, error)
+	BatchExecuteStatement(ctx context.Context, statements []Statement) (*BatchExecuteStatementResult, error)
 
 	BatchGetItemsBuilder() BatchGetItemsBuilder
+	ConditionCheckBuilder() ConditionCheckBuilder
 	DeleteItemBuilder() DeleteItemBuilder
 	GetItemBuilder() GetItemBuilder
 	QueryBuilder() QueryBuilder
@@ -468,6 +474,11 @@ func (r *repository) PutItem(ctx context.Context, qb PutItemBuilder, item any) (
 		return nil, fmt.Errorf("could not build input and expr for PutItem operation on table %s: %w", r.metadata.TableName, err)
 	}
 
+	version, versioned, err := readVersion(r.metadata, item)
+	if err != nil {
+		return nil, fmt.Errorf("could not read version of item for PutItem operation on table %s: %w", r.metadata.TableName, err)
+	}
+
 	result := newPutItemResult()
 
 	ctx = aws.WithResourceTarget(ctx, r.metadata.TableName)
@@ -490,6 +501,14 @@ func (r *repository) PutItem(ctx context.Context, qb PutItemBuilder, item any) (
 		return nil, fmt.Errorf("could not execute PutItem operation for table %s: %w", r.metadata.TableName, err)
 	}
 
+	if result.ConditionalCheckFailed && versioned {
+		return result, NewVersionConflictError(r.metadata.TableName, version)
+	}
+
+	if versioned {
+		writeVersion(r.metadata, item, version+1)
+	}
+
 	if out == nil {
 		return result, nil
 	}
@@ -584,6 +603,11 @@ func (r *repository) UpdateItem(ctx context.Context, ub UpdateItemBuilder, item
 		return nil, fmt.Errorf("could not build input for UpdateItem operation on table %s: %w", r.metadata.TableName, err)
 	}
 
+	version, versioned, err := readVersion(r.metadata, item)
+	if err != nil {
+		return nil, fmt.Errorf("could not read version of item for UpdateItem operation on table %s: %w", r.metadata.TableName, err)
+	}
+
 	ctx = aws.WithResourceTarget(ctx, r.metadata.TableName)
 
 	result := newUpdateItemResult()
@@ -605,6 +629,14 @@ func (r *repository) UpdateItem(ctx context.Context, ub UpdateItemBuilder, item
 		return nil, fmt.Errorf("could not execute UpdateItem operation for table %s: %w", r.metadata.TableName, err)
 	}
 
+	if result.ConditionalCheckFailed && versioned {
+		return result, NewVersionConflictError(r.metadata.TableName, version)
+	}
+
+	if versioned {
+		writeVersion(r.metadata, item, version+1)
+	}
+
 	if out == nil {
 		return result, nil
 	}
@@ -690,10 +722,18 @@ func (r *repository) doScan(ctx context.Context, op *ScanOperation) (*readResult
 	}, nil
 }
 
+func (r *repository) SetTtl(item any, ttl time.Duration) error {
+	return setTtl(r.metadata, item, r.clock.Now().Add(ttl))
+}
+
 func (r *repository) BatchGetItemsBuilder() BatchGetItemsBuilder {
 	return NewBatchGetItemsBuilder(r.metadata, r.clock)
 }
 
+func (r *repository) ConditionCheckBuilder() ConditionCheckBuilder {
+	return NewConditionCheckBuilder(r.metadata)
+}
+
 func (r *repository) DeleteItemBuilder() DeleteItemBuilder {
 	return NewDeleteItemBuilder(r.metadata)
 }
@@ -0,0 +1,223 @@
+package ddb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/justtrackio/gosoline/pkg/exec"
+)
+
+// StatementTablePlaceholder is replaced with the quoted table name of the repository in PartiQL statements, so
+// statements don't need to know the generated table name.
+const StatementTablePlaceholder = "{table}"
+
+// A Statement is a single PartiQL statement executed as part of BatchExecuteStatement. If the statement reads an
+// item, it is unmarshalled into Item, which has to be a pointer in this case.
+type Statement struct {
+	Statement      string
+	Parameters     []any
+	ConsistentRead bool
+	Item           any
+}
+
+type ExecuteStatementResult struct {
+	RequestCount     int32
+	ItemCount        int32
+	ConsumedCapacity *ConsumedCapacity
+}
+
+func newExecuteStatementResult() *ExecuteStatementResult {
+	return &ExecuteStatementResult{
+		ConsumedCapacity: newConsumedCapacity(kindMixed),
+	}
+}
+
+type BatchStatementResponse struct {
+	IsFound bool
+	// Error is set if the statement failed, the other statements of the batch are not affected.
+	Error error
+}
+
+type BatchExecuteStatementResult struct {
+	Responses        []BatchStatementResponse
+	ConsumedCapacity *ConsumedCapacity
+}
+
+func newBatchExecuteStatementResult(count int) *BatchExecuteStatementResult {
+	return &BatchExecuteStatementResult{
+		Responses:        make([]BatchStatementResponse, count),
+		ConsumedCapacity: newConsumedCapacity(kindMixed),
+	}
+}
+
+// ExecuteStatement executes a PartiQL statement with the given parameters bound to its ? placeholders. Items returned
+// by the statement are unmarshalled into result, which has to be a pointer to a slice. Pass nil as result for
+// statements not returning any items.
+func (r *repository) ExecuteStatement(ctx context.Context, statement string, params []any, result any) (*ExecuteStatementResult, error) {
+	_, span := r.tracer.StartSubSpan(ctx, "ddb.ExecuteStatement")
+	defer span.Finish()
+
+	var err error
+	var unmarshaller *Unmarshaller
+	var parameters []types.AttributeValue
+
+	if result != nil {
+		if unmarshaller, err = NewUnmarshallerFromPtrSlice(result); err != nil {
+			return nil, fmt.Errorf("can not initialize unmarshaller for ExecuteStatement operation on table %s: %w", r.metadata.TableName, err)
+		}
+	}
+
+	if parameters, err = marshalStatementParameters(params); err != nil {
+		return nil, fmt.Errorf("can not marshal parameters for ExecuteStatement operation on table %s: %w", r.metadata.TableName, err)
+	}
+
+	input := &dynamodb.ExecuteStatementInput{
+		Statement:              r.bindTableName(statement),
+		Parameters:             parameters,
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	}
+
+	ctx = aws.WithResourceTarget(ctx, r.metadata.TableName)
+	res := newExecuteStatementResult()
+
+	for {
+		out, err := r.client.ExecuteStatement(ctx, input)
+
+		if exec.IsRequestCanceled(err) {
+			return nil, exec.RequestCanceledError
+		}
+
+		var errResourceNotFoundException *types.ResourceNotFoundException
+		if errors.As(err, &errResourceNotFoundException) {
+			return nil, NewTableNotFoundError(r.metadata.TableName, err)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not execute ExecuteStatement operation for table %s: %w", r.metadata.TableName, err)
+		}
+
+		res.RequestCount++
+		res.ItemCount += int32(len(out.Items))
+		res.ConsumedCapacity.add(out.ConsumedCapacity)
+
+		if unmarshaller != nil && len(out.Items) > 0 {
+			if err = unmarshaller.Append(out.Items); err != nil {
+				return nil, fmt.Errorf("could not unmarshal items after ExecuteStatement operation for table %s: %w", r.metadata.TableName, err)
+			}
+		}
+
+		if out.NextToken == nil {
+			return res, nil
+		}
+
+		input.NextToken = out.NextToken
+	}
+}
+
+// BatchExecuteStatement executes up to 25 single item PartiQL statements in one request. In contrast to a transaction,
+// every statement succeeds or fails on its own, check the responses of the result for the outcome of each statement.
+func (r *repository) BatchExecuteStatement(ctx context.Context, statements []Statement) (*BatchExecuteStatementResult, error) {
+	_, span := r.tracer.StartSubSpan(ctx, "ddb.BatchExecuteStatement")
+	defer span.Finish()
+
+	res := newBatchExecuteStatementResult(len(statements))
+
+	if len(statements) == 0 {
+		return res, nil
+	}
+
+	input := &dynamodb.BatchExecuteStatementInput{
+		Statements:             make([]types.BatchStatementRequest, len(statements)),
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	}
+
+	for i, statement := range statements {
+		if statement.Item != nil && !isPointer(statement.Item) {
+			return nil, fmt.Errorf("the item of statement %d has to be a pointer", i)
+		}
+
+		parameters, err := marshalStatementParameters(statement.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("can not marshal parameters of statement %d: %w", i, err)
+		}
+
+		input.Statements[i] = types.BatchStatementRequest{
+			Statement:      r.bindTableName(statement.Statement),
+			Parameters:     parameters,
+			ConsistentRead: &statements[i].ConsistentRead,
+		}
+	}
+
+	ctx = aws.WithResourceTarget(ctx, r.metadata.TableName)
+	out, err := r.client.BatchExecuteStatement(ctx, input)
+
+	if exec.IsRequestCanceled(err) {
+		return nil, exec.RequestCanceledError
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not execute BatchExecuteStatement operation for table %s: %w", r.metadata.TableName, err)
+	}
+
+	res.ConsumedCapacity.addSlice(out.ConsumedCapacity)
+
+	for i, response := range out.Responses {
+		if response.Error != nil {
+			res.Responses[i].Error = fmt.Errorf("statement %d failed with %s: %s", i, response.Error.Code, strings.TrimSpace(valueOrEmpty(response.Error.Message)))
+
+			continue
+		}
+
+		if response.Item == nil || statements[i].Item == nil {
+			continue
+		}
+
+		if err = UnmarshalMap(response.Item, statements[i].Item); err != nil {
+			return nil, fmt.Errorf("could not unmarshal item of statement %d: %w", i, err)
+		}
+
+		res.Responses[i].IsFound = true
+	}
+
+	return res, nil
+}
+
+func (r *repository) bindTableName(statement string) *string {
+	statement = strings.ReplaceAll(statement, StatementTablePlaceholder, strconv.Quote(r.metadata.TableName))
+
+	return &statement
+}
+
+func marshalStatementParameters(params []any) ([]types.AttributeValue, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	encoder := NewEncoder()
+	parameters := make([]types.AttributeValue, len(params))
+
+	for i, param := range params {
+		av, err := encoder.Encode(param)
+		if err != nil {
+			return nil, fmt.Errorf("can not marshal parameter %d: %w", i, err)
+		}
+
+		parameters[i] = av
+	}
+
+	return parameters, nil
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
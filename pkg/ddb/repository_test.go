@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/aws/smithy-go"
+	"github.com/justtrackio/gosoline/pkg/clock"
 	dynamodbMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/dynamodb/mocks"
 	"github.com/justtrackio/gosoline/pkg/ddb"
 	"github.com/justtrackio/gosoline/pkg/exec"
@@ -17,6 +19,8 @@ import (
 	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/test/matcher"
 	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -600,6 +604,204 @@ func (s *RepositoryTestSuite) TestDeleteItem() {
 	s.Equal(expected, item)
 }
 
+func (s *RepositoryTestSuite) TestExecuteStatement() {
+	s.client.EXPECT().ExecuteStatement(matcher.Context, &dynamodb.ExecuteStatementInput{
+		Statement: aws.String(`SELECT * FROM "applike-test-gosoline-ddb-myModel" WHERE id = ?`),
+		Parameters: []types.AttributeValue{
+			&types.AttributeValueMemberN{Value: "1"},
+		},
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	}).Return(&dynamodb.ExecuteStatementOutput{
+		Items: []map[string]types.AttributeValue{
+			{
+				"id":  &types.AttributeValueMemberN{Value: "1"},
+				"rev": &types.AttributeValueMemberS{Value: "0"},
+				"foo": &types.AttributeValueMemberS{Value: "bar"},
+			},
+		},
+		NextToken: aws.String("token"),
+	}, nil).Once()
+
+	s.client.EXPECT().ExecuteStatement(matcher.Context, &dynamodb.ExecuteStatementInput{
+		Statement: aws.String(`SELECT * FROM "applike-test-gosoline-ddb-myModel" WHERE id = ?`),
+		Parameters: []types.AttributeValue{
+			&types.AttributeValueMemberN{Value: "1"},
+		},
+		NextToken:              aws.String("token"),
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	}).Return(&dynamodb.ExecuteStatementOutput{
+		Items: []map[string]types.AttributeValue{
+			{
+				"id":  &types.AttributeValueMemberN{Value: "1"},
+				"rev": &types.AttributeValueMemberS{Value: "1"},
+				"foo": &types.AttributeValueMemberS{Value: "baz"},
+			},
+		},
+	}, nil).Once()
+
+	result := make([]model, 0)
+	res, err := s.repo.ExecuteStatement(s.ctx, "SELECT * FROM {table} WHERE id = ?", []any{1}, &result)
+
+	s.NoError(err)
+	s.Equal(int32(2), res.RequestCount)
+	s.Equal(int32(2), res.ItemCount)
+	s.Equal([]model{
+		{Id: 1, Rev: "0", Foo: "bar"},
+		{Id: 1, Rev: "1", Foo: "baz"},
+	}, result)
+}
+
+func (s *RepositoryTestSuite) TestBatchExecuteStatement() {
+	s.client.EXPECT().BatchExecuteStatement(matcher.Context, &dynamodb.BatchExecuteStatementInput{
+		Statements: []types.BatchStatementRequest{
+			{
+				Statement: aws.String(`SELECT * FROM "applike-test-gosoline-ddb-myModel" WHERE id = ? AND rev = ?`),
+				Parameters: []types.AttributeValue{
+					&types.AttributeValueMemberN{Value: "1"},
+					&types.AttributeValueMemberS{Value: "0"},
+				},
+				ConsistentRead: aws.Bool(true),
+			},
+			{
+				Statement: aws.String(`UPDATE "applike-test-gosoline-ddb-myModel" SET foo = ? WHERE id = ? AND rev = ?`),
+				Parameters: []types.AttributeValue{
+					&types.AttributeValueMemberS{Value: "baz"},
+					&types.AttributeValueMemberN{Value: "2"},
+					&types.AttributeValueMemberS{Value: "0"},
+				},
+				ConsistentRead: aws.Bool(false),
+			},
+		},
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	}).Return(&dynamodb.BatchExecuteStatementOutput{
+		Responses: []types.BatchStatementResponse{
+			{
+				Item: map[string]types.AttributeValue{
+					"id":  &types.AttributeValueMemberN{Value: "1"},
+					"rev": &types.AttributeValueMemberS{Value: "0"},
+					"foo": &types.AttributeValueMemberS{Value: "bar"},
+				},
+			},
+			{
+				Error: &types.BatchStatementError{
+					Code:    types.BatchStatementErrorCodeEnumConditionalCheckFailed,
+					Message: aws.String("The conditional request failed"),
+				},
+			},
+		},
+	}, nil).Once()
+
+	item := &model{}
+	res, err := s.repo.BatchExecuteStatement(s.ctx, []ddb.Statement{
+		{
+			Statement:      "SELECT * FROM {table} WHERE id = ? AND rev = ?",
+			Parameters:     []any{1, "0"},
+			ConsistentRead: true,
+			Item:           item,
+		},
+		{
+			Statement:  "UPDATE {table} SET foo = ? WHERE id = ? AND rev = ?",
+			Parameters: []any{"baz", 2, "0"},
+		},
+	})
+
+	s.NoError(err)
+	s.True(res.Responses[0].IsFound)
+	s.NoError(res.Responses[0].Error)
+	s.Equal(&model{Id: 1, Rev: "0", Foo: "bar"}, item)
+	s.False(res.Responses[1].IsFound)
+	s.EqualError(res.Responses[1].Error, "statement 1 failed with ConditionalCheckFailed: The conditional request failed")
+}
+
+type ttlModel struct {
+	Id  int    `json:"id" ddb:"key=hash"`
+	Ttl *int64 `json:"ttl" ddb:"ttl=enabled"`
+}
+
+func TestRepository_SetTtl(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock.WithProvider(clock.NewFakeClockAt(now))
+	defer clock.WithProvider(clock.NewRealClock())
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := dynamodbMocks.NewClient(t)
+
+	newRepo := func(model any) ddb.Repository {
+		metadataFactory := ddb.NewMetadataFactoryWithInterfaces(&ddb.Settings{
+			ModelId: mdl.ModelId{Name: "ttlModel"},
+			Main: ddb.MainSettings{
+				Model: model,
+			},
+		}, "ttlModel")
+
+		repo, err := ddb.NewWithInterfaces(logger, tracing.NewLocalTracer(), client, metadataFactory)
+		assert.NoError(t, err)
+
+		return repo
+	}
+
+	item := &ttlModel{Id: 1}
+	err := newRepo(ttlModel{}).SetTtl(item, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, mdl.Box(now.Add(time.Hour).Unix()), item.Ttl)
+
+	err = newRepo(ttlModel{}).SetTtl(ttlModel{}, time.Hour)
+	assert.EqualError(t, err, "item must be a pointer")
+
+	err = newRepo(model{}).SetTtl(&model{}, time.Hour)
+	assert.EqualError(t, err, "the model of table ttlModel has no ttl attribute")
+}
+
+type versionedModel struct {
+	Id      int    `json:"id" ddb:"key=hash"`
+	Foo     string `json:"foo"`
+	Version int    `json:"version" ddb:"version=enabled"`
+}
+
+func TestRepository_Versioning(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := dynamodbMocks.NewClient(t)
+
+	metadataFactory := ddb.NewMetadataFactoryWithInterfaces(&ddb.Settings{
+		ModelId: mdl.ModelId{Name: "versionedModel"},
+		Main: ddb.MainSettings{
+			Model: versionedModel{},
+		},
+	}, "versionedModel")
+
+	repo, err := ddb.NewWithInterfaces(logger, tracing.NewLocalTracer(), client, metadataFactory)
+	assert.NoError(t, err)
+
+	client.EXPECT().PutItem(matcher.Context, mock.AnythingOfType("*dynamodb.PutItemInput")).
+		Run(func(_ context.Context, input *dynamodb.PutItemInput, _ ...func(*dynamodb.Options)) {
+			assert.Equal(t, "attribute_not_exists (#0)", aws.ToString(input.ConditionExpression))
+			assert.Equal(t, map[string]string{"#0": "version"}, input.ExpressionAttributeNames)
+			assert.Equal(t, &types.AttributeValueMemberN{Value: "1"}, input.Item["version"])
+		}).
+		Return(&dynamodb.PutItemOutput{}, nil).Once()
+
+	item := &versionedModel{Id: 1, Foo: "bar"}
+	_, err = repo.PutItem(ctx, nil, item)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, item.Version)
+
+	client.EXPECT().UpdateItem(matcher.Context, mock.AnythingOfType("*dynamodb.UpdateItemInput")).
+		Run(func(_ context.Context, input *dynamodb.UpdateItemInput, _ ...func(*dynamodb.Options)) {
+			assert.Equal(t, "#0 = :0", aws.ToString(input.ConditionExpression))
+			assert.Equal(t, "version", input.ExpressionAttributeNames["#0"])
+			assert.Equal(t, &types.AttributeValueMemberN{Value: "1"}, input.ExpressionAttributeValues[":0"])
+		}).
+		Return(nil, &types.ConditionalCheckFailedException{}).Once()
+
+	ub := repo.UpdateItemBuilder().Set("foo", "baz")
+	res, err := repo.UpdateItem(ctx, ub, item)
+	assert.True(t, ddb.IsVersionConflictError(err))
+	assert.EqualError(t, err, "item in ddb table versionedModel was modified concurrently: expected version 1")
+	assert.True(t, res.ConditionalCheckFailed)
+	assert.Equal(t, 1, item.Version)
+}
+
 func TestRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(RepositoryTestSuite))
 }
@@ -17,6 +17,8 @@ import (
 type TransactionRepository interface {
 	TransactWriteItems(ctx context.Context, items []TransactWriteItemBuilder) (*OperationResult, error)
 	TransactGetItems(ctx context.Context, items []TransactGetItemBuilder) (*OperationResult, error)
+	TransactWrite(ctx context.Context, tb TransactWriteBuilder) (*OperationResult, error)
+	TransactGet(ctx context.Context, tb TransactGetBuilder) (*OperationResult, error)
 }
 
 type transactionRepository struct {
@@ -74,6 +76,30 @@ func (r transactionRepository) TransactGetItems(ctx context.Context, items []Tra
 		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
 	}
 
+	return r.transactGet(ctx, input, items, res)
+}
+
+// TransactGet reads all items collected by the builder in a single consistent snapshot,
+// even if they are spread over multiple tables.
+func (r transactionRepository) TransactGet(ctx context.Context, tb TransactGetBuilder) (*OperationResult, error) {
+	res := newOperationResult(kindRead)
+
+	if len(tb.Items()) == 0 {
+		return res, nil
+	}
+
+	_, span := r.tracer.StartSubSpan(ctx, "ddb.TransactGetItems")
+	defer span.Finish()
+
+	input, err := tb.Build()
+	if err != nil {
+		return nil, fmt.Errorf("can not build transact get input: %w", err)
+	}
+
+	return r.transactGet(ctx, input, tb.Items(), res)
+}
+
+func (r transactionRepository) transactGet(ctx context.Context, input *dynamodb.TransactGetItemsInput, items []TransactGetItemBuilder, res *OperationResult) (*OperationResult, error) {
 	out, err := r.client.TransactGetItems(ctx, input)
 
 	if exec.IsRequestCanceled(err) {
@@ -100,6 +126,27 @@ func (r transactionRepository) TransactWriteItems(ctx context.Context, itemBuild
 	return r.TransactWriteItemsIdempotent(ctx, itemBuilders, nil)
 }
 
+// TransactWrite applies all writes and condition checks collected by the builder atomically:
+// either all of them succeed or none is applied. If the builder carries an idempotency token,
+// it is passed on as ClientRequestToken.
+func (r transactionRepository) TransactWrite(ctx context.Context, tb TransactWriteBuilder) (*OperationResult, error) {
+	res := newOperationResult(kindMixed)
+
+	if len(tb.Items()) == 0 {
+		return res, nil
+	}
+
+	_, span := r.tracer.StartSubSpan(ctx, "ddb.TransactWriteItems")
+	defer span.Finish()
+
+	input, err := tb.Build()
+	if err != nil {
+		return nil, fmt.Errorf("can not build transact write input: %w", err)
+	}
+
+	return r.transactWrite(ctx, input, res)
+}
+
 // TransactWriteItemsIdempotent
 // ClientRequestToken enforces idempotency over a ten minute time frame
 // https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_TransactWriteItems.html#DDB-TransactWriteItems-request-ClientRequestToken
@@ -129,6 +176,10 @@ func (r transactionRepository) TransactWriteItemsIdempotent(ctx context.Context,
 		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
 	}
 
+	return r.transactWrite(ctx, input, res)
+}
+
+func (r transactionRepository) transactWrite(ctx context.Context, input *dynamodb.TransactWriteItemsInput, res *OperationResult) (*OperationResult, error) {
 	out, err := r.client.TransactWriteItems(ctx, input)
 
 	if exec.IsRequestCanceled(err) {
@@ -216,6 +216,79 @@ func (s *RepositoryTransactionTestSuite) TestTransactWriteItems() {
 	s.Equal(1.0, result.ConsumedCapacity.Write())
 }
 
+func (s *RepositoryTransactionTestSuite) TestTransactWrite() {
+	putItem := &model{
+		Id:  42,
+		Rev: "foo",
+		Foo: "bar",
+	}
+
+	putItemBuilder := ddbMocks.NewPutItemBuilder(s.T())
+	putItemBuilder.EXPECT().Build(putItem).Return(&dynamodb.PutItemInput{
+		Item: map[string]types.AttributeValue{
+			"id":  &types.AttributeValueMemberN{Value: "42"},
+			"rev": &types.AttributeValueMemberS{Value: "foo"},
+			"foo": &types.AttributeValueMemberS{Value: "bar"},
+		},
+		TableName: aws.String("model"),
+	}, nil)
+
+	checkItem := &model{
+		Id:  1,
+		Rev: "parent",
+	}
+
+	conditionCheck := &types.ConditionCheck{
+		ConditionExpression: aws.String("attribute_exists(#0)"),
+		ExpressionAttributeNames: map[string]string{
+			"#0": "id",
+		},
+		Key: map[string]types.AttributeValue{
+			"id":  &types.AttributeValueMemberN{Value: "1"},
+			"rev": &types.AttributeValueMemberS{Value: "parent"},
+		},
+		TableName: aws.String("parent"),
+	}
+
+	conditionCheckBuilder := ddbMocks.NewConditionCheckBuilder(s.T())
+	conditionCheckBuilder.EXPECT().Build(checkItem).Return(conditionCheck, nil)
+
+	tb := ddb.NewTransactWriteBuilder().
+		Put(putItemBuilder, putItem).
+		ConditionCheck(conditionCheckBuilder, checkItem).
+		WithIdempotencyToken("token")
+
+	s.tracer.EXPECT().StartSubSpan(s.ctx, "ddb.TransactWriteItems").Return(s.ctx, s.span)
+	s.span.EXPECT().Finish().Return()
+
+	s.client.EXPECT().TransactWriteItems(s.ctx, mock.AnythingOfType("*dynamodb.TransactWriteItemsInput")).
+		Run(func(ctx context.Context, input *dynamodb.TransactWriteItemsInput, optFns ...func(*dynamodb.Options)) {
+			s.Equal(aws.String("token"), input.ClientRequestToken)
+			s.Len(input.TransactItems, 2)
+			s.Equal(aws.String("model"), input.TransactItems[0].Put.TableName)
+			s.Equal(conditionCheck, input.TransactItems[1].ConditionCheck)
+		}).
+		Return(&dynamodb.TransactWriteItemsOutput{}, nil)
+
+	result, err := s.repository.TransactWrite(s.ctx, tb)
+
+	s.NoError(err)
+	s.NotNil(result)
+}
+
+func (s *RepositoryTransactionTestSuite) TestTransactWrite_BuildError() {
+	tb := ddb.NewTransactWriteBuilder().
+		Put(ddbMocks.NewPutItemBuilder(s.T()), model{})
+
+	s.tracer.EXPECT().StartSubSpan(s.ctx, "ddb.TransactWriteItems").Return(s.ctx, s.span)
+	s.span.EXPECT().Finish().Return()
+
+	result, err := s.repository.TransactWrite(s.ctx, tb)
+
+	s.EqualError(err, "can not build transact write input: 1 error occurred:\n\t* can not build transact write item 0: item must be a pointer\n\n")
+	s.Nil(result)
+}
+
 func (s *RepositoryTransactionTestSuite) buildTransactGetItemBuilder(item *model) ddb.TransactGetItemBuilder {
 	builder := ddbMocks.NewGetItemBuilder(s.T())
 
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	gosoAutoscaling "github.com/justtrackio/gosoline/pkg/cloud/aws/applicationautoscaling"
 	gosoDynamodb "github.com/justtrackio/gosoline/pkg/cloud/aws/dynamodb"
 	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/log"
@@ -28,6 +29,7 @@ type TableDescription struct {
 type Service struct {
 	logger          log.Logger
 	client          gosoDynamodb.Client
+	scalingClient   gosoAutoscaling.Client
 	purger          reslife.Purger
 	metadataFactory *MetadataFactory
 }
@@ -38,6 +40,7 @@ func NewService(ctx context.Context, config cfg.Config, logger log.Logger, setti
 	var err error
 	var metadataFactory *MetadataFactory
 	var client gosoDynamodb.Client
+	var scalingClient gosoAutoscaling.Client
 	var purger *LifeCyclePurger
 
 	if metadataFactory, err = NewMetadataFactory(config, settings); err != nil {
@@ -48,17 +51,28 @@ func NewService(ctx context.Context, config cfg.Config, logger log.Logger, setti
 		return nil, fmt.Errorf("can not create dynamodb client: %w", err)
 	}
 
+	if scalingClient, err = gosoAutoscaling.ProvideClient(ctx, config, logger, settings.ClientName); err != nil {
+		return nil, fmt.Errorf("can not create application autoscaling client: %w", err)
+	}
+
 	if purger, err = NewLifeCyclePurger(ctx, config, logger, settings.ClientName, metadataFactory.GetTableName()); err != nil {
 		return nil, fmt.Errorf("can not create dynamodb lifecycle purger: %w", err)
 	}
 
-	return NewServiceWithInterfaces(logger, client, purger, metadataFactory), nil
+	return NewServiceWithInterfaces(logger, client, scalingClient, purger, metadataFactory), nil
 }
 
-func NewServiceWithInterfaces(logger log.Logger, client gosoDynamodb.Client, purger reslife.Purger, metadataFactory *MetadataFactory) *Service {
+func NewServiceWithInterfaces(
+	logger log.Logger,
+	client gosoDynamodb.Client,
+	scalingClient gosoAutoscaling.Client,
+	purger reslife.Purger,
+	metadataFactory *MetadataFactory,
+) *Service {
 	return &Service{
 		logger:          logger,
 		client:          client,
+		scalingClient:   scalingClient,
 		purger:          purger,
 		metadataFactory: metadataFactory,
 	}
@@ -134,10 +148,8 @@ func (s *Service) CreateTable(ctx context.Context) (*Metadata, error) {
 		LocalSecondaryIndexes:  localIndices,
 		GlobalSecondaryIndexes: globalIndices,
 		StreamSpecification:    streamSpecification,
-		ProvisionedThroughput: &types.ProvisionedThroughput{
-			ReadCapacityUnits:  aws.Int64(metadata.Main.ReadCapacityUnits),
-			WriteCapacityUnits: aws.Int64(metadata.Main.WriteCapacityUnits),
-		},
+		BillingMode:            billingMode(metadata),
+		ProvisionedThroughput:  provisionedThroughput(metadata, metadata.Main.metadataCapacity),
 	}
 
 	_, err = s.client.CreateTable(ctx, input)
@@ -157,7 +169,11 @@ func (s *Service) CreateTable(ctx context.Context) (*Metadata, error) {
 
 	s.logger.Info(ctx, "created ddb table %s", s.metadataFactory.GetTableName())
 
-	err = s.updateTtlSpecification(ctx, metadata)
+	if err = s.updateTtlSpecification(ctx, metadata); err != nil {
+		return metadata, err
+	}
+
+	err = s.registerAutoScaling(ctx, metadata)
 
 	return metadata, err
 }
@@ -391,13 +407,10 @@ func (s *Service) getGlobalSecondaryIndices(meta *Metadata) ([]types.GlobalSecon
 		}
 
 		indices = append(indices, types.GlobalSecondaryIndex{
-			IndexName:  aws.String(name),
-			KeySchema:  keySchema,
-			Projection: projection,
-			ProvisionedThroughput: &types.ProvisionedThroughput{
-				ReadCapacityUnits:  aws.Int64(data.ReadCapacityUnits),
-				WriteCapacityUnits: aws.Int64(data.WriteCapacityUnits),
-			},
+			IndexName:             aws.String(name),
+			KeySchema:             keySchema,
+			Projection:            projection,
+			ProvisionedThroughput: provisionedThroughput(meta, data.metadataCapacity),
 		})
 	}
 
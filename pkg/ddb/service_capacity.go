@@ -0,0 +1,147 @@
+package ddb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	scalingTypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+const defaultTargetUtilization = 70
+
+type scalableDimensions struct {
+	read  scalingTypes.ScalableDimension
+	write scalingTypes.ScalableDimension
+}
+
+var (
+	tableScalableDimensions = scalableDimensions{
+		read:  scalingTypes.ScalableDimensionDynamoDBTableReadCapacityUnits,
+		write: scalingTypes.ScalableDimensionDynamoDBTableWriteCapacityUnits,
+	}
+	indexScalableDimensions = scalableDimensions{
+		read:  scalingTypes.ScalableDimensionDynamoDBIndexReadCapacityUnits,
+		write: scalingTypes.ScalableDimensionDynamoDBIndexWriteCapacityUnits,
+	}
+)
+
+func billingMode(metadata *Metadata) types.BillingMode {
+	if metadata.BillingMode == "" {
+		return types.BillingModeProvisioned
+	}
+
+	return metadata.BillingMode
+}
+
+func isOnDemand(metadata *Metadata) bool {
+	return billingMode(metadata) == types.BillingModePayPerRequest
+}
+
+// provisionedThroughput returns nil for on-demand tables as DynamoDB rejects a provisioned throughput for them.
+func provisionedThroughput(metadata *Metadata, capacity metadataCapacity) *types.ProvisionedThroughput {
+	if isOnDemand(metadata) {
+		return nil
+	}
+
+	return &types.ProvisionedThroughput{
+		ReadCapacityUnits:  aws.Int64(capacity.ReadCapacityUnits),
+		WriteCapacityUnits: aws.Int64(capacity.WriteCapacityUnits),
+	}
+}
+
+func (s *Service) registerAutoScaling(ctx context.Context, metadata *Metadata) error {
+	if isOnDemand(metadata) {
+		return nil
+	}
+
+	resourceId := fmt.Sprintf("table/%s", metadata.TableName)
+	if err := s.registerScalableCapacity(ctx, resourceId, tableScalableDimensions, metadata.Main.metadataCapacity); err != nil {
+		return fmt.Errorf("can not register auto scaling for ddb table %s: %w", metadata.TableName, err)
+	}
+
+	for name, index := range metadata.Global {
+		resourceId = fmt.Sprintf("table/%s/index/%s", metadata.TableName, name)
+		if err := s.registerScalableCapacity(ctx, resourceId, indexScalableDimensions, index.metadataCapacity); err != nil {
+			return fmt.Errorf("can not register auto scaling for index %s of ddb table %s: %w", name, metadata.TableName, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) registerScalableCapacity(ctx context.Context, resourceId string, dimensions scalableDimensions, capacity metadataCapacity) error {
+	if !capacity.AutoScaling.Enabled {
+		return nil
+	}
+
+	read := scalingSettingsWithDefaults(capacity.AutoScaling.Read, capacity.ReadCapacityUnits)
+	if err := s.registerScalableDimension(ctx, resourceId, dimensions.read, scalingTypes.MetricTypeDynamoDBReadCapacityUtilization, read); err != nil {
+		return fmt.Errorf("can not register read capacity: %w", err)
+	}
+
+	write := scalingSettingsWithDefaults(capacity.AutoScaling.Write, capacity.WriteCapacityUnits)
+	if err := s.registerScalableDimension(ctx, resourceId, dimensions.write, scalingTypes.MetricTypeDynamoDBWriteCapacityUtilization, write); err != nil {
+		return fmt.Errorf("can not register write capacity: %w", err)
+	}
+
+	s.logger.Info(ctx, "registered auto scaling for ddb resource %s", resourceId)
+
+	return nil
+}
+
+func (s *Service) registerScalableDimension(
+	ctx context.Context,
+	resourceId string,
+	dimension scalingTypes.ScalableDimension,
+	metricType scalingTypes.MetricType,
+	settings ScalingSettings,
+) error {
+	_, err := s.scalingClient.RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  scalingTypes.ServiceNamespaceDynamodb,
+		ResourceId:        aws.String(resourceId),
+		ScalableDimension: dimension,
+		MinCapacity:       aws.Int32(int32(settings.MinCapacityUnits)),
+		MaxCapacity:       aws.Int32(int32(settings.MaxCapacityUnits)),
+	})
+	if err != nil {
+		return fmt.Errorf("can not register scalable target: %w", err)
+	}
+
+	_, err = s.scalingClient.PutScalingPolicy(ctx, &applicationautoscaling.PutScalingPolicyInput{
+		PolicyName:        aws.String(fmt.Sprintf("%s:%s", metricType, resourceId)),
+		PolicyType:        scalingTypes.PolicyTypeTargetTrackingScaling,
+		ServiceNamespace:  scalingTypes.ServiceNamespaceDynamodb,
+		ResourceId:        aws.String(resourceId),
+		ScalableDimension: dimension,
+		TargetTrackingScalingPolicyConfiguration: &scalingTypes.TargetTrackingScalingPolicyConfiguration{
+			TargetValue: aws.Float64(settings.TargetUtilization),
+			PredefinedMetricSpecification: &scalingTypes.PredefinedMetricSpecification{
+				PredefinedMetricType: metricType,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("can not put scaling policy: %w", err)
+	}
+
+	return nil
+}
+
+func scalingSettingsWithDefaults(settings ScalingSettings, capacityUnits int64) ScalingSettings {
+	if settings.MinCapacityUnits == 0 {
+		settings.MinCapacityUnits = max(1, capacityUnits)
+	}
+
+	if settings.MaxCapacityUnits < settings.MinCapacityUnits {
+		settings.MaxCapacityUnits = settings.MinCapacityUnits
+	}
+
+	if settings.TargetUtilization == 0 {
+		settings.TargetUtilization = defaultTargetUtilization
+	}
+
+	return settings
+}
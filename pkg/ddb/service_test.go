@@ -5,10 +5,13 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	scalingTypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	autoscalingMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/applicationautoscaling/mocks"
 	dynamodbMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/dynamodb/mocks"
 	"github.com/justtrackio/gosoline/pkg/ddb"
 	"github.com/justtrackio/gosoline/pkg/log"
@@ -16,6 +19,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/reslife/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type createModel struct {
@@ -215,6 +219,7 @@ func TestService_CreateTable(t *testing.T) {
 				},
 			},
 		},
+		BillingMode: types.BillingModeProvisioned,
 		ProvisionedThroughput: &types.ProvisionedThroughput{
 			ReadCapacityUnits:  aws.Int64(1),
 			WriteCapacityUnits: aws.Int64(2),
@@ -280,9 +285,168 @@ func TestService_CreateTable(t *testing.T) {
 
 	purger := mocks.NewPurger(t)
 	metadataFactory := ddb.NewMetadataFactoryWithInterfaces(settings, "applike-test-gosoline-ddb-myModel")
-	svc := ddb.NewServiceWithInterfaces(logger, client, purger, metadataFactory)
+	svc := ddb.NewServiceWithInterfaces(logger, client, autoscalingMocks.NewClient(t), purger, metadataFactory)
 
 	_, err := svc.CreateTable(ctx)
 
 	assert.NoError(t, err)
 }
+
+type capacityModel struct {
+	Id   int    `json:"id" ddb:"key=hash"`
+	Name string `json:"name" ddb:"global=hash"`
+}
+
+func TestService_CreateTable_OnDemand(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := dynamodbMocks.NewClient(t)
+
+	describeInput := &dynamodb.DescribeTableInput{
+		TableName: aws.String("capacityModel"),
+	}
+	client.EXPECT().DescribeTable(ctx, describeInput).Return(nil, &types.ResourceNotFoundException{}).Once()
+	client.EXPECT().DescribeTable(ctx, describeInput).Return(&dynamodb.DescribeTableOutput{
+		Table: &types.TableDescription{
+			TableStatus: types.TableStatusActive,
+		},
+	}, nil).Once()
+
+	client.EXPECT().CreateTable(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []types.AttributeDefinition{
+			{
+				AttributeName: aws.String("id"),
+				AttributeType: types.ScalarAttributeTypeN,
+			},
+			{
+				AttributeName: aws.String("name"),
+				AttributeType: types.ScalarAttributeTypeS,
+			},
+		},
+		GlobalSecondaryIndexes: []types.GlobalSecondaryIndex{
+			{
+				IndexName: aws.String("global-name"),
+				KeySchema: []types.KeySchemaElement{
+					{
+						AttributeName: aws.String("name"),
+						KeyType:       types.KeyTypeHash,
+					},
+				},
+				Projection: &types.Projection{
+					ProjectionType: types.ProjectionTypeAll,
+				},
+			},
+		},
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: aws.String("id"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		BillingMode: types.BillingModePayPerRequest,
+		StreamSpecification: &types.StreamSpecification{
+			StreamEnabled: aws.Bool(false),
+		},
+		TableName: aws.String("capacityModel"),
+	}).Return(nil, nil)
+
+	settings := &ddb.Settings{
+		ModelId: mdl.ModelId{
+			Name: "capacityModel",
+		},
+		Main: ddb.MainSettings{
+			Model:       capacityModel{},
+			BillingMode: types.BillingModePayPerRequest,
+			AutoScaling: ddb.AutoScalingSettings{
+				Enabled: true,
+			},
+		},
+		Global: []ddb.GlobalSettings{
+			{
+				Model: capacityModel{},
+			},
+		},
+	}
+
+	metadataFactory := ddb.NewMetadataFactoryWithInterfaces(settings, "capacityModel")
+	svc := ddb.NewServiceWithInterfaces(logger, client, autoscalingMocks.NewClient(t), mocks.NewPurger(t), metadataFactory)
+
+	_, err := svc.CreateTable(ctx)
+	assert.NoError(t, err)
+}
+
+func TestService_CreateTable_AutoScaling(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := dynamodbMocks.NewClient(t)
+	scalingClient := autoscalingMocks.NewClient(t)
+
+	describeInput := &dynamodb.DescribeTableInput{
+		TableName: aws.String("capacityModel"),
+	}
+	client.EXPECT().DescribeTable(ctx, describeInput).Return(nil, &types.ResourceNotFoundException{}).Once()
+	client.EXPECT().DescribeTable(ctx, describeInput).Return(&dynamodb.DescribeTableOutput{
+		Table: &types.TableDescription{
+			TableStatus: types.TableStatusActive,
+		},
+	}, nil).Once()
+	client.EXPECT().CreateTable(ctx, mock.AnythingOfType("*dynamodb.CreateTableInput")).Return(nil, nil)
+
+	expectScaling := func(dimension scalingTypes.ScalableDimension, metricType scalingTypes.MetricType, minCapacity int32, maxCapacity int32, target float64) {
+		scalingClient.EXPECT().RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+			ServiceNamespace:  scalingTypes.ServiceNamespaceDynamodb,
+			ResourceId:        aws.String("table/capacityModel"),
+			ScalableDimension: dimension,
+			MinCapacity:       aws.Int32(minCapacity),
+			MaxCapacity:       aws.Int32(maxCapacity),
+		}).Return(&applicationautoscaling.RegisterScalableTargetOutput{}, nil).Once()
+
+		scalingClient.EXPECT().PutScalingPolicy(ctx, &applicationautoscaling.PutScalingPolicyInput{
+			PolicyName:        aws.String(string(metricType) + ":table/capacityModel"),
+			PolicyType:        scalingTypes.PolicyTypeTargetTrackingScaling,
+			ServiceNamespace:  scalingTypes.ServiceNamespaceDynamodb,
+			ResourceId:        aws.String("table/capacityModel"),
+			ScalableDimension: dimension,
+			TargetTrackingScalingPolicyConfiguration: &scalingTypes.TargetTrackingScalingPolicyConfiguration{
+				TargetValue: aws.Float64(target),
+				PredefinedMetricSpecification: &scalingTypes.PredefinedMetricSpecification{
+					PredefinedMetricType: metricType,
+				},
+			},
+		}).Return(&applicationautoscaling.PutScalingPolicyOutput{}, nil).Once()
+	}
+
+	expectScaling(scalingTypes.ScalableDimensionDynamoDBTableReadCapacityUnits, scalingTypes.MetricTypeDynamoDBReadCapacityUtilization, 5, 50, 70)
+	expectScaling(scalingTypes.ScalableDimensionDynamoDBTableWriteCapacityUnits, scalingTypes.MetricTypeDynamoDBWriteCapacityUtilization, 2, 2, 50)
+
+	settings := &ddb.Settings{
+		ModelId: mdl.ModelId{
+			Name: "capacityModel",
+		},
+		Main: ddb.MainSettings{
+			Model:              capacityModel{},
+			ReadCapacityUnits:  5,
+			WriteCapacityUnits: 2,
+			AutoScaling: ddb.AutoScalingSettings{
+				Enabled: true,
+				Read: ddb.ScalingSettings{
+					MaxCapacityUnits: 50,
+				},
+				Write: ddb.ScalingSettings{
+					TargetUtilization: 50,
+				},
+			},
+		},
+		Global: []ddb.GlobalSettings{
+			{
+				Model: capacityModel{},
+			},
+		},
+	}
+
+	metadataFactory := ddb.NewMetadataFactoryWithInterfaces(settings, "capacityModel")
+	svc := ddb.NewServiceWithInterfaces(logger, client, scalingClient, mocks.NewPurger(t), metadataFactory)
+
+	_, err := svc.CreateTable(ctx)
+	assert.NoError(t, err)
+}
@@ -20,10 +20,14 @@ type Settings struct {
 }
 
 type MainSettings struct {
-	Model              any
-	StreamView         types.StreamViewType
+	Model      any
+	StreamView types.StreamViewType
+	// BillingMode defaults to provisioned throughput, use types.BillingModePayPerRequest for an on-demand table.
+	// The billing mode applies to the global secondary indices as well.
+	BillingMode        types.BillingMode
 	ReadCapacityUnits  int64
 	WriteCapacityUnits int64
+	AutoScaling        AutoScalingSettings
 }
 
 type LocalSettings struct {
@@ -36,6 +40,24 @@ type GlobalSettings struct {
 	Model              any
 	ReadCapacityUnits  int64
 	WriteCapacityUnits int64
+	AutoScaling        AutoScalingSettings
+}
+
+// AutoScalingSettings configure target tracking auto-scaling of the provisioned capacity of a table or a global
+// secondary index. They are ignored for on-demand tables.
+type AutoScalingSettings struct {
+	Enabled bool
+	Read    ScalingSettings
+	Write   ScalingSettings
+}
+
+type ScalingSettings struct {
+	// MinCapacityUnits defaults to the configured capacity units.
+	MinCapacityUnits int64
+	// MaxCapacityUnits defaults to MinCapacityUnits.
+	MaxCapacityUnits int64
+	// TargetUtilization is the percentage of consumed capacity the scaling aims for, defaults to 70.
+	TargetUtilization float64
 }
 
 type SimpleSettings struct {
@@ -52,6 +74,10 @@ func sanitizeSettings(settings *Settings) {
 		settings.ClientName = "default"
 	}
 
+	if settings.Main.BillingMode == "" {
+		settings.Main.BillingMode = types.BillingModeProvisioned
+	}
+
 	settings.Main.ReadCapacityUnits = int64(math.Max(1, float64(settings.Main.ReadCapacityUnits)))
 	settings.Main.WriteCapacityUnits = int64(math.Max(1, float64(settings.Main.WriteCapacityUnits)))
 
@@ -0,0 +1,57 @@
+package ddb
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+// ExpiresIn returns the value for an attribute tagged with ddb:"ttl=enabled" to let an item expire after the given duration.
+func ExpiresIn(d time.Duration) int64 {
+	return ExpiresAt(clock.Provider.Now().Add(d))
+}
+
+// ExpiresAt returns the value for an attribute tagged with ddb:"ttl=enabled" to let an item expire at the given time.
+func ExpiresAt(t time.Time) int64 {
+	return t.Unix()
+}
+
+func setTtl(metadata *Metadata, item any, expiresAt time.Time) error {
+	if !metadata.TimeToLive.Enabled {
+		return fmt.Errorf("the model of table %s has no ttl attribute", metadata.TableName)
+	}
+
+	if !isPointer(item) {
+		return fmt.Errorf("item must be a pointer")
+	}
+
+	attr := metadata.Attributes[metadata.TimeToLive.Field]
+	value := reflect.Indirect(reflect.ValueOf(item))
+
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("item has to be a pointer to a struct but is %T", item)
+	}
+
+	field := value.FieldByName(attr.FieldName)
+	if !field.IsValid() {
+		return fmt.Errorf("item of type %T has no ttl field %s", item, attr.FieldName)
+	}
+
+	if field.Kind() == reflect.Pointer {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		field.SetInt(ExpiresAt(expiresAt))
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		field.SetUint(uint64(ExpiresAt(expiresAt)))
+	default:
+		return fmt.Errorf("the ttl field %s has to be an integer but is of type %s", attr.FieldName, field.Type())
+	}
+
+	return nil
+}
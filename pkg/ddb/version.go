@@ -0,0 +1,85 @@
+package ddb
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+)
+
+// metadataVersion describes the attribute tagged with ddb:"version=enabled". If present, puts and updates are only
+// applied if the version stored in the table still matches the version of the item (optimistic locking).
+type metadataVersion struct {
+	Enabled   bool
+	Field     string
+	FieldName string
+}
+
+// readVersion returns the current version of the item. ok is false if the model has no version attribute or the
+// item doesn't contain the version field (e.g., if an update is built from a key-only struct).
+func readVersion(metadata *Metadata, item any) (version int64, ok bool, err error) {
+	if !metadata.Version.Enabled || item == nil {
+		return 0, false, nil
+	}
+
+	field, ok := versionField(metadata, item)
+	if !ok {
+		return 0, false, nil
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return field.Int(), true, nil
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return int64(field.Uint()), true, nil
+	default:
+		return 0, false, fmt.Errorf("the version field %s has to be an integer but is of type %s", metadata.Version.FieldName, field.Type())
+	}
+}
+
+// writeVersion updates the version field of the item if the item is a pointer containing it.
+func writeVersion(metadata *Metadata, item any, version int64) {
+	if !isPointer(item) {
+		return
+	}
+
+	field, ok := versionField(metadata, item)
+	if !ok || !field.CanSet() {
+		return
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		field.SetInt(version)
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		field.SetUint(uint64(version))
+	}
+}
+
+func versionField(metadata *Metadata, item any) (reflect.Value, bool) {
+	value := reflect.Indirect(reflect.ValueOf(item))
+
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	field := value.FieldByName(metadata.Version.FieldName)
+
+	return field, field.IsValid()
+}
+
+// versionCondition requires the stored item to still have the given version. Version 0 denotes an item which was
+// never written before.
+func versionCondition(metadata *Metadata, version int64, cond *expression.ConditionBuilder) expression.ConditionBuilder {
+	versionCond := expression.Name(metadata.Version.Field).Equal(expression.Value(version))
+
+	if version == 0 {
+		versionCond = expression.AttributeNotExists(expression.Name(metadata.Version.Field))
+	}
+
+	if cond == nil {
+		return versionCond
+	}
+
+	return cond.And(versionCond)
+}
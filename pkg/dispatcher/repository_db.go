@@ -75,3 +75,13 @@ func (r Repository) Delete(ctx context.Context, value db_repo.ModelBased) error
 
 	return err
 }
+
+func (r Repository) WithTx(ctx context.Context, do func(txRepo db_repo.Repository) error) error {
+	return r.Repository.WithTx(ctx, func(txRepo db_repo.Repository) error {
+		return do(Repository{
+			Repository: txRepo,
+			dispatcher: r.dispatcher,
+			logger:     r.logger,
+		})
+	})
+}
@@ -5,4 +5,20 @@ type Email struct {
 	Subject    string
 	TextBody   *string
 	HtmlBody   *string
+	// Template sends a template stored in ses instead of the subject and bodies. Only supported by the ses sender.
+	Template *Template
+	// Attachments are only supported by the ses sender and can't be combined with a template.
+	Attachments []Attachment
+}
+
+type Template struct {
+	Name string
+	// Data replaces the placeholders of the template, it is json encoded.
+	Data any
+}
+
+type Attachment struct {
+	FileName    string
+	ContentType string
+	Data        []byte
 }
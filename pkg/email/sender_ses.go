@@ -1,8 +1,16 @@
 package email
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
@@ -10,19 +18,30 @@ import (
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	gosoSES "github.com/justtrackio/gosoline/pkg/cloud/aws/ses"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/metric"
+)
+
+const (
+	MetricNameEmailSent   = "EmailSent"
+	MetricNameEmailFailed = "EmailFailed"
 )
 
 var _ Sender = &sesSender{}
 
 type SenderSesSettings struct {
 	ClientName string `cfg:"client_name" default:"default"`
+	// ConfigurationSet is the name of the ses configuration set used for all emails, e.g. to track deliveries.
+	ConfigurationSet string `cfg:"configuration_set"`
 }
 
 type sesSender struct {
-	logger log.Logger
-	client gosoSES.Client
-
-	fromAddress string
+	logger       log.Logger
+	client       gosoSES.Client
+	metricWriter metric.Writer
+	name         string
+	fromAddress  string
+	settings     *SenderSesSettings
 }
 
 func NewSesSender(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Sender, error) {
@@ -43,18 +62,91 @@ func NewSesSender(ctx context.Context, config cfg.Config, logger log.Logger, nam
 		return nil, fmt.Errorf("failed to unmarshal email settings for key %q in NewSesSender: %w", key, err)
 	}
 
-	return NewSesSenderWithInterfaces(logger, sesClient, emailSettings.FromAddress), nil
+	metricWriter := metric.NewWriter(getSesSenderDefaultMetrics(name)...)
+
+	return NewSesSenderWithInterfaces(logger, sesClient, metricWriter, name, emailSettings.FromAddress, sesSettings), nil
 }
 
-func NewSesSenderWithInterfaces(logger log.Logger, client gosoSES.Client, fromAddress string) Sender {
+func NewSesSenderWithInterfaces(
+	logger log.Logger,
+	client gosoSES.Client,
+	metricWriter metric.Writer,
+	name string,
+	fromAddress string,
+	settings *SenderSesSettings,
+) Sender {
 	return &sesSender{
-		logger:      logger,
-		client:      client,
-		fromAddress: fromAddress,
+		logger:       logger,
+		client:       client,
+		metricWriter: metricWriter,
+		name:         name,
+		fromAddress:  fromAddress,
+		settings:     settings,
 	}
 }
 
 func (s *sesSender) SendEmail(ctx context.Context, email Email) error {
+	content, err := s.buildContent(email)
+	if err != nil {
+		return err
+	}
+
+	input := &sesv2.SendEmailInput{
+		FromEmailAddress: aws.String(s.fromAddress),
+		Destination: &types.Destination{
+			ToAddresses: email.Recipients,
+		},
+		Content:              content,
+		ConfigurationSetName: mdl.NilIfEmpty(s.settings.ConfigurationSet),
+	}
+
+	if _, err = s.client.SendEmail(ctx, input); err != nil {
+		s.writeMetric(ctx, MetricNameEmailFailed)
+
+		return fmt.Errorf("can not send email: %w", err)
+	}
+
+	s.writeMetric(ctx, MetricNameEmailSent)
+
+	return nil
+}
+
+func (s *sesSender) buildContent(email Email) (*types.EmailContent, error) {
+	if email.Template != nil {
+		if len(email.Attachments) > 0 {
+			return nil, fmt.Errorf("attachments can not be sent with a template")
+		}
+
+		data, err := json.Marshal(email.Template.Data)
+		if err != nil {
+			return nil, fmt.Errorf("can not marshal data of template %s: %w", email.Template.Name, err)
+		}
+
+		return &types.EmailContent{
+			Template: &types.Template{
+				TemplateName: aws.String(email.Template.Name),
+				TemplateData: aws.String(string(data)),
+			},
+		}, nil
+	}
+
+	if email.HtmlBody == nil && email.TextBody == nil {
+		return nil, fmt.Errorf("email body cannot be empty")
+	}
+
+	if len(email.Attachments) > 0 {
+		raw, err := s.buildRawMessage(email)
+		if err != nil {
+			return nil, fmt.Errorf("can not build raw email: %w", err)
+		}
+
+		return &types.EmailContent{
+			Raw: &types.RawMessage{
+				Data: raw,
+			},
+		}, nil
+	}
+
 	body := &types.Body{}
 
 	if email.HtmlBody != nil {
@@ -65,24 +157,143 @@ func (s *sesSender) SendEmail(ctx context.Context, email Email) error {
 		body.Text = &types.Content{Data: email.TextBody, Charset: aws.String("UTF-8")}
 	}
 
-	if body.Html == nil && body.Text == nil {
-		return fmt.Errorf("email body cannot be empty")
+	return &types.EmailContent{
+		Simple: &types.Message{
+			Subject: &types.Content{Data: aws.String(email.Subject), Charset: aws.String("UTF-8")},
+			Body:    body,
+		},
+	}, nil
+}
+
+// buildRawMessage builds a multipart/mixed mime message with the bodies as multipart/alternative part followed by the
+// base64 encoded attachments.
+func (s *sesSender) buildRawMessage(email Email) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := multipart.NewWriter(buf)
+
+	headers := []string{
+		fmt.Sprintf("From: %s", s.fromAddress),
+		fmt.Sprintf("To: %s", strings.Join(email.Recipients, ", ")),
+		fmt.Sprintf("Subject: %s", mime.QEncoding.Encode("utf-8", email.Subject)),
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/mixed; boundary=%q", writer.Boundary()),
 	}
 
-	input := &sesv2.SendEmailInput{
-		FromEmailAddress: aws.String(s.fromAddress),
-		Destination: &types.Destination{
-			ToAddresses: email.Recipients,
-		},
-		Content: &types.EmailContent{
-			Simple: &types.Message{
-				Subject: &types.Content{Data: aws.String(email.Subject), Charset: aws.String("UTF-8")},
-				Body:    body,
-			},
+	buf.WriteString(strings.Join(headers, smtpLineBreak) + smtpLineBreak + smtpLineBreak)
+
+	alternative := &bytes.Buffer{}
+	alternativeWriter := multipart.NewWriter(alternative)
+
+	for _, body := range []struct {
+		contentType string
+		content     *string
+	}{
+		{contentType: "text/plain", content: email.TextBody},
+		{contentType: "text/html", content: email.HtmlBody},
+	} {
+		if body.content == nil {
+			continue
+		}
+
+		encoded, err := encodeQuotedPrintable(*body.content + smtpLineBreak)
+		if err != nil {
+			return nil, fmt.Errorf("can not encode %s body: %w", body.contentType, err)
+		}
+
+		part, err := alternativeWriter.CreatePart(mimeHeader(body.contentType))
+		if err != nil {
+			return nil, fmt.Errorf("can not create %s part: %w", body.contentType, err)
+		}
+
+		if _, err = part.Write(encoded); err != nil {
+			return nil, fmt.Errorf("can not write %s part: %w", body.contentType, err)
+		}
+	}
+
+	if err := alternativeWriter.Close(); err != nil {
+		return nil, fmt.Errorf("can not close body parts: %w", err)
+	}
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type": []string{fmt.Sprintf("multipart/alternative; boundary=%q", alternativeWriter.Boundary())},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not create body part: %w", err)
+	}
+
+	if _, err = part.Write(alternative.Bytes()); err != nil {
+		return nil, fmt.Errorf("can not write body part: %w", err)
+	}
+
+	for _, attachment := range email.Attachments {
+		if err = writeAttachment(writer, attachment); err != nil {
+			return nil, fmt.Errorf("can not write attachment %s: %w", attachment.FileName, err)
+		}
+	}
+
+	if err = writer.Close(); err != nil {
+		return nil, fmt.Errorf("can not close multipart writer: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *sesSender) writeMetric(ctx context.Context, metricName string) {
+	s.metricWriter.WriteOne(ctx, &metric.Datum{
+		Timestamp:  time.Now(),
+		MetricName: metricName,
+		Dimensions: metric.Dimensions{
+			"Sender": s.name,
 		},
+		Unit:  metric.UnitCount,
+		Value: 1.0,
+	})
+}
+
+func writeAttachment(writer *multipart.Writer, attachment Attachment) error {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              []string{mime.FormatMediaType(contentType, map[string]string{"name": attachment.FileName})},
+		"Content-Transfer-Encoding": []string{"base64"},
+		"Content-Disposition":       []string{mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName})},
+	})
+	if err != nil {
+		return err
 	}
 
-	_, err := s.client.SendEmail(ctx, input)
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+
+	// base64 encoded lines of mime messages must not exceed 76 characters
+	for len(encoded) > 76 {
+		if _, err = part.Write([]byte(encoded[:76] + smtpLineBreak)); err != nil {
+			return err
+		}
+
+		encoded = encoded[76:]
+	}
+
+	_, err = part.Write([]byte(encoded + smtpLineBreak))
 
 	return err
 }
+
+func getSesSenderDefaultMetrics(name string) []*metric.Datum {
+	return []*metric.Datum{
+		{
+			MetricName: MetricNameEmailSent,
+			Dimensions: metric.Dimensions{"Sender": name},
+			Unit:       metric.UnitCount,
+			Value:      0.0,
+		},
+		{
+			MetricName: MetricNameEmailFailed,
+			Dimensions: metric.Dimensions{"Sender": name},
+			Unit:       metric.UnitCount,
+			Value:      0.0,
+		},
+	}
+}
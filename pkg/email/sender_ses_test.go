@@ -1,8 +1,15 @@
 package email_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	netMail "net/mail"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -11,7 +18,10 @@ import (
 	"github.com/justtrackio/gosoline/pkg/cloud/aws/ses/mocks"
 	"github.com/justtrackio/gosoline/pkg/email"
 	loggerMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
 	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -20,9 +30,10 @@ type sesSenderTestSuite struct {
 
 	sender email.Sender
 
-	logger *loggerMocks.Logger
-	client *mocks.Client
-	ctx    context.Context
+	logger       *loggerMocks.Logger
+	client       *mocks.Client
+	metricWriter *metricMocks.Writer
+	ctx          context.Context
 }
 
 func TestRunSesSenderTestSuite(t *testing.T) {
@@ -32,11 +43,15 @@ func TestRunSesSenderTestSuite(t *testing.T) {
 func (s *sesSenderTestSuite) SetupTest() {
 	s.logger = new(loggerMocks.Logger)
 	s.client = mocks.NewClient(s.T())
+	s.metricWriter = metricMocks.NewWriterMockedAll()
 
 	s.sender = email.NewSesSenderWithInterfaces(
 		s.logger,
 		s.client,
+		s.metricWriter,
+		"default",
 		"sender@example.com",
+		&email.SenderSesSettings{},
 	)
 
 	s.ctx = s.T().Context()
@@ -175,6 +190,104 @@ func (s *sesSenderTestSuite) TestSendEmail_ErrorFromSES() {
 		TextBody:   &body,
 	}
 
+	s.metricWriter.EXPECT().WriteOne(matcher.Context, mock.MatchedBy(func(datum *metric.Datum) bool {
+		return datum.MetricName == "EmailFailed" && datum.Dimensions["Sender"] == "default"
+	})).Once()
+
 	err := s.sender.SendEmail(s.ctx, email)
 	s.Error(err)
 }
+
+func (s *sesSenderTestSuite) TestSendEmail_Template() {
+	s.sender = email.NewSesSenderWithInterfaces(s.logger, s.client, s.metricWriter, "default", "sender@example.com", &email.SenderSesSettings{
+		ConfigurationSet: "tracking",
+	})
+
+	expectedEmailInput := &sesv2.SendEmailInput{
+		FromEmailAddress: aws.String("sender@example.com"),
+		Destination: &types.Destination{
+			ToAddresses: []string{"recipient@example.com"},
+		},
+		Content: &types.EmailContent{
+			Template: &types.Template{
+				TemplateName: aws.String("welcome"),
+				TemplateData: aws.String(`{"name":"Jane"}`),
+			},
+		},
+		ConfigurationSetName: aws.String("tracking"),
+	}
+
+	s.client.EXPECT().SendEmail(matcher.Context, expectedEmailInput).Return(&sesv2.SendEmailOutput{}, nil)
+
+	err := s.sender.SendEmail(s.ctx, email.Email{
+		Recipients: []string{"recipient@example.com"},
+		Template: &email.Template{
+			Name: "welcome",
+			Data: map[string]string{"name": "Jane"},
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *sesSenderTestSuite) TestSendEmail_TemplateWithAttachments() {
+	err := s.sender.SendEmail(s.ctx, email.Email{
+		Recipients: []string{"recipient@example.com"},
+		Template: &email.Template{
+			Name: "welcome",
+		},
+		Attachments: []email.Attachment{{FileName: "report.csv"}},
+	})
+	s.EqualError(err, "attachments can not be sent with a template")
+}
+
+func (s *sesSenderTestSuite) TestSendEmail_Attachments() {
+	body := "See the attached report."
+	var raw []byte
+
+	s.client.EXPECT().SendEmail(matcher.Context, mock.AnythingOfType("*sesv2.SendEmailInput")).Run(func(_ context.Context, input *sesv2.SendEmailInput, _ ...func(*sesv2.Options)) {
+		s.Nil(input.Content.Simple)
+		raw = input.Content.Raw.Data
+	}).Return(&sesv2.SendEmailOutput{}, nil)
+
+	err := s.sender.SendEmail(s.ctx, email.Email{
+		Recipients: []string{"recipient@example.com"},
+		Subject:    "Report",
+		TextBody:   &body,
+		Attachments: []email.Attachment{{
+			FileName:    "report.csv",
+			ContentType: "text/csv",
+			Data:        []byte("a,b\n1,2\n"),
+		}},
+	})
+	s.NoError(err)
+
+	msg, err := netMail.ReadMessage(bytes.NewReader(raw))
+	s.NoError(err)
+	s.Equal("sender@example.com", msg.Header.Get("From"))
+	s.Equal("recipient@example.com", msg.Header.Get("To"))
+	s.Equal("Report", msg.Header.Get("Subject"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	s.NoError(err)
+	s.Equal("multipart/mixed", mediaType)
+
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+
+	bodyPart, err := reader.NextPart()
+	s.NoError(err)
+	s.Contains(bodyPart.Header.Get("Content-Type"), "multipart/alternative")
+
+	attachmentPart, err := reader.NextPart()
+	s.NoError(err)
+	s.Equal("report.csv", attachmentPart.FileName())
+
+	encoded, err := io.ReadAll(attachmentPart)
+	s.NoError(err)
+
+	data, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(encoded), "\r\n", ""))
+	s.NoError(err)
+	s.Equal([]byte("a,b\n1,2\n"), data)
+
+	_, err = reader.NextPart()
+	s.ErrorIs(err, io.EOF)
+}
@@ -81,6 +81,10 @@ func (s *smtpSender) SendEmail(_ context.Context, email Email) error {
 		return fmt.Errorf("cannot dial smtp server: %w", err)
 	}
 
+	if email.Template != nil || len(email.Attachments) > 0 {
+		return fmt.Errorf("templates and attachments are not supported by the smtp sender")
+	}
+
 	if email.HtmlBody == nil && email.TextBody == nil {
 		return fmt.Errorf("email body cannot be empty")
 	}
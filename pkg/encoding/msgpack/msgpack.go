@@ -1,9 +1,13 @@
 package msgpack
 
 import (
+	"bytes"
+
 	"github.com/vmihailenco/msgpack"
 )
 
+// Marshal encodes v as MessagePack. Struct fields are named by their msgpack tag or their field name, json tags are
+// not used.
 func Marshal(v any) ([]byte, error) {
 	return msgpack.Marshal(v)
 }
@@ -11,3 +15,22 @@ func Marshal(v any) ([]byte, error) {
 func Unmarshal(data []byte, v any) error {
 	return msgpack.Unmarshal(data, v)
 }
+
+// Valid reports whether data is a single valid MessagePack value.
+func Valid(data []byte) bool {
+	reader := bytes.NewReader(data)
+
+	if err := msgpack.NewDecoder(reader).Skip(); err != nil {
+		return false
+	}
+
+	return reader.Len() == 0
+}
+
+type Marshaler interface {
+	msgpack.Marshaler
+}
+
+type Unmarshaler interface {
+	msgpack.Unmarshaler
+}
@@ -0,0 +1,84 @@
+package msgpack_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/msgpack"
+	"github.com/stretchr/testify/assert"
+)
+
+type msgpackItem struct {
+	Id   int    `msgpack:"id"`
+	Name string `msgpack:"name"`
+	Tags []string
+}
+
+type msgpackVersion struct {
+	Major int
+	Minor int
+}
+
+var (
+	_ msgpack.Marshaler   = msgpackVersion{}
+	_ msgpack.Unmarshaler = &msgpackVersion{}
+)
+
+func (v msgpackVersion) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal([]int{v.Major, v.Minor})
+}
+
+func (v *msgpackVersion) UnmarshalMsgpack(data []byte) error {
+	parts := make([]int, 2)
+	if err := msgpack.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+
+	v.Major, v.Minor = parts[0], parts[1]
+
+	return nil
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	item := msgpackItem{
+		Id:   3,
+		Name: "item",
+		Tags: []string{"a", "b"},
+	}
+
+	data, err := msgpack.Marshal(item)
+	assert.NoError(t, err)
+
+	decoded := map[string]any{}
+	err = msgpack.Unmarshal(data, &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"id":   int64(3),
+		"name": "item",
+		"Tags": []any{"a", "b"},
+	}, decoded)
+
+	result := msgpackItem{}
+	err = msgpack.Unmarshal(data, &result)
+	assert.NoError(t, err)
+	assert.Equal(t, item, result)
+}
+
+func TestMarshaler(t *testing.T) {
+	data, err := msgpack.Marshal(msgpackVersion{Major: 1, Minor: 2})
+	assert.NoError(t, err)
+
+	result := msgpackVersion{}
+	err = msgpack.Unmarshal(data, &result)
+	assert.NoError(t, err)
+	assert.Equal(t, msgpackVersion{Major: 1, Minor: 2}, result)
+}
+
+func TestValid(t *testing.T) {
+	data, err := msgpack.Marshal(msgpackItem{Id: 1})
+	assert.NoError(t, err)
+
+	assert.True(t, msgpack.Valid(data))
+	assert.False(t, msgpack.Valid(nil))
+	assert.False(t, msgpack.Valid(data[:len(data)-1]), "truncated")
+	assert.False(t, msgpack.Valid(append(data, 0x01)), "trailing data")
+}
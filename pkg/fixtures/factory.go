@@ -0,0 +1,92 @@
+package fixtures
+
+import (
+	"fmt"
+)
+
+type (
+	// FactoryTemplate creates the index-th value of a Factory using the fake data of the given Faker.
+	FactoryTemplate[T any] func(faker Faker, index int) T
+	// FactoryOverride modifies the index-th value of a Factory after it was created by the template.
+	FactoryOverride[T any] func(value *T, index int)
+)
+
+// Factory generates fixtures from a template, e.g. to create large and realistic datasets without writing every fixture
+// by hand. All factories derived by With share the Faker and the index of the factory they are derived from, so the
+// generated values stay distinct.
+type Factory[T any] struct {
+	seed      string
+	faker     Faker
+	sequence  NumberSequence
+	template  FactoryTemplate[T]
+	overrides []FactoryOverride[T]
+}
+
+// NewFactory creates a Factory generating values with the given template. The seed is used for the Faker and as prefix
+// for the names of the generated named fixtures, use a distinct one per fixture set to keep the values deterministic.
+func NewFactory[T any](seed string, template FactoryTemplate[T]) *Factory[T] {
+	return &Factory[T]{
+		seed:     seed,
+		faker:    NewFaker(seed),
+		sequence: NewNumberSequenceFrom(0),
+		template: template,
+	}
+}
+
+// Faker returns the Faker of the factory, e.g. to fake values in overrides.
+func (f *Factory[T]) Faker() Faker {
+	return f.faker
+}
+
+// With returns a Factory applying the given overrides after the ones of this factory.
+func (f *Factory[T]) With(overrides ...FactoryOverride[T]) *Factory[T] {
+	derived := *f
+	derived.overrides = append(append([]FactoryOverride[T]{}, f.overrides...), overrides...)
+
+	return &derived
+}
+
+// Build creates a single value. The overrides are applied after the ones of the factory.
+func (f *Factory[T]) Build(overrides ...FactoryOverride[T]) T {
+	_, value := f.build(overrides)
+
+	return value
+}
+
+// BuildN creates n values.
+func (f *Factory[T]) BuildN(n int, overrides ...FactoryOverride[T]) []T {
+	values := make([]T, n)
+
+	for i := range values {
+		values[i] = f.Build(overrides...)
+	}
+
+	return values
+}
+
+// BuildNamed creates n named fixtures. They are named after the seed of the factory and their index, e.g. "users-0".
+func (f *Factory[T]) BuildNamed(n int, overrides ...FactoryOverride[T]) NamedFixtures[T] {
+	fixtures := make(NamedFixtures[T], n)
+
+	for i := range fixtures {
+		index, value := f.build(overrides)
+		fixtures[i] = NewNamedFixture(fmt.Sprintf("%s-%d", f.seed, index), value)
+	}
+
+	return fixtures
+}
+
+func (f *Factory[T]) build(overrides []FactoryOverride[T]) (int, T) {
+	index := f.sequence.GetNextInt()
+	value := f.template(f.faker, index)
+
+	for _, override := range f.overrides {
+		override(&value, index)
+	}
+
+	for _, override := range overrides {
+		override(&value, index)
+	}
+
+	return index, value
+}
@@ -0,0 +1,89 @@
+package fixtures_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/stretchr/testify/assert"
+)
+
+type factoryUser struct {
+	Id     *uint
+	Name   string
+	Email  string
+	Active bool
+}
+
+func newFactoryUserFactory() *fixtures.Factory[factoryUser] {
+	return fixtures.NewFactory("users", func(faker fixtures.Faker, index int) factoryUser {
+		return factoryUser{
+			Id:     mdl.Box(uint(index + 1)),
+			Name:   faker.Name(),
+			Email:  faker.Email(),
+			Active: faker.Bool(),
+		}
+	})
+}
+
+func TestFactory_Build(t *testing.T) {
+	factory := newFactoryUserFactory()
+
+	user := factory.Build()
+	assert.Equal(t, uint(1), *user.Id)
+	assert.NotEmpty(t, user.Name)
+	assert.NotEmpty(t, user.Email)
+
+	user = factory.Build(func(value *factoryUser, index int) {
+		value.Name = "Jane Doe"
+	})
+	assert.Equal(t, uint(2), *user.Id)
+	assert.Equal(t, "Jane Doe", user.Name)
+}
+
+func TestFactory_Deterministic(t *testing.T) {
+	assert.Equal(t, newFactoryUserFactory().BuildN(10), newFactoryUserFactory().BuildN(10))
+}
+
+func TestFactory_With(t *testing.T) {
+	factory := newFactoryUserFactory()
+	active := factory.With(func(value *factoryUser, index int) {
+		value.Active = true
+	})
+	named := active.With(func(value *factoryUser, index int) {
+		value.Name = "Jane Doe"
+	})
+
+	users := active.BuildN(50)
+	assert.Len(t, users, 50)
+
+	for i, user := range users {
+		assert.Equal(t, uint(i+1), *user.Id)
+		assert.True(t, user.Active)
+	}
+
+	user := named.Build()
+	assert.Equal(t, uint(51), *user.Id, "derived factories should share the index")
+	assert.True(t, user.Active)
+	assert.Equal(t, "Jane Doe", user.Name)
+
+	user = factory.Build()
+	assert.Equal(t, uint(52), *user.Id)
+	assert.NotEqual(t, "Jane Doe", user.Name)
+}
+
+func TestFactory_BuildNamed(t *testing.T) {
+	factory := newFactoryUserFactory()
+	factory.Build()
+
+	users := factory.BuildNamed(3, func(value *factoryUser, index int) {
+		value.Active = index%2 == 0
+	})
+
+	assert.Equal(t, 3, users.Len())
+	assert.Equal(t, uint(2), *users.GetValueByName("users-1").Id)
+	assert.Equal(t, uint(4), *users.GetValueByName("users-3").Id)
+	assert.Equal(t, 1, users.CountIf(func(user factoryUser) bool {
+		return user.Active
+	}))
+}
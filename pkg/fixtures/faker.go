@@ -0,0 +1,178 @@
+package fixtures
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+)
+
+const fakerLetters = "abcdefghijklmnopqrstuvwxyz"
+
+// Faker generates random-looking, but deterministic values for fixtures. Like the other sequences, every Faker is created
+// from a seed and returns the same values in the same order for the same seed.
+type Faker interface {
+	// Int returns a number in [min, max].
+	Int(min int, max int) int
+	// Float returns a number in [min, max).
+	Float(min float64, max float64) float64
+	// Bool returns true or false with the same probability.
+	Bool() bool
+	// Letters returns n lower case letters.
+	Letters(n int) string
+	// Word returns a lorem ipsum word.
+	Word() string
+	// Sentence returns a capitalized sentence of n lorem ipsum words ending with a dot.
+	Sentence(n int) string
+	FirstName() string
+	LastName() string
+	// Name returns a first and a last name separated by a space.
+	Name() string
+	// Username returns a lower case first name followed by a number.
+	Username() string
+	// Email returns an email address at a reserved example domain.
+	Email() string
+	// Url returns an url at a reserved example domain.
+	Url() string
+	City() string
+	// CountryCode returns an ISO 3166-1 alpha-2 country code.
+	CountryCode() string
+	// Ipv4 returns an address of the private 10.0.0.0/8 network.
+	Ipv4() string
+	// Time returns a time in [from, to) truncated to seconds.
+	Time(from time.Time, to time.Time) time.Time
+	// Uuid returns a valid UUID v4, see NewUuidSequence.
+	Uuid() string
+}
+
+type faker struct {
+	lck  sync.Mutex
+	rand *rand.Rand
+	uuid UuidSequence
+}
+
+// NewFaker provides a way to generate fake data for fixtures from a given seed. Use a distinct seed per fixture set, e.g.
+// the name of the set, to get distinct values from other fixture sets.
+func NewFaker(seed string) Faker {
+	return &faker{
+		rand: rand.New(rand.NewChaCha8(sha256.Sum256([]byte(seed)))),
+		uuid: NewUuidSequence(seed),
+	}
+}
+
+// FakeOneOf returns one of the given values chosen by the faker.
+func FakeOneOf[T any](faker Faker, values ...T) T {
+	if len(values) == 0 {
+		panic(fmt.Errorf("can not fake one of an empty list of %T", *new(T)))
+	}
+
+	return values[faker.Int(0, len(values)-1)]
+}
+
+func (f *faker) Int(min int, max int) int {
+	if max < min {
+		panic(fmt.Errorf("can not fake an int between %d and %d: max is less than min", min, max))
+	}
+
+	f.lck.Lock()
+	defer f.lck.Unlock()
+
+	return min + int(f.rand.Int64N(int64(max-min)+1))
+}
+
+func (f *faker) Float(min float64, max float64) float64 {
+	f.lck.Lock()
+	defer f.lck.Unlock()
+
+	return min + f.rand.Float64()*(max-min)
+}
+
+func (f *faker) Bool() bool {
+	return f.Int(0, 1) == 1
+}
+
+func (f *faker) Letters(n int) string {
+	letters := make([]byte, n)
+
+	for i := range letters {
+		letters[i] = fakerLetters[f.Int(0, len(fakerLetters)-1)]
+	}
+
+	return string(letters)
+}
+
+func (f *faker) Word() string {
+	return FakeOneOf(f, fakerWords...)
+}
+
+func (f *faker) Sentence(n int) string {
+	words := make([]string, n)
+
+	for i := range words {
+		words[i] = f.Word()
+	}
+
+	sentence := strings.Join(words, " ")
+	if sentence == "" {
+		return sentence
+	}
+
+	return strings.ToUpper(sentence[:1]) + sentence[1:] + "."
+}
+
+func (f *faker) FirstName() string {
+	return FakeOneOf(f, fakerFirstNames...)
+}
+
+func (f *faker) LastName() string {
+	return FakeOneOf(f, fakerLastNames...)
+}
+
+func (f *faker) Name() string {
+	return fmt.Sprintf("%s %s", f.FirstName(), f.LastName())
+}
+
+func (f *faker) Username() string {
+	return fmt.Sprintf("%s%d", strings.ToLower(f.FirstName()), f.Int(1, 9999))
+}
+
+func (f *faker) Email() string {
+	return fmt.Sprintf("%s.%s%d@%s", strings.ToLower(f.FirstName()), strings.ToLower(f.LastName()), f.Int(1, 999), FakeOneOf(f, fakerDomains...))
+}
+
+func (f *faker) Url() string {
+	return fmt.Sprintf("https://%s/%s/%s", FakeOneOf(f, fakerDomains...), f.Word(), f.Word())
+}
+
+func (f *faker) City() string {
+	return FakeOneOf(f, fakerCities...)
+}
+
+func (f *faker) CountryCode() string {
+	return FakeOneOf(f, fakerCountryCodes...)
+}
+
+func (f *faker) Ipv4() string {
+	return fmt.Sprintf("10.%d.%d.%d", f.Int(0, 255), f.Int(0, 255), f.Int(1, 254))
+}
+
+func (f *faker) Time(from time.Time, to time.Time) time.Time {
+	seconds := int64(to.Sub(from) / time.Second)
+	if seconds <= 0 {
+		return from.Truncate(time.Second)
+	}
+
+	f.lck.Lock()
+	defer f.lck.Unlock()
+
+	return from.Truncate(time.Second).Add(time.Duration(f.rand.Int64N(seconds)) * time.Second)
+}
+
+func (f *faker) Uuid() string {
+	f.lck.Lock()
+	defer f.lck.Unlock()
+
+	return f.uuid.NewV4()
+}
@@ -0,0 +1,35 @@
+package fixtures
+
+var (
+	fakerWords = []string{
+		"lorem", "ipsum", "dolor", "sit", "amet", "consectetur", "adipiscing", "elit", "sed", "do", "eiusmod", "tempor",
+		"incididunt", "ut", "labore", "et", "dolore", "magna", "aliqua", "enim", "ad", "minim", "veniam", "quis", "nostrud",
+		"exercitation", "ullamco", "laboris", "nisi", "aliquip", "ex", "ea", "commodo", "consequat", "duis", "aute", "irure",
+		"in", "reprehenderit", "voluptate", "velit", "esse", "cillum", "fugiat", "nulla", "pariatur", "excepteur", "sint",
+		"occaecat", "cupidatat", "non", "proident", "sunt", "culpa", "qui", "officia", "deserunt", "mollit", "anim", "id",
+		"est", "laborum",
+	}
+	fakerFirstNames = []string{
+		"Ada", "Alan", "Alex", "Amelia", "Anna", "Ben", "Carla", "Charlie", "Chris", "Dana", "David", "Elena", "Emil", "Emma",
+		"Finn", "Grace", "Hannah", "Ivan", "Jana", "Jonas", "Julia", "Kai", "Laura", "Leon", "Lina", "Luca", "Maria", "Max",
+		"Mia", "Noah", "Nora", "Olivia", "Paul", "Rosa", "Sam", "Sara", "Theo", "Tina", "Viktor", "Zoe",
+	}
+	fakerLastNames = []string{
+		"Adams", "Bauer", "Becker", "Brown", "Clark", "Davis", "Fischer", "Garcia", "Hoffmann", "Johnson", "Jones", "Keller",
+		"Koch", "Lopez", "Martin", "Meyer", "Miller", "Moore", "Müller", "Nguyen", "Novak", "Richter", "Rossi", "Schmidt",
+		"Schneider", "Schulz", "Smith", "Taylor", "Wagner", "Weber", "Williams", "Wilson", "Wolf", "Young",
+	}
+	fakerDomains = []string{
+		"example.com", "example.net", "example.org",
+	}
+	fakerCities = []string{
+		"Amsterdam", "Barcelona", "Berlin", "Boston", "Buenos Aires", "Cape Town", "Chicago", "Copenhagen", "Dublin",
+		"Hamburg", "Helsinki", "Istanbul", "Lisbon", "London", "Madrid", "Melbourne", "Mexico City", "Milan", "Munich",
+		"New York", "Oslo", "Paris", "Prague", "Rome", "San Francisco", "Seoul", "Singapore", "Stockholm", "Tokyo",
+		"Toronto", "Vienna", "Warsaw", "Zurich",
+	}
+	fakerCountryCodes = []string{
+		"AR", "AT", "AU", "BE", "BR", "CA", "CH", "CN", "CZ", "DE", "DK", "ES", "FI", "FR", "GB", "IE", "IN", "IT", "JP",
+		"KR", "MX", "NL", "NO", "PL", "PT", "SE", "SG", "TR", "US", "ZA",
+	}
+)
@@ -0,0 +1,94 @@
+package fixtures_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func fakeAll(faker fixtures.Faker) []any {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return []any{
+		faker.Int(-10, 10),
+		faker.Float(0, 1),
+		faker.Bool(),
+		faker.Letters(8),
+		faker.Word(),
+		faker.Sentence(5),
+		faker.Name(),
+		faker.Username(),
+		faker.Email(),
+		faker.Url(),
+		faker.City(),
+		faker.CountryCode(),
+		faker.Ipv4(),
+		faker.Time(from, from.AddDate(1, 0, 0)),
+		faker.Uuid(),
+	}
+}
+
+func TestFaker_SameSeed(t *testing.T) {
+	faker1 := fixtures.NewFaker("test")
+	faker2 := fixtures.NewFaker("test")
+
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, fakeAll(faker1), fakeAll(faker2))
+	}
+}
+
+func TestFaker_DifferentSeed(t *testing.T) {
+	faker1 := fixtures.NewFaker("test")
+	faker2 := fixtures.NewFaker("something else")
+
+	assert.NotEqual(t, fakeAll(faker1), fakeAll(faker2))
+}
+
+func TestFaker_ValidResults(t *testing.T) {
+	faker := fixtures.NewFaker("test")
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	for i := 0; i < 1000; i++ {
+		number := faker.Int(-3, 3)
+		assert.GreaterOrEqual(t, number, -3)
+		assert.LessOrEqual(t, number, 3)
+
+		float := faker.Float(1, 2)
+		assert.GreaterOrEqual(t, float, 1.0)
+		assert.Less(t, float, 2.0)
+
+		assert.Regexp(t, `^[a-z]{5}$`, faker.Letters(5))
+		assert.Regexp(t, `^[A-Z][a-z]*( [a-z]+){2}\.$`, faker.Sentence(3))
+		assert.Len(t, strings.Split(faker.Name(), " "), 2)
+		assert.Regexp(t, `^[^@\s]+@example\.(com|net|org)$`, faker.Email())
+		assert.Regexp(t, `^https://example\.(com|net|org)/[a-z]+/[a-z]+$`, faker.Url())
+		assert.Regexp(t, `^[A-Z]{2}$`, faker.CountryCode())
+		assert.True(t, net.ParseIP(faker.Ipv4()).IsPrivate())
+		assert.True(t, uuid.ValidV4(faker.Uuid()))
+
+		fakedTime := faker.Time(from, to)
+		assert.False(t, fakedTime.Before(from))
+		assert.True(t, fakedTime.Before(to))
+		assert.Equal(t, fakedTime, fakedTime.Truncate(time.Second))
+	}
+}
+
+func TestFakeOneOf(t *testing.T) {
+	faker := fixtures.NewFaker("test")
+	seen := map[string]bool{}
+
+	for i := 0; i < 100; i++ {
+		seen[fixtures.FakeOneOf(faker, "a", "b", "c")] = true
+	}
+
+	assert.Equal(t, map[string]bool{"a": true, "b": true, "c": true}, seen)
+	assert.Panics(t, func() {
+		fixtures.FakeOneOf[string](faker)
+	})
+}
@@ -0,0 +1,31 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+)
+
+type isolationSuffixCtxKey struct{}
+
+// WithIsolationSuffix returns a context for which fixture sets suffix the names of the resources they write to, so test
+// cases sharing the same containers don't overwrite each other's seed data. An empty suffix disables the isolation.
+func WithIsolationSuffix(ctx context.Context, suffix string) context.Context {
+	return context.WithValue(ctx, isolationSuffixCtxKey{}, suffix)
+}
+
+func IsolationSuffix(ctx context.Context) string {
+	suffix, _ := ctx.Value(isolationSuffixCtxKey{}).(string)
+
+	return suffix
+}
+
+// IsolatedName appends the isolation suffix of the context to the name of a resource. Fixture set factories use it for
+// the names of their tables and streams, tests use it to address the same resources.
+func IsolatedName(ctx context.Context, name string) string {
+	suffix := IsolationSuffix(ctx)
+	if suffix == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s-%s", name, suffix)
+}
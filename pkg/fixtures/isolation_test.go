@@ -0,0 +1,20 @@
+package fixtures_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsolatedName(t *testing.T) {
+	ctx := t.Context()
+	assert.Equal(t, "users", fixtures.IsolatedName(ctx, "users"), "names should stay as they are without isolation")
+
+	ctx = fixtures.WithIsolationSuffix(ctx, "test-create-user")
+	assert.Equal(t, "test-create-user", fixtures.IsolationSuffix(ctx))
+	assert.Equal(t, "users-test-create-user", fixtures.IsolatedName(ctx, "users"))
+
+	ctx = fixtures.WithIsolationSuffix(ctx, "")
+	assert.Equal(t, "users", fixtures.IsolatedName(ctx, "users"), "an empty suffix should disable the isolation again")
+}
@@ -5,6 +5,7 @@ import (
 	"math"
 	"reflect"
 	"slices"
+	"sync"
 
 	"github.com/justtrackio/gosoline/pkg/mdl"
 )
@@ -193,9 +194,9 @@ func Flatten[S ~[]T, T any](sl []S) []T {
 }
 
 func Index[T any](sl []T, e T) int {
-	for i, v := range sl {
-		equalTo := equal(e)
+	equalTo := equal(e)
 
+	for i, v := range sl {
 		if equalTo(v) {
 			return i
 		}
@@ -360,12 +361,60 @@ func UniqByType[S ~[]T, T any](sl S) S {
 	})
 }
 
+// equal compares with reflect.DeepEqual. If the value is of a type without pointers, interfaces, slices, maps, etc.
+// (like strings, numbers and structs of them), DeepEqual is the same as == and the much faster == is used instead.
 func equal[T any](expected T) func(actualValue T) bool {
+	if isShallowComparable(reflect.TypeOf(expected)) {
+		expectedAny := any(expected)
+
+		return func(actualValue T) bool {
+			// values of different dynamic types are unequal without panicking, values of the same type are comparable
+			return any(actualValue) == expectedAny
+		}
+	}
+
 	return func(actualValue T) bool {
 		return reflect.DeepEqual(actualValue, expected)
 	}
 }
 
+var shallowComparableTypes sync.Map
+
+func isShallowComparable(typ reflect.Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	case reflect.Array, reflect.Struct:
+	default:
+		return false
+	}
+
+	if cached, ok := shallowComparableTypes.Load(typ); ok {
+		return cached.(bool)
+	}
+
+	result := true
+
+	if typ.Kind() == reflect.Array {
+		result = isShallowComparable(typ.Elem())
+	}
+
+	for i := 0; typ.Kind() == reflect.Struct && i < typ.NumField() && result; i++ {
+		result = isShallowComparable(typ.Field(i).Type)
+	}
+
+	shallowComparableTypes.Store(typ, result)
+
+	return result
+}
+
 func Any[S ~[]T, T any, F func(T) bool](inp S, pred F) bool {
 	_, ok := FindFirstFunc(inp, pred)
 
@@ -1,6 +1,7 @@
 package funk_test
 
 import (
+	"fmt"
 	"math"
 	"math/rand"
 	"testing"
@@ -157,3 +158,20 @@ func staticIntSlice(length int) []int {
 
 	return a
 }
+
+var containsResult bool
+
+func BenchmarkContains(b *testing.B) {
+	var res bool
+	inp := make([]string, 1_000)
+
+	for i := range inp {
+		inp[i] = fmt.Sprintf("element-%d", i)
+	}
+
+	for n := 0; n < b.N; n++ {
+		res = funk.Contains(inp, "missing")
+	}
+
+	containsResult = res
+}
@@ -1,6 +1,7 @@
 package funk_test
 
 import (
+	"math"
 	"slices"
 	"testing"
 
@@ -59,6 +60,23 @@ func TestContains(t *testing.T) {
 	assert.True(t, out)
 }
 
+func TestContains_DeepEqual(t *testing.T) {
+	type nested struct {
+		Values []int
+	}
+
+	foo, bar := "foo", "foo"
+
+	assert.True(t, funk.Contains([]*string{&foo}, &bar), "pointers should be compared by their values")
+	assert.True(t, funk.Contains([]nested{{Values: []int{1}}}, nested{Values: []int{1}}))
+	assert.False(t, funk.Contains([]nested{{Values: []int{1}}}, nested{Values: []int{2}}))
+	assert.True(t, funk.Contains([]any{[]int{1}, "foo", 1}, any(1)), "values of uncomparable types should not panic")
+	assert.True(t, funk.Contains([]any{[]int{1}, "foo"}, any([]int{1})))
+	assert.False(t, funk.Contains([]any{int64(1)}, any(1)))
+	assert.False(t, funk.Contains([]float64{math.NaN()}, math.NaN()))
+	assert.Equal(t, 1, funk.Index([][2]string{{"a", "b"}, {"c", "d"}}, [2]string{"c", "d"}))
+}
+
 func TestContainsAll(t *testing.T) {
 	cases := map[string]struct {
 		in       []int
@@ -3,13 +3,21 @@ package guard
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/tracing"
 	"github.com/selm0/ladon"
 )
 
-const fetchLimit = 100
+const (
+	fetchLimit = 100
+	// ContextKeyBaggagePrefix prefixes the baggage values of the context in the ladon request context, so policies can
+	// use conditions like StringEqualCondition on e.g. "baggage.tenant_id". Only the values of Settings.BaggageKeys are
+	// added.
+	ContextKeyBaggagePrefix = "baggage."
+)
 
 //go:generate go run github.com/vektra/mockery/v2 --name Guard
 type Guard interface {
@@ -27,8 +35,16 @@ type Manager interface {
 	ladon.Manager
 }
 
+// Settings configures the guard with the guard key.
+type Settings struct {
+	// BaggageKeys are the baggage values available to the conditions of policies. Baggage is propagated from the callers
+	// of a service, so only configure keys which are set by trusted services and keep conditions on the subject.
+	BaggageKeys []string `cfg:"baggage_keys"`
+}
+
 type LadonGuard struct {
-	warden *ladon.Ladon
+	warden      *ladon.Ladon
+	baggageKeys []string
 }
 
 func NewGuard(ctx context.Context, config cfg.Config, logger log.Logger) (*LadonGuard, error) {
@@ -42,22 +58,55 @@ func NewGuard(ctx context.Context, config cfg.Config, logger log.Logger) (*Ladon
 		return nil, fmt.Errorf("can not create auditLogger: %w", err)
 	}
 
-	return NewGuardWithInterfaces(NewCachedManagerWithInterfaces(sqlManager), auditLogger), nil
+	settings := Settings{}
+	if err := config.UnmarshalKey("guard", &settings); err != nil {
+		return nil, fmt.Errorf("can not unmarshal guard settings: %w", err)
+	}
+
+	return NewGuardWithInterfaces(NewCachedManagerWithInterfaces(sqlManager), auditLogger, settings), nil
 }
 
-func NewGuardWithInterfaces(manager Manager, logger AuditLogger) *LadonGuard {
+func NewGuardWithInterfaces(manager Manager, logger AuditLogger, settings Settings) *LadonGuard {
 	warden := &ladon.Ladon{
 		Manager:     manager,
 		AuditLogger: logger,
 	}
 
 	return &LadonGuard{
-		warden: warden,
+		warden:      warden,
+		baggageKeys: settings.BaggageKeys,
 	}
 }
 
+// IsAllowed checks the request against the policies of its subject. The configured baggage values of the context are
+// available to the conditions of the policies, see ContextKeyBaggagePrefix. Values set on the request take precedence.
 func (g LadonGuard) IsAllowed(ctx context.Context, request *ladon.Request) error {
-	return g.warden.IsAllowed(ctx, request)
+	return g.warden.IsAllowed(ctx, g.withBaggage(ctx, request))
+}
+
+func (g LadonGuard) withBaggage(ctx context.Context, request *ladon.Request) *ladon.Request {
+	values := make(map[string]string, len(g.baggageKeys))
+
+	for _, key := range g.baggageKeys {
+		if value, ok := tracing.GetBaggageValue(ctx, key); ok {
+			values[key] = value
+		}
+	}
+
+	if len(values) == 0 {
+		return request
+	}
+
+	cpy := *request
+	cpy.Context = make(ladon.Context, len(request.Context)+len(values))
+
+	for key, value := range values {
+		cpy.Context[ContextKeyBaggagePrefix+key] = value
+	}
+
+	maps.Copy(cpy.Context, request.Context)
+
+	return &cpy
 }
 
 func (g LadonGuard) GetPolicies(ctx context.Context) (ladon.Policies, error) {
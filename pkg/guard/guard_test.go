@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/justtrackio/gosoline/pkg/guard/mocks"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/mock"
 )
 
 // Test LadonGuard::GetPolicies
@@ -18,7 +20,7 @@ import (
 func TestLadonGuard_GetPolicies(t *testing.T) {
 	manager := mocks.NewManager(t)
 	auditLogger := mocks.NewAuditLogger(t)
-	g := guard.NewGuardWithInterfaces(manager, auditLogger)
+	g := guard.NewGuardWithInterfaces(manager, auditLogger, guard.Settings{})
 
 	pol1 := &ladon.DefaultPolicy{
 		ID: "100",
@@ -40,3 +42,71 @@ func TestLadonGuard_GetPolicies(t *testing.T) {
 	expected := ladon.Policies{pol1, pol2}
 	assert.Equal(t, expected, pols)
 }
+
+func TestLadonGuard_IsAllowed_WithBaggage(t *testing.T) {
+	manager := mocks.NewManager(t)
+	auditLogger := mocks.NewAuditLogger(t)
+	g := guard.NewGuardWithInterfaces(manager, auditLogger, guard.Settings{BaggageKeys: []string{"tenant_id"}})
+
+	policy := &ladon.DefaultPolicy{
+		ID:        "tenant",
+		Subjects:  []string{"user"},
+		Resources: []string{"report"},
+		Actions:   []string{"read"},
+		Effect:    ladon.AllowAccess,
+		Conditions: ladon.Conditions{
+			guard.ContextKeyBaggagePrefix + "tenant_id": &ladon.StringEqualCondition{Equals: "justtrack"},
+		},
+	}
+
+	request := &ladon.Request{
+		Subject:  "user",
+		Resource: "report",
+		Action:   "read",
+	}
+
+	manager.EXPECT().FindRequestCandidates(mock.Anything, mock.Anything).Return(ladon.Policies{policy}, nil)
+	auditLogger.EXPECT().LogGrantedAccessRequest(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
+	auditLogger.EXPECT().LogRejectedAccessRequest(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Twice()
+
+	ctx, err := tracing.ContextWithBaggageValue(t.Context(), "tenant_id", "justtrack")
+	require.NoError(t, err)
+	assert.NoError(t, g.IsAllowed(ctx, request))
+	assert.Nil(t, request.Context, "the request of the caller should not be modified")
+
+	other, err := tracing.ContextWithBaggageValue(t.Context(), "tenant_id", "other")
+	require.NoError(t, err)
+	assert.Error(t, g.IsAllowed(other, request))
+
+	assert.Error(t, g.IsAllowed(t.Context(), request))
+}
+
+func TestLadonGuard_IsAllowed_IgnoresUnconfiguredBaggage(t *testing.T) {
+	manager := mocks.NewManager(t)
+	auditLogger := mocks.NewAuditLogger(t)
+	g := guard.NewGuardWithInterfaces(manager, auditLogger, guard.Settings{})
+
+	policy := &ladon.DefaultPolicy{
+		ID:        "tenant",
+		Subjects:  []string{"user"},
+		Resources: []string{"report"},
+		Actions:   []string{"read"},
+		Effect:    ladon.AllowAccess,
+		Conditions: ladon.Conditions{
+			guard.ContextKeyBaggagePrefix + "tenant_id": &ladon.StringEqualCondition{Equals: "justtrack"},
+		},
+	}
+
+	request := &ladon.Request{
+		Subject:  "user",
+		Resource: "report",
+		Action:   "read",
+	}
+
+	manager.EXPECT().FindRequestCandidates(mock.Anything, mock.Anything).Return(ladon.Policies{policy}, nil)
+	auditLogger.EXPECT().LogRejectedAccessRequest(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return().Once()
+
+	ctx, err := tracing.ContextWithBaggageValue(t.Context(), "tenant_id", "justtrack")
+	require.NoError(t, err)
+	assert.Error(t, g.IsAllowed(ctx, request), "baggage should not be available to policies without configuring its key")
+}
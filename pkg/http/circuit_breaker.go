@@ -2,12 +2,12 @@ package http
 
 import (
 	"context"
+	"slices"
 	"sync/atomic"
 	"time"
 
 	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/exec"
-	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
@@ -132,5 +132,5 @@ func (c *circuitBreakerClient) isRemoteFailure(response *Response, err error) bo
 		return false
 	}
 
-	return !funk.Contains(c.settings.ExpectedStatuses, response.StatusCode)
+	return !slices.Contains(c.settings.ExpectedStatuses, response.StatusCode)
 }
@@ -247,6 +247,9 @@ type ResolverSettings struct {
 type TracingSettings struct {
 	ForwardTraceId  bool                    `cfg:"forward_trace_id" default:"false"`
 	Instrumentation InstrumentationSettings `cfg:"instrumentation"`
+	// BaggageHosts are the hosts the W3C baggage of the request context is sent to. It is not sent to any other host to
+	// not leak it to third parties.
+	BaggageHosts []string `cfg:"baggage_hosts"`
 }
 
 type InstrumentationSettings struct {
@@ -336,6 +339,10 @@ func newRestyClient(tracer tracing.Instrumentor, settings Settings) *resty.Clien
 		httpClient.SetTransport(tracer.HttpClient(httpClient.GetClient()).Transport)
 	}
 
+	if len(settings.TracingSettings.BaggageHosts) > 0 {
+		httpClient.SetTransport(tracing.BaggageHttpTransport(httpClient.GetClient().Transport, settings.TracingSettings.BaggageHosts))
+	}
+
 	return httpClient
 }
 
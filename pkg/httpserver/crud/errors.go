@@ -42,6 +42,7 @@ func HandleErrorOnRead(ctx context.Context, logger log.Logger, err error) (*http
 //   - dbRepo.RecordNotFoundError | dbRepo.NoQueryResultsError -> HTTP 404
 //   - ErrModelNotChanged -> HTTP 304
 //   - db.IsDuplicateEntryError -> HTTP 409
+//   - dbRepo.StaleEntityError -> HTTP 409
 func HandleErrorOnWrite(ctx context.Context, logger log.Logger, err error) (*httpserver.Response, error) {
 	if exec.IsRequestCanceled(err) {
 		logger.Error(ctx, "failed to update model(s): %w", err)
@@ -65,6 +66,12 @@ func HandleErrorOnWrite(ctx context.Context, logger log.Logger, err error) (*htt
 		return httpserver.NewStatusResponse(http.StatusConflict), nil
 	}
 
+	if dbRepo.IsStaleEntityError(err) {
+		logger.Warn(ctx, "failed to update stale model(s): %s", err.Error())
+
+		return httpserver.NewStatusResponse(http.StatusConflict), nil
+	}
+
 	// rely on the outside handling of access forbidden and HTTP 500
 	return nil, err
 }
@@ -2,9 +2,11 @@ package crud
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gin-gonic/gin"
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	dbRepo "github.com/justtrackio/gosoline/pkg/db-repo"
 	"github.com/justtrackio/gosoline/pkg/httpserver"
 	"github.com/justtrackio/gosoline/pkg/httpserver/sql"
 	"github.com/justtrackio/gosoline/pkg/log"
@@ -70,3 +72,22 @@ func (lh listHandler) Handle(ctx context.Context, request *httpserver.Request) (
 
 	return resp, nil
 }
+
+// ListModels queries the models with the typed repository and transforms each of them with the TransformOutput
+// method of the handler. It can be used to implement the List method of a handler.
+func ListModels[T dbRepo.ModelBased](ctx context.Context, handler BaseHandler, repo dbRepo.TypedRepositoryReadOnly[T], qb *dbRepo.QueryBuilder, apiView string) ([]any, error) {
+	models, err := repo.Query(ctx, qb)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]any, len(models))
+
+	for i, model := range models {
+		if out[i], err = handler.TransformOutput(ctx, model, apiView); err != nil {
+			return nil, fmt.Errorf("can not transform model with id %d: %w", *model.GetId(), err)
+		}
+	}
+
+	return out, nil
+}
@@ -6,9 +6,11 @@ import (
 
 	configMocks "github.com/justtrackio/gosoline/pkg/cfg/mocks"
 	"github.com/justtrackio/gosoline/pkg/db-repo"
+	dbRepoMocks "github.com/justtrackio/gosoline/pkg/db-repo/mocks"
 	"github.com/justtrackio/gosoline/pkg/httpserver"
 	"github.com/justtrackio/gosoline/pkg/httpserver/crud"
 	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/test/matcher"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,3 +46,28 @@ func TestListHandler_Handle(t *testing.T) {
 
 	transformer.Repo.AssertExpectations(t)
 }
+
+func TestListModels(t *testing.T) {
+	handler := newHandler(t)
+	repo := dbRepoMocks.NewTypedRepositoryReadOnly[*Model](t)
+	qb := db_repo.NewQueryBuilder()
+
+	repo.EXPECT().Query(matcher.Context, qb).Return([]*Model{
+		{
+			Model: db_repo.Model{Id: mdl.Box(uint(1))},
+			Name:  mdl.Box("foo"),
+		},
+		{
+			Model: db_repo.Model{Id: mdl.Box(uint(2))},
+			Name:  mdl.Box("bar"),
+		},
+	}, nil)
+
+	out, err := crud.ListModels(t.Context(), handler, repo, qb, crud.DefaultApiView)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []any{
+		&Output{Id: mdl.Box(uint(1)), Name: mdl.Box("foo")},
+		&Output{Id: mdl.Box(uint(2)), Name: mdl.Box("bar")},
+	}, out)
+}
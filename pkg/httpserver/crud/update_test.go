@@ -108,3 +108,31 @@ func (s *updateTestSuite) TestUpdate_ValidationError() {
 	s.Equal(http.StatusBadRequest, response.Code)
 	s.JSONEq(`{"err":"validation: invalid foobar"}`, response.Body.String())
 }
+
+func (s *updateTestSuite) TestUpdate_StaleEntity() {
+	readModel := &Model{}
+	updateModel := &Model{
+		Model: db_repo.Model{
+			Id: mdl.Box(uint(1)),
+			Timestamps: db_repo.Timestamps{
+				UpdatedAt: &time.Time{},
+				CreatedAt: &time.Time{},
+			},
+		},
+		Name: mdl.Box("updated"),
+	}
+
+	s.handler.Repo.EXPECT().Update(matcher.Context, updateModel).Return(db_repo.NewStaleEntityError(1, "model", 3))
+	s.handler.Repo.EXPECT().Read(matcher.Context, mdl.Box(uint(1)), readModel).Run(func(_ context.Context, _ *uint, out db_repo.ModelBased) {
+		model := out.(*Model)
+		model.Id = mdl.Box(uint(1))
+		model.Name = mdl.Box("updated")
+		model.UpdatedAt = &time.Time{}
+		model.CreatedAt = &time.Time{}
+	}).Return(nil)
+
+	body := `{"name": "updated"}`
+	response := httpserver.HttpTest("PUT", "/:id", "/1", body, s.updateHandler)
+
+	s.Equal(http.StatusConflict, response.Code)
+}
@@ -35,6 +35,7 @@ func NewLoggingMiddlewareWithInterfaces(logger log.Logger, settings LoggingSetti
 
 		reqCtx := log.WithFingersCrossedScope(ginCtx.Request.Context())
 		reqCtx = log.InitContext(reqCtx)
+		reqCtx = log.WithSentryBreadcrumbs(reqCtx)
 		reqCtx = reqctx.New(reqCtx)
 
 		if requestId := ginCtx.Request.Header.Get("X-Request-Id"); requestId != "" {
@@ -136,7 +136,7 @@ func NewWithInterfaces(
 ) (*HttpServer, error) {
 	server := &http.Server{
 		Addr:         ":" + settings.Port,
-		Handler:      tracer.HttpHandler(router),
+		Handler:      tracing.BaggageHttpHandler(tracer.HttpHandler(router), settings.Baggage.Keys),
 		ReadTimeout:  settings.Timeout.Read,
 		WriteTimeout: settings.Timeout.Write,
 		IdleTimeout:  settings.Timeout.Idle,
@@ -62,6 +62,15 @@ type (
 		// MaxBodyBytes is the maximum size of an incoming request body in bytes.
 		// A value of 0 disables the limit. Default: 10 MiB.
 		MaxBodyBytes int64 `cfg:"max_body_bytes" default:"10485760"`
+		// Baggage settings.
+		Baggage BaggageSettings `cfg:"baggage"`
+	}
+
+	// BaggageSettings configures which members of the W3C baggage header of a request are accepted.
+	BaggageSettings struct {
+		// Keys of the accepted members. The header is sent by the caller, so it is ignored if no keys are configured.
+		// Don't base authorization on accepted members unless all callers are trusted.
+		Keys []string `cfg:"keys"`
 	}
 
 	// TimeoutSettings configures IO timeouts.
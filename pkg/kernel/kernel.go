@@ -261,6 +261,14 @@ func (k *kernel) reportFailedHealthcheck(result HealthCheckResult) {
 func (k *kernel) exit() {
 	k.exitOnce.Do(func() {
 		k.logger.Info(k.ctx, "leaving kernel with exit code %d", k.exitCode)
+
+		// make sure handlers buffering log records get a chance to write them before we exit
+		if flusher, ok := k.logger.(log.Flusher); ok {
+			if err := flusher.Flush(); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "can not flush logger: %s\n", err)
+			}
+		}
+
 		k.exitHandler(k.exitCode)
 	})
 }
@@ -59,3 +59,31 @@ func getBatch(ctx context.Context, keys any, result any, getChunk chunkGetter, b
 
 	return missing, nil
 }
+
+// GetBatch reads the values of the given keys with the batch operation of the store (MGET for redis, BatchGetItem for
+// DynamoDB) and returns them in a typed map. The second return value contains the keys missing in the store.
+func GetBatch[K comparable, T any](ctx context.Context, store KvStore[T], keys []K) (map[K]T, []K, error) {
+	values := make(map[K]T, len(keys))
+
+	missing, err := store.GetBatch(ctx, keys, values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missingKeys := make([]K, 0, len(missing))
+	for _, key := range missing {
+		typedKey, ok := key.(K)
+		if !ok {
+			return nil, nil, fmt.Errorf("missing key %v is of type %T instead of %T", key, key, *new(K))
+		}
+
+		missingKeys = append(missingKeys, typedKey)
+	}
+
+	return values, missingKeys, nil
+}
+
+// PutBatch writes all values with the batch operation of the store.
+func PutBatch[K comparable, T any](ctx context.Context, store KvStore[T], values map[K]T) error {
+	return store.PutBatch(ctx, values)
+}
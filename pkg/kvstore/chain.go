@@ -4,22 +4,50 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/refl"
 )
 
+// WritePolicy defines how Put and PutBatch of a chain write to a layer of the chain.
+type WritePolicy string
+
+const (
+	// WritePolicyWriteThrough writes to the layer before the write to the chain returns.
+	WritePolicyWriteThrough WritePolicy = "write_through"
+	// WritePolicyWriteBehind writes to the layer in the background. The write to the chain doesn't wait for it and
+	// errors are only logged.
+	WritePolicyWriteBehind WritePolicy = "write_behind"
+	// WritePolicyReadRepair doesn't write the value to the layer but removes the key from it. The layer gets the
+	// value again from the following layers on the next read.
+	WritePolicyReadRepair WritePolicy = "read_repair"
+)
+
+type ChainLayerSettings struct {
+	// Name of the layer in logs and metrics, defaults to layer<index>.
+	Name string
+	// WritePolicy of the layer, defaults to WritePolicyWriteThrough.
+	WritePolicy WritePolicy
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name ChainKvStore
 type ChainKvStore[T any] interface {
 	KvStore[T]
 	Add(elementFactory ElementFactory[T]) error
 	AddStore(store KvStore[T])
+	AddLayer(elementFactory ElementFactory[T], settings ChainLayerSettings) error
+	AddStoreLayer(store KvStore[T], settings ChainLayerSettings)
 }
 
 type chainKvStore[T any] struct {
 	logger   log.Logger
 	factory  Factory[T]
 	chain    []KvStore[T]
+	layers   []ChainLayerSettings
+	metrics  *chainMetrics
 	settings *Settings
 
 	missingCache KvStore[T]
@@ -42,6 +70,10 @@ func NewChainKvStore[T any](ctx context.Context, config cfg.Config, logger log.L
 		missingCacheSettings := *settings
 		missingCacheSettings.Name = fmt.Sprintf("%s-missingCache", settings.Name)
 
+		if settings.MissingCacheTtl > 0 {
+			missingCacheSettings.Ttl = settings.MissingCacheTtl
+		}
+
 		if missingCache, err = NewInMemoryKvStore[T](ctx, config, logger, &missingCacheSettings); err != nil {
 			return nil, fmt.Errorf("can not create missing cache: %w", err)
 		}
@@ -57,24 +89,43 @@ func NewChainKvStoreWithInterfaces[T any](logger log.Logger, factory Factory[T],
 		logger:       logger,
 		factory:      factory,
 		chain:        make([]KvStore[T], 0),
+		layers:       make([]ChainLayerSettings, 0),
+		metrics:      newChainMetrics(settings),
 		settings:     settings,
 		missingCache: missingCache,
 	}
 }
 
 func (s *chainKvStore[T]) Add(elementFactory ElementFactory[T]) error {
+	return s.AddLayer(elementFactory, ChainLayerSettings{})
+}
+
+func (s *chainKvStore[T]) AddStore(store KvStore[T]) {
+	s.AddStoreLayer(store, ChainLayerSettings{})
+}
+
+func (s *chainKvStore[T]) AddLayer(elementFactory ElementFactory[T], settings ChainLayerSettings) error {
 	store, err := s.factory(elementFactory, s.settings)
 	if err != nil {
 		return fmt.Errorf("can not create store: %w", err)
 	}
 
-	s.AddStore(store)
+	s.AddStoreLayer(store, settings)
 
 	return nil
 }
 
-func (s *chainKvStore[T]) AddStore(store KvStore[T]) {
+func (s *chainKvStore[T]) AddStoreLayer(store KvStore[T], settings ChainLayerSettings) {
+	if settings.Name == "" {
+		settings.Name = fmt.Sprintf("layer%d", len(s.chain))
+	}
+
+	if settings.WritePolicy == "" {
+		settings.WritePolicy = WritePolicyWriteThrough
+	}
+
 	s.chain = append(s.chain, store)
+	s.layers = append(s.layers, settings)
 }
 
 func (s *chainKvStore[T]) Contains(ctx context.Context, key any) (bool, error) {
@@ -82,6 +133,34 @@ func (s *chainKvStore[T]) Contains(ctx context.Context, key any) (bool, error) {
 }
 
 func (s *chainKvStore[T]) Get(ctx context.Context, key any, value *T) (bool, error) {
+	found, _, err := s.get(ctx, key, value, func(element KvStore[T]) (bool, time.Duration, error) {
+		exists, err := element.Get(ctx, key, value)
+
+		return exists, 0, err
+	}, func(element KvStore[T], _ time.Duration) error {
+		return element.Put(ctx, key, *value)
+	})
+
+	return found, err
+}
+
+// GetWithTTL returns the remaining lifetime of the element the value was found in. The value is propagated to the lower
+// cache levels with this lifetime, so they don't keep it longer than the element they got it from.
+func (s *chainKvStore[T]) GetWithTTL(ctx context.Context, key any, value *T) (bool, time.Duration, error) {
+	return s.get(ctx, key, value, func(element KvStore[T]) (bool, time.Duration, error) {
+		return element.GetWithTTL(ctx, key, value)
+	}, func(element KvStore[T], ttl time.Duration) error {
+		return element.PutWithTTL(ctx, key, *value, ttl)
+	})
+}
+
+func (s *chainKvStore[T]) get(
+	ctx context.Context,
+	key any,
+	value *T,
+	read func(element KvStore[T]) (bool, time.Duration, error),
+	propagate func(element KvStore[T], ttl time.Duration) error,
+) (bool, time.Duration, error) {
 	// check if we can short circuit the whole deal
 	exists, err := s.missingCache.Contains(ctx, key)
 	if err != nil {
@@ -89,19 +168,22 @@ func (s *chainKvStore[T]) Get(ctx context.Context, key any, value *T) (bool, err
 	}
 
 	if exists {
-		return false, nil
+		return false, 0, nil
 	}
 
+	var ttl time.Duration
 	lastElementIndex := len(s.chain) - 1
 	foundInIndex := lastElementIndex + 1
 
 	for i, element := range s.chain {
 		var err error
-		exists, err = element.Get(ctx, key, value)
+		exists, ttl, err = read(element)
+		s.metrics.recordRead(ctx, s.layers[i].Name, 1, exists)
+
 		if err != nil {
 			// return error only if last element fails
 			if i == lastElementIndex {
-				return false, fmt.Errorf("could not get %s from kvstore %T: %w", key, element, err)
+				return false, 0, fmt.Errorf("could not get %s from kvstore %T: %w", key, element, err)
 			}
 
 			s.logger.Warn(ctx, "could not get %s from kvstore %T: %s", key, element, err.Error())
@@ -120,18 +202,18 @@ func (s *chainKvStore[T]) Get(ctx context.Context, key any, value *T) (bool, err
 			s.logger.Warn(ctx, "failed to write to missing value cache: %s", err.Error())
 		}
 
-		return false, nil
+		return false, 0, nil
 	}
 
 	// propagate to the lower cache levels
 	for i := foundInIndex - 1; i >= 0; i-- {
-		err := s.chain[i].Put(ctx, key, *value)
+		err := propagate(s.chain[i], ttl)
 		if err != nil {
 			s.logger.Warn(ctx, "could not put %s to kvstore %T: %s", key, s.chain[i], err.Error())
 		}
 	}
 
-	return true, nil
+	return true, ttl, nil
 }
 
 func (s *chainKvStore[T]) GetBatch(ctx context.Context, keys any, values any) ([]any, error) {
@@ -169,7 +251,8 @@ func (s *chainKvStore[T]) GetBatch(ctx context.Context, keys any, values any) ([
 }
 
 func (s *chainKvStore[T]) getKeysFromMissingCache(ctx context.Context, todo []any) (remainingTodo []any, cachedMissing []any, err error) {
-	cachedMissingMap := make(map[string]any)
+	// the in-memory store writes the values at their original keys, which can be of any type
+	cachedMissingMap := make(map[any]any)
 	remainingTodo, err = s.missingCache.GetBatch(ctx, todo, cachedMissingMap)
 	if err != nil {
 		s.logger.Warn(ctx, "failed to read from missing value cache: %s", err.Error())
@@ -201,6 +284,8 @@ func (s *chainKvStore[T]) getFromChain(ctx context.Context, todo []any, values a
 			refill[i] = remainingTodo
 		}
 
+		s.metrics.recordBatchRead(ctx, s.layers[i].Name, len(remainingTodo), len(remainingTodo)-len(refill[i]))
+
 		remainingTodo = refill[i]
 
 		if len(remainingTodo) == 0 {
@@ -262,18 +347,23 @@ func (s *chainKvStore[T]) storeMissingKeys(ctx context.Context, todo []any) {
 }
 
 func (s *chainKvStore[T]) Put(ctx context.Context, key any, value T) error {
-	lastElementIndex := len(s.chain) - 1
+	return s.put(ctx, key, func(ctx context.Context, element KvStore[T]) error {
+		return element.Put(ctx, key, value)
+	})
+}
 
-	for i := 0; i <= lastElementIndex; i++ {
-		err := s.chain[i].Put(ctx, key, value)
-		if err != nil {
-			// return error only if last element fails
-			if i == lastElementIndex {
-				return fmt.Errorf("could not put %s to kvstore %T: %w", key, s.chain[i], err)
-			}
+func (s *chainKvStore[T]) PutWithTTL(ctx context.Context, key any, value T, ttl time.Duration) error {
+	return s.put(ctx, key, func(ctx context.Context, element KvStore[T]) error {
+		return element.PutWithTTL(ctx, key, value, ttl)
+	})
+}
 
-			s.logger.Warn(ctx, "could not put %s to kvstore %T: %s", key, s.chain[i], err.Error())
-		}
+func (s *chainKvStore[T]) put(ctx context.Context, key any, write func(ctx context.Context, element KvStore[T]) error) error {
+	err := s.writeLayers(ctx, write, func(ctx context.Context, element KvStore[T]) error {
+		return element.Delete(ctx, key)
+	})
+	if err != nil {
+		return fmt.Errorf("could not put %s to kvstore: %w", key, err)
 	}
 
 	// remove the value from the missing value cache only after we persisted it
@@ -286,24 +376,81 @@ func (s *chainKvStore[T]) Put(ctx context.Context, key any, value T) error {
 	return nil
 }
 
+// writeLayers writes to every layer according to its write policy. Layers with the read repair policy are invalidated
+// after all other layers were written, so a concurrent read can't fill them with the old value again.
+func (s *chainKvStore[T]) writeLayers(
+	ctx context.Context,
+	write func(ctx context.Context, element KvStore[T]) error,
+	invalidate func(ctx context.Context, element KvStore[T]) error,
+) error {
+	lastElementIndex := len(s.chain) - 1
+
+	for i := 0; i <= lastElementIndex; i++ {
+		switch s.layers[i].WritePolicy {
+		case WritePolicyReadRepair:
+			continue
+		case WritePolicyWriteBehind:
+			s.writeBehind(ctx, i, write)
+
+			continue
+		}
+
+		err := write(ctx, s.chain[i])
+		if err == nil {
+			continue
+		}
+
+		s.metrics.recordWriteError(ctx, s.layers[i].Name)
+
+		// return error only if last element fails
+		if i == lastElementIndex {
+			return fmt.Errorf("could not write to layer %s: %w", s.layers[i].Name, err)
+		}
+
+		s.logger.Warn(ctx, "could not write to kvstore layer %s: %s", s.layers[i].Name, err.Error())
+	}
+
+	for i, element := range s.chain {
+		if s.layers[i].WritePolicy != WritePolicyReadRepair {
+			continue
+		}
+
+		// we can't keep the old value in the layer, it would be served until it expires
+		if err := invalidate(ctx, element); err != nil {
+			s.metrics.recordWriteError(ctx, s.layers[i].Name)
+
+			return fmt.Errorf("could not invalidate layer %s: %w", s.layers[i].Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *chainKvStore[T]) writeBehind(ctx context.Context, index int, write func(ctx context.Context, element KvStore[T]) error) {
+	// the write has to finish even if the request which triggered it is done already
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		if err := write(ctx, s.chain[index]); err != nil {
+			s.metrics.recordWriteError(ctx, s.layers[index].Name)
+			s.logger.Warn(ctx, "could not write behind to kvstore layer %s: %s", s.layers[index].Name, err.Error())
+		}
+	}()
+}
+
 func (s *chainKvStore[T]) PutBatch(ctx context.Context, values any) error {
 	mii, err := refl.InterfaceToMapInterfaceInterface(values)
 	if err != nil {
 		return fmt.Errorf("can not cast values from %T to map[any]any: %w", values, err)
 	}
 
-	lastElementIndex := len(s.chain) - 1
-
-	for i := 0; i <= lastElementIndex; i++ {
-		err := s.chain[i].PutBatch(ctx, mii)
-		if err != nil {
-			// return error only if last element fails
-			if i == lastElementIndex {
-				return fmt.Errorf("could not put batch to kvstore %T: %w", s.chain[i], err)
-			}
-
-			s.logger.Warn(ctx, "could not put batch to kvstore %T: %s", s.chain[i], err.Error())
-		}
+	err = s.writeLayers(ctx, func(ctx context.Context, element KvStore[T]) error {
+		return element.PutBatch(ctx, mii)
+	}, func(ctx context.Context, element KvStore[T]) error {
+		return element.DeleteBatch(ctx, funk.Keys(mii))
+	})
+	if err != nil {
+		return fmt.Errorf("could not put batch to kvstore: %w", err)
 	}
 
 	for key := range mii {
@@ -2,7 +2,9 @@ package kvstore_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/justtrackio/gosoline/pkg/kvstore"
 	kvStoreMocks "github.com/justtrackio/gosoline/pkg/kvstore/mocks"
@@ -10,6 +12,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/test/matcher"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestChainKvStore_Contains(t *testing.T) {
@@ -116,6 +119,28 @@ func TestChainKvStore_Get(t *testing.T) {
 	element1.AssertExpectations(t)
 }
 
+func TestChainKvStore_GetWithTTL(t *testing.T) {
+	ctx := t.Context()
+	item := &Item{}
+	store, element0, element1 := buildTestableChainStore[Item](t, false)
+
+	element0.EXPECT().GetWithTTL(matcher.Context, "foo", item).Return(false, 0, nil).Once()
+	element1.EXPECT().GetWithTTL(matcher.Context, "foo", item).Run(func(ctx context.Context, key any, item *Item) {
+		item.Id = "foo"
+		item.Body = "bar"
+	}).Return(true, time.Minute, nil).Once()
+
+	// the lower levels must not keep the value longer than the element it was found in
+	element0.EXPECT().PutWithTTL(matcher.Context, "foo", Item{Id: "foo", Body: "bar"}, time.Minute).Return(nil).Once()
+
+	found, ttl, err := store.GetWithTTL(ctx, "foo", item)
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, time.Minute, ttl)
+	assert.Equal(t, "bar", item.Body)
+}
+
 func TestChainKvStore_Get_CacheMissing(t *testing.T) {
 	ctx := t.Context()
 	item := &Item{}
@@ -388,6 +413,23 @@ func TestChainKvStore_Put(t *testing.T) {
 	element1.AssertExpectations(t)
 }
 
+func TestChainKvStore_PutWithTTL(t *testing.T) {
+	ctx := t.Context()
+	item := Item{
+		Id:   "foo",
+		Body: "bar",
+	}
+
+	store, element0, element1 := buildTestableChainStore[Item](t, false)
+
+	element0.EXPECT().PutWithTTL(matcher.Context, "foo", item, time.Minute).Return(nil).Once()
+	element1.EXPECT().PutWithTTL(matcher.Context, "foo", item, time.Minute).Return(nil).Once()
+
+	err := store.PutWithTTL(ctx, "foo", item, time.Minute)
+
+	assert.NoError(t, err)
+}
+
 func TestChainKvStore_PutBatch(t *testing.T) {
 	ctx := t.Context()
 	items := map[string]Item{
@@ -448,6 +490,87 @@ func TestChainKvStore_DeleteBatch(t *testing.T) {
 	element1.AssertExpectations(t)
 }
 
+func TestChainKvStore_Put_WriteBehind(t *testing.T) {
+	ctx := t.Context()
+	item := Item{Id: "foo", Body: "bar"}
+	store, element0, element1 := buildTestableChainStoreWithPolicies[Item](t, kvstore.WritePolicyWriteBehind)
+
+	written := make(chan struct{})
+	element0.EXPECT().Put(mock.Anything, "foo", item).Run(func(ctx context.Context, key any, value Item) {
+		close(written)
+	}).Return(nil).Once()
+	element1.EXPECT().Put(matcher.Context, "foo", item).Return(nil).Once()
+
+	err := store.Put(ctx, "foo", item)
+	assert.NoError(t, err)
+
+	select {
+	case <-written:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the write behind layer was not written")
+	}
+}
+
+func TestChainKvStore_Put_ReadRepair(t *testing.T) {
+	ctx := t.Context()
+	item := Item{Id: "foo", Body: "bar"}
+	store, element0, element1 := buildTestableChainStoreWithPolicies[Item](t, kvstore.WritePolicyReadRepair)
+
+	// the read repair layer is only invalidated, it is filled again by the next read
+	element1.EXPECT().Put(matcher.Context, "foo", item).Return(nil).Once()
+	element0.EXPECT().Delete(matcher.Context, "foo").Return(nil).Once()
+
+	err := store.Put(ctx, "foo", item)
+	assert.NoError(t, err)
+}
+
+func TestChainKvStore_PutBatch_ReadRepair(t *testing.T) {
+	ctx := t.Context()
+	store, element0, element1 := buildTestableChainStoreWithPolicies[Item](t, kvstore.WritePolicyReadRepair)
+
+	element1.EXPECT().PutBatch(matcher.Context, map[any]any{"foo": Item{Id: "foo"}}).Return(nil).Once()
+	element0.EXPECT().DeleteBatch(matcher.Context, []any{"foo"}).Return(nil).Once()
+
+	err := store.PutBatch(ctx, map[string]Item{"foo": {Id: "foo"}})
+	assert.NoError(t, err)
+}
+
+func TestChainKvStore_Put_ReadRepairFailed(t *testing.T) {
+	ctx := t.Context()
+	item := Item{Id: "foo", Body: "bar"}
+	store, element0, element1 := buildTestableChainStoreWithPolicies[Item](t, kvstore.WritePolicyReadRepair)
+
+	element1.EXPECT().Put(matcher.Context, "foo", item).Return(nil).Once()
+	element0.EXPECT().Delete(matcher.Context, "foo").Return(errors.New("connection refused")).Once()
+
+	err := store.Put(ctx, "foo", item)
+	assert.EqualError(t, err, "could not put foo to kvstore: could not invalidate layer cache: connection refused")
+}
+
+func buildTestableChainStoreWithPolicies[T any](t *testing.T, cachePolicy kvstore.WritePolicy) (
+	store kvstore.ChainKvStore[T],
+	element0 *kvStoreMocks.KvStore[T],
+	element1 *kvStoreMocks.KvStore[T],
+) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll)
+
+	element0 = kvStoreMocks.NewKvStore[T](t)
+	element1 = kvStoreMocks.NewKvStore[T](t)
+
+	settings := &kvstore.Settings{
+		ModelId: mdl.ModelId{
+			Name: "test",
+		},
+		BatchSize: 100,
+	}
+
+	store = kvstore.NewChainKvStoreWithInterfaces[T](logger, nilFactory[T], kvstore.NewEmptyKvStore[T](), settings)
+	store.AddStoreLayer(element0, kvstore.ChainLayerSettings{Name: "cache", WritePolicy: cachePolicy})
+	store.AddStoreLayer(element1, kvstore.ChainLayerSettings{Name: "backend"})
+
+	return store, element0, element1
+}
+
 func nilFactory[T any](_ kvstore.ElementFactory[T], _ *kvstore.Settings) (kvstore.KvStore[T], error) {
 	return nil, nil
 }
@@ -489,3 +612,23 @@ func buildTestableChainStore[T any](t *testing.T, missingCacheEnabled bool) (
 
 	return store, element0, element1
 }
+
+func TestChainKvStore_GetBatch_CacheMissing_IntKeys(t *testing.T) {
+	ctx := t.Context()
+	keys := []any{1, 2}
+	result := make(map[int]Item)
+
+	store, element0, element1 := buildTestableChainStore[Item](t, true)
+
+	element0.EXPECT().GetBatch(matcher.Context, keys, result).Return(keys, nil).Once()
+	element1.EXPECT().GetBatch(matcher.Context, keys, result).Return(keys, nil).Once()
+
+	missing, err := store.GetBatch(ctx, keys, result)
+	assert.NoError(t, err)
+	assert.Equal(t, keys, missing)
+
+	// the second read is answered by the missing value cache
+	missing, err = store.GetBatch(ctx, keys, result)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, keys, missing)
+}
@@ -0,0 +1,151 @@
+package kvstore
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/encoding/msgpack"
+	"google.golang.org/protobuf/proto"
+)
+
+type Codec string
+
+const (
+	CodecJson     Codec = "json"
+	CodecMsgpack  Codec = "msgpack"
+	CodecProtobuf Codec = "protobuf"
+)
+
+// codecHeader marks a value which is not encoded with JSON. The header is a null byte followed by the id of the codec,
+// neither can be the first byte of a JSON document.
+const codecHeader = byte(0)
+
+const (
+	codecIdMsgpack  = byte(1)
+	codecIdProtobuf = byte(2)
+)
+
+// ddbCodecPrefix is the start of every base64 encoded value with a codec header (the null byte and a codec id below 16).
+const ddbCodecPrefix = "AA"
+
+type codecFuncs struct {
+	marshal   func(v any) ([]byte, error)
+	unmarshal func(data []byte, v any) error
+}
+
+var codecs = map[byte]codecFuncs{
+	codecIdMsgpack: {
+		marshal:   msgpack.Marshal,
+		unmarshal: msgpack.Unmarshal,
+	},
+	codecIdProtobuf: {
+		marshal:   marshalProtobuf,
+		unmarshal: unmarshalProtobuf,
+	},
+}
+
+func codecId(codec Codec) (byte, error) {
+	switch codec {
+	case "", CodecJson:
+		return 0, nil
+	case CodecMsgpack:
+		return codecIdMsgpack, nil
+	case CodecProtobuf:
+		return codecIdProtobuf, nil
+	default:
+		return 0, fmt.Errorf("unknown codec %s", codec)
+	}
+}
+
+// EncodeValue encodes the value with the codec. JSON values are written as they are, so they stay readable for
+// readers without codec support. All other values start with a header naming the codec. value has to be a pointer
+// for the protobuf codec.
+func EncodeValue(codec Codec, value any) ([]byte, error) {
+	id, err := codecId(codec)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == 0 {
+		return json.Marshal(value)
+	}
+
+	data, err := codecs[id].marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("can not encode value with codec %s: %w", codec, err)
+	}
+
+	return append([]byte{codecHeader, id}, data...), nil
+}
+
+// DecodeValue decodes the data with the codec named in its header, independent of the codec the store currently
+// writes with. This allows to switch the codec of a store without invalidating its values.
+func DecodeValue(data []byte, value any) error {
+	if len(data) < 2 || data[0] != codecHeader {
+		return json.Unmarshal(data, value)
+	}
+
+	funcs, ok := codecs[data[1]]
+	if !ok {
+		return fmt.Errorf("unknown codec id %d", data[1])
+	}
+
+	return funcs.unmarshal(data[2:], value)
+}
+
+// encodeDdbValue encodes the value as string, as DynamoDB only accepts valid UTF-8. Values with a codec header are
+// base64 encoded.
+func encodeDdbValue(codec Codec, value any) (string, error) {
+	data, err := EncodeValue(codec, value)
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) > 0 && data[0] == codecHeader {
+		return base64.StdEncoding.EncodeToString(data), nil
+	}
+
+	return string(data), nil
+}
+
+func decodeDdbValue(data string, value any) error {
+	if !strings.HasPrefix(data, ddbCodecPrefix) {
+		return DecodeValue([]byte(data), value)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return fmt.Errorf("can not decode base64 value: %w", err)
+	}
+
+	return DecodeValue(decoded, value)
+}
+
+func marshalProtobuf(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+
+	// the messages are implemented by pointers, values of batches are passed as they are stored in the map
+	if !ok && v != nil {
+		ptr := reflect.New(reflect.TypeOf(v))
+		ptr.Elem().Set(reflect.ValueOf(v))
+		msg, ok = ptr.Interface().(proto.Message)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("the value of type %T is not a protobuf message", v)
+	}
+
+	return proto.Marshal(msg)
+}
+
+func unmarshalProtobuf(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("the value of type %T is not a protobuf message", v)
+	}
+
+	return proto.Unmarshal(data, msg)
+}
@@ -0,0 +1,91 @@
+package kvstore_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/msgpack"
+	"github.com/justtrackio/gosoline/pkg/kvstore"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	redisMocks "github.com/justtrackio/gosoline/pkg/redis/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestEncodeValue_Json(t *testing.T) {
+	data, err := kvstore.EncodeValue(kvstore.CodecJson, Item{Id: "foo", Body: "bar"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"foo","body":"bar"}`, string(data), "json values should be written without header")
+
+	item := Item{}
+	err = kvstore.DecodeValue(data, &item)
+	assert.NoError(t, err)
+	assert.Equal(t, Item{Id: "foo", Body: "bar"}, item)
+}
+
+func TestEncodeValue_Msgpack(t *testing.T) {
+	data, err := kvstore.EncodeValue(kvstore.CodecMsgpack, Item{Id: "foo", Body: "bar"})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 1}, data[:2])
+
+	item := Item{}
+	err = kvstore.DecodeValue(data, &item)
+	assert.NoError(t, err)
+	assert.Equal(t, Item{Id: "foo", Body: "bar"}, item)
+}
+
+func TestEncodeValue_Protobuf(t *testing.T) {
+	data, err := kvstore.EncodeValue(kvstore.CodecProtobuf, wrapperspb.String("foo"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 2}, data[:2])
+
+	msg := &wrapperspb.StringValue{}
+	err = kvstore.DecodeValue(data, msg)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(wrapperspb.String("foo"), msg))
+
+	_, err = kvstore.EncodeValue(kvstore.CodecProtobuf, Item{})
+	assert.EqualError(t, err, "can not encode value with codec protobuf: the value of type kvstore_test.Item is not a protobuf message")
+}
+
+func TestEncodeValue_UnknownCodec(t *testing.T) {
+	_, err := kvstore.EncodeValue("xml", Item{})
+	assert.EqualError(t, err, "unknown codec xml")
+}
+
+func TestRedisKvStore_Msgpack(t *testing.T) {
+	ctx := t.Context()
+	client := redisMocks.NewClient(t)
+	store := kvstore.NewRedisKvStoreWithInterfaces[Item](client, &kvstore.Settings{
+		ModelId: mdl.ModelId{
+			Name: "test",
+		},
+		Codec:     kvstore.CodecMsgpack,
+		BatchSize: 100,
+	})
+
+	encoded, err := msgpack.Marshal(Item{Id: "foo", Body: "bar"})
+	assert.NoError(t, err)
+	encoded = append([]byte{0, 1}, encoded...)
+
+	client.EXPECT().Set(matcher.Context, "foo", encoded, mock.Anything).Return(nil).Once()
+	err = store.Put(ctx, "foo", Item{Id: "foo", Body: "bar"})
+	assert.NoError(t, err)
+
+	// values written before switching the codec are still readable
+	client.EXPECT().Get(matcher.Context, "foo").Return(string(encoded), nil).Once()
+	client.EXPECT().Get(matcher.Context, "legacy").Return(`{"id":"legacy","body":"baz"}`, nil).Once()
+
+	item := Item{}
+	found, err := store.Get(ctx, "foo", &item)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, Item{Id: "foo", Body: "bar"}, item)
+
+	found, err = store.Get(ctx, "legacy", &item)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, Item{Id: "legacy", Body: "baz"}, item)
+}
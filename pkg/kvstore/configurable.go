@@ -27,18 +27,20 @@ type ChainConfiguration struct {
 	Ttl                 time.Duration         `cfg:"ttl"`
 	BatchSize           int                   `cfg:"batch_size" default:"100" validate:"min=1"`
 	MissingCacheEnabled bool                  `cfg:"missing_cache_enabled" default:"false"`
+	MissingCacheTtl     time.Duration         `cfg:"missing_cache_ttl"`
+	WritePolicies       map[string]string     `cfg:"write_policies"`
 	MetricsEnabled      bool                  `cfg:"metrics_enabled" default:"false"`
+	Codec               string                `cfg:"codec" default:"json" validate:"oneof=json msgpack protobuf"`
+	Namespace           NamespaceSettings     `cfg:"namespace"`
 	InMemory            InMemoryConfiguration `cfg:"in_memory"`
 	Redis               RedisConfiguration    `cfg:"redis"`
 }
 
 type InMemoryConfiguration struct {
 	MaxSize        int64  `cfg:"max_size" default:"5000"`
+	MaxBytes       int64  `cfg:"max_bytes" default:"0"`
 	Buckets        uint32 `cfg:"buckets" default:"16"`
-	ItemsToPrune   uint32 `cfg:"items_to_prune" default:"500"`
-	DeleteBuffer   uint32 `cfg:"delete_buffer" default:"1024"`
-	PromoteBuffer  uint32 `cfg:"promote_buffer" default:"1024"`
-	GetsPerPromote int32  `cfg:"gets_per_promote" default:"3"`
+	EvictionPolicy string `cfg:"eviction_policy" default:"lru" validate:"oneof=lru lfu"`
 }
 
 type RedisConfiguration struct {
@@ -86,40 +88,47 @@ func newKvStoreChainFromConfig[T any](ctx context.Context, config cfg.Config, lo
 	modelId := configuration.ModelId
 	modelId.Name = name
 
-	store, err := NewChainKvStore[T](ctx, config, logger, configuration.MissingCacheEnabled, &Settings{
-		ModelId:        modelId,
-		DdbSettings:    configuration.Ddb,
-		Ttl:            configuration.Ttl,
-		BatchSize:      configuration.BatchSize,
-		MetricsEnabled: configuration.MetricsEnabled,
+	settings := &Settings{
+		ModelId:         modelId,
+		DdbSettings:     configuration.Ddb,
+		Ttl:             configuration.Ttl,
+		MissingCacheTtl: configuration.MissingCacheTtl,
+		Codec:           Codec(configuration.Codec),
+		BatchSize:       configuration.BatchSize,
+		MetricsEnabled:  configuration.MetricsEnabled,
 		InMemorySettings: InMemorySettings{
 			MaxSize:        configuration.InMemory.MaxSize,
+			MaxBytes:       configuration.InMemory.MaxBytes,
 			Buckets:        configuration.InMemory.Buckets,
-			ItemsToPrune:   configuration.InMemory.ItemsToPrune,
-			DeleteBuffer:   configuration.InMemory.DeleteBuffer,
-			PromoteBuffer:  configuration.InMemory.PromoteBuffer,
-			GetsPerPromote: configuration.InMemory.GetsPerPromote,
+			EvictionPolicy: EvictionPolicy(configuration.InMemory.EvictionPolicy),
 		},
 		RedisSettings: RedisSettings{
 			KeyPrefixPattern: configuration.Redis.KeyPattern,
 		},
-	})
+	}
+
+	store, err := NewChainKvStore[T](ctx, config, logger, configuration.MissingCacheEnabled, settings)
 	if err != nil {
 		return nil, fmt.Errorf("can not create chain store: %w", err)
 	}
 
-	for _, element := range configuration.Elements {
+	for i, element := range configuration.Elements {
+		layerSettings, err := readChainLayerSettings(configuration, element, i == len(configuration.Elements)-1)
+		if err != nil {
+			return nil, fmt.Errorf("invalid settings for element %s of kvstore %s: %w", element, name, err)
+		}
+
 		switch element {
 		case TypeDdb:
-			if err := store.Add(NewDdbKvStore[T]); err != nil {
+			if err := store.AddLayer(NewDdbKvStore[T], layerSettings); err != nil {
 				return nil, fmt.Errorf("can not add ddb store: %w", err)
 			}
 		case TypeInMemory:
-			if err := store.Add(NewInMemoryKvStore[T]); err != nil {
+			if err := store.AddLayer(NewInMemoryKvStore[T], layerSettings); err != nil {
 				return nil, fmt.Errorf("can not add inMemory store: %w", err)
 			}
 		case TypeRedis:
-			if err := store.Add(NewRedisKvStore[T]); err != nil {
+			if err := store.AddLayer(NewRedisKvStore[T], layerSettings); err != nil {
 				return nil, fmt.Errorf("can not add redis store: %w", err)
 			}
 		default:
@@ -127,7 +136,65 @@ func newKvStoreChainFromConfig[T any](ctx context.Context, config cfg.Config, lo
 		}
 	}
 
-	return store, nil
+	// the elements write their own metrics, this adds the metrics of the chain as a whole
+	metricStore := NewMetricStore[T](store, settings)
+
+	if configuration.Namespace.Name == "" {
+		return metricStore, nil
+	}
+
+	versions, err := newNamespaceVersionStore(ctx, config, logger, configuration, settings)
+	if err != nil {
+		return nil, fmt.Errorf("can not create version store of namespace %s: %w", configuration.Namespace.Name, err)
+	}
+
+	return NewNamespacedKvStore(metricStore, versions, configuration.Namespace), nil
+}
+
+// newNamespaceVersionStore creates a store on the last element of the chain, as this is the one shared by all instances.
+// The version doesn't expire and is always encoded as json, independent of the codec of the values.
+func newNamespaceVersionStore(ctx context.Context, config cfg.Config, logger log.Logger, configuration ChainConfiguration, settings *Settings) (KvStore[int64], error) {
+	versionSettings := *settings
+	versionSettings.Ttl = 0
+	versionSettings.Codec = CodecJson
+	versionSettings.MetricsEnabled = false
+
+	element := configuration.Elements[len(configuration.Elements)-1]
+
+	switch element {
+	case TypeDdb:
+		return NewDdbKvStore[int64](ctx, config, logger, &versionSettings)
+	case TypeInMemory:
+		return NewInMemoryKvStore[int64](ctx, config, logger, &versionSettings)
+	case TypeRedis:
+		return NewRedisKvStore[int64](ctx, config, logger, &versionSettings)
+	default:
+		return nil, fmt.Errorf("invalid element type %s for kvstore chain", element)
+	}
+}
+
+func readChainLayerSettings(configuration ChainConfiguration, element string, isLast bool) (ChainLayerSettings, error) {
+	settings := ChainLayerSettings{
+		Name:        element,
+		WritePolicy: WritePolicyWriteThrough,
+	}
+
+	if policy, ok := configuration.WritePolicies[element]; ok {
+		settings.WritePolicy = WritePolicy(policy)
+	}
+
+	switch settings.WritePolicy {
+	case WritePolicyWriteThrough, WritePolicyWriteBehind:
+	case WritePolicyReadRepair:
+		// the last element is the only one holding values which were never read, it always has to be written
+		if isLast {
+			return settings, fmt.Errorf("the last element of a chain can not use the write policy %s", WritePolicyReadRepair)
+		}
+	default:
+		return settings, fmt.Errorf("unknown write policy %s", settings.WritePolicy)
+	}
+
+	return settings, nil
 }
 
 func GetConfigurableKey(name string) string {
@@ -5,16 +5,21 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"time"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/ddb"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/refl"
 )
 
 type DdbItem struct {
 	Key   string `json:"key"   ddb:"key=hash"`
 	Value string `json:"value"`
+	// Ttl is the unix timestamp the item expires at, items without a ttl don't expire.
+	Ttl *int64 `json:"ttl,omitempty" ddb:"ttl=enabled"`
 }
 
 type DdbDeleteItem struct {
@@ -23,6 +28,7 @@ type DdbDeleteItem struct {
 
 type ddbKvStore[T any] struct {
 	repository ddb.Repository
+	clock      clock.Clock
 	settings   *Settings
 }
 
@@ -58,12 +64,13 @@ func NewDdbKvStore[T any](ctx context.Context, config cfg.Config, logger log.Log
 		return nil, fmt.Errorf("can not create ddb repository: %w", err)
 	}
 
-	return NewDdbKvStoreWithInterfaces[T](repository, settings), nil
+	return NewDdbKvStoreWithInterfaces[T](repository, clock.Provider, settings), nil
 }
 
-func NewDdbKvStoreWithInterfaces[T any](repository ddb.Repository, settings *Settings) KvStore[T] {
-	return NewMetricStoreWithInterfaces[T](&ddbKvStore[T]{
+func NewDdbKvStoreWithInterfaces[T any](repository ddb.Repository, clock clock.Clock, settings *Settings) KvStore[T] {
+	return NewMetricStore[T](&ddbKvStore[T]{
 		repository: repository,
+		clock:      clock,
 		settings:   settings,
 	}, settings)
 }
@@ -75,40 +82,53 @@ func (s *ddbKvStore[T]) Contains(ctx context.Context, key any) (bool, error) {
 	}
 
 	item := &DdbItem{}
-	qb := s.repository.GetItemBuilder().WithHash(keyStr)
+	qb := s.repository.GetItemBuilder().WithHash(keyStr).DisableTtlFilter()
 	res, err := s.repository.GetItem(ctx, qb, item)
 	if err != nil {
 		return false, fmt.Errorf("can not check if ddb store contains the key %s: %w", keyStr, err)
 	}
 
-	return res.IsFound, nil
+	_, expired := s.remainingTtl(item)
+
+	return res.IsFound && !expired, nil
 }
 
 func (s *ddbKvStore[T]) Get(ctx context.Context, key any, value *T) (bool, error) {
+	found, _, err := s.GetWithTTL(ctx, key, value)
+
+	return found, err
+}
+
+func (s *ddbKvStore[T]) GetWithTTL(ctx context.Context, key any, value *T) (bool, time.Duration, error) {
 	keyStr, err := CastKeyToString(key)
 	if err != nil {
-		return false, fmt.Errorf("can not cast key %T %v to string: %w", key, key, err)
+		return false, 0, fmt.Errorf("can not cast key %T %v to string: %w", key, key, err)
 	}
 
-	qb := s.repository.GetItemBuilder().WithHash(keyStr)
+	// the ttl filter of the repository would drop items without a ttl, so expired items are filtered here
+	qb := s.repository.GetItemBuilder().WithHash(keyStr).DisableTtlFilter()
 
 	item := &DdbItem{}
 	res, err := s.repository.GetItem(ctx, qb, item)
 	if err != nil {
-		return false, fmt.Errorf("can not get item %s from ddb store: %w", keyStr, err)
+		return false, 0, fmt.Errorf("can not get item %s from ddb store: %w", keyStr, err)
 	}
 
 	if !res.IsFound {
-		return false, nil
+		return false, 0, nil
 	}
 
-	bytes := []byte(item.Value)
-	err = Unmarshal(bytes, value)
+	ttl, expired := s.remainingTtl(item)
+	if expired {
+		return false, 0, nil
+	}
+
+	err = decodeDdbValue(item.Value, value)
 	if err != nil {
-		return false, fmt.Errorf("can not unmarshal value for item %s: %w", keyStr, err)
+		return false, 0, fmt.Errorf("can not unmarshal value for item %s: %w", keyStr, err)
 	}
 
-	return true, nil
+	return true, ttl, nil
 }
 
 func (s *ddbKvStore[T]) GetBatch(ctx context.Context, keys any, result any) ([]any, error) {
@@ -133,6 +153,7 @@ func (s *ddbKvStore[T]) getChunk(ctx context.Context, resultMap *refl.Map, keys
 
 	qb := s.repository.BatchGetItemsBuilder()
 	qb.WithHashKeys(keyStrings)
+	qb.DisableTtlFilter()
 	items := make([]DdbItem, 0)
 
 	_, err = s.repository.BatchGetItems(ctx, qb, &items)
@@ -143,10 +164,14 @@ func (s *ddbKvStore[T]) getChunk(ctx context.Context, resultMap *refl.Map, keys
 	found := make(map[string]bool)
 
 	for i := 0; i < len(items); i++ {
+		if _, expired := s.remainingTtl(&items[i]); expired {
+			continue
+		}
+
 		found[items[i].Key] = true
 
 		element := resultMap.NewElement()
-		err = Unmarshal([]byte(items[i].Value), element)
+		err = decodeDdbValue(items[i].Value, element)
 		if err != nil {
 			return nil, fmt.Errorf("can not unmarshal item: %w", err)
 		}
@@ -169,19 +194,24 @@ func (s *ddbKvStore[T]) getChunk(ctx context.Context, resultMap *refl.Map, keys
 }
 
 func (s *ddbKvStore[T]) Put(ctx context.Context, key any, value T) error {
+	return s.PutWithTTL(ctx, key, value, 0)
+}
+
+func (s *ddbKvStore[T]) PutWithTTL(ctx context.Context, key any, value T, ttl time.Duration) error {
 	keyStr, err := CastKeyToString(key)
 	if err != nil {
 		return fmt.Errorf("can not cast key %T %v to string: %w", key, key, err)
 	}
 
-	bytes, err := Marshal(value)
+	encoded, err := encodeDdbValue(s.settings.Codec, value)
 	if err != nil {
 		return fmt.Errorf("can not marshal value %s: %w", keyStr, err)
 	}
 
 	item := &DdbItem{
 		Key:   keyStr,
-		Value: string(bytes),
+		Value: encoded,
+		Ttl:   s.expiresAt(ttl),
 	}
 
 	_, err = s.repository.PutItem(ctx, nil, item)
@@ -218,14 +248,15 @@ func (s *ddbKvStore[T]) PutBatch(ctx context.Context, values any) error {
 		key := keyMap[keyStr]
 		value := mii[key]
 
-		bytes, err := Marshal(value)
+		encoded, err := encodeDdbValue(s.settings.Codec, value)
 		if err != nil {
 			return fmt.Errorf("can not marshal value %s: %w", keyStr, err)
 		}
 
 		item := DdbItem{
 			Key:   keyStr,
-			Value: string(bytes),
+			Value: encoded,
+			Ttl:   s.expiresAt(0),
 		}
 
 		items = append(items, item)
@@ -281,3 +312,28 @@ func (s *ddbKvStore[T]) DeleteBatch(ctx context.Context, keys any) error {
 
 	return nil
 }
+
+// expiresAt returns the value of the ttl attribute for an item written now, a ttl of 0 uses the default ttl of the store.
+func (s *ddbKvStore[T]) expiresAt(ttl time.Duration) *int64 {
+	if ttl == 0 {
+		ttl = s.settings.Ttl
+	}
+
+	if ttl <= 0 {
+		return nil
+	}
+
+	return mdl.Box(ddb.ExpiresAt(s.clock.Now().Add(ttl)))
+}
+
+// remainingTtl returns the remaining lifetime of the item and whether it is expired already. DynamoDB removes
+// expired items only eventually, so they can still be returned for some time.
+func (s *ddbKvStore[T]) remainingTtl(item *DdbItem) (time.Duration, bool) {
+	if item.Ttl == nil {
+		return 0, false
+	}
+
+	remaining := time.Unix(*item.Ttl, 0).Sub(s.clock.Now())
+
+	return remaining, remaining <= 0
+}
@@ -2,8 +2,11 @@ package kvstore_test
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
+	"time"
 
+	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/ddb"
 	ddbMocks "github.com/justtrackio/gosoline/pkg/ddb/mocks"
 	"github.com/justtrackio/gosoline/pkg/kvstore"
@@ -12,10 +15,13 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+var testDdbNow = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
 func TestDdbKvStore_Contains(t *testing.T) {
 	ctx, store, repo := buildTestableDdbStore[string](t)
 
 	builder := ddbMocks.NewGetItemBuilder(t)
+	builder.EXPECT().DisableTtlFilter().Return(builder)
 	builder.EXPECT().WithHash("foo").Return(builder).Once()
 
 	repo.EXPECT().GetItemBuilder().Return(builder)
@@ -44,6 +50,7 @@ func TestDdbKvStore_Get(t *testing.T) {
 	ctx, store, repo := buildTestableDdbStore[Item](t)
 
 	builder := ddbMocks.NewGetItemBuilder(t)
+	builder.EXPECT().DisableTtlFilter().Return(builder)
 	builder.EXPECT().WithHash("foo").Return(builder).Once()
 
 	ddbItem := &kvstore.DdbItem{
@@ -79,6 +86,7 @@ func TestDdbKvStore_GetBatch(t *testing.T) {
 	result := make(map[string]Item)
 
 	builder := ddbMocks.NewBatchGetItemsBuilder(t)
+	builder.EXPECT().DisableTtlFilter().Return(builder)
 	builder.EXPECT().WithHashKeys(keys).Return(builder)
 
 	items := make([]kvstore.DdbItem, 0)
@@ -113,6 +121,7 @@ func TestDdbKvStore_GetBatch_ReturnedKeysInDifferentOrder(t *testing.T) {
 	result := make(map[string]*Item)
 
 	builder := ddbMocks.NewBatchGetItemsBuilder(t)
+	builder.EXPECT().DisableTtlFilter().Return(builder)
 	builder.EXPECT().WithHashKeys([]string{"foo", "fuu"}).Return(builder)
 
 	items := make([]kvstore.DdbItem, 0)
@@ -156,6 +165,7 @@ func TestDdbKvStore_GetBatch_WithDuplicateKeys(t *testing.T) {
 	result := make(map[string]*Item)
 
 	builder := ddbMocks.NewBatchGetItemsBuilder(t)
+	builder.EXPECT().DisableTtlFilter().Return(builder)
 	builder.EXPECT().WithHashKeys([]string{"foo", "fuu"}).Return(builder)
 
 	items := make([]kvstore.DdbItem, 0)
@@ -205,6 +215,71 @@ func TestDdbKvStore_Put(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestDdbKvStore_PutWithTTL(t *testing.T) {
+	ctx, store, repo := buildTestableDdbStore[Item](t)
+
+	ddbItem := &kvstore.DdbItem{
+		Key:   "foo",
+		Value: `{"id":"foo","body":"bar"}`,
+		Ttl:   mdl.Box(testDdbNow.Add(time.Minute).Unix()),
+	}
+	repo.EXPECT().PutItem(ctx, nil, ddbItem).Return(nil, nil)
+
+	err := store.PutWithTTL(ctx, "foo", Item{Id: "foo", Body: "bar"}, time.Minute)
+
+	assert.NoError(t, err)
+}
+
+func TestDdbKvStore_GetWithTTL(t *testing.T) {
+	ctx, store, repo := buildTestableDdbStore[Item](t)
+
+	builder := ddbMocks.NewGetItemBuilder(t)
+	builder.EXPECT().WithHash("foo").Return(builder).Once()
+	builder.EXPECT().DisableTtlFilter().Return(builder)
+
+	repo.EXPECT().GetItemBuilder().Return(builder)
+	repo.EXPECT().GetItem(ctx, builder, mock.AnythingOfType("*kvstore.DdbItem")).Run(func(ctx context.Context, qb ddb.GetItemBuilder, result any) {
+		ddbItem := result.(*kvstore.DdbItem)
+		ddbItem.Key = "foo"
+		ddbItem.Value = `{"id":"foo","body":"bar"}`
+		ddbItem.Ttl = mdl.Box(testDdbNow.Add(time.Minute).Unix())
+	}).Return(&ddb.GetItemResult{
+		IsFound: true,
+	}, nil).Once()
+
+	item := &Item{}
+	found, ttl, err := store.GetWithTTL(ctx, "foo", item)
+
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, time.Minute, ttl)
+	assert.Equal(t, "bar", item.Body)
+}
+
+func TestDdbKvStore_Get_Expired(t *testing.T) {
+	ctx, store, repo := buildTestableDdbStore[Item](t)
+
+	builder := ddbMocks.NewGetItemBuilder(t)
+	builder.EXPECT().WithHash("foo").Return(builder).Once()
+	builder.EXPECT().DisableTtlFilter().Return(builder)
+
+	// ddb deletes expired items only eventually, so they still have to be filtered
+	repo.EXPECT().GetItemBuilder().Return(builder)
+	repo.EXPECT().GetItem(ctx, builder, mock.AnythingOfType("*kvstore.DdbItem")).Run(func(ctx context.Context, qb ddb.GetItemBuilder, result any) {
+		ddbItem := result.(*kvstore.DdbItem)
+		ddbItem.Key = "foo"
+		ddbItem.Value = `{"id":"foo","body":"bar"}`
+		ddbItem.Ttl = mdl.Box(testDdbNow.Add(-time.Second).Unix())
+	}).Return(&ddb.GetItemResult{
+		IsFound: true,
+	}, nil).Once()
+
+	found, err := store.Get(ctx, "foo", &Item{})
+
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
 func TestDdbKvStore_PutBatch(t *testing.T) {
 	ctx, store, repo := buildTestableDdbStore[Item](t)
 
@@ -272,11 +347,53 @@ func TestDdbKvStore_DeleteBatch(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestDdbKvStore_Msgpack(t *testing.T) {
+	ctx := t.Context()
+	repo := ddbMocks.NewRepository(t)
+	store := kvstore.NewDdbKvStoreWithInterfaces[Item](repo, clock.NewFakeClockAt(testDdbNow), &kvstore.Settings{
+		ModelId: mdl.ModelId{
+			Name: "test",
+		},
+		Codec:     kvstore.CodecMsgpack,
+		BatchSize: 100,
+	})
+
+	encoded, err := kvstore.EncodeValue(kvstore.CodecMsgpack, Item{Id: "foo", Body: "bar"})
+	assert.NoError(t, err)
+
+	// binary values are written base64 encoded, as DynamoDB only accepts valid UTF-8 strings
+	ddbItem := &kvstore.DdbItem{
+		Key:   "foo",
+		Value: base64.StdEncoding.EncodeToString(encoded),
+	}
+	repo.EXPECT().PutItem(ctx, nil, ddbItem).Return(nil, nil).Once()
+
+	err = store.Put(ctx, "foo", Item{Id: "foo", Body: "bar"})
+	assert.NoError(t, err)
+
+	builder := ddbMocks.NewGetItemBuilder(t)
+	builder.EXPECT().DisableTtlFilter().Return(builder)
+	builder.EXPECT().WithHash("foo").Return(builder).Once()
+
+	repo.EXPECT().GetItemBuilder().Return(builder)
+	repo.EXPECT().GetItem(ctx, builder, &kvstore.DdbItem{}).Run(func(ctx context.Context, qb ddb.GetItemBuilder, result any) {
+		*result.(*kvstore.DdbItem) = *ddbItem
+	}).Return(&ddb.GetItemResult{
+		IsFound: true,
+	}, nil).Once()
+
+	item := Item{}
+	found, err := store.Get(ctx, "foo", &item)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, Item{Id: "foo", Body: "bar"}, item)
+}
+
 func buildTestableDdbStore[T any](t *testing.T) (context.Context, kvstore.KvStore[T], *ddbMocks.Repository) {
 	ctx := t.Context()
 	repository := ddbMocks.NewRepository(t)
 
-	store := kvstore.NewDdbKvStoreWithInterfaces[T](repository, &kvstore.Settings{
+	store := kvstore.NewDdbKvStoreWithInterfaces[T](repository, clock.NewFakeClockAt(testDdbNow), &kvstore.Settings{
 		ModelId: mdl.ModelId{
 			Name:        "test",
 			Application: "kvstore",
@@ -3,6 +3,7 @@ package kvstore
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/justtrackio/gosoline/pkg/refl"
 )
@@ -25,6 +26,10 @@ func (s *emptyKvStore[T]) Get(_ context.Context, _ any, _ *T) (bool, error) {
 	return false, nil
 }
 
+func (s *emptyKvStore[T]) GetWithTTL(_ context.Context, _ any, _ *T) (bool, time.Duration, error) {
+	return false, 0, nil
+}
+
 func (s *emptyKvStore[T]) GetBatch(_ context.Context, keys any, _ any) ([]any, error) {
 	missing, err := refl.InterfaceToInterfaceSlice(keys)
 	if err != nil {
@@ -38,6 +43,10 @@ func (s *emptyKvStore[T]) Put(_ context.Context, _ any, _ T) error {
 	return nil
 }
 
+func (s *emptyKvStore[T]) PutWithTTL(_ context.Context, _ any, _ T, _ time.Duration) error {
+	return nil
+}
+
 func (s *emptyKvStore[T]) PutBatch(_ context.Context, _ any) error {
 	return nil
 }
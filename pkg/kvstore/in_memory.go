@@ -5,21 +5,18 @@ import (
 	"fmt"
 	"math/bits"
 	"reflect"
-	"sync/atomic"
 	"time"
 
-	"github.com/justtrackio/gosoline/pkg/cache"
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/refl"
-	"github.com/karlseguin/ccache"
 )
 
 type InMemoryKvStore[T any] struct {
-	cache     cache.Cache[T]
-	settings  *Settings
-	cacheSize *int64
+	cache    *memoryCache[T]
+	settings *Settings
 }
 
 func NewInMemoryKvStore[T any](_ context.Context, config cfg.Config, _ log.Logger, settings *Settings) (KvStore[T], error) {
@@ -35,6 +32,10 @@ func NewInMemoryKvStore[T any](_ context.Context, config cfg.Config, _ log.Logge
 }
 
 func NewInMemoryKvStoreWithInterfaces[T any](settings *Settings) KvStore[T] {
+	return NewMetricStore[T](newInMemoryKvStore[T](clock.Provider, settings), settings)
+}
+
+func newInMemoryKvStore[T any](clock clock.Clock, settings *Settings) *InMemoryKvStore[T] {
 	// make sure the config has some sensible values
 	if settings.MaxSize <= 0 {
 		settings.MaxSize = 5000
@@ -50,46 +51,19 @@ func NewInMemoryKvStoreWithInterfaces[T any](settings *Settings) KvStore[T] {
 		}
 		settings.Buckets = 1 << exponent
 	}
-	if settings.ItemsToPrune == 0 {
-		settings.ItemsToPrune = uint32(settings.MaxSize / 10)
-	}
-	if settings.DeleteBuffer == 0 {
-		settings.DeleteBuffer = 1024
-	}
-	if settings.PromoteBuffer == 0 {
-		settings.PromoteBuffer = 1024
-	}
-	if settings.GetsPerPromote <= 0 {
-		settings.GetsPerPromote = 3
-	}
-
-	cacheSize := new(int64)
-	trackDeletes := func(item *ccache.Item) {
-		// track how many items are still in the cache
-		atomic.AddInt64(cacheSize, -1)
+	if settings.EvictionPolicy == "" {
+		settings.EvictionPolicy = EvictionPolicyLru
 	}
 
-	cacheConfig := ccache.Configure().
-		OnDelete(trackDeletes).
-		MaxSize(settings.MaxSize).
-		Buckets(settings.Buckets).
-		ItemsToPrune(settings.ItemsToPrune).
-		DeleteBuffer(settings.DeleteBuffer).
-		PromoteBuffer(settings.PromoteBuffer).
-		GetsPerPromote(settings.GetsPerPromote)
-
 	ttl := settings.Ttl
 	if ttl == 0 {
 		ttl = time.Hour
 	}
 
-	baseCache := cache.NewWithConfiguration[T](*cacheConfig, ttl)
-
-	return NewMetricStoreWithInterfaces[T](&InMemoryKvStore[T]{
-		cache:     baseCache,
-		settings:  settings,
-		cacheSize: cacheSize,
-	}, settings)
+	return &InMemoryKvStore[T]{
+		cache:    newMemoryCache[T](clock, settings.InMemorySettings, ttl),
+		settings: settings,
+	}
 }
 
 func (s *InMemoryKvStore[T]) Contains(_ context.Context, key any) (bool, error) {
@@ -98,23 +72,31 @@ func (s *InMemoryKvStore[T]) Contains(_ context.Context, key any) (bool, error)
 		return false, fmt.Errorf("can not build string key %T %v: %w", key, key, err)
 	}
 
-	return s.cache.Contains(keyStr), nil
+	_, _, ok := s.cache.Get(keyStr)
+
+	return ok, nil
+}
+
+func (s *InMemoryKvStore[T]) Get(ctx context.Context, key any, value *T) (bool, error) {
+	found, _, err := s.GetWithTTL(ctx, key, value)
+
+	return found, err
 }
 
-func (s *InMemoryKvStore[T]) Get(_ context.Context, key any, value *T) (bool, error) {
+func (s *InMemoryKvStore[T]) GetWithTTL(_ context.Context, key any, value *T) (bool, time.Duration, error) {
 	keyStr, err := CastKeyToString(key)
 	if err != nil {
-		return false, fmt.Errorf("can not build string key %T %v: %w", key, key, err)
+		return false, 0, fmt.Errorf("can not build string key %T %v: %w", key, key, err)
 	}
 
-	item, ok := s.cache.Get(keyStr)
+	item, ttl, ok := s.cache.Get(keyStr)
 	if !ok {
-		return false, nil
+		return false, 0, nil
 	}
 
 	*value = item
 
-	return true, nil
+	return true, ttl, nil
 }
 
 func (s *InMemoryKvStore[T]) GetBatch(ctx context.Context, keys any, values any) ([]any, error) {
@@ -137,12 +119,12 @@ func (s *InMemoryKvStore[T]) getChunk(ctx context.Context, resultMap *refl.Map,
 		}
 
 		if !ok {
-			missing = append(missing, keyString)
+			missing = append(missing, key)
 
 			continue
 		}
 
-		if err := resultMap.Set(keyString, element); err != nil {
+		if err := resultMap.Set(key, element); err != nil {
 			return nil, fmt.Errorf("can not set new element on result map: %w", err)
 		}
 	}
@@ -150,15 +132,29 @@ func (s *InMemoryKvStore[T]) getChunk(ctx context.Context, resultMap *refl.Map,
 	return missing, nil
 }
 
-func (s *InMemoryKvStore[T]) Put(_ context.Context, key any, value T) error {
+func (s *InMemoryKvStore[T]) Put(ctx context.Context, key any, value T) error {
+	return s.PutWithTTL(ctx, key, value, 0)
+}
+
+func (s *InMemoryKvStore[T]) PutWithTTL(_ context.Context, key any, value T, ttl time.Duration) error {
 	keyStr, err := CastKeyToString(key)
 	if err != nil {
 		return fmt.Errorf("can not build string key %T %v: %w", key, key, err)
 	}
 
-	s.cache.Set(keyStr, value)
+	var size int64
+
+	// the size is only needed to limit the bytes of the store, so we can spare the encoding otherwise
+	if s.settings.MaxBytes > 0 {
+		bytes, err := EncodeValue(s.settings.Codec, value)
+		if err != nil {
+			return fmt.Errorf("can not marshal value %s to estimate its size: %w", keyStr, err)
+		}
+
+		size = int64(len(keyStr) + len(bytes))
+	}
 
-	atomic.AddInt64(s.cacheSize, 1)
+	s.cache.Set(keyStr, value, size, ttl)
 
 	return nil
 }
@@ -179,7 +175,11 @@ func (s *InMemoryKvStore[T]) PutBatch(ctx context.Context, values any) error {
 }
 
 func (s *InMemoryKvStore[T]) EstimateSize() *int64 {
-	return mdl.Box(atomic.LoadInt64(s.cacheSize))
+	return mdl.Box(s.cache.Size())
+}
+
+func (s *InMemoryKvStore[T]) EstimateEvictions() int64 {
+	return s.cache.Evictions()
 }
 
 func (s *InMemoryKvStore[T]) Delete(_ context.Context, key any) error {
@@ -188,7 +188,7 @@ func (s *InMemoryKvStore[T]) Delete(_ context.Context, key any) error {
 		return fmt.Errorf("can not build string key %T %v: %w", key, key, err)
 	}
 
-	s.cache.Expire(keyStr)
+	s.cache.Delete(keyStr)
 
 	return nil
 }
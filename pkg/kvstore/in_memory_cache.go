@@ -0,0 +1,283 @@
+package kvstore
+
+import (
+	"container/heap"
+	"container/list"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+type EvictionPolicy string
+
+const (
+	// EvictionPolicyLru evicts the least recently used value.
+	EvictionPolicyLru EvictionPolicy = "lru"
+	// EvictionPolicyLfu evicts the least frequently used value, ties are broken by the least recent use.
+	EvictionPolicyLfu EvictionPolicy = "lfu"
+)
+
+type memoryCacheEntry[T any] struct {
+	key       string
+	value     T
+	size      int64
+	expiresAt time.Time
+
+	// bookkeeping of the eviction order
+	element  *list.Element
+	index    int
+	hits     int64
+	lastUsed uint64
+}
+
+// evictionOrder keeps track of which entry of a shard to evict next.
+type evictionOrder[T any] interface {
+	add(entry *memoryCacheEntry[T])
+	touch(entry *memoryCacheEntry[T])
+	remove(entry *memoryCacheEntry[T])
+	victim() *memoryCacheEntry[T]
+}
+
+type lruOrder[T any] struct {
+	entries *list.List
+}
+
+func (o *lruOrder[T]) add(entry *memoryCacheEntry[T]) {
+	entry.element = o.entries.PushFront(entry)
+}
+
+func (o *lruOrder[T]) touch(entry *memoryCacheEntry[T]) {
+	o.entries.MoveToFront(entry.element)
+}
+
+func (o *lruOrder[T]) remove(entry *memoryCacheEntry[T]) {
+	o.entries.Remove(entry.element)
+}
+
+func (o *lruOrder[T]) victim() *memoryCacheEntry[T] {
+	if back := o.entries.Back(); back != nil {
+		return back.Value.(*memoryCacheEntry[T])
+	}
+
+	return nil
+}
+
+// lfuOrder is a min heap of the entries ordered by their hits and last use.
+type lfuOrder[T any] struct {
+	entries []*memoryCacheEntry[T]
+	clock   uint64
+}
+
+func (o *lfuOrder[T]) Len() int {
+	return len(o.entries)
+}
+
+func (o *lfuOrder[T]) Less(i, j int) bool {
+	if o.entries[i].hits != o.entries[j].hits {
+		return o.entries[i].hits < o.entries[j].hits
+	}
+
+	return o.entries[i].lastUsed < o.entries[j].lastUsed
+}
+
+func (o *lfuOrder[T]) Swap(i, j int) {
+	o.entries[i], o.entries[j] = o.entries[j], o.entries[i]
+	o.entries[i].index = i
+	o.entries[j].index = j
+}
+
+func (o *lfuOrder[T]) Push(x any) {
+	entry := x.(*memoryCacheEntry[T])
+	entry.index = len(o.entries)
+	o.entries = append(o.entries, entry)
+}
+
+func (o *lfuOrder[T]) Pop() any {
+	last := len(o.entries) - 1
+	entry := o.entries[last]
+	o.entries[last] = nil
+	o.entries = o.entries[:last]
+
+	return entry
+}
+
+func (o *lfuOrder[T]) add(entry *memoryCacheEntry[T]) {
+	o.clock++
+	entry.lastUsed = o.clock
+	heap.Push(o, entry)
+}
+
+func (o *lfuOrder[T]) touch(entry *memoryCacheEntry[T]) {
+	o.clock++
+	entry.hits++
+	entry.lastUsed = o.clock
+	heap.Fix(o, entry.index)
+}
+
+func (o *lfuOrder[T]) remove(entry *memoryCacheEntry[T]) {
+	heap.Remove(o, entry.index)
+}
+
+func (o *lfuOrder[T]) victim() *memoryCacheEntry[T] {
+	if len(o.entries) == 0 {
+		return nil
+	}
+
+	return o.entries[0]
+}
+
+type memoryCacheShard[T any] struct {
+	lck        sync.Mutex
+	entries    map[string]*memoryCacheEntry[T]
+	order      evictionOrder[T]
+	bytes      int64
+	maxEntries int64
+	maxBytes   int64
+}
+
+// memoryCache is split into shards with a lock each, so concurrent access to different keys rarely blocks. Every shard
+// holds at most its share of the entries and bytes and evicts values according to the eviction policy.
+type memoryCache[T any] struct {
+	clock     clock.Clock
+	shards    []*memoryCacheShard[T]
+	ttl       time.Duration
+	size      int64
+	evictions int64
+}
+
+func newMemoryCache[T any](clock clock.Clock, settings InMemorySettings, ttl time.Duration) *memoryCache[T] {
+	c := &memoryCache[T]{
+		clock:  clock,
+		shards: make([]*memoryCacheShard[T], settings.Buckets),
+		ttl:    ttl,
+	}
+
+	shardCount := int64(settings.Buckets)
+
+	for i := range c.shards {
+		var order evictionOrder[T] = &lruOrder[T]{entries: list.New()}
+		if settings.EvictionPolicy == EvictionPolicyLfu {
+			order = &lfuOrder[T]{}
+		}
+
+		c.shards[i] = &memoryCacheShard[T]{
+			entries:    make(map[string]*memoryCacheEntry[T]),
+			order:      order,
+			maxEntries: max((settings.MaxSize+shardCount-1)/shardCount, 1),
+			maxBytes:   settings.MaxBytes / shardCount,
+		}
+	}
+
+	return c
+}
+
+func (c *memoryCache[T]) shard(key string) *memoryCacheShard[T] {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key))
+
+	// the count of shards is a power of two
+	return c.shards[hash.Sum32()&uint32(len(c.shards)-1)]
+}
+
+func (c *memoryCache[T]) Get(key string) (T, time.Duration, bool) {
+	shard := c.shard(key)
+	shard.lck.Lock()
+	defer shard.lck.Unlock()
+
+	entry, ok := shard.entries[key]
+	if !ok {
+		return *new(T), 0, false
+	}
+
+	ttl := entry.expiresAt.Sub(c.clock.Now())
+	if ttl <= 0 {
+		c.removeEntry(shard, entry)
+
+		return *new(T), 0, false
+	}
+
+	shard.order.touch(entry)
+
+	return entry.value, ttl, true
+}
+
+// Set stores the value with the given size in bytes. A ttl of 0 uses the default ttl of the cache.
+func (c *memoryCache[T]) Set(key string, value T, size int64, ttl time.Duration) {
+	if ttl == 0 {
+		ttl = c.ttl
+	}
+
+	shard := c.shard(key)
+	shard.lck.Lock()
+	defer shard.lck.Unlock()
+
+	if entry, ok := shard.entries[key]; ok {
+		c.removeEntry(shard, entry)
+	}
+
+	// make room before adding the entry, so a new entry is never evicted right away
+	for c.exceedsLimits(shard, 1, size) {
+		victim := shard.order.victim()
+		if victim == nil {
+			break
+		}
+
+		// expired entries are removed either way and don't count as evictions
+		if victim.expiresAt.After(c.clock.Now()) {
+			atomic.AddInt64(&c.evictions, 1)
+		}
+
+		c.removeEntry(shard, victim)
+	}
+
+	entry := &memoryCacheEntry[T]{
+		key:       key,
+		value:     value,
+		size:      size,
+		expiresAt: c.clock.Now().Add(ttl),
+	}
+
+	shard.entries[key] = entry
+	shard.order.add(entry)
+	shard.bytes += size
+	atomic.AddInt64(&c.size, 1)
+}
+
+func (c *memoryCache[T]) Delete(key string) {
+	shard := c.shard(key)
+	shard.lck.Lock()
+	defer shard.lck.Unlock()
+
+	if entry, ok := shard.entries[key]; ok {
+		c.removeEntry(shard, entry)
+	}
+}
+
+// Size returns the count of entries in the cache, including expired entries not yet removed.
+func (c *memoryCache[T]) Size() int64 {
+	return atomic.LoadInt64(&c.size)
+}
+
+// Evictions returns the count of entries removed to stay within the limits since the cache was created.
+func (c *memoryCache[T]) Evictions() int64 {
+	return atomic.LoadInt64(&c.evictions)
+}
+
+// exceedsLimits reports if the shard would hold too many entries or bytes after adding the given entries and bytes.
+func (c *memoryCache[T]) exceedsLimits(shard *memoryCacheShard[T], entries int64, bytes int64) bool {
+	if int64(len(shard.entries))+entries > shard.maxEntries {
+		return true
+	}
+
+	return shard.maxBytes > 0 && shard.bytes+bytes > shard.maxBytes
+}
+
+func (c *memoryCache[T]) removeEntry(shard *memoryCacheShard[T], entry *memoryCacheEntry[T]) {
+	delete(shard.entries, entry.key)
+	shard.order.remove(entry)
+	shard.bytes -= entry.size
+	atomic.AddInt64(&c.size, -1)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/justtrackio/gosoline/pkg/kvstore"
 	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -96,6 +97,124 @@ func (s *InMemoryKvStoreTestSuite) TestStoreStruct() {
 	s.Equal("d", missing[0], "element d should be missing")
 }
 
+func (s *InMemoryKvStoreTestSuite) TestStoreWithTTL() {
+	ctx := s.T().Context()
+
+	err := s.floatStore.PutWithTTL(ctx, "key", 1.1, time.Minute)
+	s.NoError(err, "there should be no error on PutWithTTL")
+
+	var v float64
+	ok, ttl, err := s.floatStore.GetWithTTL(ctx, "key", &v)
+	s.NoError(err, "there should be no error on GetWithTTL")
+	s.True(ok, "the item should be in the store")
+	s.Equal(1.1, v)
+	s.InDelta(time.Minute, ttl, float64(time.Second), "the item should expire after a minute")
+
+	err = s.floatStore.PutWithTTL(ctx, "key", 1.2, 0)
+	s.NoError(err, "there should be no error on PutWithTTL")
+
+	ok, ttl, err = s.floatStore.GetWithTTL(ctx, "key", &v)
+	s.NoError(err, "there should be no error on GetWithTTL")
+	s.True(ok, "the item should be in the store")
+	s.InDelta(time.Hour, ttl, float64(time.Second), "the item should expire after the default ttl")
+
+	ok, _, err = s.floatStore.GetWithTTL(ctx, "missing", &v)
+	s.NoError(err, "there should be no error on GetWithTTL")
+	s.False(ok, "the item should be missing the store")
+}
+
+func (s *InMemoryKvStoreTestSuite) TestTypedBatch() {
+	ctx := s.T().Context()
+
+	err := kvstore.PutBatch(ctx, s.floatStore, map[int]float64{
+		1: 1.1,
+		2: 2.2,
+	})
+	s.NoError(err, "there should be no error on PutBatch")
+
+	values, missing, err := kvstore.GetBatch(ctx, s.floatStore, []int{1, 2, 3, 2})
+	s.NoError(err, "there should be no error on GetBatch")
+	s.Equal(map[int]float64{1: 1.1, 2: 2.2}, values)
+	s.Equal([]int{3}, missing)
+}
+
+func (s *InMemoryKvStoreTestSuite) TestEvictionLru() {
+	ctx := s.T().Context()
+	store := kvstore.NewInMemoryKvStoreWithInterfaces[int](&kvstore.Settings{
+		InMemorySettings: kvstore.InMemorySettings{
+			MaxSize: 2,
+			Buckets: 1,
+		},
+	})
+
+	s.NoError(store.Put(ctx, "a", 1))
+	s.NoError(store.Put(ctx, "b", 2))
+
+	// a is used more recently than b now
+	var v int
+	found, err := store.Get(ctx, "a", &v)
+	s.NoError(err)
+	s.True(found)
+
+	s.NoError(store.Put(ctx, "c", 3))
+
+	assertStoreKeys(s.T(), store, map[string]bool{"a": true, "b": false, "c": true})
+	s.Equal(int64(1), store.(kvstore.EvictingStore).EstimateEvictions())
+	s.Equal(int64(2), *store.(kvstore.SizedStore[int]).EstimateSize())
+}
+
+func (s *InMemoryKvStoreTestSuite) TestEvictionLfu() {
+	ctx := s.T().Context()
+	store := kvstore.NewInMemoryKvStoreWithInterfaces[int](&kvstore.Settings{
+		InMemorySettings: kvstore.InMemorySettings{
+			MaxSize:        2,
+			Buckets:        1,
+			EvictionPolicy: kvstore.EvictionPolicyLfu,
+		},
+	})
+
+	s.NoError(store.Put(ctx, "a", 1))
+	s.NoError(store.Put(ctx, "b", 2))
+
+	// a is used more often, but b more recently
+	var v int
+	for _, key := range []string{"a", "a", "b"} {
+		found, err := store.Get(ctx, key, &v)
+		s.NoError(err)
+		s.True(found)
+	}
+
+	s.NoError(store.Put(ctx, "c", 3))
+
+	assertStoreKeys(s.T(), store, map[string]bool{"a": true, "b": false, "c": true})
+}
+
+func (s *InMemoryKvStoreTestSuite) TestEvictionMaxBytes() {
+	ctx := s.T().Context()
+	store := kvstore.NewInMemoryKvStoreWithInterfaces[string](&kvstore.Settings{
+		InMemorySettings: kvstore.InMemorySettings{
+			MaxSize:  100,
+			MaxBytes: 20,
+			Buckets:  1,
+		},
+	})
+
+	// every entry takes 1 byte for the key and 7 bytes for the quoted value
+	s.NoError(store.Put(ctx, "a", "value"))
+	s.NoError(store.Put(ctx, "b", "value"))
+	s.NoError(store.Put(ctx, "c", "value"))
+
+	assertStoreKeys(s.T(), store, map[string]bool{"a": false, "b": true, "c": true})
+}
+
+func assertStoreKeys[T any](t *testing.T, store kvstore.KvStore[T], expected map[string]bool) {
+	for key, exists := range expected {
+		found, err := store.Contains(t.Context(), key)
+		assert.NoError(t, err)
+		assert.Equal(t, exists, found, "unexpected existence of key %s", key)
+	}
+}
+
 func TestInMemoryKvStoreTestSuite(t *testing.T) {
 	suite.Run(t, new(InMemoryKvStoreTestSuite))
 }
@@ -15,20 +15,27 @@ import (
 type Settings struct {
 	mdl.ModelId
 	InMemorySettings
-	DdbSettings    DdbSettings
-	RedisSettings  RedisSettings
-	Ttl            time.Duration
+	DdbSettings   DdbSettings
+	RedisSettings RedisSettings
+	// Ttl is the default ttl of values written with Put or PutBatch, 0 lets values not expire (the in-memory store
+	// falls back to one hour).
+	Ttl time.Duration
+	// MissingCacheTtl is the ttl of keys in the missing value cache of a chain, defaults to Ttl.
+	MissingCacheTtl time.Duration
+	// Codec used to encode the values, values are decoded with the codec they were written with.
+	Codec          Codec
 	BatchSize      int
 	MetricsEnabled bool
 }
 
 type InMemorySettings struct {
-	MaxSize        int64
+	// MaxSize is the maximum count of values in the store.
+	MaxSize int64
+	// MaxBytes is the maximum size of the values in the store in bytes, 0 disables the limit.
+	MaxBytes int64
+	// Buckets is the count of shards the store is split into, it has to be a power of two.
 	Buckets        uint32
-	ItemsToPrune   uint32
-	DeleteBuffer   uint32
-	PromoteBuffer  uint32
-	GetsPerPromote int32
+	EvictionPolicy EvictionPolicy
 }
 
 type DdbSettings struct {
@@ -47,14 +54,24 @@ type KvStore[T any] interface {
 	// not exist, false is returned and value is not modified.
 	// value should be a pointer to the model you want to retrieve.
 	Get(ctx context.Context, key any, value *T) (bool, error)
+	// Retrieve a value from the store like Get and additionally return
+	// its remaining lifetime. A lifetime of 0 means the value does not expire.
+	GetWithTTL(ctx context.Context, key any, value *T) (bool, time.Duration, error)
 	// Retrieve a set of values from the store. Each value is written to the
 	// map in values at its key.  Values should be something which can be converted to map[any]T.
 	// Returns a list of missing keys in the store.
+	//
+	// Deprecated: use the GetBatch function instead, which returns a typed map of values and missing keys.
 	GetBatch(ctx context.Context, keys any, values any) ([]any, error)
 	// Write a value to the store
 	Put(ctx context.Context, key any, value T) error
+	// Write a value to the store which expires after the given ttl. A ttl
+	// of 0 uses the default ttl of the store.
+	PutWithTTL(ctx context.Context, key any, value T, ttl time.Duration) error
 	// Write a batch of values to the store. Values should be something which
 	// can be converted to map[any]T.
+	//
+	// Deprecated: use the PutBatch function instead, which takes the values as typed map.
 	PutBatch(ctx context.Context, values any) error
 	// Remove the value with the given key from the store
 	Delete(ctx context.Context, key any) error
@@ -70,6 +87,12 @@ type SizedStore[T any] interface {
 	EstimateSize() *int64
 }
 
+// EvictingStore is implemented by stores removing values before they expire to stay within their limits.
+type EvictingStore interface {
+	// return the count of values evicted since the store was created
+	EstimateEvictions() int64
+}
+
 type Factory[T any] func(elementFactory ElementFactory[T], settings *Settings) (KvStore[T], error)
 
 type ElementFactory[T any] func(ctx context.Context, config cfg.Config, logger log.Logger, settings *Settings) (KvStore[T], error)
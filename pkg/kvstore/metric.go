@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/justtrackio/gosoline/pkg/clock"
 	"github.com/justtrackio/gosoline/pkg/metric"
 	"github.com/justtrackio/gosoline/pkg/refl"
 )
@@ -12,98 +13,156 @@ import (
 const (
 	// number of items stored in the store (if available)
 	metricNameKvStoreSize = "kvStoreSize"
+	// number of items evicted from the store to stay within its limits (if available)
+	metricNameKvStoreEviction = "kvStoreEviction"
 	// number of items we try to read from the store
 	metricNameKvStoreRead = "kvStoreRead"
 	// number of items found and read from the store
 	metricNameKvStoreHit = "kvStoreHit"
+	// number of items we tried to read, but which were not in the store
+	metricNameKvStoreMiss = "kvStoreMiss"
+	// percentage of the read items which were found in the store
+	metricNameKvStoreHitRatio = "kvStoreHitRatio"
+	// duration of an operation in milliseconds
+	metricNameKvStoreLatency = "kvStoreLatency"
 	// number of items written to the store
 	metricNameKvStoreWrite = "kvStoreWrite"
 	// number of items deleted from the store
 	metricNameKvStoreDelete = "kvStoreDelete"
+	// number of items we try to read from a layer of a chain
+	metricNameKvStoreLayerRead = "kvStoreLayerRead"
+	// number of items found in a layer of a chain
+	metricNameKvStoreLayerHit = "kvStoreLayerHit"
+	// number of items not found in a layer of a chain
+	metricNameKvStoreLayerMiss = "kvStoreLayerMiss"
+	// percentage of the items read from a layer of a chain which were found in it
+	metricNameKvStoreLayerHitRatio = "kvStoreLayerHitRatio"
+	// number of failed writes to a layer of a chain which didn't fail the write to the chain
+	metricNameKvStoreLayerWriteError = "kvStoreLayerWriteError"
 )
 
 type MetricStore[T any] struct {
 	KvStore[T]
 	metricWriter metric.Writer
+	clock        clock.Clock
 	model        string
 	store        string
 }
 
-func NewMetricStoreWithInterfaces[T any](store KvStore[T], settings *Settings) KvStore[T] {
+// NewMetricStore wraps the store with a MetricStore if metrics are enabled in the settings.
+func NewMetricStore[T any](store KvStore[T], settings *Settings) KvStore[T] {
 	if !settings.MetricsEnabled {
 		return store
 	}
 
-	modelIdString := settings.String()
-	storeName := fmt.Sprintf("%T", store)
-	defaults := getDefaultMetrics(modelIdString, storeName)
+	defaults := getDefaultMetrics(settings.String(), storeName(store))
+	metricWriter := metric.NewWriter(defaults...)
 
+	return NewMetricStoreWithInterfaces(store, metricWriter, clock.Provider, settings)
+}
+
+// NewMetricStoreWithInterfaces creates a store writing the reads, hits, misses, writes, deletes and the latency of every
+// operation of the wrapped store. The size and the evictions of the store are written every minute if the store
+// implements SizedStore or EvictingStore.
+func NewMetricStoreWithInterfaces[T any](store KvStore[T], metricWriter metric.Writer, clock clock.Clock, settings *Settings) *MetricStore[T] {
 	s := &MetricStore[T]{
 		KvStore:      store,
-		metricWriter: metric.NewWriter(defaults...),
-		model:        modelIdString,
-		store:        storeName,
+		metricWriter: metricWriter,
+		clock:        clock,
+		model:        settings.String(),
+		store:        storeName(store),
 	}
 
-	if sizedStore, ok := store.(SizedStore[T]); ok {
-		go s.recordSize(sizedStore)
+	sizedStore, _ := store.(SizedStore[T])
+	evictingStore, _ := store.(EvictingStore)
+
+	if sizedStore != nil || evictingStore != nil {
+		go s.recordStats(sizedStore, evictingStore)
 	}
 
 	return s
 }
 
 func (s *MetricStore[T]) Contains(ctx context.Context, key any) (bool, error) {
-	s.recordReads(ctx, 1)
+	defer s.recordLatency(ctx, "Contains", s.clock.Now())
 
 	found, err := s.KvStore.Contains(ctx, key)
 
-	if found && err == nil {
-		s.recordHits(ctx, 1)
+	if err == nil {
+		s.recordReads(ctx, 1, found)
 	}
 
 	return found, err
 }
 
 func (s *MetricStore[T]) Get(ctx context.Context, key any, value *T) (bool, error) {
-	s.recordReads(ctx, 1)
+	defer s.recordLatency(ctx, "Get", s.clock.Now())
 
 	found, err := s.KvStore.Get(ctx, key, value)
 
-	if found && err == nil {
-		s.recordHits(ctx, 1)
+	if err == nil {
+		s.recordReads(ctx, 1, found)
 	}
 
 	return found, err
 }
 
+func (s *MetricStore[T]) GetWithTTL(ctx context.Context, key any, value *T) (bool, time.Duration, error) {
+	defer s.recordLatency(ctx, "GetWithTTL", s.clock.Now())
+
+	found, ttl, err := s.KvStore.GetWithTTL(ctx, key, value)
+
+	if err == nil {
+		s.recordReads(ctx, 1, found)
+	}
+
+	return found, ttl, err
+}
+
 func (s *MetricStore[T]) GetBatch(ctx context.Context, keys any, result any) ([]any, error) {
+	defer s.recordLatency(ctx, "GetBatch", s.clock.Now())
+
 	keySlice, err := refl.InterfaceToInterfaceSlice(keys)
 	if err != nil {
 		return nil, fmt.Errorf("can not morph keys to slice of interfaces: %w", err)
 	}
 
-	s.recordReads(ctx, len(keySlice))
-
 	missing, err := s.KvStore.GetBatch(ctx, keySlice, result)
 
 	if err == nil {
-		s.recordHits(ctx, len(keySlice)-len(missing))
+		s.recordBatchReads(ctx, len(keySlice), len(keySlice)-len(missing))
 	}
 
 	return missing, err
 }
 
 func (s *MetricStore[T]) Put(ctx context.Context, key any, value T) error {
+	defer s.recordLatency(ctx, "Put", s.clock.Now())
+
 	err := s.KvStore.Put(ctx, key, value)
 
 	if err == nil {
 		s.recordWrites(ctx, 1)
 	}
 
-	return nil
+	return err
+}
+
+func (s *MetricStore[T]) PutWithTTL(ctx context.Context, key any, value T, ttl time.Duration) error {
+	defer s.recordLatency(ctx, "PutWithTTL", s.clock.Now())
+
+	err := s.KvStore.PutWithTTL(ctx, key, value, ttl)
+
+	if err == nil {
+		s.recordWrites(ctx, 1)
+	}
+
+	return err
 }
 
 func (s *MetricStore[T]) PutBatch(ctx context.Context, values any) error {
+	defer s.recordLatency(ctx, "PutBatch", s.clock.Now())
+
 	mii, err := refl.InterfaceToMapInterfaceInterface(values)
 	if err != nil {
 		return fmt.Errorf("could not convert values to map[any]any: %w", err)
@@ -115,10 +174,12 @@ func (s *MetricStore[T]) PutBatch(ctx context.Context, values any) error {
 		s.recordWrites(ctx, len(mii))
 	}
 
-	return nil
+	return err
 }
 
 func (s *MetricStore[T]) Delete(ctx context.Context, key any) error {
+	defer s.recordLatency(ctx, "Delete", s.clock.Now())
+
 	err := s.KvStore.Delete(ctx, key)
 
 	if err == nil {
@@ -129,6 +190,8 @@ func (s *MetricStore[T]) Delete(ctx context.Context, key any) error {
 }
 
 func (s *MetricStore[T]) DeleteBatch(ctx context.Context, keys any) error {
+	defer s.recordLatency(ctx, "DeleteBatch", s.clock.Now())
+
 	si, err := refl.InterfaceToInterfaceSlice(keys)
 	if err != nil {
 		return fmt.Errorf("could not convert keys from %T to []any: %w", keys, err)
@@ -143,35 +206,67 @@ func (s *MetricStore[T]) DeleteBatch(ctx context.Context, keys any) error {
 	return err
 }
 
-func (s *MetricStore[T]) recordSize(sizedStore SizedStore[T]) {
+func (s *MetricStore[T]) recordStats(sizedStore SizedStore[T], evictingStore EvictingStore) {
 	ticker := time.NewTicker(time.Minute)
+	evictions := int64(0)
 
 	for range ticker.C {
-		size := sizedStore.EstimateSize()
+		if sizedStore != nil {
+			if size := sizedStore.EstimateSize(); size != nil {
+				s.record(context.Background(), metricNameKvStoreSize, float64(*size), metric.UnitCount)
+			}
+		}
 
-		if size != nil {
-			s.record(context.Background(), metricNameKvStoreSize, *size)
+		if evictingStore != nil {
+			total := evictingStore.EstimateEvictions()
+			s.record(context.Background(), metricNameKvStoreEviction, float64(total-evictions), metric.UnitCount)
+			evictions = total
 		}
 	}
 }
 
-func (s *MetricStore[T]) recordReads(ctx context.Context, count int) {
-	s.record(ctx, metricNameKvStoreRead, int64(count))
+func (s *MetricStore[T]) recordReads(ctx context.Context, count int, found bool) {
+	hits := 0
+	if found {
+		hits = count
+	}
+
+	s.recordBatchReads(ctx, count, hits)
 }
 
-func (s *MetricStore[T]) recordHits(ctx context.Context, count int) {
-	s.record(ctx, metricNameKvStoreHit, int64(count))
+func (s *MetricStore[T]) recordBatchReads(ctx context.Context, count int, hits int) {
+	s.record(ctx, metricNameKvStoreRead, float64(count), metric.UnitCount)
+	s.record(ctx, metricNameKvStoreHit, float64(hits), metric.UnitCount)
+	s.record(ctx, metricNameKvStoreMiss, float64(count-hits), metric.UnitCount)
+
+	if count > 0 {
+		s.record(ctx, metricNameKvStoreHitRatio, hitRatio(count, hits), metric.UnitCountAverage)
+	}
 }
 
 func (s *MetricStore[T]) recordWrites(ctx context.Context, count int) {
-	s.record(ctx, metricNameKvStoreWrite, int64(count))
+	s.record(ctx, metricNameKvStoreWrite, float64(count), metric.UnitCount)
 }
 
 func (s *MetricStore[T]) recordDeletes(ctx context.Context, count int) {
-	s.record(ctx, metricNameKvStoreDelete, int64(count))
+	s.record(ctx, metricNameKvStoreDelete, float64(count), metric.UnitCount)
 }
 
-func (s *MetricStore[T]) record(ctx context.Context, name string, value int64) {
+func (s *MetricStore[T]) recordLatency(ctx context.Context, operation string, start time.Time) {
+	s.metricWriter.WriteOne(ctx, &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: metricNameKvStoreLatency,
+		Dimensions: map[string]string{
+			"model":     s.model,
+			"store":     s.store,
+			"operation": operation,
+		},
+		Value: float64(s.clock.Since(start)) / float64(time.Millisecond),
+		Unit:  metric.UnitMillisecondsAverage,
+	})
+}
+
+func (s *MetricStore[T]) record(ctx context.Context, name string, value float64, unit metric.StandardUnit) {
 	s.metricWriter.WriteOne(ctx, &metric.Datum{
 		Priority:   metric.PriorityHigh,
 		MetricName: name,
@@ -179,54 +274,96 @@ func (s *MetricStore[T]) record(ctx context.Context, name string, value int64) {
 			"model": s.model,
 			"store": s.store,
 		},
-		Value: float64(value),
-		Unit:  metric.UnitCount,
+		Value: value,
+		Unit:  unit,
 	})
 }
 
+func storeName(store any) string {
+	return fmt.Sprintf("%T", store)
+}
+
+// hitRatio returns the percentage of the reads which were hits. The values are averaged, so the ratio of a period is
+// the average ratio of the operations in it.
+func hitRatio(count int, hits int) float64 {
+	return 100 * float64(hits) / float64(count)
+}
+
 func getDefaultMetrics(model string, store string) metric.Data {
 	// no default for the size, if we don't know the size, it is not 0
+	// no default for the latency and hit ratio either, there is no meaningful value without any operation
 
-	return metric.Data{
-		{
-			Priority:   metric.PriorityHigh,
-			MetricName: metricNameKvStoreRead,
-			Dimensions: map[string]string{
-				"model": model,
-				"store": store,
-			},
-			Unit:  metric.UnitCount,
-			Value: 0.0,
-		},
-		{
-			Priority:   metric.PriorityHigh,
-			MetricName: metricNameKvStoreHit,
-			Dimensions: map[string]string{
-				"model": model,
-				"store": store,
-			},
-			Unit:  metric.UnitCount,
-			Value: 0.0,
-		},
-		{
-			Priority:   metric.PriorityHigh,
-			MetricName: metricNameKvStoreWrite,
-			Dimensions: map[string]string{
-				"model": model,
-				"store": store,
-			},
-			Unit:  metric.UnitCount,
-			Value: 0.0,
-		},
-		{
+	data := make(metric.Data, 0)
+
+	for _, name := range []string{metricNameKvStoreRead, metricNameKvStoreHit, metricNameKvStoreMiss, metricNameKvStoreWrite, metricNameKvStoreDelete} {
+		data = append(data, &metric.Datum{
 			Priority:   metric.PriorityHigh,
-			MetricName: metricNameKvStoreDelete,
+			MetricName: name,
 			Dimensions: map[string]string{
 				"model": model,
 				"store": store,
 			},
 			Unit:  metric.UnitCount,
 			Value: 0.0,
-		},
+		})
+	}
+
+	return data
+}
+
+// chainMetrics records the metrics of the layers of a chain. It does nothing if metrics are disabled.
+type chainMetrics struct {
+	metricWriter metric.Writer
+	model        string
+}
+
+func newChainMetrics(settings *Settings) *chainMetrics {
+	if !settings.MetricsEnabled {
+		return &chainMetrics{}
 	}
+
+	return &chainMetrics{
+		metricWriter: metric.NewWriter(),
+		model:        settings.String(),
+	}
+}
+
+func (m *chainMetrics) recordRead(ctx context.Context, layer string, count int, found bool) {
+	hits := 0
+	if found {
+		hits = count
+	}
+
+	m.recordBatchRead(ctx, layer, count, hits)
+}
+
+func (m *chainMetrics) recordBatchRead(ctx context.Context, layer string, count int, hits int) {
+	m.record(ctx, metricNameKvStoreLayerRead, layer, float64(count), metric.UnitCount)
+	m.record(ctx, metricNameKvStoreLayerHit, layer, float64(hits), metric.UnitCount)
+	m.record(ctx, metricNameKvStoreLayerMiss, layer, float64(count-hits), metric.UnitCount)
+
+	if count > 0 {
+		m.record(ctx, metricNameKvStoreLayerHitRatio, layer, hitRatio(count, hits), metric.UnitCountAverage)
+	}
+}
+
+func (m *chainMetrics) recordWriteError(ctx context.Context, layer string) {
+	m.record(ctx, metricNameKvStoreLayerWriteError, layer, 1, metric.UnitCount)
+}
+
+func (m *chainMetrics) record(ctx context.Context, name string, layer string, value float64, unit metric.StandardUnit) {
+	if m.metricWriter == nil {
+		return
+	}
+
+	m.metricWriter.WriteOne(ctx, &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: name,
+		Dimensions: map[string]string{
+			"model": m.model,
+			"layer": layer,
+		},
+		Value: value,
+		Unit:  unit,
+	})
 }
@@ -0,0 +1,121 @@
+package kvstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/kvstore"
+	kvStoreMocks "github.com/justtrackio/gosoline/pkg/kvstore/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MetricStoreTestSuite struct {
+	suite.Suite
+
+	ctx          context.Context
+	clock        clock.FakeClock
+	metricWriter *metricMocks.Writer
+	base         *kvStoreMocks.KvStore[string]
+	store        kvstore.KvStore[string]
+}
+
+func TestMetricStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(MetricStoreTestSuite))
+}
+
+func (s *MetricStoreTestSuite) SetupTest() {
+	s.ctx = s.T().Context()
+	s.clock = clock.NewFakeClock()
+	s.metricWriter = metricMocks.NewWriter(s.T())
+	s.base = kvStoreMocks.NewKvStore[string](s.T())
+	s.store = kvstore.NewMetricStoreWithInterfaces[string](s.base, s.metricWriter, s.clock, &kvstore.Settings{
+		ModelId: mdl.ModelId{
+			Name: "test",
+		},
+		MetricsEnabled: true,
+	})
+}
+
+func (s *MetricStoreTestSuite) TestGet() {
+	s.base.EXPECT().Get(matcher.Context, "foo", mock.AnythingOfType("*string")).Run(func(ctx context.Context, key any, value *string) {
+		s.clock.Advance(5 * time.Millisecond)
+		*value = "bar"
+	}).Return(true, nil).Once()
+
+	s.expectCount("kvStoreRead", 1)
+	s.expectCount("kvStoreHit", 1)
+	s.expectCount("kvStoreMiss", 0)
+	s.expect("kvStoreHitRatio", 100, metric.UnitCountAverage)
+	s.expectLatency("Get", 5)
+
+	var value string
+	found, err := s.store.Get(s.ctx, "foo", &value)
+	s.NoError(err)
+	s.True(found)
+	s.Equal("bar", value)
+}
+
+func (s *MetricStoreTestSuite) TestGetBatch() {
+	keys := []any{"a", "b", "c", "d"}
+	values := map[string]string{}
+
+	s.base.EXPECT().GetBatch(matcher.Context, keys, values).Return([]any{"d"}, nil).Once()
+
+	s.expectCount("kvStoreRead", 4)
+	s.expectCount("kvStoreHit", 3)
+	s.expectCount("kvStoreMiss", 1)
+	s.expect("kvStoreHitRatio", 75, metric.UnitCountAverage)
+	s.expectLatency("GetBatch", 0)
+
+	missing, err := s.store.GetBatch(s.ctx, keys, values)
+	s.NoError(err)
+	s.Equal([]any{"d"}, missing)
+}
+
+func (s *MetricStoreTestSuite) TestPutError() {
+	s.base.EXPECT().Put(matcher.Context, "foo", "bar").Return(assert.AnError).Once()
+
+	s.expectLatency("Put", 0)
+
+	err := s.store.Put(s.ctx, "foo", "bar")
+	s.Equal(assert.AnError, err)
+}
+
+func (s *MetricStoreTestSuite) expectCount(name string, value float64) {
+	s.expect(name, value, metric.UnitCount)
+}
+
+func (s *MetricStoreTestSuite) expect(name string, value float64, unit metric.StandardUnit) {
+	s.metricWriter.EXPECT().WriteOne(matcher.Context, &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: name,
+		Dimensions: map[string]string{
+			"model": ".test",
+			"store": "*mocks.KvStore[string]",
+		},
+		Value: value,
+		Unit:  unit,
+	}).Once()
+}
+
+func (s *MetricStoreTestSuite) expectLatency(operation string, value float64) {
+	s.metricWriter.EXPECT().WriteOne(matcher.Context, &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: "kvStoreLatency",
+		Dimensions: map[string]string{
+			"model":     ".test",
+			"store":     "*mocks.KvStore[string]",
+			"operation": operation,
+		},
+		Value: value,
+		Unit:  metric.UnitMillisecondsAverage,
+	}).Once()
+}
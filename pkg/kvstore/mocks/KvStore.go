@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // KvStore is an autogenerated mock type for the KvStore type
@@ -290,6 +292,71 @@ func (_c *KvStore_GetBatch_Call[T]) RunAndReturn(run func(context.Context, inter
 	return _c
 }
 
+// GetWithTTL provides a mock function with given fields: ctx, key, value
+func (_m *KvStore[T]) GetWithTTL(ctx context.Context, key interface{}, value *T) (bool, time.Duration, error) {
+	ret := _m.Called(ctx, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithTTL")
+	}
+
+	var r0 bool
+	var r1 time.Duration
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, *T) (bool, time.Duration, error)); ok {
+		return rf(ctx, key, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, *T) bool); ok {
+		r0 = rf(ctx, key, value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, *T) time.Duration); ok {
+		r1 = rf(ctx, key, value)
+	} else {
+		r1 = ret.Get(1).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, interface{}, *T) error); ok {
+		r2 = rf(ctx, key, value)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// KvStore_GetWithTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithTTL'
+type KvStore_GetWithTTL_Call[T interface{}] struct {
+	*mock.Call
+}
+
+// GetWithTTL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key interface{}
+//   - value *T
+func (_e *KvStore_Expecter[T]) GetWithTTL(ctx interface{}, key interface{}, value interface{}) *KvStore_GetWithTTL_Call[T] {
+	return &KvStore_GetWithTTL_Call[T]{Call: _e.mock.On("GetWithTTL", ctx, key, value)}
+}
+
+func (_c *KvStore_GetWithTTL_Call[T]) Run(run func(ctx context.Context, key interface{}, value *T)) *KvStore_GetWithTTL_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(*T))
+	})
+	return _c
+}
+
+func (_c *KvStore_GetWithTTL_Call[T]) Return(_a0 bool, _a1 time.Duration, _a2 error) *KvStore_GetWithTTL_Call[T] {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *KvStore_GetWithTTL_Call[T]) RunAndReturn(run func(context.Context, interface{}, *T) (bool, time.Duration, error)) *KvStore_GetWithTTL_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Put provides a mock function with given fields: ctx, key, value
 func (_m *KvStore[T]) Put(ctx context.Context, key interface{}, value T) error {
 	ret := _m.Called(ctx, key, value)
@@ -385,6 +452,55 @@ func (_c *KvStore_PutBatch_Call[T]) RunAndReturn(run func(context.Context, inter
 	return _c
 }
 
+// PutWithTTL provides a mock function with given fields: ctx, key, value, ttl
+func (_m *KvStore[T]) PutWithTTL(ctx context.Context, key interface{}, value T, ttl time.Duration) error {
+	ret := _m.Called(ctx, key, value, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutWithTTL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, T, time.Duration) error); ok {
+		r0 = rf(ctx, key, value, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KvStore_PutWithTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutWithTTL'
+type KvStore_PutWithTTL_Call[T interface{}] struct {
+	*mock.Call
+}
+
+// PutWithTTL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key interface{}
+//   - value T
+//   - ttl time.Duration
+func (_e *KvStore_Expecter[T]) PutWithTTL(ctx interface{}, key interface{}, value interface{}, ttl interface{}) *KvStore_PutWithTTL_Call[T] {
+	return &KvStore_PutWithTTL_Call[T]{Call: _e.mock.On("PutWithTTL", ctx, key, value, ttl)}
+}
+
+func (_c *KvStore_PutWithTTL_Call[T]) Run(run func(ctx context.Context, key interface{}, value T, ttl time.Duration)) *KvStore_PutWithTTL_Call[T] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(T), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *KvStore_PutWithTTL_Call[T]) Return(_a0 error) *KvStore_PutWithTTL_Call[T] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KvStore_PutWithTTL_Call[T]) RunAndReturn(run func(context.Context, interface{}, T, time.Duration) error) *KvStore_PutWithTTL_Call[T] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewKvStore creates a new instance of KvStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewKvStore[T interface{}](t interface {
@@ -0,0 +1,294 @@
+package kvstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/refl"
+)
+
+type NamespaceSettings struct {
+	// Name of the namespace, an empty name disables namespacing.
+	Name string `cfg:"name"`
+	// Version the namespace starts with. Increasing it invalidates all values written with an older version.
+	Version int64 `cfg:"version" default:"1"`
+	// VersionCacheTtl is how long an instance uses the version it read from the store before reading it again.
+	VersionCacheTtl time.Duration `cfg:"version_cache_ttl" default:"1s"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name NamespacedKvStore
+type NamespacedKvStore[T any] interface {
+	KvStore[T]
+	// Version returns the current version of the namespace.
+	Version(ctx context.Context) (int64, error)
+	// BumpVersion switches all instances sharing the store to the next version of the namespace and returns it.
+	// All values written with the previous version are not visible anymore and expire with their ttl.
+	BumpVersion(ctx context.Context) (int64, error)
+}
+
+type namespacedKvStore[T any] struct {
+	store    KvStore[T]
+	versions KvStore[int64]
+	clock    clock.Clock
+	settings NamespaceSettings
+
+	lck           sync.Mutex
+	version       int64
+	versionReadAt time.Time
+}
+
+// NewNamespacedKvStore prefixes every key with the name and the current version of the namespace before passing it to
+// the wrapped store. Bumping the version invalidates the whole namespace at once, without deleting a single key.
+// The current version is kept in the versions store, which has to be shared by all instances using the namespace.
+func NewNamespacedKvStore[T any](store KvStore[T], versions KvStore[int64], settings NamespaceSettings) NamespacedKvStore[T] {
+	return NewNamespacedKvStoreWithInterfaces(store, versions, clock.Provider, settings)
+}
+
+func NewNamespacedKvStoreWithInterfaces[T any](store KvStore[T], versions KvStore[int64], clock clock.Clock, settings NamespaceSettings) NamespacedKvStore[T] {
+	return &namespacedKvStore[T]{
+		store:    store,
+		versions: versions,
+		clock:    clock,
+		settings: settings,
+	}
+}
+
+func (s *namespacedKvStore[T]) Version(ctx context.Context) (int64, error) {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	if !s.versionReadAt.IsZero() && s.clock.Since(s.versionReadAt) < s.settings.VersionCacheTtl {
+		return s.version, nil
+	}
+
+	return s.readVersion(ctx)
+}
+
+// BumpVersion doesn't use a cached version. Instances bumping the version at the same time might still end up with
+// a single increase of the version, which invalidates the namespace all the same.
+func (s *namespacedKvStore[T]) BumpVersion(ctx context.Context) (int64, error) {
+	s.lck.Lock()
+	defer s.lck.Unlock()
+
+	version, err := s.readVersion(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	version++
+
+	if err := s.versions.Put(ctx, s.versionKey(), version); err != nil {
+		return 0, fmt.Errorf("can not write version %d of namespace %s: %w", version, s.settings.Name, err)
+	}
+
+	s.version = version
+	s.versionReadAt = s.clock.Now()
+
+	return version, nil
+}
+
+func (s *namespacedKvStore[T]) readVersion(ctx context.Context) (int64, error) {
+	var stored int64
+
+	found, err := s.versions.Get(ctx, s.versionKey(), &stored)
+	if err != nil {
+		return 0, fmt.Errorf("can not read version of namespace %s: %w", s.settings.Name, err)
+	}
+
+	// a version from the config higher than the stored one was increased since the last bump and wins
+	version := s.settings.Version
+	if found {
+		version = max(version, stored)
+	}
+
+	s.version = version
+	s.versionReadAt = s.clock.Now()
+
+	return version, nil
+}
+
+func (s *namespacedKvStore[T]) Contains(ctx context.Context, key any) (bool, error) {
+	namespacedKey, err := s.key(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	return s.store.Contains(ctx, namespacedKey)
+}
+
+func (s *namespacedKvStore[T]) Get(ctx context.Context, key any, value *T) (bool, error) {
+	namespacedKey, err := s.key(ctx, key)
+	if err != nil {
+		return false, err
+	}
+
+	return s.store.Get(ctx, namespacedKey, value)
+}
+
+func (s *namespacedKvStore[T]) GetWithTTL(ctx context.Context, key any, value *T) (bool, time.Duration, error) {
+	namespacedKey, err := s.key(ctx, key)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return s.store.GetWithTTL(ctx, namespacedKey, value)
+}
+
+func (s *namespacedKvStore[T]) GetBatch(ctx context.Context, keys any, values any) ([]any, error) {
+	keySlice, err := refl.InterfaceToInterfaceSlice(keys)
+	if err != nil {
+		return nil, fmt.Errorf("can not morph keys to slice of interfaces: %w", err)
+	}
+
+	resultMap, err := refl.MapOf(values)
+	if err != nil {
+		return nil, fmt.Errorf("can not use provided result value: %w", err)
+	}
+
+	// the version is read once, so all keys of the batch are read from the same version
+	version, err := s.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	namespacedKeys := make([]any, len(keySlice))
+	originalKeys := make(map[string]any, len(keySlice))
+
+	for i, key := range keySlice {
+		namespacedKey, err := s.versionedKey(key, version)
+		if err != nil {
+			return nil, err
+		}
+
+		namespacedKeys[i] = namespacedKey
+		originalKeys[namespacedKey] = key
+	}
+
+	namespacedValues := make(map[string]T, len(keySlice))
+	missing, err := s.store.GetBatch(ctx, namespacedKeys, namespacedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	for namespacedKey, value := range namespacedValues {
+		if err := resultMap.Set(originalKeys[namespacedKey], &value); err != nil {
+			return nil, fmt.Errorf("can not set new element on result map: %w", err)
+		}
+	}
+
+	for i, namespacedKey := range missing {
+		keyStr, err := CastKeyToString(namespacedKey)
+		if err != nil {
+			return nil, fmt.Errorf("can not build string key %T %v: %w", namespacedKey, namespacedKey, err)
+		}
+
+		missing[i] = originalKeys[keyStr]
+	}
+
+	return missing, nil
+}
+
+func (s *namespacedKvStore[T]) Put(ctx context.Context, key any, value T) error {
+	namespacedKey, err := s.key(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Put(ctx, namespacedKey, value)
+}
+
+func (s *namespacedKvStore[T]) PutWithTTL(ctx context.Context, key any, value T, ttl time.Duration) error {
+	namespacedKey, err := s.key(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return s.store.PutWithTTL(ctx, namespacedKey, value, ttl)
+}
+
+func (s *namespacedKvStore[T]) PutBatch(ctx context.Context, values any) error {
+	mii, err := refl.InterfaceToMapInterfaceInterface(values)
+	if err != nil {
+		return fmt.Errorf("could not convert values from %T to map[any]any", values)
+	}
+
+	version, err := s.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	namespacedValues := make(map[string]T, len(mii))
+
+	for key, value := range mii {
+		namespacedKey, err := s.versionedKey(key, version)
+		if err != nil {
+			return err
+		}
+
+		typedValue, ok := value.(T)
+		if !ok {
+			return fmt.Errorf("value for key %s is of type %T instead of %T", namespacedKey, value, *new(T))
+		}
+
+		namespacedValues[namespacedKey] = typedValue
+	}
+
+	return s.store.PutBatch(ctx, namespacedValues)
+}
+
+func (s *namespacedKvStore[T]) Delete(ctx context.Context, key any) error {
+	namespacedKey, err := s.key(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(ctx, namespacedKey)
+}
+
+func (s *namespacedKvStore[T]) DeleteBatch(ctx context.Context, keys any) error {
+	keySlice, err := refl.InterfaceToInterfaceSlice(keys)
+	if err != nil {
+		return fmt.Errorf("could not convert keys from %T to []any: %w", keys, err)
+	}
+
+	version, err := s.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	namespacedKeys := make([]any, len(keySlice))
+
+	for i, key := range keySlice {
+		if namespacedKeys[i], err = s.versionedKey(key, version); err != nil {
+			return err
+		}
+	}
+
+	return s.store.DeleteBatch(ctx, namespacedKeys)
+}
+
+func (s *namespacedKvStore[T]) key(ctx context.Context, key any) (string, error) {
+	version, err := s.Version(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return s.versionedKey(key, version)
+}
+
+// versionKey can't collide with the keys of values, which always contain a version number after the name.
+func (s *namespacedKvStore[T]) versionKey() string {
+	return fmt.Sprintf("%s:version", s.settings.Name)
+}
+
+func (s *namespacedKvStore[T]) versionedKey(key any, version int64) (string, error) {
+	keyStr, err := CastKeyToString(key)
+	if err != nil {
+		return "", fmt.Errorf("can not build string key %T %v: %w", key, key, err)
+	}
+
+	return fmt.Sprintf("%s:v%d:%s", s.settings.Name, version, keyStr), nil
+}
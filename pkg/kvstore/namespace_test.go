@@ -0,0 +1,164 @@
+package kvstore_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/kvstore"
+	"github.com/stretchr/testify/suite"
+)
+
+type NamespacedKvStoreTestSuite struct {
+	suite.Suite
+	clock    clock.FakeClock
+	base     kvstore.KvStore[string]
+	versions kvstore.KvStore[int64]
+	store    kvstore.NamespacedKvStore[string]
+}
+
+func TestNamespacedKvStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(NamespacedKvStoreTestSuite))
+}
+
+func (s *NamespacedKvStoreTestSuite) SetupTest() {
+	s.base = kvstore.NewInMemoryKvStoreWithInterfaces[string](&kvstore.Settings{
+		Ttl:       time.Hour,
+		BatchSize: 100,
+	})
+	s.versions = kvstore.NewInMemoryKvStoreWithInterfaces[int64](&kvstore.Settings{
+		BatchSize: 100,
+	})
+	s.clock = clock.NewFakeClock()
+	s.store = s.newStore()
+}
+
+// newStore creates a store of another instance sharing the backend with the store of the suite
+func (s *NamespacedKvStoreTestSuite) newStore() kvstore.NamespacedKvStore[string] {
+	return kvstore.NewNamespacedKvStoreWithInterfaces(s.base, s.versions, s.clock, kvstore.NamespaceSettings{
+		Name:            "users",
+		Version:         3,
+		VersionCacheTtl: time.Second,
+	})
+}
+
+func (s *NamespacedKvStoreTestSuite) TestPrefixedKeys() {
+	ctx := s.T().Context()
+
+	err := s.store.Put(ctx, "foo", "bar")
+	s.NoError(err)
+
+	var value string
+	found, err := s.base.Get(ctx, "users:v3:foo", &value)
+	s.NoError(err)
+	s.True(found)
+	s.Equal("bar", value)
+
+	found, err = s.store.Get(ctx, "foo", &value)
+	s.NoError(err)
+	s.True(found)
+	s.Equal("bar", value)
+}
+
+func (s *NamespacedKvStoreTestSuite) TestBumpVersion() {
+	ctx := s.T().Context()
+
+	err := s.store.Put(ctx, "foo", "bar")
+	s.NoError(err)
+
+	version, err := s.store.BumpVersion(ctx)
+	s.NoError(err)
+	s.Equal(int64(4), version)
+
+	version, err = s.store.Version(ctx)
+	s.NoError(err)
+	s.Equal(int64(4), version)
+
+	found, err := s.store.Contains(ctx, "foo")
+	s.NoError(err)
+	s.False(found, "the value of the old version should not be visible anymore")
+
+	err = s.store.Put(ctx, "foo", "baz")
+	s.NoError(err)
+
+	var value string
+	found, err = s.store.Get(ctx, "foo", &value)
+	s.NoError(err)
+	s.True(found)
+	s.Equal("baz", value)
+}
+
+func (s *NamespacedKvStoreTestSuite) TestBatch() {
+	ctx := s.T().Context()
+
+	err := s.store.PutBatch(ctx, map[int]string{
+		1: "a",
+		2: "b",
+	})
+	s.NoError(err)
+
+	values := map[int]string{}
+	missing, err := s.store.GetBatch(ctx, []int{1, 2, 3}, values)
+	s.NoError(err)
+	s.Equal(map[int]string{1: "a", 2: "b"}, values)
+	s.Equal([]any{3}, missing)
+
+	err = s.store.DeleteBatch(ctx, []int{1})
+	s.NoError(err)
+
+	found, err := s.store.Contains(ctx, 1)
+	s.NoError(err)
+	s.False(found)
+}
+
+func (s *NamespacedKvStoreTestSuite) TestBumpVersionSharedBackend() {
+	ctx := s.T().Context()
+	other := s.newStore()
+
+	err := s.store.Put(ctx, "foo", "bar")
+	s.NoError(err)
+
+	found, err := other.Contains(ctx, "foo")
+	s.NoError(err)
+	s.True(found)
+
+	version, err := s.store.BumpVersion(ctx)
+	s.NoError(err)
+	s.Equal(int64(4), version)
+
+	var stored int64
+	found, err = s.versions.Get(ctx, "users:version", &stored)
+	s.NoError(err)
+	s.True(found)
+	s.Equal(int64(4), stored)
+
+	// the other instance uses its cached version until the cache expires
+	found, err = other.Contains(ctx, "foo")
+	s.NoError(err)
+	s.True(found)
+
+	s.clock.Advance(time.Second)
+
+	version, err = other.Version(ctx)
+	s.NoError(err)
+	s.Equal(int64(4), version)
+
+	found, err = other.Contains(ctx, "foo")
+	s.NoError(err)
+	s.False(found, "the bump of the other instance should invalidate the namespace")
+
+	version, err = other.BumpVersion(ctx)
+	s.NoError(err)
+	s.Equal(int64(5), version)
+}
+
+func (s *NamespacedKvStoreTestSuite) TestConfiguredVersionAboveStoredVersion() {
+	ctx := s.T().Context()
+
+	err := s.versions.Put(ctx, "users:version", int64(2))
+	s.NoError(err)
+
+	version, err := s.store.Version(ctx)
+	s.NoError(err)
+	s.Equal(int64(3), version, "a configured version above the stored one should win")
+}
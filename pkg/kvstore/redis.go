@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/log"
@@ -40,7 +41,7 @@ func NewRedisKvStore[T any](ctx context.Context, config cfg.Config, logger log.L
 }
 
 func NewRedisKvStoreWithInterfaces[T any](client redis.Client, settings *Settings) KvStore[T] {
-	return NewMetricStoreWithInterfaces[T](&redisKvStore[T]{
+	return NewMetricStore[T](&redisKvStore[T]{
 		client:   client,
 		settings: settings,
 	}, settings)
@@ -76,7 +77,7 @@ func (s *redisKvStore[T]) Get(ctx context.Context, key any, value *T) (bool, err
 		return false, fmt.Errorf("can not get value from redis store: %w", err)
 	}
 
-	err = Unmarshal([]byte(data), value)
+	err = DecodeValue([]byte(data), value)
 	if err != nil {
 		return false, fmt.Errorf("can not unmarshal value from redis store: %w", err)
 	}
@@ -84,6 +85,26 @@ func (s *redisKvStore[T]) Get(ctx context.Context, key any, value *T) (bool, err
 	return true, nil
 }
 
+func (s *redisKvStore[T]) GetWithTTL(ctx context.Context, key any, value *T) (bool, time.Duration, error) {
+	found, err := s.Get(ctx, key, value)
+	if err != nil || !found {
+		return found, 0, err
+	}
+
+	keyStr, err := s.key(key)
+	if err != nil {
+		return false, 0, fmt.Errorf("can not get key to read ttl from redis: %w", err)
+	}
+
+	ttl, err := s.client.TTL(ctx, keyStr)
+	if err != nil {
+		return false, 0, fmt.Errorf("can not get ttl from redis store: %w", err)
+	}
+
+	// redis returns a negative ttl if the key has no expiration or was removed in the meantime
+	return true, max(ttl, 0), nil
+}
+
 func (s *redisKvStore[T]) GetBatch(ctx context.Context, keys any, result any) ([]any, error) {
 	return getBatch(ctx, keys, result, s.getChunk, s.settings.BatchSize)
 }
@@ -121,7 +142,7 @@ func (s *redisKvStore[T]) getChunk(ctx context.Context, resultMap *refl.Map, key
 		}
 
 		element := resultMap.NewElement()
-		err = Unmarshal([]byte(item), element)
+		err = DecodeValue([]byte(item), element)
 		if err != nil {
 			return nil, fmt.Errorf("can not unmarshal item: %w", err)
 		}
@@ -135,12 +156,20 @@ func (s *redisKvStore[T]) getChunk(ctx context.Context, resultMap *refl.Map, key
 }
 
 func (s *redisKvStore[T]) Put(ctx context.Context, key any, value T) error {
+	return s.PutWithTTL(ctx, key, value, 0)
+}
+
+func (s *redisKvStore[T]) PutWithTTL(ctx context.Context, key any, value T, ttl time.Duration) error {
 	keyStr, bytes, err := s.marshalKeyValue(key, value)
 	if err != nil {
 		return fmt.Errorf("can not get key/value to write to redis: %w", err)
 	}
 
-	err = s.client.Set(ctx, keyStr, bytes, s.settings.Ttl)
+	if ttl == 0 {
+		ttl = s.settings.Ttl
+	}
+
+	err = s.client.Set(ctx, keyStr, bytes, ttl)
 	if err != nil {
 		return fmt.Errorf("can not set value in redis store: %w", err)
 	}
@@ -149,7 +178,7 @@ func (s *redisKvStore[T]) Put(ctx context.Context, key any, value T) error {
 }
 
 func (s *redisKvStore[T]) marshalKeyValue(key any, value any) (keyStr string, bytes []byte, err error) {
-	if bytes, err = Marshal(value); err != nil {
+	if bytes, err = EncodeValue(s.settings.Codec, value); err != nil {
 		return "", nil, fmt.Errorf("can not marshal value %T %v: %w", value, value, err)
 	}
 
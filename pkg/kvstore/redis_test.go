@@ -83,6 +83,39 @@ func TestRedisKvStore_Put(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRedisKvStore_PutWithTTL(t *testing.T) {
+	ctx, store, client := buildTestableRedisStoreWithTTL[Item](t)
+	client.EXPECT().Set(ctx, "foo", []byte(`{"id":"foo","body":"bar"}`), time.Minute).Return(nil).Once()
+	client.EXPECT().Set(ctx, "fuu", []byte(`{"id":"fuu","body":"baz"}`), time.Second).Return(nil).Once()
+
+	err := store.PutWithTTL(ctx, "foo", Item{Id: "foo", Body: "bar"}, time.Minute)
+	assert.NoError(t, err)
+
+	// a ttl of 0 uses the default ttl of the store
+	err = store.PutWithTTL(ctx, "fuu", Item{Id: "fuu", Body: "baz"}, 0)
+	assert.NoError(t, err)
+}
+
+func TestRedisKvStore_GetWithTTL(t *testing.T) {
+	ctx, store, client := buildTestableRedisStore[Item](t)
+	client.EXPECT().Get(ctx, "foo").Return(`{"id":"foo","body":"bar"}`, nil).Once()
+	client.EXPECT().TTL(ctx, "foo").Return(time.Minute, nil).Once()
+	client.EXPECT().Get(ctx, "fuu").Return(`{"id":"fuu","body":"baz"}`, nil).Once()
+	client.EXPECT().TTL(ctx, "fuu").Return(time.Duration(-1), nil).Once()
+
+	item := &Item{}
+	found, ttl, err := store.GetWithTTL(ctx, "foo", item)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, time.Minute, ttl)
+	assert.Equal(t, "bar", item.Body)
+
+	found, ttl, err = store.GetWithTTL(ctx, "fuu", item)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, time.Duration(0), ttl)
+}
+
 func TestRedisKvStore_PutBatch(t *testing.T) {
 	ctx, store, client := buildTestableRedisStoreWithTTL[Item](t)
 
@@ -0,0 +1,133 @@
+package kvstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"golang.org/x/sync/singleflight"
+)
+
+// LoadFunc loads the value for a key which is missing in the store. It returns false if there is no value for the key.
+type LoadFunc[T any] func(ctx context.Context, key any) (T, bool, error)
+
+type SingleflightSettings struct {
+	// Ttl of the loaded values written to the store, 0 uses the default ttl of the store.
+	Ttl time.Duration
+	// StaleWindow is the last part of the lifetime of a value in which it is considered stale. A stale value is
+	// returned right away and refreshed in the background. 0 disables serving stale values.
+	StaleWindow time.Duration
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name Getter
+type Getter[T any] interface {
+	// Get reads the value from the store or loads and stores it if it is missing.
+	Get(ctx context.Context, key any, value *T) (bool, error)
+}
+
+type singleflightResult[T any] struct {
+	value T
+	found bool
+}
+
+type singleflightGetter[T any] struct {
+	logger   log.Logger
+	store    KvStore[T]
+	load     LoadFunc[T]
+	group    singleflight.Group
+	settings SingleflightSettings
+}
+
+// NewSingleflightGetter creates a Getter for the configured kvstore with the given name.
+func NewSingleflightGetter[T any](ctx context.Context, config cfg.Config, logger log.Logger, name string, load LoadFunc[T], settings SingleflightSettings) (Getter[T], error) {
+	store, err := ProvideConfigurableKvStore[T](ctx, config, logger, name)
+	if err != nil {
+		return nil, fmt.Errorf("can not create kvstore %s: %w", name, err)
+	}
+
+	return NewSingleflightGetterWithInterfaces(logger, store, load, settings), nil
+}
+
+// NewSingleflightGetterWithInterfaces creates a Getter which loads every key missing in the store only once, no
+// matter how many concurrent requests miss it. This prevents a stampede on the source of the values when a hot key
+// expires.
+func NewSingleflightGetterWithInterfaces[T any](logger log.Logger, store KvStore[T], load LoadFunc[T], settings SingleflightSettings) Getter[T] {
+	return &singleflightGetter[T]{
+		logger:   logger,
+		store:    store,
+		load:     load,
+		settings: settings,
+	}
+}
+
+func (g *singleflightGetter[T]) Get(ctx context.Context, key any, value *T) (bool, error) {
+	keyStr, err := CastKeyToString(key)
+	if err != nil {
+		return false, fmt.Errorf("can not build string key %T %v: %w", key, key, err)
+	}
+
+	found, ttl, err := g.store.GetWithTTL(ctx, key, value)
+	if err != nil {
+		return false, fmt.Errorf("can not get value %s from store: %w", keyStr, err)
+	}
+
+	if found {
+		if g.settings.StaleWindow > 0 && ttl > 0 && ttl <= g.settings.StaleWindow {
+			g.refresh(ctx, keyStr, key)
+		}
+
+		return true, nil
+	}
+
+	// the load is shared by all callers, so a caller giving up must not cancel it for the others
+	ch := g.group.DoChan(keyStr, func() (any, error) {
+		return g.loadAndStore(context.WithoutCancel(ctx), keyStr, key)
+	})
+
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return false, res.Err
+		}
+
+		result := res.Val.(singleflightResult[T])
+		if result.found {
+			*value = result.value
+		}
+
+		return result.found, nil
+	}
+}
+
+func (g *singleflightGetter[T]) refresh(ctx context.Context, keyStr string, key any) {
+	// the channel is buffered, so we don't have to wait for the result
+	g.group.DoChan(keyStr, func() (any, error) {
+		result, err := g.loadAndStore(context.WithoutCancel(ctx), keyStr, key)
+		if err != nil {
+			g.logger.Warn(ctx, "can not refresh stale value %s: %s", keyStr, err.Error())
+		}
+
+		return result, err
+	})
+}
+
+func (g *singleflightGetter[T]) loadAndStore(ctx context.Context, keyStr string, key any) (singleflightResult[T], error) {
+	value, found, err := g.load(ctx, key)
+	if err != nil {
+		return singleflightResult[T]{}, fmt.Errorf("can not load value %s: %w", keyStr, err)
+	}
+
+	if !found {
+		return singleflightResult[T]{}, nil
+	}
+
+	if err = g.store.PutWithTTL(ctx, key, value, g.settings.Ttl); err != nil {
+		g.logger.Warn(ctx, "can not write loaded value %s to store: %s", keyStr, err.Error())
+	}
+
+	return singleflightResult[T]{value: value, found: true}, nil
+}
@@ -0,0 +1,156 @@
+package kvstore_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/kvstore"
+	kvStoreMocks "github.com/justtrackio/gosoline/pkg/kvstore/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSingleflightGetter_ConcurrentMisses(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	store := kvStoreMocks.NewKvStore[string](t)
+
+	missed := &sync.WaitGroup{}
+	missed.Add(10)
+	release := make(chan struct{})
+	loads := int32(0)
+
+	store.EXPECT().GetWithTTL(matcher.Context, "foo", mock.AnythingOfType("*string")).Run(func(ctx context.Context, key any, value *string) {
+		missed.Done()
+	}).Return(false, 0, nil).Times(10)
+	store.EXPECT().PutWithTTL(matcher.Context, "foo", "bar", time.Minute).Return(nil).Once()
+
+	getter := kvstore.NewSingleflightGetterWithInterfaces(logger, store, func(ctx context.Context, key any) (string, bool, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+
+		return "bar", true, nil
+	}, kvstore.SingleflightSettings{
+		Ttl: time.Minute,
+	})
+
+	results := make([]string, 10)
+	done := &sync.WaitGroup{}
+	done.Add(10)
+
+	for i := range results {
+		go func() {
+			defer done.Done()
+
+			found, err := getter.Get(ctx, "foo", &results[i])
+			assert.NoError(t, err)
+			assert.True(t, found)
+		}()
+	}
+
+	missed.Wait()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	done.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	for _, result := range results {
+		assert.Equal(t, "bar", result)
+	}
+}
+
+func TestSingleflightGetter_Hit(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	store := kvStoreMocks.NewKvStore[string](t)
+
+	store.EXPECT().GetWithTTL(matcher.Context, "foo", mock.AnythingOfType("*string")).Run(func(ctx context.Context, key any, value *string) {
+		*value = "bar"
+	}).Return(true, time.Hour, nil).Once()
+
+	getter := kvstore.NewSingleflightGetterWithInterfaces(logger, store, func(ctx context.Context, key any) (string, bool, error) {
+		assert.Fail(t, "the loader should not be called")
+
+		return "", false, nil
+	}, kvstore.SingleflightSettings{
+		StaleWindow: time.Minute,
+	})
+
+	var value string
+	found, err := getter.Get(ctx, "foo", &value)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "bar", value)
+}
+
+func TestSingleflightGetter_StaleRefresh(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	store := kvStoreMocks.NewKvStore[string](t)
+	refreshed := make(chan struct{})
+
+	store.EXPECT().GetWithTTL(matcher.Context, "foo", mock.AnythingOfType("*string")).Run(func(ctx context.Context, key any, value *string) {
+		*value = "stale"
+	}).Return(true, 30*time.Second, nil).Once()
+	store.EXPECT().PutWithTTL(matcher.Context, "foo", "fresh", time.Duration(0)).Run(func(ctx context.Context, key any, value string, ttl time.Duration) {
+		close(refreshed)
+	}).Return(nil).Once()
+
+	getter := kvstore.NewSingleflightGetterWithInterfaces(logger, store, func(ctx context.Context, key any) (string, bool, error) {
+		return "fresh", true, nil
+	}, kvstore.SingleflightSettings{
+		StaleWindow: time.Minute,
+	})
+
+	var value string
+	found, err := getter.Get(ctx, "foo", &value)
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "stale", value)
+
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the stale value should have been refreshed")
+	}
+}
+
+func TestSingleflightGetter_NotFound(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	store := kvStoreMocks.NewKvStore[string](t)
+
+	store.EXPECT().GetWithTTL(matcher.Context, "foo", mock.AnythingOfType("*string")).Return(false, 0, nil).Once()
+
+	getter := kvstore.NewSingleflightGetterWithInterfaces(logger, store, func(ctx context.Context, key any) (string, bool, error) {
+		return "", false, nil
+	}, kvstore.SingleflightSettings{})
+
+	var value string
+	found, err := getter.Get(ctx, "foo", &value)
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestSingleflightGetter_LoadError(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	store := kvStoreMocks.NewKvStore[string](t)
+
+	store.EXPECT().GetWithTTL(matcher.Context, "foo", mock.AnythingOfType("*string")).Return(false, 0, nil).Once()
+
+	getter := kvstore.NewSingleflightGetterWithInterfaces(logger, store, func(ctx context.Context, key any) (string, bool, error) {
+		return "", false, fmt.Errorf("source down")
+	}, kvstore.SingleflightSettings{})
+
+	var value string
+	found, err := getter.Get(ctx, "foo", &value)
+	assert.EqualError(t, err, "can not load value foo: source down")
+	assert.False(t, found)
+}
@@ -0,0 +1,143 @@
+package log
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// channelLevelOverrides holds the channel levels changed at runtime. Reads happen on every log call, so the overrides
+// are kept in an immutable map which gets replaced on every change.
+type channelLevelOverrides struct {
+	lck       sync.Mutex
+	overrides atomic.Pointer[map[string]int]
+}
+
+var runtimeChannelLevels = newChannelLevelOverrides()
+
+func newChannelLevelOverrides() *channelLevelOverrides {
+	overrides := &channelLevelOverrides{}
+	overrides.overrides.Store(&map[string]int{})
+
+	return overrides
+}
+
+// SetChannelLevel overrides the level of all channels matching the pattern for all handlers at runtime.
+// The pattern is either an exact channel name, "*" matching all channels or a prefix followed by ".*" (e.g. "stream.*")
+// matching the channel with that name and all channels below it. If several patterns match a channel, the most
+// specific one wins.
+func SetChannelLevel(pattern string, level string) error {
+	priority, ok := LevelPriority(level)
+	if !ok {
+		return fmt.Errorf("invalid log level %q", level)
+	}
+
+	if err := validateChannelPattern(pattern); err != nil {
+		return err
+	}
+
+	runtimeChannelLevels.update(func(overrides map[string]int) {
+		overrides[pattern] = priority
+	})
+
+	return nil
+}
+
+// RemoveChannelLevel removes a runtime override set with SetChannelLevel.
+func RemoveChannelLevel(pattern string) {
+	runtimeChannelLevels.update(func(overrides map[string]int) {
+		delete(overrides, pattern)
+	})
+}
+
+// ResetChannelLevels removes all runtime overrides.
+func ResetChannelLevels() {
+	runtimeChannelLevels.update(func(overrides map[string]int) {
+		clear(overrides)
+	})
+}
+
+// ChannelLevels returns all runtime overrides by pattern.
+func ChannelLevels() map[string]string {
+	overrides := *runtimeChannelLevels.overrides.Load()
+	levels := make(map[string]string, len(overrides))
+
+	for pattern, priority := range overrides {
+		levels[pattern] = LevelName(priority)
+	}
+
+	return levels
+}
+
+// ChannelLevelOverride returns the level set with SetChannelLevel for the channel, if any pattern matches it. This allows
+// to skip expensive work for logs which can only be enabled at runtime.
+func ChannelLevelOverride(channel string) (string, bool) {
+	priority, ok := runtimeChannelLevels.channelLevel(channel)
+	if !ok {
+		return "", false
+	}
+
+	return LevelName(priority), true
+}
+
+func (o *channelLevelOverrides) update(mutate func(overrides map[string]int)) {
+	o.lck.Lock()
+	defer o.lck.Unlock()
+
+	overrides := maps.Clone(*o.overrides.Load())
+	mutate(overrides)
+	o.overrides.Store(&overrides)
+}
+
+func (o *channelLevelOverrides) channelLevel(channel string) (int, bool) {
+	overrides := *o.overrides.Load()
+
+	if len(overrides) == 0 {
+		return 0, false
+	}
+
+	return matchChannelLevel(overrides, channel)
+}
+
+// matchChannelLevel finds the level of the most specific pattern matching the channel: an exact match wins over
+// the longest matching "<prefix>.*" pattern, which wins over "*".
+func matchChannelLevel[T any](levels map[string]T, channel string) (T, bool) {
+	if level, ok := levels[channel]; ok {
+		return level, true
+	}
+
+	for prefix := channel; prefix != ""; {
+		if level, ok := levels[prefix+".*"]; ok {
+			return level, true
+		}
+
+		idx := strings.LastIndex(prefix, ".")
+		if idx < 0 {
+			break
+		}
+
+		prefix = prefix[:idx]
+	}
+
+	level, ok := levels["*"]
+
+	return level, ok
+}
+
+func validateChannelPattern(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf("the channel pattern must not be empty")
+	}
+
+	if pattern == "*" {
+		return nil
+	}
+
+	if idx := strings.Index(pattern, "*"); idx >= 0 && (idx != len(pattern)-1 || !strings.HasSuffix(pattern, ".*")) {
+		return fmt.Errorf("invalid channel pattern %q: wildcards are only allowed as \"*\" or as \".*\" suffix", pattern)
+	}
+
+	return nil
+}
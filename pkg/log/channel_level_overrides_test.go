@@ -0,0 +1,93 @@
+package log_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetChannelLevel(t *testing.T) {
+	defer log.ResetChannelLevels()
+
+	config := cfg.New(map[string]any{
+		"log": map[string]any{
+			"handlers": map[string]any{
+				"main": map[string]any{
+					"override_levels": true,
+				},
+			},
+		},
+	})
+
+	buf := &bytes.Buffer{}
+	remoteBuf := &bytes.Buffer{}
+	handler := log.NewHandlerIoWriter(config, log.PriorityInfo, log.FormatterSimple, "main", time.RFC3339, buf)
+	remoteHandler := log.NewHandlerIoWriter(config, log.PriorityInfo, log.FormatterSimple, "remote", time.RFC3339, remoteBuf)
+	logger := log.NewLoggerWithInterfaces(clock.NewFakeClock(), []log.Handler{handler, remoteHandler})
+	ctx := t.Context()
+
+	assert.NoError(t, log.SetChannelLevel("stream.*", log.LevelDebug))
+	assert.NoError(t, log.SetChannelLevel("stream.consumer.*", log.LevelError))
+	assert.NoError(t, log.SetChannelLevel("stream.consumer.important", log.LevelTrace))
+	assert.NoError(t, log.SetChannelLevel("*", log.LevelWarn))
+
+	logger.WithChannel("stream").Debug(ctx, "stream debug")
+	logger.WithChannel("stream.producer").Debug(ctx, "producer debug")
+	logger.WithChannel("stream.consumer.foo").Warn(ctx, "consumer warn")
+	logger.WithChannel("stream.consumer.important").Debug(ctx, "important debug")
+	logger.WithChannel("streams").Info(ctx, "streams info")
+	logger.WithChannel("streams").Warn(ctx, "streams warn")
+
+	assert.Equal(t, map[string]string{
+		"*":                         log.LevelWarn,
+		"stream.*":                  log.LevelDebug,
+		"stream.consumer.*":         log.LevelError,
+		"stream.consumer.important": log.LevelTrace,
+	}, log.ChannelLevels())
+
+	log.RemoveChannelLevel("*")
+	logger.WithChannel("streams").Info(ctx, "streams info after removal")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 5)
+	assert.Contains(t, lines[0], "stream debug")
+	assert.Contains(t, lines[1], "producer debug")
+	assert.Contains(t, lines[2], "important debug")
+	assert.Contains(t, lines[3], "streams warn")
+	assert.Contains(t, lines[4], "streams info after removal")
+
+	// handlers not allowing level overrides only apply the stricter levels
+	lines = strings.Split(strings.TrimSpace(remoteBuf.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "streams warn")
+	assert.Contains(t, lines[1], "streams info after removal")
+}
+
+func TestSetChannelLevelInvalid(t *testing.T) {
+	defer log.ResetChannelLevels()
+
+	assert.EqualError(t, log.SetChannelLevel("stream.*", "verbose"), `invalid log level "verbose"`)
+	assert.Error(t, log.SetChannelLevel("", log.LevelDebug))
+	assert.Error(t, log.SetChannelLevel("stream*", log.LevelDebug))
+	assert.Error(t, log.SetChannelLevel("*.consumer", log.LevelDebug))
+	assert.Empty(t, log.ChannelLevels())
+}
+
+func TestChannelLevelOverride(t *testing.T) {
+	defer log.ResetChannelLevels()
+
+	_, ok := log.ChannelLevelOverride("db.queries.default")
+	assert.False(t, ok)
+
+	assert.NoError(t, log.SetChannelLevel("db.queries.*", log.LevelDebug))
+
+	level, ok := log.ChannelLevelOverride("db.queries.default")
+	assert.True(t, ok)
+	assert.Equal(t, log.LevelDebug, level)
+}
@@ -0,0 +1,70 @@
+package log
+
+import (
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+)
+
+// NewChannelLevelsFromConfig reads the channel levels configured for all handlers at log.levels. The keys are channel
+// patterns as accepted by SetChannelLevel, e.g.
+//
+//	log.levels:
+//	  "kvstore.*": warn
+//	  stream.consumer: debug
+//
+// Patterns with a ".*" suffix apply to the channel and all channels below it, so levels are inherited by child channels
+// unless a more specific pattern is configured.
+func NewChannelLevelsFromConfig(config cfg.Config) (map[string]string, error) {
+	settings, err := config.GetStringMap("log.levels", map[string]any{})
+	if err != nil {
+		return nil, fmt.Errorf("can not read log levels: %w", err)
+	}
+
+	levels := map[string]string{}
+	flattenChannelLevels("", settings, levels)
+
+	return levels, nil
+}
+
+// flattenChannelLevels joins the keys of nested maps with dots, as patterns containing dots are split into nested maps
+// when reading config files.
+func flattenChannelLevels(prefix string, settings map[string]any, levels map[string]string) {
+	for key, value := range settings {
+		pattern := joinChannel(prefix, key)
+
+		switch v := value.(type) {
+		case map[string]any:
+			flattenChannelLevels(pattern, v, levels)
+		default:
+			levels[pattern] = fmt.Sprint(v)
+		}
+	}
+}
+
+func joinChannel(prefix string, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+func parseChannelLevels(levels map[string]string) (map[string]int, error) {
+	priorities := make(map[string]int, len(levels))
+
+	for pattern, level := range levels {
+		if err := validateChannelPattern(pattern); err != nil {
+			return nil, err
+		}
+
+		priority, ok := LevelPriority(level)
+		if !ok {
+			return nil, fmt.Errorf("invalid log level %q for channel pattern %q", level, pattern)
+		}
+
+		priorities[pattern] = priority
+	}
+
+	return priorities, nil
+}
@@ -0,0 +1,132 @@
+package log_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelLevelsFromConfig(t *testing.T) {
+	config := cfg.New()
+	// merging config maps splits keys containing dots, just like reading a config file does
+	err := config.Option(cfg.WithConfigMap(map[string]any{
+		"log": map[string]any{
+			"levels": map[string]any{
+				"kvstore.*":       log.LevelWarn,
+				"stream.consumer": log.LevelDebug,
+				"*":               log.LevelInfo,
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	levels, err := log.NewChannelLevelsFromConfig(config)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"kvstore.*":       log.LevelWarn,
+		"stream.consumer": log.LevelDebug,
+		"*":               log.LevelInfo,
+	}, levels)
+
+	handlerConfig := cfg.New(map[string]any{
+		"log": map[string]any{
+			"handlers": map[string]any{
+				"main": map[string]any{
+					"override_levels": true,
+				},
+			},
+		},
+	})
+
+	buf := &bytes.Buffer{}
+	remoteBuf := &bytes.Buffer{}
+	handler := log.NewHandlerIoWriter(handlerConfig, log.PriorityError, log.FormatterSimple, "main", time.RFC3339, buf)
+	remoteHandler := log.NewHandlerIoWriter(handlerConfig, log.PriorityWarn, log.FormatterSimple, "remote", time.RFC3339, remoteBuf)
+	logger := log.NewLoggerWithInterfaces(clock.NewFakeClock(), []log.Handler{handler, remoteHandler})
+	require.NoError(t, logger.Option(log.WithChannelLevels(levels)))
+
+	ctx := t.Context()
+	logger.WithChannel("kvstore").Info(ctx, "kvstore info")
+	logger.WithChannel("kvstore.redis.users").Info(ctx, "kvstore child info")
+	logger.WithChannel("kvstore.redis.users").Warn(ctx, "kvstore child warn")
+	logger.WithChannel("stream.consumer").Debug(ctx, "consumer debug")
+	logger.WithChannel("stream.consumer.foo").Debug(ctx, "consumer child debug")
+	logger.WithChannel("main").Info(ctx, "main info")
+
+	lines := getLogLines(buf)
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], "kvstore child warn")
+	assert.Contains(t, lines[1], "consumer debug")
+	assert.Contains(t, lines[2], "main info")
+
+	// the configured levels are combined with the level of handlers not allowing level overrides
+	lines = getLogLines(remoteBuf)
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], "kvstore child warn")
+}
+
+func TestChannelLevelsInvalid(t *testing.T) {
+	logger := log.NewLogger()
+
+	err := logger.Option(log.WithChannelLevels(map[string]string{"stream.*": "verbose"}))
+	assert.ErrorContains(t, err, `invalid log level "verbose" for channel pattern "stream.*"`)
+
+	err = logger.Option(log.WithChannelLevels(map[string]string{"*.consumer": log.LevelInfo}))
+	assert.ErrorContains(t, err, `invalid channel pattern "*.consumer"`)
+}
+
+func TestHandlerChannelLevelsWithWildcards(t *testing.T) {
+	config := cfg.New()
+	err := config.Option(cfg.WithConfigMap(map[string]any{
+		"log": map[string]any{
+			"levels": map[string]any{
+				"stream.*": log.LevelDebug,
+			},
+			"handlers": map[string]any{
+				"main": map[string]any{
+					"override_levels": true,
+					"channels": map[string]any{
+						"stream.consumer.*": map[string]any{
+							"level": log.LevelError,
+						},
+						"kvstore.*": map[string]any{
+							"level": log.LevelWarn,
+						},
+						"kvstore.redis": map[string]any{
+							"level": log.LevelDebug,
+						},
+					},
+				},
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	levels, err := log.NewChannelLevelsFromConfig(config)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	handler := log.NewHandlerIoWriter(config, log.PriorityInfo, log.FormatterSimple, "main", time.RFC3339, buf)
+	logger := log.NewLoggerWithInterfaces(clock.NewFakeClock(), []log.Handler{handler})
+	require.NoError(t, logger.Option(log.WithChannelLevels(levels)))
+
+	ctx := t.Context()
+	logger.WithChannel("kvstore.memory").Info(ctx, "memory info")
+	logger.WithChannel("kvstore.redis").Debug(ctx, "redis debug")
+	logger.WithChannel("stream.producer").Debug(ctx, "producer debug")
+	logger.WithChannel("stream.consumer.foo").Warn(ctx, "consumer warn")
+	logger.WithChannel("stream.consumer.foo").Error(ctx, "consumer error")
+
+	lines := getLogLines(buf)
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], "redis debug")
+	assert.Contains(t, lines[1], "producer debug")
+	assert.Contains(t, lines[2], "consumer error")
+}
@@ -11,7 +11,8 @@ func init() {
 }
 
 // MainLoggerConfigPostProcessor ensures a "main" logger handler is configured.
-// If not explicitly defined, it defaults to an "iowriter" handler.
+// If not explicitly defined, it defaults to an "iowriter" handler writing to the console, which allows shared channel
+// levels to go below its own level (see LevelOverridable).
 func MainLoggerConfigPostProcessor(config cfg.GosoConf) (bool, error) {
 	if config.IsSet("log.handlers.main.type") {
 		return false, nil
@@ -21,6 +22,10 @@ func MainLoggerConfigPostProcessor(config cfg.GosoConf) (bool, error) {
 		cfg.WithConfigSetting("log.handlers.main.type", "iowriter"),
 	}
 
+	if !config.IsSet("log.handlers.main.override_levels") {
+		configOptions = append(configOptions, cfg.WithConfigSetting("log.handlers.main.override_levels", true))
+	}
+
 	if err := config.Option(configOptions...); err != nil {
 		return false, fmt.Errorf("can not apply config settings for main handler: %w", err)
 	}
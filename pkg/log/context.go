@@ -190,6 +190,40 @@ func appendContextFields(ctx context.Context, newFields map[string]any, local bo
 	return newContext(ctx, value.localFields, value.globalFields)
 }
 
+// ForkContext returns a context for work handed off to another goroutine. The returned context carries copies of the
+// local and global fields of ctx, so the fields can be mutated by the new goroutine without affecting the original
+// context and vice versa. All other values and the cancellation of ctx are kept.
+func ForkContext(ctx context.Context) context.Context {
+	return CopyContextFields(ctx, ctx)
+}
+
+// CopyContextFields returns a context derived from to, carrying copies of the local and global fields of from. Use it
+// to keep the fields of a request or message when running work with another context, e.g. one which isn't canceled
+// when the request is done:
+//
+//	logger = logger.WithContext(ctx)
+//	go process(log.CopyContextFields(ctx, context.Background()))
+//
+// If from carries fields, the fields already present in to are replaced.
+func CopyContextFields(from context.Context, to context.Context) context.Context {
+	value, ok := from.Value(contextFieldsKey).(contextFields)
+	if !ok {
+		return to
+	}
+
+	return newContext(to, value.localFields.clone(), value.globalFields.clone())
+}
+
+func (f fields) clone() fields {
+	f.lck.RLock()
+	defer f.lck.RUnlock()
+
+	return fields{
+		lck:  &sync.RWMutex{},
+		data: funk.MergeMaps(f.data),
+	}
+}
+
 // LocalOnlyContextFieldsResolver extracts the local fields from a context and, if not present, it returns an empty map.
 //
 // Warning: Besides very specific circumstances this method is most likely not what you want. Consider using
@@ -1,6 +1,7 @@
 package log_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/justtrackio/gosoline/pkg/log"
@@ -81,3 +82,35 @@ func TestMergeLoggerFieldsCorrectly(t *testing.T) {
 		"global field": "global",
 	}, merged)
 }
+
+func TestForkContext(t *testing.T) {
+	ctx := log.AppendContextFields(t.Context(), map[string]any{
+		"request_id": "abc",
+	})
+	ctx = log.AppendGlobalContextFields(ctx, map[string]any{
+		"user_id": 1,
+	})
+
+	forked := log.ForkContext(ctx)
+	_ = log.MutateContextFields(forked, map[string]any{"step": "child"})
+	_ = log.MutateGlobalContextFields(forked, map[string]any{"user_id": 2})
+
+	assert.Equal(t, map[string]any{"request_id": "abc", "user_id": 1}, log.ContextFieldsResolver(ctx))
+	assert.Equal(t, map[string]any{"request_id": "abc", "step": "child", "user_id": 2}, log.ContextFieldsResolver(forked))
+}
+
+func TestCopyContextFields(t *testing.T) {
+	parent, cancel := context.WithCancel(t.Context())
+	ctx := log.AppendContextFields(parent, map[string]any{
+		"request_id": "abc",
+	})
+
+	detached := log.CopyContextFields(ctx, context.Background())
+	cancel()
+
+	assert.NoError(t, detached.Err())
+	assert.Equal(t, map[string]any{"request_id": "abc"}, log.ContextFieldsResolver(detached))
+
+	unchanged := context.Background()
+	assert.Equal(t, unchanged, log.CopyContextFields(t.Context(), unchanged))
+}
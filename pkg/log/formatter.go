@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/fatih/color"
+	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/encoding/json"
 )
 
@@ -13,12 +14,41 @@ import (
 // It receives metadata (timestamp, level, channel) and the log payload (message, args, error, fields).
 type Formatter func(timestamp string, level int, format string, args []any, err error, data Data) ([]byte, error)
 
+// FormatterFactory creates a formatter which needs access to the configuration (e.g. to include the app identity).
+type FormatterFactory func(config cfg.Config) (Formatter, error)
+
 var formatters = map[string]Formatter{
 	"console": FormatterConsole,
 	"simple":  FormatterSimple,
 	"json":    FormatterJson,
 }
 
+var formatterFactories = map[string]FormatterFactory{}
+
+// AddFormatterFactory registers a new formatter which can be selected by handlers using the given name.
+func AddFormatterFactory(name string, factory FormatterFactory) {
+	formatterFactories[name] = factory
+}
+
+// NewFormatterFromConfig returns the formatter registered with the given name.
+func NewFormatterFromConfig(config cfg.Config, name string) (Formatter, error) {
+	if formatter, ok := formatters[name]; ok {
+		return formatter, nil
+	}
+
+	factory, ok := formatterFactories[name]
+	if !ok {
+		return nil, fmt.Errorf("formatter of type %s not available", name)
+	}
+
+	formatter, err := factory(config)
+	if err != nil {
+		return nil, fmt.Errorf("can not create formatter of type %s: %w", name, err)
+	}
+
+	return formatter, nil
+}
+
 // FormatterConsole formats a log entry for console output, using colors for different parts of the log message.
 func FormatterConsole(timestamp string, level int, format string, args []any, err error, data Data) ([]byte, error) {
 	fieldString := getFieldsAsString(data.Fields)
@@ -0,0 +1,123 @@
+package log
+
+import (
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+)
+
+// EcsVersion is the version of the Elastic Common Schema the "ecs" formatter adheres to.
+const EcsVersion = "8.11.0"
+
+func init() {
+	AddFormatterFactory("ecs", formatterEcsFactory)
+}
+
+type formatterEcsStruct struct {
+	Timestamp  string           `json:"@timestamp"`
+	LogLevel   string           `json:"log.level"`
+	Message    string           `json:"message"`
+	EcsVersion string           `json:"ecs.version"`
+	LogLogger  string           `json:"log.logger"`
+	Service    formatterEcsSvc  `json:"service"`
+	Error      *formatterEcsErr `json:"error,omitempty"`
+	Trace      *formatterEcsId  `json:"trace,omitempty"`
+	Span       *formatterEcsId  `json:"span,omitempty"`
+	Fields     map[string]any   `json:"fields,omitempty"`
+	Context    map[string]any   `json:"context,omitempty"`
+}
+
+type formatterEcsSvc struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+	Namespace   string `json:"namespace,omitempty"`
+}
+
+type formatterEcsErr struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+type formatterEcsId struct {
+	Id string `json:"id"`
+}
+
+func formatterEcsFactory(config cfg.Config) (Formatter, error) {
+	var err error
+	var identity cfg.Identity
+	var namespace string
+
+	if identity, err = cfg.GetAppIdentity(config); err != nil {
+		return nil, fmt.Errorf("can not get app identity: %w", err)
+	}
+
+	if namespace, err = identity.FormatNamespace("."); err != nil {
+		return nil, fmt.Errorf("failed to format namespace: %w", err)
+	}
+
+	return NewFormatterEcs(identity.Name, identity.Env, namespace), nil
+}
+
+// NewFormatterEcs creates a formatter writing log entries as JSON following the Elastic Common Schema, so they are
+// mapped correctly in Elasticsearch without an ingest pipeline. The trace_id and span_id context fields are mapped
+// to trace.id and span.id, all other fields and context fields are written to the custom "fields" and "context"
+// objects. The handler should use an ISO 8601 timestamp format like "2006-01-02T15:04:05.000Z07:00".
+func NewFormatterEcs(serviceName string, environment string, namespace string) Formatter {
+	service := formatterEcsSvc{
+		Name:        serviceName,
+		Environment: environment,
+		Namespace:   namespace,
+	}
+
+	return func(timestamp string, level int, format string, args []any, err error, data Data) ([]byte, error) {
+		context := data.ContextFields
+		ecs := &formatterEcsStruct{
+			Timestamp:  timestamp,
+			LogLevel:   LevelName(level),
+			Message:    fmt.Sprintf(format, args...),
+			EcsVersion: EcsVersion,
+			LogLogger:  data.Channel,
+			Service:    service,
+			Fields:     data.Fields,
+		}
+
+		if err != nil {
+			ecs.Error = &formatterEcsErr{
+				Message: err.Error(),
+				Type:    fmt.Sprintf("%T", err),
+			}
+		}
+
+		if traceId, ok := context["trace_id"].(string); ok && traceId != "" {
+			ecs.Trace = &formatterEcsId{Id: traceId}
+			context = withoutField(context, "trace_id")
+		}
+
+		if spanId, ok := context["span_id"].(string); ok && spanId != "" {
+			ecs.Span = &formatterEcsId{Id: spanId}
+			context = withoutField(context, "span_id")
+		}
+
+		ecs.Context = context
+
+		serialized, marshalErr := json.Marshal(ecs)
+		if marshalErr != nil {
+			return nil, fmt.Errorf("failed to marshal fields to JSON, %w", marshalErr)
+		}
+
+		return append(serialized, '\n'), nil
+	}
+}
+
+func withoutField(fields map[string]any, key string) map[string]any {
+	result := make(map[string]any, len(fields))
+
+	for k, v := range fields {
+		if k != key {
+			result[k] = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,60 @@
+package log_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatterEcs(t *testing.T) {
+	formatter := log.NewFormatterEcs("api", "prod", "shop.prod")
+
+	bytes, err := formatter("2024-01-01T10:00:00.000Z", log.PriorityError, "request failed: %s", []any{"timeout"}, fmt.Errorf("timeout"), log.Data{
+		Channel: "http",
+		Fields: map[string]any{
+			"status": 500,
+		},
+		ContextFields: map[string]any{
+			"trace_id":   "1-abc",
+			"request_id": "req",
+		},
+	})
+	require.NoError(t, err)
+
+	expected := `{"@timestamp":"2024-01-01T10:00:00.000Z","log.level":"error","message":"request failed: timeout","ecs.version":"8.11.0","log.logger":"http",` +
+		`"service":{"name":"api","environment":"prod","namespace":"shop.prod"},"error":{"message":"timeout","type":"*errors.errorString"},` +
+		`"trace":{"id":"1-abc"},"fields":{"status":500},"context":{"request_id":"req"}}` + "\n"
+
+	assert.Equal(t, expected, string(bytes))
+}
+
+func TestFormatterEcsFromConfig(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":  "test",
+			"name": "api",
+		},
+	})
+
+	formatter, err := log.NewFormatterFromConfig(config, "ecs")
+	require.NoError(t, err)
+
+	bytes, err := formatter("2024-01-01T10:00:00.000Z", log.PriorityInfo, "msg", nil, nil, log.Data{Channel: "main"})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"@timestamp": "2024-01-01T10:00:00.000Z",
+		"log.level": "info",
+		"message": "msg",
+		"ecs.version": "8.11.0",
+		"log.logger": "main",
+		"service": {"name": "api", "environment": "test"}
+	}`, string(bytes))
+
+	_, err = log.NewFormatterFromConfig(config, "unknown")
+	assert.EqualError(t, err, "formatter of type unknown not available")
+}
@@ -16,17 +16,48 @@ type Handler interface {
 	Log(ctx context.Context, timestamp time.Time, level int, msg string, args []any, err error, data Data) error
 }
 
+// LevelOverridable is implemented by handlers which allow the channel levels shared by all handlers (like the ones set
+// with SetChannelLevel) to go below their own level. Handlers not implementing it or returning false only apply shared
+// levels which are stricter than their own level, so lowering a level for debugging doesn't flood remote handlers.
+type LevelOverridable interface {
+	AllowsLevelOverrides() bool
+}
+
+// AllowsLevelOverrides reports if the handler implements LevelOverridable and allows shared channel levels to go below
+// its own level. Handler wrappers use it to forward the setting of the wrapped handler.
+func AllowsLevelOverrides(handler Handler) bool {
+	overridable, ok := handler.(LevelOverridable)
+
+	return ok && overridable.AllowsLevelOverrides()
+}
+
 // HandlerFactory is a function type for creating new handlers from configuration.
 type HandlerFactory func(config cfg.Config, name string) (Handler, error)
 
+// HandlerWrapperFactory wraps a handler created from config with additional behavior (like sampling) configured for it.
+// If the behavior isn't enabled for the handler, the handler has to be returned unchanged.
+type HandlerWrapperFactory func(config cfg.Config, name string, handler Handler) (Handler, error)
+
 var handlerFactories = map[string]HandlerFactory{}
 
+// handlerWrapperFactories are applied in order, the last wrapper ends up as the outermost handler.
+var handlerWrapperFactories = []HandlerWrapperFactory{
+	handlerSamplingWrapper,
+	handlerAsyncWrapper,
+}
+
 // AddHandlerFactory registers a new factory function for creating log handlers of a specific type.
 // This allows for extending the logging system with custom handler implementations.
 func AddHandlerFactory(typ string, factory HandlerFactory) {
 	handlerFactories[typ] = factory
 }
 
+// AddHandlerWrapperFactory registers a wrapper applied to all handlers created from config.
+// Wrappers registered this way end up outside of the built-in ones (sampling and async).
+func AddHandlerWrapperFactory(factory HandlerWrapperFactory) {
+	handlerWrapperFactories = append(handlerWrapperFactories, factory)
+}
+
 // NewHandlersFromConfig creates a slice of log handlers based on the provided configuration.
 // It parses the "log.handlers" section of the config and instantiates the corresponding handlers.
 func NewHandlersFromConfig(config cfg.Config) ([]Handler, error) {
@@ -50,6 +81,12 @@ func NewHandlersFromConfig(config cfg.Config) ([]Handler, error) {
 			return nil, fmt.Errorf("can not create logging handler of type %s on index %d: %w", handlerSettings.Type, i, err)
 		}
 
+		for _, wrapperFactory := range handlerWrapperFactories {
+			if handlers[i], err = wrapperFactory(config, name, handlers[i]); err != nil {
+				return nil, fmt.Errorf("can not wrap logging handler of type %s on index %d: %w", handlerSettings.Type, i, err)
+			}
+		}
+
 		i++
 	}
 
@@ -0,0 +1,229 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+const (
+	// AsyncOverflowDrop drops log entries if the queue of an async handler is full.
+	AsyncOverflowDrop = "drop"
+	// AsyncOverflowBlock blocks the logging goroutine until the queue of an async handler has space again.
+	AsyncOverflowBlock = "block"
+)
+
+// HandlerAsyncSettings configures writing log entries asynchronously for a handler.
+// It is read from log.handlers.<name>.async.
+type HandlerAsyncSettings struct {
+	Enabled bool `cfg:"enabled" default:"false"`
+	// QueueSize is the amount of log entries which can be queued before the overflow policy kicks in.
+	QueueSize int `cfg:"queue_size" default:"10000"`
+	// Workers is the amount of goroutines writing the queued entries to the handler.
+	Workers int `cfg:"workers" default:"1"`
+	// OverflowPolicy is either "drop" or "block".
+	OverflowPolicy string `cfg:"overflow_policy" default:"drop"`
+}
+
+// AsyncDropCounter is called with the amount of log entries an async handler dropped since its last call.
+type AsyncDropCounter func(dropped int64)
+
+// AsyncDropCounterFactory creates the AsyncDropCounter for the async handler of the named handler, e.g. one writing a
+// metric. pkg/metric registers one with SetAsyncDropCounterFactory when it is imported.
+type AsyncDropCounterFactory func(config cfg.Config, handlerName string) (AsyncDropCounter, error)
+
+var asyncDropCounterFactory AsyncDropCounterFactory
+
+// SetAsyncDropCounterFactory sets the factory creating the drop counters of async handlers created from config.
+func SetAsyncDropCounterFactory(factory AsyncDropCounterFactory) {
+	asyncDropCounterFactory = factory
+}
+
+type handlerAsyncEntry struct {
+	ctx       context.Context
+	timestamp time.Time
+	level     int
+	msg       string
+	args      []any
+	err       error
+	data      Data
+}
+
+// HandlerAsync wraps a handler and writes log entries to it from background workers, so slow handlers don't block
+// the logging goroutine. Entries are queued in a bounded queue. If the queue is full, entries are either dropped
+// (and the amount of dropped entries is counted and reported with the next written entry) or the logging goroutine blocks.
+type HandlerAsync struct {
+	Handler
+	clock       clock.Clock
+	dropCounter AsyncDropCounter
+	settings    HandlerAsyncSettings
+	queue       chan handlerAsyncEntry
+	dropped     atomic.Int64
+	pendingLck  sync.Mutex
+	pending     int
+	idle        *sync.Cond
+}
+
+func handlerAsyncWrapper(config cfg.Config, name string, handler Handler) (Handler, error) {
+	settings := &HandlerAsyncSettings{}
+	key := fmt.Sprintf("%s.async", getHandlerConfigKey(name))
+
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal async settings for key %q: %w", key, err)
+	}
+
+	if !settings.Enabled {
+		return handler, nil
+	}
+
+	var err error
+	var dropCounter AsyncDropCounter
+
+	if asyncDropCounterFactory != nil {
+		if dropCounter, err = asyncDropCounterFactory(config, name); err != nil {
+			return nil, fmt.Errorf("can not create drop counter for async handler %s: %w", name, err)
+		}
+	}
+
+	return NewHandlerAsyncWithInterfaces(clock.Provider, handler, *settings, dropCounter)
+}
+
+// NewHandlerAsync creates a new async handler wrapping the given handler and starts its workers.
+func NewHandlerAsync(handler Handler, settings HandlerAsyncSettings) (*HandlerAsync, error) {
+	return NewHandlerAsyncWithInterfaces(clock.Provider, handler, settings, nil)
+}
+
+// NewHandlerAsyncWithInterfaces creates a new async handler like NewHandlerAsync. Dropped entries are reported to the
+// drop counter, if one is given, and logged with the time of the clock.
+func NewHandlerAsyncWithInterfaces(clock clock.Clock, handler Handler, settings HandlerAsyncSettings, dropCounter AsyncDropCounter) (*HandlerAsync, error) {
+	if settings.OverflowPolicy != AsyncOverflowDrop && settings.OverflowPolicy != AsyncOverflowBlock {
+		return nil, fmt.Errorf("invalid overflow policy %q, has to be either %q or %q", settings.OverflowPolicy, AsyncOverflowDrop, AsyncOverflowBlock)
+	}
+
+	settings.Workers = max(settings.Workers, 1)
+	settings.QueueSize = max(settings.QueueSize, 1)
+
+	async := &HandlerAsync{
+		Handler:     handler,
+		clock:       clock,
+		dropCounter: dropCounter,
+		settings:    settings,
+		queue:       make(chan handlerAsyncEntry, settings.QueueSize),
+	}
+	async.idle = sync.NewCond(&async.pendingLck)
+
+	for i := 0; i < settings.Workers; i++ {
+		go async.work()
+	}
+
+	return async, nil
+}
+
+// AllowsLevelOverrides forwards the setting of the wrapped handler, see LevelOverridable.
+func (h *HandlerAsync) AllowsLevelOverrides() bool {
+	return AllowsLevelOverrides(h.Handler)
+}
+
+// Log queues the log entry to be written by a background worker.
+func (h *HandlerAsync) Log(ctx context.Context, timestamp time.Time, level int, msg string, args []any, err error, data Data) error {
+	entry := handlerAsyncEntry{
+		ctx:       context.WithoutCancel(ctx),
+		timestamp: timestamp,
+		level:     level,
+		msg:       msg,
+		args:      args,
+		err:       err,
+		data:      data,
+	}
+
+	h.addPending(1)
+
+	if h.settings.OverflowPolicy == AsyncOverflowBlock {
+		h.queue <- entry
+
+		return nil
+	}
+
+	select {
+	case h.queue <- entry:
+	default:
+		h.dropped.Add(1)
+		h.addPending(-1)
+	}
+
+	return nil
+}
+
+// Dropped returns the amount of log entries dropped since the last report of dropped entries.
+func (h *HandlerAsync) Dropped() int64 {
+	return h.dropped.Load()
+}
+
+// Flush waits until all queued log entries are written and flushes the wrapped handler if it buffers records.
+func (h *HandlerAsync) Flush() error {
+	h.pendingLck.Lock()
+	for h.pending > 0 {
+		h.idle.Wait()
+	}
+	h.pendingLck.Unlock()
+
+	h.reportDropped()
+
+	if flusher, ok := h.Handler.(Flusher); ok {
+		return flusher.Flush()
+	}
+
+	return nil
+}
+
+func (h *HandlerAsync) work() {
+	for entry := range h.queue {
+		h.reportDropped()
+
+		if err := h.Handler.Log(entry.ctx, entry.timestamp, entry.level, entry.msg, entry.args, entry.err, entry.data); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to write to log, %s\n", err)
+		}
+
+		h.addPending(-1)
+	}
+}
+
+func (h *HandlerAsync) reportDropped() {
+	dropped := h.dropped.Swap(0)
+	if dropped == 0 {
+		return
+	}
+
+	if h.dropCounter != nil {
+		h.dropCounter(dropped)
+	}
+
+	data := Data{
+		Channel:       "log",
+		ContextFields: map[string]any{},
+		Fields: map[string]any{
+			"dropped_count": dropped,
+		},
+	}
+
+	if err := h.Handler.Log(context.Background(), h.clock.Now(), PriorityWarn, "dropped %d log messages as the async queue was full", []any{dropped}, nil, data); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Failed to write to log, %s\n", err)
+	}
+}
+
+func (h *HandlerAsync) addPending(delta int) {
+	h.pendingLck.Lock()
+	defer h.pendingLck.Unlock()
+
+	h.pending += delta
+
+	if h.pending == 0 {
+		h.idle.Broadcast()
+	}
+}
@@ -0,0 +1,137 @@
+package log_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockingHandler struct {
+	lck        sync.Mutex
+	release    chan struct{}
+	messages   []string
+	timestamps []time.Time
+}
+
+func (h *blockingHandler) ChannelLevel(string) (*int, error) {
+	return nil, nil
+}
+
+func (h *blockingHandler) Level() int {
+	return log.PriorityInfo
+}
+
+func (h *blockingHandler) Log(_ context.Context, timestamp time.Time, _ int, msg string, _ []any, _ error, _ log.Data) error {
+	<-h.release
+
+	h.lck.Lock()
+	defer h.lck.Unlock()
+
+	h.messages = append(h.messages, msg)
+	h.timestamps = append(h.timestamps, timestamp)
+
+	return nil
+}
+
+func TestHandlerAsyncDrop(t *testing.T) {
+	fakeClock := clock.NewFakeClock()
+	counted := atomic.Int64{}
+	dropCounter := func(dropped int64) {
+		counted.Add(dropped)
+	}
+
+	inner := &blockingHandler{release: make(chan struct{})}
+	handler, err := log.NewHandlerAsyncWithInterfaces(fakeClock, inner, log.HandlerAsyncSettings{
+		QueueSize:      2,
+		Workers:        1,
+		OverflowPolicy: log.AsyncOverflowDrop,
+	}, dropCounter)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, handler.Log(ctx, fakeClock.Now(), log.PriorityInfo, "message", nil, nil, log.Data{}))
+	}
+
+	// one entry is taken by the worker, two are queued, the rest is dropped
+	dropped := handler.Dropped()
+	assert.GreaterOrEqual(t, dropped, int64(7))
+
+	close(inner.release)
+	assert.NoError(t, handler.Flush())
+
+	assert.Equal(t, int64(0), handler.Dropped())
+	assert.Equal(t, dropped, counted.Load())
+	assert.Contains(t, inner.messages, "dropped %d log messages as the async queue was full")
+	assert.GreaterOrEqual(t, len(inner.messages), 3)
+
+	for _, timestamp := range inner.timestamps {
+		assert.Equal(t, fakeClock.Now(), timestamp)
+	}
+}
+
+func TestHandlerAsyncBlock(t *testing.T) {
+	inner := &blockingHandler{release: make(chan struct{})}
+	handler, err := log.NewHandlerAsync(inner, log.HandlerAsyncSettings{
+		QueueSize:      1,
+		Workers:        2,
+		OverflowPolicy: log.AsyncOverflowBlock,
+	})
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, handler.Log(t.Context(), time.Now(), log.PriorityInfo, "message", nil, nil, log.Data{}))
+		}
+	}()
+
+	select {
+	case <-done:
+		assert.Fail(t, "logging should block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(inner.release)
+	<-done
+
+	assert.NoError(t, handler.Flush())
+	assert.Len(t, inner.messages, 5)
+	assert.Equal(t, int64(0), handler.Dropped())
+}
+
+func TestHandlerAsyncInvalidPolicy(t *testing.T) {
+	_, err := log.NewHandlerAsync(&blockingHandler{}, log.HandlerAsyncSettings{
+		OverflowPolicy: "ignore",
+	})
+	assert.EqualError(t, err, `invalid overflow policy "ignore", has to be either "drop" or "block"`)
+}
+
+func TestHandlerAsyncFromConfig(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"log": map[string]any{
+			"handlers": map[string]any{
+				"main": map[string]any{
+					"type": "iowriter",
+					"async": map[string]any{
+						"enabled": true,
+					},
+				},
+			},
+		},
+	})
+
+	handlers, err := log.NewHandlersFromConfig(config)
+	require.NoError(t, err)
+	assert.IsType(t, &log.HandlerAsync{}, handlers[0])
+}
@@ -0,0 +1,179 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+// BatchSettings configures how handlers shipping logs to a remote sink group records before sending them.
+type BatchSettings struct {
+	// Size is the amount of records after which a batch is sent without waiting for the interval to elapse.
+	Size int `cfg:"size" default:"500"`
+	// Interval is the maximum time a record is buffered before it is sent.
+	Interval time.Duration `cfg:"interval" default:"1s"`
+	// MaxBuffered is the maximum amount of records kept in memory while the sink is not reachable.
+	// Any additional records are dropped.
+	MaxBuffered int `cfg:"max_buffered" default:"10000"`
+}
+
+// RetrySettings configures the exponential backoff used by handlers shipping logs to a remote sink.
+type RetrySettings struct {
+	Enabled         bool          `cfg:"enabled" default:"true"`
+	InitialInterval time.Duration `cfg:"initial_interval" default:"100ms"`
+	MaxInterval     time.Duration `cfg:"max_interval" default:"5s"`
+	MaxElapsedTime  time.Duration `cfg:"max_elapsed_time" default:"30s"`
+}
+
+// Flusher is implemented by handlers (and the logger) which buffer log records in memory.
+// Flush writes all buffered records and should be called before the application exits.
+type Flusher interface {
+	Flush() error
+}
+
+// BatchSendFunc sends a batch of buffered records to the sink of a handler.
+type BatchSendFunc[T any] func(ctx context.Context, batch []T) error
+
+// Batcher collects records in memory and passes them on to a send function once the batch size is reached,
+// the batch interval elapsed or Flush is called. It is used by handlers shipping logs to a remote sink.
+type Batcher[T any] struct {
+	lck      sync.Mutex
+	sendLck  sync.Mutex
+	clock    clock.Clock
+	batch    BatchSettings
+	retry    RetrySettings
+	send     BatchSendFunc[T]
+	buffer   []T
+	dropped  int
+	full     chan struct{}
+	stopOnce sync.Once
+	stop     chan struct{}
+}
+
+// NewBatcher creates a new Batcher and starts flushing it in the background.
+func NewBatcher[T any](clk clock.Clock, batch BatchSettings, retry RetrySettings, send BatchSendFunc[T]) *Batcher[T] {
+	batch.Size = max(batch.Size, 1)
+
+	if batch.Interval <= 0 {
+		batch.Interval = time.Second
+	}
+
+	batcher := &Batcher[T]{
+		clock:  clk,
+		batch:  batch,
+		retry:  retry,
+		send:   send,
+		buffer: make([]T, 0, batch.Size),
+		full:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+	}
+
+	go batcher.run()
+
+	return batcher
+}
+
+// Add buffers a record. If the buffer already holds MaxBuffered records, the record is dropped.
+func (b *Batcher[T]) Add(record T) {
+	b.lck.Lock()
+
+	if b.batch.MaxBuffered > 0 && len(b.buffer) >= b.batch.MaxBuffered {
+		b.dropped++
+		b.lck.Unlock()
+
+		return
+	}
+
+	b.buffer = append(b.buffer, record)
+	isFull := len(b.buffer) >= b.batch.Size
+	b.lck.Unlock()
+
+	if !isFull {
+		return
+	}
+
+	select {
+	case b.full <- struct{}{}:
+	default:
+	}
+}
+
+func (b *Batcher[T]) run() {
+	ticker := b.clock.NewTicker(b.batch.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.stop:
+			return
+		case <-ticker.Chan():
+		case <-b.full:
+		}
+
+		if err := b.Flush(); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to write to log, %s\n", err)
+		}
+	}
+}
+
+// Flush sends all buffered records, split into batches of the configured size. A failing batch doesn't stop the
+// following batches from being sent, the errors of all failed batches are returned together.
+func (b *Batcher[T]) Flush() error {
+	b.sendLck.Lock()
+	defer b.sendLck.Unlock()
+
+	b.lck.Lock()
+	records := b.buffer
+	dropped := b.dropped
+	b.buffer = make([]T, 0, b.batch.Size)
+	b.dropped = 0
+	b.lck.Unlock()
+
+	if dropped > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "Failed to write to log, dropped %d records as the buffer was full\n", dropped)
+	}
+
+	var errs []error
+
+	for start := 0; start < len(records); start += b.batch.Size {
+		end := min(start+b.batch.Size, len(records))
+
+		if err := b.sendWithRetry(records[start:end]); err != nil {
+			errs = append(errs, fmt.Errorf("can not send batch of %d log records: %w", end-start, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Close flushes all remaining records and stops the background flushing.
+func (b *Batcher[T]) Close() error {
+	b.stopOnce.Do(func() {
+		close(b.stop)
+	})
+
+	return b.Flush()
+}
+
+func (b *Batcher[T]) sendWithRetry(batch []T) error {
+	ctx := context.Background()
+
+	if !b.retry.Enabled {
+		return b.send(ctx, batch)
+	}
+
+	backoffConfig := backoff.NewExponentialBackOff()
+	backoffConfig.InitialInterval = b.retry.InitialInterval
+	backoffConfig.MaxInterval = b.retry.MaxInterval
+	backoffConfig.MaxElapsedTime = b.retry.MaxElapsedTime
+
+	return backoff.Retry(func() error {
+		return b.send(ctx, batch)
+	}, backoffConfig)
+}
@@ -0,0 +1,60 @@
+package log_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatcher_FlushContinuesAfterFailedBatch(t *testing.T) {
+	sent := make(chan []int)
+	results := make(chan error)
+
+	send := func(_ context.Context, batch []int) error {
+		sent <- append([]int(nil), batch...)
+
+		return <-results
+	}
+
+	// the interval of the fake clock never elapses, so only full batches are flushed in the background
+	batcher := log.NewBatcher(clock.NewFakeClock(), log.BatchSettings{
+		Size:        2,
+		Interval:    time.Hour,
+		MaxBuffered: 10,
+	}, log.RetrySettings{}, send)
+
+	receive := func() []int {
+		select {
+		case batch := <-sent:
+			return batch
+		case <-time.After(time.Second):
+			require.FailNow(t, "no batch was sent")
+
+			return nil
+		}
+	}
+
+	batcher.Add(1)
+	batcher.Add(2)
+	assert.Equal(t, []int{1, 2}, receive())
+
+	// the background flush is blocked by the send of the first batch until the other records are buffered
+	batcher.Add(3)
+	batcher.Add(4)
+	batcher.Add(5)
+	results <- nil
+
+	assert.Equal(t, []int{3, 4}, receive())
+	results <- fmt.Errorf("sink not reachable")
+
+	assert.Equal(t, []int{5}, receive(), "the batch after the failed one should still be sent")
+	results <- nil
+
+	assert.NoError(t, batcher.Close())
+}
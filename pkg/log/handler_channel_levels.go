@@ -0,0 +1,142 @@
+package log
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+)
+
+// ChannelSetting configures the log level for a specific channel.
+type ChannelSetting struct {
+	Level string `cfg:"level"`
+}
+
+// channelLevels resolves and caches the channel specific log levels configured below
+// log.handlers.<name>.channels.<pattern>.level for a single handler. If no level is configured for the exact
+// channel name, the most specific wildcard pattern (like "stream.*" or "*") matching the channel is used.
+type channelLevels struct {
+	config      cfg.Config
+	lck         sync.RWMutex
+	handlerName string
+	channels    map[string]*int
+	patterns    map[string]string
+	overrides   *bool
+}
+
+func newChannelLevels(config cfg.Config, handlerName string) *channelLevels {
+	return &channelLevels{
+		config:      config,
+		handlerName: handlerName,
+		channels:    make(map[string]*int),
+	}
+}
+
+// ChannelLevel returns the specific log level configured for a given channel, or an error if the channel settings are invalid.
+func (c *channelLevels) ChannelLevel(name string) (level *int, err error) {
+	c.lck.RLock()
+	cached, ok := c.channels[name]
+	c.lck.RUnlock()
+
+	if ok {
+		return cached, nil
+	}
+
+	c.lck.Lock()
+	defer c.lck.Unlock()
+
+	key := fmt.Sprintf("%s.channels.%s", getHandlerConfigKey(c.handlerName), name)
+	settings := &ChannelSetting{}
+	err = c.config.UnmarshalKey(key, settings)
+	if err != nil {
+		// store that we don't have a setting to avoid spamming errors
+		c.channels[name] = nil
+
+		return nil, fmt.Errorf("can not unmarshal channel settings: %w", err)
+	}
+
+	if settings.Level == "" {
+		if settings.Level, err = c.patternLevel(name); err != nil {
+			c.channels[name] = nil
+
+			return nil, err
+		}
+	}
+
+	if settings.Level == "" {
+		c.channels[name] = nil
+
+		return nil, nil
+	}
+
+	priority, ok := LevelPriority(settings.Level)
+	if !ok {
+		c.channels[name] = nil
+
+		return nil, fmt.Errorf("invalid log level priority %q", settings.Level)
+	}
+
+	c.channels[name] = &priority
+
+	return &priority, nil
+}
+
+// AllowsLevelOverrides returns the setting log.handlers.<name>.override_levels (false by default), see LevelOverridable.
+func (c *channelLevels) AllowsLevelOverrides() bool {
+	c.lck.RLock()
+	overrides := c.overrides
+	c.lck.RUnlock()
+
+	if overrides != nil {
+		return *overrides
+	}
+
+	c.lck.Lock()
+	defer c.lck.Unlock()
+
+	key := fmt.Sprintf("%s.override_levels", getHandlerConfigKey(c.handlerName))
+	allowed, err := c.config.GetBool(key, false)
+	// an invalid setting keeps the overrides disabled
+	allowed = allowed && err == nil
+	c.overrides = &allowed
+
+	return allowed
+}
+
+// patternLevel returns the level of the most specific wildcard pattern matching the channel.
+// Has to be called while holding the write lock.
+func (c *channelLevels) patternLevel(name string) (string, error) {
+	if c.patterns == nil {
+		key := fmt.Sprintf("%s.channels", getHandlerConfigKey(c.handlerName))
+		channels, err := c.config.GetStringMap(key, map[string]any{})
+		if err != nil {
+			return "", fmt.Errorf("can not read channel settings: %w", err)
+		}
+
+		c.patterns = map[string]string{}
+		flattenChannelSettings("", channels, c.patterns)
+	}
+
+	level, _ := matchChannelLevel(c.patterns, name)
+
+	return level, nil
+}
+
+// flattenChannelSettings collects the levels of all channel settings. Channel names containing dots end up as nested
+// maps in the config, so the path to every map containing a level is joined with dots again.
+func flattenChannelSettings(prefix string, settings map[string]any, patterns map[string]string) {
+	for key, value := range settings {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		pattern := joinChannel(prefix, key)
+
+		if level, ok := nested["level"].(string); ok && level != "" {
+			patterns[pattern] = level
+		}
+
+		flattenChannelSettings(pattern, nested, patterns)
+	}
+}
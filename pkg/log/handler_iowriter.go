@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"sync"
 	"time"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
@@ -22,11 +21,6 @@ type HandlerIoWriterSettings struct {
 	Writer          string `cfg:"writer" default:"stdout"`
 }
 
-// ChannelSetting configures the log level for a specific channel.
-type ChannelSetting struct {
-	Level string `cfg:"level"`
-}
-
 func handlerIoWriterFactory(config cfg.Config, name string) (Handler, error) {
 	handlerConfigKey := getHandlerConfigKey(name)
 
@@ -49,8 +43,8 @@ func handlerIoWriterFactory(config cfg.Config, name string) (Handler, error) {
 		return nil, fmt.Errorf("can not create io writer of type %s: %w", settings.Writer, err)
 	}
 
-	if formatter, ok = formatters[settings.Formatter]; !ok {
-		return nil, fmt.Errorf("io writer formatter of type %s not available", settings.Formatter)
+	if formatter, err = NewFormatterFromConfig(config, settings.Formatter); err != nil {
+		return nil, fmt.Errorf("can not create io writer formatter: %w", err)
 	}
 
 	priority, ok := LevelPriority(settings.Level)
@@ -62,10 +56,8 @@ func handlerIoWriterFactory(config cfg.Config, name string) (Handler, error) {
 }
 
 type handlerIoWriter struct {
-	config          cfg.Config
-	lck             sync.RWMutex
+	*channelLevels
 	level           int
-	channels        map[string]*int
 	formatter       Formatter
 	name            string
 	timestampFormat string
@@ -74,9 +66,8 @@ type handlerIoWriter struct {
 
 func NewHandlerIoWriter(config cfg.Config, levelPriority int, formatter Formatter, name string, timestampFormat string, writer io.Writer) Handler {
 	return &handlerIoWriter{
-		config:          config,
+		channelLevels:   newChannelLevels(config, name),
 		level:           levelPriority,
-		channels:        make(map[string]*int),
 		formatter:       formatter,
 		name:            name,
 		timestampFormat: timestampFormat,
@@ -84,47 +75,6 @@ func NewHandlerIoWriter(config cfg.Config, levelPriority int, formatter Formatte
 	}
 }
 
-// ChannelLevel returns the specific log level configured for a given channel, or an error if the channel settings are invalid.
-func (h *handlerIoWriter) ChannelLevel(name string) (level *int, err error) {
-	h.lck.RLock()
-	cached, ok := h.channels[name]
-	h.lck.RUnlock()
-
-	if ok {
-		return cached, nil
-	}
-
-	h.lck.Lock()
-	defer h.lck.Unlock()
-
-	key := fmt.Sprintf("%s.channels.%s", getHandlerConfigKey(h.name), name)
-	settings := &ChannelSetting{}
-	err = h.config.UnmarshalKey(key, settings)
-	if err != nil {
-		// store that we don't have a setting to avoid spamming errors
-		h.channels[name] = nil
-
-		return nil, fmt.Errorf("can not unmarshal channel settings: %w", err)
-	}
-
-	if settings.Level == "" {
-		h.channels[name] = nil
-
-		return nil, nil
-	}
-
-	priority, ok := LevelPriority(settings.Level)
-	if !ok {
-		h.channels[name] = nil
-
-		return nil, fmt.Errorf("invalid log level priority %q", settings.Level)
-	}
-
-	h.channels[name] = &priority
-
-	return &priority, nil
-}
-
 // Level returns the default log level priority for this handler.
 func (h *handlerIoWriter) Level() int {
 	return h.level
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
 )
 
 func init() {
@@ -13,7 +14,8 @@ func init() {
 }
 
 type ioWriterFileSettings struct {
-	Path string `cfg:"path" default:"logs.log"`
+	Path     string                   `cfg:"path" default:"logs.log"`
+	Rotation IoWriterRotationSettings `cfg:"rotation"`
 }
 
 func ioWriterFileFactory(config cfg.Config, configKey string) (io.Writer, error) {
@@ -22,6 +24,10 @@ func ioWriterFileFactory(config cfg.Config, configKey string) (io.Writer, error)
 		return nil, fmt.Errorf("failed to unmarshal ioWriterFile settings for key %q: %w", configKey, err)
 	}
 
+	if settings.Rotation.MaxSizeMb > 0 || settings.Rotation.Interval > 0 {
+		return NewIoWriterRotatingFile(clock.Provider, settings.Path, settings.Rotation)
+	}
+
 	return NewIoWriterFile(settings.Path)
 }
 
@@ -0,0 +1,240 @@
+package log
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+const rotatedFileTimeFormat = "2006-01-02T15-04-05.000"
+
+// IoWriterRotationSettings configures the rotation of the log file written by the "file" io writer.
+// Rotation is disabled as long as neither a max size nor an interval is configured.
+type IoWriterRotationSettings struct {
+	// MaxSizeMb rotates the file before it grows beyond this size.
+	MaxSizeMb int `cfg:"max_size_mb" default:"0"`
+	// Interval rotates the file whenever a multiple of the interval has passed, e.g. 24h rotates at midnight (UTC).
+	Interval time.Duration `cfg:"interval" default:"0s"`
+	// MaxBackups is the amount of rotated files to keep. Older files are removed, 0 keeps all files.
+	MaxBackups int `cfg:"max_backups" default:"7"`
+	// Compress gzips the rotated files.
+	Compress bool `cfg:"compress" default:"true"`
+}
+
+// IoWriterRotatingFile is an io.Writer appending to a file, which is rotated once it reaches a maximum size or
+// the rotation interval elapsed. Rotated files get the rotation time appended to their name, are optionally
+// compressed and only the configured amount of backups is retained.
+type IoWriterRotatingFile struct {
+	lck            sync.Mutex
+	maintenanceLck sync.Mutex
+	maintenance    sync.WaitGroup
+	clock          clock.Clock
+	path           string
+	settings       IoWriterRotationSettings
+	file           *os.File
+	size           int64
+	nextRotation   time.Time
+}
+
+// NewIoWriterRotatingFile creates a new rotating file writer for the file at the specified path.
+func NewIoWriterRotatingFile(clock clock.Clock, path string, settings IoWriterRotationSettings) (*IoWriterRotatingFile, error) {
+	writer := &IoWriterRotatingFile{
+		clock:    clock,
+		path:     path,
+		settings: settings,
+	}
+
+	if err := writer.open(); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// Write appends p to the current file, rotating the file before if needed.
+func (w *IoWriterRotatingFile) Write(p []byte) (int, error) {
+	w.lck.Lock()
+	defer w.lck.Unlock()
+
+	if w.shouldRotate(len(p)) {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+
+	return n, err
+}
+
+// Close closes the current file and waits for the compression and cleanup of rotated files to finish.
+func (w *IoWriterRotatingFile) Close() error {
+	w.lck.Lock()
+	defer w.lck.Unlock()
+
+	w.maintenance.Wait()
+
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("can not close log file %s: %w", w.path, err)
+	}
+
+	return nil
+}
+
+func (w *IoWriterRotatingFile) open() error {
+	var err error
+	var info os.FileInfo
+
+	if w.file, err = os.OpenFile(w.path, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0o600); err != nil {
+		return fmt.Errorf("can not open file %s to write logs to: %w", w.path, err)
+	}
+
+	if info, err = w.file.Stat(); err != nil {
+		return fmt.Errorf("can not stat log file %s: %w", w.path, err)
+	}
+
+	w.size = info.Size()
+
+	if w.settings.Interval > 0 {
+		w.nextRotation = w.clock.Now().Truncate(w.settings.Interval).Add(w.settings.Interval)
+	}
+
+	return nil
+}
+
+func (w *IoWriterRotatingFile) shouldRotate(writeSize int) bool {
+	maxSize := int64(w.settings.MaxSizeMb) * 1024 * 1024
+
+	if maxSize > 0 && w.size > 0 && w.size+int64(writeSize) > maxSize {
+		return true
+	}
+
+	return w.settings.Interval > 0 && !w.clock.Now().Before(w.nextRotation)
+}
+
+func (w *IoWriterRotatingFile) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return fmt.Errorf("can not close log file %s: %w", w.path, err)
+	}
+
+	rotatedPath := w.rotatedPath()
+	if err := os.Rename(w.path, rotatedPath); err != nil {
+		return fmt.Errorf("can not rename log file %s to %s: %w", w.path, rotatedPath, err)
+	}
+
+	if err := w.open(); err != nil {
+		return err
+	}
+
+	w.maintenance.Add(1)
+	go w.maintain(rotatedPath)
+
+	return nil
+}
+
+func (w *IoWriterRotatingFile) rotatedPath() string {
+	base := fmt.Sprintf("%s.%s", w.path, w.clock.Now().UTC().Format(rotatedFileTimeFormat))
+	rotatedPath := base
+
+	for i := 1; w.exists(rotatedPath) || w.exists(rotatedPath+".gz"); i++ {
+		rotatedPath = fmt.Sprintf("%s.%d", base, i)
+	}
+
+	return rotatedPath
+}
+
+func (w *IoWriterRotatingFile) exists(path string) bool {
+	_, err := os.Stat(path)
+
+	return err == nil
+}
+
+func (w *IoWriterRotatingFile) maintain(rotatedPath string) {
+	defer w.maintenance.Done()
+
+	w.maintenanceLck.Lock()
+	defer w.maintenanceLck.Unlock()
+
+	if w.settings.Compress {
+		if err := compressFile(rotatedPath); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to compress rotated log file, %s\n", err)
+		}
+	}
+
+	if err := w.removeOldBackups(); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Failed to remove old log files, %s\n", err)
+	}
+}
+
+func (w *IoWriterRotatingFile) removeOldBackups() error {
+	if w.settings.MaxBackups <= 0 {
+		return nil
+	}
+
+	backups, err := filepath.Glob(w.path + ".*")
+	if err != nil {
+		return fmt.Errorf("can not list rotated log files: %w", err)
+	}
+
+	// the rotation time is part of the file name, so sorting by name sorts the files by age
+	sort.Strings(backups)
+
+	for len(backups) > w.settings.MaxBackups {
+		if err = os.Remove(backups[0]); err != nil {
+			return fmt.Errorf("can not remove rotated log file %s: %w", backups[0], err)
+		}
+
+		backups = backups[1:]
+	}
+
+	return nil
+}
+
+func compressFile(path string) (err error) {
+	var source, target *os.File
+
+	if strings.HasSuffix(path, ".gz") {
+		return nil
+	}
+
+	if source, err = os.Open(path); err != nil {
+		return fmt.Errorf("can not open file %s: %w", path, err)
+	}
+
+	defer func() {
+		_ = source.Close()
+	}()
+
+	if target, err = os.OpenFile(path+".gz", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600); err != nil {
+		return fmt.Errorf("can not create file %s.gz: %w", path, err)
+	}
+
+	gz := gzip.NewWriter(target)
+
+	if _, err = io.Copy(gz, source); err != nil {
+		_ = target.Close()
+
+		return fmt.Errorf("can not compress file %s: %w", path, err)
+	}
+
+	if err = gz.Close(); err != nil {
+		_ = target.Close()
+
+		return fmt.Errorf("can not finish compression of file %s: %w", path, err)
+	}
+
+	if err = target.Close(); err != nil {
+		return fmt.Errorf("can not close file %s.gz: %w", path, err)
+	}
+
+	return os.Remove(path)
+}
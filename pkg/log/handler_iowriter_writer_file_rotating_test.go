@@ -0,0 +1,133 @@
+package log_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type IoWriterRotatingFileTestSuite struct {
+	suite.Suite
+
+	dir   string
+	path  string
+	clock clock.FakeClock
+}
+
+func TestIoWriterRotatingFileTestSuite(t *testing.T) {
+	suite.Run(t, new(IoWriterRotatingFileTestSuite))
+}
+
+func (s *IoWriterRotatingFileTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.path = filepath.Join(s.dir, "app.log")
+	s.clock = clock.NewFakeClockAt(time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC))
+}
+
+func (s *IoWriterRotatingFileTestSuite) TestRotateBySize() {
+	writer, err := log.NewIoWriterRotatingFile(s.clock, s.path, log.IoWriterRotationSettings{
+		MaxSizeMb:  1,
+		MaxBackups: 5,
+		Compress:   false,
+	})
+	s.NoError(err)
+
+	chunk := bytes.Repeat([]byte("a"), 600*1024)
+
+	_, err = writer.Write(chunk)
+	s.NoError(err)
+	_, err = writer.Write(chunk)
+	s.NoError(err)
+	s.NoError(writer.Close())
+
+	s.Equal([]string{"app.log", "app.log.2024-01-01T10-30-00.000"}, s.files())
+	s.Len(s.read(s.path), len(chunk))
+}
+
+func (s *IoWriterRotatingFileTestSuite) TestRotateByIntervalWithCompression() {
+	writer, err := log.NewIoWriterRotatingFile(s.clock, s.path, log.IoWriterRotationSettings{
+		Interval:   time.Hour,
+		MaxBackups: 5,
+		Compress:   true,
+	})
+	s.NoError(err)
+
+	_, err = writer.Write([]byte("first\n"))
+	s.NoError(err)
+
+	s.clock.Advance(29 * time.Minute)
+	_, err = writer.Write([]byte("second\n"))
+	s.NoError(err)
+
+	s.clock.Advance(time.Minute)
+	_, err = writer.Write([]byte("third\n"))
+	s.NoError(err)
+	s.NoError(writer.Close())
+
+	s.Equal([]string{"app.log", "app.log.2024-01-01T11-00-00.000.gz"}, s.files())
+	s.Equal("third\n", string(s.read(s.path)))
+
+	file, err := os.Open(filepath.Join(s.dir, "app.log.2024-01-01T11-00-00.000.gz"))
+	s.NoError(err)
+	defer func() {
+		s.NoError(file.Close())
+	}()
+
+	gz, err := gzip.NewReader(file)
+	s.NoError(err)
+
+	content, err := io.ReadAll(gz)
+	s.NoError(err)
+	s.Equal("first\nsecond\n", string(content))
+}
+
+func (s *IoWriterRotatingFileTestSuite) TestRetention() {
+	writer, err := log.NewIoWriterRotatingFile(s.clock, s.path, log.IoWriterRotationSettings{
+		Interval:   time.Hour,
+		MaxBackups: 2,
+		Compress:   false,
+	})
+	s.NoError(err)
+
+	for i := 0; i < 4; i++ {
+		_, err = writer.Write([]byte("line\n"))
+		s.NoError(err)
+
+		s.clock.Advance(time.Hour)
+	}
+
+	s.NoError(writer.Close())
+
+	s.Equal([]string{
+		"app.log",
+		"app.log.2024-01-01T12-30-00.000",
+		"app.log.2024-01-01T13-30-00.000",
+	}, s.files())
+}
+
+func (s *IoWriterRotatingFileTestSuite) files() []string {
+	entries, err := os.ReadDir(s.dir)
+	s.NoError(err)
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+
+	return names
+}
+
+func (s *IoWriterRotatingFileTestSuite) read(path string) []byte {
+	content, err := os.ReadFile(path)
+	s.NoError(err)
+
+	return content
+}
@@ -0,0 +1,251 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+)
+
+const lokiPushPath = "/loki/api/v1/push"
+
+func init() {
+	AddHandlerFactory("loki", handlerLokiFactory)
+}
+
+// HandlerLokiSettings configures the "loki" handler, which pushes log lines to the Grafana Loki push API.
+type HandlerLokiSettings struct {
+	Level           string            `cfg:"level" default:"info"`
+	Url             string            `cfg:"url" default:"http://localhost:3100"`
+	TenantId        string            `cfg:"tenant_id"`
+	Username        string            `cfg:"username"`
+	Password        string            `cfg:"password"`
+	Formatter       string            `cfg:"formatter" default:"json"`
+	TimestampFormat string            `cfg:"timestamp_format" default:"2006-01-02T15:04:05.000Z07:00"`
+	Labels          map[string]string `cfg:"labels"`
+	Timeout         time.Duration     `cfg:"timeout" default:"10s"`
+	Batch           BatchSettings     `cfg:"batch"`
+	Retry           RetrySettings     `cfg:"retry"`
+}
+
+type lokiEntry struct {
+	stream    string
+	labels    map[string]string
+	timestamp time.Time
+	line      string
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPushRequest struct {
+	Streams []*lokiStream `json:"streams"`
+}
+
+func handlerLokiFactory(config cfg.Config, name string) (Handler, error) {
+	return NewHandlerLoki(config, name)
+}
+
+// HandlerLoki buffers log lines and pushes them in batches to Loki. Every line is labeled with the app identity
+// (app, env and namespace), the channel and the level of the log entry as well as the configured static labels.
+type HandlerLoki struct {
+	*channelLevels
+	level     int
+	formatter Formatter
+	client    *http.Client
+	labels    map[string]string
+	settings  *HandlerLokiSettings
+	batcher   *Batcher[lokiEntry]
+}
+
+// NewHandlerLoki creates a new Loki handler with the settings found at log.handlers.<name>.
+func NewHandlerLoki(config cfg.Config, name string) (*HandlerLoki, error) {
+	settings := &HandlerLokiSettings{}
+	if err := UnmarshalHandlerSettingsFromConfig(config, name, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal handler settings: %w", err)
+	}
+
+	client := &http.Client{
+		Timeout: settings.Timeout,
+	}
+
+	return NewHandlerLokiWithInterfaces(clock.Provider, config, name, client, settings)
+}
+
+// NewHandlerLokiWithInterfaces creates a new Loki handler pushing the log lines with the provided http client.
+func NewHandlerLokiWithInterfaces(clock clock.Clock, config cfg.Config, name string, client *http.Client, settings *HandlerLokiSettings) (*HandlerLoki, error) {
+	var err error
+	var ok bool
+	var priority int
+	var formatter Formatter
+	var identity cfg.Identity
+	var namespace string
+
+	if priority, ok = LevelPriority(settings.Level); !ok {
+		return nil, fmt.Errorf("invalid log level %q", settings.Level)
+	}
+
+	if formatter, err = NewFormatterFromConfig(config, settings.Formatter); err != nil {
+		return nil, fmt.Errorf("can not create loki formatter: %w", err)
+	}
+
+	if identity, err = cfg.GetAppIdentity(config); err != nil {
+		return nil, fmt.Errorf("can not get app identity: %w", err)
+	}
+
+	if namespace, err = identity.FormatNamespace("."); err != nil {
+		return nil, fmt.Errorf("failed to format namespace: %w", err)
+	}
+
+	labels := map[string]string{
+		"app": identity.Name,
+		"env": identity.Env,
+	}
+
+	if namespace != "" {
+		labels["namespace"] = namespace
+	}
+
+	for key, value := range settings.Labels {
+		labels[key] = value
+	}
+
+	handler := &HandlerLoki{
+		channelLevels: newChannelLevels(config, name),
+		level:         priority,
+		formatter:     formatter,
+		client:        client,
+		labels:        labels,
+		settings:      settings,
+	}
+	handler.batcher = NewBatcher(clock, settings.Batch, settings.Retry, handler.push)
+
+	return handler, nil
+}
+
+// Level returns the default log level priority for this handler.
+func (h *HandlerLoki) Level() int {
+	return h.level
+}
+
+// Log formats the log entry and adds it to the current batch.
+func (h *HandlerLoki) Log(_ context.Context, timestamp time.Time, level int, msg string, args []any, logErr error, data Data) error {
+	line, err := h.formatter(timestamp.Format(h.settings.TimestampFormat), level, msg, args, logErr, data)
+	if err != nil {
+		return fmt.Errorf("can not format log message: %w", err)
+	}
+
+	labels := make(map[string]string, len(h.labels)+2)
+	for key, value := range h.labels {
+		labels[key] = value
+	}
+
+	labels["channel"] = data.Channel
+	labels["level"] = LevelName(level)
+
+	h.batcher.Add(lokiEntry{
+		stream:    lokiStreamKey(labels),
+		labels:    labels,
+		timestamp: timestamp,
+		line:      strings.TrimRight(string(line), "\n"),
+	})
+
+	return nil
+}
+
+// Flush pushes all buffered log lines to Loki.
+func (h *HandlerLoki) Flush() error {
+	return h.batcher.Flush()
+}
+
+func (h *HandlerLoki) push(ctx context.Context, entries []lokiEntry) error {
+	var err error
+	var body []byte
+	var request *http.Request
+	var response *http.Response
+
+	streams := make(map[string]*lokiStream)
+	pushRequest := &lokiPushRequest{}
+
+	for _, entry := range entries {
+		stream, ok := streams[entry.stream]
+		if !ok {
+			stream = &lokiStream{
+				Stream: entry.labels,
+			}
+			streams[entry.stream] = stream
+			pushRequest.Streams = append(pushRequest.Streams, stream)
+		}
+
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(entry.timestamp.UnixNano(), 10), entry.line})
+	}
+
+	if body, err = json.Marshal(pushRequest); err != nil {
+		return backoff.Permanent(fmt.Errorf("can not marshal loki push request: %w", err))
+	}
+
+	url := strings.TrimRight(h.settings.Url, "/") + lokiPushPath
+	if request, err = http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body)); err != nil {
+		return backoff.Permanent(fmt.Errorf("can not create loki push request: %w", err))
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	if h.settings.TenantId != "" {
+		request.Header.Set("X-Scope-OrgID", h.settings.TenantId)
+	}
+
+	if h.settings.Username != "" {
+		request.SetBasicAuth(h.settings.Username, h.settings.Password)
+	}
+
+	if response, err = h.client.Do(request); err != nil {
+		return fmt.Errorf("can not push log lines to %s: %w", url, err)
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < 300 {
+		return nil
+	}
+
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	err = fmt.Errorf("loki responded with status %d: %s", response.StatusCode, strings.TrimSpace(string(responseBody)))
+
+	// client errors besides rate limiting won't go away by sending the same request again
+	if response.StatusCode < 500 && response.StatusCode != http.StatusTooManyRequests {
+		return backoff.Permanent(err)
+	}
+
+	return err
+}
+
+func lokiStreamKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+
+	for i, key := range keys {
+		parts[i] = fmt.Sprintf("%s=%q", key, labels[key])
+	}
+
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,154 @@
+package log_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type lokiPushRequest struct {
+	Streams []struct {
+		Stream map[string]string `json:"stream"`
+		Values [][2]string       `json:"values"`
+	} `json:"streams"`
+}
+
+type HandlerLokiTestSuite struct {
+	suite.Suite
+
+	lck       sync.Mutex
+	server    *httptest.Server
+	requests  []lokiPushRequest
+	headers   []http.Header
+	responses []int
+	clock     clock.FakeClock
+	handler   *log.HandlerLoki
+}
+
+func TestHandlerLokiTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerLokiTestSuite))
+}
+
+func (s *HandlerLokiTestSuite) SetupTest() {
+	s.requests = nil
+	s.headers = nil
+	s.responses = nil
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lck.Lock()
+		defer s.lck.Unlock()
+
+		s.Equal("/loki/api/v1/push", r.URL.Path)
+
+		if len(s.responses) > 0 {
+			status := s.responses[0]
+			s.responses = s.responses[1:]
+
+			if status >= 300 {
+				w.WriteHeader(status)
+
+				return
+			}
+		}
+
+		body, err := io.ReadAll(r.Body)
+		s.NoError(err)
+
+		request := lokiPushRequest{}
+		s.NoError(json.Unmarshal(body, &request))
+
+		s.requests = append(s.requests, request)
+		s.headers = append(s.headers, r.Header)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":  "test",
+			"name": "loki",
+		},
+	})
+
+	var err error
+	s.clock = clock.NewFakeClock()
+	s.handler, err = log.NewHandlerLokiWithInterfaces(s.clock, config, "loki", s.server.Client(), &log.HandlerLokiSettings{
+		Level:           log.LevelInfo,
+		Url:             s.server.URL,
+		TenantId:        "tenant",
+		Formatter:       "simple",
+		TimestampFormat: time.RFC3339,
+		Labels:          map[string]string{"cluster": "local"},
+		Batch: log.BatchSettings{
+			Size:     10,
+			Interval: time.Minute,
+		},
+		Retry: log.RetrySettings{
+			Enabled:         true,
+			InitialInterval: time.Millisecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Second,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *HandlerLokiTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *HandlerLokiTestSuite) TestPushGroupsStreams() {
+	ctx := s.T().Context()
+
+	s.NoError(s.handler.Log(ctx, s.clock.Now(), log.PriorityInfo, "first", nil, nil, log.Data{Channel: "main"}))
+	s.NoError(s.handler.Log(ctx, s.clock.Now(), log.PriorityInfo, "second", nil, nil, log.Data{Channel: "main"}))
+	s.NoError(s.handler.Log(ctx, s.clock.Now(), log.PriorityWarn, "third", nil, nil, log.Data{Channel: "stream"}))
+	s.NoError(s.handler.Flush())
+
+	s.Len(s.requests, 1)
+	s.Equal("tenant", s.headers[0].Get("X-Scope-OrgID"))
+	s.Len(s.requests[0].Streams, 2)
+
+	main := s.requests[0].Streams[0]
+	s.Equal(map[string]string{
+		"app":     "loki",
+		"env":     "test",
+		"cluster": "local",
+		"channel": "main",
+		"level":   "info",
+	}, main.Stream)
+	s.Len(main.Values, 2)
+	s.Contains(main.Values[0][1], "first")
+	s.Contains(main.Values[1][1], "second")
+	s.NotContains(main.Values[0][1], "\n")
+
+	stream := s.requests[0].Streams[1]
+	s.Equal("stream", stream.Stream["channel"])
+	s.Equal("warn", stream.Stream["level"])
+}
+
+func (s *HandlerLokiTestSuite) TestPushRetriesServerErrors() {
+	s.responses = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+
+	s.NoError(s.handler.Log(s.T().Context(), s.clock.Now(), log.PriorityInfo, "msg", nil, nil, log.Data{Channel: "main"}))
+	s.NoError(s.handler.Flush())
+
+	s.Len(s.requests, 1)
+}
+
+func (s *HandlerLokiTestSuite) TestPushDoesNotRetryClientErrors() {
+	s.responses = []int{http.StatusBadRequest}
+
+	s.NoError(s.handler.Log(s.T().Context(), s.clock.Now(), log.PriorityInfo, "msg", nil, nil, log.Data{Channel: "main"}))
+	s.EqualError(s.handler.Flush(), "can not send batch of 1 log records: loki responded with status 400: ")
+
+	s.Len(s.requests, 0)
+}
@@ -0,0 +1,311 @@
+package log
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const otlpScopeName = "github.com/justtrackio/gosoline/pkg/log"
+
+func init() {
+	AddHandlerFactory("otlp", handlerOtlpFactory)
+}
+
+// HandlerOtlpSettings configures the "otlp" handler, which ships log records via OTLP/gRPC to an OpenTelemetry collector.
+type HandlerOtlpSettings struct {
+	Level    string            `cfg:"level" default:"info"`
+	Endpoint string            `cfg:"endpoint" default:"localhost:4317"`
+	Insecure bool              `cfg:"insecure" default:"false"`
+	Headers  map[string]string `cfg:"headers"`
+	Timeout  time.Duration     `cfg:"timeout" default:"10s"`
+	Batch    BatchSettings     `cfg:"batch"`
+	Retry    RetrySettings     `cfg:"retry"`
+}
+
+func handlerOtlpFactory(config cfg.Config, name string) (Handler, error) {
+	return NewHandlerOtlp(config, name)
+}
+
+var severityNumbers = map[int]logspb.SeverityNumber{
+	PriorityTrace: logspb.SeverityNumber_SEVERITY_NUMBER_TRACE,
+	PriorityDebug: logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG,
+	PriorityInfo:  logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+	PriorityWarn:  logspb.SeverityNumber_SEVERITY_NUMBER_WARN,
+	PriorityError: logspb.SeverityNumber_SEVERITY_NUMBER_ERROR,
+}
+
+// HandlerOtlp buffers log records and exports them in batches to an OpenTelemetry collector.
+// The resource attributes of the exported records are derived from the app identity.
+type HandlerOtlp struct {
+	*channelLevels
+	level    int
+	client   collogspb.LogsServiceClient
+	resource *resourcepb.Resource
+	settings *HandlerOtlpSettings
+	batcher  *Batcher[*logspb.LogRecord]
+}
+
+// NewHandlerOtlp creates a new OTLP handler with the settings found at log.handlers.<name>.
+func NewHandlerOtlp(config cfg.Config, name string) (*HandlerOtlp, error) {
+	var err error
+	var conn *grpc.ClientConn
+	var resource *resourcepb.Resource
+
+	settings := &HandlerOtlpSettings{}
+	if err = UnmarshalHandlerSettingsFromConfig(config, name, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal handler settings: %w", err)
+	}
+
+	transportCredentials := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if settings.Insecure {
+		transportCredentials = insecure.NewCredentials()
+	}
+
+	if conn, err = grpc.NewClient(settings.Endpoint, grpc.WithTransportCredentials(transportCredentials)); err != nil {
+		return nil, fmt.Errorf("can not create grpc client for endpoint %s: %w", settings.Endpoint, err)
+	}
+
+	if resource, err = NewOtlpResource(config); err != nil {
+		return nil, fmt.Errorf("can not create otlp resource: %w", err)
+	}
+
+	client := collogspb.NewLogsServiceClient(conn)
+
+	return NewHandlerOtlpWithInterfaces(clock.Provider, config, name, client, resource, settings)
+}
+
+// NewHandlerOtlpWithInterfaces creates a new OTLP handler using the provided client to export the records.
+func NewHandlerOtlpWithInterfaces(
+	clock clock.Clock,
+	config cfg.Config,
+	name string,
+	client collogspb.LogsServiceClient,
+	resource *resourcepb.Resource,
+	settings *HandlerOtlpSettings,
+) (*HandlerOtlp, error) {
+	priority, ok := LevelPriority(settings.Level)
+	if !ok {
+		return nil, fmt.Errorf("invalid log level %q", settings.Level)
+	}
+
+	handler := &HandlerOtlp{
+		channelLevels: newChannelLevels(config, name),
+		level:         priority,
+		client:        client,
+		resource:      resource,
+		settings:      settings,
+	}
+	handler.batcher = NewBatcher(clock, settings.Batch, settings.Retry, handler.export)
+
+	return handler, nil
+}
+
+// NewOtlpResource describes the application in terms of OpenTelemetry resource attributes based on the app identity.
+func NewOtlpResource(config cfg.Config) (*resourcepb.Resource, error) {
+	var err error
+	var identity cfg.Identity
+	var namespace string
+
+	if identity, err = cfg.GetAppIdentity(config); err != nil {
+		return nil, fmt.Errorf("can not get app identity: %w", err)
+	}
+
+	if namespace, err = identity.FormatNamespace("."); err != nil {
+		return nil, fmt.Errorf("failed to format namespace: %w", err)
+	}
+
+	attributes := map[string]any{
+		"service.name":           identity.Name,
+		"deployment.environment": identity.Env,
+	}
+
+	if namespace != "" {
+		attributes["service.namespace"] = namespace
+	}
+
+	for key, value := range identity.Tags {
+		attributes[fmt.Sprintf("app.tags.%s", key)] = value
+	}
+
+	return &resourcepb.Resource{
+		Attributes: otlpAttributes(attributes),
+	}, nil
+}
+
+// Level returns the default log level priority for this handler.
+func (h *HandlerOtlp) Level() int {
+	return h.level
+}
+
+// Log converts the log entry into an OTLP log record and adds it to the current batch.
+func (h *HandlerOtlp) Log(_ context.Context, timestamp time.Time, level int, msg string, args []any, err error, data Data) error {
+	attributes := mergeFields(data.Fields, data.ContextFields)
+	attributes["channel"] = data.Channel
+
+	if err != nil {
+		attributes["exception.message"] = err.Error()
+		attributes["exception.type"] = fmt.Sprintf("%T", err)
+	}
+
+	// like the ecs formatter, the trace_id and span_id context fields identify the trace of the record
+	traceId := otlpId(attributes, "trace_id", 16)
+	spanId := otlpId(attributes, "span_id", 8)
+
+	record := &logspb.LogRecord{
+		TimeUnixNano:         uint64(timestamp.UnixNano()),
+		ObservedTimeUnixNano: uint64(timestamp.UnixNano()),
+		SeverityNumber:       severityNumbers[level],
+		SeverityText:         LevelName(level),
+		Body:                 otlpValue(fmt.Sprintf(msg, args...)),
+		Attributes:           otlpAttributes(attributes),
+		TraceId:              traceId,
+		SpanId:               spanId,
+	}
+
+	h.batcher.Add(record)
+
+	return nil
+}
+
+// Flush exports all buffered log records.
+func (h *HandlerOtlp) Flush() error {
+	return h.batcher.Flush()
+}
+
+func (h *HandlerOtlp) export(ctx context.Context, records []*logspb.LogRecord) error {
+	ctx, cancel := context.WithTimeout(ctx, h.settings.Timeout)
+	defer cancel()
+
+	if len(h.settings.Headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(h.settings.Headers))
+	}
+
+	request := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			{
+				Resource: h.resource,
+				ScopeLogs: []*logspb.ScopeLogs{
+					{
+						Scope: &commonpb.InstrumentationScope{
+							Name: otlpScopeName,
+						},
+						LogRecords: records,
+					},
+				},
+			},
+		},
+	}
+
+	response, err := h.client.Export(ctx, request)
+	if err != nil {
+		return fmt.Errorf("can not export log records to %s: %w", h.settings.Endpoint, err)
+	}
+
+	if partial := response.GetPartialSuccess(); partial != nil && partial.GetRejectedLogRecords() > 0 {
+		return fmt.Errorf("collector rejected %d log records: %s", partial.GetRejectedLogRecords(), partial.GetErrorMessage())
+	}
+
+	return nil
+}
+
+// otlpId decodes the hex id of the given field and removes the field from the attributes. X-Ray trace ids like
+// 1-5e3d5273-7f0bd984ad68e2d290caeb84 are converted to their W3C representation. Invalid ids are kept as attribute.
+func otlpId(attributes map[string]any, field string, size int) []byte {
+	value, ok := attributes[field].(string)
+	if !ok {
+		return nil
+	}
+
+	value = strings.ReplaceAll(strings.TrimPrefix(value, "1-"), "-", "")
+	if len(value) != 2*size {
+		return nil
+	}
+
+	id, err := hex.DecodeString(value)
+	if err != nil {
+		return nil
+	}
+
+	delete(attributes, field)
+
+	return id
+}
+
+func otlpAttributes(values map[string]any) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	attributes := make([]*commonpb.KeyValue, 0, len(keys))
+
+	for _, key := range keys {
+		attributes = append(attributes, &commonpb.KeyValue{
+			Key:   key,
+			Value: otlpValue(values[key]),
+		})
+	}
+
+	return attributes
+}
+
+func otlpValue(value any) *commonpb.AnyValue {
+	switch v := value.(type) {
+	case nil:
+		return &commonpb.AnyValue{}
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int8:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int16:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case uint8:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case uint16:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case uint32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case float32:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: float64(v)}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case time.Time:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.Format(time.RFC3339Nano)}}
+	case []any:
+		values := make([]*commonpb.AnyValue, len(v))
+		for i, elem := range v {
+			values[i] = otlpValue(elem)
+		}
+
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: values}}}
+	case map[string]any:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{Values: otlpAttributes(v)}}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
@@ -0,0 +1,204 @@
+package log_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/suite"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+)
+
+type otlpLogsClient struct {
+	lck      sync.Mutex
+	requests []*collogspb.ExportLogsServiceRequest
+	errs     []error
+}
+
+func (c *otlpLogsClient) Export(_ context.Context, in *collogspb.ExportLogsServiceRequest, _ ...grpc.CallOption) (*collogspb.ExportLogsServiceResponse, error) {
+	c.lck.Lock()
+	defer c.lck.Unlock()
+
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+
+		return nil, err
+	}
+
+	c.requests = append(c.requests, in)
+
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+// the exporter runs in its own goroutine, so the test reads and writes the client state through these methods only
+func (c *otlpLogsClient) getRequests() []*collogspb.ExportLogsServiceRequest {
+	c.lck.Lock()
+	defer c.lck.Unlock()
+
+	return slices.Clone(c.requests)
+}
+
+func (c *otlpLogsClient) setErrs(errs ...error) {
+	c.lck.Lock()
+	defer c.lck.Unlock()
+
+	c.errs = errs
+}
+
+func (c *otlpLogsClient) getErrs() []error {
+	c.lck.Lock()
+	defer c.lck.Unlock()
+
+	return slices.Clone(c.errs)
+}
+
+type HandlerOtlpTestSuite struct {
+	suite.Suite
+
+	clock   clock.FakeClock
+	client  *otlpLogsClient
+	handler *log.HandlerOtlp
+}
+
+func TestHandlerOtlpTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerOtlpTestSuite))
+}
+
+func (s *HandlerOtlpTestSuite) SetupTest() {
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":  "test",
+			"name": "otlp",
+		},
+	})
+
+	resource, err := log.NewOtlpResource(config)
+	s.NoError(err)
+
+	s.clock = clock.NewFakeClock()
+	s.client = &otlpLogsClient{}
+	s.handler, err = log.NewHandlerOtlpWithInterfaces(s.clock, config, "otlp", s.client, resource, &log.HandlerOtlpSettings{
+		Level:   log.LevelInfo,
+		Timeout: time.Second,
+		Batch: log.BatchSettings{
+			Size:        2,
+			Interval:    time.Minute,
+			MaxBuffered: 10,
+		},
+		Retry: log.RetrySettings{
+			Enabled:         true,
+			InitialInterval: time.Millisecond,
+			MaxInterval:     time.Millisecond,
+			MaxElapsedTime:  time.Second,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *HandlerOtlpTestSuite) TestLogAndFlush() {
+	err := s.handler.Log(s.T().Context(), s.clock.Now(), log.PriorityWarn, "msg %d", []any{1}, fmt.Errorf("boom"), log.Data{
+		Channel:       "main",
+		Fields:        map[string]any{"count": 3},
+		ContextFields: map[string]any{"request_id": "abc"},
+	})
+	s.NoError(err)
+
+	s.NoError(s.handler.Flush())
+
+	requests := s.client.getRequests()
+	s.Len(requests, 1)
+
+	resourceLogs := requests[0].ResourceLogs[0]
+	s.Equal(map[string]string{
+		"deployment.environment": "test",
+		"service.name":           "otlp",
+	}, s.attributesOf(resourceLogs.Resource))
+
+	record := resourceLogs.ScopeLogs[0].LogRecords[0]
+	s.Equal(logspb.SeverityNumber_SEVERITY_NUMBER_WARN, record.SeverityNumber)
+	s.Equal("warn", record.SeverityText)
+	s.Equal("msg 1", record.Body.GetStringValue())
+	s.Equal(uint64(s.clock.Now().UnixNano()), record.TimeUnixNano)
+
+	attributes := map[string]any{}
+	for _, kv := range record.Attributes {
+		if kv.Value.GetStringValue() != "" {
+			attributes[kv.Key] = kv.Value.GetStringValue()
+		} else {
+			attributes[kv.Key] = kv.Value.GetIntValue()
+		}
+	}
+
+	s.Equal(map[string]any{
+		"channel":           "main",
+		"count":             int64(3),
+		"exception.message": "boom",
+		"exception.type":    "*errors.errorString",
+		"request_id":        "abc",
+	}, attributes)
+}
+
+func (s *HandlerOtlpTestSuite) TestLogWithTrace() {
+	err := s.handler.Log(s.T().Context(), s.clock.Now(), log.PriorityInfo, "msg", nil, nil, log.Data{
+		Channel: "main",
+		ContextFields: map[string]any{
+			"trace_id": "1-5e3d5273-7f0bd984ad68e2d290caeb84",
+			"span_id":  "b1e67e41debe0b65",
+		},
+	})
+	s.NoError(err)
+
+	s.NoError(s.handler.Flush())
+
+	record := s.client.getRequests()[0].ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+	s.Equal("5e3d52737f0bd984ad68e2d290caeb84", hex.EncodeToString(record.TraceId))
+	s.Equal("b1e67e41debe0b65", hex.EncodeToString(record.SpanId))
+	s.Len(record.Attributes, 1, "only the channel should be left as attribute")
+}
+
+func (s *HandlerOtlpTestSuite) TestFlushSplitsBatches() {
+	for i := 0; i < 3; i++ {
+		s.NoError(s.handler.Log(s.T().Context(), s.clock.Now(), log.PriorityInfo, "msg", nil, nil, log.Data{}))
+	}
+
+	s.NoError(s.handler.Flush())
+
+	// the background flush might have picked up the first full batch already
+	records := 0
+	for _, request := range s.client.getRequests() {
+		records += len(request.ResourceLogs[0].ScopeLogs[0].LogRecords)
+	}
+
+	s.Equal(3, records)
+}
+
+func (s *HandlerOtlpTestSuite) TestFlushRetries() {
+	s.client.setErrs(fmt.Errorf("unavailable"), fmt.Errorf("unavailable"))
+
+	s.NoError(s.handler.Log(s.T().Context(), s.clock.Now(), log.PriorityInfo, "msg", nil, nil, log.Data{}))
+	s.NoError(s.handler.Flush())
+
+	s.Len(s.client.getRequests(), 1)
+	s.Empty(s.client.getErrs())
+}
+
+func (s *HandlerOtlpTestSuite) attributesOf(resource *resourcepb.Resource) map[string]string {
+	attributes := map[string]string{}
+
+	for _, kv := range resource.Attributes {
+		attributes[kv.Key] = kv.Value.GetStringValue()
+	}
+
+	return attributes
+}
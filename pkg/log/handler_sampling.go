@@ -0,0 +1,217 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+// HandlerSamplingSettings configures the sampling of identical log messages for a handler.
+// It is read from log.handlers.<name>.sampling.
+type HandlerSamplingSettings struct {
+	Enabled bool `cfg:"enabled" default:"false"`
+	// Interval is the window in which at most Burst identical messages are passed on to the handler.
+	Interval time.Duration `cfg:"interval" default:"1m"`
+	// Burst is the amount of identical messages passed on per interval before they get suppressed.
+	Burst int `cfg:"burst" default:"10"`
+}
+
+type handlerSamplingEntry struct {
+	windowStart time.Time
+	count       int
+	suppressed  int
+	level       int
+	msg         string
+	data        Data
+}
+
+// HandlerSampling wraps a handler and suppresses identical log messages once they occur more than the configured
+// burst within an interval. Messages are identical if they share the channel, level and formatted message. Once the
+// interval of a suppressed message elapsed, a summary with the amount of suppressed messages is written instead.
+type HandlerSampling struct {
+	Handler
+	lck      sync.Mutex
+	clock    clock.Clock
+	settings HandlerSamplingSettings
+	entries  map[string]*handlerSamplingEntry
+}
+
+func handlerSamplingWrapper(config cfg.Config, name string, handler Handler) (Handler, error) {
+	settings := &HandlerSamplingSettings{}
+	key := fmt.Sprintf("%s.sampling", getHandlerConfigKey(name))
+
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sampling settings for key %q: %w", key, err)
+	}
+
+	if !settings.Enabled {
+		return handler, nil
+	}
+
+	return NewHandlerSampling(clock.Provider, handler, *settings), nil
+}
+
+// NewHandlerSampling creates a new sampling handler wrapping the given handler.
+func NewHandlerSampling(clock clock.Clock, handler Handler, settings HandlerSamplingSettings) *HandlerSampling {
+	settings.Burst = max(settings.Burst, 1)
+
+	if settings.Interval <= 0 {
+		settings.Interval = time.Minute
+	}
+
+	sampling := &HandlerSampling{
+		Handler:  handler,
+		clock:    clock,
+		settings: settings,
+		entries:  make(map[string]*handlerSamplingEntry),
+	}
+
+	go sampling.run()
+
+	return sampling
+}
+
+// AllowsLevelOverrides forwards the setting of the wrapped handler, see LevelOverridable.
+func (h *HandlerSampling) AllowsLevelOverrides() bool {
+	return AllowsLevelOverrides(h.Handler)
+}
+
+// Log passes the log entry on to the wrapped handler unless too many identical messages were seen in the current interval.
+func (h *HandlerSampling) Log(ctx context.Context, timestamp time.Time, level int, msg string, args []any, err error, data Data) error {
+	formatted := fmt.Sprintf(msg, args...)
+	fingerprint := fmt.Sprintf("%s|%d|%s", data.Channel, level, formatted)
+
+	h.lck.Lock()
+	entry, ok := h.entries[fingerprint]
+
+	if !ok {
+		entry = &handlerSamplingEntry{
+			windowStart: timestamp,
+		}
+		h.entries[fingerprint] = entry
+	}
+
+	var summary *handlerSamplingEntry
+	if timestamp.Sub(entry.windowStart) >= h.settings.Interval {
+		summary = h.resetEntry(entry, timestamp)
+	}
+
+	entry.count++
+	suppress := entry.count > h.settings.Burst
+
+	if suppress {
+		entry.suppressed++
+		entry.level = level
+		entry.msg = formatted
+		entry.data = data
+	}
+	h.lck.Unlock()
+
+	if summary != nil {
+		if summaryErr := h.logSummary(ctx, timestamp, summary); summaryErr != nil {
+			return summaryErr
+		}
+	}
+
+	if suppress {
+		return nil
+	}
+
+	return h.Handler.Log(ctx, timestamp, level, msg, args, err, data)
+}
+
+// Flush writes the summaries of all currently suppressed messages and flushes the wrapped handler if it buffers records.
+func (h *HandlerSampling) Flush() error {
+	now := h.clock.Now()
+	summaries := make([]*handlerSamplingEntry, 0)
+
+	h.lck.Lock()
+	for _, entry := range h.entries {
+		if summary := h.resetEntry(entry, now); summary != nil {
+			summaries = append(summaries, summary)
+		}
+	}
+	h.lck.Unlock()
+
+	for _, summary := range summaries {
+		if err := h.logSummary(context.Background(), now, summary); err != nil {
+			return err
+		}
+	}
+
+	if flusher, ok := h.Handler.(Flusher); ok {
+		return flusher.Flush()
+	}
+
+	return nil
+}
+
+func (h *HandlerSampling) run() {
+	ticker := h.clock.NewTicker(h.settings.Interval)
+	defer ticker.Stop()
+
+	for range ticker.Chan() {
+		h.expire()
+	}
+}
+
+// expire writes the summaries of all messages whose interval elapsed and forgets about messages not seen anymore.
+func (h *HandlerSampling) expire() {
+	now := h.clock.Now()
+	summaries := make([]*handlerSamplingEntry, 0)
+
+	h.lck.Lock()
+	for fingerprint, entry := range h.entries {
+		if now.Sub(entry.windowStart) < h.settings.Interval {
+			continue
+		}
+
+		if summary := h.resetEntry(entry, now); summary != nil {
+			summaries = append(summaries, summary)
+
+			continue
+		}
+
+		delete(h.entries, fingerprint)
+	}
+	h.lck.Unlock()
+
+	for _, summary := range summaries {
+		if err := h.logSummary(context.Background(), now, summary); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to write to log, %s\n", err)
+		}
+	}
+}
+
+// resetEntry starts a new window for the entry and returns a copy of the old state if messages were suppressed.
+func (h *HandlerSampling) resetEntry(entry *handlerSamplingEntry, now time.Time) *handlerSamplingEntry {
+	var summary *handlerSamplingEntry
+
+	if entry.suppressed > 0 {
+		cpy := *entry
+		summary = &cpy
+	}
+
+	entry.windowStart = now
+	entry.count = 0
+	entry.suppressed = 0
+
+	return summary
+}
+
+func (h *HandlerSampling) logSummary(ctx context.Context, timestamp time.Time, summary *handlerSamplingEntry) error {
+	data := Data{
+		Channel:       summary.data.Channel,
+		ContextFields: summary.data.ContextFields,
+		Fields: mergeFields(summary.data.Fields, map[string]any{
+			"suppressed_count": summary.suppressed,
+		}),
+	}
+
+	return h.Handler.Log(ctx, timestamp, summary.level, "suppressed %d similar messages: %s", []any{summary.suppressed, summary.msg}, nil, data)
+}
@@ -0,0 +1,115 @@
+package log_test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type syncBuffer struct {
+	lck sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lck.Lock()
+	defer b.lck.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lck.Lock()
+	defer b.lck.Unlock()
+
+	return b.buf.String()
+}
+
+func TestHandlerSampling(t *testing.T) {
+	buf := &syncBuffer{}
+	cl := clock.NewFakeClock()
+
+	inner := log.NewHandlerIoWriter(cfg.New(), log.PriorityInfo, log.FormatterSimple, "main", "15:04:05", buf)
+	handler := log.NewHandlerSampling(cl, inner, log.HandlerSamplingSettings{
+		Enabled:  true,
+		Interval: time.Minute,
+		Burst:    2,
+	})
+	logger := log.NewLoggerWithInterfaces(cl, []log.Handler{handler})
+	ctx := t.Context()
+
+	for i := 0; i < 5; i++ {
+		logger.Warn(ctx, "connection refused")
+	}
+
+	logger.Warn(ctx, "something else")
+
+	cl.Advance(time.Minute)
+	logger.Warn(ctx, "connection refused")
+
+	var lines []string
+	assert.Eventually(t, func() bool {
+		lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+		return len(lines) == 5
+	}, time.Second, time.Millisecond)
+	assert.Contains(t, lines[0], "connection refused")
+	assert.Contains(t, lines[1], "connection refused")
+	assert.Contains(t, lines[2], "something else")
+
+	// the summary is either written by the next message or the background expiry, whichever comes first
+	rest := strings.Join(lines[3:], "\n")
+	assert.Contains(t, rest, "suppressed 3 similar messages: connection refused")
+	assert.Contains(t, rest, "suppressed_count: 3")
+	assert.Equal(t, 1, strings.Count(rest, "suppressed 3"))
+}
+
+func TestHandlerSamplingFlush(t *testing.T) {
+	buf := &syncBuffer{}
+	cl := clock.NewFakeClock()
+
+	inner := log.NewHandlerIoWriter(cfg.New(), log.PriorityInfo, log.FormatterSimple, "main", "15:04:05", buf)
+	handler := log.NewHandlerSampling(cl, inner, log.HandlerSamplingSettings{
+		Enabled:  true,
+		Interval: time.Minute,
+		Burst:    1,
+	})
+	logger := log.NewLoggerWithInterfaces(cl, []log.Handler{handler})
+
+	logger.Error(t.Context(), "failed to process message %d", 1)
+	logger.Error(t.Context(), "failed to process message %d", 1)
+	logger.Error(t.Context(), "failed to process message %d", 2)
+
+	assert.NoError(t, logger.Flush())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.Contains(t, lines[2], "suppressed 1 similar messages: failed to process message 1")
+}
+
+func TestHandlerSamplingFromConfig(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"log": map[string]any{
+			"handlers": map[string]any{
+				"main": map[string]any{
+					"type": "iowriter",
+					"sampling": map[string]any{
+						"enabled": true,
+					},
+				},
+			},
+		},
+	})
+
+	handlers, err := log.NewHandlersFromConfig(config)
+	assert.NoError(t, err)
+	assert.Len(t, handlers, 1)
+	assert.IsType(t, &log.HandlerSampling{}, handlers[0])
+}
@@ -3,6 +3,8 @@ package log
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/getsentry/sentry-go"
@@ -13,26 +15,98 @@ func init() {
 	AddHandlerFactory("sentry", handlerSentryFactory)
 }
 
-func handlerSentryFactory(config cfg.Config, _ string) (Handler, error) {
-	return NewHandlerSentry(config)
+// HandlerSentrySettings configures the "sentry" handler. The environment is taken from the app identity, the release
+// defaults to "<app.name>@<app.tags.version>" if the app has a version tag.
+type HandlerSentrySettings struct {
+	Dsn                 string                          `cfg:"dsn"`
+	Release             string                          `cfg:"release"`
+	ContextFieldsAsTags bool                            `cfg:"context_fields_as_tags" default:"true"`
+	Breadcrumbs         HandlerSentryBreadcrumbSettings `cfg:"breadcrumbs"`
+}
+
+// HandlerSentryBreadcrumbSettings configures capturing recent log records as breadcrumbs attached to Sentry events.
+type HandlerSentryBreadcrumbSettings struct {
+	Enabled  bool   `cfg:"enabled" default:"false"`
+	Level    string `cfg:"level" default:"info"`
+	MaxCount int    `cfg:"max_count" default:"30"`
+}
+
+// SentryFingerprintHook computes the fingerprint Sentry uses to group an error into an issue. Returning an empty
+// fingerprint leaves the decision to the next hook or the default grouping of Sentry.
+type SentryFingerprintHook func(err error, data Data) []string
+
+func handlerSentryFactory(config cfg.Config, name string) (Handler, error) {
+	return newHandlerSentry(config, name)
+}
+
+type sentryBreadcrumbsCtxKey struct{}
+
+// sentryBreadcrumbs holds the recent log records of a single request or message.
+type sentryBreadcrumbs struct {
+	lck   sync.Mutex
+	items []*sentry.Breadcrumb
+}
+
+// WithSentryBreadcrumbs creates a new context collecting the log records as Sentry breadcrumbs, so errors logged with
+// the context (or contexts derived from it) carry the preceding records of the same request or message. The httpserver
+// and stream consumers start a new trail for every request and message. Records logged with a context without a trail
+// are not recorded as breadcrumbs.
+func WithSentryBreadcrumbs(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(sentryBreadcrumbsCtxKey{}).(*sentryBreadcrumbs); ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, sentryBreadcrumbsCtxKey{}, &sentryBreadcrumbs{})
 }
 
 // HandlerSentry forwards log messages with error levels to Sentry.
 type HandlerSentry struct {
-	hub SentryHub
+	hub              SentryHub
+	settings         *HandlerSentrySettings
+	level            int
+	lck              sync.Mutex
+	fingerprintHooks []SentryFingerprintHook
 }
 
 // NewHandlerSentry creates a new Sentry handler initialized with settings from the provided configuration.
 func NewHandlerSentry(config cfg.Config) (*HandlerSentry, error) {
+	return newHandlerSentry(config, "sentry")
+}
+
+func newHandlerSentry(config cfg.Config, name string) (*HandlerSentry, error) {
 	var err error
 	var hub SentryHub
 
-	if hub, err = NewSentryHub(config); err != nil {
+	settings := &HandlerSentrySettings{}
+	if err = config.UnmarshalKey(getHandlerConfigKey(name), settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sentry handler settings: %w", err)
+	}
+
+	if hub, err = newSentryHubFromConfig(config, settings); err != nil {
 		return nil, fmt.Errorf("can not create sentry hub: %w", err)
 	}
 
+	return NewHandlerSentryWithInterfaces(hub, settings)
+}
+
+// NewHandlerSentryWithInterfaces creates a new Sentry handler capturing the errors with the given hub.
+func NewHandlerSentryWithInterfaces(hub SentryHub, settings *HandlerSentrySettings) (*HandlerSentry, error) {
+	level := PriorityError
+
+	if settings.Breadcrumbs.Enabled {
+		var ok bool
+
+		if level, ok = LevelPriority(settings.Breadcrumbs.Level); !ok {
+			return nil, fmt.Errorf("invalid breadcrumb log level %q", settings.Breadcrumbs.Level)
+		}
+
+		level = min(level, PriorityError)
+	}
+
 	return &HandlerSentry{
-		hub: hub,
+		hub:      hub,
+		settings: settings,
+		level:    level,
 	}, nil
 }
 
@@ -43,6 +117,15 @@ func (h *HandlerSentry) WithContext(name string, context map[string]any) {
 	})
 }
 
+// AddFingerprintHook registers a hook computing the fingerprint of captured errors.
+// Hooks are called in the order they were added, the first non-empty fingerprint is used.
+func (h *HandlerSentry) AddFingerprintHook(hooks ...SentryFingerprintHook) {
+	h.lck.Lock()
+	defer h.lck.Unlock()
+
+	h.fingerprintHooks = append(h.fingerprintHooks, hooks...)
+}
+
 // ChannelLevel returns nil for the Sentry handler, as it doesn't support channel-specific levels.
 // It relies on the global level configuration.
 func (h *HandlerSentry) ChannelLevel(string) (level *int, err error) {
@@ -50,23 +133,42 @@ func (h *HandlerSentry) ChannelLevel(string) (level *int, err error) {
 }
 
 // Level returns the default log level priority for the Sentry handler, which is PriorityError.
-// This means, by default, only error logs are sent to Sentry.
+// This means, by default, only error logs are sent to Sentry. If breadcrumbs are enabled, the level
+// is lowered to the configured breadcrumb level.
 func (h *HandlerSentry) Level() int {
-	return PriorityError
+	return h.level
 }
 
 // Log sends the error from the log entry to Sentry.
-// It includes fields and context as Sentry context data.
-func (h *HandlerSentry) Log(_ context.Context, _ time.Time, _ int, _ string, _ []any, err error, data Data) error {
+// It includes fields and context as Sentry context data, the context fields as tags and the messages
+// logged with the same context before as breadcrumbs (see WithSentryBreadcrumbs). Log entries without an error are
+// only recorded as breadcrumbs.
+func (h *HandlerSentry) Log(ctx context.Context, timestamp time.Time, level int, msg string, args []any, err error, data Data) error {
 	if err == nil {
+		h.addBreadcrumb(ctx, timestamp, level, msg, args, data)
+
 		return nil
 	}
 
 	fields := mergeFields(data.Fields, data.ContextFields)
+	fingerprint := h.fingerprint(err, data)
+	breadcrumbs := h.recentBreadcrumbs(ctx)
 
 	h.hub.WithScope(func(scope *sentry.Scope) {
 		scope.SetContext("fields", fields)
 
+		if h.settings.ContextFieldsAsTags {
+			scope.SetTags(sentryTags(data.ContextFields))
+		}
+
+		if len(fingerprint) > 0 {
+			scope.SetFingerprint(fingerprint)
+		}
+
+		for _, breadcrumb := range breadcrumbs {
+			scope.AddBreadcrumb(breadcrumb, len(breadcrumbs))
+		}
+
 		eventId := h.hub.CaptureException(err)
 
 		if eventId != nil {
@@ -76,5 +178,88 @@ func (h *HandlerSentry) Log(_ context.Context, _ time.Time, _ int, _ string, _ [
 		}
 	})
 
+	h.addBreadcrumb(ctx, timestamp, level, msg, args, data)
+
 	return nil
 }
+
+func (h *HandlerSentry) fingerprint(err error, data Data) []string {
+	h.lck.Lock()
+	hooks := h.fingerprintHooks
+	h.lck.Unlock()
+
+	for _, hook := range hooks {
+		if fingerprint := hook(err, data); len(fingerprint) > 0 {
+			return fingerprint
+		}
+	}
+
+	return nil
+}
+
+func (h *HandlerSentry) addBreadcrumb(ctx context.Context, timestamp time.Time, level int, msg string, args []any, data Data) {
+	if !h.settings.Breadcrumbs.Enabled || h.settings.Breadcrumbs.MaxCount <= 0 {
+		return
+	}
+
+	trail, ok := ctx.Value(sentryBreadcrumbsCtxKey{}).(*sentryBreadcrumbs)
+	if !ok {
+		return
+	}
+
+	breadcrumb := &sentry.Breadcrumb{
+		Type:      "default",
+		Category:  data.Channel,
+		Message:   fmt.Sprintf(msg, args...),
+		Level:     sentryLevel(level),
+		Timestamp: timestamp,
+		Data:      data.Fields,
+	}
+
+	trail.lck.Lock()
+	defer trail.lck.Unlock()
+
+	trail.items = append(trail.items, breadcrumb)
+
+	if overflow := len(trail.items) - h.settings.Breadcrumbs.MaxCount; overflow > 0 {
+		trail.items = trail.items[overflow:]
+	}
+}
+
+func (h *HandlerSentry) recentBreadcrumbs(ctx context.Context) []*sentry.Breadcrumb {
+	trail, ok := ctx.Value(sentryBreadcrumbsCtxKey{}).(*sentryBreadcrumbs)
+	if !ok {
+		return nil
+	}
+
+	trail.lck.Lock()
+	defer trail.lck.Unlock()
+
+	return slices.Clone(trail.items)
+}
+
+func sentryLevel(level int) sentry.Level {
+	switch {
+	case level >= PriorityError:
+		return sentry.LevelError
+	case level >= PriorityWarn:
+		return sentry.LevelWarning
+	case level >= PriorityInfo:
+		return sentry.LevelInfo
+	default:
+		return sentry.LevelDebug
+	}
+}
+
+func sentryTags(fields map[string]any) map[string]string {
+	tags := make(map[string]string, len(fields))
+
+	for key, value := range fields {
+		switch value.(type) {
+		case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			tags[key] = fmt.Sprint(value)
+		}
+	}
+
+	return tags
+}
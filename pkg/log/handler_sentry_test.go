@@ -0,0 +1,102 @@
+package log_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/justtrackio/gosoline/pkg/log"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerSentryCapture(t *testing.T) {
+	hub := logMocks.NewSentryHub(t)
+	scope := sentry.NewScope()
+	eventId := sentry.EventID("event")
+
+	hub.EXPECT().WithScope(mock.Anything).Run(func(f func(*sentry.Scope)) {
+		f(scope)
+	}).Once()
+	hub.EXPECT().CaptureException(fmt.Errorf("boom")).Return(&eventId).Once()
+
+	handler, err := log.NewHandlerSentryWithInterfaces(hub, &log.HandlerSentrySettings{
+		ContextFieldsAsTags: true,
+		Breadcrumbs: log.HandlerSentryBreadcrumbSettings{
+			Enabled:  true,
+			Level:    "info",
+			MaxCount: 2,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, log.PriorityInfo, handler.Level())
+
+	handler.AddFingerprintHook(func(err error, data log.Data) []string {
+		return nil
+	}, func(err error, data log.Data) []string {
+		return []string{data.Channel, err.Error()}
+	})
+
+	ctx := log.WithSentryBreadcrumbs(t.Context())
+	otherCtx := log.WithSentryBreadcrumbs(t.Context())
+
+	for i := 0; i < 3; i++ {
+		err = handler.Log(ctx, time.Unix(int64(i), 0), log.PriorityInfo, "message %d", []any{i}, nil, log.Data{Channel: "main"})
+		assert.NoError(t, err)
+	}
+
+	// records of other requests and of contexts without a trail are not part of the breadcrumbs
+	assert.NoError(t, handler.Log(otherCtx, time.Now(), log.PriorityInfo, "other request", nil, nil, log.Data{Channel: "main"}))
+	assert.NoError(t, handler.Log(t.Context(), time.Now(), log.PriorityInfo, "no trail", nil, nil, log.Data{Channel: "main"}))
+
+	err = handler.Log(ctx, time.Now(), log.PriorityError, "failed", nil, fmt.Errorf("boom"), log.Data{
+		Channel: "main",
+		Fields: map[string]any{
+			"id": 1,
+		},
+		ContextFields: map[string]any{
+			"request_id": "req",
+			"nested":     map[string]any{"a": "b"},
+		},
+	})
+	assert.NoError(t, err)
+
+	event := scope.ApplyToEvent(&sentry.Event{}, nil, nil)
+	require.NotNil(t, event)
+
+	assert.Equal(t, []string{"main", "boom"}, event.Fingerprint)
+	assert.Equal(t, map[string]string{"request_id": "req"}, event.Tags)
+	assert.Equal(t, sentry.Context{
+		"id":         1,
+		"request_id": "req",
+		"nested":     map[string]any{"a": "b"},
+	}, event.Contexts["fields"])
+
+	require.Len(t, event.Breadcrumbs, 2)
+	assert.Equal(t, "message 1", event.Breadcrumbs[0].Message)
+	assert.Equal(t, "message 2", event.Breadcrumbs[1].Message)
+	assert.Equal(t, sentry.LevelInfo, event.Breadcrumbs[1].Level)
+	assert.Equal(t, "main", event.Breadcrumbs[1].Category)
+}
+
+func TestHandlerSentryWithoutBreadcrumbs(t *testing.T) {
+	hub := logMocks.NewSentryHub(t)
+
+	handler, err := log.NewHandlerSentryWithInterfaces(hub, &log.HandlerSentrySettings{})
+	require.NoError(t, err)
+	assert.Equal(t, log.PriorityError, handler.Level())
+
+	err = handler.Log(t.Context(), time.Now(), log.PriorityInfo, "message", nil, nil, log.Data{Channel: "main"})
+	assert.NoError(t, err)
+
+	_, err = log.NewHandlerSentryWithInterfaces(hub, &log.HandlerSentrySettings{
+		Breadcrumbs: log.HandlerSentryBreadcrumbSettings{
+			Enabled: true,
+			Level:   "verbose",
+		},
+	})
+	assert.EqualError(t, err, `invalid breadcrumb log level "verbose"`)
+}
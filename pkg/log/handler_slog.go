@@ -0,0 +1,81 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+var slogLevels = map[int]slog.Level{
+	PriorityTrace: slog.LevelDebug - 4,
+	PriorityDebug: slog.LevelDebug,
+	PriorityInfo:  slog.LevelInfo,
+	PriorityWarn:  slog.LevelWarn,
+	PriorityError: slog.LevelError,
+}
+
+// HandlerSlog passes log entries on to a slog.Handler. The channel, fields and context fields are added as
+// attributes (fields and context fields in the groups "fields" and "context") and the error as "err" attribute.
+type HandlerSlog struct {
+	handler slog.Handler
+	level   int
+}
+
+// NewHandlerSlog creates a new handler writing all log entries of at least the given level to the slog.Handler.
+func NewHandlerSlog(handler slog.Handler, level int) *HandlerSlog {
+	return &HandlerSlog{
+		handler: handler,
+		level:   level,
+	}
+}
+
+// ChannelLevel returns nil as the slog handler doesn't support channel specific levels.
+func (h *HandlerSlog) ChannelLevel(string) (level *int, err error) {
+	return nil, nil
+}
+
+// Level returns the default log level priority for this handler.
+func (h *HandlerSlog) Level() int {
+	return h.level
+}
+
+// Log converts the log entry into a slog.Record and passes it on to the slog.Handler if it is enabled for the level.
+func (h *HandlerSlog) Log(ctx context.Context, timestamp time.Time, level int, msg string, args []any, err error, data Data) error {
+	slogLevel := slogLevels[level]
+
+	if !h.handler.Enabled(ctx, slogLevel) {
+		return nil
+	}
+
+	record := slog.NewRecord(timestamp, slogLevel, fmt.Sprintf(msg, args...), 0)
+	record.AddAttrs(slog.String("channel", data.Channel))
+
+	if err != nil {
+		record.AddAttrs(slog.Any("err", err))
+	}
+
+	if len(data.Fields) > 0 {
+		record.AddAttrs(slogGroup("fields", data.Fields))
+	}
+
+	if len(data.ContextFields) > 0 {
+		record.AddAttrs(slogGroup("context", data.ContextFields))
+	}
+
+	if handleErr := h.handler.Handle(ctx, record); handleErr != nil {
+		return fmt.Errorf("slog handler failed to handle record: %w", handleErr)
+	}
+
+	return nil
+}
+
+func slogGroup(name string, fields map[string]any) slog.Attr {
+	attrs := make([]any, 0, len(fields))
+
+	for key, value := range fields {
+		attrs = append(attrs, slog.Any(key, value))
+	}
+
+	return slog.Group(name, attrs...)
+}
@@ -2,9 +2,11 @@ package log
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/justtrackio/gosoline/pkg/clock"
@@ -92,6 +94,7 @@ type Logger interface {
 	Error(ctx context.Context, format string, args ...any)
 
 	WithChannel(channel string) Logger
+	WithContext(ctx context.Context) Logger
 	WithFields(Fields) Logger
 }
 
@@ -117,7 +120,10 @@ type gosoLogger struct {
 	clock           clock.Clock
 	data            Data
 	ctxResolvers    []ContextFieldsResolverFunction
+	boundCtxs       []context.Context
 	handlers        []Handler
+	channelLevels   map[string]int
+	redactor        *Redactor
 	samplingEnabled bool
 }
 
@@ -177,6 +183,16 @@ func (l *gosoLogger) WithChannel(channel string) Logger {
 	return cpy
 }
 
+// WithContext returns a logger including the context fields of ctx in every log entry, even if the entry is logged with
+// another context (like one handed off to another goroutine). The fields are resolved when logging, so later mutations
+// of the fields of ctx are visible. Fields of the context passed when logging take precedence.
+func (l *gosoLogger) WithContext(ctx context.Context) Logger {
+	cpy := l.copy()
+	cpy.boundCtxs = append(slices.Clip(l.boundCtxs), ctx)
+
+	return cpy
+}
+
 func (l *gosoLogger) WithFields(fields Fields) Logger {
 	cpy := l.copy()
 	cpy.data.Fields = mergeFields(l.data.Fields, fields)
@@ -184,12 +200,33 @@ func (l *gosoLogger) WithFields(fields Fields) Logger {
 	return cpy
 }
 
+// Flush writes the records buffered by all handlers implementing the Flusher interface.
+func (l *gosoLogger) Flush() error {
+	var errs []error
+
+	for _, handler := range l.handlers {
+		flusher, ok := handler.(Flusher)
+		if !ok {
+			continue
+		}
+
+		if err := flusher.Flush(); err != nil {
+			errs = append(errs, fmt.Errorf("can not flush handler %T: %w", handler, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func (l *gosoLogger) copy() *gosoLogger {
 	return &gosoLogger{
 		clock:           l.clock,
 		data:            l.data,
 		ctxResolvers:    l.ctxResolvers,
+		boundCtxs:       l.boundCtxs,
 		handlers:        l.handlers,
+		channelLevels:   l.channelLevels,
+		redactor:        l.redactor,
 		samplingEnabled: l.samplingEnabled,
 	}
 }
@@ -203,11 +240,22 @@ func (l *gosoLogger) log(ctx context.Context, level int, msg string, args []any,
 		Fields:        l.data.Fields,
 	}
 
+	for _, boundCtx := range l.boundCtxs {
+		for _, r := range l.ctxResolvers {
+			data.ContextFields = mergeFields(data.ContextFields, r(boundCtx))
+		}
+	}
+
 	for _, r := range l.ctxResolvers {
 		newContextFields := r(ctx)
 		data.ContextFields = mergeFields(data.ContextFields, newContextFields)
 	}
 
+	if l.redactor != nil {
+		data.Fields = l.redactor.Redact(data.Fields)
+		data.ContextFields = l.redactor.Redact(data.ContextFields)
+	}
+
 	if !l.samplingEnabled || !smplctx.HasSampling(ctx) {
 		l.executeHandlers(ctx, timestamp, level, msg, args, loggedErr, data)
 
@@ -264,13 +312,40 @@ func (l *gosoLogger) executeHandlers(ctx context.Context, timestamp time.Time, l
 }
 
 func (l *gosoLogger) shouldLog(current string, level int, h Handler) (bool, error) {
+	handlerLevel, err := l.handlerLevel(current, h)
+	if err != nil {
+		return false, err
+	}
+
+	if overrideLevel, ok := runtimeChannelLevels.channelLevel(current); ok {
+		return l.sharedLevel(overrideLevel, handlerLevel, h) <= level, nil
+	}
+
+	return handlerLevel <= level, nil
+}
+
+func (l *gosoLogger) handlerLevel(current string, h Handler) (int, error) {
 	if channelLevel, err := h.ChannelLevel(current); err != nil {
-		return false, fmt.Errorf("can not get channel level: %w", err)
+		return 0, fmt.Errorf("can not get channel level: %w", err)
 	} else if channelLevel != nil {
-		return *channelLevel <= level, nil
+		return *channelLevel, nil
+	}
+
+	if configuredLevel, ok := matchChannelLevel(l.channelLevels, current); ok {
+		return l.sharedLevel(configuredLevel, h.Level(), h), nil
+	}
+
+	return h.Level(), nil
+}
+
+// sharedLevel applies a level shared by all handlers. It can only raise the level of a handler unless the handler
+// allows level overrides.
+func (l *gosoLogger) sharedLevel(sharedLevel int, handlerLevel int, h Handler) int {
+	if AllowsLevelOverrides(h) {
+		return sharedLevel
 	}
 
-	return h.Level() <= level, nil
+	return max(sharedLevel, handlerLevel)
 }
 
 func (l *gosoLogger) err(err error) {
@@ -49,6 +49,13 @@ func (l *SamplingLogger) WithChannel(channel string) Logger {
 	return l.copy(logger)
 }
 
+// WithContext returns a new sampling logger including the context fields of ctx in every log entry.
+func (l *SamplingLogger) WithContext(ctx context.Context) Logger {
+	logger := l.Logger.WithContext(ctx)
+
+	return l.copy(logger)
+}
+
 // WithFields returns a new sampling logger with additional structured fields.
 func (l *SamplingLogger) WithFields(fields Fields) Logger {
 	logger := l.Logger.WithFields(fields)
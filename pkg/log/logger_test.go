@@ -157,3 +157,25 @@ func getLogLines(buf *bytes.Buffer) []string {
 		return s != ""
 	})
 }
+
+func TestLoggerWithContext(t *testing.T) {
+	logger, buf := getBufferedLogger(t, cfg.New())
+
+	ctx := log.InitContext(t.Context())
+	ctx = log.MutateContextFields(ctx, map[string]any{
+		"request_id": "abc",
+		"step":       "request",
+	})
+
+	boundLogger := logger.WithContext(ctx).WithChannel("worker")
+
+	// fields added to the bound context later on are visible as well
+	_ = log.MutateContextFields(ctx, map[string]any{"user_id": 1})
+
+	workerCtx := log.AppendContextFields(t.Context(), map[string]any{"step": "worker"})
+	boundLogger.Info(workerCtx, "handed off")
+
+	lines := getLogLines(buf)
+	assert.Len(t, lines, 1)
+	assert.JSONEq(t, `{"channel":"worker","level":2,"level_name":"info","timestamp":"1984-04-04T00:00:00Z","message":"handed off","fields":{},"context":{"request_id":"abc","step":"worker","user_id":1}}`, lines[0])
+}
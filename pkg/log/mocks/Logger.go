@@ -250,6 +250,54 @@ func (_c *Logger_WithChannel_Call) RunAndReturn(run func(string) log.Logger) *Lo
 	return _c
 }
 
+// WithContext provides a mock function with given fields: ctx
+func (_m *Logger) WithContext(ctx context.Context) log.Logger {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithContext")
+	}
+
+	var r0 log.Logger
+	if rf, ok := ret.Get(0).(func(context.Context) log.Logger); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(log.Logger)
+		}
+	}
+
+	return r0
+}
+
+// Logger_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type Logger_WithContext_Call struct {
+	*mock.Call
+}
+
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Logger_Expecter) WithContext(ctx interface{}) *Logger_WithContext_Call {
+	return &Logger_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
+}
+
+func (_c *Logger_WithContext_Call) Run(run func(ctx context.Context)) *Logger_WithContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Logger_WithContext_Call) Return(_a0 log.Logger) *Logger_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Logger_WithContext_Call) RunAndReturn(run func(context.Context) log.Logger) *Logger_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithFields provides a mock function with given fields: _a0
 func (_m *Logger) WithFields(_a0 log.Fields) log.Logger {
 	ret := _m.Called(_a0)
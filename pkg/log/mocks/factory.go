@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"slices"
@@ -79,6 +80,24 @@ func (l *loggerMock) WithChannel(channel string) log.Logger {
 	}
 }
 
+func (l *loggerMock) WithContext(ctx context.Context) log.Logger {
+	// forward potential calls to the underlying mock if we expect some
+	if _, ok := funk.FindFirstFunc(l.ExpectedCalls, func(call *mock.Call) bool {
+		return call.Method == "WithContext"
+	}); ok {
+		l.Logger.WithContext(ctx)
+	}
+
+	return &loggerMock{
+		Logger:         l.Logger,
+		t:              l.t,
+		currentChannel: l.currentChannel,
+		currentFields:  l.currentFields,
+		lck:            l.lck,
+		pendingLogs:    l.pendingLogs,
+	}
+}
+
 func (l *loggerMock) WithFields(fields log.Fields) log.Logger {
 	// forward potential calls to the underlying mock if we expect some
 	if _, ok := funk.FindFirstFunc(l.ExpectedCalls, func(call *mock.Call) bool {
@@ -39,3 +39,28 @@ func WithSamplingEnabled(enabled bool) Option {
 		return nil
 	}
 }
+
+// WithRedactor redacts sensitive data from the fields and context fields of every log entry before passing it to the handlers.
+func WithRedactor(redactor *Redactor) Option {
+	return func(logger *gosoLogger) error {
+		logger.redactor = redactor
+
+		return nil
+	}
+}
+
+// WithChannelLevels sets the levels of all channels matching the given patterns for all handlers of the logger.
+// See SetChannelLevel for the supported patterns. Channel levels configured for a handler take precedence. The levels
+// only go below the level of a handler if it allows level overrides, see LevelOverridable.
+func WithChannelLevels(levels map[string]string) Option {
+	return func(logger *gosoLogger) error {
+		priorities, err := parseChannelLevels(levels)
+		if err != nil {
+			return err
+		}
+
+		logger.channelLevels = priorities
+
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package log
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+)
+
+// RedactionSettings configures which fields and values get redacted before a log entry is passed to the handlers.
+// It is read from log.redaction.
+type RedactionSettings struct {
+	Enabled     bool   `cfg:"enabled" default:"false"`
+	Replacement string `cfg:"replacement" default:"[REDACTED]"`
+	// Fields are case-insensitive glob patterns (like "*password*") matched against field names.
+	// The complete value of a matching field is replaced.
+	Fields []string `cfg:"fields"`
+	// Values are regular expressions matched against string values. Only the matching parts are replaced.
+	Values []string `cfg:"values"`
+	// Presets enables predefined value patterns by name, see RedactionPresets.
+	Presets []string `cfg:"presets"`
+}
+
+// RedactionPresets contains commonly needed value patterns which can be enabled by name.
+var RedactionPresets = map[string]string{
+	"email":        `[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`,
+	"iban":         `\b[A-Z]{2}[0-9]{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,3})?\b`,
+	"bearer_token": `(?i)bearer [a-z0-9\-._~+/]+=*`,
+	"jwt":          `eyJ[a-zA-Z0-9_\-]+\.eyJ[a-zA-Z0-9_\-]+\.[a-zA-Z0-9_\-]+`,
+}
+
+// Redactor replaces sensitive data in log fields.
+type Redactor struct {
+	replacement string
+	fields      []string
+	values      []*regexp.Regexp
+}
+
+// NewRedactorFromConfig creates a redactor from the settings at log.redaction. It returns nil if redaction is disabled.
+func NewRedactorFromConfig(config cfg.Config) (*Redactor, error) {
+	settings := &RedactionSettings{}
+	if err := config.UnmarshalKey("log.redaction", settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal redaction settings: %w", err)
+	}
+
+	if !settings.Enabled {
+		return nil, nil
+	}
+
+	return NewRedactor(settings)
+}
+
+// NewRedactor creates a redactor with the given settings, ignoring the enabled flag.
+func NewRedactor(settings *RedactionSettings) (*Redactor, error) {
+	redactor := &Redactor{
+		replacement: settings.Replacement,
+		fields:      make([]string, 0, len(settings.Fields)),
+		values:      make([]*regexp.Regexp, 0, len(settings.Values)+len(settings.Presets)),
+	}
+
+	for _, pattern := range settings.Fields {
+		pattern = strings.ToLower(pattern)
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid field pattern %q: %w", pattern, err)
+		}
+
+		redactor.fields = append(redactor.fields, pattern)
+	}
+
+	expressions := make([]string, 0, len(settings.Values)+len(settings.Presets))
+	expressions = append(expressions, settings.Values...)
+
+	for _, preset := range settings.Presets {
+		expression, ok := RedactionPresets[preset]
+		if !ok {
+			return nil, fmt.Errorf("there is no redaction preset named %q", preset)
+		}
+
+		expressions = append(expressions, expression)
+	}
+
+	for _, expression := range expressions {
+		regex, err := regexp.Compile(expression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value pattern %q: %w", expression, err)
+		}
+
+		redactor.values = append(redactor.values, regex)
+	}
+
+	return redactor, nil
+}
+
+// Redact returns a copy of the fields with all sensitive data replaced. Nested maps and slices are redacted as well.
+func (r *Redactor) Redact(fields map[string]any) map[string]any {
+	if len(fields) == 0 {
+		return fields
+	}
+
+	redacted := make(map[string]any, len(fields))
+
+	for key, value := range fields {
+		if r.matchesField(key) {
+			redacted[key] = r.replacement
+
+			continue
+		}
+
+		redacted[key] = r.redactValue(value)
+	}
+
+	return redacted
+}
+
+func (r *Redactor) matchesField(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, pattern := range r.fields {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Redactor) redactValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		for _, regex := range r.values {
+			v = regex.ReplaceAllLiteralString(v, r.replacement)
+		}
+
+		return v
+	case map[string]any:
+		return r.Redact(v)
+	case []any:
+		values := make([]any, len(v))
+		for i, elem := range v {
+			values[i] = r.redactValue(elem)
+		}
+
+		return values
+	default:
+		return value
+	}
+}
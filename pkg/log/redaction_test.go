@@ -0,0 +1,101 @@
+package log_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactor(t *testing.T) {
+	redactor, err := log.NewRedactor(&log.RedactionSettings{
+		Replacement: "***",
+		Fields:      []string{"*password*", "token"},
+		Values:      []string{`\d{4}-\d{4}-\d{4}-\d{4}`},
+		Presets:     []string{"email", "iban"},
+	})
+	require.NoError(t, err)
+
+	actual := redactor.Redact(map[string]any{
+		"user_password": "secret",
+		"Token":         "abc",
+		"tokens":        3,
+		"message":       "mail from john.doe@example.com paid with 1234-5678-9012-3456",
+		"nested": map[string]any{
+			"PASSWORD": "secret",
+			"iban":     "DE89 3704 0044 0532 0130 00",
+		},
+		"list": []any{"jane@example.org", 1},
+	})
+
+	assert.Equal(t, map[string]any{
+		"user_password": "***",
+		"Token":         "***",
+		"tokens":        3,
+		"message":       "mail from *** paid with ***",
+		"nested": map[string]any{
+			"PASSWORD": "***",
+			"iban":     "***",
+		},
+		"list": []any{"***", 1},
+	}, actual)
+}
+
+func TestRedactorInvalidSettings(t *testing.T) {
+	_, err := log.NewRedactor(&log.RedactionSettings{
+		Presets: []string{"unknown"},
+	})
+	assert.EqualError(t, err, `there is no redaction preset named "unknown"`)
+
+	_, err = log.NewRedactor(&log.RedactionSettings{
+		Values: []string{"("},
+	})
+	assert.Error(t, err)
+}
+
+func TestLoggerWithRedactor(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"log": map[string]any{
+			"redaction": map[string]any{
+				"enabled": true,
+				"fields":  []string{"secret"},
+				"presets": []string{"email"},
+			},
+		},
+	})
+
+	redactor, err := log.NewRedactorFromConfig(config)
+	require.NoError(t, err)
+	require.NotNil(t, redactor)
+
+	buf := &bytes.Buffer{}
+	handler := log.NewHandlerIoWriter(config, log.PriorityInfo, log.FormatterJson, "main", time.RFC3339, buf)
+	logger := log.NewLoggerWithInterfaces(clock.NewFakeClock(), []log.Handler{handler})
+	require.NoError(t, logger.Option(
+		log.WithRedactor(redactor),
+		log.WithContextFieldsResolver(log.ContextFieldsResolver),
+	))
+
+	ctx := log.AppendContextFields(t.Context(), map[string]any{
+		"user": "john@example.com",
+	})
+	logger.WithFields(log.Fields{"secret": "value"}).Info(ctx, "message")
+
+	entry := map[string]any{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	assert.Equal(t, map[string]any{"secret": "[REDACTED]"}, entry["fields"])
+	assert.Equal(t, map[string]any{"user": "[REDACTED]"}, entry["context"])
+}
+
+func TestRedactorFromConfigDisabled(t *testing.T) {
+	redactor, err := log.NewRedactorFromConfig(cfg.New())
+	assert.NoError(t, err)
+	assert.Nil(t, redactor)
+}
@@ -22,12 +22,23 @@ type SentryHub interface {
 type SentryHubSettings struct {
 	Dsn          string
 	Environment  string
+	Release      string
 	AppName      string
 	AppNamespace string
 }
 
 // NewSentryHub creates a new SentryHub using configuration from the "app_id" settings.
+// The dsn and release are read from the settings of the "sentry" log handler.
 func NewSentryHub(config cfg.Config) (SentryHub, error) {
+	handlerSettings := &HandlerSentrySettings{}
+	if err := config.UnmarshalKey(getHandlerConfigKey("sentry"), handlerSettings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sentry handler settings: %w", err)
+	}
+
+	return newSentryHubFromConfig(config, handlerSettings)
+}
+
+func newSentryHubFromConfig(config cfg.Config, handlerSettings *HandlerSentrySettings) (SentryHub, error) {
 	var err error
 	var identity cfg.Identity
 	var namespace string
@@ -40,8 +51,15 @@ func NewSentryHub(config cfg.Config) (SentryHub, error) {
 		return nil, fmt.Errorf("failed to format namespace: %w", err)
 	}
 
+	release := handlerSettings.Release
+	if version, ok := identity.Tags["version"]; ok && release == "" {
+		release = fmt.Sprintf("%s@%s", identity.Name, version)
+	}
+
 	settings := &SentryHubSettings{
+		Dsn:          handlerSettings.Dsn,
 		Environment:  identity.Env,
+		Release:      release,
 		AppName:      identity.Name,
 		AppNamespace: namespace,
 	}
@@ -55,6 +73,7 @@ func NewSentryHubWithSettings(settings *SentryHubSettings) (SentryHub, error) {
 	options := sentry.ClientOptions{
 		Dsn:         settings.Dsn,
 		Environment: settings.Environment,
+		Release:     settings.Release,
 	}
 
 	var err error
@@ -0,0 +1,121 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+)
+
+// SlogHandler exposes a Logger as slog.Handler, so libraries logging with log/slog write into the channels,
+// levels and context fields of the gosoline logger. Attributes of the records become logger fields, groups
+// are flattened into dot separated field names.
+type SlogHandler struct {
+	logger Logger
+	groups []string
+}
+
+// NewSlogHandler creates a new slog.Handler writing all records to the given logger.
+func NewSlogHandler(logger Logger) *SlogHandler {
+	return &SlogHandler{
+		logger: logger,
+	}
+}
+
+// NewSlogLogger creates a new slog.Logger writing all records to the given logger.
+func NewSlogLogger(logger Logger) *slog.Logger {
+	return slog.New(NewSlogHandler(logger))
+}
+
+// Enabled always returns true as the levels are checked by the handlers of the gosoline logger.
+func (h *SlogHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+// Handle writes the record to the logger with the level mapped to the closest gosoline level.
+func (h *SlogHandler) Handle(ctx context.Context, record slog.Record) error {
+	logger := h.logger
+
+	if record.NumAttrs() > 0 {
+		fields := make(Fields, record.NumAttrs())
+
+		record.Attrs(func(attr slog.Attr) bool {
+			h.addAttr(fields, h.groups, attr)
+
+			return true
+		})
+
+		logger = logger.WithFields(fields)
+	}
+
+	switch {
+	case record.Level >= slog.LevelError:
+		logger.Error(ctx, "%s", record.Message)
+	case record.Level >= slog.LevelWarn:
+		logger.Warn(ctx, "%s", record.Message)
+	case record.Level >= slog.LevelInfo:
+		logger.Info(ctx, "%s", record.Message)
+	default:
+		logger.Debug(ctx, "%s", record.Message)
+	}
+
+	return nil
+}
+
+// WithAttrs returns a new handler with the attributes added as logger fields.
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	fields := make(Fields, len(attrs))
+	for _, attr := range attrs {
+		h.addAttr(fields, h.groups, attr)
+	}
+
+	return &SlogHandler{
+		logger: h.logger.WithFields(fields),
+		groups: h.groups,
+	}
+}
+
+// WithGroup returns a new handler prefixing the names of all following attributes with the group name.
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	groups := make([]string, len(h.groups), len(h.groups)+1)
+	copy(groups, h.groups)
+
+	return &SlogHandler{
+		logger: h.logger,
+		groups: append(groups, name),
+	}
+}
+
+func (h *SlogHandler) addAttr(fields Fields, groups []string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			groups = append(groups[:len(groups):len(groups)], attr.Key)
+		}
+
+		for _, groupAttr := range attr.Value.Group() {
+			h.addAttr(fields, groups, groupAttr)
+		}
+
+		return
+	}
+
+	key := attr.Key
+	if len(groups) > 0 {
+		key = strings.Join(groups, ".") + "." + key
+	}
+
+	fields[key] = attr.Value.Any()
+}
@@ -0,0 +1,74 @@
+package log_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlogHandler(t *testing.T) {
+	ctx := t.Context()
+	logger := mocks.NewLogger(t)
+	withAttrs := mocks.NewLogger(t)
+	withRecord := mocks.NewLogger(t)
+
+	logger.EXPECT().WithFields(log.Fields{"component": "client"}).Return(withAttrs).Once()
+	withAttrs.EXPECT().WithFields(log.Fields{
+		"request.method":        "GET",
+		"request.nested.status": int64(200),
+	}).Return(withRecord).Once()
+	withRecord.EXPECT().Warn(ctx, "%s", "request 100% done").Once()
+
+	slogger := log.NewSlogLogger(logger).With("component", "client").WithGroup("request")
+	slogger.WarnContext(ctx, "request 100% done", "method", "GET", slog.Group("nested", "status", 200))
+}
+
+func TestSlogHandlerLevels(t *testing.T) {
+	ctx := t.Context()
+	logger := mocks.NewLogger(t)
+
+	logger.EXPECT().Debug(ctx, "%s", "debug").Once()
+	logger.EXPECT().Debug(ctx, "%s", "trace").Once()
+	logger.EXPECT().Info(ctx, "%s", "info").Once()
+	logger.EXPECT().Error(ctx, "%s", "error").Once()
+
+	slogger := log.NewSlogLogger(logger)
+	slogger.DebugContext(ctx, "debug")
+	slogger.Log(ctx, slog.LevelDebug-4, "trace")
+	slogger.InfoContext(ctx, "info")
+	slogger.ErrorContext(ctx, "error")
+}
+
+func TestHandlerSlog(t *testing.T) {
+	buf := &bytes.Buffer{}
+	slogHandler := slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo})
+
+	handler := log.NewHandlerSlog(slogHandler, log.PriorityDebug)
+	logger := log.NewLoggerWithInterfaces(clock.NewFakeClockAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), []log.Handler{handler})
+
+	logger.WithChannel("http").Debug(t.Context(), "filtered by the slog handler")
+	logger.WithChannel("http").WithFields(log.Fields{"status": 500}).Error(t.Context(), "request failed: %w", fmt.Errorf("timeout"))
+
+	record := map[string]any{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+
+	assert.Equal(t, map[string]any{
+		"time":    "2024-01-01T00:00:00Z",
+		"level":   "ERROR",
+		"msg":     "request failed: timeout",
+		"channel": "http",
+		"err":     "request failed: timeout",
+		"fields": map[string]any{
+			"status": float64(500),
+		},
+	}, record)
+}
@@ -0,0 +1,117 @@
+package mdl
+
+import (
+	"reflect"
+)
+
+// Clone returns a deep copy of v, e.g. to keep a snapshot of a model before mutating it. Pointers, slices, maps,
+// arrays, interfaces and the exported fields of structs are copied recursively. Nil values stay nil and values
+// referenced more than once (including cycles) are copied once, so the copy has the same shape as the original.
+//
+// Unexported struct fields are copied shallowly, which keeps values like time.Time intact but shares the data unexported
+// pointers, slices and maps refer to. Channels and funcs are shared as well.
+func Clone[T any](v T) T {
+	c := &cloner{
+		pointers: map[clonePointer]reflect.Value{},
+	}
+
+	cloned, _ := c.clone(reflect.ValueOf(&v).Elem()).Interface().(T)
+
+	return cloned
+}
+
+type clonePointer struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+type cloner struct {
+	pointers map[clonePointer]reflect.Value
+}
+
+func (c *cloner) clone(src reflect.Value) reflect.Value {
+	typ := src.Type()
+
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		key := clonePointer{typ: typ, ptr: src.Pointer()}
+		if dst, ok := c.pointers[key]; ok {
+			return dst
+		}
+
+		dst := reflect.New(typ.Elem())
+		c.pointers[key] = dst
+		dst.Elem().Set(c.clone(src.Elem()))
+
+		return dst
+	case reflect.Interface:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		dst := reflect.New(typ).Elem()
+		dst.Set(c.clone(src.Elem()))
+
+		return dst
+	case reflect.Slice:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		dst := reflect.MakeSlice(typ, src.Len(), src.Cap())
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(c.clone(src.Index(i)))
+		}
+
+		return dst
+	case reflect.Array:
+		dst := reflect.New(typ).Elem()
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(c.clone(src.Index(i)))
+		}
+
+		return dst
+	case reflect.Map:
+		if src.IsNil() {
+			return reflect.Zero(typ)
+		}
+
+		dst := reflect.MakeMapWithSize(typ, src.Len())
+		it := src.MapRange()
+
+		for it.Next() {
+			dst.SetMapIndex(c.clone(it.Key()), c.clone(it.Value()))
+		}
+
+		return dst
+	case reflect.Struct:
+		dst := reflect.New(typ).Elem()
+		dst.Set(src)
+		c.cloneFields(dst, src)
+
+		return dst
+	default:
+		return src
+	}
+}
+
+// cloneFields replaces the exported fields of dst, a shallow copy of src, with deep copies. The exported fields of
+// embedded structs are promoted, so they are copied even if the embedded type is unexported.
+func (c *cloner) cloneFields(dst reflect.Value, src reflect.Value) {
+	typ := src.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		switch {
+		case field.IsExported():
+			dst.Field(i).Set(c.clone(src.Field(i)))
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			c.cloneFields(dst.Field(i), src.Field(i))
+		}
+	}
+}
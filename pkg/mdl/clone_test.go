@@ -0,0 +1,92 @@
+package mdl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/stretchr/testify/assert"
+)
+
+type cloneBase struct {
+	Id        *uint
+	CreatedAt time.Time
+}
+
+type cloneItem struct {
+	Name string
+	Tags []string
+}
+
+type cloneModel struct {
+	cloneBase
+	Name       string
+	Items      []cloneItem
+	ItemsById  map[string]*cloneItem
+	Primary    *cloneItem
+	Attributes map[string]any
+	Matrix     [2][]int
+	Empty      []string
+	Parent     *cloneModel
+	secret     []byte
+}
+
+func TestClone(t *testing.T) {
+	item := &cloneItem{Name: "a", Tags: []string{"x"}}
+	original := cloneModel{
+		cloneBase: cloneBase{
+			Id:        mdl.Box(uint(1)),
+			CreatedAt: time.Date(2024, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600)),
+		},
+		Name:      "model",
+		Items:     []cloneItem{{Name: "b", Tags: []string{"y"}}},
+		ItemsById: map[string]*cloneItem{"a": item},
+		Primary:   item,
+		Attributes: map[string]any{
+			"list": []any{"z", map[string]any{"nested": true}},
+		},
+		Matrix: [2][]int{{1}, {2}},
+		secret: []byte("secret"),
+	}
+
+	cloned := mdl.Clone(original)
+	assert.Equal(t, original, cloned)
+
+	*cloned.Id = 2
+	cloned.Items[0].Tags[0] = "changed"
+	cloned.ItemsById["a"].Name = "changed"
+	cloned.Attributes["list"].([]any)[1].(map[string]any)["nested"] = false
+	cloned.Matrix[0][0] = 3
+
+	assert.Equal(t, uint(1), *original.Id)
+	assert.Equal(t, "y", original.Items[0].Tags[0])
+	assert.Equal(t, "a", original.ItemsById["a"].Name)
+	assert.Equal(t, true, original.Attributes["list"].([]any)[1].(map[string]any)["nested"])
+	assert.Equal(t, 1, original.Matrix[0][0])
+
+	assert.Same(t, cloned.Primary, cloned.ItemsById["a"], "values referenced twice should be copied once")
+	assert.Nil(t, cloned.Empty)
+	assert.True(t, original.CreatedAt.Equal(cloned.CreatedAt))
+	assert.Equal(t, original.CreatedAt.Location(), cloned.CreatedAt.Location())
+}
+
+func TestClone_Cycle(t *testing.T) {
+	original := &cloneModel{Name: "root"}
+	original.Parent = original
+
+	cloned := mdl.Clone(original)
+
+	assert.NotSame(t, original, cloned)
+	assert.Same(t, cloned, cloned.Parent)
+}
+
+func TestClone_Interface(t *testing.T) {
+	var nilValue any
+	assert.Nil(t, mdl.Clone(nilValue))
+
+	var value any = []int{1, 2}
+	cloned := mdl.Clone(value)
+	cloned.([]int)[0] = 3
+
+	assert.Equal(t, []int{1, 2}, value)
+}
@@ -46,6 +46,30 @@ func ClonePtr[T any](v *T) *T {
 	return &cloned
 }
 
+// Map converts all elements of the slice with f. Unlike funk.Map, a nil slice stays nil, so it is still encoded as null
+// in a response.
+func Map[S any, T any](in []S, f func(S) T) []T {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]T, len(in))
+	for i, v := range in {
+		out[i] = f(v)
+	}
+
+	return out
+}
+
+// MapPtr converts the value v points to with f, or returns nil if v is nil.
+func MapPtr[S any, T any](v *S, f func(S) T) *T {
+	if v == nil {
+		return nil
+	}
+
+	return Box(f(*v))
+}
+
 func Unbox[T any](v *T, def T) T {
 	if v == nil {
 		return def
@@ -1,6 +1,7 @@
 package mdl_test
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/justtrackio/gosoline/pkg/mdl"
@@ -27,3 +28,14 @@ func TestClonePtr(t *testing.T) {
 		assert.Equal(t, "hello", value)
 	})
 }
+
+func TestMap(t *testing.T) {
+	assert.Nil(t, mdl.Map[int, string](nil, strconv.Itoa))
+	assert.Equal(t, []string{}, mdl.Map([]int{}, strconv.Itoa))
+	assert.Equal(t, []string{"1", "2"}, mdl.Map([]int{1, 2}, strconv.Itoa))
+}
+
+func TestMapPtr(t *testing.T) {
+	assert.Nil(t, mdl.MapPtr[int, string](nil, strconv.Itoa))
+	assert.Equal(t, mdl.Box("1"), mdl.MapPtr(mdl.Box(1), strconv.Itoa))
+}
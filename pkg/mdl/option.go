@@ -0,0 +1,115 @@
+package mdl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Option holds a value or nothing. In contrast to a pointer, it can't be changed through a shared reference and using
+// it without a check requires an explicit call to OrEmpty or OrElse. It is encoded as JSON null if it is empty and can
+// be omitted with the omitzero option of the json tag.
+type Option[T any] struct {
+	value T
+	set   bool
+}
+
+// Some returns an Option holding v.
+func Some[T any](v T) Option[T] {
+	return Option[T]{
+		value: v,
+		set:   true,
+	}
+}
+
+// None returns an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// OptionOf returns an Option holding the value v points to, or an empty Option if v is nil.
+func OptionOf[T any](v *T) Option[T] {
+	if v == nil {
+		return None[T]()
+	}
+
+	return Some(*v)
+}
+
+// MapOption converts the value of the Option with f, an empty Option stays empty.
+func MapOption[S any, T any](o Option[S], f func(S) T) Option[T] {
+	if !o.set {
+		return None[T]()
+	}
+
+	return Some(f(o.value))
+}
+
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+func (o Option[T]) IsSome() bool {
+	return o.set
+}
+
+// IsZero reports if the Option is empty, which makes the json omitzero option skip it.
+func (o Option[T]) IsZero() bool {
+	return !o.set
+}
+
+// OrElse returns the value of the Option or def if it is empty.
+func (o Option[T]) OrElse(def T) T {
+	if !o.set {
+		return def
+	}
+
+	return o.value
+}
+
+// OrEmpty returns the value of the Option or the zero value of T if it is empty.
+func (o Option[T]) OrEmpty() T {
+	return o.value
+}
+
+// Ptr returns a pointer to a copy of the value of the Option or nil if it is empty.
+func (o Option[T]) Ptr() *T {
+	if !o.set {
+		return nil
+	}
+
+	return Box(o.value)
+}
+
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.value)
+}
+
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("can not unmarshal option value of type %T: %w", value, err)
+	}
+
+	*o = Some(value)
+
+	return nil
+}
+
+func (o Option[T]) String() string {
+	if !o.set {
+		return "None"
+	}
+
+	return fmt.Sprintf("Some(%v)", o.value)
+}
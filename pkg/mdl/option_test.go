@@ -0,0 +1,70 @@
+package mdl_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/stretchr/testify/assert"
+)
+
+type optionResponse struct {
+	Name    mdl.Option[string] `json:"name"`
+	Age     mdl.Option[int]    `json:"age,omitzero"`
+	Comment mdl.Option[string] `json:"comment"`
+}
+
+func TestOption(t *testing.T) {
+	some := mdl.Some(3)
+	none := mdl.None[int]()
+
+	value, ok := some.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+	assert.True(t, some.IsSome())
+	assert.Equal(t, 3, some.OrElse(5))
+	assert.Equal(t, 3, *some.Ptr())
+	assert.Equal(t, "Some(3)", some.String())
+
+	_, ok = none.Get()
+	assert.False(t, ok)
+	assert.False(t, none.IsSome())
+	assert.Equal(t, 5, none.OrElse(5))
+	assert.Equal(t, 0, none.OrEmpty())
+	assert.Nil(t, none.Ptr())
+	assert.Equal(t, "None", none.String())
+}
+
+func TestOptionOf(t *testing.T) {
+	assert.Equal(t, mdl.None[string](), mdl.OptionOf[string](nil))
+	assert.Equal(t, mdl.Some("a"), mdl.OptionOf(mdl.Box("a")))
+}
+
+func TestMapOption(t *testing.T) {
+	assert.Equal(t, mdl.Some("3"), mdl.MapOption(mdl.Some(3), strconv.Itoa))
+	assert.Equal(t, mdl.None[string](), mdl.MapOption(mdl.None[int](), strconv.Itoa))
+}
+
+func TestOption_Json(t *testing.T) {
+	response := optionResponse{
+		Name: mdl.Some("Jane"),
+	}
+
+	encoded, err := json.Marshal(response)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Jane","comment":null}`, string(encoded))
+
+	decoded := optionResponse{
+		Comment: mdl.Some("old"),
+	}
+	err = json.Unmarshal([]byte(`{"name":"Jane","age":42,"comment":null}`), &decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, optionResponse{
+		Name: mdl.Some("Jane"),
+		Age:  mdl.Some(42),
+	}, decoded)
+
+	err = json.Unmarshal([]byte(`{"age":"42"}`), &decoded)
+	assert.ErrorContains(t, err, "can not unmarshal option value of type int")
+}
@@ -0,0 +1,193 @@
+package mdlsub
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/stream"
+)
+
+// OutboxRelayModule publishes the model events written to the outbox table by db_repo.NewOutboxNotifier to the
+// configured stream output and deletes them afterward. Events are published at least once: if the delete fails after
+// publishing, the events are published again.
+type OutboxRelayModule struct {
+	kernel.BackgroundModule
+	kernel.ServiceStage
+
+	logger   log.Logger
+	clock    clock.Clock
+	client   db.Client
+	output   stream.Output
+	dialect  db.Dialect
+	table    string
+	settings db_repo.OutboxRelaySettings
+}
+
+func NewOutboxRelayModule(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+	settings, err := db_repo.ReadOutboxSettings(config)
+	if err != nil {
+		return nil, err
+	}
+
+	dbSettings, err := db.ReadSettings(config, settings.Relay.ClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not read settings of db client %s: %w", settings.Relay.ClientName, err)
+	}
+
+	client, err := db.ProvideClient(ctx, config, logger, settings.Relay.ClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create db client %s: %w", settings.Relay.ClientName, err)
+	}
+
+	output, _, err := stream.NewConfigurableOutput(ctx, config, logger, settings.Relay.Output)
+	if err != nil {
+		return nil, fmt.Errorf("can not create output %s: %w", settings.Relay.Output, err)
+	}
+
+	return NewOutboxRelayModuleWithInterfaces(logger, clock.Provider, client, output, db.GetDialect(dbSettings.Driver), settings.Table, settings.Relay), nil
+}
+
+func NewOutboxRelayModuleWithInterfaces(
+	logger log.Logger,
+	clock clock.Clock,
+	client db.Client,
+	output stream.Output,
+	dialect db.Dialect,
+	table string,
+	settings db_repo.OutboxRelaySettings,
+) *OutboxRelayModule {
+	return &OutboxRelayModule{
+		logger:   logger.WithChannel("outbox-relay"),
+		clock:    clock,
+		client:   client,
+		output:   output,
+		dialect:  dialect,
+		table:    table,
+		settings: settings,
+	}
+}
+
+func (m *OutboxRelayModule) Run(ctx context.Context) error {
+	ticker := m.clock.NewTicker(m.settings.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.Chan():
+			if err := m.relayAll(ctx); err != nil {
+				if exec.IsRequestCanceled(err) {
+					return nil
+				}
+
+				m.logger.Error(ctx, "can not relay outbox events: %w", err)
+			}
+		}
+	}
+}
+
+// relayAll relays batches until the outbox is empty, so a backlog doesn't have to wait for the next tick.
+func (m *OutboxRelayModule) relayAll(ctx context.Context) error {
+	for {
+		count, err := m.Relay(ctx)
+		if err != nil {
+			return err
+		}
+
+		if count < m.settings.BatchSize {
+			return nil
+		}
+	}
+}
+
+// Relay publishes and deletes the oldest batch of events in a single transaction and returns the number of events
+// published. The rows are locked with SKIP LOCKED, so several instances of an application can relay concurrently.
+func (m *OutboxRelayModule) Relay(ctx context.Context) (int, error) {
+	count := 0
+
+	err := m.client.WithTx(ctx, nil, func(ctx context.Context, tx *sqlx.Tx) error {
+		events, err := m.selectEvents(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		if count = len(events); count == 0 {
+			return nil
+		}
+
+		messages := make([]stream.WritableMessage, len(events))
+		ids := make([]uint, len(events))
+
+		for i, event := range events {
+			messages[i] = stream.NewJsonMessage(event.Body, map[string]string{
+				AttributeModelId: event.ModelId,
+				AttributeType:    event.Type,
+				AttributeVersion: strconv.Itoa(event.Version),
+			})
+			ids[i] = event.Id
+		}
+
+		if err = m.output.Write(ctx, messages); err != nil {
+			return fmt.Errorf("can not publish %d outbox events: %w", len(messages), err)
+		}
+
+		return m.deleteEvents(ctx, tx, ids)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if count > 0 {
+		m.logger.Info(ctx, "relayed %d outbox events", count)
+	}
+
+	return count, nil
+}
+
+func (m *OutboxRelayModule) selectEvents(ctx context.Context, tx *sqlx.Tx) ([]db_repo.OutboxEvent, error) {
+	qry, args, err := squirrel.Select("id", "model_id", "type", "version", "body", "created_at").
+		From(m.table).
+		OrderBy("id").
+		Limit(uint64(m.settings.BatchSize)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(m.dialect.PlaceholderFormat()).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("can not build select statement: %w", err)
+	}
+
+	events := make([]db_repo.OutboxEvent, 0, m.settings.BatchSize)
+	if err = tx.SelectContext(ctx, &events, qry, args...); err != nil {
+		return nil, fmt.Errorf("can not select outbox events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (m *OutboxRelayModule) deleteEvents(ctx context.Context, tx *sqlx.Tx, ids []uint) error {
+	qry, args, err := squirrel.Delete(m.table).
+		Where(squirrel.Eq{"id": ids}).
+		PlaceholderFormat(m.dialect.PlaceholderFormat()).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("can not build delete statement: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, qry, args...); err != nil {
+		return fmt.Errorf("can not delete %d published outbox events: %w", len(ids), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package mdlsub_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdlsub"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	streamMocks "github.com/justtrackio/gosoline/pkg/stream/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	outboxSelect = "SELECT id, model_id, type, version, body, created_at FROM outbox_events ORDER BY id LIMIT 2 FOR UPDATE SKIP LOCKED"
+	outboxDelete = "DELETE FROM outbox_events WHERE id IN (?,?)"
+)
+
+func getOutboxRelay(t *testing.T, clk clock.Clock) (goSqlMock.Sqlmock, *streamMocks.Output, *mdlsub.OutboxRelayModule) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	sqlDb, dbc, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	client := db.NewClientWithInterfaces(logger, sqlx.NewDb(sqlDb, "mysql"), exec.NewDefaultExecutor())
+	output := streamMocks.NewOutput(t)
+
+	relay := mdlsub.NewOutboxRelayModuleWithInterfaces(logger, clk, client, output, db.GetDialect(db.DriverMysql), "outbox_events", db_repo.OutboxRelaySettings{
+		BatchSize: 2,
+		Interval:  time.Second,
+	})
+
+	return dbc, output, relay
+}
+
+func TestOutboxRelay_Relay(t *testing.T) {
+	dbc, output, relay := getOutboxRelay(t, clock.NewFakeClock())
+
+	rows := goSqlMock.NewRows([]string{"id", "model_id", "type", "version", "body", "created_at"}).
+		AddRow(1, "app.model", "create", 1, `{"id":1}`, time.Now()).
+		AddRow(2, "app.model", "delete", 1, `{"id":2}`, time.Now())
+
+	dbc.ExpectBegin()
+	dbc.ExpectQuery(regexp.QuoteMeta(outboxSelect)).WillReturnRows(rows)
+	dbc.ExpectExec(regexp.QuoteMeta(outboxDelete)).WithArgs(1, 2).WillReturnResult(goSqlMock.NewResult(0, 2))
+	dbc.ExpectCommit()
+
+	output.EXPECT().Write(mock.Anything, []stream.WritableMessage{
+		stream.NewJsonMessage(`{"id":1}`, map[string]string{
+			mdlsub.AttributeModelId: "app.model",
+			mdlsub.AttributeType:    "create",
+			mdlsub.AttributeVersion: "1",
+		}),
+		stream.NewJsonMessage(`{"id":2}`, map[string]string{
+			mdlsub.AttributeModelId: "app.model",
+			mdlsub.AttributeType:    "delete",
+			mdlsub.AttributeVersion: "1",
+		}),
+	}).Return(nil).Once()
+
+	count, err := relay.Relay(t.Context())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestOutboxRelay_RunDrainsOutboxOnTick(t *testing.T) {
+	clk := clock.NewFakeClock()
+	dbc, output, relay := getOutboxRelay(t, clk)
+	columns := []string{"id", "model_id", "type", "version", "body", "created_at"}
+
+	// the first batch is full, so the relay continues with the next batch without waiting for another tick
+	dbc.ExpectBegin()
+	dbc.ExpectQuery(regexp.QuoteMeta(outboxSelect)).WillReturnRows(goSqlMock.NewRows(columns).
+		AddRow(1, "app.model", "create", 1, `{"id":1}`, time.Now()).
+		AddRow(2, "app.model", "create", 1, `{"id":2}`, time.Now()))
+	dbc.ExpectExec(regexp.QuoteMeta(outboxDelete)).WithArgs(1, 2).WillReturnResult(goSqlMock.NewResult(0, 2))
+	dbc.ExpectCommit()
+
+	dbc.ExpectBegin()
+	dbc.ExpectQuery(regexp.QuoteMeta(outboxSelect)).WillReturnRows(goSqlMock.NewRows(columns).
+		AddRow(3, "app.model", "create", 1, `{"id":3}`, time.Now()))
+	dbc.ExpectExec(regexp.QuoteMeta("DELETE FROM outbox_events WHERE id IN (?)")).WithArgs(3).WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectCommit()
+
+	output.EXPECT().Write(mock.Anything, mock.Anything).Return(nil).Twice()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+
+	go func() {
+		done <- relay.Run(ctx)
+	}()
+
+	clk.BlockUntilTickers(1)
+	clk.Advance(time.Second)
+
+	assert.Eventually(t, func() bool {
+		return dbc.ExpectationsWereMet() == nil
+	}, time.Second, time.Millisecond, "the outbox should be drained after a single tick")
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestOutboxRelay_Empty(t *testing.T) {
+	dbc, _, relay := getOutboxRelay(t, clock.NewFakeClock())
+
+	dbc.ExpectBegin()
+	dbc.ExpectQuery(regexp.QuoteMeta(outboxSelect)).WillReturnRows(goSqlMock.NewRows([]string{"id", "model_id", "type", "version", "body", "created_at"}))
+	dbc.ExpectCommit()
+
+	count, err := relay.Relay(t.Context())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.NoError(t, dbc.ExpectationsWereMet())
+}
+
+func TestOutboxRelay_PublishError(t *testing.T) {
+	dbc, output, relay := getOutboxRelay(t, clock.NewFakeClock())
+
+	rows := goSqlMock.NewRows([]string{"id", "model_id", "type", "version", "body", "created_at"}).
+		AddRow(1, "app.model", "create", 1, `{"id":1}`, time.Now())
+
+	dbc.ExpectBegin()
+	dbc.ExpectQuery(regexp.QuoteMeta(outboxSelect)).WillReturnRows(rows)
+	dbc.ExpectRollback()
+
+	output.EXPECT().Write(mock.Anything, mock.Anything).Return(fmt.Errorf("stream is down")).Once()
+
+	count, err := relay.Relay(t.Context())
+
+	assert.ErrorContains(t, err, "stream is down")
+	assert.Equal(t, 0, count)
+	assert.NoError(t, dbc.ExpectationsWereMet(), "the events should stay in the outbox")
+}
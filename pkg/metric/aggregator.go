@@ -0,0 +1,139 @@
+package metric
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+type BatchedMetricDatum struct {
+	Priority   int
+	Timestamp  time.Time
+	MetricName string
+	Dimensions Dimensions
+	Values     []float64
+	Unit       types.StandardUnit
+	Kind       Kind
+	Exemplar   *Exemplar
+}
+
+// aggregateKey identifies the aggregate of a data point. Using a comparable struct instead of a formatted string keeps
+// adding a data point to an existing aggregate free of allocations apart from the dimension key.
+type aggregateKey struct {
+	metricName string
+	dimensions string
+	minute     int64
+}
+
+// Aggregator collapses all data points with the same metric name and dimensions written in the same minute into a
+// single datum, so aggregating writers only have to send a handful of data per interval instead of every data point.
+// It is not safe for concurrent use.
+type Aggregator struct {
+	batch          map[aggregateKey]*BatchedMetricDatum
+	dataPointCount int
+	statisticSets  bool
+}
+
+// NewAggregator creates an empty aggregator. With statisticSets, metrics which are neither counters nor distributions
+// carry their count, sum, minimum and maximum in addition to the sum, see Data.
+func NewAggregator(statisticSets bool) *Aggregator {
+	return &Aggregator{
+		batch:         make(map[aggregateKey]*BatchedMetricDatum),
+		statisticSets: statisticSets,
+	}
+}
+
+// Add adds a data point to the aggregate of its metric. The first data point of a metric is amended from the metric
+// defaults and has to be valid, otherwise the data point is dropped and an error returned. The aggregate keeps the
+// exemplar of the latest data point having one.
+func (a *Aggregator) Add(datum *Datum) error {
+	a.dataPointCount++
+
+	key := aggregateKey{
+		metricName: datum.MetricName,
+		dimensions: datum.DimensionKV(),
+		minute:     datum.Timestamp.Truncate(time.Minute).Unix(),
+	}
+
+	if existing, ok := a.batch[key]; ok {
+		existing.Values = append(existing.Values, datum.Value)
+
+		if datum.Exemplar != nil {
+			existing.Exemplar = datum.Exemplar
+		}
+
+		return nil
+	}
+
+	amendFromDefault(datum)
+
+	if err := datum.IsValid(); err != nil {
+		return err
+	}
+
+	a.batch[key] = &BatchedMetricDatum{
+		Priority:   datum.Priority,
+		Timestamp:  datum.Timestamp,
+		MetricName: datum.MetricName,
+		Dimensions: datum.Dimensions,
+		Unit:       datum.Unit,
+		Values:     []float64{datum.Value},
+		Kind:       datum.Kind,
+		Exemplar:   datum.Exemplar,
+	}
+
+	return nil
+}
+
+// Len returns the amount of aggregated metrics.
+func (a *Aggregator) Len() int {
+	return len(a.batch)
+}
+
+// DataPointCount returns the amount of data points added since the last reset.
+func (a *Aggregator) DataPointCount() int {
+	return a.dataPointCount
+}
+
+// Reset drops all aggregated metrics.
+func (a *Aggregator) Reset() {
+	a.batch = make(map[aggregateKey]*BatchedMetricDatum)
+	a.dataPointCount = 0
+}
+
+// Data returns a datum per aggregated metric:
+//   - histograms and summaries keep all their values as a Distribution, Value is their average
+//   - custom units like UnitCountAverage are reduced as registered
+//   - counters are summed up
+//   - everything else is summed up as well. With statistic sets enabled, it additionally carries the count, sum,
+//     minimum and maximum as a Distribution without values, so writers like CloudWatch can send a statistic set
+func (a *Aggregator) Data() Data {
+	data := make(Data, 0, len(a.batch))
+
+	for _, v := range a.batch {
+		datum := &Datum{
+			Priority:   v.Priority,
+			Timestamp:  v.Timestamp,
+			MetricName: v.MetricName,
+			Dimensions: v.Dimensions,
+			Unit:       v.Unit,
+			Kind:       v.Kind,
+			Exemplar:   v.Exemplar,
+		}
+
+		switch {
+		case v.Kind.IsDistribution():
+			datum.Distribution = NewDistribution(v.Values)
+			datum.Value = datum.Distribution.Average()
+		case !a.statisticSets || isCustomUnit(v.Unit) || effectiveKind(datum) == kindCounter || len(v.Values) == 1:
+			datum.Unit, datum.Value = resolveCustomUnit(v.Unit, v.Values)
+		default:
+			datum.Distribution = NewStatistics(v.Values)
+			datum.Value = datum.Distribution.Sum
+		}
+
+		data = append(data, datum)
+	}
+
+	return data
+}
@@ -0,0 +1,118 @@
+package metric_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregator(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 30, 15, 0, time.UTC)
+	aggregator := metric.NewAggregator(true)
+
+	add := func(name string, unit metric.StandardUnit, kind metric.Kind, values ...float64) {
+		for _, value := range values {
+			err := aggregator.Add(&metric.Datum{
+				Priority:   metric.PriorityHigh,
+				Timestamp:  timestamp,
+				MetricName: name,
+				Dimensions: metric.Dimensions{"queue": "events"},
+				Unit:       unit,
+				Kind:       kind,
+				Value:      value,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	add("a_count", metric.UnitCount, metric.KindDefault, 1, 1, 1)
+	add("b_average", metric.UnitCountAverage, metric.KindDefault, 2, 4)
+	add("c_latency", metric.UnitMilliseconds, metric.KindHistogram.Build(), 10, 30)
+	add("d_size", metric.StandardUnit("Bytes"), metric.KindDefault, 5, 1, 3)
+	add("e_single", metric.StandardUnit("Bytes"), metric.KindDefault, 7)
+
+	err := aggregator.Add(&metric.Datum{MetricName: "invalid"})
+	assert.EqualError(t, err, "metric invalid has no priority")
+
+	assert.Equal(t, 5, aggregator.Len())
+	assert.Equal(t, 12, aggregator.DataPointCount())
+
+	data := aggregator.Data()
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].MetricName < data[j].MetricName
+	})
+	require.Len(t, data, 5)
+
+	assert.Equal(t, 3.0, data[0].Value)
+	assert.Nil(t, data[0].Distribution)
+
+	assert.Equal(t, metric.UnitCount, data[1].Unit)
+	assert.Equal(t, 3.0, data[1].Value)
+
+	assert.Equal(t, 20.0, data[2].Value)
+	assert.Equal(t, metric.NewDistribution([]float64{10, 30}), data[2].Distribution)
+
+	assert.Equal(t, 9.0, data[3].Value)
+	assert.Equal(t, &metric.Distribution{Count: 3, Sum: 9, Min: 1, Max: 5}, data[3].Distribution)
+
+	assert.Equal(t, 7.0, data[4].Value)
+	assert.Nil(t, data[4].Distribution)
+
+	aggregator.Reset()
+	assert.Equal(t, 0, aggregator.Len())
+	assert.Equal(t, 0, aggregator.DataPointCount())
+}
+
+func TestAggregator_WithoutStatisticSets(t *testing.T) {
+	aggregator := metric.NewAggregator(false)
+
+	for _, value := range []float64{5, 1, 3} {
+		err := aggregator.Add(&metric.Datum{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  time.Date(2024, 1, 1, 12, 30, 15, 0, time.UTC),
+			MetricName: "size",
+			Unit:       metric.StandardUnit("Bytes"),
+			Value:      value,
+		})
+		require.NoError(t, err)
+	}
+
+	data := aggregator.Data()
+	require.Len(t, data, 1)
+	assert.Equal(t, 9.0, data[0].Value)
+	assert.Nil(t, data[0].Distribution)
+}
+
+func TestAggregator_KeepsLatestExemplar(t *testing.T) {
+	timestamp := time.Date(2024, 1, 1, 12, 30, 15, 0, time.UTC)
+	aggregator := metric.NewAggregator(false)
+
+	first := &metric.Exemplar{TraceId: "first", Value: 10}
+	latest := &metric.Exemplar{TraceId: "latest", Value: 30}
+
+	for _, exemplar := range []*metric.Exemplar{first, latest, nil} {
+		value := 20.0
+		if exemplar != nil {
+			value = exemplar.Value
+		}
+
+		err := aggregator.Add(&metric.Datum{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  timestamp,
+			MetricName: "latency",
+			Unit:       metric.UnitMilliseconds,
+			Kind:       metric.KindHistogram.Build(),
+			Value:      value,
+			Exemplar:   exemplar,
+		})
+		require.NoError(t, err)
+	}
+
+	data := aggregator.Data()
+	require.Len(t, data, 1)
+	assert.Same(t, latest, data[0].Exemplar)
+}
@@ -0,0 +1,91 @@
+package metric
+
+import (
+	"context"
+	"time"
+
+	awsMiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithyMiddleware "github.com/aws/smithy-go/middleware"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoAws "github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	MetricNameAwsRequest        = "AwsRequest"
+	MetricNameAwsRequestError   = "AwsRequestError"
+	MetricNameAwsRequestLatency = "AwsRequestLatency"
+)
+
+func init() {
+	gosoAws.AddClientMiddlewareFactory("metrics", AwsRequestMetricsMiddlewareFactory)
+}
+
+func AwsRequestMetricsMiddlewareFactory(_ context.Context, _ cfg.Config, _ log.Logger, settings gosoAws.ClientSettings) ([]func(stack *smithyMiddleware.Stack) error, error) {
+	if !settings.Middleware.Metrics {
+		return nil, nil
+	}
+
+	metricWriter := NewWriter()
+
+	return []func(stack *smithyMiddleware.Stack) error{
+		func(stack *smithyMiddleware.Stack) error {
+			mw := AwsRequestMetricsMiddleware(metricWriter, clock.Provider, settings.ClientName)
+
+			// requests rejected by the circuit breaker are counted as errors as well
+			if _, ok := stack.Initialize.Get(gosoAws.MiddlewareNameCircuitBreaker); ok {
+				return stack.Initialize.Insert(mw, gosoAws.MiddlewareNameCircuitBreaker, smithyMiddleware.Before)
+			}
+
+			return stack.Initialize.Add(mw, smithyMiddleware.After)
+		},
+	}, nil
+}
+
+// AwsRequestMetricsMiddleware writes the count, errors and latency of every request per service and operation. The
+// latency includes all attempts of a request.
+func AwsRequestMetricsMiddleware(metricWriter Writer, clock clock.Clock, clientName string) smithyMiddleware.InitializeMiddleware {
+	return smithyMiddleware.InitializeMiddlewareFunc("RequestMetrics", func(
+		ctx context.Context,
+		input smithyMiddleware.InitializeInput,
+		next smithyMiddleware.InitializeHandler,
+	) (smithyMiddleware.InitializeOutput, smithyMiddleware.Metadata, error) {
+		start := clock.Now()
+		output, metadata, err := next.HandleInitialize(ctx, input)
+		took := clock.Since(start)
+
+		dimensions := Dimensions{
+			"Service":    awsMiddleware.GetServiceID(ctx),
+			"Operation":  awsMiddleware.GetOperationName(ctx),
+			"ClientName": clientName,
+		}
+
+		errorCount := 0.0
+		if err != nil && !exec.IsRequestCanceled(err) {
+			errorCount = 1.0
+		}
+
+		metricWriter.Write(ctx, Data{
+			{
+				MetricName: MetricNameAwsRequest,
+				Dimensions: dimensions,
+				Value:      1.0,
+			},
+			{
+				MetricName: MetricNameAwsRequestError,
+				Dimensions: dimensions,
+				Value:      errorCount,
+			},
+			{
+				MetricName: MetricNameAwsRequestLatency,
+				Dimensions: dimensions,
+				Value:      float64(took) / float64(time.Millisecond),
+				Unit:       UnitMillisecondsAverage,
+			},
+		})
+
+		return output, metadata, err
+	})
+}
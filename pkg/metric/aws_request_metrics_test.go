@@ -0,0 +1,49 @@
+package metric_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awsMiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithyMiddleware "github.com/aws/smithy-go/middleware"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAwsRequestMetricsMiddleware(t *testing.T) {
+	fakeClock := clock.NewFakeClock()
+	metricWriter := metricMocks.NewWriter(t)
+	mw := metric.AwsRequestMetricsMiddleware(metricWriter, fakeClock, "default")
+
+	dimensions := metric.Dimensions{
+		"Service":    "DynamoDB",
+		"Operation":  "GetItem",
+		"ClientName": "default",
+	}
+	metricWriter.EXPECT().Write(matcher.Context, metric.Data{
+		{MetricName: metric.MetricNameAwsRequest, Dimensions: dimensions, Value: 1},
+		{MetricName: metric.MetricNameAwsRequestError, Dimensions: dimensions, Value: 1},
+		{MetricName: metric.MetricNameAwsRequestLatency, Dimensions: dimensions, Value: 250, Unit: metric.UnitMillisecondsAverage},
+	}).Once()
+
+	serviceMetadata := &awsMiddleware.RegisterServiceMetadata{
+		ServiceID:     "DynamoDB",
+		OperationName: "GetItem",
+	}
+
+	handler := smithyMiddleware.InitializeHandlerFunc(func(ctx context.Context, input smithyMiddleware.InitializeInput) (smithyMiddleware.InitializeOutput, smithyMiddleware.Metadata, error) {
+		fakeClock.Advance(250 * time.Millisecond)
+
+		return smithyMiddleware.InitializeOutput{}, smithyMiddleware.Metadata{}, errors.New("service unavailable")
+	})
+
+	_, _, err := serviceMetadata.HandleInitialize(t.Context(), smithyMiddleware.InitializeInput{}, smithyMiddleware.InitializeHandlerFunc(func(ctx context.Context, input smithyMiddleware.InitializeInput) (smithyMiddleware.InitializeOutput, smithyMiddleware.Metadata, error) {
+		return mw.HandleInitialize(ctx, input, handler)
+	}))
+	assert.EqualError(t, err, "service unavailable")
+}
@@ -0,0 +1,71 @@
+package metric
+
+import (
+	"maps"
+)
+
+// DimensionOverflow replaces the values of all dimensions of a datum once its metric exceeded the allowed amount of
+// distinct dimension sets.
+const DimensionOverflow = "overflow"
+
+type CardinalitySettings struct {
+	// MaxDimensionSets is the amount of distinct dimension sets allowed per metric and metric interval, 0 disables the
+	// limit.
+	MaxDimensionSets int `cfg:"max_dimension_sets" default:"1000"`
+}
+
+// cardinalityLimiter caps the amount of distinct dimension sets per metric. Metrics with dimensions depending on
+// unbounded values like user ids would otherwise create a time series per value, exploding the costs of the backends.
+// The known dimension sets are forgotten on every reset, so sets not written anymore free their slot again.
+// It is not safe for concurrent use.
+type cardinalityLimiter struct {
+	settings CardinalitySettings
+	sets     map[string]map[string]struct{}
+}
+
+func newCardinalityLimiter(settings CardinalitySettings) *cardinalityLimiter {
+	return &cardinalityLimiter{
+		settings: settings,
+		sets:     make(map[string]map[string]struct{}),
+	}
+}
+
+// limit returns the datum unchanged as long as its dimension set is known or the limit of its metric was not yet
+// reached. Otherwise, it returns a copy with all dimension values replaced by DimensionOverflow and true.
+func (l *cardinalityLimiter) limit(datum *Datum) (*Datum, bool) {
+	if l.settings.MaxDimensionSets <= 0 || len(datum.Dimensions) == 0 {
+		return datum, false
+	}
+
+	sets, ok := l.sets[datum.MetricName]
+	if !ok {
+		sets = make(map[string]struct{})
+		l.sets[datum.MetricName] = sets
+	}
+
+	key := datum.DimensionKV()
+
+	if _, ok := sets[key]; ok {
+		return datum, false
+	}
+
+	if len(sets) < l.settings.MaxDimensionSets {
+		sets[key] = struct{}{}
+
+		return datum, false
+	}
+
+	cpy := *datum
+	cpy.Dimensions = maps.Clone(datum.Dimensions)
+
+	for name := range cpy.Dimensions {
+		cpy.Dimensions[name] = DimensionOverflow
+	}
+
+	return &cpy, true
+}
+
+// reset forgets all known dimension sets, starting a new period for the limit.
+func (l *cardinalityLimiter) reset() {
+	clear(l.sets)
+}
@@ -0,0 +1,57 @@
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCardinalityLimiter verifies that known dimension sets pass while new ones are written as overflow once the
+// limit of the metric is reached.
+func TestCardinalityLimiter(t *testing.T) {
+	limiter := newCardinalityLimiter(CardinalitySettings{MaxDimensionSets: 2})
+
+	datum := func(name string, user string) *Datum {
+		return &Datum{MetricName: name, Dimensions: Dimensions{"User": user, "Type": "click"}}
+	}
+
+	for _, user := range []string{"1", "2", "1"} {
+		limited, overflow := limiter.limit(datum("Clicks", user))
+		assert.False(t, overflow)
+		assert.Equal(t, user, limited.Dimensions["User"])
+	}
+
+	original := datum("Clicks", "3")
+	limited, overflow := limiter.limit(original)
+	assert.True(t, overflow)
+	assert.Equal(t, Dimensions{"User": DimensionOverflow, "Type": DimensionOverflow}, limited.Dimensions)
+	assert.Equal(t, "3", original.Dimensions["User"], "the original datum must not be changed")
+
+	_, overflow = limiter.limit(datum("Views", "3"))
+	assert.False(t, overflow, "the limit is applied per metric")
+
+	_, overflow = newCardinalityLimiter(CardinalitySettings{}).limit(datum("Clicks", "4"))
+	assert.False(t, overflow, "a limit of 0 disables limiting")
+
+	limiter.reset()
+	limited, overflow = limiter.limit(datum("Clicks", "3"))
+	assert.False(t, overflow, "the limit starts over after a reset")
+	assert.Equal(t, "3", limited.Dimensions["User"])
+}
+
+func TestDatum_ValidateDimensions(t *testing.T) {
+	datum := &Datum{MetricName: "Clicks", Priority: PriorityHigh, Unit: UnitCount, Dimensions: Dimensions{"Type": ""}}
+	assert.NoError(t, datum.IsValid())
+
+	datum.Dimensions = Dimensions{"": "click"}
+	assert.EqualError(t, datum.IsValid(), "metric Clicks has a dimension without a name")
+
+	datum.Dimensions = Dimensions{"Type": "cl\nick"}
+	assert.EqualError(t, datum.IsValid(), "metric Clicks has an invalid value for dimension Type: the value contains control characters")
+
+	datum.Dimensions = Dimensions{}
+	for i := 0; i <= maxDimensions; i++ {
+		datum.Dimensions[string(rune('a'+i))] = "x"
+	}
+	assert.EqualError(t, datum.IsValid(), "metric Clicks has 31 dimensions, but at most 30 are allowed")
+}
@@ -1,7 +1,9 @@
 package metric
 
 import (
+	"slices"
 	"sync"
+	"sync/atomic"
 
 	"github.com/justtrackio/gosoline/pkg/log"
 )
@@ -36,58 +38,101 @@ func providerMetricChannel(configure func(channel *metricChannel)) *metricChanne
 // closed). if a service writes too many metrics and blows through its memory allocation,
 // this is a much louder error, causes the service to restart, and the service to heal
 // automatically (to some degree at least)
+//
+// writing is on the hot path of every service, so writers never take a lock: every write
+// pushes a batch onto a lock-free stack, which the reader takes as a whole. the batches are
+// pooled and the reader copies their data into a buffer it reuses, so writing doesn't
+// allocate once the pool and the buffer have grown to the usual amount of data.
 type metricChannel struct {
-	lck     sync.Mutex
 	logger  log.Logger
 	hasData chan struct{}
-	data    Data
-	enabled bool
-	closed  bool
+	head    atomic.Pointer[metricBatch]
+	enabled atomic.Bool
+	closed  atomic.Bool
+	// exemplars enables linking written data to the trace of their context
+	exemplars atomic.Bool
 }
 
-func (c *metricChannel) read() Data {
-	c.lck.Lock()
-	defer c.lck.Unlock()
+// metricBatch is the data of a single write, linked to the batch written before.
+type metricBatch struct {
+	data Data
+	next *metricBatch
+}
+
+var metricBatchPool = sync.Pool{
+	New: func() any {
+		return &metricBatch{}
+	},
+}
 
-	data := c.data
-	c.data = nil
+// read returns the data written since the last read in the order it was written. The data
+// is copied into buf, so pass the data returned by the previous read once it is no longer
+// needed to reuse its memory, or nil.
+func (c *metricChannel) read(buf Data) Data {
+	clear(buf)
 
 	// no need to clear hasData - the caller should have done this, but it is
 	// also not an error for this flag to be wrongly set
+	head := c.head.Swap(nil)
 
-	return data
-}
+	size := 0
+	for batch := head; batch != nil; batch = batch.next {
+		size += len(batch.data)
+	}
+
+	buf = slices.Grow(buf[:0], size)[:size]
 
-func (c *metricChannel) write(batch Data) {
-	c.lck.Lock()
-	defer c.lck.Unlock()
+	// the stack holds the latest batch first, so we fill the buffer from its end
+	end := size
+	for batch := head; batch != nil; {
+		end -= copy(buf[end-len(batch.data):end], batch.data)
 
+		next := batch.next
+		clear(batch.data)
+		batch.data = batch.data[:0]
+		batch.next = nil
+		metricBatchPool.Put(batch)
+
+		batch = next
+	}
+
+	return buf
+}
+
+func (c *metricChannel) write(data Data) {
 	// we just return on closed channels because we can't avoid some services
 	// writing some metrics when they are shut down. if we are already closed
 	// at that point, we log pointless messages about the channel being closed
 	// already, although there isn't really anything we can do about it
 	// also: writing a warning here would be a terrible idea as this could
-	// trigger a metric to be written, calling this method again (but the lock
-	// is already taken)
-	if !c.enabled || c.closed {
+	// trigger a metric to be written, calling this method again
+	if !c.enabled.Load() || c.closed.Load() {
 		return
 	}
 
-	c.data = append(c.data, batch...)
+	batch := metricBatchPool.Get().(*metricBatch)
+	batch.data = append(batch.data, data...)
+
+	for {
+		batch.next = c.head.Load()
+
+		if c.head.CompareAndSwap(batch.next, batch) {
+			break
+		}
+	}
 
 	// we still need to be able to read from this like from a channel... so fake
 	// it with a dummy channel which can only hold a single value (we only need
 	// a flag whether there could be data in here - it is okay for hasData to
-	// return a value even though c.data has length 0)
+	// return a value even though there is no data)
 	select {
 	case c.hasData <- struct{}{}:
 	default:
 	}
 }
 
+// close drops all data written afterward. Data of writes racing with close might still be
+// added, but is never read.
 func (c *metricChannel) close() {
-	c.lck.Lock()
-	defer c.lck.Unlock()
-
-	c.closed = true
+	c.closed.Store(true)
 }
@@ -0,0 +1,155 @@
+package metric
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestChannel(enabled bool) *metricChannel {
+	channel := &metricChannel{
+		hasData: make(chan struct{}, 1),
+	}
+	channel.enabled.Store(enabled)
+
+	return channel
+}
+
+func TestMetricChannel_ReadReusesBuffer(t *testing.T) {
+	channel := newTestChannel(true)
+
+	first := &Datum{MetricName: "first"}
+	channel.write(Data{first})
+
+	data := channel.read(nil)
+	require.Equal(t, Data{first}, data)
+	assert.Len(t, channel.hasData, 1)
+
+	second := &Datum{MetricName: "second"}
+	third := &Datum{MetricName: "third"}
+	channel.write(Data{second})
+	channel.write(Data{third})
+
+	previous := data
+	data = channel.read(previous[:1:1])
+	assert.Equal(t, Data{second, third}, data, "the data should be read in the order it was written")
+
+	fourth := &Datum{MetricName: "fourth"}
+	channel.write(Data{fourth})
+
+	reused := channel.read(data)
+	assert.Equal(t, Data{fourth}, reused)
+	assert.Same(t, &data[0], &reused[0], "the buffer of the previous read should be reused")
+	assert.Nil(t, data[1], "the reused buffer should be cleared to not keep the data alive")
+}
+
+func TestMetricChannel_ConcurrentWrites(t *testing.T) {
+	const writers, writes = 4, 100
+
+	channel := newTestChannel(true)
+	wg := &sync.WaitGroup{}
+
+	for w := range writers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range writes {
+				channel.write(Data{{MetricName: fmt.Sprint(w), Value: float64(i)}})
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	data := channel.read(nil)
+	require.Len(t, data, writers*writes)
+
+	next := map[string]float64{}
+	for _, datum := range data {
+		assert.Equal(t, next[datum.MetricName], datum.Value, "the writes of writer %s should be read in order", datum.MetricName)
+		next[datum.MetricName]++
+	}
+
+	assert.Empty(t, channel.read(nil))
+}
+
+func TestMetricChannel_DropsDataIfDisabledOrClosed(t *testing.T) {
+	disabled := newTestChannel(false)
+	disabled.write(Data{{MetricName: "disabled"}})
+	assert.Empty(t, disabled.read(nil))
+	assert.Empty(t, disabled.hasData)
+
+	closed := newTestChannel(true)
+	closed.close()
+	closed.write(Data{{MetricName: "closed"}})
+	assert.Empty(t, closed.read(nil))
+	assert.Empty(t, closed.hasData)
+}
+
+func BenchmarkWriter_Write(b *testing.B) {
+	channel := newTestChannel(true)
+	w := NewWriterWithInterfaces(clock.NewRealClock(), channel)
+	dimensions := Dimensions{"Consumer": "benchmark"}
+
+	var buffer Data
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		datum := AcquireDatum()
+		datum.MetricName = "ProcessedCount"
+		datum.Dimensions = dimensions
+		datum.Value = 1
+		w.WriteOne(b.Context(), datum)
+
+		if i%1000 == 0 {
+			buffer = channel.read(buffer)
+			releaseData(buffer)
+		}
+	}
+}
+
+func BenchmarkWriter_WriteParallel(b *testing.B) {
+	channel := newTestChannel(true)
+	w := NewWriterWithInterfaces(clock.NewRealClock(), channel)
+	dimensions := Dimensions{"Consumer": "benchmark"}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	// drain the channel like the daemon does, so the writers compete with a reader for the head of the channel
+	go func() {
+		var buffer Data
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-channel.hasData:
+				buffer = channel.read(buffer)
+				releaseData(buffer)
+			}
+		}
+	}()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			datum := AcquireDatum()
+			datum.MetricName = "ProcessedCount"
+			datum.Dimensions = dimensions
+			datum.Value = 1
+			w.WriteOne(b.Context(), datum)
+		}
+	})
+}
@@ -51,6 +51,12 @@ func RegisterCustomUnit(unit types.StandardUnit, standardUnit types.StandardUnit
 	}
 }
 
+func isCustomUnit(unit types.StandardUnit) bool {
+	_, ok := customUnits[unit]
+
+	return ok
+}
+
 func resolveCustomUnit(unit types.StandardUnit, values []float64) (resolvedUnit types.StandardUnit, resolvedValue float64) {
 	if customMetric, ok := customUnits[unit]; ok {
 		return customMetric.Unit, customMetric.Reducer(values)
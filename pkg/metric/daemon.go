@@ -3,10 +3,10 @@ package metric
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/kernel"
@@ -15,10 +15,13 @@ import (
 )
 
 const (
-	defaultTimeFormat       = "2006-01-02T15:04Z07:00"
 	WriterTypeCloudwatch    = "cloudwatch"
 	WriterTypeElasticsearch = "elasticsearch"
+	WriterTypeMemory        = "memory"
+	WriterTypeOtlp          = "otlp"
 	WriterTypePrometheus    = "prometheus"
+	WriterTypePushgateway   = "pushgateway"
+	WriterTypeStatsd        = "statsd"
 )
 
 type WriterFactory func(ctx context.Context, config cfg.Config, logger log.Logger) (Writer, error)
@@ -29,28 +32,19 @@ func RegisterWriterFactory(name string, factory WriterFactory) {
 	writerFactories[name] = factory
 }
 
-type BatchedMetricDatum struct {
-	Priority   int
-	Timestamp  time.Time
-	MetricName string
-	Dimensions Dimensions
-	Values     []float64
-	Unit       types.StandardUnit
-	Kind       Kind
-}
-
 type Daemon struct {
 	kernel.EssentialBackgroundModule
 	logger   log.Logger
 	settings *Settings
 
 	channel                 *metricChannel
+	buffer                  Data
 	ticker                  *time.Ticker
 	aggregatedMetricWriters []Writer
 	rawMetricWriters        []Writer
 
-	batch          map[string]*BatchedMetricDatum
-	dataPointCount int
+	aggregator  *Aggregator
+	cardinality *cardinalityLimiter
 
 	errorThrottlesLck sync.Mutex
 	errorThrottles    map[string]bool
@@ -67,7 +61,8 @@ func NewDaemonModule(ctx context.Context, config cfg.Config, logger log.Logger)
 	}
 
 	channel := providerMetricChannel(func(channel *metricChannel) {
-		channel.enabled = settings.Enabled
+		channel.enabled.Store(settings.Enabled)
+		channel.exemplars.Store(settings.Exemplars.Enabled)
 		channel.logger = logger.WithChannel("metrics")
 	})
 
@@ -79,12 +74,6 @@ func NewDaemonModule(ctx context.Context, config cfg.Config, logger log.Logger)
 	rawWriters := make([]Writer, 0)
 
 	for _, typ := range settings.Writers {
-		metricWriterAggrCnfKey := metricWriterAggrKey(typ)
-		aggWriter, err := config.GetBool(metricWriterAggrCnfKey, false)
-		if err != nil {
-			return nil, fmt.Errorf("can not get bool from config at %s: %w", metricWriterAggrCnfKey, err)
-		}
-
 		factory, ok := writerFactories[typ]
 		if !ok {
 			return nil, fmt.Errorf("unrecognized writer type: %s", typ)
@@ -95,6 +84,14 @@ func NewDaemonModule(ctx context.Context, config cfg.Config, logger log.Logger)
 			return nil, fmt.Errorf("could not create %s metric writer: %w", typ, err)
 		}
 
+		// the factory reads the writer settings, which sets the default of the writer for aggregate (e.g. true for
+		// cloudwatch) if it is not configured. Hence, we can only decide about aggregation after creating the writer.
+		metricWriterAggrCnfKey := metricWriterAggrKey(typ)
+		aggWriter, err := config.GetBool(metricWriterAggrCnfKey, false)
+		if err != nil {
+			return nil, fmt.Errorf("can not get bool from config at %s: %w", metricWriterAggrCnfKey, err)
+		}
+
 		if aggWriter {
 			aggWriters = append(aggWriters, w)
 		} else {
@@ -113,8 +110,8 @@ func NewMetricDaemonWithInterfaces(logger log.Logger, channel *metricChannel, ag
 		ticker:                  time.NewTicker(settings.Interval),
 		aggregatedMetricWriters: aggWriters,
 		rawMetricWriters:        rawWriters,
-		batch:                   make(map[string]*BatchedMetricDatum),
-		dataPointCount:          0,
+		aggregator:              NewAggregator(settings.StatisticSets),
+		cardinality:             newCardinalityLimiter(settings.Cardinality),
 		errorThrottles:          make(map[string]bool),
 	}, nil
 }
@@ -131,24 +128,30 @@ func (d *Daemon) Run(ctx context.Context) error {
 	defaults := funk.Values(metricDefaults)
 	metricDefaultsLock.RUnlock()
 
-	d.rawFanout(ctx, defaults)
+	d.rawFanout(ctx, d.guard(ctx, d.withDimensions(defaults)))
 
 	for {
 		select {
 		case <-ctx.Done():
 			d.ticker.Stop()
 			d.emptyChannel(ctx)
+			d.sampleGauges(context.WithoutCancel(ctx))
 			d.publish(ctx)
+			d.flush(context.WithoutCancel(ctx))
 
 			return nil
 
 		case <-d.channel.hasData:
-			data := d.channel.read()
+			written := d.read()
+			data := d.guard(ctx, d.withDimensions(written))
 			d.rawFanout(ctx, data)
 			d.appendBatch(ctx, data)
+			releaseData(written)
 
 		case <-d.ticker.C:
+			d.sampleGauges(ctx)
 			d.publish(ctx)
+			d.cardinality.reset()
 		}
 	}
 }
@@ -156,63 +159,111 @@ func (d *Daemon) Run(ctx context.Context) error {
 func (d *Daemon) emptyChannel(ctx context.Context) {
 	d.channel.close()
 
-	if data := d.channel.read(); len(data) > 0 {
+	written := d.read()
+
+	if data := d.guard(ctx, d.withDimensions(written)); len(data) > 0 {
 		d.rawFanout(ctx, data)
 		d.appendBatch(ctx, data)
 	}
+
+	releaseData(written)
 }
 
-func (d *Daemon) rawFanout(ctx context.Context, data Data) {
-	for _, w := range d.rawMetricWriters {
-		w.Write(ctx, data)
-	}
+// read returns the data written to the channel. The returned slice is handed back to the channel on the next read, so
+// it must not be retained; guard copies the data into a slice of its own. Pooled data is released by the caller once
+// it was written, see AcquireDatum.
+func (d *Daemon) read() Data {
+	d.buffer = d.channel.read(d.buffer)
+
+	return d.buffer
 }
 
-func (d *Daemon) appendBatch(ctx context.Context, data Data) {
-	for _, dat := range data {
-		d.append(ctx, dat)
+// flush flushes all writers holding back data after the remaining data was published on shutdown.
+func (d *Daemon) flush(ctx context.Context) {
+	for _, w := range slices.Concat(d.rawMetricWriters, d.aggregatedMetricWriters) {
+		flusher, ok := w.(Flusher)
+		if !ok {
+			continue
+		}
+
+		if err := flusher.Flush(ctx); err != nil {
+			d.logger.Error(ctx, "could not flush metric writer: %w", err)
+		}
 	}
 }
 
-func (d *Daemon) append(ctx context.Context, datum *Datum) {
-	d.dataPointCount++
-
-	dimKV := datum.DimensionKV()
-	timeKey := datum.Timestamp.Format(defaultTimeFormat)
+// withDimensions merges the configured dimensions into copies of the data. The data is amended from the metric defaults
+// first, as the defaults are registered without the configured dimensions.
+func (d *Daemon) withDimensions(data Data) Data {
+	if len(d.settings.Dimensions) == 0 {
+		return data
+	}
 
-	key := fmt.Sprintf("%s-%s-%s", datum.MetricName, dimKV, timeKey)
+	result := make(Data, len(data))
 
-	if _, ok := d.batch[key]; !ok {
+	for i, datum := range data {
 		amendFromDefault(datum)
 
-		if err := datum.IsValid(); err != nil {
+		cpy := *datum
+		cpy.Dimensions = funk.MergeMaps(Dimensions(d.settings.Dimensions), datum.Dimensions)
+		result[i] = &cpy
+	}
+
+	return result
+}
+
+// sampleGauges writes the current values of all registered gauges, see RegisterGauge.
+func (d *Daemon) sampleGauges(ctx context.Context) {
+	if data := d.guard(ctx, d.withDimensions(sampleGauges(ctx, time.Now()))); len(data) > 0 {
+		d.rawFanout(ctx, data)
+		d.appendBatch(ctx, data)
+	}
+}
+
+// guard drops data with invalid dimensions and limits the cardinality of the dimensions per metric.
+func (d *Daemon) guard(ctx context.Context, data Data) Data {
+	result := make(Data, 0, len(data))
+
+	for _, datum := range data {
+		if err := datum.validateDimensions(); err != nil {
 			if d.throttleError(err.Error()) {
 				d.logger.Error(ctx, "invalid metric: %s", err.Error())
 			}
 
-			return
+			continue
 		}
 
-		d.batch[key] = &BatchedMetricDatum{
-			Priority:   datum.Priority,
-			Timestamp:  datum.Timestamp,
-			MetricName: datum.MetricName,
-			Dimensions: datum.Dimensions,
-			Unit:       datum.Unit,
-			Values:     []float64{datum.Value},
-			Kind:       datum.Kind,
+		limited, overflow := d.cardinality.limit(datum)
+		if overflow && d.throttleError("cardinality overflow of metric "+datum.MetricName) {
+			d.logger.Warn(ctx, "metric %s exceeded %d distinct dimension sets, writing further dimension sets as %s", datum.MetricName, d.settings.Cardinality.MaxDimensionSets, DimensionOverflow)
 		}
 
-		return
+		result = append(result, limited)
 	}
 
-	existing := d.batch[key]
-	existing.Values = append(existing.Values, datum.Value)
+	return result
+}
+
+func (d *Daemon) rawFanout(ctx context.Context, data Data) {
+	for _, w := range d.rawMetricWriters {
+		w.Write(ctx, data)
+	}
+}
+
+func (d *Daemon) appendBatch(ctx context.Context, data Data) {
+	for _, dat := range data {
+		d.append(ctx, dat)
+	}
+}
+
+func (d *Daemon) append(ctx context.Context, datum *Datum) {
+	if err := d.aggregator.Add(datum); err != nil && d.throttleError(err.Error()) {
+		d.logger.Error(ctx, "invalid metric: %s", err.Error())
+	}
 }
 
 func (d *Daemon) resetBatch(ctx context.Context) {
-	d.batch = make(map[string]*BatchedMetricDatum)
-	d.dataPointCount = 0
+	d.aggregator.Reset()
 
 	metricDefaultsLock.RLock()
 	defs := make([]*Datum, 0, len(metricDefaults))
@@ -223,50 +274,29 @@ func (d *Daemon) resetBatch(ctx context.Context) {
 	}
 	metricDefaultsLock.RUnlock()
 
-	for _, cpy := range defs {
+	for _, cpy := range d.withDimensions(defs) {
 		d.append(ctx, cpy)
 	}
 }
 
 func (d *Daemon) publish(ctx context.Context) {
-	size := len(d.batch)
+	size := d.aggregator.Len()
 
 	if size == 0 {
 		return
 	}
 
-	data := d.buildMetricData()
+	dataPointCount := d.aggregator.DataPointCount()
+	data := d.aggregator.Data()
 
 	for _, w := range d.aggregatedMetricWriters {
 		w.Write(ctx, data)
 	}
 
-	d.logger.Info(ctx, "published %d data points in %d metrics", d.dataPointCount, size)
+	d.logger.Info(ctx, "published %d data points in %d metrics", dataPointCount, size)
 	d.resetBatch(ctx)
 }
 
-func (d *Daemon) buildMetricData() Data {
-	data := make([]*Datum, 0)
-
-	for _, v := range d.batch {
-		unit, value := resolveCustomUnit(v.Unit, v.Values)
-
-		datum := &Datum{
-			Priority:   v.Priority,
-			Timestamp:  v.Timestamp,
-			MetricName: v.MetricName,
-			Dimensions: v.Dimensions,
-			Unit:       unit,
-			Value:      value,
-			Kind:       v.Kind,
-		}
-
-		data = append(data, datum)
-	}
-
-	return data
-}
-
 // we don't want to log errors every time they occur - it is enough to log them once they occur, at least for a minute
 func (d *Daemon) throttleError(err string) bool {
 	d.errorThrottlesLck.Lock()
@@ -2,9 +2,17 @@ package metric
 
 import (
 	"fmt"
-	"sort"
+	"slices"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	maxDimensions           = 30
+	maxDimensionNameLength  = 255
+	maxDimensionValueLength = 1024
 )
 
 type Datum struct {
@@ -15,24 +23,105 @@ type Datum struct {
 	Value      float64      `json:"value"`
 	Unit       StandardUnit `json:"unit"`
 	Kind       Kind         `json:"-"`
+	// Distribution is set by the aggregation of the metric daemon. For histograms and summaries it holds all values
+	// and Value is their average, for other metrics written more than once per interval it only holds the statistics
+	// and Value is their sum. Writers not supporting distributions can thus always use Value.
+	Distribution *Distribution `json:"distribution,omitempty"`
+	// Exemplar links the datum to the trace it was written in. Writers not supporting exemplars ignore it.
+	Exemplar *Exemplar `json:"exemplar,omitempty"`
+	// pooled marks data acquired by AcquireDatum, which the metric daemon hands back to the pool after writing it.
+	pooled bool
+}
+
+// Distribution describes the values recorded for a metric during an interval. It allows writers to export statistic
+// sets, percentiles or buckets instead of a single reduced value. Values is empty if only the statistics were kept.
+type Distribution struct {
+	Count  float64   `json:"count"`
+	Sum    float64   `json:"sum"`
+	Min    float64   `json:"min"`
+	Max    float64   `json:"max"`
+	Values []float64 `json:"values"`
 }
 
+// NewDistribution computes the statistics of the given values.
+func NewDistribution(values []float64) *Distribution {
+	return &Distribution{
+		Count:  float64(len(values)),
+		Sum:    sum(values),
+		Min:    minimum(values),
+		Max:    maximum(values),
+		Values: values,
+	}
+}
+
+// NewStatistics computes the count, sum, minimum and maximum of the given values without keeping the values.
+func NewStatistics(values []float64) *Distribution {
+	distribution := NewDistribution(values)
+	distribution.Values = nil
+
+	return distribution
+}
+
+// Average returns the mean of all values of the distribution.
+func (d *Distribution) Average() float64 {
+	if d.Count == 0 {
+		return 0
+	}
+
+	return d.Sum / d.Count
+}
+
+// Observations returns all values recorded for the datum: the values of its distribution or else its single value.
+func (d *Datum) Observations() []float64 {
+	if d.Distribution != nil {
+		return d.Distribution.Values
+	}
+
+	return []float64{d.Value}
+}
+
+// Id identifies the metric and dimensions of the datum, e.g. to look up its defaults.
 func (d *Datum) Id() string {
-	return fmt.Sprintf("%s:%s", d.MetricName, d.DimensionKV())
+	return d.MetricName + ":" + d.DimensionKV()
 }
 
+// DimensionKV returns the dimensions as a string with all name:value pairs sorted by name. It is computed for every
+// datum written, so it avoids formatting and sorts the names on the stack for the usual handful of dimensions.
 func (d *Datum) DimensionKV() string {
-	dims := make([]string, 0)
+	switch len(d.Dimensions) {
+	case 0:
+		return ""
+	case 1:
+		for k, v := range d.Dimensions {
+			return k + ":" + v
+		}
+	}
+
+	var buf [8]string
+	names := buf[:0]
+	size := 0
 
 	for k, v := range d.Dimensions {
-		flat := fmt.Sprintf("%s:%s", k, v)
-		dims = append(dims, flat)
+		names = append(names, k)
+		size += len(k) + len(v) + 2
 	}
 
-	sort.Strings(dims)
-	dimKey := strings.Join(dims, "-")
+	slices.Sort(names)
+
+	builder := strings.Builder{}
+	builder.Grow(size)
 
-	return dimKey
+	for i, name := range names {
+		if i > 0 {
+			builder.WriteByte('-')
+		}
+
+		builder.WriteString(name)
+		builder.WriteByte(':')
+		builder.WriteString(d.Dimensions[name])
+	}
+
+	return builder.String()
 }
 
 func (d *Datum) IsValid() error {
@@ -48,6 +137,48 @@ func (d *Datum) IsValid() error {
 		return fmt.Errorf("metric %s has no unit", d.MetricName)
 	}
 
+	return d.validateDimensions()
+}
+
+// validateDimensions checks the dimensions against the limits of CloudWatch, which are the strictest of all writers.
+// Empty values are allowed, as they are used for optional labels with prometheus.
+func (d *Datum) validateDimensions() error {
+	if len(d.Dimensions) > maxDimensions {
+		return fmt.Errorf("metric %s has %d dimensions, but at most %d are allowed", d.MetricName, len(d.Dimensions), maxDimensions)
+	}
+
+	for name, value := range d.Dimensions {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("metric %s has a dimension without a name", d.MetricName)
+		}
+
+		if err := validateDimension("name", name, maxDimensionNameLength); err != nil {
+			return fmt.Errorf("metric %s has an invalid dimension %q: %w", d.MetricName, name, err)
+		}
+
+		if err := validateDimension("value", value, maxDimensionValueLength); err != nil {
+			return fmt.Errorf("metric %s has an invalid value for dimension %s: %w", d.MetricName, name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDimension(typ string, s string, maxLength int) error {
+	if len(s) > maxLength {
+		return fmt.Errorf("the %s is longer than %d characters", typ, maxLength)
+	}
+
+	if !utf8.ValidString(s) {
+		return fmt.Errorf("the %s is not valid utf-8", typ)
+	}
+
+	for _, r := range s {
+		if unicode.IsControl(r) {
+			return fmt.Errorf("the %s contains control characters", typ)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,32 @@
+package metric
+
+import "sync"
+
+var datumPool = sync.Pool{
+	New: func() any {
+		return &Datum{}
+	},
+}
+
+// AcquireDatum returns an empty datum from a pool. Use it on hot paths writing data very often, like once per message.
+// The datum is handed back to the pool by the metric daemon once it was written, so it must neither be used nor written
+// again after passing it to Writer.Write. Dimensions are never modified by the daemon and can be shared between data.
+func AcquireDatum() *Datum {
+	datum := datumPool.Get().(*Datum)
+	datum.pooled = true
+
+	return datum
+}
+
+// releaseData hands all data acquired by AcquireDatum back to the pool. Writers don't keep references to written data,
+// so the daemon releases the data read from the channel after fanning it out and aggregating it.
+func releaseData(data Data) {
+	for _, datum := range data {
+		if datum == nil || !datum.pooled {
+			continue
+		}
+
+		*datum = Datum{}
+		datumPool.Put(datum)
+	}
+}
@@ -0,0 +1,21 @@
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseData(t *testing.T) {
+	pooled := AcquireDatum()
+	pooled.MetricName = "pooled"
+	pooled.Dimensions = Dimensions{"Consumer": "foo"}
+	pooled.Value = 1
+
+	unpooled := &Datum{MetricName: "unpooled", Value: 1}
+
+	releaseData(Data{pooled, unpooled, nil})
+
+	assert.Equal(t, Datum{}, *pooled, "pooled data should be reset when released")
+	assert.Equal(t, Datum{MetricName: "unpooled", Value: 1}, *unpooled, "data not acquired from the pool should be left alone")
+}
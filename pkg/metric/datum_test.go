@@ -0,0 +1,43 @@
+package metric_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatum_DimensionKV(t *testing.T) {
+	tests := map[string]struct {
+		dimensions metric.Dimensions
+		expected   string
+	}{
+		"none": {
+			expected: "",
+		},
+		"single": {
+			dimensions: metric.Dimensions{"Consumer": "events"},
+			expected:   "Consumer:events",
+		},
+		"sorted by name": {
+			dimensions: metric.Dimensions{"Queue": "default", "Consumer": "events", "Result": ""},
+			expected:   "Consumer:events-Queue:default-Result:",
+		},
+		"more than fit on the stack": {
+			dimensions: metric.Dimensions{"i": "9", "h": "8", "g": "7", "f": "6", "e": "5", "d": "4", "c": "3", "b": "2", "a": "1"},
+			expected:   "a:1-b:2-c:3-d:4-e:5-f:6-g:7-h:8-i:9",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			datum := &metric.Datum{
+				MetricName: "metric",
+				Dimensions: test.dimensions,
+			}
+
+			assert.Equal(t, test.expected, datum.DimensionKV())
+			assert.Equal(t, "metric:"+test.expected, datum.Id())
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package metric
+
+import (
+	"context"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/tracing"
+)
+
+const (
+	ExemplarLabelTraceId = "trace_id"
+	ExemplarLabelSpanId  = "span_id"
+)
+
+type ExemplarSettings struct {
+	// Enabled attaches the trace of the context a datum is written in as exemplar to the datum. The Prometheus metric
+	// server only offers the OpenMetrics format, which is needed to expose exemplars, if enabled.
+	Enabled bool `cfg:"enabled" default:"false"`
+}
+
+// Exemplar links a datum to the trace it was written in, so dashboards can jump from a latency spike directly to a
+// representative trace. Value and Timestamp are the ones of the written datum, as aggregation changes both.
+type Exemplar struct {
+	TraceId   string    `json:"traceId"`
+	SpanId    string    `json:"spanId"`
+	Value     float64   `json:"value"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Labels returns the exemplar labels used by Prometheus.
+func (e *Exemplar) Labels() map[string]string {
+	labels := map[string]string{
+		ExemplarLabelTraceId: e.TraceId,
+	}
+
+	if e.SpanId != "" {
+		labels[ExemplarLabelSpanId] = e.SpanId
+	}
+
+	return labels
+}
+
+// exemplarTrace returns the sampled trace of the context. Traces which are not sampled are not recorded, so linking
+// to them would lead nowhere.
+func exemplarTrace(ctx context.Context) *tracing.Trace {
+	var trace *tracing.Trace
+
+	if span := tracing.GetSpanFromContext(ctx); span != nil {
+		trace = span.GetTrace()
+	}
+
+	if trace == nil {
+		trace = tracing.GetTraceFromContext(ctx)
+	}
+
+	if trace == nil || !trace.Sampled || trace.TraceId == "" {
+		return nil
+	}
+
+	return trace
+}
+
+// newExemplar creates an exemplar for the datum if it was written in a sampled trace and has no exemplar yet.
+func newExemplar(trace *tracing.Trace, datum *Datum) *Exemplar {
+	if trace == nil || datum.Exemplar != nil {
+		return datum.Exemplar
+	}
+
+	return &Exemplar{
+		TraceId:   trace.TraceId,
+		SpanId:    trace.Id,
+		Value:     datum.Value,
+		Timestamp: datum.Timestamp,
+	}
+}
@@ -0,0 +1,74 @@
+package metric
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter_Exemplar(t *testing.T) {
+	channel := newTestChannel(true)
+	channel.exemplars.Store(true)
+	fakeClock := clock.NewFakeClock()
+	w := NewWriterWithInterfaces(fakeClock, channel)
+
+	sampled := tracing.ContextWithTrace(t.Context(), &tracing.Trace{
+		TraceId: "1-5759e988-bd862e3fe1be46a994272793",
+		Id:      "53995c3f42cd8ad8",
+		Sampled: true,
+	})
+	notSampled := tracing.ContextWithTrace(t.Context(), &tracing.Trace{
+		TraceId: "1-5759e988-bd862e3fe1be46a994272794",
+		Id:      "53995c3f42cd8ad9",
+	})
+
+	existing := &Exemplar{TraceId: "existing"}
+
+	w.WriteOne(sampled, &Datum{MetricName: "sampled", Value: 3})
+	w.WriteOne(sampled, &Datum{MetricName: "existing", Value: 3, Exemplar: existing})
+	w.WriteOne(notSampled, &Datum{MetricName: "not-sampled", Value: 3})
+	w.WriteOne(t.Context(), &Datum{MetricName: "untraced", Value: 3})
+
+	data := channel.read(nil)
+	require.Len(t, data, 4)
+
+	assert.Equal(t, &Exemplar{
+		TraceId:   "1-5759e988-bd862e3fe1be46a994272793",
+		SpanId:    "53995c3f42cd8ad8",
+		Value:     3,
+		Timestamp: fakeClock.Now(),
+	}, data[0].Exemplar)
+	assert.Same(t, existing, data[1].Exemplar)
+	assert.Nil(t, data[2].Exemplar)
+	assert.Nil(t, data[3].Exemplar)
+
+	channel.exemplars.Store(false)
+	w.WriteOne(sampled, &Datum{MetricName: "disabled", Value: 3})
+
+	data = channel.read(data)
+	require.Len(t, data, 1)
+	assert.Nil(t, data[0].Exemplar)
+}
+
+func TestOtlpExemplars(t *testing.T) {
+	exemplars := otlpExemplars(&Exemplar{
+		TraceId: "1-5759e988-bd862e3fe1be46a994272793",
+		SpanId:  "53995c3f42cd8ad8",
+		Value:   3,
+	})
+	require.Len(t, exemplars, 1)
+	assert.Equal(t, []byte{0x57, 0x59, 0xe9, 0x88, 0xbd, 0x86, 0x2e, 0x3f, 0xe1, 0xbe, 0x46, 0xa9, 0x94, 0x27, 0x27, 0x93}, exemplars[0].TraceId)
+	assert.Equal(t, []byte{0x53, 0x99, 0x5c, 0x3f, 0x42, 0xcd, 0x8a, 0xd8}, exemplars[0].SpanId)
+	assert.Equal(t, 3.0, exemplars[0].GetAsDouble())
+
+	otel := otlpExemplars(&Exemplar{TraceId: "5759e988bd862e3fe1be46a994272793"})
+	require.Len(t, otel, 1)
+	assert.Len(t, otel[0].TraceId, 16)
+	assert.Nil(t, otel[0].SpanId)
+
+	assert.Nil(t, otlpExemplars(&Exemplar{TraceId: "goso:1234"}))
+	assert.Nil(t, otlpExemplars(nil))
+}
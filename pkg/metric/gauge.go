@@ -0,0 +1,68 @@
+package metric
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/funk"
+)
+
+// GaugeFunc returns the current value of a gauge, e.g. the size of a buffer or the utilization of a pool.
+type GaugeFunc func(ctx context.Context) float64
+
+type registeredGauge struct {
+	datum Datum
+	fn    GaugeFunc
+}
+
+var (
+	gaugesLock sync.RWMutex
+	gauges     = map[string]registeredGauge{}
+)
+
+// RegisterGauge registers a function which is sampled by the metric daemon on every flush. The datum describes the
+// metric written with the sampled value; its kind defaults to a gauge. Registering a gauge with the same metric name
+// and dimensions replaces the existing one. The returned function unregisters the gauge again, call it once the
+// measured component stops.
+func RegisterGauge(datum Datum, fn GaugeFunc) (unregister func()) {
+	if datum.Kind.kind == kindDefault {
+		datum.Kind = KindGauge.Build()
+	}
+
+	id := datum.Id()
+
+	gaugesLock.Lock()
+	defer gaugesLock.Unlock()
+
+	gauges[id] = registeredGauge{
+		datum: datum,
+		fn:    fn,
+	}
+
+	return func() {
+		gaugesLock.Lock()
+		defer gaugesLock.Unlock()
+
+		delete(gauges, id)
+	}
+}
+
+// sampleGauges calls all registered gauge functions and returns their values.
+func sampleGauges(ctx context.Context, now time.Time) Data {
+	gaugesLock.RLock()
+	registered := funk.Values(gauges)
+	gaugesLock.RUnlock()
+
+	data := make(Data, 0, len(registered))
+
+	for _, gauge := range registered {
+		datum := gauge.datum
+		datum.Timestamp = now
+		datum.Value = gauge.fn(ctx)
+
+		data = append(data, &datum)
+	}
+
+	return data
+}
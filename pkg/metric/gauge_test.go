@@ -0,0 +1,47 @@
+package metric
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRegisterGauge verifies that registered gauges are sampled with the kind defaulting to a gauge and are not sampled
+// anymore after unregistering them.
+func TestRegisterGauge(t *testing.T) {
+	now := time.Unix(1549283566, 0)
+	size := 3.0
+
+	unregister := RegisterGauge(Datum{
+		Priority:   PriorityHigh,
+		MetricName: "test-gauge-buffer-size",
+		Unit:       UnitCount,
+	}, func(ctx context.Context) float64 {
+		return size
+	})
+
+	sample := func() *Datum {
+		for _, datum := range sampleGauges(t.Context(), now) {
+			if datum.MetricName == "test-gauge-buffer-size" {
+				return datum
+			}
+		}
+
+		return nil
+	}
+
+	datum := sample()
+	require.NotNil(t, datum)
+	assert.Equal(t, 3.0, datum.Value)
+	assert.Equal(t, now, datum.Timestamp)
+	assert.Equal(t, kindGauge, effectiveKind(datum))
+
+	size = 5
+	assert.Equal(t, 5.0, sample().Value)
+
+	unregister()
+	assert.Nil(t, sample())
+}
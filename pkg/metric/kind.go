@@ -217,3 +217,27 @@ func (k SummaryKindBuilder) WithBufCap(bufCap uint32) SummaryKindBuilder {
 
 	return k
 }
+
+// IsDistribution reports whether the kind describes a distribution of values, i.e. a histogram or a summary. The values
+// of such metrics are not reduced to a single value when aggregated, but kept as a Distribution.
+func (k Kind) IsDistribution() bool {
+	return k.kind == kindHistogram || k.kind == kindSummary
+}
+
+// effectiveKind returns the kind of the datum or, if none was set, decides based on the unit: UnitCount becomes a
+// counter, time units a summary and everything else a gauge.
+func effectiveKind(datum *Datum) kind {
+	switch datum.Kind.kind {
+	case kindCounter, kindGauge, kindHistogram, kindSummary:
+		return datum.Kind.kind
+	}
+
+	switch datum.Unit {
+	case UnitCount:
+		return kindCounter
+	case UnitMilliseconds, UnitSeconds:
+		return kindSummary
+	default:
+		return kindGauge
+	}
+}
@@ -0,0 +1,34 @@
+package metric
+
+import (
+	"context"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const MetricNameLogEntriesDropped = "LogEntriesDropped"
+
+func init() {
+	log.SetAsyncDropCounterFactory(asyncDropCounterFactory)
+}
+
+func asyncDropCounterFactory(_ cfg.Config, handlerName string) (log.AsyncDropCounter, error) {
+	return NewAsyncDropCounterWithInterfaces(NewWriter(), handlerName), nil
+}
+
+// NewAsyncDropCounterWithInterfaces writes the log entries dropped by an async log handler as LogEntriesDropped metric
+// with the name of the handler as dimension.
+func NewAsyncDropCounterWithInterfaces(writer Writer, handlerName string) log.AsyncDropCounter {
+	return func(dropped int64) {
+		writer.WriteOne(context.Background(), &Datum{
+			Priority:   PriorityHigh,
+			MetricName: MetricNameLogEntriesDropped,
+			Dimensions: Dimensions{
+				"Handler": handlerName,
+			},
+			Unit:  UnitCount,
+			Value: float64(dropped),
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package metric_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAsyncDropCounter(t *testing.T) {
+	writer := metricMocks.NewWriter(t)
+	writer.EXPECT().WriteOne(mock.Anything, &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: metric.MetricNameLogEntriesDropped,
+		Dimensions: metric.Dimensions{
+			"Handler": "main",
+		},
+		Unit:  metric.UnitCount,
+		Value: 7,
+	}).Once()
+
+	counter := metric.NewAsyncDropCounterWithInterfaces(writer, "main")
+	counter(7)
+}
@@ -0,0 +1,288 @@
+package metric
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	MetricNameErrorCount         = "ErrorCount"
+	errorAggregationStackDepth   = 8
+	errorAggregationLogPkgPrefix = "github.com/justtrackio/gosoline/pkg/log."
+)
+
+func init() {
+	log.AddHandlerWrapperFactory(errorAggregationHandlerWrapper)
+}
+
+// ErrorAggregationSettings configures the aggregation of identical errors for a log handler.
+// It is read from log.handlers.<name>.error_aggregation.
+type ErrorAggregationSettings struct {
+	Enabled bool `cfg:"enabled" default:"false"`
+	// Interval is the window in which identical errors are aggregated.
+	Interval time.Duration `cfg:"interval" default:"1m"`
+	// Samples is the amount of identical errors passed on to the handler per interval.
+	Samples int `cfg:"samples" default:"1"`
+}
+
+type errorAggregationEntry struct {
+	fingerprint string
+	errorType   string
+	windowStart time.Time
+	count       int
+	level       int
+	msg         string
+	data        log.Data
+}
+
+// ErrorAggregationHandler wraps a log handler and groups identical errors by their fingerprint, which is computed from
+// the channel, the type of the error and the stack of the logging call site. Per interval, only the first samples of
+// each group are passed on to the handler. At the end of the interval the amount of errors per fingerprint is written
+// as a metric with the channel and the error type as dimensions and, if errors were held back, a summary is logged.
+// The fingerprint itself is not used as dimension to keep the cardinality of the metric bounded, instead errors passed
+// on and the summary carry it in the error_fingerprint field.
+type ErrorAggregationHandler struct {
+	log.Handler
+	lck      sync.Mutex
+	clock    clock.Clock
+	writer   Writer
+	settings ErrorAggregationSettings
+	entries  map[string]*errorAggregationEntry
+}
+
+func errorAggregationHandlerWrapper(config cfg.Config, name string, handler log.Handler) (log.Handler, error) {
+	settings := &ErrorAggregationSettings{}
+	key := fmt.Sprintf("log.handlers.%s.error_aggregation", name)
+
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal error aggregation settings for key %q: %w", key, err)
+	}
+
+	if !settings.Enabled {
+		return handler, nil
+	}
+
+	return NewErrorAggregationHandlerWithInterfaces(clock.Provider, NewWriter(), handler, *settings), nil
+}
+
+// NewErrorAggregationHandlerWithInterfaces creates a new error aggregation handler wrapping the given handler.
+func NewErrorAggregationHandlerWithInterfaces(clock clock.Clock, writer Writer, handler log.Handler, settings ErrorAggregationSettings) *ErrorAggregationHandler {
+	settings.Samples = max(settings.Samples, 1)
+
+	if settings.Interval <= 0 {
+		settings.Interval = time.Minute
+	}
+
+	aggregation := &ErrorAggregationHandler{
+		Handler:  handler,
+		clock:    clock,
+		writer:   writer,
+		settings: settings,
+		entries:  make(map[string]*errorAggregationEntry),
+	}
+
+	go aggregation.run()
+
+	return aggregation
+}
+
+// AllowsLevelOverrides forwards the setting of the wrapped handler, see log.LevelOverridable.
+func (h *ErrorAggregationHandler) AllowsLevelOverrides() bool {
+	return log.AllowsLevelOverrides(h.Handler)
+}
+
+// Log passes log entries without an error on to the wrapped handler. Errors are counted per fingerprint and only the
+// configured amount of samples per interval is passed on.
+func (h *ErrorAggregationHandler) Log(ctx context.Context, timestamp time.Time, level int, msg string, args []any, err error, data log.Data) error {
+	if err == nil || level < log.PriorityError {
+		return h.Handler.Log(ctx, timestamp, level, msg, args, err, data)
+	}
+
+	fingerprint := errorFingerprint(data.Channel, err)
+
+	h.lck.Lock()
+	entry, ok := h.entries[fingerprint]
+
+	if !ok {
+		entry = &errorAggregationEntry{
+			fingerprint: fingerprint,
+			errorType:   fmt.Sprintf("%T", err),
+			windowStart: timestamp,
+		}
+		h.entries[fingerprint] = entry
+	}
+
+	var elapsed *errorAggregationEntry
+	if timestamp.Sub(entry.windowStart) >= h.settings.Interval {
+		elapsed = h.resetEntry(entry, timestamp)
+	}
+
+	entry.count++
+	entry.level = level
+	entry.msg = fmt.Sprintf(msg, args...)
+	entry.data = data
+	forward := entry.count <= h.settings.Samples
+	h.lck.Unlock()
+
+	if elapsed != nil {
+		if summaryErr := h.report(ctx, timestamp, elapsed); summaryErr != nil {
+			return summaryErr
+		}
+	}
+
+	if !forward {
+		return nil
+	}
+
+	data.Fields = funk.MergeMaps(data.Fields, map[string]any{
+		"error_fingerprint": fingerprint,
+	})
+
+	return h.Handler.Log(ctx, timestamp, level, msg, args, err, data)
+}
+
+// Flush reports the counts of all aggregated errors and flushes the wrapped handler if it buffers records.
+func (h *ErrorAggregationHandler) Flush() error {
+	now := h.clock.Now()
+	elapsed := make([]*errorAggregationEntry, 0)
+
+	h.lck.Lock()
+	for _, entry := range h.entries {
+		if report := h.resetEntry(entry, now); report != nil {
+			elapsed = append(elapsed, report)
+		}
+	}
+	h.lck.Unlock()
+
+	for _, report := range elapsed {
+		if err := h.report(context.Background(), now, report); err != nil {
+			return err
+		}
+	}
+
+	if flusher, ok := h.Handler.(log.Flusher); ok {
+		return flusher.Flush()
+	}
+
+	return nil
+}
+
+func (h *ErrorAggregationHandler) run() {
+	ticker := h.clock.NewTicker(h.settings.Interval)
+	defer ticker.Stop()
+
+	for range ticker.Chan() {
+		h.expire()
+	}
+}
+
+// expire reports all errors whose interval elapsed and forgets about errors not seen anymore.
+func (h *ErrorAggregationHandler) expire() {
+	now := h.clock.Now()
+	elapsed := make([]*errorAggregationEntry, 0)
+
+	h.lck.Lock()
+	for fingerprint, entry := range h.entries {
+		if now.Sub(entry.windowStart) < h.settings.Interval {
+			continue
+		}
+
+		if report := h.resetEntry(entry, now); report != nil {
+			elapsed = append(elapsed, report)
+
+			continue
+		}
+
+		delete(h.entries, fingerprint)
+	}
+	h.lck.Unlock()
+
+	for _, report := range elapsed {
+		if err := h.report(context.Background(), now, report); err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to write to log, %s\n", err)
+		}
+	}
+}
+
+// resetEntry starts a new window for the entry and returns a copy of the old state if errors were counted.
+func (h *ErrorAggregationHandler) resetEntry(entry *errorAggregationEntry, now time.Time) *errorAggregationEntry {
+	var report *errorAggregationEntry
+
+	if entry.count > 0 {
+		cpy := *entry
+		report = &cpy
+	}
+
+	entry.windowStart = now
+	entry.count = 0
+
+	return report
+}
+
+func (h *ErrorAggregationHandler) report(ctx context.Context, timestamp time.Time, report *errorAggregationEntry) error {
+	h.writer.WriteOne(ctx, &Datum{
+		Priority:   PriorityHigh,
+		Timestamp:  timestamp,
+		MetricName: MetricNameErrorCount,
+		Dimensions: Dimensions{
+			"Channel":   report.data.Channel,
+			"ErrorType": report.errorType,
+		},
+		Unit:  UnitCount,
+		Value: float64(report.count),
+	})
+
+	held := report.count - h.settings.Samples
+	if held <= 0 {
+		return nil
+	}
+
+	data := log.Data{
+		Channel:       report.data.Channel,
+		ContextFields: report.data.ContextFields,
+		Fields: funk.MergeMaps(report.data.Fields, map[string]any{
+			"error_fingerprint": report.fingerprint,
+			"error_count":       report.count,
+		}),
+	}
+
+	return h.Handler.Log(ctx, timestamp, report.level, "aggregated %d additional occurrences of error: %s", []any{held, report.msg}, nil, data)
+}
+
+// errorFingerprint hashes the channel, the type of the error and the call stack of the logging call site, skipping the
+// frames of the logger itself.
+func errorFingerprint(channel string, err error) string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	hash := fnv.New64a()
+	_, _ = fmt.Fprintf(hash, "%s|%T", channel, err)
+
+	for depth := 0; depth < errorAggregationStackDepth; {
+		frame, more := frames.Next()
+
+		if !strings.HasPrefix(frame.Function, errorAggregationLogPkgPrefix) {
+			_, _ = hash.Write([]byte("|" + frame.Function + ":" + strconv.Itoa(frame.Line)))
+			depth++
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return fmt.Sprintf("%016x", hash.Sum64())
+}
@@ -0,0 +1,80 @@
+package metric_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorAggregationHandler(t *testing.T) {
+	buf := &bytes.Buffer{}
+	inner := log.NewHandlerIoWriter(cfg.New(), log.PriorityInfo, log.FormatterSimple, "main", time.RFC3339, buf)
+	writer := metricMocks.NewWriter(t)
+	fakeClock := clock.NewFakeClock()
+
+	handler := metric.NewErrorAggregationHandlerWithInterfaces(fakeClock, writer, inner, metric.ErrorAggregationSettings{
+		Interval: time.Minute,
+		Samples:  2,
+	})
+	logger := log.NewLoggerWithInterfaces(fakeClock, []log.Handler{handler})
+	ctx := t.Context()
+
+	for i := 0; i < 5; i++ {
+		logger.Error(ctx, "retry %d failed: %w", i, fmt.Errorf("timeout"))
+	}
+	logger.Error(ctx, "other call site: %w", fmt.Errorf("timeout"))
+	logger.Info(ctx, "info is passed on")
+
+	counts := map[float64]int{}
+	writer.EXPECT().WriteOne(mock.Anything, mock.AnythingOfType("*metric.Datum")).Run(func(_ context.Context, datum *metric.Datum) {
+		assert.Equal(t, metric.MetricNameErrorCount, datum.MetricName)
+		assert.Equal(t, metric.Dimensions{
+			"Channel":   "main",
+			"ErrorType": "*fmt.wrapError",
+		}, datum.Dimensions)
+
+		counts[datum.Value]++
+	}).Twice()
+
+	require.NoError(t, handler.Flush())
+	assert.Equal(t, map[float64]int{5: 1, 1: 1}, counts)
+
+	output := buf.String()
+	assert.Contains(t, output, "retry 0 failed: timeout")
+	assert.Contains(t, output, "retry 1 failed: timeout")
+	assert.NotContains(t, output, "retry 2 failed: timeout\n")
+	assert.Contains(t, output, "other call site: timeout")
+	assert.Contains(t, output, "info is passed on")
+	assert.Contains(t, output, "aggregated 3 additional occurrences of error: retry 4 failed: timeout")
+	assert.Regexp(t, `aggregated 3 additional occurrences of error: retry 4 failed: timeout.*error_fingerprint: [0-9a-f]{16}`, output)
+}
+
+func TestErrorAggregationHandlerFromConfig(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"log": map[string]any{
+			"handlers": map[string]any{
+				"main": map[string]any{
+					"type": "iowriter",
+					"error_aggregation": map[string]any{
+						"enabled": true,
+					},
+				},
+			},
+		},
+	})
+
+	handlers, err := log.NewHandlersFromConfig(config)
+	require.NoError(t, err)
+	assert.IsType(t, &metric.ErrorAggregationHandler{}, handlers[0])
+}
@@ -80,31 +80,46 @@ func NewPrometheusMetricsServerModule(ctx context.Context, config cfg.Config, lo
 		return nil, nil
 	}
 
-	return NewPrometheusMetricServer(ctx, logger, promSettings)
+	return NewPrometheusMetricServer(ctx, logger, promSettings, settings.Exemplars)
 }
 
-func NewPrometheusMetricServer(ctx context.Context, logger log.Logger, settings *PrometheusSettings) (kernel.Module, error) {
+func NewPrometheusMetricServer(ctx context.Context, logger log.Logger, settings *PrometheusSettings, exemplars ExemplarSettings) (kernel.Module, error) {
 	registry, err := ProvideRegistry(ctx, prometheusDefaultRegistry)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewMetricServerWithInterfaces(ctx, logger, registry, settings)
+	return NewMetricServerWithInterfaces(ctx, logger, registry, settings, exemplars)
 }
 
-func NewMetricServerWithInterfaces(ctx context.Context, logger log.Logger, registry *prometheus.Registry, settings *PrometheusSettings) (kernel.Module, error) {
+func NewMetricServerWithInterfaces(ctx context.Context, logger log.Logger, registry *prometheus.Registry, settings *PrometheusSettings, exemplars ExemplarSettings) (kernel.Module, error) {
+	handler := promhttp.InstrumentMetricHandler(
+		registry, promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+			// exemplars are only exposed in the OpenMetrics format, which scrapers negotiating it get instead of the
+			// text format. Hence, we only offer it if there are exemplars to expose.
+			EnableOpenMetrics: exemplars.Enabled,
+		}),
+	)
+
+	server, err := newMetricsServer(ctx, logger, settings.Api.Port, settings.Api.Timeout, settings.Api.Path, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return server, nil
+}
+
+func newMetricsServer(ctx context.Context, logger log.Logger, port int, timeout TimeoutSettings, path string, pathHandler http.Handler) (*metricsServer, error) {
 	handler := http.NewServeMux()
 	server := &http.Server{
-		Addr:         fmt.Sprintf(":%d", settings.Api.Port),
-		ReadTimeout:  settings.Api.Timeout.Read,
-		WriteTimeout: settings.Api.Timeout.Write,
-		IdleTimeout:  settings.Api.Timeout.Idle,
+		Addr:         fmt.Sprintf(":%d", port),
+		ReadTimeout:  timeout.Read,
+		WriteTimeout: timeout.Write,
+		IdleTimeout:  timeout.Idle,
 		Handler:      handler,
 	}
 
-	handler.Handle(settings.Api.Path, promhttp.InstrumentMetricHandler(
-		registry, promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
-	))
+	handler.Handle(path, pathHandler)
 
 	var err error
 	var listener net.Listener
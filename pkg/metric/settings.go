@@ -11,6 +11,14 @@ type Settings struct {
 	Enabled  bool          `cfg:"enabled" default:"false"`
 	Interval time.Duration `cfg:"interval" default:"60s"`
 	Writers  []string      `cfg:"writers"`
+	// Dimensions are merged into every datum. The values can contain app identity placeholders like {app.env}.
+	Dimensions  map[string]string   `cfg:"dimensions"`
+	Cardinality CardinalitySettings `cfg:"cardinality"`
+	Exemplars   ExemplarSettings    `cfg:"exemplars"`
+	// StatisticSets makes aggregating writers send gauges, timers and other metrics which aren't counters as statistic
+	// sets (count, sum, minimum and maximum) instead of their sum. This changes the meaning of the Average, Minimum and
+	// Maximum statistics of existing metrics, so it is off by default.
+	StatisticSets bool `cfg:"statistic_sets" default:"false"`
 }
 
 func GetMetricSettings(config cfg.Config) (*Settings, error) {
@@ -19,6 +27,21 @@ func GetMetricSettings(config cfg.Config) (*Settings, error) {
 		return nil, fmt.Errorf("failed to unmarshal metric settings: %w", err)
 	}
 
+	if len(settings.Dimensions) == 0 {
+		return settings, nil
+	}
+
+	identity, err := cfg.GetAppIdentity(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app identity from config: %w", err)
+	}
+
+	for name, pattern := range settings.Dimensions {
+		if settings.Dimensions[name], err = identity.Format(pattern, "-"); err != nil {
+			return nil, fmt.Errorf("failed to format metric dimension %s: %w", name, err)
+		}
+	}
+
 	return settings, nil
 }
 
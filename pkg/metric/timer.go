@@ -0,0 +1,82 @@
+package metric
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/funk"
+)
+
+const (
+	DimensionResult = "Result"
+	ResultSuccess   = "success"
+	ResultFailure   = "failure"
+)
+
+// Timer measures the duration of an operation and writes it as a metric in milliseconds once stopped.
+type Timer struct {
+	clock      clock.Clock
+	writer     Writer
+	name       string
+	dimensions Dimensions
+	start      time.Time
+	stopped    atomic.Bool
+}
+
+// StartTimer starts measuring the duration of an operation written as metric with the given name and the merged
+// dimensions when stopping the timer:
+//
+//	timer := metric.StartTimer("ProcessDuration", metric.Dimensions{"Queue": queue})
+//	err := process(ctx, msg)
+//	timer.StopWithResult(ctx, err)
+func StartTimer(name string, dimensions ...Dimensions) *Timer {
+	return StartTimerWithInterfaces(clock.Provider, NewWriter(), name, dimensions...)
+}
+
+func StartTimerWithInterfaces(clock clock.Clock, writer Writer, name string, dimensions ...Dimensions) *Timer {
+	return &Timer{
+		clock:      clock,
+		writer:     writer,
+		name:       name,
+		dimensions: funk.MergeMaps(dimensions...),
+		start:      clock.Now(),
+	}
+}
+
+// Stop writes the duration since the timer was started and returns it. Only the first call writes a metric.
+func (t *Timer) Stop(ctx context.Context) time.Duration {
+	return t.stop(ctx, t.dimensions)
+}
+
+// StopWithResult works like Stop, but additionally adds the Result dimension, which is failure if err is not nil and
+// success otherwise.
+func (t *Timer) StopWithResult(ctx context.Context, err error) time.Duration {
+	result := ResultSuccess
+	if err != nil {
+		result = ResultFailure
+	}
+
+	return t.stop(ctx, funk.MergeMaps(t.dimensions, Dimensions{DimensionResult: result}))
+}
+
+func (t *Timer) stop(ctx context.Context, dimensions Dimensions) time.Duration {
+	now := t.clock.Now()
+	duration := now.Sub(t.start)
+
+	if !t.stopped.CompareAndSwap(false, true) {
+		return duration
+	}
+
+	t.writer.WriteOne(ctx, &Datum{
+		Priority:   PriorityHigh,
+		Timestamp:  now,
+		MetricName: t.name,
+		Dimensions: dimensions,
+		Value:      float64(duration) / float64(time.Millisecond),
+		Unit:       UnitMilliseconds,
+	})
+
+	return duration
+}
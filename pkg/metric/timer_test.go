@@ -0,0 +1,55 @@
+package metric_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimer(t *testing.T) {
+	fakeClock := clock.NewFakeClock()
+	start := fakeClock.Now()
+	writer := metricMocks.NewWriter(t)
+
+	timer := metric.StartTimerWithInterfaces(fakeClock, writer, "ProcessDuration", metric.Dimensions{"Queue": "events"}, metric.Dimensions{"Module": "consumer"})
+	fakeClock.Advance(1500 * time.Microsecond)
+
+	writer.EXPECT().WriteOne(t.Context(), &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		Timestamp:  start.Add(1500 * time.Microsecond),
+		MetricName: "ProcessDuration",
+		Dimensions: metric.Dimensions{"Queue": "events", "Module": "consumer", metric.DimensionResult: metric.ResultFailure},
+		Value:      1.5,
+		Unit:       metric.UnitMilliseconds,
+	}).Once()
+
+	assert.Equal(t, 1500*time.Microsecond, timer.StopWithResult(t.Context(), fmt.Errorf("failed")))
+
+	fakeClock.Advance(time.Millisecond)
+	assert.Equal(t, 2500*time.Microsecond, timer.Stop(t.Context()), "stopping again only returns the duration")
+}
+
+func TestTimer_Stop(t *testing.T) {
+	fakeClock := clock.NewFakeClock()
+	start := fakeClock.Now()
+	writer := metricMocks.NewWriter(t)
+
+	timer := metric.StartTimerWithInterfaces(fakeClock, writer, "RequestDuration")
+	fakeClock.Advance(time.Second)
+
+	writer.EXPECT().WriteOne(t.Context(), &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		Timestamp:  start.Add(time.Second),
+		MetricName: "RequestDuration",
+		Dimensions: metric.Dimensions{},
+		Value:      1000,
+		Unit:       metric.UnitMilliseconds,
+	}).Once()
+
+	timer.Stop(t.Context())
+}
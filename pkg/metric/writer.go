@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/tracing"
 )
 
 const (
@@ -15,24 +17,53 @@ const (
 
 //go:generate go run github.com/vektra/mockery/v2 --name Writer
 type (
+	// Writer writes data to a metric backend. Writers must not keep references to the written data after Write
+	// returned, as the metric daemon hands data acquired by AcquireDatum back to the pool afterward.
 	Writer interface {
 		GetPriority() int
 		Write(ctx context.Context, batch Data)
 		WriteOne(ctx context.Context, data *Datum)
 	}
 
+	// Flusher is implemented by writers holding back data, like the pushgateway writer. The metric daemon flushes them
+	// after publishing the remaining data on shutdown.
+	Flusher interface {
+		Flush(ctx context.Context) error
+	}
+
 	writer struct {
-		clock   clock.Clock
-		channel *metricChannel
+		clock      clock.Clock
+		channel    *metricChannel
+		dimensions Dimensions
 	}
 )
 
 func NewWriter(defaults ...*Datum) Writer {
+	return NewWriterWithDimensions(nil, defaults...)
+}
+
+// NewWriterWithDimensions creates a writer merging the given dimensions into every datum it writes, e.g. the name of
+// the module writing the metrics. Dimensions set on a datum take precedence. The dimensions are merged into the
+// defaults as well, so the defaults match the data written by the writer.
+func NewWriterWithDimensions(dimensions Dimensions, defaults ...*Datum) Writer {
 	channel := providerMetricChannel(func(*metricChannel) {})
 
+	if len(dimensions) > 0 {
+		defaults = funk.Map(defaults, func(datum *Datum) *Datum {
+			cpy := *datum
+			cpy.Dimensions = funk.MergeMaps(dimensions, datum.Dimensions)
+
+			return &cpy
+		})
+	}
+
 	addMetricDefaults(defaults...)
 
-	return NewWriterWithInterfaces(clock.Provider, channel)
+	return &writer{
+		clock:      clock.Provider,
+		channel:    channel,
+		dimensions: dimensions,
+	}
 }
 
 func NewWriterWithInterfaces(clock clock.Clock, channel *metricChannel) Writer {
@@ -46,15 +77,28 @@ func (w writer) GetPriority() int {
 	return PriorityLow
 }
 
-func (w writer) Write(_ context.Context, batch Data) {
-	if !w.channel.enabled || len(batch) == 0 {
+// Write pushes the batch into the metric channel. If the context belongs to a sampled trace, the trace is attached as
+// exemplar to all data without an exemplar.
+func (w writer) Write(ctx context.Context, batch Data) {
+	if !w.channel.enabled.Load() || len(batch) == 0 {
 		return
 	}
 
+	var trace *tracing.Trace
+	if w.channel.exemplars.Load() {
+		trace = exemplarTrace(ctx)
+	}
+
 	for i := 0; i < len(batch); i++ {
 		if batch[i].Timestamp.IsZero() {
 			batch[i].Timestamp = w.clock.Now()
 		}
+
+		if len(w.dimensions) > 0 {
+			batch[i].Dimensions = funk.MergeMaps(w.dimensions, batch[i].Dimensions)
+		}
+
+		batch[i].Exemplar = newExemplar(trace, batch[i])
 	}
 
 	w.channel.write(batch)
@@ -3,6 +3,7 @@ package metric
 import (
 	"context"
 	"fmt"
+	"path"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -30,15 +31,26 @@ const (
 	UnitMilliseconds = types.StandardUnitMilliseconds
 
 	chunkSizeCloudWatch = 20
+	maxValuesCloudWatch = 150
 	minusOneWeek        = -1 * 7 * 24 * time.Hour
 	plusOneHour         = 1 * time.Hour
 )
 
 type (
 	CloudWatchSettings struct {
-		Naming         CloudwatchNamingSettings `cfg:"naming"`
-		Aggregate      bool                     `cfg:"aggregate" default:"true"`
-		WriteGraceTime time.Duration            `cfg:"write_grace_time" default:"10s"`
+		Naming         CloudwatchNamingSettings         `cfg:"naming"`
+		Aggregate      bool                             `cfg:"aggregate" default:"true"`
+		WriteGraceTime time.Duration                    `cfg:"write_grace_time" default:"10s"`
+		HighResolution CloudwatchHighResolutionSettings `cfg:"high_resolution"`
+	}
+
+	// CloudwatchHighResolutionSettings selects the metrics stored with a resolution of one second instead of one minute.
+	// As the daemon aggregates data per minute, high resolution metrics are only useful with aggregate disabled.
+	CloudwatchHighResolutionSettings struct {
+		// Priority selects all data with at least this priority, 0 selects none.
+		Priority int `cfg:"priority" default:"0"`
+		// Metrics are patterns of metric names as understood by path.Match, e.g. "StreamMessage*".
+		Metrics []string `cfg:"metrics"`
 	}
 
 	CloudwatchNamingSettings struct {
@@ -47,8 +59,9 @@ type (
 	}
 
 	cwWriterCtxKey string
-	Dimensions     map[string]string
-	StandardUnit   = types.StandardUnit
+	// Dimensions of a datum are never modified once written, so the same map can be shared by many data.
+	Dimensions   map[string]string
+	StandardUnit = types.StandardUnit
 
 	cloudwatchWriter struct {
 		logger         log.Logger
@@ -56,6 +69,7 @@ type (
 		client         gosoCloudwatch.Client
 		cwNamespace    string
 		writeGraceTime time.Duration
+		highResolution CloudwatchHighResolutionSettings
 	}
 )
 
@@ -89,7 +103,13 @@ func NewCloudwatchWriter(ctx context.Context, config cfg.Config, logger log.Logg
 		return nil, fmt.Errorf("can not create cloudwatch client: %w", err)
 	}
 
-	return NewCloudwatchWriterWithInterfaces(logger, testClock, client, cwNamespace, cwSettings.WriteGraceTime), nil
+	for _, pattern := range cwSettings.HighResolution.Metrics {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid high resolution metric pattern %q: %w", pattern, err)
+		}
+	}
+
+	return NewCloudwatchWriterWithInterfaces(logger, testClock, client, cwNamespace, cwSettings.WriteGraceTime, cwSettings.HighResolution), nil
 }
 
 func NewCloudwatchWriterWithInterfaces(
@@ -98,6 +118,7 @@ func NewCloudwatchWriterWithInterfaces(
 	cw gosoCloudwatch.Client,
 	cwNamespace string,
 	writeGraceTime time.Duration,
+	highResolution CloudwatchHighResolutionSettings,
 ) Writer {
 	return &cloudwatchWriter{
 		logger:         logger.WithChannel("metrics"),
@@ -105,6 +126,7 @@ func NewCloudwatchWriterWithInterfaces(
 		client:         cw,
 		cwNamespace:    cwNamespace,
 		writeGraceTime: writeGraceTime,
+		highResolution: highResolution,
 	}
 }
 
@@ -210,12 +232,69 @@ func (w *cloudwatchWriter) buildMetricData(ctx context.Context, batch Data) ([]t
 			Unit:       data.Unit,
 		}
 
+		if data.Distribution != nil && data.Distribution.Count > 0 {
+			cloudwatchDistribution(&datum, data.Distribution)
+		}
+
+		if w.isHighResolution(data) {
+			datum.StorageResolution = aws.Int32(1)
+		}
+
 		metricData = append(metricData, datum)
 	}
 
 	return metricData, nil
 }
 
+func (w *cloudwatchWriter) isHighResolution(datum *Datum) bool {
+	if w.highResolution.Priority > 0 && datum.Priority >= w.highResolution.Priority {
+		return true
+	}
+
+	for _, pattern := range w.highResolution.Metrics {
+		if ok, _ := path.Match(pattern, datum.MetricName); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cloudwatchDistribution writes the values of a distribution with their counts, which allows CloudWatch to compute
+// percentiles. If the distribution only holds statistics or there are more distinct values than CloudWatch accepts for a
+// single datum, a statistic set is written.
+func cloudwatchDistribution(datum *types.MetricDatum, distribution *Distribution) {
+	datum.Value = nil
+	counts := make(map[float64]float64)
+	values := make([]float64, 0)
+
+	for _, value := range distribution.Values {
+		if _, ok := counts[value]; !ok {
+			values = append(values, value)
+		}
+
+		counts[value]++
+	}
+
+	if len(values) == 0 || len(values) > maxValuesCloudWatch {
+		datum.StatisticValues = &types.StatisticSet{
+			SampleCount: aws.Float64(distribution.Count),
+			Sum:         aws.Float64(distribution.Sum),
+			Minimum:     aws.Float64(distribution.Min),
+			Maximum:     aws.Float64(distribution.Max),
+		}
+
+		return
+	}
+
+	datum.Values = values
+	datum.Counts = make([]float64, len(values))
+
+	for i, value := range values {
+		datum.Counts[i] = counts[value]
+	}
+}
+
 func GetCloudWatchNamespace(config cfg.Config) (string, error) {
 	var err error
 	var identity cfg.Identity
@@ -67,6 +67,7 @@ func buildMocksAndWrite(t *testing.T, ctx context.Context, now time.Time, metric
 		cwClient,
 		"my/test/namespace/grp/app",
 		10*time.Second,
+		metric.CloudwatchHighResolutionSettings{},
 	)
 
 	data := metric.Data{
@@ -84,3 +85,136 @@ func buildMocksAndWrite(t *testing.T, ctx context.Context, now time.Time, metric
 
 	mo.Write(ctx, data)
 }
+
+func TestOutput_WriteDistribution(t *testing.T) {
+	now := time.Unix(1549283566, 0)
+	cwClient := cloudwatchMocks.NewClient(t)
+
+	cwClient.EXPECT().PutMetricData(matcher.Context, &cloudwatch.PutMetricDataInput{
+		Namespace: aws.String("my/test/namespace/grp/app"),
+		MetricData: []types.MetricDatum{{
+			MetricName: aws.String("latency"),
+			Dimensions: []types.Dimension{},
+			Timestamp:  aws.Time(now),
+			Values:     []float64{10, 20, 30},
+			Counts:     []float64{2, 1, 1},
+			Unit:       metric.UnitMilliseconds,
+		}},
+	}).Return(nil, nil)
+
+	writer := metric.NewCloudwatchWriterWithInterfaces(
+		logMocks.NewLoggerMock(logMocks.WithMockAll),
+		clock.NewFakeClockAt(now),
+		cwClient,
+		"my/test/namespace/grp/app",
+		10*time.Second,
+		metric.CloudwatchHighResolutionSettings{},
+	)
+
+	writer.Write(t.Context(), metric.Data{
+		{
+			Priority:     metric.PriorityHigh,
+			Timestamp:    now,
+			MetricName:   "latency",
+			Unit:         metric.UnitMilliseconds,
+			Value:        17.5,
+			Kind:         metric.KindHistogram.Build(),
+			Distribution: metric.NewDistribution([]float64{10, 20, 10, 30}),
+		},
+	})
+}
+
+func TestOutput_WriteStatistics(t *testing.T) {
+	now := time.Unix(1549283566, 0)
+	cwClient := cloudwatchMocks.NewClient(t)
+
+	cwClient.EXPECT().PutMetricData(matcher.Context, &cloudwatch.PutMetricDataInput{
+		Namespace: aws.String("my/test/namespace/grp/app"),
+		MetricData: []types.MetricDatum{{
+			MetricName: aws.String("size"),
+			Dimensions: []types.Dimension{},
+			Timestamp:  aws.Time(now),
+			StatisticValues: &types.StatisticSet{
+				SampleCount: aws.Float64(3),
+				Sum:         aws.Float64(9),
+				Minimum:     aws.Float64(1),
+				Maximum:     aws.Float64(5),
+			},
+			Unit: types.StandardUnitBytes,
+		}},
+	}).Return(nil, nil)
+
+	writer := metric.NewCloudwatchWriterWithInterfaces(
+		logMocks.NewLoggerMock(logMocks.WithMockAll),
+		clock.NewFakeClockAt(now),
+		cwClient,
+		"my/test/namespace/grp/app",
+		10*time.Second,
+		metric.CloudwatchHighResolutionSettings{},
+	)
+
+	writer.Write(t.Context(), metric.Data{
+		{
+			Priority:     metric.PriorityHigh,
+			Timestamp:    now,
+			MetricName:   "size",
+			Unit:         types.StandardUnitBytes,
+			Value:        9,
+			Distribution: metric.NewStatistics([]float64{5, 1, 3}),
+		},
+	})
+}
+
+func TestOutput_WriteHighResolution(t *testing.T) {
+	now := time.Unix(1549283566, 0)
+	cwClient := cloudwatchMocks.NewClient(t)
+
+	cwClient.EXPECT().PutMetricData(matcher.Context, &cloudwatch.PutMetricDataInput{
+		Namespace: aws.String("my/test/namespace/grp/app"),
+		MetricData: []types.MetricDatum{
+			{
+				MetricName:        aws.String("StreamMessageLatency"),
+				Dimensions:        []types.Dimension{},
+				Timestamp:         aws.Time(now),
+				Value:             aws.Float64(12),
+				Unit:              metric.UnitMilliseconds,
+				StorageResolution: aws.Int32(1),
+			},
+			{
+				MetricName: aws.String("ApiRequestCount"),
+				Dimensions: []types.Dimension{},
+				Timestamp:  aws.Time(now),
+				Value:      aws.Float64(1),
+				Unit:       metric.UnitCount,
+			},
+		},
+	}).Return(nil, nil)
+
+	writer := metric.NewCloudwatchWriterWithInterfaces(
+		logMocks.NewLoggerMock(logMocks.WithMockAll),
+		clock.NewFakeClockAt(now),
+		cwClient,
+		"my/test/namespace/grp/app",
+		10*time.Second,
+		metric.CloudwatchHighResolutionSettings{
+			Metrics: []string{"StreamMessage*"},
+		},
+	)
+
+	writer.Write(t.Context(), metric.Data{
+		{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  now,
+			MetricName: "StreamMessageLatency",
+			Unit:       metric.UnitMilliseconds,
+			Value:      12,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  now,
+			MetricName: "ApiRequestCount",
+			Unit:       metric.UnitCount,
+			Value:      1,
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package metric
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWriterWithDimensions verifies that the dimensions of a writer are merged into the written data and its defaults,
+// with the dimensions of a datum taking precedence.
+func TestWriterWithDimensions(t *testing.T) {
+	dimensions := Dimensions{"Module": "consumer", "Queue": "default"}
+	writer := NewWriterWithDimensions(dimensions, &Datum{
+		Priority:   PriorityHigh,
+		MetricName: "test-writer-dimensions",
+		Dimensions: Dimensions{"Queue": "events"},
+		Unit:       UnitCount,
+	}).(*writer)
+
+	channel := &metricChannel{
+		hasData: make(chan struct{}, 1),
+	}
+	channel.enabled.Store(true)
+	writer.channel = channel
+	writer.clock = clock.NewFakeClock()
+
+	writer.WriteOne(t.Context(), &Datum{
+		MetricName: "test-writer-dimensions",
+		Dimensions: Dimensions{"Queue": "events"},
+		Value:      1,
+	})
+
+	data := channel.read(nil)
+	require.Len(t, data, 1)
+	assert.Equal(t, Dimensions{"Module": "consumer", "Queue": "events"}, data[0].Dimensions)
+
+	amendFromDefault(data[0])
+	assert.Equal(t, PriorityHigh, data[0].Priority)
+	assert.Equal(t, UnitCount, data[0].Unit)
+}
+
+func TestGetMetricSettings_Dimensions(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":  "test",
+			"name": "metric",
+		},
+		"metric": map[string]any{
+			"dimensions": map[string]any{
+				"Environment": "{app.env}",
+				"Pod":         "metric-0",
+			},
+		},
+	})
+
+	settings, err := GetMetricSettings(config)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Environment": "test", "Pod": "metric-0"}, settings.Dimensions)
+}
@@ -0,0 +1,251 @@
+package metric
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+func init() {
+	RegisterWriterFactory(WriterTypeMemory, ProvideMemoryWriter)
+}
+
+var _ Writer = &MemoryWriter{}
+
+// MemorySettings configures the "memory" writer, which keeps the recent data in memory and serves them on a local
+// debug endpoint, so metrics can be inspected during development without any metric backend.
+type MemorySettings struct {
+	Aggregate bool `cfg:"aggregate" default:"false"`
+	// Size is the amount of recent data kept in memory.
+	Size int                  `cfg:"size" default:"1000"`
+	Api  MemoryServerSettings `cfg:"api"`
+}
+
+type MemoryServerSettings struct {
+	Enabled bool            `cfg:"enabled" default:"true"`
+	Port    int             `cfg:"port" default:"8093"`
+	Path    string          `cfg:"path" default:"/metrics"`
+	Timeout TimeoutSettings `cfg:"timeout"`
+}
+
+// MemoryMetricSummary aggregates all data written for a metric with the same dimensions.
+type MemoryMetricSummary struct {
+	MetricName string       `json:"metricName"`
+	Dimensions Dimensions   `json:"dimensions"`
+	Unit       StandardUnit `json:"unit"`
+	Count      int          `json:"count"`
+	Sum        float64      `json:"sum"`
+	Min        float64      `json:"min"`
+	Max        float64      `json:"max"`
+	Last       float64      `json:"last"`
+	LastWrite  time.Time    `json:"lastWrite"`
+}
+
+// MemorySnapshot is served on the debug endpoint of the memory writer.
+type MemorySnapshot struct {
+	Metrics []MemoryMetricSummary `json:"metrics"`
+	Recent  []Datum               `json:"recent"`
+}
+
+type memoryWriterCtxKey string
+
+// MemoryWriter keeps the most recent data and a summary per metric and dimensions in memory.
+type MemoryWriter struct {
+	lck       sync.Mutex
+	size      int
+	recent    []Datum
+	next      int
+	summaries map[string]*MemoryMetricSummary
+}
+
+// ProvideMemoryWriter provides a memory writer. If one is registered under the default key in
+// the appctx, this is returned, else creates a new one and registers it.
+func ProvideMemoryWriter(ctx context.Context, config cfg.Config, _ log.Logger) (Writer, error) {
+	writer, err := provideMemoryWriter(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+func provideMemoryWriter(ctx context.Context, config cfg.Config) (*MemoryWriter, error) {
+	return appctx.Provide(ctx, memoryWriterCtxKey("default"), func() (*MemoryWriter, error) {
+		settings, err := getMetricWriterSettings[MemorySettings](config, WriterTypeMemory)
+		if err != nil {
+			return nil, fmt.Errorf("could not get memory writer settings: %w", err)
+		}
+
+		return NewMemoryWriter(settings.Size), nil
+	})
+}
+
+// NewMemoryWriter creates a writer keeping the given amount of recent data.
+func NewMemoryWriter(size int) *MemoryWriter {
+	return &MemoryWriter{
+		size:      max(size, 1),
+		recent:    make([]Datum, 0, max(size, 1)),
+		summaries: make(map[string]*MemoryMetricSummary),
+	}
+}
+
+func (w *MemoryWriter) GetPriority() int {
+	return PriorityLow
+}
+
+func (w *MemoryWriter) WriteOne(ctx context.Context, data *Datum) {
+	w.Write(ctx, Data{data})
+}
+
+func (w *MemoryWriter) Write(_ context.Context, batch Data) {
+	w.lck.Lock()
+	defer w.lck.Unlock()
+
+	for _, datum := range batch {
+		amendFromDefault(datum)
+
+		// the copy outlives the datum, which might be handed back to the pool after writing
+		cpy := *datum
+		cpy.pooled = false
+
+		if len(w.recent) < w.size {
+			w.recent = append(w.recent, cpy)
+		} else {
+			w.recent[w.next] = cpy
+		}
+		w.next = (w.next + 1) % w.size
+
+		w.summarize(datum)
+	}
+}
+
+func (w *MemoryWriter) summarize(datum *Datum) {
+	id := datum.Id()
+	summary, ok := w.summaries[id]
+
+	if !ok {
+		summary = &MemoryMetricSummary{
+			MetricName: datum.MetricName,
+			Dimensions: datum.Dimensions,
+			Unit:       datum.Unit,
+			Min:        math.Inf(1),
+			Max:        math.Inf(-1),
+		}
+		w.summaries[id] = summary
+	}
+
+	if distribution := datum.Distribution; distribution != nil && len(distribution.Values) == 0 {
+		summary.Count += int(distribution.Count)
+		summary.Sum += distribution.Sum
+		summary.Min = math.Min(summary.Min, distribution.Min)
+		summary.Max = math.Max(summary.Max, distribution.Max)
+	} else {
+		for _, value := range datum.Observations() {
+			summary.Count++
+			summary.Sum += value
+			summary.Min = math.Min(summary.Min, value)
+			summary.Max = math.Max(summary.Max, value)
+		}
+	}
+
+	summary.Last = datum.Value
+	summary.LastWrite = datum.Timestamp
+}
+
+// Snapshot returns the summaries of all metrics sorted by name and dimensions and the recent data, newest first.
+func (w *MemoryWriter) Snapshot() MemorySnapshot {
+	w.lck.Lock()
+	defer w.lck.Unlock()
+
+	snapshot := MemorySnapshot{
+		Metrics: make([]MemoryMetricSummary, 0, len(w.summaries)),
+		Recent:  make([]Datum, 0, len(w.recent)),
+	}
+
+	ids := make([]string, 0, len(w.summaries))
+	for id := range w.summaries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		snapshot.Metrics = append(snapshot.Metrics, *w.summaries[id])
+	}
+
+	// the oldest datum is at next once the buffer is full
+	oldest := 0
+	if len(w.recent) == w.size {
+		oldest = w.next
+	}
+
+	snapshot.Recent = append(snapshot.Recent, w.recent[oldest:]...)
+	snapshot.Recent = append(snapshot.Recent, w.recent[:oldest]...)
+	slices.Reverse(snapshot.Recent)
+
+	return snapshot
+}
+
+// ServeHTTP serves the snapshot as json. The metric query parameter filters the data by a prefix of the metric name.
+func (w *MemoryWriter) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	snapshot := w.Snapshot()
+
+	if prefix := request.URL.Query().Get("metric"); prefix != "" {
+		snapshot.Metrics = slices.DeleteFunc(snapshot.Metrics, func(summary MemoryMetricSummary) bool {
+			return !strings.HasPrefix(summary.MetricName, prefix)
+		})
+		snapshot.Recent = slices.DeleteFunc(snapshot.Recent, func(datum Datum) bool {
+			return !strings.HasPrefix(datum.MetricName, prefix)
+		})
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(snapshot); err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// NewMemoryMetricsServerModule serves the data of the memory writer if it is one of the configured metric writers.
+func NewMemoryMetricsServerModule(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+	var err error
+	var memorySettings *MemorySettings
+	var settings *Settings
+	var writer *MemoryWriter
+
+	if memorySettings, err = getMetricWriterSettings[MemorySettings](config, WriterTypeMemory); err != nil {
+		return nil, fmt.Errorf("could not get memory writer settings: %w", err)
+	}
+
+	if settings, err = GetMetricSettings(config); err != nil {
+		return nil, fmt.Errorf("could not get metric settings: %w", err)
+	}
+
+	if !settings.Enabled || !slices.Contains(settings.Writers, WriterTypeMemory) || !memorySettings.Api.Enabled {
+		return nil, nil
+	}
+
+	if writer, err = provideMemoryWriter(ctx, config); err != nil {
+		return nil, err
+	}
+
+	server, err := newMetricsServer(ctx, logger, memorySettings.Api.Port, memorySettings.Api.Timeout, memorySettings.Api.Path, writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return server, nil
+}
@@ -0,0 +1,67 @@
+package metric_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryWriter(t *testing.T) {
+	timestamp := time.Unix(1549283566, 0).UTC()
+	writer := metric.NewMemoryWriter(2)
+
+	for i, value := range []float64{1, 5, 3} {
+		writer.WriteOne(t.Context(), &metric.Datum{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  timestamp.Add(time.Duration(i) * time.Second),
+			MetricName: "ProcessedItems",
+			Dimensions: metric.Dimensions{"Queue": "events"},
+			Value:      value,
+			Unit:       metric.UnitCount,
+		})
+	}
+	writer.WriteOne(t.Context(), &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		Timestamp:  timestamp,
+		MetricName: "Latency",
+		Value:      12,
+		Unit:       metric.UnitMilliseconds,
+	})
+
+	snapshot := writer.Snapshot()
+
+	require.Len(t, snapshot.Metrics, 2)
+	assert.Equal(t, "Latency", snapshot.Metrics[0].MetricName)
+	assert.Equal(t, metric.MemoryMetricSummary{
+		MetricName: "ProcessedItems",
+		Dimensions: metric.Dimensions{"Queue": "events"},
+		Unit:       metric.UnitCount,
+		Count:      3,
+		Sum:        9,
+		Min:        1,
+		Max:        5,
+		Last:       3,
+		LastWrite:  timestamp.Add(2 * time.Second),
+	}, snapshot.Metrics[1])
+
+	require.Len(t, snapshot.Recent, 2)
+	assert.Equal(t, "Latency", snapshot.Recent[0].MetricName)
+	assert.Equal(t, 3.0, snapshot.Recent[1].Value)
+
+	recorder := httptest.NewRecorder()
+	writer.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics?metric=Processed", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	served := metric.MemorySnapshot{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &served))
+	require.Len(t, served.Metrics, 1)
+	assert.Equal(t, "ProcessedItems", served.Metrics[0].MetricName)
+	require.Len(t, served.Recent, 1)
+	assert.Equal(t, "ProcessedItems", served.Recent[0].MetricName)
+}
@@ -0,0 +1,364 @@
+package metric
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const otlpScopeName = "github.com/justtrackio/gosoline/pkg/metric"
+
+func init() {
+	RegisterWriterFactory(WriterTypeOtlp, ProvideOtlpWriter)
+}
+
+var (
+	_         Writer = &otlpWriter{}
+	otlpUnits        = map[StandardUnit]string{
+		UnitCount:        "1",
+		UnitSeconds:      "s",
+		UnitMilliseconds: "ms",
+		"Microseconds":   "us",
+		"Bytes":          "By",
+		"Kilobytes":      "kBy",
+		"Megabytes":      "MBy",
+		"Percent":        "%",
+		"Bytes/Second":   "By/s",
+		"Count/Second":   "1/s",
+	}
+)
+
+// OtlpSettings configures the "otlp" writer, which exports metrics via OTLP/gRPC to an OpenTelemetry collector.
+type OtlpSettings struct {
+	Aggregate      bool              `cfg:"aggregate" default:"false"`
+	Endpoint       string            `cfg:"endpoint" default:"localhost:4317"`
+	Insecure       bool              `cfg:"insecure" default:"false"`
+	Headers        map[string]string `cfg:"headers"`
+	Timeout        time.Duration     `cfg:"timeout" default:"10s"`
+	WriteGraceTime time.Duration     `cfg:"write_grace_time" default:"10s"`
+}
+
+type (
+	otlpWriterCtxKey string
+
+	otlpWriter struct {
+		logger   log.Logger
+		client   colmetricspb.MetricsServiceClient
+		resource *resourcepb.Resource
+		settings *OtlpSettings
+	}
+)
+
+// ProvideOtlpWriter provides an OTLP writer. If one is registered under the default key in
+// the appctx, this is returned, else creates a new one and registers it.
+func ProvideOtlpWriter(ctx context.Context, config cfg.Config, logger log.Logger) (Writer, error) {
+	return appctx.Provide(ctx, otlpWriterCtxKey("default"), func() (Writer, error) {
+		return NewOtlpWriter(ctx, config, logger)
+	})
+}
+
+// NewOtlpWriter creates a new OTLP metric writer with the settings found at metric.writer_settings.otlp.
+// The exported metrics carry the same resource attributes derived from the app identity as the otlp log handler,
+// so logs and metrics of an application can be correlated in the backend. Metrics with Kind "total" are dropped,
+// as OpenTelemetry backends can sum over all attributes of a metric themselves.
+func NewOtlpWriter(_ context.Context, config cfg.Config, logger log.Logger) (Writer, error) {
+	var err error
+	var settings *OtlpSettings
+	var conn *grpc.ClientConn
+	var resource *resourcepb.Resource
+
+	if settings, err = getMetricWriterSettings[OtlpSettings](config, WriterTypeOtlp); err != nil {
+		return nil, fmt.Errorf("could not get otlp writer settings: %w", err)
+	}
+
+	transportCredentials := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if settings.Insecure {
+		transportCredentials = insecure.NewCredentials()
+	}
+
+	if conn, err = grpc.NewClient(settings.Endpoint, grpc.WithTransportCredentials(transportCredentials)); err != nil {
+		return nil, fmt.Errorf("can not create grpc client for endpoint %s: %w", settings.Endpoint, err)
+	}
+
+	if resource, err = log.NewOtlpResource(config); err != nil {
+		return nil, fmt.Errorf("can not create otlp resource: %w", err)
+	}
+
+	client := colmetricspb.NewMetricsServiceClient(conn)
+
+	return NewOtlpWriterWithInterfaces(logger, client, resource, settings), nil
+}
+
+// NewOtlpWriterWithInterfaces creates a new OTLP metric writer using the provided client to export the metrics.
+func NewOtlpWriterWithInterfaces(
+	logger log.Logger,
+	client colmetricspb.MetricsServiceClient,
+	resource *resourcepb.Resource,
+	settings *OtlpSettings,
+) Writer {
+	return &otlpWriter{
+		logger:   logger.WithChannel("metrics"),
+		client:   client,
+		resource: resource,
+		settings: settings,
+	}
+}
+
+func (w *otlpWriter) GetPriority() int {
+	return PriorityLow
+}
+
+func (w *otlpWriter) WriteOne(ctx context.Context, data *Datum) {
+	w.Write(ctx, Data{data})
+}
+
+func (w *otlpWriter) Write(applicationCtx context.Context, batch Data) {
+	if len(batch) == 0 {
+		return
+	}
+
+	delayedCtx, stop := exec.WithDelayedCancelContext(applicationCtx, w.settings.WriteGraceTime)
+	defer stop()
+
+	metrics := make([]*metricspb.Metric, 0, len(batch))
+
+	for _, datum := range batch {
+		amendFromDefault(datum)
+
+		if datum.Priority < w.GetPriority() || datum.Kind.kind == KindTotal.kind {
+			continue
+		}
+
+		metrics = append(metrics, otlpMetric(datum))
+	}
+
+	if len(metrics) == 0 {
+		return
+	}
+
+	if err := w.export(delayedCtx, metrics); err != nil {
+		w.logger.Error(delayedCtx, "could not write metric data: %w", err)
+
+		return
+	}
+
+	w.logger.Debug(delayedCtx, "written %d metric data sets to otlp", len(metrics))
+}
+
+func (w *otlpWriter) export(ctx context.Context, metrics []*metricspb.Metric) error {
+	ctx, cancel := context.WithTimeout(ctx, w.settings.Timeout)
+	defer cancel()
+
+	if len(w.settings.Headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(w.settings.Headers))
+	}
+
+	request := &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: w.resource,
+				ScopeMetrics: []*metricspb.ScopeMetrics{
+					{
+						Scope: &commonpb.InstrumentationScope{
+							Name: otlpScopeName,
+						},
+						Metrics: metrics,
+					},
+				},
+			},
+		},
+	}
+
+	response, err := w.client.Export(ctx, request)
+	if err != nil {
+		return fmt.Errorf("can not export metrics to %s: %w", w.settings.Endpoint, err)
+	}
+
+	if partial := response.GetPartialSuccess(); partial != nil && partial.GetRejectedDataPoints() > 0 {
+		return fmt.Errorf("collector rejected %d data points: %s", partial.GetRejectedDataPoints(), partial.GetErrorMessage())
+	}
+
+	return nil
+}
+
+// otlpMetric converts a datum into an OTLP metric with a single data point. The kind of the datum decides about the
+// OTLP type in the same way as for prometheus: counters become monotonic delta sums, histograms and summaries become
+// delta histograms and everything else is a gauge.
+func otlpMetric(datum *Datum) *metricspb.Metric {
+	timestamp := datum.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	timeUnixNano := uint64(timestamp.UnixNano())
+	attributes := otlpAttributes(datum.Dimensions)
+	exemplars := otlpExemplars(datum.Exemplar)
+
+	metric := &metricspb.Metric{
+		Name:        datum.MetricName,
+		Description: datum.Kind.help,
+		Unit:        otlpUnit(datum.Unit),
+	}
+
+	switch effectiveKind(datum) {
+	case kindCounter:
+		metric.Data = &metricspb.Metric_Sum{
+			Sum: &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				IsMonotonic:            true,
+				DataPoints: []*metricspb.NumberDataPoint{
+					{
+						Attributes:        attributes,
+						StartTimeUnixNano: timeUnixNano,
+						TimeUnixNano:      timeUnixNano,
+						Value:             &metricspb.NumberDataPoint_AsDouble{AsDouble: datum.Value},
+						Exemplars:         exemplars,
+					},
+				},
+			},
+		}
+	case kindHistogram, kindSummary:
+		distribution := datum.Distribution
+		if distribution == nil {
+			distribution = NewDistribution([]float64{datum.Value})
+		}
+
+		metric.Data = &metricspb.Metric_Histogram{
+			Histogram: &metricspb.Histogram{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				DataPoints: []*metricspb.HistogramDataPoint{
+					{
+						Attributes:        attributes,
+						StartTimeUnixNano: timeUnixNano,
+						TimeUnixNano:      timeUnixNano,
+						Count:             uint64(distribution.Count),
+						Sum:               &distribution.Sum,
+						Min:               &distribution.Min,
+						Max:               &distribution.Max,
+						ExplicitBounds:    datum.Kind.buckets,
+						BucketCounts:      otlpBucketCounts(datum.Kind.buckets, distribution.Values),
+						Exemplars:         exemplars,
+					},
+				},
+			},
+		}
+	default:
+		metric.Data = &metricspb.Metric_Gauge{
+			Gauge: &metricspb.Gauge{
+				DataPoints: []*metricspb.NumberDataPoint{
+					{
+						Attributes:   attributes,
+						TimeUnixNano: timeUnixNano,
+						Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: datum.Value},
+						Exemplars:    exemplars,
+					},
+				},
+			},
+		}
+	}
+
+	return metric
+}
+
+// otlpBucketCounts returns the counts for the buckets delimited by the given bounds, with each value counted in the
+// first bucket whose upper bound is not exceeded. Without bounds, there is a single bucket.
+func otlpBucketCounts(bounds []float64, values []float64) []uint64 {
+	counts := make([]uint64, len(bounds)+1)
+
+	for _, value := range values {
+		counts[sort.SearchFloat64s(bounds, value)]++
+	}
+
+	return counts
+}
+
+// otlpExemplars converts the exemplar of a datum. Exemplars with trace ids which can't be represented in OTLP are
+// dropped.
+func otlpExemplars(exemplar *Exemplar) []*metricspb.Exemplar {
+	if exemplar == nil {
+		return nil
+	}
+
+	traceId := otlpTraceId(exemplar.TraceId)
+	if traceId == nil {
+		return nil
+	}
+
+	return []*metricspb.Exemplar{
+		{
+			TimeUnixNano: uint64(exemplar.Timestamp.UnixNano()),
+			Value:        &metricspb.Exemplar_AsDouble{AsDouble: exemplar.Value},
+			TraceId:      traceId,
+			SpanId:       otlpSpanId(exemplar.SpanId),
+		},
+	}
+}
+
+// otlpTraceId converts trace ids of OpenTelemetry (32 hex digits) and X-Ray (1-8 hex digits-24 hex digits) to the
+// 16 bytes expected by OTLP. It returns nil for any other format.
+func otlpTraceId(traceId string) []byte {
+	traceId = strings.ReplaceAll(strings.TrimPrefix(traceId, "1-"), "-", "")
+
+	return decodeHexId(traceId, 16)
+}
+
+func otlpSpanId(spanId string) []byte {
+	return decodeHexId(spanId, 8)
+}
+
+func decodeHexId(id string, size int) []byte {
+	if len(id) != 2*size {
+		return nil
+	}
+
+	decoded, err := hex.DecodeString(id)
+	if err != nil {
+		return nil
+	}
+
+	return decoded
+}
+
+func otlpUnit(unit StandardUnit) string {
+	if converted, ok := otlpUnits[unit]; ok {
+		return converted
+	}
+
+	return string(unit)
+}
+
+func otlpAttributes(dimensions Dimensions) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(dimensions))
+	for key := range dimensions {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	attributes := make([]*commonpb.KeyValue, 0, len(keys))
+
+	for _, key := range keys {
+		attributes = append(attributes, &commonpb.KeyValue{
+			Key:   key,
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: dimensions[key]}},
+		})
+	}
+
+	return attributes
+}
@@ -0,0 +1,113 @@
+package metric_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+)
+
+type otlpMetricsClient struct {
+	requests []*colmetricspb.ExportMetricsServiceRequest
+}
+
+func (c *otlpMetricsClient) Export(_ context.Context, in *colmetricspb.ExportMetricsServiceRequest, _ ...grpc.CallOption) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	c.requests = append(c.requests, in)
+
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+func TestOtlpWriter_Write(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":  "test",
+			"name": "otlp",
+		},
+	})
+
+	resource, err := log.NewOtlpResource(config)
+	require.NoError(t, err)
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := &otlpMetricsClient{}
+	writer := metric.NewOtlpWriterWithInterfaces(logger, client, resource, &metric.OtlpSettings{
+		Timeout:        time.Second,
+		WriteGraceTime: time.Second,
+	})
+
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	writer.Write(t.Context(), metric.Data{
+		{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  timestamp,
+			MetricName: "requests",
+			Dimensions: metric.Dimensions{"path": "/a"},
+			Value:      3,
+			Unit:       metric.UnitCount,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  timestamp,
+			MetricName: "requests_total",
+			Value:      3,
+			Unit:       metric.UnitCount,
+			Kind:       metric.KindTotal,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  timestamp,
+			MetricName: "latency",
+			Value:      42,
+			Unit:       metric.UnitMilliseconds,
+			Kind:       metric.KindHistogram.WithBuckets([]float64{10, 50, 100}).Build(),
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			Timestamp:  timestamp,
+			MetricName: "queue_length",
+			Value:      7,
+			Unit:       metric.StandardUnit("None"),
+		},
+	})
+
+	require.Len(t, client.requests, 1)
+	resourceMetrics := client.requests[0].ResourceMetrics
+	require.Len(t, resourceMetrics, 1)
+	assert.Equal(t, resource, resourceMetrics[0].Resource)
+
+	metrics := resourceMetrics[0].ScopeMetrics[0].Metrics
+	require.Len(t, metrics, 3)
+
+	assert.Equal(t, "requests", metrics[0].Name)
+	assert.Equal(t, "1", metrics[0].Unit)
+	sum := metrics[0].GetSum()
+	require.NotNil(t, sum)
+	assert.True(t, sum.IsMonotonic)
+	assert.Equal(t, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA, sum.AggregationTemporality)
+	assert.Equal(t, 3.0, sum.DataPoints[0].GetAsDouble())
+	assert.Equal(t, uint64(timestamp.UnixNano()), sum.DataPoints[0].TimeUnixNano)
+	assert.Equal(t, "path", sum.DataPoints[0].Attributes[0].Key)
+	assert.Equal(t, "/a", sum.DataPoints[0].Attributes[0].Value.GetStringValue())
+
+	assert.Equal(t, "latency", metrics[1].Name)
+	assert.Equal(t, "ms", metrics[1].Unit)
+	histogram := metrics[1].GetHistogram()
+	require.NotNil(t, histogram)
+	assert.Equal(t, []float64{10, 50, 100}, histogram.DataPoints[0].ExplicitBounds)
+	assert.Equal(t, []uint64{0, 1, 0, 0}, histogram.DataPoints[0].BucketCounts)
+	assert.Equal(t, 42.0, histogram.DataPoints[0].GetSum())
+
+	assert.Equal(t, "queue_length", metrics[2].Name)
+	gauge := metrics[2].GetGauge()
+	require.NotNil(t, gauge)
+	assert.Equal(t, 7.0, gauge.DataPoints[0].GetAsDouble())
+}
@@ -186,19 +186,7 @@ func (w *prometheusWriter) writeMetricFromDatum(ctx context.Context, datum *Datu
 }
 
 func (w *prometheusWriter) getEffectiveKind(datum *Datum) kind {
-	switch datum.Kind.kind {
-	case kindCounter, kindGauge, kindHistogram, kindSummary:
-		return datum.Kind.kind
-	}
-
-	switch datum.Unit {
-	case UnitCount:
-		return kindCounter
-	case UnitMilliseconds, UnitSeconds:
-		return kindSummary
-	default:
-		return kindGauge
-	}
+	return effectiveKind(datum)
 }
 
 func (w *prometheusWriter) buildHelp(datum *Datum) string {
@@ -289,9 +277,15 @@ func (w *prometheusWriter) counter(ctx context.Context, datum *Datum) {
 	metric := w.createCounter(datum)
 
 	err := w.registerAndProcessMetric(metric, datum.MetricName, func(metric prometheus.Collector) {
-		metric.(*prometheus.CounterVec).
-			With(prometheus.Labels(datum.Dimensions)).
-			Add(datum.Value)
+		counter := metric.(*prometheus.CounterVec).With(prometheus.Labels(datum.Dimensions))
+
+		if datum.Exemplar == nil {
+			counter.Add(datum.Value)
+
+			return
+		}
+
+		counter.(prometheus.ExemplarAdder).AddWithExemplar(datum.Value, datum.Exemplar.Labels())
 	})
 	if err != nil {
 		w.logger.Error(ctx, "writing prometheus counter for datum %s: %v", datum.MetricName, err)
@@ -315,9 +309,11 @@ func (w *prometheusWriter) summary(ctx context.Context, datum *Datum) {
 	metric := w.createSummary(datum)
 
 	err := w.registerAndProcessMetric(metric, datum.MetricName, func(metric prometheus.Collector) {
-		metric.(*prometheus.SummaryVec).
-			With(prometheus.Labels(datum.Dimensions)).
-			Observe(datum.Value)
+		observer := metric.(*prometheus.SummaryVec).With(prometheus.Labels(datum.Dimensions))
+
+		for _, value := range datum.Observations() {
+			observer.Observe(value)
+		}
 	})
 	if err != nil {
 		w.logger.Error(ctx, "writing prometheus summary for datum %s: %v", datum.MetricName, err)
@@ -328,9 +324,20 @@ func (w *prometheusWriter) histogram(ctx context.Context, datum *Datum) {
 	metric := w.createHistogram(datum)
 
 	err := w.registerAndProcessMetric(metric, datum.MetricName, func(metric prometheus.Collector) {
-		metric.(*prometheus.HistogramVec).
-			With(prometheus.Labels(datum.Dimensions)).
-			Observe(datum.Value)
+		observer := metric.(*prometheus.HistogramVec).With(prometheus.Labels(datum.Dimensions))
+		exemplar := datum.Exemplar
+
+		for _, value := range datum.Observations() {
+			// attach the exemplar to the observation it was recorded for
+			if exemplar != nil && exemplar.Value == value {
+				observer.(prometheus.ExemplarObserver).ObserveWithExemplar(value, exemplar.Labels())
+				exemplar = nil
+
+				continue
+			}
+
+			observer.Observe(value)
+		}
 	})
 	if err != nil {
 		w.logger.Error(ctx, "writing prometheus histogram for datum %s: %v", datum.MetricName, err)
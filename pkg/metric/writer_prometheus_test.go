@@ -11,6 +11,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/metric"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -251,3 +252,97 @@ func Test_promWriter_Write_WithCanceledContextStillWrites(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 1, count)
 }
+
+func Test_promWriter_WriteDistribution(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	registry := prometheus.NewRegistry()
+	w := metric.NewPrometheusWriterWithInterfaces(logger, registry, "ns", 1000, writeGraceTime)
+
+	w.WriteOne(t.Context(), &metric.Datum{
+		Priority:     metric.PriorityHigh,
+		MetricName:   "latency",
+		Unit:         metric.UnitMilliseconds,
+		Value:        20,
+		Kind:         metric.KindHistogram.WithBuckets([]float64{15, 50}).Build(),
+		Distribution: metric.NewDistribution([]float64{10, 20, 30}),
+	})
+
+	metricOutput := `
+		# HELP ns_latency unit: Milliseconds
+		# TYPE ns_latency histogram
+		ns_latency_bucket{le="15"} 1
+		ns_latency_bucket{le="50"} 3
+		ns_latency_bucket{le="+Inf"} 3
+		ns_latency_sum 60
+		ns_latency_count 3
+	`
+
+	err := testutil.GatherAndCompare(registry, strings.NewReader(metricOutput), "ns_latency")
+	assert.NoError(t, err)
+}
+
+func Test_promWriter_WriteExemplar(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	registry := prometheus.NewRegistry()
+	w := metric.NewPrometheusWriterWithInterfaces(logger, registry, "ns", 1000, writeGraceTime)
+
+	exemplar := &metric.Exemplar{
+		TraceId: "5759e988bd862e3fe1be46a994272793",
+		SpanId:  "53995c3f42cd8ad8",
+		Value:   20,
+	}
+
+	w.Write(t.Context(), metric.Data{
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: "requests",
+			Unit:       metric.UnitCount,
+			Value:      2,
+			Exemplar:   exemplar,
+		},
+		{
+			Priority:     metric.PriorityHigh,
+			MetricName:   "latency",
+			Unit:         metric.UnitMilliseconds,
+			Value:        20,
+			Kind:         metric.KindHistogram.WithBuckets([]float64{15, 50}).Build(),
+			Distribution: metric.NewDistribution([]float64{10, 20, 30}),
+			Exemplar:     exemplar,
+		},
+	})
+
+	families, err := registry.Gather()
+	assert.NoError(t, err)
+	assert.Len(t, families, 2)
+
+	expectedLabels := map[string]string{
+		metric.ExemplarLabelTraceId: exemplar.TraceId,
+		metric.ExemplarLabelSpanId:  exemplar.SpanId,
+	}
+
+	for _, family := range families {
+		switch family.GetName() {
+		case "ns_latency":
+			buckets := family.GetMetric()[0].GetHistogram().GetBucket()
+			assert.Nil(t, buckets[0].GetExemplar(), "the exemplar should be attached to the observation it was recorded for")
+			assert.Equal(t, 20.0, buckets[1].GetExemplar().GetValue())
+			assert.Equal(t, expectedLabels, exemplarLabels(buckets[1].GetExemplar().GetLabel()))
+		case "ns_requests":
+			counter := family.GetMetric()[0].GetCounter()
+			assert.Equal(t, 2.0, counter.GetValue())
+			assert.Equal(t, expectedLabels, exemplarLabels(counter.GetExemplar().GetLabel()))
+		default:
+			assert.Fail(t, "unexpected metric family", family.GetName())
+		}
+	}
+}
+
+func exemplarLabels(pairs []*dto.LabelPair) map[string]string {
+	labels := make(map[string]string, len(pairs))
+
+	for _, pair := range pairs {
+		labels[pair.GetName()] = pair.GetValue()
+	}
+
+	return labels
+}
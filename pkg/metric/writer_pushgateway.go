@@ -0,0 +1,124 @@
+package metric
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+func init() {
+	RegisterWriterFactory(WriterTypePushgateway, ProvidePushgatewayWriter)
+}
+
+var (
+	_ Writer  = &pushgatewayWriter{}
+	_ Flusher = &pushgatewayWriter{}
+)
+
+// PushgatewaySettings configures the "pushgateway" writer, which pushes the metrics of short-lived jobs to a
+// Prometheus Pushgateway once the application stops.
+type PushgatewaySettings struct {
+	Aggregate bool   `cfg:"aggregate" default:"false"`
+	Url       string `cfg:"url" default:"http://localhost:9091"`
+	// Job is the job label of the pushed metrics, it can contain app identity placeholders.
+	Job string `cfg:"job,nodecode" default:"{app.name}"`
+	// Grouping labels are added to the job label to identify the group of pushed metrics, their values can contain
+	// app identity placeholders.
+	Grouping       map[string]string        `cfg:"grouping"`
+	Timeout        time.Duration            `cfg:"timeout" default:"10s"`
+	MetricLimit    int64                    `cfg:"metric_limit" default:"10000"`
+	WriteGraceTime time.Duration            `cfg:"write_grace_time" default:"10s"`
+	Naming         PrometheusNamingSettings `cfg:"naming"`
+}
+
+type (
+	pushgatewayWriterCtxKey string
+
+	pushgatewayWriter struct {
+		Writer
+		logger log.Logger
+		pusher *push.Pusher
+	}
+)
+
+// ProvidePushgatewayWriter provides a pushgateway writer. If one is registered under the default key in
+// the appctx, this is returned, else creates a new one and registers it.
+func ProvidePushgatewayWriter(ctx context.Context, config cfg.Config, logger log.Logger) (Writer, error) {
+	return appctx.Provide(ctx, pushgatewayWriterCtxKey("default"), func() (Writer, error) {
+		return NewPushgatewayWriter(ctx, config, logger)
+	})
+}
+
+// NewPushgatewayWriter creates a new pushgateway metric writer with the settings found at
+// metric.writer_settings.pushgateway. Metrics are collected like with the prometheus writer, but in a registry of their
+// own, which is pushed to the gateway when the metric daemon stops. Use it for jobs which finish before they could be
+// scraped; long-running applications should use the prometheus writer instead.
+func NewPushgatewayWriter(_ context.Context, config cfg.Config, logger log.Logger) (Writer, error) {
+	var err error
+	var settings *PushgatewaySettings
+	var identity cfg.Identity
+	var namespace, job string
+
+	if settings, err = getMetricWriterSettings[PushgatewaySettings](config, WriterTypePushgateway); err != nil {
+		return nil, fmt.Errorf("could not get pushgateway writer settings: %w", err)
+	}
+
+	if identity, err = cfg.GetAppIdentity(config); err != nil {
+		return nil, fmt.Errorf("could not get app identity from config: %w", err)
+	}
+
+	if namespace, err = identity.Format(settings.Naming.NamespacePattern, settings.Naming.NamespaceDelimiter); err != nil {
+		return nil, fmt.Errorf("could not format prometheus namespace: %w", err)
+	}
+
+	if job, err = identity.Format(settings.Job, "-"); err != nil {
+		return nil, fmt.Errorf("could not format pushgateway job: %w", err)
+	}
+
+	registry := prometheus.NewRegistry()
+	pusher := push.New(settings.Url, job).
+		Gatherer(registry).
+		Client(&http.Client{Timeout: settings.Timeout})
+
+	for name, pattern := range settings.Grouping {
+		value, err := identity.Format(pattern, "-")
+		if err != nil {
+			return nil, fmt.Errorf("could not format pushgateway grouping label %s: %w", name, err)
+		}
+
+		pusher = pusher.Grouping(name, value)
+	}
+
+	prometheusWriter := NewPrometheusWriterWithInterfaces(logger, registry, promReplacer.Replace(namespace), settings.MetricLimit, settings.WriteGraceTime)
+
+	return NewPushgatewayWriterWithInterfaces(logger, prometheusWriter, pusher), nil
+}
+
+// NewPushgatewayWriterWithInterfaces creates a new pushgateway writer using writer to collect the metrics into the
+// registry gathered by pusher.
+func NewPushgatewayWriterWithInterfaces(logger log.Logger, writer Writer, pusher *push.Pusher) Writer {
+	return &pushgatewayWriter{
+		Writer: writer,
+		logger: logger.WithChannel("metrics"),
+		pusher: pusher,
+	}
+}
+
+// Flush pushes all collected metrics to the gateway, replacing the metrics previously pushed for the same job and
+// grouping labels.
+func (w *pushgatewayWriter) Flush(ctx context.Context) error {
+	if err := w.pusher.PushContext(ctx); err != nil {
+		return fmt.Errorf("can not push metrics to pushgateway: %w", err)
+	}
+
+	w.logger.Info(ctx, "pushed metrics to pushgateway")
+
+	return nil
+}
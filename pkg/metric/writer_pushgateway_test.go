@@ -0,0 +1,92 @@
+package metric_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushgatewayWriter_Flush(t *testing.T) {
+	var method, path, body string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		method, path, body = r.Method, r.URL.Path, string(raw)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":       "test",
+			"name":      "import",
+			"namespace": "{app.env}",
+		},
+		"metric": map[string]any{
+			"writer_settings": map[string]any{
+				"pushgateway": map[string]any{
+					"url": server.URL,
+					"grouping": map[string]any{
+						"env": "{app.env}",
+					},
+				},
+			},
+		},
+	})
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	writer, err := metric.NewPushgatewayWriter(t.Context(), config, logger)
+	require.NoError(t, err)
+
+	writer.WriteOne(t.Context(), &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: "imported_items",
+		Value:      3,
+		Unit:       metric.UnitCount,
+	})
+
+	flusher, ok := writer.(metric.Flusher)
+	require.True(t, ok)
+	require.NoError(t, flusher.Flush(t.Context()))
+
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/metrics/job/import/env/test", path)
+	assert.NotEmpty(t, body)
+}
+
+func TestPushgatewayWriter_FlushFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	config := cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":       "test",
+			"name":      "import",
+			"namespace": "{app.env}",
+		},
+		"metric": map[string]any{
+			"writer_settings": map[string]any{
+				"pushgateway": map[string]any{
+					"url": server.URL,
+				},
+			},
+		},
+	})
+
+	writer, err := metric.NewPushgatewayWriter(t.Context(), config, log.NewLogger())
+	require.NoError(t, err)
+
+	err = writer.(metric.Flusher).Flush(t.Context())
+	assert.ErrorContains(t, err, "can not push metrics to pushgateway")
+}
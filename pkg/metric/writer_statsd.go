@@ -0,0 +1,213 @@
+package metric
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	StatsdTagFormatDatadog = "datadog"
+	StatsdTagFormatNone    = "none"
+)
+
+func init() {
+	RegisterWriterFactory(WriterTypeStatsd, ProvideStatsdWriter)
+}
+
+var (
+	_              Writer = &statsdWriter{}
+	statsdReplacer        = strings.NewReplacer(":", "_", "|", "_", "@", "_", ",", "_", "#", "_", "\n", "_")
+)
+
+// StatsdSettings configures the "statsd" writer, which sends metrics to a StatsD or DogStatsD agent.
+type StatsdSettings struct {
+	Aggregate bool `cfg:"aggregate" default:"false"`
+	// Network is either udp or unixgram to talk to the agent over a unix domain socket.
+	Network string `cfg:"network" default:"udp"`
+	// Address is host:port for udp or the path of the socket for unixgram.
+	Address string `cfg:"address" default:"127.0.0.1:8125"`
+	// Prefix is prepended to every metric name, separated by a dot.
+	Prefix string `cfg:"prefix"`
+	// Tags are added to every metric in addition to its dimensions.
+	Tags map[string]string `cfg:"tags"`
+	// TagFormat is datadog to send dimensions as DogStatsD tags or none for agents not supporting tags.
+	TagFormat     string `cfg:"tag_format" default:"datadog"`
+	MaxPacketSize int    `cfg:"max_packet_size" default:"1432"`
+}
+
+type (
+	statsdWriterCtxKey string
+
+	statsdWriter struct {
+		logger   log.Logger
+		conn     io.Writer
+		settings *StatsdSettings
+	}
+)
+
+// ProvideStatsdWriter provides a statsd writer. If one is registered under the default key in
+// the appctx, this is returned, else creates a new one and registers it.
+func ProvideStatsdWriter(ctx context.Context, config cfg.Config, logger log.Logger) (Writer, error) {
+	return appctx.Provide(ctx, statsdWriterCtxKey("default"), func() (Writer, error) {
+		return NewStatsdWriter(ctx, config, logger)
+	})
+}
+
+// NewStatsdWriter creates a new statsd metric writer with the settings found at metric.writer_settings.statsd.
+// Metrics are sent fire and forget, multiple metrics are packed into a single packet up to the max packet size.
+// Metrics with Kind "total" are dropped, as the agent can sum over all tags of a metric itself.
+func NewStatsdWriter(_ context.Context, config cfg.Config, logger log.Logger) (Writer, error) {
+	var err error
+	var settings *StatsdSettings
+	var conn net.Conn
+
+	if settings, err = getMetricWriterSettings[StatsdSettings](config, WriterTypeStatsd); err != nil {
+		return nil, fmt.Errorf("could not get statsd writer settings: %w", err)
+	}
+
+	if settings.TagFormat != StatsdTagFormatDatadog && settings.TagFormat != StatsdTagFormatNone {
+		return nil, fmt.Errorf("unknown statsd tag format %q", settings.TagFormat)
+	}
+
+	if conn, err = net.Dial(settings.Network, settings.Address); err != nil {
+		return nil, fmt.Errorf("can not connect to statsd agent at %s://%s: %w", settings.Network, settings.Address, err)
+	}
+
+	return NewStatsdWriterWithInterfaces(logger, conn, settings), nil
+}
+
+// NewStatsdWriterWithInterfaces creates a new statsd metric writer sending every packet with a single write to conn.
+func NewStatsdWriterWithInterfaces(logger log.Logger, conn io.Writer, settings *StatsdSettings) Writer {
+	return &statsdWriter{
+		logger:   logger.WithChannel("metrics"),
+		conn:     conn,
+		settings: settings,
+	}
+}
+
+func (w *statsdWriter) GetPriority() int {
+	return PriorityLow
+}
+
+func (w *statsdWriter) WriteOne(ctx context.Context, data *Datum) {
+	w.Write(ctx, Data{data})
+}
+
+func (w *statsdWriter) Write(ctx context.Context, batch Data) {
+	if len(batch) == 0 {
+		return
+	}
+
+	packet := &bytes.Buffer{}
+	written := 0
+
+	for _, datum := range batch {
+		amendFromDefault(datum)
+
+		if datum.Priority < w.GetPriority() || datum.Kind.kind == KindTotal.kind {
+			continue
+		}
+
+		for _, line := range w.format(datum) {
+			if packet.Len() > 0 && packet.Len()+1+len(line) > w.settings.MaxPacketSize {
+				w.send(ctx, packet)
+			}
+
+			if packet.Len() > 0 {
+				packet.WriteByte('\n')
+			}
+
+			packet.WriteString(line)
+		}
+
+		written++
+	}
+
+	if packet.Len() > 0 {
+		w.send(ctx, packet)
+	}
+
+	w.logger.Debug(ctx, "written %d metric data sets to statsd", written)
+}
+
+func (w *statsdWriter) send(ctx context.Context, packet *bytes.Buffer) {
+	if _, err := w.conn.Write(packet.Bytes()); err != nil {
+		w.logger.Warn(ctx, "could not send metrics to statsd agent: %s", err.Error())
+	}
+
+	packet.Reset()
+}
+
+// format renders a datum as statsd lines like name:value|type|#tag:value. Counters and gauges keep their types,
+// time based summaries and histograms are sent as timings in milliseconds and all other histograms as DogStatsD
+// histograms. Every value of a distribution is sent as its own line.
+func (w *statsdWriter) format(datum *Datum) []string {
+	name := statsdReplacer.Replace(datum.MetricName)
+	if w.settings.Prefix != "" {
+		name = w.settings.Prefix + "." + name
+	}
+
+	values := []float64{datum.Value}
+	factor := 1.0
+	var typ string
+
+	switch effectiveKind(datum) {
+	case kindCounter:
+		typ = "c"
+	case kindHistogram, kindSummary:
+		values = datum.Observations()
+
+		switch datum.Unit {
+		case UnitSeconds:
+			typ, factor = "ms", 1000
+		case UnitMilliseconds:
+			typ = "ms"
+		default:
+			typ = "h"
+		}
+	default:
+		typ = "g"
+	}
+
+	suffix := "|" + typ
+	if w.settings.TagFormat != StatsdTagFormatNone {
+		if tags := w.tags(datum.Dimensions); tags != "" {
+			suffix += "|#" + tags
+		}
+	}
+
+	lines := make([]string, len(values))
+	for i, value := range values {
+		lines[i] = name + ":" + strconv.FormatFloat(value*factor, 'f', -1, 64) + suffix
+	}
+
+	return lines
+}
+
+func (w *statsdWriter) tags(dimensions Dimensions) string {
+	tags := make([]string, 0, len(w.settings.Tags)+len(dimensions))
+
+	for key, value := range w.settings.Tags {
+		if _, ok := dimensions[key]; !ok {
+			tags = append(tags, statsdReplacer.Replace(key)+":"+statsdReplacer.Replace(value))
+		}
+	}
+
+	for key, value := range dimensions {
+		tags = append(tags, statsdReplacer.Replace(key)+":"+statsdReplacer.Replace(value))
+	}
+
+	sort.Strings(tags)
+
+	return strings.Join(tags, ",")
+}
@@ -0,0 +1,85 @@
+package metric_test
+
+import (
+	"testing"
+
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/stretchr/testify/assert"
+)
+
+type statsdPackets struct {
+	packets []string
+}
+
+func (p *statsdPackets) Write(b []byte) (int, error) {
+	p.packets = append(p.packets, string(b))
+
+	return len(b), nil
+}
+
+func TestStatsdWriter_Write(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	conn := &statsdPackets{}
+	writer := metric.NewStatsdWriterWithInterfaces(logger, conn, &metric.StatsdSettings{
+		Prefix:        "app",
+		Tags:          map[string]string{"env": "test"},
+		TagFormat:     metric.StatsdTagFormatDatadog,
+		MaxPacketSize: 100,
+	})
+
+	writer.Write(t.Context(), metric.Data{
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: "ApiRequestCount",
+			Dimensions: metric.Dimensions{"path": "/v1:users"},
+			Value:      2,
+			Unit:       metric.UnitCount,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: "ApiRequestCount",
+			Value:      2,
+			Unit:       metric.UnitCount,
+			Kind:       metric.KindTotal,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: "ApiRequestResponseTime",
+			Value:      0.25,
+			Unit:       metric.UnitSeconds,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: "QueueLength",
+			Dimensions: metric.Dimensions{"env": "override"},
+			Value:      7,
+			Unit:       metric.StandardUnit("None"),
+		},
+	})
+
+	assert.Equal(t, []string{
+		"app.ApiRequestCount:2|c|#env:test,path:/v1_users\napp.ApiRequestResponseTime:250|ms|#env:test",
+		"app.QueueLength:7|g|#env:override",
+	}, conn.packets)
+}
+
+func TestStatsdWriter_WriteWithoutTags(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	conn := &statsdPackets{}
+	writer := metric.NewStatsdWriterWithInterfaces(logger, conn, &metric.StatsdSettings{
+		TagFormat:     metric.StatsdTagFormatNone,
+		MaxPacketSize: 1432,
+	})
+
+	writer.WriteOne(t.Context(), &metric.Datum{
+		Priority:   metric.PriorityHigh,
+		MetricName: "Latency",
+		Dimensions: metric.Dimensions{"path": "/"},
+		Value:      3,
+		Unit:       metric.StandardUnit("None"),
+		Kind:       metric.KindHistogram.Build(),
+	})
+
+	assert.Equal(t, []string{"Latency:3|h"}, conn.packets)
+}
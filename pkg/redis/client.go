@@ -11,6 +11,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/exec"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/reslife"
+	"github.com/justtrackio/gosoline/pkg/tracing"
 	baseRedis "github.com/redis/go-redis/v9"
 )
 
@@ -77,6 +78,9 @@ type Client interface {
 	MSet(ctx context.Context, pairs ...any) error
 	Set(ctx context.Context, key string, value any, ttl time.Duration) error
 	SetNX(ctx context.Context, key string, value any, ttl time.Duration) (bool, error)
+	// TTL returns the remaining time to live of the key, a negative duration if the key has no expiration (-1)
+	// or doesn't exist (-2).
+	TTL(ctx context.Context, key string) (time.Duration, error)
 
 	BLPop(ctx context.Context, timeout time.Duration, keys ...string) ([]string, error)
 	LPop(ctx context.Context, key string) (string, error)
@@ -136,6 +140,9 @@ type Client interface {
 	ZRem(ctx context.Context, key string, members ...string) (int64, error)
 	ZRevRank(ctx context.Context, key string, member string) (int64, error)
 
+	// Eval runs a lua script atomically. The keys are prefixed like the keys of all other commands.
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, error)
+
 	IsAlive(ctx context.Context) bool
 
 	Pipeline() Pipeliner
@@ -145,6 +152,7 @@ type redisClient struct {
 	base      baseRedis.Cmdable
 	logger    log.Logger
 	executor  exec.Executor
+	tracer    tracing.Tracer
 	settings  *Settings
 	keyPrefix string
 }
@@ -192,6 +200,13 @@ func NewClientWithSettings(ctx context.Context, config cfg.Config, logger log.Lo
 
 	executor := NewExecutor(logger, settings.BackoffSettings, settings.Name)
 
+	var tracer tracing.Tracer = tracing.NewNoopTracer()
+	if settings.Tracing.Enabled {
+		if tracer, err = tracing.ProvideTracer(ctx, config, logger); err != nil {
+			return nil, fmt.Errorf("can not create tracer: %w", err)
+		}
+	}
+
 	if _, ok := dialers[settings.Dialer]; !ok {
 		return nil, fmt.Errorf("there is no redis dialer of type %s", settings.Dialer)
 	}
@@ -208,14 +223,22 @@ func NewClientWithSettings(ctx context.Context, config cfg.Config, logger log.Lo
 		return nil, err
 	}
 
-	return NewClientWithInterfaces(logger, baseClient, executor, settings, keyPrefix), nil
+	return NewClientWithInterfaces(logger, baseClient, executor, tracer, settings, keyPrefix), nil
 }
 
-func NewClientWithInterfaces(logger log.Logger, baseRedis baseRedis.Cmdable, executor exec.Executor, settings *Settings, keyPrefix string) Client {
+func NewClientWithInterfaces(
+	logger log.Logger,
+	baseRedis baseRedis.Cmdable,
+	executor exec.Executor,
+	tracer tracing.Tracer,
+	settings *Settings,
+	keyPrefix string,
+) Client {
 	return &redisClient{
 		logger:    logger,
 		base:      baseRedis,
 		executor:  executor,
+		tracer:    tracer,
 		settings:  settings,
 		keyPrefix: keyPrefix,
 	}
@@ -276,7 +299,11 @@ func (c *redisClient) MSet(ctx context.Context, pairs ...any) error {
 			return nil, err
 		}
 
-		return c.base.MSet(ctx, prefixedPairs...), nil
+		cmder := c.trace(ctx, len(prefixedPairs)/2, func() ErrCmder {
+			return c.base.MSet(ctx, prefixedPairs...)
+		})
+
+		return cmder, nil
 	})
 
 	return err
@@ -609,6 +636,14 @@ func (c *redisClient) Expire(ctx context.Context, key string, ttl time.Duration)
 	return cmd.(*baseRedis.BoolCmd).Val(), err
 }
 
+func (c *redisClient) TTL(ctx context.Context, key string) (time.Duration, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(key string) ErrCmder {
+		return c.base.TTL(ctx, key)
+	}, key)
+
+	return cmd.(*baseRedis.DurationCmd).Val(), err
+}
+
 func (c *redisClient) PFAdd(ctx context.Context, key string, els ...any) (int64, error) {
 	cmd, err := c.executePrefixedKey(ctx, func(key string) ErrCmder {
 		return c.base.PFAdd(ctx, key, els...)
@@ -793,6 +828,14 @@ func (c *redisClient) IsAlive(ctx context.Context) bool {
 	return alive && err == nil
 }
 
+func (c *redisClient) Eval(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+	cmd, err := c.executePrefixed(ctx, func(keys ...string) ErrCmder {
+		return c.base.Eval(ctx, script, keys, args...)
+	}, keys...)
+
+	return cmd.(*baseRedis.Cmd).Val(), err
+}
+
 func (c *redisClient) GetDel(ctx context.Context, key string) (any, error) {
 	cmd, err := c.executePrefixedKey(ctx, func(key string) ErrCmder {
 		return c.base.GetDel(ctx, key)
@@ -818,7 +861,7 @@ func (c *redisClient) Pipeline() Pipeliner {
 
 func (c *redisClient) execute(ctx context.Context, wrappedCmd func() ErrCmder) (any, error) {
 	return c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		cmder := wrappedCmd()
+		cmder := c.trace(ctx, 0, wrappedCmd)
 
 		return cmder, cmder.Err()
 	})
@@ -872,7 +915,9 @@ func (c *redisClient) executePrefixedKey(ctx context.Context, wrappedCmd func(pr
 			return nil, err
 		}
 
-		cmder := wrappedCmd(prefixedKey)
+		cmder := c.trace(ctx, 1, func() ErrCmder {
+			return wrappedCmd(prefixedKey)
+		})
 
 		return cmder, cmder.Err()
 	})
@@ -885,7 +930,9 @@ func (c *redisClient) executePrefixed(ctx context.Context, wrappedCmd func(prefi
 			return nil, err
 		}
 
-		cmder := wrappedCmd(prefixedKeys...)
+		cmder := c.trace(ctx, len(prefixedKeys), func() ErrCmder {
+			return wrappedCmd(prefixedKeys...)
+		})
 
 		return cmder, cmder.Err()
 	})
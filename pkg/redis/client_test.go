@@ -13,6 +13,8 @@ import (
 	"github.com/justtrackio/gosoline/pkg/exec"
 	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
 	"github.com/justtrackio/gosoline/pkg/redis"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	tracingMocks "github.com/justtrackio/gosoline/pkg/tracing/mocks"
 	baseRedis "github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -48,7 +50,7 @@ func (s *ClientWithMiniRedisTestSuite) SetupTest() {
 	})
 
 	s.server = server
-	s.client = redis.NewClientWithInterfaces(s.logger, s.baseClient, executor, s.settings, "")
+	s.client = redis.NewClientWithInterfaces(s.logger, s.baseClient, executor, tracing.NewNoopTracer(), s.settings, "")
 }
 
 func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_InvalidPattern_MissingKey() {
@@ -91,7 +93,7 @@ func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_FormatError() {
 func (s *ClientWithMiniRedisTestSuite) newPrefixedClient() redis.Client {
 	executor := exec.NewDefaultExecutor()
 
-	return redis.NewClientWithInterfaces(s.logger, s.baseClient, executor, s.settings, "my-prefix-")
+	return redis.NewClientWithInterfaces(s.logger, s.baseClient, executor, tracing.NewNoopTracer(), s.settings, "my-prefix-")
 }
 
 func (s *ClientWithMiniRedisTestSuite) TestClient_Prefixing() {
@@ -1067,7 +1069,7 @@ func (s *ClientWithMiniRedisTestSuite) TestGetNotFound() {
 		MaxInterval:     time.Second * 3,
 		MaxElapsedTime:  0,
 	}, "test")
-	s.client = redis.NewClientWithInterfaces(logger, s.baseClient, executor, s.settings, "")
+	s.client = redis.NewClientWithInterfaces(logger, s.baseClient, executor, tracing.NewNoopTracer(), s.settings, "")
 
 	res, err := s.client.Get(s.T().Context(), "missing")
 
@@ -1131,6 +1133,24 @@ func (s *ClientWithMiniRedisTestSuite) TestSet() {
 	s.NoError(err, "there should be no error on Set with expiration date")
 }
 
+func (s *ClientWithMiniRedisTestSuite) TestTTL() {
+	ctx := s.T().Context()
+
+	ttl, err := s.client.TTL(ctx, "missing")
+	s.NoError(err)
+	s.Equal(time.Duration(-2), ttl)
+
+	s.NoError(s.client.Set(ctx, "key", "value", 0))
+	ttl, err = s.client.TTL(ctx, "key")
+	s.NoError(err)
+	s.Equal(time.Duration(-1), ttl)
+
+	s.NoError(s.client.Set(ctx, "key", "value", time.Minute))
+	ttl, err = s.client.TTL(ctx, "key")
+	s.NoError(err)
+	s.Equal(time.Minute, ttl)
+}
+
 func (s *ClientWithMiniRedisTestSuite) TestHSet() {
 	err := s.client.HSet(s.T().Context(), "key", "field", "value")
 	s.NoError(err, "there should be no error on HSet")
@@ -1188,6 +1208,25 @@ func (s *ClientWithMiniRedisTestSuite) TestGetDel() {
 	s.Equal(redis.Nil, err)
 }
 
+func (s *ClientWithMiniRedisTestSuite) TestEval() {
+	script := `if redis.call('GET', KEYS[1]) == ARGV[1] then redis.call('SET', KEYS[1], ARGV[2]) return 1 end return 0`
+
+	err := s.client.Set(s.T().Context(), "key", "value1", 0)
+	s.NoError(err, "there should be no error on Set")
+
+	res, err := s.client.Eval(s.T().Context(), script, []string{"key"}, "value1", "value2")
+	s.NoError(err, "there should be no error on Eval")
+	s.Equal(int64(1), res)
+
+	res, err = s.client.Eval(s.T().Context(), script, []string{"key"}, "value1", "value3")
+	s.NoError(err, "there should be no error on Eval")
+	s.Equal(int64(0), res)
+
+	val, err := s.client.Get(s.T().Context(), "key")
+	s.NoError(err, "there should be no error on Get")
+	s.Equal("value2", val)
+}
+
 func (s *ClientWithMiniRedisTestSuite) TestGetSet() {
 	val, err := s.client.GetSet(s.T().Context(), "key", "value1")
 	s.Equal(redis.Nil, err)
@@ -1739,7 +1778,7 @@ func (s *ClientWithMockTestSuite) SetupTest() {
 	executor := redis.NewBackoffExecutor(logger, settings.BackoffSettings, "test")
 
 	s.redisMock = redismock.NewMock()
-	s.client = redis.NewClientWithInterfaces(logger, s.redisMock, executor, settings, "")
+	s.client = redis.NewClientWithInterfaces(logger, s.redisMock, executor, tracing.NewNoopTracer(), settings, "")
 }
 
 func (s *ClientWithMockTestSuite) TestSetWithOOM() {
@@ -1795,7 +1834,7 @@ func (s *ClientWithMockTestSuite) TestPrefixing_PFAddCountMerge() {
 	settings := &redis.Settings{}
 	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
 	executor := redis.NewBackoffExecutor(logger, settings.BackoffSettings, "test")
-	prefixedClient := redis.NewClientWithInterfaces(logger, s.redisMock, executor, settings, "pfx-")
+	prefixedClient := redis.NewClientWithInterfaces(logger, s.redisMock, executor, tracing.NewNoopTracer(), settings, "pfx-")
 
 	// Expect all keys to have prefix
 	s.redisMock.On("PFAdd", s.T().Context(), "pfx-hll1", []any{"a", "b"}).Return(baseRedis.NewIntResult(1, nil)).Once()
@@ -1824,3 +1863,38 @@ func (s *ClientWithMockTestSuite) TestPrefixing_PFAddCountMerge() {
 func TestClientWithMockTestSuite(t *testing.T) {
 	suite.Run(t, new(ClientWithMockTestSuite))
 }
+
+func TestClient_Tracing(t *testing.T) {
+	server := miniredis.RunT(t)
+	baseClient := baseRedis.NewClient(&baseRedis.Options{
+		Addr: server.Addr(),
+	})
+
+	settings := &redis.Settings{
+		Name: "cache",
+		Tracing: redis.TracingSettings{
+			Enabled: true,
+		},
+	}
+
+	span := tracingMocks.NewSpan(t)
+	span.EXPECT().AddAnnotation("redis.command", "exists").Once()
+	span.EXPECT().AddMetadata("redis.key_count", 2).Once()
+	span.EXPECT().Finish().Once()
+
+	ctx := tracing.ContextWithSpan(t.Context(), span)
+
+	tracer := tracingMocks.NewTracer(t)
+	tracer.EXPECT().StartSubSpan(mock.Anything, "redis-cache").Return(ctx, span).Once()
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := redis.NewClientWithInterfaces(logger, baseClient, exec.NewDefaultExecutor(), tracer, settings, "")
+
+	count, err := client.Exists(ctx, "a", "b")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	// commands without a span in their context are not traced
+	_, err = client.Exists(t.Context(), "a")
+	assert.NoError(t, err)
+}
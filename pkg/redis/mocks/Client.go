@@ -340,6 +340,77 @@ func (_c *Client_Del_Call) RunAndReturn(run func(context.Context, ...string) (in
 	return _c
 }
 
+// Eval provides a mock function with given fields: ctx, script, keys, args
+func (_m *Client) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, script, keys)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Eval")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, ...interface{}) (interface{}, error)); ok {
+		return rf(ctx, script, keys, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, ...interface{}) interface{}); ok {
+		r0 = rf(ctx, script, keys, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, ...interface{}) error); ok {
+		r1 = rf(ctx, script, keys, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_Eval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Eval'
+type Client_Eval_Call struct {
+	*mock.Call
+}
+
+// Eval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - script string
+//   - keys []string
+//   - args ...interface{}
+func (_e *Client_Expecter) Eval(ctx interface{}, script interface{}, keys interface{}, args ...interface{}) *Client_Eval_Call {
+	return &Client_Eval_Call{Call: _e.mock.On("Eval",
+		append([]interface{}{ctx, script, keys}, args...)...)}
+}
+
+func (_c *Client_Eval_Call) Run(run func(ctx context.Context, script string, keys []string, args ...interface{})) *Client_Eval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_Eval_Call) Return(_a0 interface{}, _a1 error) *Client_Eval_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_Eval_Call) RunAndReturn(run func(context.Context, string, []string, ...interface{}) (interface{}, error)) *Client_Eval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Exists provides a mock function with given fields: ctx, keys
 func (_m *Client) Exists(ctx context.Context, keys ...string) (int64, error) {
 	_va := make([]interface{}, len(keys))
@@ -3178,6 +3249,63 @@ func (_c *Client_SetNX_Call) RunAndReturn(run func(context.Context, string, inte
 	return _c
 }
 
+// TTL provides a mock function with given fields: ctx, key
+func (_m *Client) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TTL")
+	}
+
+	var r0 time.Duration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (time.Duration, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Duration); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_TTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TTL'
+type Client_TTL_Call struct {
+	*mock.Call
+}
+
+// TTL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *Client_Expecter) TTL(ctx interface{}, key interface{}) *Client_TTL_Call {
+	return &Client_TTL_Call{Call: _e.mock.On("TTL", ctx, key)}
+}
+
+func (_c *Client_TTL_Call) Run(run func(ctx context.Context, key string)) *Client_TTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Client_TTL_Call) Return(_a0 time.Duration, _a1 error) *Client_TTL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_TTL_Call) RunAndReturn(run func(context.Context, string) (time.Duration, error)) *Client_TTL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ZAdd provides a mock function with given fields: ctx, key, score, member
 func (_m *Client) ZAdd(ctx context.Context, key string, score float64, member string) (int64, error) {
 	ret := _m.Called(ctx, key, score, member)
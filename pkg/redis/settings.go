@@ -14,13 +14,19 @@ type Naming struct {
 	KeyDelimiter     string `cfg:"key_delimiter,nodecode" default:"-"`
 }
 
+type TracingSettings struct {
+	// Enabled records every command as sub span of the span found in the context of the command.
+	Enabled bool `cfg:"enabled" default:"false"`
+}
+
 type Settings struct {
 	cfg.Identity
-	DB              int    `cfg:"db" default:"0"`
-	Name            string `cfg:"name"`
-	Dialer          string `cfg:"dialer" default:"tcp"`
-	Address         string `cfg:"address" default:"127.0.0.1:6379"`
-	Naming          Naming `cfg:"naming"`
+	DB              int             `cfg:"db" default:"0"`
+	Name            string          `cfg:"name"`
+	Dialer          string          `cfg:"dialer" default:"tcp"`
+	Address         string          `cfg:"address" default:"127.0.0.1:6379"`
+	Naming          Naming          `cfg:"naming"`
+	Tracing         TracingSettings `cfg:"tracing"`
 	BackoffSettings exec.BackoffSettings
 }
 
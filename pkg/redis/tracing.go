@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	baseRedis "github.com/redis/go-redis/v9"
+)
+
+// trace runs the command within a sub span of the span found in the context. The span carries the command name and
+// the number of keys the command operates on. Commands without a span in their context are not traced, as they would
+// only create orphaned spans.
+func (c *redisClient) trace(ctx context.Context, keyCount int, wrappedCmd func() ErrCmder) ErrCmder {
+	if !c.settings.Tracing.Enabled || tracing.GetSpanFromContext(ctx) == nil {
+		return wrappedCmd()
+	}
+
+	_, span := c.tracer.StartSubSpan(ctx, fmt.Sprintf("redis-%s", c.settings.Name))
+	defer span.Finish()
+
+	cmder := wrappedCmd()
+
+	if cmd, ok := cmder.(baseRedis.Cmder); ok {
+		span.AddAnnotation("redis.command", cmd.Name())
+	}
+
+	span.AddMetadata("redis.key_count", keyCount)
+
+	// a missing key is a valid result and not an error of the command
+	if err := cmder.Err(); err != nil && !errors.Is(err, Nil) {
+		span.AddError(err)
+	}
+
+	return cmder
+}
@@ -0,0 +1,198 @@
+package refl
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// FieldChange describes a field with different values in the compared structs.
+type FieldChange struct {
+	// Path of the field, see StructField.Path.
+	Path string
+	Old  any
+	New  any
+}
+
+type FieldChanges []FieldChange
+
+// Paths returns the paths of all changed fields.
+func (c FieldChanges) Paths() []string {
+	paths := make([]string, len(c))
+
+	for i, change := range c {
+		paths[i] = change.Path
+	}
+
+	return paths
+}
+
+// Has reports if the field with the given path changed.
+func (c FieldChanges) Has(path string) bool {
+	return slices.ContainsFunc(c, func(change FieldChange) bool {
+		return change.Path == path
+	})
+}
+
+type diffSettings struct {
+	pathTag string
+	ignored []string
+}
+
+type DiffOption func(settings *diffSettings)
+
+// WithDiffPathTag builds the paths from the names of the given tag (e.g. "json" or "db") instead of the field names.
+// Fields with a "-" tag value are not compared.
+func WithDiffPathTag(key string) DiffOption {
+	return func(settings *diffSettings) {
+		settings.pathTag = key
+	}
+}
+
+// WithDiffIgnoredPaths doesn't compare the fields with the given paths (and their nested fields), e.g. "UpdatedAt".
+func WithDiffIgnoredPaths(paths ...string) DiffOption {
+	return func(settings *diffSettings) {
+		settings.ignored = append(settings.ignored, paths...)
+	}
+}
+
+// Diff compares the exported fields of two structs (or pointers to structs) of the same type and returns the changed
+// fields in the order of their declaration. Nested structs are compared field by field, embedded structs are
+// flattened into the struct embedding them. Slices, maps and structs without exported fields are compared as a whole,
+// values with an Equal method (like time.Time) are compared by it. A nil pointer to a struct is only equal to another
+// nil pointer, a change from or to nil is reported for the pointer field itself.
+func Diff(old any, new any, options ...DiffOption) (FieldChanges, error) {
+	settings := &diffSettings{}
+	for _, opt := range options {
+		opt(settings)
+	}
+
+	oldValue := reflect.Indirect(reflect.ValueOf(old))
+	newValue := reflect.Indirect(reflect.ValueOf(new))
+
+	if oldValue.Kind() != reflect.Struct || newValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("can only diff structs or pointers to structs but got %T and %T", old, new)
+	}
+
+	if oldValue.Type() != newValue.Type() {
+		return nil, fmt.Errorf("can not diff values of different types %T and %T", old, new)
+	}
+
+	d := &differ{
+		settings: settings,
+		changes:  make(FieldChanges, 0),
+	}
+	d.diffStruct(oldValue, newValue, "")
+
+	return d.changes, nil
+}
+
+type differ struct {
+	settings *diffSettings
+	changes  FieldChanges
+}
+
+func (d *differ) diffStruct(oldValue reflect.Value, newValue reflect.Value, prefix string) {
+	typ := oldValue.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			d.diffStruct(oldValue.Field(i), newValue.Field(i), prefix)
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := d.name(field)
+		if !ok {
+			continue
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		if slices.Contains(d.settings.ignored, path) {
+			continue
+		}
+
+		d.diffValue(oldValue.Field(i), newValue.Field(i), path)
+	}
+}
+
+func (d *differ) diffValue(oldValue reflect.Value, newValue reflect.Value, path string) {
+	oldStruct, newStruct := oldValue, newValue
+
+	if oldValue.Kind() == reflect.Ptr && oldValue.Type().Elem().Kind() == reflect.Struct && !oldValue.IsNil() && !newValue.IsNil() {
+		oldStruct, newStruct = oldValue.Elem(), newValue.Elem()
+	}
+
+	if oldStruct.Kind() == reflect.Struct && d.hasExportedFields(oldStruct.Type()) && !d.hasEqual(oldStruct.Type()) {
+		d.diffStruct(oldStruct, newStruct, path)
+
+		return
+	}
+
+	if d.equal(oldValue, newValue) {
+		return
+	}
+
+	d.changes = append(d.changes, FieldChange{
+		Path: path,
+		Old:  oldValue.Interface(),
+		New:  newValue.Interface(),
+	})
+}
+
+func (d *differ) equal(oldValue reflect.Value, newValue reflect.Value) bool {
+	if d.hasEqual(oldValue.Type()) {
+		return oldValue.MethodByName("Equal").Call([]reflect.Value{newValue})[0].Bool()
+	}
+
+	if oldValue.Kind() == reflect.Ptr && !oldValue.IsNil() && !newValue.IsNil() && d.hasEqual(oldValue.Type().Elem()) {
+		return d.equal(oldValue.Elem(), newValue.Elem())
+	}
+
+	return reflect.DeepEqual(oldValue.Interface(), newValue.Interface())
+}
+
+// hasEqual reports if the type has a method like time.Time.Equal.
+func (d *differ) hasEqual(typ reflect.Type) bool {
+	method, ok := typ.MethodByName("Equal")
+
+	return ok && method.Type.NumIn() == 2 && method.Type.In(1) == typ && method.Type.NumOut() == 1 && method.Type.Out(0).Kind() == reflect.Bool
+}
+
+func (d *differ) hasExportedFields(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).IsExported() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *differ) name(field reflect.StructField) (string, bool) {
+	if d.settings.pathTag == "" {
+		return field.Name, true
+	}
+
+	tagName, _, _ := strings.Cut(field.Tag.Get(d.settings.pathTag), ",")
+
+	switch tagName {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	default:
+		return tagName, true
+	}
+}
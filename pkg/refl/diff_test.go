@@ -0,0 +1,111 @@
+package refl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/refl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type diffTimestamps struct {
+	UpdatedAt *time.Time `json:"updatedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type diffAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+type diffModel struct {
+	diffTimestamps
+	Id       uint              `json:"id"`
+	Name     string            `json:"name,omitempty"`
+	Address  diffAddress       `json:"address"`
+	Billing  *diffAddress      `json:"billing"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels"`
+	Password string            `json:"-"`
+	internal string
+}
+
+func newDiffModel() diffModel {
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	return diffModel{
+		diffTimestamps: diffTimestamps{
+			UpdatedAt: mdl.Box(createdAt),
+			CreatedAt: createdAt,
+		},
+		Id:      1,
+		Name:    "foo",
+		Address: diffAddress{City: "Berlin", Zip: "10115"},
+		Billing: &diffAddress{City: "Berlin", Zip: "10115"},
+		Tags:    []string{"a"},
+		Labels:  map[string]string{"a": "b"},
+	}
+}
+
+func TestDiff_Equal(t *testing.T) {
+	old := newDiffModel()
+	new := newDiffModel()
+	new.CreatedAt = new.CreatedAt.In(time.FixedZone("CET", 3600))
+	new.UpdatedAt = mdl.Box(new.UpdatedAt.In(time.FixedZone("CET", 3600)))
+	new.internal = "changed"
+
+	changes, err := refl.Diff(old, &new)
+	require.NoError(t, err)
+	assert.Empty(t, changes, "times should be compared with their Equal method")
+}
+
+func TestDiff_Changes(t *testing.T) {
+	old := newDiffModel()
+	new := newDiffModel()
+	new.UpdatedAt = mdl.Box(new.UpdatedAt.Add(time.Hour))
+	new.Name = "bar"
+	new.Address.Zip = "10117"
+	new.Billing.City = "Hamburg"
+	new.Tags = append(new.Tags, "b")
+	new.Password = "secret"
+
+	changes, err := refl.Diff(&old, &new)
+	require.NoError(t, err)
+
+	assert.Equal(t, refl.FieldChanges{
+		{Path: "UpdatedAt", Old: old.UpdatedAt, New: new.UpdatedAt},
+		{Path: "Name", Old: "foo", New: "bar"},
+		{Path: "Address.Zip", Old: "10115", New: "10117"},
+		{Path: "Billing.City", Old: "Berlin", New: "Hamburg"},
+		{Path: "Tags", Old: []string{"a"}, New: []string{"a", "b"}},
+		{Path: "Password", Old: "", New: "secret"},
+	}, changes)
+}
+
+func TestDiff_PathTagAndIgnoredPaths(t *testing.T) {
+	old := newDiffModel()
+	new := newDiffModel()
+	new.UpdatedAt = nil
+	new.Address.City = "Hamburg"
+	new.Billing = nil
+	new.Labels["a"] = "c"
+	new.Password = "secret"
+
+	changes, err := refl.Diff(old, new, refl.WithDiffPathTag("json"), refl.WithDiffIgnoredPaths("updatedAt"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"address.city", "billing", "labels"}, changes.Paths())
+	assert.True(t, changes.Has("billing"))
+	assert.False(t, changes.Has("password"))
+	assert.Equal(t, refl.FieldChange{Path: "billing", Old: old.Billing, New: (*diffAddress)(nil)}, changes[1])
+}
+
+func TestDiff_Invalid(t *testing.T) {
+	_, err := refl.Diff(diffModel{}, diffAddress{})
+	assert.EqualError(t, err, "can not diff values of different types refl_test.diffModel and refl_test.diffAddress")
+
+	_, err = refl.Diff(1, 2)
+	assert.EqualError(t, err, "can only diff structs or pointers to structs but got int and int")
+}
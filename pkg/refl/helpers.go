@@ -2,6 +2,8 @@ package refl
 
 import (
 	"reflect"
+
+	"github.com/justtrackio/gosoline/pkg/mdl"
 )
 
 func IsStructOrPointerToStruct(value any) bool {
@@ -181,6 +183,11 @@ func CreatePointerToSliceOfTypeAndSize(value any, size int) any {
 	return ptr
 }
 
+// DeepCopy returns a deep copy of the value, see mdl.Clone for the details.
+func DeepCopy(value any) any {
+	return mdl.Clone(value)
+}
+
 func CopyPointerSlice(ptrA any, ptrB any) {
 	pv := reflect.ValueOf(ptrB)
 
@@ -289,3 +289,12 @@ func TestUnbox(t *testing.T) {
 func box[T any](val T) *T {
 	return &val
 }
+
+func TestDeepCopy(t *testing.T) {
+	original := map[string][]int{"a": {1}}
+
+	cloned := refl.DeepCopy(original).(map[string][]int)
+	cloned["a"][0] = 2
+
+	assert.Equal(t, map[string][]int{"a": {1}}, original)
+}
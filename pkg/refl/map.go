@@ -2,6 +2,7 @@ package refl
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
 )
 
@@ -12,31 +13,28 @@ func InterfaceToMapInterfaceInterface(m any) (map[any]any, error) {
 
 	mii := make(map[any]any)
 
-	v := reflect.ValueOf(m)
-
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
-	}
-
-	if v.Kind() != reflect.Map {
-		return mii, fmt.Errorf("value has to be of kind Map but instead is of type %T", m)
+	rm, err := MapOf(m)
+	if err != nil {
+		return mii, err
 	}
 
-	iter := v.MapRange()
-	for iter.Next() {
-		k := iter.Key()
-		v := iter.Value()
-
-		mii[k.Interface()] = v.Interface()
+	for k, v := range rm.All() {
+		mii[k] = v
 	}
 
 	return mii, nil
 }
 
+// MapOf wraps a map (or a pointer to a map) to fill and read it without knowing its key and element types. A nil map is
+// initialized if it is passed as pointer.
 func MapOf(m any) (*Map, error) {
 	mapType := reflect.TypeOf(m)
 	mapValue := reflect.ValueOf(m)
 
+	if mapType == nil {
+		return nil, fmt.Errorf("value has to be of kind Map but instead is nil")
+	}
+
 	if mapType.Kind() == reflect.Ptr {
 		mapType = mapType.Elem()
 		mapValue = mapValue.Elem()
@@ -46,6 +44,10 @@ func MapOf(m any) (*Map, error) {
 		return nil, fmt.Errorf("value has to be of kind Map but instead is of type %T", m)
 	}
 
+	if mapValue.IsNil() && mapValue.CanSet() {
+		mapValue.Set(reflect.MakeMap(mapType))
+	}
+
 	keyType := mapType.Key()
 	elementType := mapType.Elem()
 	elementIsPointer := false
@@ -72,19 +74,65 @@ type Map struct {
 	elementIsPointer bool
 }
 
+// KeyType returns the type of the keys of the map.
+func (m *Map) KeyType() reflect.Type {
+	return m.keyType
+}
+
+// ElementType returns the type of the elements of the map, without the pointer for maps of pointers.
+func (m *Map) ElementType() reflect.Type {
+	return m.elementType
+}
+
+// ElementIsPointer reports if the map holds pointers to its elements.
+func (m *Map) ElementIsPointer() bool {
+	return m.elementIsPointer
+}
+
+func (m *Map) Len() int {
+	return m.mapValue.Len()
+}
+
+// NewElement returns a pointer to a new zero element, which can be filled and passed to Set.
 func (m *Map) NewElement() any {
 	return reflect.New(m.elementType).Interface()
 }
 
+// Get returns the element stored for the key as it is stored in the map, i.e. as pointer for maps of pointers.
+func (m *Map) Get(key any) (any, bool) {
+	keyValue := reflect.ValueOf(key)
+
+	if !keyValue.IsValid() || !keyValue.Type().AssignableTo(m.keyType) {
+		return nil, false
+	}
+
+	value := m.mapValue.MapIndex(keyValue)
+	if !value.IsValid() {
+		return nil, false
+	}
+
+	return value.Interface(), true
+}
+
+// Set stores the value for the key. The value can be an element or a pointer to it, e.g. one created by NewElement, as
+// long as the map doesn't hold pointers.
 func (m *Map) Set(key any, value any) error {
 	keyValue := reflect.ValueOf(key)
 
-	if keyValue.Type() != m.keyType {
-		return fmt.Errorf("provided key should be of type %v but instead is %v", m.keyType, keyValue.Type())
+	if !keyValue.IsValid() || !keyValue.Type().AssignableTo(m.keyType) {
+		return fmt.Errorf("provided key should be of type %v but instead is %T", m.keyType, key)
+	}
+
+	if m.mapValue.IsNil() {
+		return fmt.Errorf("can not set a value on a nil map, pass a pointer to the map instead")
 	}
 
 	valueValue := reflect.ValueOf(value)
 
+	if m.elementIsPointer && valueValue.Kind() != reflect.Ptr {
+		return fmt.Errorf("the value which you try to set on the map has to be addressable")
+	}
+
 	if !m.elementIsPointer {
 		valueValue = reflect.Indirect(valueValue)
 	}
@@ -93,3 +141,16 @@ func (m *Map) Set(key any, value any) error {
 
 	return nil
 }
+
+// All iterates over the keys and elements of the map in no particular order.
+func (m *Map) All() iter.Seq2[any, any] {
+	return func(yield func(any, any) bool) {
+		it := m.mapValue.MapRange()
+
+		for it.Next() {
+			if !yield(it.Key().Interface(), it.Value().Interface()) {
+				return
+			}
+		}
+	}
+}
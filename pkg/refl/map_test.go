@@ -1,6 +1,7 @@
 package refl_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/justtrackio/gosoline/pkg/refl"
@@ -74,3 +75,77 @@ func TestMapOfPointer(t *testing.T) {
 	assert.Equal(t, "foo", items[3].Value)
 	assert.Equal(t, "bar", items[5].Value)
 }
+
+func TestMapOfNilPointer(t *testing.T) {
+	var items map[string]*Item
+	m, err := refl.MapOf(&items)
+	assert.NoError(t, err)
+
+	assert.Equal(t, reflect.TypeOf(""), m.KeyType())
+	assert.Equal(t, reflect.TypeOf(Item{}), m.ElementType())
+	assert.True(t, m.ElementIsPointer())
+
+	err = m.Set("foo", Item{Value: "foo"})
+	assert.EqualError(t, err, "the value which you try to set on the map has to be addressable")
+
+	err = m.Set(1, &Item{Value: "foo"})
+	assert.EqualError(t, err, "provided key should be of type string but instead is int")
+
+	err = m.Set("foo", &Item{Value: "foo"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]*Item{"foo": {Value: "foo"}}, items)
+}
+
+func TestMapOfNil(t *testing.T) {
+	var items map[string]Item
+	m, err := refl.MapOf(items)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, m.Len())
+
+	err = m.Set("foo", Item{Value: "foo"})
+	assert.EqualError(t, err, "can not set a value on a nil map, pass a pointer to the map instead")
+
+	_, err = refl.MapOf(nil)
+	assert.EqualError(t, err, "value has to be of kind Map but instead is nil")
+
+	_, err = refl.MapOf([]Item{})
+	assert.EqualError(t, err, "value has to be of kind Map but instead is of type []refl_test.Item")
+}
+
+func TestMapOfGetAndAll(t *testing.T) {
+	items := map[int]Item{
+		1: {Value: "foo"},
+		2: {Value: "bar"},
+	}
+	m, err := refl.MapOf(items)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, m.Len())
+	assert.False(t, m.ElementIsPointer())
+
+	value, ok := m.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, Item{Value: "foo"}, value)
+
+	_, ok = m.Get(3)
+	assert.False(t, ok)
+
+	_, ok = m.Get("1")
+	assert.False(t, ok)
+
+	all := map[any]any{}
+	for k, v := range m.All() {
+		all[k] = v
+	}
+
+	assert.Equal(t, map[any]any{1: Item{Value: "foo"}, 2: Item{Value: "bar"}}, all)
+
+	count := 0
+	for range m.All() {
+		count++
+
+		break
+	}
+
+	assert.Equal(t, 1, count)
+}
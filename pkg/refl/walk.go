@@ -0,0 +1,226 @@
+package refl
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"reflect"
+	"strings"
+)
+
+// StructField is a field visited by WalkStruct.
+type StructField struct {
+	// Path of the field, the names of the fields from the walked struct to the field joined by dots, e.g. "Server.Port".
+	// The names of embedded structs are not part of the path.
+	Path  string
+	Field reflect.StructField
+	// Value of the field. It is settable if the walked struct was passed as pointer.
+	Value reflect.Value
+}
+
+// Tag returns the value of the tag with the given key.
+func (f StructField) Tag(key string) (string, bool) {
+	return f.Field.Tag.Lookup(key)
+}
+
+// TagName returns the value of the tag with the given key until the first comma, e.g. the name of a json or cfg tag.
+func (f StructField) TagName(key string) string {
+	tag := f.Field.Tag.Get(key)
+	name, _, _ := strings.Cut(tag, ",")
+
+	return name
+}
+
+type walkSettings struct {
+	tagKeys          []string
+	pathTag          string
+	allocatePointers bool
+}
+
+type WalkOption func(settings *walkSettings)
+
+// WithWalkTagFilter only yields the fields having at least one of the tags. Fields with a "-" tag value are skipped
+// together with their nested fields. Fields without the tags are still walked into.
+func WithWalkTagFilter(keys ...string) WalkOption {
+	return func(settings *walkSettings) {
+		settings.tagKeys = append(settings.tagKeys, keys...)
+	}
+}
+
+// WithWalkPathTag builds the paths from the names of the given tag (e.g. "cfg" or "json") instead of the field names.
+// Fields without the tag keep their field name.
+func WithWalkPathTag(key string) WalkOption {
+	return func(settings *walkSettings) {
+		settings.pathTag = key
+	}
+}
+
+// WithWalkAllocatePointers allocates nil pointers to structs to walk into them. This requires the walked struct to be
+// passed as pointer, pointers to recursive types are allocated only once per path.
+func WithWalkAllocatePointers() WalkOption {
+	return func(settings *walkSettings) {
+		settings.allocatePointers = true
+	}
+}
+
+// WalkStruct returns an iterator over all exported fields of a struct or a pointer to a struct, depth first. A field
+// holding a struct or a pointer to a struct is yielded before its nested fields, embedded structs are flattened into the
+// struct embedding them. Nil pointers are not walked into unless WithWalkAllocatePointers is used, slices and maps are
+// never walked into.
+func WalkStruct(value any, options ...WalkOption) (iter.Seq[StructField], error) {
+	settings := &walkSettings{}
+	for _, opt := range options {
+		opt(settings)
+	}
+
+	rv := reflect.ValueOf(value)
+	pointers := map[walkPointer]bool{}
+
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("can not walk a nil %T", value)
+		}
+
+		pointers[walkPointer{typ: rv.Type(), ptr: rv.Pointer()}] = true
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("value has to be a struct or a pointer to a struct but instead is of type %T", value)
+	}
+
+	return func(yield func(StructField) bool) {
+		w := &walker{
+			settings: settings,
+			yield:    yield,
+			walking:  map[reflect.Type]int{},
+			pointers: maps.Clone(pointers),
+		}
+
+		w.walk(rv, "")
+	}, nil
+}
+
+type walkPointer struct {
+	typ reflect.Type
+	ptr uintptr
+}
+
+type walker struct {
+	settings *walkSettings
+	yield    func(StructField) bool
+	// walking counts the struct types on the current path, pointers holds the pointers followed on it. Both prevent
+	// endless walks through recursive types.
+	walking  map[reflect.Type]int
+	pointers map[walkPointer]bool
+}
+
+// walk returns false as soon as the iteration was stopped by the consumer.
+func (w *walker) walk(rv reflect.Value, prefix string) bool {
+	typ := rv.Type()
+
+	w.walking[typ]++
+	defer func() {
+		w.walking[typ]--
+	}()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		// the exported fields of embedded structs are promoted even if the type of the struct is unexported
+		if !field.IsExported() && !(field.Anonymous && field.Type.Kind() == reflect.Struct) {
+			continue
+		}
+
+		if w.isSkipped(field) {
+			continue
+		}
+
+		fieldValue := rv.Field(i)
+		path := prefix
+
+		if !field.Anonymous {
+			path = w.path(prefix, field)
+		}
+
+		if !field.Anonymous && w.isYielded(field) {
+			if !w.yield(StructField{Path: path, Field: field, Value: fieldValue}) {
+				return false
+			}
+		}
+
+		if !w.walkNested(fieldValue, path) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (w *walker) walkNested(rv reflect.Value, path string) bool {
+	if rv.Kind() == reflect.Struct {
+		return w.walk(rv, path)
+	}
+
+	if rv.Kind() != reflect.Ptr || rv.Type().Elem().Kind() != reflect.Struct {
+		return true
+	}
+
+	if rv.IsNil() {
+		if !w.settings.allocatePointers || !rv.CanSet() || w.walking[rv.Type().Elem()] > 1 {
+			return true
+		}
+
+		rv.Set(reflect.New(rv.Type().Elem()))
+	}
+
+	pointer := walkPointer{typ: rv.Type(), ptr: rv.Pointer()}
+	if w.pointers[pointer] {
+		return true
+	}
+
+	w.pointers[pointer] = true
+	defer delete(w.pointers, pointer)
+
+	return w.walk(rv.Elem(), path)
+}
+
+func (w *walker) isSkipped(field reflect.StructField) bool {
+	for _, key := range w.settings.tagKeys {
+		if tag, ok := field.Tag.Lookup(key); ok && tag == "-" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *walker) isYielded(field reflect.StructField) bool {
+	if len(w.settings.tagKeys) == 0 {
+		return true
+	}
+
+	for _, key := range w.settings.tagKeys {
+		if _, ok := field.Tag.Lookup(key); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *walker) path(prefix string, field reflect.StructField) string {
+	name := field.Name
+
+	if w.settings.pathTag != "" {
+		if tagName, _, _ := strings.Cut(field.Tag.Get(w.settings.pathTag), ","); tagName != "" && tagName != "-" {
+			name = tagName
+		}
+	}
+
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
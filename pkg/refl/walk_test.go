@@ -0,0 +1,153 @@
+package refl_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/refl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type walkBase struct {
+	Id      int       `json:"id"`
+	Created time.Time `json:"created"`
+}
+
+type walkCredentials struct {
+	User     string `cfg:"user"`
+	Password string `cfg:"password" redact:"true"`
+}
+
+type walkSettings struct {
+	walkBase
+	Name        string           `cfg:"name" json:"name,omitempty"`
+	Credentials walkCredentials  `cfg:"credentials"`
+	Fallback    *walkCredentials `cfg:"fallback"`
+	Ignored     walkCredentials  `cfg:"-"`
+	Tags        []string         `cfg:"tags"`
+	internal    string
+}
+
+type walkNode struct {
+	Value int
+	Next  *walkNode
+}
+
+func walkPaths(t *testing.T, value any, options ...refl.WalkOption) []string {
+	fields, err := refl.WalkStruct(value, options...)
+	require.NoError(t, err)
+
+	paths := make([]string, 0)
+	for field := range fields {
+		paths = append(paths, field.Path)
+	}
+
+	return paths
+}
+
+func TestWalkStruct(t *testing.T) {
+	settings := walkSettings{internal: "internal"}
+
+	assert.Equal(t, []string{
+		"Id",
+		"Created",
+		"Name",
+		"Credentials",
+		"Credentials.User",
+		"Credentials.Password",
+		"Fallback",
+		"Ignored",
+		"Ignored.User",
+		"Ignored.Password",
+		"Tags",
+	}, walkPaths(t, settings))
+}
+
+func TestWalkStruct_TagFilterAndPathTag(t *testing.T) {
+	settings := &walkSettings{
+		Fallback: &walkCredentials{},
+	}
+
+	assert.Equal(t, []string{
+		"name",
+		"credentials",
+		"credentials.user",
+		"credentials.password",
+		"fallback",
+		"fallback.user",
+		"fallback.password",
+		"tags",
+	}, walkPaths(t, settings, refl.WithWalkTagFilter("cfg"), refl.WithWalkPathTag("cfg")))
+
+	assert.Equal(t, []string{
+		"Credentials.Password",
+		"Fallback.Password",
+		"Ignored.Password",
+	}, walkPaths(t, settings, refl.WithWalkTagFilter("redact")))
+}
+
+func TestWalkStruct_SetValues(t *testing.T) {
+	settings := &walkSettings{}
+
+	fields, err := refl.WalkStruct(settings, refl.WithWalkTagFilter("redact"), refl.WithWalkAllocatePointers())
+	require.NoError(t, err)
+
+	for field := range fields {
+		assert.True(t, field.Value.CanSet())
+		assert.Equal(t, "true", field.TagName("redact"))
+
+		field.Value.SetString("***")
+	}
+
+	assert.Equal(t, "***", settings.Credentials.Password)
+	assert.Equal(t, "***", settings.Fallback.Password)
+	assert.Equal(t, "***", settings.Ignored.Password)
+
+	fields, err = refl.WalkStruct(settings, refl.WithWalkTagFilter("json"))
+	require.NoError(t, err)
+
+	for field := range fields {
+		if field.TagName("json") == "id" {
+			field.Value.SetInt(42)
+		}
+	}
+
+	assert.Equal(t, 42, settings.Id, "fields promoted by unexported embedded structs should be settable")
+}
+
+func TestWalkStruct_Recursive(t *testing.T) {
+	node := &walkNode{Value: 1}
+	node.Next = &walkNode{Value: 2, Next: node}
+
+	assert.Equal(t, []string{"Value", "Next", "Next.Value", "Next.Next"}, walkPaths(t, node))
+
+	empty := &walkNode{}
+	assert.Equal(t, []string{"Value", "Next", "Next.Value", "Next.Next"}, walkPaths(t, empty, refl.WithWalkAllocatePointers()))
+	assert.NotNil(t, empty.Next)
+	assert.Nil(t, empty.Next.Next)
+}
+
+func TestWalkStruct_Stop(t *testing.T) {
+	fields, err := refl.WalkStruct(walkSettings{})
+	require.NoError(t, err)
+
+	paths := make([]string, 0)
+	for field := range fields {
+		paths = append(paths, field.Path)
+
+		if field.Path == "Credentials.User" {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"Id", "Created", "Name", "Credentials", "Credentials.User"}, paths)
+}
+
+func TestWalkStruct_Invalid(t *testing.T) {
+	_, err := refl.WalkStruct((*walkSettings)(nil))
+	assert.EqualError(t, err, "can not walk a nil *refl_test.walkSettings")
+
+	_, err = refl.WalkStruct([]walkSettings{})
+	assert.EqualError(t, err, "value has to be a struct or a pointer to a struct but instead is of type []refl_test.walkSettings")
+}
@@ -265,6 +265,12 @@ func (m *LifeCycleManager) Purge(ctx context.Context) error {
 	return nil
 }
 
+// ResetPurged forgets which resources got purged already, so the next purge cycle purges all of them again, e.g. between
+// test cases sharing the same resources.
+func (m *LifeCycleManager) ResetPurged() {
+	m.purged = funk.Set[string]{}
+}
+
 func (m *LifeCycleManager) prepareCycle(settings SettingsCycle) (enabled bool, excludes []*regexp.Regexp, err error) {
 	if !settings.Enabled {
 		return false, nil, nil
@@ -87,3 +87,12 @@ func (r sqlRepository) Delete(ctx context.Context, value db_repo.ModelBased) err
 
 	return nil
 }
+
+func (r sqlRepository) WithTx(ctx context.Context, do func(txRepo db_repo.Repository) error) error {
+	return r.Repository.WithTx(ctx, func(txRepo db_repo.Repository) error {
+		return do(sqlRepository{
+			Repository: txRepo,
+			guard:      r.guard,
+		})
+	})
+}
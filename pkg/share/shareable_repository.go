@@ -37,6 +37,17 @@ func NewShareableRepository(ctx context.Context, config cfg.Config, logger log.L
 	}, nil
 }
 
+func (r shareRepository) WithTx(ctx context.Context, do func(txRepo db_repo.Repository) error) error {
+	return r.Repository.WithTx(ctx, func(txRepo db_repo.Repository) error {
+		return do(shareRepository{
+			Repository:      txRepo,
+			guard:           r.guard,
+			logger:          r.logger,
+			shareRepository: r.shareRepository,
+		})
+	})
+}
+
 func (r shareRepository) Update(ctx context.Context, value db_repo.ModelBased) error {
 	entity, ok := value.(Shareable)
 	if !ok {
@@ -123,7 +123,7 @@ func (c *Consumer) readData(ctx context.Context) error {
 }
 
 func (c *Consumer) processAggregateMessage(ctx context.Context, cdata *consumerData) {
-	ctx, span := c.startTracingContext(ctx)
+	ctx, span := c.startTracingContext(ctx, cdata.msg)
 	defer span.Finish()
 
 	var err error
@@ -159,7 +159,7 @@ func (c *Consumer) processAggregateMessage(ctx context.Context, cdata *consumerD
 }
 
 func (c *Consumer) processSingleMessage(ctx context.Context, cdata *consumerData) {
-	ctx, span := c.startTracingContext(ctx)
+	ctx, span := c.startTracingContext(ctx, cdata.msg)
 	defer span.Finish()
 
 	start := c.clock.Now()
@@ -172,11 +172,14 @@ func (c *Consumer) processSingleMessage(ctx context.Context, cdata *consumerData
 	c.writeMetricDurationAndProcessedCount(ctx, duration, 1)
 }
 
-func (c *Consumer) startTracingContext(ctx context.Context) (context.Context, tracing.Span) {
-	ctx, span := c.tracer.StartSpanFromContext(ctx, c.id)
+// startTracingContext starts the consumer span as child of the span which produced the message. The trace has to be
+// read before decoding the message, as the decoder removes it from the attributes.
+func (c *Consumer) startTracingContext(ctx context.Context, msg *Message) (context.Context, tracing.Span) {
+	ctx, span := tracing.StartConsumerSpan(ctx, c.tracer, c.id, tracing.GetMessageTrace(msg.Attributes))
 
 	ctx = log.InitContext(ctx)
 	ctx = log.WithFingersCrossedScope(ctx)
+	ctx = log.WithSentryBreadcrumbs(ctx)
 	ctx = reqctx.New(ctx)
 
 	return ctx, span
@@ -207,10 +210,8 @@ func (c *Consumer) process(ctx context.Context, msg *Message, hasNativeRetry boo
 		c.metricWriter.Write(ctx, metric.Data{
 			&metric.Datum{
 				MetricName: metricNameConsumerUnknownModelError,
-				Dimensions: map[string]string{
-					"Consumer": c.name,
-				},
-				Value: 1.0,
+				Dimensions: c.metricDimensions,
+				Value:      1.0,
 			},
 		})
 
@@ -97,6 +97,9 @@ type baseConsumer struct {
 	settings         ConsumerSettings
 	consumerCallback any
 	processed        int32
+	// metricDimensions are shared by all metrics written per message instead of allocating them again every time.
+	// The metric writers never modify the dimensions of a datum.
+	metricDimensions metric.Dimensions
 }
 
 func NewBaseConsumer(
@@ -223,6 +226,7 @@ func NewBaseConsumerWithInterfaces(
 		settings:            settings,
 		consumerCallback:    consumerCallback,
 		data:                make(chan *consumerData),
+		metricDimensions:    metric.Dimensions{"Consumer": name},
 	}
 }
 
@@ -473,10 +477,8 @@ func (c *baseConsumer) handleError(ctx context.Context, err error, msg string) {
 	c.metricWriter.Write(ctx, metric.Data{
 		&metric.Datum{
 			MetricName: metricNameConsumerError,
-			Dimensions: map[string]string{
-				"Consumer": c.name,
-			},
-			Value: 1.0,
+			Dimensions: c.metricDimensions,
+			Value:      1.0,
 		},
 	})
 }
@@ -490,35 +492,29 @@ func (c *baseConsumer) isHealthy() bool {
 	return c.input.IsHealthy() && retryInputHealthy
 }
 
+// writeMetricDurationAndProcessedCount runs for every consumed batch, so it writes pooled data.
 func (c *baseConsumer) writeMetricDurationAndProcessedCount(ctx context.Context, duration time.Duration, processedCount int) {
-	c.metricWriter.Write(ctx, metric.Data{
-		&metric.Datum{
-			Priority:   metric.PriorityHigh,
-			MetricName: metricNameConsumerDuration,
-			Dimensions: map[string]string{
-				"Consumer": c.name,
-			},
-			Unit:  metric.UnitMillisecondsAverage,
-			Value: float64(duration.Milliseconds()),
-		},
-		&metric.Datum{
-			MetricName: metricNameConsumerProcessedCount,
-			Dimensions: map[string]string{
-				"Consumer": c.name,
-			},
-			Value: float64(processedCount),
-		},
-	})
+	durationDatum := metric.AcquireDatum()
+	durationDatum.Priority = metric.PriorityHigh
+	durationDatum.MetricName = metricNameConsumerDuration
+	durationDatum.Dimensions = c.metricDimensions
+	durationDatum.Unit = metric.UnitMillisecondsAverage
+	durationDatum.Value = float64(duration.Milliseconds())
+
+	processedDatum := metric.AcquireDatum()
+	processedDatum.MetricName = metricNameConsumerProcessedCount
+	processedDatum.Dimensions = c.metricDimensions
+	processedDatum.Value = float64(processedCount)
+
+	c.metricWriter.Write(ctx, metric.Data{durationDatum, processedDatum})
 }
 
 func (c *baseConsumer) writeMetricRetryCount(ctx context.Context, metricName string) {
 	c.metricWriter.Write(ctx, metric.Data{
 		&metric.Datum{
 			MetricName: metricName,
-			Dimensions: map[string]string{
-				"Consumer": c.name,
-			},
-			Value: float64(1),
+			Dimensions: c.metricDimensions,
+			Value:      float64(1),
 		},
 	})
 }
@@ -237,10 +237,8 @@ func (c *BatchConsumer) decodeMessages(
 			c.metricWriter.Write(batchCtx, metric.Data{
 				&metric.Datum{
 					MetricName: metricNameConsumerUnknownModelError,
-					Dimensions: map[string]string{
-						"Consumer": c.name,
-					},
-					Value: 1.0,
+					Dimensions: c.metricDimensions,
+					Value:      1.0,
 				},
 			})
 
@@ -257,6 +255,9 @@ func (c *BatchConsumer) decodeMessages(
 			continue
 		}
 
+		// the decoder removes the trace of the producer from the attributes
+		producer := tracing.GetMessageTrace(cdata.msg.Attributes)
+
 		msgCtx, attribute, err := c.encoder.Decode(batchCtx, cdata.msg, model)
 		if err != nil {
 			c.logger.Error(msgCtx, "an error occurred during the batch decode message operation: %w", err)
@@ -268,7 +269,7 @@ func (c *BatchConsumer) decodeMessages(
 		attributes = append(attributes, attribute)
 		newBatch = append(newBatch, cdata)
 
-		_, span := c.tracer.StartSubSpan(msgCtx, c.id)
+		_, span := tracing.StartLinkedSubSpan(msgCtx, c.tracer, c.id, producer)
 		spans = append(spans, span)
 	}
 
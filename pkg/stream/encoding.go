@@ -9,6 +9,7 @@ type EncodingType string
 const (
 	EncodingAvro     EncodingType = "application/avro"
 	EncodingJson     EncodingType = "application/json"
+	EncodingMsgpack  EncodingType = "application/x-msgpack"
 	EncodingProtobuf EncodingType = "application/x-protobuf"
 )
 
@@ -31,6 +32,7 @@ type MessageBodyEncoder interface {
 
 var messageBodyEncoders = map[EncodingType]MessageBodyEncoder{
 	EncodingJson:     new(jsonEncoder),
+	EncodingMsgpack:  new(base64LayeredMsgpackEncoder),
 	EncodingProtobuf: new(base64LayeredProtobufEncoder),
 }
 
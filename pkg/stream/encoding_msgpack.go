@@ -0,0 +1,52 @@
+package stream
+
+import (
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/base64"
+	"github.com/justtrackio/gosoline/pkg/encoding/msgpack"
+)
+
+type msgpackEncoder struct{}
+
+func NewMsgpackEncoder() MessageBodyEncoder {
+	return msgpackEncoder{}
+}
+
+func (e msgpackEncoder) Encode(data any) ([]byte, error) {
+	return msgpack.Marshal(data)
+}
+
+func (e msgpackEncoder) Decode(data []byte, out any) error {
+	return msgpack.Unmarshal(data, out)
+}
+
+type base64LayeredMsgpackEncoder struct{}
+
+// NewBase64LayeredMsgpackEncoder encodes the body as MessagePack wrapped in base64, as the body of a message has to be a
+// valid string. Struct fields are named by their msgpack tag or their field name.
+func NewBase64LayeredMsgpackEncoder() MessageBodyEncoder {
+	return base64LayeredMsgpackEncoder{}
+}
+
+func (e base64LayeredMsgpackEncoder) Encode(data any) ([]byte, error) {
+	bytes, err := msgpack.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal msgpack message: %w", err)
+	}
+
+	return base64.Encode(bytes), nil
+}
+
+func (e base64LayeredMsgpackEncoder) Decode(data64 []byte, out any) error {
+	data, err := base64.Decode(data64)
+	if err != nil {
+		return fmt.Errorf("failed to decode msgpack base64 layer: %w", err)
+	}
+
+	if err := msgpack.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode msgpack message: %w", err)
+	}
+
+	return nil
+}
@@ -72,3 +72,20 @@ func TestEncodingProtobuf(t *testing.T) {
 		Data: "this is data!",
 	}, out)
 }
+
+func TestEncodingMsgpack(t *testing.T) {
+	body, err := stream.EncodeMessage(stream.EncodingMsgpack, &TestEncodingMessage{
+		Id:   42,
+		Data: "this is data!",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("gqJJZNMAAAAAAAAAKqREYXRhrXRoaXMgaXMgZGF0YSE="), body)
+
+	out := &TestEncodingMessage{}
+	err = stream.DecodeMessage(stream.EncodingMsgpack, body, out)
+	assert.NoError(t, err)
+	assert.Equal(t, &TestEncodingMessage{
+		Id:   42,
+		Data: "this is data!",
+	}, out)
+}
@@ -8,32 +8,36 @@ import (
 	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/cloud/aws/kinesis"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sns"
 	"github.com/justtrackio/gosoline/pkg/cloud/aws/sqs"
 	kafkaConsumer "github.com/justtrackio/gosoline/pkg/kafka/consumer"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/stream/health"
 )
 
 const (
-	InputTypeFile     = "file"
-	InputTypeInMemory = "inMemory"
-	InputTypeKafka    = "kafka"
-	InputTypeKinesis  = "kinesis"
-	InputTypeRedis    = "redis"
-	InputTypeSns      = "sns"
-	InputTypeSqs      = "sqs"
+	InputTypeDdbStreams = "ddbStreams"
+	InputTypeFile       = "file"
+	InputTypeInMemory   = "inMemory"
+	InputTypeKafka      = "kafka"
+	InputTypeKinesis    = "kinesis"
+	InputTypeRedis      = "redis"
+	InputTypeSns        = "sns"
+	InputTypeSqs        = "sqs"
 )
 
 type InputFactory func(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Input, error)
 
 var inputFactories = map[string]InputFactory{
-	InputTypeFile:     newFileInputFromConfig,
-	InputTypeInMemory: newInMemoryInputFromConfig,
-	InputTypeKafka:    newKafkaInputFromConfig,
-	InputTypeKinesis:  newKinesisInputFromConfig,
-	InputTypeRedis:    newRedisInputFromConfig,
-	InputTypeSns:      newSnsInputFromConfig,
-	InputTypeSqs:      newSqsInputFromConfig,
+	InputTypeDdbStreams: newDdbStreamsInputFromConfig,
+	InputTypeFile:       newFileInputFromConfig,
+	InputTypeInMemory:   newInMemoryInputFromConfig,
+	InputTypeKafka:      newKafkaInputFromConfig,
+	InputTypeKinesis:    newKinesisInputFromConfig,
+	InputTypeRedis:      newRedisInputFromConfig,
+	InputTypeSns:        newSnsInputFromConfig,
+	InputTypeSqs:        newSqsInputFromConfig,
 }
 
 func SetInputFactory(typ string, factory InputFactory) {
@@ -68,6 +72,41 @@ func NewConfigurableInput(ctx context.Context, config cfg.Config, logger log.Log
 	return input, nil
 }
 
+type ddbStreamsInputConfiguration struct {
+	ModelId           mdl.ModelId                `cfg:"model_id"`
+	ClientName        string                     `cfg:"client_name" default:"default"`
+	StartingPosition  string                     `cfg:"starting_position" default:"trim_horizon" validate:"oneof=trim_horizon latest"`
+	WaitTime          time.Duration              `cfg:"wait_time" default:"1s"`
+	DiscoverFrequency time.Duration              `cfg:"discover_frequency" default:"1m"`
+	LeaseDuration     time.Duration              `cfg:"lease_duration" default:"1m" validate:"min=3000000000"`
+	Healthcheck       health.HealthCheckSettings `cfg:"healthcheck"`
+}
+
+func newDdbStreamsInputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Input, error) {
+	key := ConfigurableInputKey(name)
+
+	configuration := ddbStreamsInputConfiguration{}
+	if err := config.UnmarshalKey(key, &configuration); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ddb streams input settings: %w", err)
+	}
+
+	if err := configuration.ModelId.PadFromConfig(config); err != nil {
+		return nil, fmt.Errorf("failed to pad model id for ddb streams input %q: %w", name, err)
+	}
+
+	settings := &DdbStreamsInputSettings{
+		ModelId:           configuration.ModelId,
+		ClientName:        configuration.ClientName,
+		StartingPosition:  configuration.StartingPosition,
+		WaitTime:          configuration.WaitTime,
+		DiscoverFrequency: configuration.DiscoverFrequency,
+		LeaseDuration:     configuration.LeaseDuration,
+		Healthcheck:       configuration.Healthcheck,
+	}
+
+	return NewDdbStreamsInput(ctx, config, logger, settings)
+}
+
 func newFileInputFromConfig(_ context.Context, config cfg.Config, logger log.Logger, name string) (Input, error) {
 	key := ConfigurableInputKey(name)
 	settings := FileSettings{}
@@ -151,6 +190,7 @@ type SnsInputTargetConfiguration struct {
 	TopicId    string            `cfg:"topic_id" validate:"required"`
 	Attributes map[string]string `cfg:"attributes"`
 	ClientName string            `cfg:"client_name" default:"default"`
+	Fifo       sns.FifoSettings  `cfg:"fifo"`
 }
 
 type SnsInputConfiguration struct {
@@ -163,6 +203,7 @@ type SnsInputConfiguration struct {
 	VisibilityTimeout   int                           `cfg:"visibility_timeout" default:"30" validate:"min=1"`
 	RunnerCount         int                           `cfg:"runner_count" default:"1" validate:"min=1"`
 	RedrivePolicy       sqs.RedrivePolicy             `cfg:"redrive_policy"`
+	Fifo                sqs.FifoSettings              `cfg:"fifo"`
 	ClientName          string                        `cfg:"client_name" default:"default"`
 	Healthcheck         health.HealthCheckSettings    `cfg:"healthcheck"`
 }
@@ -187,6 +228,7 @@ func readSnsInputSettings(config cfg.Config, name string) (*SnsInputSettings, []
 		VisibilityTimeout:   configuration.VisibilityTimeout,
 		RunnerCount:         configuration.RunnerCount,
 		RedrivePolicy:       configuration.RedrivePolicy,
+		Fifo:                configuration.Fifo,
 		ClientName:          configuration.ClientName,
 		Healthcheck:         configuration.Healthcheck,
 	}
@@ -203,10 +245,11 @@ func readSnsInputSettings(config cfg.Config, name string) (*SnsInputSettings, []
 		}
 
 		targets[i] = SnsInputTarget{
-			Identity:   t.ToIdentity(),
-			TopicId:    t.TopicId,
-			Attributes: t.Attributes,
-			ClientName: clientName,
+			Identity:     t.ToIdentity(),
+			TopicId:      t.TopicId,
+			FilterPolicy: sns.FilterPolicyFromAttributes(t.Attributes),
+			ClientName:   clientName,
+			Fifo:         t.Fifo,
 		}
 	}
 
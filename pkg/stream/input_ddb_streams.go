@@ -0,0 +1,718 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoDynamodbStreams "github.com/justtrackio/gosoline/pkg/cloud/aws/dynamodbstreams"
+	"github.com/justtrackio/gosoline/pkg/coffin"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/stream/health"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+)
+
+const (
+	DdbStreamEventInsert = "INSERT"
+	DdbStreamEventModify = "MODIFY"
+	DdbStreamEventRemove = "REMOVE"
+
+	AttributeDdbStreamEventName      = "ddbStreamEventName"
+	AttributeDdbStreamShardId        = "ddbStreamShardId"
+	AttributeDdbStreamSequenceNumber = "ddbStreamSequenceNumber"
+
+	DdbStreamsStartingPositionTrimHorizon = "trim_horizon"
+	DdbStreamsStartingPositionLatest      = "latest"
+)
+
+type DdbStreamsInputSettings struct {
+	// ModelId of the table whose stream should be consumed. The table name is derived from it the same way the ddb
+	// repository does it.
+	ModelId    mdl.ModelId
+	ClientName string
+	// StartingPosition defines where to start reading a shard without a checkpoint: trim_horizon or latest.
+	StartingPosition string
+	// WaitTime is the time to wait before polling a shard again after it returned no records.
+	WaitTime time.Duration
+	// DiscoverFrequency is the interval in which the stream is checked for new shards.
+	DiscoverFrequency time.Duration
+	// LeaseDuration is the time the lease of the stream is held without renewing it. Only the instance holding the lease
+	// reads the stream, all others wait for it to expire or be released. The lease is renewed every third of it.
+	LeaseDuration time.Duration
+	Healthcheck   health.HealthCheckSettings
+}
+
+// A DdbStreamRecord is the body of every message produced by the ddb streams input. The images use the attribute names
+// of the table, which are the json tags of the ddb model, so they decode directly into the model.
+type DdbStreamRecord struct {
+	EventId                     string         `json:"eventId"`
+	EventName                   string         `json:"eventName"`
+	SequenceNumber              string         `json:"sequenceNumber"`
+	ApproximateCreationDateTime time.Time      `json:"approximateCreationDateTime"`
+	Keys                        map[string]any `json:"keys"`
+	NewImage                    map[string]any `json:"newImage,omitempty"`
+	OldImage                    map[string]any `json:"oldImage,omitempty"`
+}
+
+// A DdbStreamEvent is the typed counterpart of DdbStreamRecord. Return a pointer to it from GetModel of your consumer
+// callback to receive the old and new image decoded into your model.
+type DdbStreamEvent[M any] struct {
+	EventId                     string         `json:"eventId"`
+	EventName                   string         `json:"eventName"`
+	SequenceNumber              string         `json:"sequenceNumber"`
+	ApproximateCreationDateTime time.Time      `json:"approximateCreationDateTime"`
+	Keys                        map[string]any `json:"keys"`
+	NewImage                    *M             `json:"newImage,omitempty"`
+	OldImage                    *M             `json:"oldImage,omitempty"`
+}
+
+var _ AcknowledgeableInput = &ddbStreamsInput{}
+
+type ddbStreamsInput struct {
+	logger           log.Logger
+	client           gosoDynamodbStreams.Client
+	checkpoints      DdbStreamsCheckpointStore
+	clock            clock.Clock
+	healthCheckTimer clock.HealthCheckTimer
+	settings         *DdbStreamsInputSettings
+	tableName        string
+	owner            string
+
+	channel  chan *Message
+	cancel   context.CancelFunc
+	lck      sync.Mutex
+	leased   bool
+	started  map[string]bool
+	finished map[string]bool
+	shards   map[string]*ddbStreamsShard
+}
+
+// ddbStreamsShard tracks the messages of a shard which were sent to the consumer, but not acknowledged yet. The
+// checkpoint only advances over messages which were acknowledged, as do all messages read before them.
+type ddbStreamsShard struct {
+	// persistLck serializes persisting the checkpoint, all other fields are guarded by the lock of the input
+	persistLck sync.Mutex
+	checkpoint DdbStreamCheckpoint
+	// pending are the sequence numbers of the unacknowledged messages in the order they were read
+	pending   []string
+	acked     map[string]bool
+	exhausted bool
+	// version is incremented with every change of the checkpoint, persisted is the last version written
+	version   int
+	persisted int
+}
+
+// NewDdbStreamsInput creates an input consuming the DynamoDB stream of a table. The instances of an application share a
+// lease on the stream, so only one of them reads it at a time while the others stand by.
+func NewDdbStreamsInput(ctx context.Context, config cfg.Config, logger log.Logger, settings *DdbStreamsInputSettings) (Input, error) {
+	var err error
+	var client gosoDynamodbStreams.Client
+	var checkpoints DdbStreamsCheckpointStore
+	var tableName string
+	var healthCheckTimer clock.HealthCheckTimer
+
+	if tableName, err = ddb.GetTableName(config, &ddb.Settings{ModelId: settings.ModelId, ClientName: settings.ClientName}); err != nil {
+		return nil, fmt.Errorf("can not get table name for model %s: %w", settings.ModelId.Name, err)
+	}
+
+	if client, err = gosoDynamodbStreams.ProvideClient(ctx, config, logger, settings.ClientName); err != nil {
+		return nil, fmt.Errorf("can not create dynamodb streams client: %w", err)
+	}
+
+	if checkpoints, err = NewDdbStreamsCheckpointStore(ctx, config, logger, settings.ClientName, tableName); err != nil {
+		return nil, fmt.Errorf("can not create checkpoint store: %w", err)
+	}
+
+	if healthCheckTimer, err = clock.NewHealthCheckTimer(settings.Healthcheck.Timeout); err != nil {
+		return nil, fmt.Errorf("failed to create healthcheck timer: %w", err)
+	}
+
+	return NewDdbStreamsInputWithInterfaces(logger, client, checkpoints, clock.Provider, healthCheckTimer, settings, tableName), nil
+}
+
+func NewDdbStreamsInputWithInterfaces(
+	logger log.Logger,
+	client gosoDynamodbStreams.Client,
+	checkpoints DdbStreamsCheckpointStore,
+	clock clock.Clock,
+	healthCheckTimer clock.HealthCheckTimer,
+	settings *DdbStreamsInputSettings,
+	tableName string,
+) Input {
+	return &ddbStreamsInput{
+		logger:           logger.WithChannel("ddb-streams").WithFields(log.Fields{"table_name": tableName}),
+		client:           client,
+		checkpoints:      checkpoints,
+		clock:            clock,
+		healthCheckTimer: healthCheckTimer,
+		settings:         settings,
+		tableName:        tableName,
+		owner:            uuid.New().NewV4(),
+		channel:          make(chan *Message),
+		started:          map[string]bool{},
+		finished:         map[string]bool{},
+		shards:           map[string]*ddbStreamsShard{},
+	}
+}
+
+func (i *ddbStreamsInput) Data() <-chan *Message {
+	return i.channel
+}
+
+func (i *ddbStreamsInput) IsHealthy() bool {
+	return i.healthCheckTimer.IsHealthy()
+}
+
+func (i *ddbStreamsInput) Stop(_ context.Context) {
+	i.lck.Lock()
+	defer i.lck.Unlock()
+
+	if i.cancel != nil {
+		i.cancel()
+	}
+}
+
+func (i *ddbStreamsInput) Run(ctx context.Context) error {
+	defer close(i.channel)
+
+	var err error
+	var streamArn string
+
+	i.lck.Lock()
+	ctx, i.cancel = context.WithCancel(ctx)
+	i.lck.Unlock()
+
+	if streamArn, err = i.getStreamArn(ctx); err != nil {
+		return fmt.Errorf("can not get stream arn of table %s: %w", i.tableName, err)
+	}
+
+	var acquired bool
+	if acquired, err = i.waitForLease(ctx); err != nil {
+		return fmt.Errorf("can not acquire lease of stream %s: %w", streamArn, err)
+	}
+
+	if !acquired {
+		return nil
+	}
+
+	defer i.releaseLease(ctx)
+
+	cfn, cfnCtx := coffin.WithContext(ctx)
+	ticker := i.clock.NewTicker(i.settings.DiscoverFrequency)
+	defer ticker.Stop()
+
+	cfn.GoWithContext(cfnCtx, i.renewLease)
+	cfn.GoWithContext(cfnCtx, func(ctx context.Context) error {
+		for {
+			if err := i.discoverShards(ctx, cfn, streamArn); err != nil {
+				return fmt.Errorf("can not discover shards of stream %s: %w", streamArn, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.Chan():
+			}
+		}
+	})
+
+	if err = cfn.Wait(); err != nil && !exec.IsRequestCanceled(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Ack marks the message as consumed, see AckBatch.
+func (i *ddbStreamsInput) Ack(ctx context.Context, msg *Message, ack bool) error {
+	return i.AckBatch(ctx, []*Message{msg}, []bool{ack})
+}
+
+// AckBatch advances the checkpoints of the shards of the messages over all messages which were consumed. Messages which
+// were not acknowledged were handed to the retry handler of the consumer already, so they count as consumed as well, like
+// for kinesis. Messages acknowledged after the lease of the stream was released are read again by the next instance.
+func (i *ddbStreamsInput) AckBatch(ctx context.Context, msgs []*Message, _ []bool) error {
+	i.lck.Lock()
+
+	if !i.leased {
+		i.lck.Unlock()
+
+		return nil
+	}
+
+	changed := make([]*ddbStreamsShard, 0, 1)
+
+	for _, msg := range msgs {
+		shard, ok := i.shards[msg.Attributes[AttributeDdbStreamShardId]]
+		if !ok {
+			continue
+		}
+
+		shard.acked[msg.Attributes[AttributeDdbStreamSequenceNumber]] = true
+
+		if i.advance(shard) && !slices.Contains(changed, shard) {
+			changed = append(changed, shard)
+		}
+	}
+
+	i.lck.Unlock()
+
+	for _, shard := range changed {
+		if err := i.persist(ctx, shard); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// advance moves the checkpoint of the shard over the acknowledged messages at the start of its pending messages and
+// finishes the shard once all its messages were read and acknowledged. It has to be called with the lock held.
+func (i *ddbStreamsInput) advance(shard *ddbStreamsShard) bool {
+	advanced := false
+
+	for len(shard.pending) > 0 && shard.acked[shard.pending[0]] {
+		shard.checkpoint.SequenceNumber = shard.pending[0]
+		delete(shard.acked, shard.pending[0])
+		shard.pending = shard.pending[1:]
+		advanced = true
+	}
+
+	if shard.exhausted && len(shard.pending) == 0 && shard.checkpoint.FinishedAt == nil {
+		shard.checkpoint.FinishedAt = aws.Time(i.clock.Now())
+		advanced = true
+	}
+
+	if advanced {
+		shard.checkpoint.UpdatedAt = i.clock.Now()
+		shard.version++
+	}
+
+	return advanced
+}
+
+// persist writes the latest checkpoint of the shard unless a concurrent call already did so.
+func (i *ddbStreamsInput) persist(ctx context.Context, shard *ddbStreamsShard) error {
+	shard.persistLck.Lock()
+	defer shard.persistLck.Unlock()
+
+	i.lck.Lock()
+	checkpoint := shard.checkpoint
+	version := shard.version
+	i.lck.Unlock()
+
+	if version == shard.persisted {
+		return nil
+	}
+
+	if err := i.checkpoints.Put(ctx, &checkpoint); err != nil {
+		return fmt.Errorf("can not persist checkpoint of shard %s: %w", checkpoint.ShardId, err)
+	}
+
+	shard.persisted = version
+
+	if checkpoint.FinishedAt != nil {
+		i.logger.WithFields(log.Fields{"shard_id": checkpoint.ShardId}).Info(ctx, "finished reading shard")
+		i.markFinished(checkpoint.ShardId)
+	}
+
+	return nil
+}
+
+// waitForLease returns once the lease of the stream was acquired or the context was canceled.
+func (i *ddbStreamsInput) waitForLease(ctx context.Context) (bool, error) {
+	waiting := false
+
+	for {
+		acquired, err := i.checkpoints.AcquireLease(ctx, i.owner, i.clock.Now(), i.settings.LeaseDuration)
+		if exec.IsRequestCanceled(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		// standing by for the lease is the expected state of all but one instance
+		i.healthCheckTimer.MarkHealthy()
+
+		if acquired {
+			i.lck.Lock()
+			i.leased = true
+			i.lck.Unlock()
+
+			i.logger.Info(ctx, "acquired lease of stream")
+
+			return true, nil
+		}
+
+		if !waiting {
+			i.logger.Info(ctx, "waiting for lease of stream, which is held by another instance")
+			waiting = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-i.clock.After(i.settings.LeaseDuration / 3):
+		}
+	}
+}
+
+func (i *ddbStreamsInput) renewLease(ctx context.Context) error {
+	ticker := i.clock.NewTicker(i.settings.LeaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.Chan():
+		}
+
+		acquired, err := i.checkpoints.AcquireLease(ctx, i.owner, i.clock.Now(), i.settings.LeaseDuration)
+		if exec.IsRequestCanceled(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("can not renew lease of stream: %w", err)
+		}
+
+		if !acquired {
+			i.lck.Lock()
+			i.leased = false
+			i.lck.Unlock()
+
+			return fmt.Errorf("lost lease of stream to another instance")
+		}
+	}
+}
+
+func (i *ddbStreamsInput) releaseLease(ctx context.Context) {
+	i.lck.Lock()
+	i.leased = false
+	i.lck.Unlock()
+
+	if err := i.checkpoints.ReleaseLease(context.WithoutCancel(ctx), i.owner); err != nil {
+		i.logger.Warn(ctx, "can not release lease of stream: %s", err)
+	}
+}
+
+func (i *ddbStreamsInput) getStreamArn(ctx context.Context) (string, error) {
+	out, err := i.client.ListStreams(ctx, &dynamodbstreams.ListStreamsInput{
+		TableName: aws.String(i.tableName),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(out.Streams) == 0 {
+		return "", fmt.Errorf("table %s has no stream enabled", i.tableName)
+	}
+
+	return aws.ToString(out.Streams[len(out.Streams)-1].StreamArn), nil
+}
+
+func (i *ddbStreamsInput) discoverShards(ctx context.Context, cfn coffin.Coffin, streamArn string) error {
+	i.healthCheckTimer.MarkHealthy()
+
+	shards := make([]types.Shard, 0)
+	input := &dynamodbstreams.DescribeStreamInput{
+		StreamArn: aws.String(streamArn),
+	}
+
+	for {
+		out, err := i.client.DescribeStream(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		shards = append(shards, out.StreamDescription.Shards...)
+
+		if out.StreamDescription.LastEvaluatedShardId == nil {
+			break
+		}
+
+		input.ExclusiveStartShardId = out.StreamDescription.LastEvaluatedShardId
+	}
+
+	known := make(map[string]bool, len(shards))
+	for _, shard := range shards {
+		known[aws.ToString(shard.ShardId)] = true
+	}
+
+	i.lck.Lock()
+	defer i.lck.Unlock()
+
+	for _, shard := range shards {
+		shardId := aws.ToString(shard.ShardId)
+		parentId := aws.ToString(shard.ParentShardId)
+
+		if i.started[shardId] {
+			continue
+		}
+
+		// records of a child shard have to be processed after the records of its parent, so we wait for the parent
+		// to be finished as long as it still exists in the stream
+		if parentId != "" && known[parentId] && !i.finished[parentId] {
+			continue
+		}
+
+		i.started[shardId] = true
+
+		cfn.GoWithContextf(ctx, func(ctx context.Context) error {
+			return i.readShard(ctx, streamArn, shardId)
+		}, "panic while reading shard %s", shardId)
+	}
+
+	return nil
+}
+
+func (i *ddbStreamsInput) readShard(ctx context.Context, streamArn string, shardId string) error {
+	var err error
+	var checkpoint *DdbStreamCheckpoint
+	var iterator *string
+
+	logger := i.logger.WithFields(log.Fields{"shard_id": shardId})
+
+	if checkpoint, err = i.checkpoints.Get(ctx, shardId); err != nil {
+		return fmt.Errorf("can not get checkpoint for shard %s: %w", shardId, err)
+	}
+
+	if checkpoint.FinishedAt != nil {
+		i.markFinished(shardId)
+
+		return nil
+	}
+
+	// the checkpoint only advances once messages are acknowledged, so we keep track of the last record we read
+	// ourselves to renew expired iterators
+	sequenceNumber := checkpoint.SequenceNumber
+
+	if iterator, err = i.getShardIterator(ctx, streamArn, shardId, sequenceNumber); err != nil {
+		return fmt.Errorf("can not get iterator for shard %s: %w", shardId, err)
+	}
+
+	shard := i.addShard(checkpoint)
+
+	logger.Info(ctx, "started reading shard")
+
+	for {
+		out, err := i.client.GetRecords(ctx, &dynamodbstreams.GetRecordsInput{
+			ShardIterator: iterator,
+		})
+
+		var expired *types.ExpiredIteratorException
+		if errors.As(err, &expired) {
+			if iterator, err = i.getShardIterator(ctx, streamArn, shardId, sequenceNumber); err != nil {
+				return fmt.Errorf("can not renew expired iterator for shard %s: %w", shardId, err)
+			}
+
+			continue
+		}
+
+		if exec.IsRequestCanceled(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("can not get records of shard %s: %w", shardId, err)
+		}
+
+		i.healthCheckTimer.MarkHealthy()
+
+		for _, record := range out.Records {
+			msg, err := i.buildMessage(shardId, record)
+			if err != nil {
+				return fmt.Errorf("can not build message for record %s of shard %s: %w", aws.ToString(record.EventID), shardId, err)
+			}
+
+			// the message has to be pending before the consumer can acknowledge it
+			i.addPending(shard, msg.Attributes[AttributeDdbStreamSequenceNumber])
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case i.channel <- msg:
+				i.healthCheckTimer.MarkHealthy()
+			}
+
+			sequenceNumber = aws.ToString(record.Dynamodb.SequenceNumber)
+		}
+
+		if out.NextShardIterator == nil {
+			logger.Info(ctx, "read all records of shard")
+
+			return i.exhaust(ctx, shard)
+		}
+
+		iterator = out.NextShardIterator
+
+		if len(out.Records) > 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-i.clock.After(i.settings.WaitTime):
+		}
+	}
+}
+
+func (i *ddbStreamsInput) addShard(checkpoint *DdbStreamCheckpoint) *ddbStreamsShard {
+	i.lck.Lock()
+	defer i.lck.Unlock()
+
+	shard := &ddbStreamsShard{
+		checkpoint: *checkpoint,
+		pending:    make([]string, 0),
+		acked:      map[string]bool{},
+	}
+	i.shards[checkpoint.ShardId] = shard
+
+	return shard
+}
+
+func (i *ddbStreamsInput) addPending(shard *ddbStreamsShard, sequenceNumber string) {
+	i.lck.Lock()
+	defer i.lck.Unlock()
+
+	shard.pending = append(shard.pending, sequenceNumber)
+}
+
+// exhaust marks all records of the shard as read. The shard is finished as soon as all its messages are acknowledged,
+// which might already be the case.
+func (i *ddbStreamsInput) exhaust(ctx context.Context, shard *ddbStreamsShard) error {
+	i.lck.Lock()
+	shard.exhausted = true
+	finished := i.advance(shard)
+	i.lck.Unlock()
+
+	if !finished {
+		return nil
+	}
+
+	return i.persist(ctx, shard)
+}
+
+func (i *ddbStreamsInput) getShardIterator(ctx context.Context, streamArn string, shardId string, sequenceNumber string) (*string, error) {
+	input := &dynamodbstreams.GetShardIteratorInput{
+		StreamArn:         aws.String(streamArn),
+		ShardId:           aws.String(shardId),
+		ShardIteratorType: types.ShardIteratorTypeTrimHorizon,
+	}
+
+	switch {
+	case sequenceNumber != "":
+		input.ShardIteratorType = types.ShardIteratorTypeAfterSequenceNumber
+		input.SequenceNumber = aws.String(sequenceNumber)
+	case i.settings.StartingPosition == DdbStreamsStartingPositionLatest:
+		input.ShardIteratorType = types.ShardIteratorTypeLatest
+	}
+
+	out, err := i.client.GetShardIterator(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.ShardIterator, nil
+}
+
+func (i *ddbStreamsInput) markFinished(shardId string) {
+	i.lck.Lock()
+	defer i.lck.Unlock()
+
+	i.finished[shardId] = true
+	delete(i.shards, shardId)
+}
+
+func (i *ddbStreamsInput) buildMessage(shardId string, record types.Record) (*Message, error) {
+	if record.Dynamodb == nil {
+		return nil, fmt.Errorf("record has no dynamodb payload")
+	}
+
+	body := DdbStreamRecord{
+		EventId:        aws.ToString(record.EventID),
+		EventName:      string(record.EventName),
+		SequenceNumber: aws.ToString(record.Dynamodb.SequenceNumber),
+		Keys:           ddbStreamAttributesToJson(record.Dynamodb.Keys),
+		NewImage:       ddbStreamAttributesToJson(record.Dynamodb.NewImage),
+		OldImage:       ddbStreamAttributesToJson(record.Dynamodb.OldImage),
+	}
+
+	if record.Dynamodb.ApproximateCreationDateTime != nil {
+		body.ApproximateCreationDateTime = *record.Dynamodb.ApproximateCreationDateTime
+	}
+
+	return MarshalJsonMessage(body, map[string]string{
+		AttributeDdbStreamEventName:      body.EventName,
+		AttributeDdbStreamShardId:        shardId,
+		AttributeDdbStreamSequenceNumber: body.SequenceNumber,
+	})
+}
+
+func ddbStreamAttributesToJson(attributes map[string]types.AttributeValue) map[string]any {
+	if attributes == nil {
+		return nil
+	}
+
+	result := make(map[string]any, len(attributes))
+	for key, value := range attributes {
+		result[key] = ddbStreamAttributeToJson(value)
+	}
+
+	return result
+}
+
+// ddbStreamAttributeToJson converts an attribute value to a value with the same json representation the ddb package
+// would produce for it. Numbers are kept as json.Number, so they don't lose precision on the way to the model.
+func ddbStreamAttributeToJson(value types.AttributeValue) any {
+	switch v := value.(type) {
+	case *types.AttributeValueMemberS:
+		return v.Value
+	case *types.AttributeValueMemberN:
+		return json.Number(v.Value)
+	case *types.AttributeValueMemberB:
+		return v.Value
+	case *types.AttributeValueMemberBOOL:
+		return v.Value
+	case *types.AttributeValueMemberNULL:
+		return nil
+	case *types.AttributeValueMemberM:
+		return ddbStreamAttributesToJson(v.Value)
+	case *types.AttributeValueMemberL:
+		result := make([]any, len(v.Value))
+		for i, item := range v.Value {
+			result[i] = ddbStreamAttributeToJson(item)
+		}
+
+		return result
+	case *types.AttributeValueMemberSS:
+		return v.Value
+	case *types.AttributeValueMemberNS:
+		result := make([]json.Number, len(v.Value))
+		for i, item := range v.Value {
+			result[i] = json.Number(item)
+		}
+
+		return result
+	case *types.AttributeValueMemberBS:
+		return v.Value
+	default:
+		return nil
+	}
+}
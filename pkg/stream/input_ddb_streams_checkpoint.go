@@ -0,0 +1,150 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+)
+
+// A DdbStreamCheckpoint stores how far a shard of a ddb stream has been consumed.
+type DdbStreamCheckpoint struct {
+	Namespace      string     `json:"namespace" ddb:"key=hash"`
+	ShardId        string     `json:"shardId" ddb:"key=range"`
+	SequenceNumber string     `json:"sequenceNumber,omitempty"`
+	FinishedAt     *time.Time `json:"finishedAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// ddbStreamLease is stored next to the checkpoints of a stream with a reserved shard id. The instance holding it is the
+// only one reading the stream.
+type ddbStreamLease struct {
+	Namespace string `json:"namespace"`
+	ShardId   string `json:"shardId"`
+	Owner     string `json:"owner"`
+	// ExpiresAt is stored as unix milliseconds, so it can be compared in conditions.
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+const ddbStreamsLeaseShardId = "lease"
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbStreamsCheckpointStore
+type DdbStreamsCheckpointStore interface {
+	// Get returns the checkpoint of a shard. If the shard was never read, an empty checkpoint is returned.
+	Get(ctx context.Context, shardId string) (*DdbStreamCheckpoint, error)
+	Put(ctx context.Context, checkpoint *DdbStreamCheckpoint) error
+	// AcquireLease takes or renews the lease of the stream for the owner for the given duration. It returns false if the
+	// lease is held by another owner and didn't expire yet.
+	AcquireLease(ctx context.Context, owner string, now time.Time, duration time.Duration) (bool, error)
+	// ReleaseLease releases the lease of the stream if it is still held by the owner.
+	ReleaseLease(ctx context.Context, owner string) error
+}
+
+type ddbStreamsCheckpointStore struct {
+	repo      ddb.Repository
+	namespace string
+}
+
+// NewDdbStreamsCheckpointStore stores the checkpoints in a ddb table shared by all ddb stream inputs of an application.
+// Checkpoints are namespaced by the consuming application and the consumed table.
+func NewDdbStreamsCheckpointStore(ctx context.Context, config cfg.Config, logger log.Logger, clientName string, tableName string) (DdbStreamsCheckpointStore, error) {
+	var err error
+	var repo ddb.Repository
+	var identity cfg.Identity
+	var namespace string
+
+	settings := &ddb.Settings{
+		ClientName: clientName,
+		ModelId: mdl.ModelId{
+			Name: "ddb-streams-checkpoints",
+		},
+		Main: ddb.MainSettings{
+			Model: &DdbStreamCheckpoint{},
+		},
+		DisableTracing: true,
+	}
+
+	if repo, err = ddb.NewRepository(ctx, config, logger, settings); err != nil {
+		return nil, fmt.Errorf("can not create ddb repository: %w", err)
+	}
+
+	if identity, err = cfg.GetAppIdentity(config); err != nil {
+		return nil, fmt.Errorf("can not get app identity from config: %w", err)
+	}
+
+	if namespace, err = identity.FormatNamespace("-"); err != nil {
+		return nil, fmt.Errorf("can not format checkpoint namespace: %w", err)
+	}
+
+	return NewDdbStreamsCheckpointStoreWithInterfaces(repo, fmt.Sprintf("%s-%s-%s", namespace, identity.Name, tableName)), nil
+}
+
+func NewDdbStreamsCheckpointStoreWithInterfaces(repo ddb.Repository, namespace string) DdbStreamsCheckpointStore {
+	return &ddbStreamsCheckpointStore{
+		repo:      repo,
+		namespace: namespace,
+	}
+}
+
+func (s *ddbStreamsCheckpointStore) Get(ctx context.Context, shardId string) (*DdbStreamCheckpoint, error) {
+	checkpoint := &DdbStreamCheckpoint{}
+	qb := s.repo.GetItemBuilder().WithHash(s.namespace).WithRange(shardId)
+
+	if _, err := s.repo.GetItem(ctx, qb, checkpoint); err != nil {
+		return nil, fmt.Errorf("can not get checkpoint: %w", err)
+	}
+
+	checkpoint.Namespace = s.namespace
+	checkpoint.ShardId = shardId
+
+	return checkpoint, nil
+}
+
+func (s *ddbStreamsCheckpointStore) Put(ctx context.Context, checkpoint *DdbStreamCheckpoint) error {
+	checkpoint.Namespace = s.namespace
+
+	if _, err := s.repo.PutItem(ctx, s.repo.PutItemBuilder(), checkpoint); err != nil {
+		return fmt.Errorf("can not put checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ddbStreamsCheckpointStore) AcquireLease(ctx context.Context, owner string, now time.Time, duration time.Duration) (bool, error) {
+	lease := &ddbStreamLease{
+		Namespace: s.namespace,
+		ShardId:   ddbStreamsLeaseShardId,
+		Owner:     owner,
+		ExpiresAt: now.Add(duration).UnixMilli(),
+	}
+
+	condition := ddb.Or(
+		ddb.AttributeNotExists("shardId"),
+		ddb.Eq("owner", owner),
+		ddb.Lt("expiresAt", now.UnixMilli()),
+	)
+
+	result, err := s.repo.PutItem(ctx, s.repo.PutItemBuilder().WithCondition(condition), lease)
+	if err != nil {
+		return false, fmt.Errorf("can not put lease: %w", err)
+	}
+
+	return !result.ConditionalCheckFailed, nil
+}
+
+func (s *ddbStreamsCheckpointStore) ReleaseLease(ctx context.Context, owner string) error {
+	qb := s.repo.DeleteItemBuilder().
+		WithHash(s.namespace).
+		WithRange(ddbStreamsLeaseShardId).
+		WithCondition(ddb.Eq("owner", owner))
+
+	if _, err := s.repo.DeleteItem(ctx, qb, &ddbStreamLease{}); err != nil {
+		return fmt.Errorf("can not delete lease: %w", err)
+	}
+
+	return nil
+}
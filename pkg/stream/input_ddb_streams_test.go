@@ -0,0 +1,292 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	dynamodbstreamsMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/dynamodbstreams/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	streamMocks "github.com/justtrackio/gosoline/pkg/stream/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ddbStreamsTestModel struct {
+	Id    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+type DdbStreamsInputTestSuite struct {
+	suite.Suite
+
+	client      *dynamodbstreamsMocks.Client
+	checkpoints *streamMocks.DdbStreamsCheckpointStore
+	clock       clock.FakeClock
+	input       stream.Input
+}
+
+func TestDdbStreamsInputTestSuite(t *testing.T) {
+	suite.Run(t, new(DdbStreamsInputTestSuite))
+}
+
+func (s *DdbStreamsInputTestSuite) SetupTest() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	healthCheckTimer := clock.NewHealthCheckTimerWithInterfaces(clock.NewFakeClock(), time.Minute)
+
+	s.client = dynamodbstreamsMocks.NewClient(s.T())
+	s.checkpoints = streamMocks.NewDdbStreamsCheckpointStore(s.T())
+	s.clock = clock.NewFakeClockAt(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	settings := &stream.DdbStreamsInputSettings{
+		StartingPosition:  stream.DdbStreamsStartingPositionTrimHorizon,
+		WaitTime:          time.Second,
+		DiscoverFrequency: time.Minute,
+		LeaseDuration:     time.Minute,
+	}
+
+	s.input = stream.NewDdbStreamsInputWithInterfaces(logger, s.client, s.checkpoints, s.clock, healthCheckTimer, settings, "table")
+}
+
+func (s *DdbStreamsInputTestSuite) TestReadFinishedShard() {
+	s.expectStream()
+	s.expectLease(true)
+	s.checkpoints.EXPECT().ReleaseLease(mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+
+	s.expectShards(
+		types.Shard{ShardId: aws.String("parent")},
+		types.Shard{ShardId: aws.String("child"), ParentShardId: aws.String("parent")},
+	)
+
+	s.checkpoints.EXPECT().Get(mock.Anything, "parent").Return(&stream.DdbStreamCheckpoint{
+		ShardId:        "parent",
+		SequenceNumber: "100",
+	}, nil)
+
+	s.client.EXPECT().GetShardIterator(mock.Anything, &dynamodbstreams.GetShardIteratorInput{
+		StreamArn:         aws.String("arn"),
+		ShardId:           aws.String("parent"),
+		ShardIteratorType: types.ShardIteratorTypeAfterSequenceNumber,
+		SequenceNumber:    aws.String("100"),
+	}).Return(&dynamodbstreams.GetShardIteratorOutput{
+		ShardIterator: aws.String("iterator"),
+	}, nil)
+
+	s.client.EXPECT().GetRecords(mock.Anything, &dynamodbstreams.GetRecordsInput{
+		ShardIterator: aws.String("iterator"),
+	}).Return(&dynamodbstreams.GetRecordsOutput{
+		Records: []types.Record{
+			{
+				EventID:   aws.String("event"),
+				EventName: types.OperationTypeModify,
+				Dynamodb: &types.StreamRecord{
+					SequenceNumber: aws.String("101"),
+					Keys: map[string]types.AttributeValue{
+						"id": &types.AttributeValueMemberN{Value: "9007199254740993"},
+					},
+					NewImage: map[string]types.AttributeValue{
+						"id":    &types.AttributeValueMemberN{Value: "9007199254740993"},
+						"title": &types.AttributeValueMemberS{Value: "new"},
+					},
+					OldImage: map[string]types.AttributeValue{
+						"id":    &types.AttributeValueMemberN{Value: "9007199254740993"},
+						"title": &types.AttributeValueMemberS{Value: "old"},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	finishedAt := s.clock.Now()
+	persisted := make(chan struct{})
+	s.checkpoints.EXPECT().Put(mock.Anything, &stream.DdbStreamCheckpoint{
+		ShardId:        "parent",
+		SequenceNumber: "101",
+		FinishedAt:     &finishedAt,
+		UpdatedAt:      finishedAt,
+	}).Run(func(ctx context.Context, checkpoint *stream.DdbStreamCheckpoint) {
+		close(persisted)
+	}).Return(nil)
+
+	done := make(chan error)
+	go func() {
+		done <- s.input.Run(s.T().Context())
+	}()
+
+	msg := <-s.input.Data()
+	s.Equal(stream.DdbStreamEventModify, msg.Attributes[stream.AttributeDdbStreamEventName])
+	s.Equal("parent", msg.Attributes[stream.AttributeDdbStreamShardId])
+	s.Equal("101", msg.Attributes[stream.AttributeDdbStreamSequenceNumber])
+
+	event := &stream.DdbStreamEvent[ddbStreamsTestModel]{}
+	err := stream.NewJsonEncoder().Decode([]byte(msg.Body), event)
+	s.NoError(err)
+
+	s.Equal("event", event.EventId)
+	s.Equal(stream.DdbStreamEventModify, event.EventName)
+	s.Equal(&ddbStreamsTestModel{Id: 9007199254740993, Title: "new"}, event.NewImage)
+	s.Equal(&ddbStreamsTestModel{Id: 9007199254740993, Title: "old"}, event.OldImage)
+
+	// the shard is only finished once its last message was acknowledged. the child shard is only started once the
+	// parent is finished and the next discovery ran
+	s.NoError(s.input.(stream.AcknowledgeableInput).Ack(s.T().Context(), msg, true))
+	<-persisted
+
+	s.input.Stop(context.Background())
+	s.NoError(<-done)
+
+	_, ok := <-s.input.Data()
+	assert.False(s.T(), ok)
+}
+
+func (s *DdbStreamsInputTestSuite) TestAckAdvancesCheckpointInOrder() {
+	s.expectStream()
+	s.expectLease(true)
+	s.checkpoints.EXPECT().ReleaseLease(mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	s.expectShards(types.Shard{ShardId: aws.String("shard")})
+
+	s.checkpoints.EXPECT().Get(mock.Anything, "shard").Return(&stream.DdbStreamCheckpoint{
+		ShardId: "shard",
+	}, nil)
+
+	s.client.EXPECT().GetShardIterator(mock.Anything, &dynamodbstreams.GetShardIteratorInput{
+		StreamArn:         aws.String("arn"),
+		ShardId:           aws.String("shard"),
+		ShardIteratorType: types.ShardIteratorTypeTrimHorizon,
+	}).Return(&dynamodbstreams.GetShardIteratorOutput{
+		ShardIterator: aws.String("iterator"),
+	}, nil)
+
+	s.client.EXPECT().GetRecords(mock.Anything, &dynamodbstreams.GetRecordsInput{
+		ShardIterator: aws.String("iterator"),
+	}).Return(&dynamodbstreams.GetRecordsOutput{
+		Records: []types.Record{
+			s.buildRecord("101"),
+			s.buildRecord("102"),
+		},
+		NextShardIterator: aws.String("next"),
+	}, nil)
+
+	s.client.EXPECT().GetRecords(mock.Anything, &dynamodbstreams.GetRecordsInput{
+		ShardIterator: aws.String("next"),
+	}).Return(&dynamodbstreams.GetRecordsOutput{
+		NextShardIterator: aws.String("next"),
+	}, nil)
+
+	persisted := make(chan struct{})
+	s.checkpoints.EXPECT().Put(mock.Anything, &stream.DdbStreamCheckpoint{
+		ShardId:        "shard",
+		SequenceNumber: "102",
+		UpdatedAt:      s.clock.Now(),
+	}).Run(func(ctx context.Context, checkpoint *stream.DdbStreamCheckpoint) {
+		close(persisted)
+	}).Return(nil).Once()
+
+	done := make(chan error)
+	go func() {
+		done <- s.input.Run(s.T().Context())
+	}()
+
+	first := <-s.input.Data()
+	second := <-s.input.Data()
+
+	input := s.input.(stream.AcknowledgeableInput)
+
+	// the checkpoint must not pass the first message before it was acknowledged
+	s.NoError(input.Ack(s.T().Context(), second, true))
+	s.NoError(input.Ack(s.T().Context(), first, false))
+	<-persisted
+
+	// wait for the reader to poll the empty shard again
+	s.clock.BlockUntil(1)
+	s.input.Stop(context.Background())
+	s.NoError(<-done)
+
+	// messages acknowledged after the lease was released are read again by the next instance
+	s.NoError(input.Ack(s.T().Context(), second, true))
+}
+
+func (s *DdbStreamsInputTestSuite) TestWaitForLease() {
+	s.expectStream()
+	s.expectLease(false)
+	s.expectLease(true)
+	s.checkpoints.EXPECT().ReleaseLease(mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+
+	described := make(chan struct{})
+	s.expectShards().Run(func(ctx context.Context, params *dynamodbstreams.DescribeStreamInput, optFns ...func(*dynamodbstreams.Options)) {
+		close(described)
+	})
+
+	done := make(chan error)
+	go func() {
+		done <- s.input.Run(s.T().Context())
+	}()
+
+	// another instance holds the lease, so we retry after a third of the lease duration
+	s.clock.BlockUntil(1)
+	s.clock.Advance(20 * time.Second)
+	<-described
+
+	s.input.Stop(context.Background())
+	s.NoError(<-done)
+}
+
+func (s *DdbStreamsInputTestSuite) TestLostLease() {
+	s.expectStream()
+	s.expectLease(true)
+	s.expectLease(false)
+	s.checkpoints.EXPECT().ReleaseLease(mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	s.expectShards()
+
+	done := make(chan error)
+	go func() {
+		done <- s.input.Run(s.T().Context())
+	}()
+
+	// the discovery and lease renewal tickers
+	s.clock.BlockUntilTickers(2)
+	s.clock.Advance(20 * time.Second)
+
+	s.EqualError(<-done, "lost lease of stream to another instance")
+}
+
+func (s *DdbStreamsInputTestSuite) expectStream() {
+	s.client.EXPECT().ListStreams(mock.Anything, &dynamodbstreams.ListStreamsInput{
+		TableName: aws.String("table"),
+	}).Return(&dynamodbstreams.ListStreamsOutput{
+		Streams: []types.Stream{{StreamArn: aws.String("arn")}},
+	}, nil)
+}
+
+func (s *DdbStreamsInputTestSuite) expectLease(acquired bool) {
+	s.checkpoints.EXPECT().AcquireLease(mock.Anything, mock.AnythingOfType("string"), mock.Anything, time.Minute).Return(acquired, nil).Once()
+}
+
+func (s *DdbStreamsInputTestSuite) expectShards(shards ...types.Shard) *dynamodbstreamsMocks.Client_DescribeStream_Call {
+	return s.client.EXPECT().DescribeStream(mock.Anything, &dynamodbstreams.DescribeStreamInput{
+		StreamArn: aws.String("arn"),
+	}).Return(&dynamodbstreams.DescribeStreamOutput{
+		StreamDescription: &types.StreamDescription{
+			Shards: shards,
+		},
+	}, nil)
+}
+
+func (s *DdbStreamsInputTestSuite) buildRecord(sequenceNumber string) types.Record {
+	return types.Record{
+		EventID:   aws.String("event-" + sequenceNumber),
+		EventName: types.OperationTypeInsert,
+		Dynamodb: &types.StreamRecord{
+			SequenceNumber: aws.String(sequenceNumber),
+			Keys: map[string]types.AttributeValue{
+				"id": &types.AttributeValueMemberN{Value: "1"},
+			},
+		},
+	}
+}
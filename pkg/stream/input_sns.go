@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sns"
 	"github.com/justtrackio/gosoline/pkg/cloud/aws/sqs"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/reslife"
@@ -19,6 +20,7 @@ type SnsInputSettings struct {
 	MaxNumberOfMessages int32                      `cfg:"max_number_of_messages" default:"10" validate:"min=1,max=10"`
 	WaitTime            int32                      `cfg:"wait_time"`
 	RedrivePolicy       sqs.RedrivePolicy          `cfg:"redrive_policy"`
+	Fifo                sqs.FifoSettings           `cfg:"fifo"`
 	VisibilityTimeout   int                        `cfg:"visibility_timeout"`
 	RunnerCount         int                        `cfg:"runner_count"`
 	ClientName          string                     `cfg:"client_name"`
@@ -38,14 +40,15 @@ func (s SnsInputSettings) GetQueueId() string {
 }
 
 func (s SnsInputSettings) IsFifoEnabled() bool {
-	return false
+	return s.Fifo.Enabled
 }
 
 type SnsInputTarget struct {
-	Identity   cfg.Identity
-	TopicId    string
-	Attributes map[string]string
-	ClientName string
+	Identity     cfg.Identity
+	TopicId      string
+	FilterPolicy sns.FilterPolicy
+	ClientName   string
+	Fifo         sns.FifoSettings
 }
 
 func (t SnsInputTarget) GetIdentity() cfg.Identity {
@@ -60,6 +63,10 @@ func (t SnsInputTarget) GetTopicId() string {
 	return t.TopicId
 }
 
+func (t SnsInputTarget) IsFifoEnabled() bool {
+	return t.Fifo.Enabled
+}
+
 type snsInput struct {
 	*sqsInput
 }
@@ -76,11 +83,23 @@ func NewSnsInput(ctx context.Context, config cfg.Config, logger log.Logger, sett
 		VisibilityTimeout:   settings.VisibilityTimeout,
 		RunnerCount:         settings.RunnerCount,
 		RedrivePolicy:       settings.RedrivePolicy,
+		Fifo:                settings.Fifo,
 		ClientName:          settings.ClientName,
 		Healthcheck:         settings.Healthcheck,
 		Unmarshaller:        UnmarshallerSns,
 	}
 
+	for _, target := range targets {
+		// sqs fifo queues only accept messages from sns fifo topics
+		if settings.Fifo.Enabled && !target.Fifo.Enabled {
+			return nil, fmt.Errorf("the fifo queue %s can not subscribe to the standard topic %s", settings.QueueId, target.TopicId)
+		}
+
+		if err = target.FilterPolicy.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid filter policy for topic %s: %w", target.TopicId, err)
+		}
+	}
+
 	if input, err = NewSqsInput(ctx, config, logger, sqsInputSettings); err != nil {
 		return nil, fmt.Errorf("can not create sqsInput: %w", err)
 	}
@@ -76,11 +76,11 @@ func (l *lifecycleManager) Create(ctx context.Context) error {
 	}
 
 	for topicName, target := range l.targets {
-		if topicArn, err = l.snsService.CreateTopic(ctx, topicName); err != nil {
+		if topicArn, err = l.snsService.CreateTopic(ctx, topicName, target.Fifo); err != nil {
 			return fmt.Errorf("can not create topic %s: %w", topicName, err)
 		}
 
-		if err = l.snsService.SubscribeSqs(ctx, props.Arn, topicArn, target.Attributes); err != nil {
+		if err = l.snsService.SubscribeSqs(ctx, props.Arn, topicArn, target.FilterPolicy); err != nil {
 			return fmt.Errorf("can not subscribe to queue: %w", err)
 		}
 	}
@@ -0,0 +1,132 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const LogHandlerTypeStream = "stream"
+
+func init() {
+	log.AddHandlerFactory(LogHandlerTypeStream, logHandlerFactory)
+}
+
+// LogHandlerSettings configures the "stream" log handler, which publishes log records to a configured stream output.
+type LogHandlerSettings struct {
+	Level string `cfg:"level" default:"info"`
+	// Output is the name of the output configured at stream.output.<name>.
+	Output string `cfg:"output" validate:"required"`
+	// Channels restricts the handler to log records of these channels. All channels are published if empty.
+	Channels []string          `cfg:"channels"`
+	Batch    log.BatchSettings `cfg:"batch"`
+	Retry    log.RetrySettings `cfg:"retry"`
+}
+
+// LogRecord is the body of the messages published by the "stream" log handler.
+type LogRecord struct {
+	Timestamp     time.Time      `json:"timestamp"`
+	Level         string         `json:"level"`
+	Channel       string         `json:"channel"`
+	Message       string         `json:"message"`
+	Error         string         `json:"error,omitempty"`
+	Fields        map[string]any `json:"fields,omitempty"`
+	ContextFields map[string]any `json:"context,omitempty"`
+}
+
+// LogHandler publishes log records as json messages to a stream output. Records are buffered and written in batches,
+// so it can be used to route audit relevant logs into a data pipeline using kinesis, kafka, sqs or any other output.
+type LogHandler struct {
+	output   Output
+	level    int
+	channels funk.Set[string]
+	batcher  *log.Batcher[WritableMessage]
+}
+
+func logHandlerFactory(config cfg.Config, name string) (log.Handler, error) {
+	var err error
+	var output Output
+
+	settings := &LogHandlerSettings{}
+	if err = log.UnmarshalHandlerSettingsFromConfig(config, name, settings); err != nil {
+		return nil, err
+	}
+
+	// the output must not log to the logger this handler is part of, otherwise every log message of the output would
+	// be published again by this handler
+	ctx := appctx.WithContainer(context.Background())
+	logger := log.NewCliLogger().WithChannel("log-handler-stream")
+
+	if output, _, err = NewConfigurableOutput(ctx, config, logger, settings.Output); err != nil {
+		return nil, fmt.Errorf("can not create output %s for log handler %s: %w", settings.Output, name, err)
+	}
+
+	return NewLogHandlerWithInterfaces(clock.Provider, output, settings)
+}
+
+// NewLogHandlerWithInterfaces creates a new "stream" log handler writing to the given output.
+func NewLogHandlerWithInterfaces(clock clock.Clock, output Output, settings *LogHandlerSettings) (*LogHandler, error) {
+	level, ok := log.LevelPriority(settings.Level)
+	if !ok {
+		return nil, fmt.Errorf("invalid log level %q", settings.Level)
+	}
+
+	handler := &LogHandler{
+		output:   output,
+		level:    level,
+		channels: funk.SliceToSet(settings.Channels),
+	}
+	handler.batcher = log.NewBatcher(clock, settings.Batch, settings.Retry, handler.output.Write)
+
+	return handler, nil
+}
+
+// ChannelLevel returns nil, channels are selected using the channels setting.
+func (h *LogHandler) ChannelLevel(string) (*int, error) {
+	return nil, nil
+}
+
+// Level returns the minimum priority of log records published by the handler.
+func (h *LogHandler) Level() int {
+	return h.level
+}
+
+// Log buffers the log record to be published with the next batch.
+func (h *LogHandler) Log(_ context.Context, timestamp time.Time, level int, msg string, args []any, err error, data log.Data) error {
+	if !h.channels.Empty() && !h.channels.Contains(data.Channel) {
+		return nil
+	}
+
+	record := LogRecord{
+		Timestamp:     timestamp,
+		Level:         log.LevelName(level),
+		Channel:       data.Channel,
+		Message:       fmt.Sprintf(msg, args...),
+		Fields:        data.Fields,
+		ContextFields: data.ContextFields,
+	}
+
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	message, marshalErr := MarshalJsonMessage(record)
+	if marshalErr != nil {
+		return fmt.Errorf("can not marshal log record: %w", marshalErr)
+	}
+
+	h.batcher.Add(message)
+
+	return nil
+}
+
+// Flush publishes all buffered log records.
+func (h *LogHandler) Flush() error {
+	return h.batcher.Flush()
+}
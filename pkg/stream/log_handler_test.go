@@ -0,0 +1,88 @@
+package stream_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogHandler(t *testing.T) {
+	output := stream.NewInMemoryOutput()
+	handler, err := stream.NewLogHandlerWithInterfaces(clock.NewFakeClock(), output, &stream.LogHandlerSettings{
+		Level:    "info",
+		Channels: []string{"audit"},
+		Batch: log.BatchSettings{
+			Size:     10,
+			Interval: time.Minute,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, log.PriorityInfo, handler.Level())
+
+	timestamp := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	err = handler.Log(t.Context(), timestamp, log.PriorityInfo, "ignored", nil, nil, log.Data{Channel: "main"})
+	assert.NoError(t, err)
+
+	err = handler.Log(t.Context(), timestamp, log.PriorityWarn, "user %s deleted", []any{"alice"}, fmt.Errorf("partially"), log.Data{
+		Channel:       "audit",
+		Fields:        map[string]any{"user": "alice"},
+		ContextFields: map[string]any{"request_id": "req"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, output.Len(), "records should be buffered")
+
+	require.NoError(t, handler.Flush())
+	require.Equal(t, 1, output.Len())
+
+	msg, ok := output.Get(0)
+	require.True(t, ok)
+
+	assert.Equal(t, stream.EncodingJson.String(), msg.Attributes[stream.AttributeEncoding])
+	assert.JSONEq(t, `{
+		"timestamp": "2024-01-01T10:00:00Z",
+		"level": "warn",
+		"channel": "audit",
+		"message": "user alice deleted",
+		"error": "partially",
+		"fields": {"user": "alice"},
+		"context": {"request_id": "req"}
+	}`, msg.Body)
+}
+
+func TestLogHandlerFromConfig(t *testing.T) {
+	config := cfg.New(map[string]any{
+		"log": map[string]any{
+			"handlers": map[string]any{
+				"audit": map[string]any{
+					"type":   "stream",
+					"output": "audit-logs",
+				},
+			},
+		},
+		"stream": map[string]any{
+			"output": map[string]any{
+				"audit-logs": map[string]any{
+					"type": "inMemory",
+				},
+			},
+		},
+	})
+
+	handlers, err := log.NewHandlersFromConfig(config)
+	require.NoError(t, err)
+	require.Len(t, handlers, 1)
+
+	logger := log.NewLoggerWithInterfaces(clock.NewFakeClock(), handlers)
+	logger.Info(t.Context(), "audit entry")
+
+	require.NoError(t, logger.Flush())
+	assert.Equal(t, 1, stream.ProvideInMemoryOutput("audit-logs").Len())
+}
@@ -66,6 +66,24 @@ func MarshalJsonMessage(body any, attributes ...map[string]string) (*Message, er
 	return msg, nil
 }
 
+func NewMsgpackMessage(body string, attributes ...map[string]string) *Message {
+	msg := NewMessage(body, attributes...)
+	msg.Attributes[AttributeEncoding] = EncodingMsgpack.String()
+
+	return msg
+}
+
+func MarshalMsgpackMessage(body any, attributes ...map[string]string) (*Message, error) {
+	data, err := NewBase64LayeredMsgpackEncoder().Encode(body)
+	if err != nil {
+		return nil, fmt.Errorf("can not marshal body to msgpack: %w", err)
+	}
+
+	msg := NewMsgpackMessage(string(data), attributes...)
+
+	return msg, nil
+}
+
 func NewProtobufMessage(body string, attributes ...map[string]string) *Message {
 	msg := NewMessage(body, attributes...)
 	msg.Attributes[AttributeEncoding] = EncodingProtobuf.String()
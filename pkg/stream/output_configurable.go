@@ -6,6 +6,10 @@ import (
 	"time"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/eventbridge"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/firehose"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/lambda"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sns"
 	"github.com/justtrackio/gosoline/pkg/cloud/aws/sqs"
 	kafkaProducer "github.com/justtrackio/gosoline/pkg/kafka/producer"
 	"github.com/justtrackio/gosoline/pkg/log"
@@ -13,10 +17,13 @@ import (
 )
 
 func init() {
+	AddOutputFactory(OutputTypeEventBridge, newEventBridgeOutputFromConfig)
 	AddOutputFactory(OutputTypeFile, newFileOutputFromConfig)
+	AddOutputFactory(OutputTypeFirehose, newFirehoseOutputFromConfig)
 	AddOutputFactory(OutputTypeInMemory, newInMemoryOutputFromConfig)
 	AddOutputFactory(OutputTypeKafka, newKafkaOutputFromConfig)
 	AddOutputFactory(OutputTypeKinesis, newKinesisOutputFromConfig)
+	AddOutputFactory(OutputTypeLambda, newLambdaOutputFromConfig)
 	AddOutputFactory(OutputTypeMultiple, NewConfigurableMultiOutput)
 	AddOutputFactory(OutputTypeNoOp, newNoOpOutput)
 	AddOutputFactory(OutputTypeRedis, newRedisListOutputFromConfig)
@@ -25,15 +32,18 @@ func init() {
 }
 
 const (
-	OutputTypeFile     = "file"
-	OutputTypeInMemory = "inMemory"
-	OutputTypeKafka    = "kafka"
-	OutputTypeKinesis  = "kinesis"
-	OutputTypeMultiple = "multiple"
-	OutputTypeNoOp     = "noop"
-	OutputTypeRedis    = "redis"
-	OutputTypeSns      = "sns"
-	OutputTypeSqs      = "sqs"
+	OutputTypeEventBridge = "eventbridge"
+	OutputTypeFile        = "file"
+	OutputTypeFirehose    = "firehose"
+	OutputTypeInMemory    = "inMemory"
+	OutputTypeKafka       = "kafka"
+	OutputTypeKinesis     = "kinesis"
+	OutputTypeLambda      = "lambda"
+	OutputTypeMultiple    = "multiple"
+	OutputTypeNoOp        = "noop"
+	OutputTypeRedis       = "redis"
+	OutputTypeSns         = "sns"
+	OutputTypeSqs         = "sqs"
 )
 
 var outputFactories = map[string]OutputFactory{}
@@ -86,6 +96,57 @@ func NewConfigurableOutput(ctx context.Context, config cfg.Config, logger log.Lo
 	return outputWithTracer, outputCapabilities, nil
 }
 
+type EventBridgeOutputConfiguration struct {
+	BaseOutputConfiguration
+	Type                string `cfg:"type" default:"eventbridge"`
+	ClientName          string `cfg:"client_name" default:"default"`
+	EventBusName        string `cfg:"event_bus_name" default:"default"`
+	Source              string `cfg:"source"`
+	SourceAttribute     string `cfg:"source_attribute"`
+	DetailType          string `cfg:"detail_type"`
+	DetailTypeAttribute string `cfg:"detail_type_attribute"`
+}
+
+func newEventBridgeOutputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Output, *OutputCapabilities, error) {
+	key := ConfigurableOutputKey(name)
+	configuration := EventBridgeOutputConfiguration{}
+	if err := config.UnmarshalKey(key, &configuration); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal eventbridge output settings for key %q in newEventBridgeOutputFromConfig: %w", key, err)
+	}
+
+	if configuration.Source == "" && configuration.SourceAttribute == "" {
+		return nil, nil, fmt.Errorf("either the source or the source_attribute of the eventbridge output %s has to be set", name)
+	}
+
+	if configuration.DetailType == "" && configuration.DetailTypeAttribute == "" {
+		return nil, nil, fmt.Errorf("either the detail_type or the detail_type_attribute of the eventbridge output %s has to be set", name)
+	}
+
+	outputCapabilities := &OutputCapabilities{
+		IsPartitionedOutput: false,
+		ProvidesCompression: false,
+		// source and detail type are mapped from the attributes of every single message
+		SupportsAggregation:               false,
+		MaxBatchSize:                      mdl.Box(eventbridge.MaxBatchSize),
+		MaxMessageSize:                    mdl.Box(256 * 1024),
+		IgnoreProducerDaemonBatchSettings: false,
+	}
+
+	output, err := NewEventBridgeOutput(ctx, config, logger, &EventBridgeOutputSettings{
+		ClientName:          configuration.ClientName,
+		EventBusName:        configuration.EventBusName,
+		Source:              configuration.Source,
+		SourceAttribute:     configuration.SourceAttribute,
+		DetailType:          configuration.DetailType,
+		DetailTypeAttribute: configuration.DetailTypeAttribute,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not create eventbridge output %s: %w", name, err)
+	}
+
+	return output, outputCapabilities, nil
+}
+
 func newFileOutputFromConfig(_ context.Context, config cfg.Config, logger log.Logger, name string) (Output, *OutputCapabilities, error) {
 	key := ConfigurableOutputKey(name)
 	settings := &FileOutputSettings{}
@@ -191,6 +252,55 @@ func newKafkaOutputFromConfig(ctx context.Context, config cfg.Config, logger log
 	return output, outputCapabilities, nil
 }
 
+type FirehoseOutputConfiguration struct {
+	BaseOutputConfiguration
+	cfg.ResourceIdentifier
+	Type                   string   `cfg:"type" default:"firehose"`
+	ClientName             string   `cfg:"client_name" default:"default"`
+	DeliveryStreamName     string   `cfg:"delivery_stream_name" validate:"required"`
+	PartitionKeyAttributes []string `cfg:"partition_key_attributes"`
+	Delimiter              string   `cfg:"delimiter" default:"\n"`
+	MaxBatchSize           int      `cfg:"max_batch_size" default:"500" validate:"gt=0,lte=500"`
+	MaxBatchBytes          int      `cfg:"max_batch_bytes" default:"4194304" validate:"gt=0,lte=4194304"`
+}
+
+func newFirehoseOutputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Output, *OutputCapabilities, error) {
+	key := ConfigurableOutputKey(name)
+	configuration := &FirehoseOutputConfiguration{}
+	if err := config.UnmarshalKey(key, configuration); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal firehose output settings for key %q in newFirehoseOutputFromConfig: %w", key, err)
+	}
+
+	if err := configuration.PadFromConfig(config); err != nil {
+		return nil, nil, fmt.Errorf("failed to pad resource identifier for firehose output %q: %w", name, err)
+	}
+
+	outputCapabilities := &OutputCapabilities{
+		IsPartitionedOutput: false,
+		ProvidesCompression: false,
+		// the delivery stream extracts the partition keys from the written messages, so we can not aggregate them
+		SupportsAggregation:               len(configuration.PartitionKeyAttributes) == 0,
+		MaxBatchSize:                      mdl.Box(configuration.MaxBatchSize),
+		MaxMessageSize:                    mdl.Box(firehose.RecordSizeMax - len(configuration.Delimiter)),
+		IgnoreProducerDaemonBatchSettings: false,
+	}
+
+	output, err := NewFirehoseOutput(ctx, config, logger, &FirehoseOutputSettings{
+		ResourceIdentifier:     configuration.ResourceIdentifier,
+		ClientName:             configuration.ClientName,
+		DeliveryStreamName:     configuration.DeliveryStreamName,
+		PartitionKeyAttributes: configuration.PartitionKeyAttributes,
+		Delimiter:              configuration.Delimiter,
+		BatchSize:              configuration.MaxBatchSize,
+		BatchBytes:             configuration.MaxBatchBytes,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not create firehose output %s: %w", name, err)
+	}
+
+	return output, outputCapabilities, nil
+}
+
 type KinesisOutputConfiguration struct {
 	BaseOutputConfiguration
 	cfg.ResourceIdentifier
@@ -231,6 +341,57 @@ func newKinesisOutputFromConfig(ctx context.Context, config cfg.Config, logger l
 	return output, outputCapabilities, nil
 }
 
+type LambdaOutputConfiguration struct {
+	BaseOutputConfiguration
+	Type           string `cfg:"type" default:"lambda"`
+	ClientName     string `cfg:"client_name" default:"default"`
+	FunctionName   string `cfg:"function_name" validate:"required"`
+	Qualifier      string `cfg:"qualifier"`
+	InvocationType string `cfg:"invocation_type" default:"RequestResponse" validate:"oneof=RequestResponse Event"`
+	Batch          bool   `cfg:"batch" default:"false"`
+	MaxBatchSize   int    `cfg:"max_batch_size" default:"10" validate:"gt=0"`
+}
+
+func newLambdaOutputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Output, *OutputCapabilities, error) {
+	key := ConfigurableOutputKey(name)
+	configuration := LambdaOutputConfiguration{}
+	if err := config.UnmarshalKey(key, &configuration); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal lambda output settings for key %q in newLambdaOutputFromConfig: %w", key, err)
+	}
+
+	maxMessageSize := lambda.MaxPayloadSizeRequestResponse
+	if configuration.InvocationType == lambda.InvocationTypeEvent {
+		maxMessageSize = lambda.MaxPayloadSizeEvent
+	}
+
+	outputCapabilities := &OutputCapabilities{
+		IsPartitionedOutput: false,
+		ProvidesCompression: false,
+		// the function receives the messages as they were written, so we can not aggregate them
+		SupportsAggregation:               false,
+		MaxBatchSize:                      nil,
+		MaxMessageSize:                    mdl.Box(maxMessageSize),
+		IgnoreProducerDaemonBatchSettings: false,
+	}
+
+	if configuration.Batch {
+		outputCapabilities.MaxBatchSize = mdl.Box(configuration.MaxBatchSize)
+	}
+
+	output, err := NewLambdaOutput(ctx, config, logger, &LambdaOutputSettings{
+		ClientName:     configuration.ClientName,
+		FunctionName:   configuration.FunctionName,
+		Qualifier:      configuration.Qualifier,
+		InvocationType: configuration.InvocationType,
+		Batch:          configuration.Batch,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not create lambda output %s: %w", name, err)
+	}
+
+	return output, outputCapabilities, nil
+}
+
 type redisListOutputConfiguration struct {
 	ServerName string `cfg:"server_name" default:"default" validate:"required,min=1"`
 	Key        string `cfg:"key" validate:"required,min=1"`
@@ -260,9 +421,10 @@ func newRedisListOutputFromConfig(ctx context.Context, config cfg.Config, logger
 type SnsOutputConfiguration struct {
 	BaseOutputConfiguration
 	cfg.ResourceIdentifier
-	Type       string `cfg:"type" default:"sns"`
-	TopicId    string `cfg:"topic_id" validate:"required"`
-	ClientName string `cfg:"client_name" default:"default"`
+	Type       string           `cfg:"type" default:"sns"`
+	TopicId    string           `cfg:"topic_id" validate:"required"`
+	ClientName string           `cfg:"client_name" default:"default"`
+	Fifo       sns.FifoSettings `cfg:"fifo"`
 }
 
 func newSnsOutputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Output, *OutputCapabilities, error) {
@@ -289,6 +451,7 @@ func newSnsOutputFromConfig(ctx context.Context, config cfg.Config, logger log.L
 		Identity:   configuration.ToIdentity(),
 		TopicId:    configuration.TopicId,
 		ClientName: configuration.ClientName,
+		Fifo:       configuration.Fifo,
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("can not create sns output %s: %w", name, err)
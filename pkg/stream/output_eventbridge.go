@@ -0,0 +1,105 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/eventbridge"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type EventBridgeOutputSettings struct {
+	ClientName   string
+	EventBusName string
+	// Source is used for all messages without a value for the SourceAttribute.
+	Source          string
+	SourceAttribute string
+	// DetailType is used for all messages without a value for the DetailTypeAttribute.
+	DetailType          string
+	DetailTypeAttribute string
+}
+
+type eventBridgeOutput struct {
+	logger   log.Logger
+	bus      eventbridge.EventBus
+	settings *EventBridgeOutputSettings
+}
+
+func NewEventBridgeOutput(ctx context.Context, config cfg.Config, logger log.Logger, settings *EventBridgeOutputSettings) (Output, error) {
+	bus, err := eventbridge.NewEventBus(ctx, config, logger, &eventbridge.EventBusSettings{
+		EventBusName: settings.EventBusName,
+		ClientName:   settings.ClientName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not create event bus: %w", err)
+	}
+
+	return NewEventBridgeOutputWithInterfaces(logger, bus, settings), nil
+}
+
+func NewEventBridgeOutputWithInterfaces(logger log.Logger, bus eventbridge.EventBus, settings *EventBridgeOutputSettings) Output {
+	return &eventBridgeOutput{
+		logger:   logger,
+		bus:      bus,
+		settings: settings,
+	}
+}
+
+func (o *eventBridgeOutput) WriteOne(ctx context.Context, msg WritableMessage) error {
+	return o.Write(ctx, []WritableMessage{msg})
+}
+
+func (o *eventBridgeOutput) Write(ctx context.Context, batch []WritableMessage) error {
+	events := make([]eventbridge.Event, len(batch))
+
+	for i, msg := range batch {
+		event, err := o.buildEvent(msg)
+		if err != nil {
+			return fmt.Errorf("can not build event for message %d: %w", i, err)
+		}
+
+		events[i] = event
+	}
+
+	if err := o.bus.PutEvents(ctx, events); err != nil {
+		return fmt.Errorf("can not put events to event bus %s: %w", o.settings.EventBusName, err)
+	}
+
+	return nil
+}
+
+// buildEvent puts the whole message, including its attributes, as detail of the event. This way consumers of the
+// event, e.g. a sqs queue, can decode it the same way as messages of any other output.
+func (o *eventBridgeOutput) buildEvent(msg WritableMessage) (eventbridge.Event, error) {
+	attributes := getAttributes(msg)
+
+	detail, err := msg.MarshalToString()
+	if err != nil {
+		return eventbridge.Event{}, fmt.Errorf("can not marshal message: %w", err)
+	}
+
+	event := eventbridge.Event{
+		Source:     o.mapAttribute(attributes, o.settings.SourceAttribute, o.settings.Source),
+		DetailType: o.mapAttribute(attributes, o.settings.DetailTypeAttribute, o.settings.DetailType),
+		Detail:     detail,
+	}
+
+	if event.Source == "" {
+		return eventbridge.Event{}, fmt.Errorf("the message has no source: neither the attribute %q nor a default source is set", o.settings.SourceAttribute)
+	}
+
+	if event.DetailType == "" {
+		return eventbridge.Event{}, fmt.Errorf("the message has no detail type: neither the attribute %q nor a default detail type is set", o.settings.DetailTypeAttribute)
+	}
+
+	return event, nil
+}
+
+func (o *eventBridgeOutput) mapAttribute(attributes map[string]string, attribute string, defaultValue string) string {
+	if value, ok := attributes[attribute]; attribute != "" && ok && value != "" {
+		return value
+	}
+
+	return defaultValue
+}
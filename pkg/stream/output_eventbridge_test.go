@@ -0,0 +1,56 @@
+package stream_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/eventbridge"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/eventbridge/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBridgeOutput_Write(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	bus := mocks.NewEventBus(t)
+
+	bus.EXPECT().PutEvents(matcher.Context, []eventbridge.Event{
+		{
+			Source:     "orders",
+			DetailType: "created",
+			Detail:     `{"attributes":{"encoding":"application/json","source":"orders","type":"created"},"body":"1"}`,
+		},
+		{
+			Source:     "gosoline",
+			DetailType: "default",
+			Detail:     `{"attributes":{"encoding":"application/json"},"body":"2"}`,
+		},
+	}).Return(nil).Once()
+
+	output := stream.NewEventBridgeOutputWithInterfaces(logger, bus, &stream.EventBridgeOutputSettings{
+		Source:              "gosoline",
+		SourceAttribute:     "source",
+		DetailType:          "default",
+		DetailTypeAttribute: "type",
+	})
+
+	err := output.Write(t.Context(), []stream.WritableMessage{
+		mkTestMessage(t, 1, map[string]string{"source": "orders", "type": "created"}),
+		mkTestMessage(t, 2, map[string]string{}),
+	})
+	assert.NoError(t, err)
+}
+
+func TestEventBridgeOutput_WriteMissingDetailType(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	bus := mocks.NewEventBus(t)
+
+	output := stream.NewEventBridgeOutputWithInterfaces(logger, bus, &stream.EventBridgeOutputSettings{
+		Source:              "gosoline",
+		DetailTypeAttribute: "type",
+	})
+
+	err := output.WriteOne(t.Context(), mkTestMessage(t, 1, map[string]string{}))
+	assert.EqualError(t, err, `can not build event for message 0: the message has no detail type: neither the attribute "type" nor a default detail type is set`)
+}
@@ -0,0 +1,111 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws"
+	gosoFirehose "github.com/justtrackio/gosoline/pkg/cloud/aws/firehose"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type FirehoseOutputSettings struct {
+	cfg.ResourceIdentifier
+	ClientName         string
+	DeliveryStreamName string
+	// PartitionKeyAttributes are the message attributes every message has to provide. They are part of the written
+	// json, so the dynamic partitioning of the delivery stream can extract them with a query like .attributes.<name>.
+	PartitionKeyAttributes []string
+	// Delimiter is appended to every record, a newline by default to keep the records apart in the delivered files.
+	Delimiter string
+	// BatchSize is the maximum number of records per request.
+	BatchSize int
+	// BatchBytes is the maximum size of all records per request.
+	BatchBytes int
+}
+
+type firehoseOutput struct {
+	recordWriter gosoFirehose.RecordWriter
+	settings     *FirehoseOutputSettings
+}
+
+func NewFirehoseOutput(ctx context.Context, config cfg.Config, logger log.Logger, settings *FirehoseOutputSettings) (Output, error) {
+	var err error
+	var recordWriter gosoFirehose.RecordWriter
+
+	clientsKey := aws.GetClientConfigKey("firehose", settings.ClientName)
+	defaultClientKey := aws.GetClientConfigKey("firehose", "default")
+	clientDefaultsKey := aws.GetDefaultsKey(settings.ClientName)
+	defaultsKey := aws.GetDefaultsKey("default")
+
+	backoffSettings, err := exec.ReadBackoffSettings(config, clientsKey, clientDefaultsKey, defaultClientKey, defaultsKey, "cloud.aws.defaults")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backoff settings for firehose: %w", err)
+	}
+	backoffSettings.InitialInterval = time.Second
+
+	recordWriterSettings := &gosoFirehose.RecordWriterSettings{
+		ResourceIdentifier: settings.ResourceIdentifier,
+		ClientName:         settings.ClientName,
+		DeliveryStreamName: settings.DeliveryStreamName,
+		BatchSize:          settings.BatchSize,
+		BatchBytes:         settings.BatchBytes,
+		Backoff:            backoffSettings,
+	}
+
+	if recordWriter, err = gosoFirehose.NewRecordWriter(ctx, config, logger, recordWriterSettings); err != nil {
+		return nil, fmt.Errorf("can not create record writer for delivery stream %s: %w", settings.DeliveryStreamName, err)
+	}
+
+	return NewFirehoseOutputWithInterfaces(recordWriter, settings), nil
+}
+
+func NewFirehoseOutputWithInterfaces(recordWriter gosoFirehose.RecordWriter, settings *FirehoseOutputSettings) Output {
+	return &firehoseOutput{
+		recordWriter: recordWriter,
+		settings:     settings,
+	}
+}
+
+func (o *firehoseOutput) WriteOne(ctx context.Context, record WritableMessage) error {
+	return o.Write(ctx, []WritableMessage{record})
+}
+
+func (o *firehoseOutput) Write(ctx context.Context, batch []WritableMessage) error {
+	var err error
+	records := make([][]byte, len(batch))
+
+	for i, msg := range batch {
+		if records[i], err = o.buildRecord(msg); err != nil {
+			return fmt.Errorf("can not build record: %w", err)
+		}
+	}
+
+	return o.recordWriter.PutRecordBatch(ctx, records)
+}
+
+func (o *firehoseOutput) buildRecord(msg WritableMessage) ([]byte, error) {
+	attributes := getAttributes(msg)
+	missing := make([]string, 0)
+
+	for _, attribute := range o.settings.PartitionKeyAttributes {
+		if attributes[attribute] == "" {
+			missing = append(missing, attribute)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("the message is missing the partition key attributes %s", strings.Join(missing, ", "))
+	}
+
+	data, err := msg.MarshalToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("can not marshal message to bytes: %w", err)
+	}
+
+	return append(data, o.settings.Delimiter...), nil
+}
@@ -0,0 +1,50 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+
+	firehoseMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/firehose/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/stretchr/testify/suite"
+)
+
+type OutputFirehoseTestSuite struct {
+	suite.Suite
+
+	ctx          context.Context
+	recordWriter *firehoseMocks.RecordWriter
+	output       stream.Output
+}
+
+func (s *OutputFirehoseTestSuite) SetupTest() {
+	s.ctx = s.T().Context()
+	s.recordWriter = firehoseMocks.NewRecordWriter(s.T())
+	s.output = stream.NewFirehoseOutputWithInterfaces(s.recordWriter, &stream.FirehoseOutputSettings{
+		PartitionKeyAttributes: []string{"customerId"},
+		Delimiter:              "\n",
+	})
+}
+
+func (s *OutputFirehoseTestSuite) TestWrite() {
+	expectedRecords := [][]byte{
+		[]byte(`{"attributes":{"customerId":"1","encoding":"application/json"},"body":"body 1"}` + "\n"),
+		[]byte(`{"attributes":{"customerId":"2","encoding":"application/json"},"body":"body 2"}` + "\n"),
+	}
+	s.recordWriter.EXPECT().PutRecordBatch(s.ctx, expectedRecords).Return(nil)
+
+	err := s.output.Write(s.ctx, []stream.WritableMessage{
+		stream.NewJsonMessage("body 1", map[string]string{"customerId": "1"}),
+		stream.NewJsonMessage("body 2", map[string]string{"customerId": "2"}),
+	})
+	s.NoError(err)
+}
+
+func (s *OutputFirehoseTestSuite) TestWriteMissingPartitionKey() {
+	err := s.output.WriteOne(s.ctx, stream.NewJsonMessage("body", map[string]string{}))
+	s.EqualError(err, "can not build record: the message is missing the partition key attributes customerId")
+}
+
+func TestOutputFirehoseTestSuite(t *testing.T) {
+	suite.Run(t, new(OutputFirehoseTestSuite))
+}
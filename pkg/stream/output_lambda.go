@@ -0,0 +1,104 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/lambda"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type LambdaOutputSettings struct {
+	ClientName     string
+	FunctionName   string
+	Qualifier      string
+	InvocationType string
+	// Batch invokes the function once per batch with a json array of all messages instead of once per message.
+	Batch bool
+}
+
+type lambdaOutput struct {
+	logger   log.Logger
+	invoker  lambda.Invoker
+	settings *LambdaOutputSettings
+}
+
+func NewLambdaOutput(ctx context.Context, config cfg.Config, logger log.Logger, settings *LambdaOutputSettings) (Output, error) {
+	invoker, err := lambda.NewInvoker(ctx, config, logger, &lambda.InvokerSettings{
+		ClientName:     settings.ClientName,
+		FunctionName:   settings.FunctionName,
+		Qualifier:      settings.Qualifier,
+		InvocationType: settings.InvocationType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not create lambda invoker: %w", err)
+	}
+
+	return NewLambdaOutputWithInterfaces(logger, invoker, settings), nil
+}
+
+func NewLambdaOutputWithInterfaces(logger log.Logger, invoker lambda.Invoker, settings *LambdaOutputSettings) Output {
+	return &lambdaOutput{
+		logger:   logger,
+		invoker:  invoker,
+		settings: settings,
+	}
+}
+
+func (o *lambdaOutput) WriteOne(ctx context.Context, msg WritableMessage) error {
+	return o.Write(ctx, []WritableMessage{msg})
+}
+
+func (o *lambdaOutput) Write(ctx context.Context, batch []WritableMessage) error {
+	if o.settings.Batch {
+		return o.invokeBatch(ctx, batch)
+	}
+
+	var result error
+
+	for _, msg := range batch {
+		payload, err := msg.MarshalToBytes()
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("can not marshal message: %w", err))
+
+			continue
+		}
+
+		if _, err = o.invoker.Invoke(ctx, payload); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if result != nil {
+		return fmt.Errorf("there were errors on invoking the lambda function %s: %w", o.settings.FunctionName, result)
+	}
+
+	return nil
+}
+
+func (o *lambdaOutput) invokeBatch(ctx context.Context, batch []WritableMessage) error {
+	messages := make([]json.RawMessage, len(batch))
+
+	for i, msg := range batch {
+		payload, err := msg.MarshalToBytes()
+		if err != nil {
+			return fmt.Errorf("can not marshal message %d: %w", i, err)
+		}
+
+		messages[i] = payload
+	}
+
+	payload, err := json.Marshal(messages)
+	if err != nil {
+		return fmt.Errorf("can not marshal batch of %d messages: %w", len(batch), err)
+	}
+
+	if _, err = o.invoker.Invoke(ctx, payload); err != nil {
+		return fmt.Errorf("can not invoke the lambda function %s with a batch of %d messages: %w", o.settings.FunctionName, len(batch), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package stream_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/lambda/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLambdaOutput_Write(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	invoker := mocks.NewInvoker(t)
+
+	invoker.EXPECT().Invoke(matcher.Context, []byte(`{"attributes":{"encoding":"application/json"},"body":"1"}`)).Return(nil, nil).Once()
+	invoker.EXPECT().Invoke(matcher.Context, []byte(`{"attributes":{"encoding":"application/json"},"body":"2"}`)).Return(nil, fmt.Errorf("throttled")).Once()
+	invoker.EXPECT().Invoke(matcher.Context, []byte(`{"attributes":{"encoding":"application/json"},"body":"3"}`)).Return(nil, nil).Once()
+
+	output := stream.NewLambdaOutputWithInterfaces(logger, invoker, &stream.LambdaOutputSettings{
+		FunctionName: "function",
+	})
+
+	err := output.Write(t.Context(), []stream.WritableMessage{
+		mkTestMessage(t, 1, map[string]string{}),
+		mkTestMessage(t, 2, map[string]string{}),
+		mkTestMessage(t, 3, map[string]string{}),
+	})
+	assert.ErrorContains(t, err, "there were errors on invoking the lambda function function")
+	assert.ErrorContains(t, err, "throttled")
+}
+
+func TestLambdaOutput_WriteBatch(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	invoker := mocks.NewInvoker(t)
+
+	payload := `[{"attributes":{"encoding":"application/json"},"body":"1"},{"attributes":{"encoding":"application/json"},"body":"2"}]`
+	invoker.EXPECT().Invoke(matcher.Context, []byte(payload)).Return(nil, nil).Once()
+
+	output := stream.NewLambdaOutputWithInterfaces(logger, invoker, &stream.LambdaOutputSettings{
+		FunctionName: "function",
+		Batch:        true,
+	})
+
+	err := output.Write(t.Context(), []stream.WritableMessage{
+		mkTestMessage(t, 1, map[string]string{}),
+		mkTestMessage(t, 2, map[string]string{}),
+	})
+	assert.NoError(t, err)
+}
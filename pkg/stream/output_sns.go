@@ -13,6 +13,7 @@ type SnsOutputSettings struct {
 	Identity   cfg.Identity
 	TopicId    string
 	ClientName string
+	Fifo       sns.FifoSettings
 }
 
 func (s SnsOutputSettings) GetIdentity() cfg.Identity {
@@ -27,6 +28,10 @@ func (s SnsOutputSettings) GetTopicId() string {
 	return s.TopicId
 }
 
+func (s SnsOutputSettings) IsFifoEnabled() bool {
+	return s.Fifo.Enabled
+}
+
 type snsOutput struct {
 	logger log.Logger
 	topic  sns.Topic
@@ -44,6 +49,7 @@ func NewSnsOutput(ctx context.Context, config cfg.Config, logger log.Logger, set
 	topicSettings := &sns.TopicSettings{
 		TopicName:  topicName,
 		ClientName: settings.ClientName,
+		Fifo:       settings.Fifo,
 	}
 
 	if topic, err = sns.NewTopic(ctx, config, logger, topicSettings); err != nil {
@@ -46,21 +46,40 @@ func NewOutputTracerWithInterfaces(tracer tracing.Tracer, base Output, name stri
 }
 
 func (o outputTracer) WriteOne(ctx context.Context, msg WritableMessage) error {
-	spanName := fmt.Sprintf("stream-output-%s", o.name)
-
-	ctx, trans := o.tracer.StartSubSpan(ctx, spanName)
-	defer trans.Finish()
+	ctx, span := o.startProducerSpan(ctx, msg)
+	defer span.Finish()
 
 	return o.base.WriteOne(ctx, msg)
 }
 
 func (o outputTracer) Write(ctx context.Context, batch []WritableMessage) error {
+	ctx, span := o.startProducerSpan(ctx, batch...)
+	defer span.Finish()
+
+	return o.base.Write(ctx, batch)
+}
+
+// startProducerSpan starts the span publishing the messages. As the messages got encoded before, their trace
+// attribute still references the span of the caller and is updated to reference the producer span, so consumers are
+// connected to the span publishing the message.
+func (o outputTracer) startProducerSpan(ctx context.Context, msgs ...WritableMessage) (context.Context, tracing.Span) {
 	spanName := fmt.Sprintf("stream-output-%s", o.name)
+	ctx, span := tracing.StartProducerSpan(ctx, o.tracer, spanName)
 
-	ctx, trans := o.tracer.StartSubSpan(ctx, spanName)
-	defer trans.Finish()
+	traceId := tracing.GetTraceIdFromContext(ctx)
+	if traceId == nil {
+		return ctx, span
+	}
 
-	return o.base.Write(ctx, batch)
+	for _, msg := range msgs {
+		if msg, ok := msg.(*Message); ok {
+			if _, ok := msg.Attributes[tracing.AttributeTraceId]; ok {
+				msg.Attributes[tracing.AttributeTraceId] = *traceId
+			}
+		}
+	}
+
+	return ctx, span
 }
 
 func (o outputTracer) InitSchemaRegistry(ctx context.Context, settings SchemaSettingsWithEncoding) (MessageBodyEncoder, error) {
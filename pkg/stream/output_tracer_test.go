@@ -0,0 +1,44 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/stream/mocks"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	tracingMocks "github.com/justtrackio/gosoline/pkg/tracing/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOutputTracer_Write(t *testing.T) {
+	span := tracingMocks.NewSpan(t)
+	span.EXPECT().GetTrace().Return(&tracing.Trace{
+		TraceId: "1-5e3d83c1-e6a0db584850d61342823d5a",
+		Id:      "7a2c0f2d4c3b2a11",
+		Sampled: true,
+	})
+	span.EXPECT().Finish().Once()
+
+	spanCtx := tracing.ContextWithSpan(t.Context(), span)
+
+	tracer := tracingMocks.NewTracer(t)
+	tracer.EXPECT().StartSubSpan(mock.Anything, "stream-output-test").Return(spanCtx, span).Once()
+
+	traced := stream.NewMessage("traced", map[string]string{
+		tracing.AttributeTraceId: "Root=1-5e3d83c1-e6a0db584850d61342823d5a;Parent=53995c3f42cd8ad8;Sampled=1",
+	})
+	untraced := stream.NewMessage("untraced")
+
+	batch := []stream.WritableMessage{traced, untraced}
+
+	output := mocks.NewOutput(t)
+	output.EXPECT().Write(spanCtx, batch).Return(nil).Once()
+
+	err := stream.NewOutputTracerWithInterfaces(tracer, output, "test").Write(context.Background(), batch)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Root=1-5e3d83c1-e6a0db584850d61342823d5a;Parent=7a2c0f2d4c3b2a11;Sampled=1", traced.Attributes[tracing.AttributeTraceId])
+	assert.NotContains(t, untraced.Attributes, tracing.AttributeTraceId)
+}
@@ -230,8 +230,9 @@ func (s *ProducerDaemonTestSuite) TestWriteBatchOnTickAfterWrite() {
 	}
 	s.expectMessage(expected2)
 
-	s.clock.Advance(time.Hour)
-	time.Sleep(time.Millisecond)
+	err = s.clock.AdvanceAndWait(s.T().Context(), time.Hour)
+	s.NoError(err, "the daemon should receive the tick")
+
 	err = s.stop()
 
 	s.NoError(err, "there should be no error on run")
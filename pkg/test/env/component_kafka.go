@@ -1,17 +1,28 @@
 package env
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/kafka"
 	"github.com/justtrackio/gosoline/pkg/kafka/admin"
 	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/stretchr/testify/assert"
+	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 type KafkaComponent struct {
 	baseComponent
+	config                cfg.Config
 	adminClient           admin.Client
 	schemaRegistryClient  schemaRegistry.Client
 	brokerAddress         string
 	schemaRegistryAddress string
+	consumeTimeout        time.Duration
 }
 
 func (c KafkaComponent) CfgOptions() []cfg.Option {
@@ -44,3 +55,129 @@ func (c KafkaComponent) BrokerAddress() string {
 func (c KafkaComponent) SchemaRegistryAddress() string {
 	return c.schemaRegistryAddress
 }
+
+// TopicName returns the full name of the topic with the given id of the application, as used by the kafka inputs and
+// outputs with the same topic id.
+func (c KafkaComponent) TopicName(topicId string) string {
+	topic, err := kafka.BuildFullTopicName(c.config, cfg.Identity{}, topicId)
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "can not build the name of topic %s", topicId)
+	}
+
+	return topic
+}
+
+// CreateTopic creates the topic unless it exists already.
+func (c KafkaComponent) CreateTopic(topic string, partitions int32) {
+	if err := c.createTopic(c.t.Context(), topic, partitions); err != nil {
+		assert.FailNow(c.t, err.Error(), "can not create topic %s", topic)
+	}
+}
+
+// Produce writes the records to the topic and waits until the broker acknowledged all of them.
+func (c KafkaComponent) Produce(topic string, records ...*kgo.Record) {
+	client := c.client()
+	defer client.Close()
+
+	for _, record := range records {
+		record.Topic = topic
+	}
+
+	if err := client.ProduceSync(c.t.Context(), records...).FirstErr(); err != nil {
+		assert.FailNow(c.t, err.Error(), "can not produce records to topic %s", topic)
+	}
+}
+
+// ProduceMessage encodes the body as json and writes it with the attributes to the topic like the kafka output does.
+// The attribute stream.AttributeKafkaKey is used as key of the record.
+func (c KafkaComponent) ProduceMessage(topic string, body any, attributes map[string]string) {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "can not encode message for topic %s", topic)
+	}
+
+	record, err := stream.NewKafkaMessage(stream.NewJsonMessage(string(bytes), attributes))
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "can not create record for topic %s", topic)
+	}
+
+	c.Produce(topic, record)
+}
+
+// Consume reads the first count records of the topic. It fails the test if the topic doesn't contain that many records
+// before the consume timeout (test.components.kafka.default.consume_timeout) passed.
+func (c KafkaComponent) Consume(topic string, count int) []*kgo.Record {
+	client := c.client(
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(c.t.Context(), c.consumeTimeout)
+	defer cancel()
+
+	records := make([]*kgo.Record, 0, count)
+
+	for len(records) < count {
+		fetches := client.PollRecords(ctx, count-len(records))
+
+		if ctx.Err() != nil {
+			assert.FailNow(c.t, "not enough records", "expected %d records in topic %s, but got %d after %s", count, topic, len(records), c.consumeTimeout)
+
+			return records
+		}
+
+		for _, err := range fetches.Errors() {
+			assert.FailNow(c.t, err.Err.Error(), "can not consume topic %s", topic)
+		}
+
+		records = append(records, fetches.Records()...)
+	}
+
+	return records
+}
+
+// ConsumeMessages reads the first count records of the topic as stream messages, see Consume.
+func (c KafkaComponent) ConsumeMessages(topic string, count int) []*stream.Message {
+	records := c.Consume(topic, count)
+	messages := make([]*stream.Message, len(records))
+
+	for i, record := range records {
+		messages[i] = stream.KafkaToGosoMessage(*record)
+	}
+
+	return messages
+}
+
+func (c KafkaComponent) createTopic(ctx context.Context, topic string, partitions int32) error {
+	// topics requested explicitly are part of the result even if they don't exist, so list all of them instead
+	topics, err := c.adminClient.ListTopics(ctx)
+	if err != nil {
+		return fmt.Errorf("can not list topics: %w", err)
+	}
+
+	if topics.Has(topic) {
+		return nil
+	}
+
+	// a single broker can only hold one replica of each partition
+	res, err := c.adminClient.CreateTopic(ctx, partitions, 1, nil, topic)
+	if err != nil {
+		return fmt.Errorf("can not create topic %s: %w", topic, err)
+	}
+
+	if res.Err != nil {
+		return fmt.Errorf("can not create topic %s: %s: %w", topic, res.ErrMessage, res.Err)
+	}
+
+	return nil
+}
+
+func (c KafkaComponent) client(opts ...kgo.Opt) *kgo.Client {
+	client, err := kgo.NewClient(append([]kgo.Opt{kgo.SeedBrokers(c.brokerAddress)}, opts...)...)
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "can not create kafka client")
+	}
+
+	return client
+}
@@ -110,3 +110,12 @@ func (c *localstackComponent) SnsClient() *sns.Client {
 func (c *localstackComponent) Toxiproxy() *toxiproxy.Proxy {
 	return c.toxiproxy
 }
+
+// Toxics injects faults into the connections to the component. It is nil unless toxiproxy_enabled is set.
+func (c *localstackComponent) Toxics() *ProxyToxics {
+	if c.toxiproxy == nil {
+		return nil
+	}
+
+	return NewProxyToxics(c.toxiproxy)
+}
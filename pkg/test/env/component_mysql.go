@@ -65,3 +65,12 @@ func (c *mysqlComponent) AssertRowCount(table string, expectedCount int) {
 func (c *mysqlComponent) Toxiproxy() *toxiproxy.Proxy {
 	return c.toxiproxy
 }
+
+// Toxics injects faults into the connections to the component. It is nil unless toxiproxy_enabled is set.
+func (c *mysqlComponent) Toxics() *ProxyToxics {
+	if c.toxiproxy == nil {
+		return nil
+	}
+
+	return NewProxyToxics(c.toxiproxy)
+}
@@ -1,14 +1,16 @@
 package env
 
 import (
+	toxiproxy "github.com/Shopify/toxiproxy/v2/client"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	baseRedis "github.com/redis/go-redis/v9"
 )
 
 type RedisComponent struct {
 	baseComponent
-	address string
-	client  *baseRedis.Client
+	address   string
+	client    *baseRedis.Client
+	toxiproxy *toxiproxy.Proxy
 }
 
 func (c *RedisComponent) CfgOptions() []cfg.Option {
@@ -29,3 +31,16 @@ func (c *RedisComponent) Address() string {
 func (c *RedisComponent) Client() *baseRedis.Client {
 	return c.client
 }
+
+func (c *RedisComponent) Toxiproxy() *toxiproxy.Proxy {
+	return c.toxiproxy
+}
+
+// Toxics injects faults into the connections to the component. It is nil unless toxiproxy_enabled is set.
+func (c *RedisComponent) Toxics() *ProxyToxics {
+	if c.toxiproxy == nil {
+		return nil
+	}
+
+	return NewProxyToxics(c.toxiproxy)
+}
@@ -15,6 +15,9 @@ type ComponentContainerDescription struct {
 	ContainerConfig  *ContainerConfig
 	HealthCheck      ComponentHealthCheck
 	ShutdownCallback ComponentShutdownCallback
+	// ResetCallback removes all state of a container left behind by earlier test runs. Containers with a reset callback
+	// are kept alive across test packages if container reuse is enabled and are reset before each environment uses them.
+	ResetCallback ComponentResetCallback
 }
 
 type ComponentContainerDescriptions map[string]*ComponentContainerDescription
@@ -29,6 +32,7 @@ type componentFactory interface {
 type (
 	ComponentHealthCheck      func(container *Container) error
 	ComponentShutdownCallback func(container *Container) func() error
+	ComponentResetCallback    func(container *Container) error
 )
 
 type ComponentBaseSettingsAware interface {
@@ -0,0 +1,69 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// maxContainerLeaseSlots limits the number of reused containers per container config, it is only reached if that many
+// test binaries run at the same time.
+const maxContainerLeaseSlots = 64
+
+// containerLease grants a test binary the exclusive use of a reused container. Every container config has a number of
+// slots, each backed by a lock file in the temp directory. A test binary locks the first free slot and uses the
+// container with the name of the slot until the environment is stopped. The lock is released by the operating system if
+// the test binary dies, so the container can be used by the next test binary.
+type containerLease struct {
+	name string
+	file *os.File
+}
+
+func acquireContainerLease(key string) (*containerLease, error) {
+	dir := filepath.Join(os.TempDir(), "gosoline-container-reuse")
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("can not create lease directory %s: %w", dir, err)
+	}
+
+	for slot := range maxContainerLeaseSlots {
+		name := fmt.Sprintf("%s-%d", key, slot)
+
+		file, err := os.OpenFile(filepath.Join(dir, name+".lock"), os.O_CREATE|os.O_RDWR, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("can not open lease file for container %s: %w", name, err)
+		}
+
+		locked, err := tryLockFile(file)
+		if err != nil {
+			_ = file.Close()
+
+			return nil, fmt.Errorf("can not lock lease file for container %s: %w", name, err)
+		}
+
+		if locked {
+			return &containerLease{
+				name: name,
+				file: file,
+			}, nil
+		}
+
+		if err = file.Close(); err != nil {
+			return nil, fmt.Errorf("can not close lease file for container %s: %w", name, err)
+		}
+	}
+
+	return nil, fmt.Errorf("all %d slots for container %s are leased", maxContainerLeaseSlots, key)
+}
+
+func (l *containerLease) release() error {
+	if err := unlockFile(l.file); err != nil {
+		return fmt.Errorf("can not unlock lease file for container %s: %w", l.name, err)
+	}
+
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("can not close lease file for container %s: %w", l.name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+//go:build linux || darwin
+
+package env
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+const containerLeaseSupported = true
+
+func tryLockFile(file *os.File) (bool, error) {
+	err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+
+	if errors.Is(err, syscall.EWOULDBLOCK) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func unlockFile(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,20 @@
+//go:build !linux && !darwin
+
+package env
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+)
+
+// containers can't be leased without file locks, so they are never reused
+const containerLeaseSupported = false
+
+func tryLockFile(_ *os.File) (bool, error) {
+	return false, fmt.Errorf("file locks are not supported on %s", runtime.GOOS)
+}
+
+func unlockFile(_ *os.File) error {
+	return fmt.Errorf("file locks are not supported on %s", runtime.GOOS)
+}
@@ -24,6 +24,15 @@ type ContainerManagerSettings struct {
 	NamePrefix  string              `cfg:"name_prefix"  default:"goso"`
 	HealthCheck HealthCheckSettings `cfg:"health_check"`
 	ExpireAfter time.Duration       `cfg:"expire_after" default:"5m"`
+	Reuse       ReuseSettings       `cfg:"reuse"`
+}
+
+// ReuseSettings configure if the local runner keeps containers alive after the environment is stopped, so the test
+// binaries of the following packages don't have to start them again. Only containers of components which can reset
+// their state (mysql, redis and localstack) are reused, they are reset every time an environment starts using them.
+type ReuseSettings struct {
+	Enabled bool          `cfg:"enabled" default:"false"`
+	MaxAge  time.Duration `cfg:"max_age" default:"1h"`
 }
 
 type HealthCheckSettings struct {
@@ -166,6 +175,14 @@ func (m *containerManager) runContainer(ctx context.Context, request ContainerRe
 		return nil, fmt.Errorf("healthcheck failed on container for component %s: %w", request.id(), err)
 	}
 
+	if container.reused {
+		if err = desc.ResetCallback(container); err != nil {
+			return nil, fmt.Errorf("can not reset reused container for component %s: %w", request.id(), err)
+		}
+
+		m.logger.Debug(ctx, "reset reused container %s", container.name)
+	}
+
 	return container, nil
 }
 
@@ -2,6 +2,9 @@ package env
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -58,6 +61,36 @@ func (c ContainerConfig) String() string {
 	return fmt.Sprintf("%s:%s", c.Repository, c.Tag)
 }
 
+// Hash identifies the container which is started for the config. Two configs have the same hash if their containers
+// are interchangeable, the credentials to pull the image are not part of it.
+func (c ContainerConfig) Hash() string {
+	// encoding/json sorts the keys of maps, so equal configs always result in the same document. Marshaling can't fail,
+	// the document consists of strings, ints and maps with string keys only.
+	document, _ := json.Marshal(struct {
+		Hostname     string
+		Repository   string
+		Tag          string
+		Tmpfs        []TmpfsSettings
+		Env          map[string]string
+		Cmd          []string
+		PortBindings PortBindings
+		ExposedPorts []string
+	}{
+		Hostname:     c.Hostname,
+		Repository:   c.Repository,
+		Tag:          c.Tag,
+		Tmpfs:        c.Tmpfs,
+		Env:          c.Env,
+		Cmd:          c.Cmd,
+		PortBindings: c.PortBindings,
+		ExposedPorts: c.ExposedPorts,
+	})
+
+	sum := sha256.Sum256(document)
+
+	return hex.EncodeToString(sum[:])
+}
+
 type PortBindings map[string]PortBinding
 
 type PortBinding struct {
@@ -75,6 +108,7 @@ type Container struct {
 	name             string
 	bindings         map[string]ContainerBinding
 	internalBindings map[string]ContainerBinding // For container-to-container communication
+	reused           bool                        // The container was started by an earlier environment and has to be reset
 }
 
 type ContainerBinding struct {
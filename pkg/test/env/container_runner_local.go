@@ -20,6 +20,9 @@ import (
 
 var _ ContainerRunner = &containerRunnerLocal{}
 
+// labelReuse marks reused containers, remove them with docker rm -f $(docker ps -aq --filter label=gosoline.reuse)
+const labelReuse = "gosoline.reuse"
+
 var (
 	alreadyExists   = regexp.MustCompile(`API error \(409\): removal of container (\w+) is already in progress`)
 	noSuchContainer = regexp.MustCompile(`No such container: (\w+)`)
@@ -35,6 +38,7 @@ type containerRunnerLocal struct {
 	pool            *dockertest.Pool
 	id              string
 	resources       map[string]*dockertest.Resource
+	leases          []*containerLease
 	resourcesLck    sync.Mutex
 	managerSettings *ContainerManagerSettings
 	runnerSettings  *ContainerRunnerLocalSettings
@@ -115,6 +119,15 @@ func (r *containerRunnerLocal) RunContainer(ctx context.Context, request Contain
 	}
 
 	resourceId := fmt.Sprintf("%s-%s", request.id(), request.ContainerName)
+
+	if r.isReusable(request) {
+		if container, err = r.runReusableContainer(ctx, request, runOptions, resourceId); err != nil {
+			return nil, err
+		}
+
+		return container, nil
+	}
+
 	if container, err = r.runContainer(request, runOptions, resourceId); err != nil {
 		return nil, err
 	}
@@ -123,8 +136,6 @@ func (r *containerRunnerLocal) RunContainer(ctx context.Context, request Contain
 }
 
 func (r *containerRunnerLocal) runContainer(request ContainerRequest, options *dockertest.RunOptions, resourceId string) (*Container, error) {
-	var resourceContainer *Container
-
 	config := request.ContainerDescription.ContainerConfig
 	tmpfsConfig := r.getTmpfsConfig(config.Tmpfs)
 
@@ -144,6 +155,83 @@ func (r *containerRunnerLocal) runContainer(request ContainerRequest, options *d
 		return nil, fmt.Errorf("could not set expiry on container %s: %w", options.Name, err)
 	}
 
+	return r.newContainer(request, resource, options.Name)
+}
+
+func (r *containerRunnerLocal) isReusable(request ContainerRequest) bool {
+	return r.managerSettings.Reuse.Enabled && request.ContainerDescription.ResetCallback != nil && containerLeaseSupported
+}
+
+// runReusableContainer leases a container for the config of the request which is neither stopped by this runner nor
+// expires. If the container of the lease is still running from an earlier test binary, it is used as is and gets reset
+// by the container manager. Otherwise, or if it is older than the max age of reused containers, a new one is started.
+func (r *containerRunnerLocal) runReusableContainer(ctx context.Context, request ContainerRequest, options *dockertest.RunOptions, resourceId string) (*Container, error) {
+	var err error
+	var lease *containerLease
+	var resource *dockertest.Resource
+	var existing *docker.Container
+
+	config := request.ContainerDescription.ContainerConfig
+	key := fmt.Sprintf("%s-reuse-%s-%s-%s", r.managerSettings.NamePrefix, request.id(), request.ContainerName, config.Hash()[:12])
+
+	if lease, err = acquireContainerLease(key); err != nil {
+		return nil, fmt.Errorf("can not lease container %s: %w", resourceId, err)
+	}
+
+	r.resourcesLck.Lock()
+	r.leases = append(r.leases, lease)
+	r.resourcesLck.Unlock()
+
+	options.Name = lease.name
+	options.Labels = map[string]string{
+		labelReuse: "true",
+	}
+
+	if existing, err = r.pool.Client.InspectContainer(lease.name); err != nil {
+		var noSuchContainer *docker.NoSuchContainer
+
+		if !errors.As(err, &noSuchContainer) {
+			return nil, fmt.Errorf("can not inspect container %s: %w", lease.name, err)
+		}
+	}
+
+	if existing != nil && existing.State.Running && time.Since(existing.Created) < r.managerSettings.Reuse.MaxAge {
+		r.logger.Debug(ctx, "reuse container %s created at %s", lease.name, existing.Created.Format(time.RFC3339))
+
+		container, err := r.newContainer(request, &dockertest.Resource{Container: existing}, lease.name)
+		if err != nil {
+			return nil, err
+		}
+
+		container.reused = true
+
+		return container, nil
+	}
+
+	if existing != nil {
+		r.logger.Debug(ctx, "replace container %s created at %s", lease.name, existing.Created.Format(time.RFC3339))
+
+		if err = r.pool.Client.RemoveContainer(docker.RemoveContainerOptions{ID: existing.ID, Force: true, RemoveVolumes: true}); err != nil {
+			return nil, fmt.Errorf("can not remove outdated container %s: %w", lease.name, err)
+		}
+	}
+
+	tmpfsConfig := r.getTmpfsConfig(config.Tmpfs)
+
+	resource, err = r.pool.RunWithOptions(options, func(hc *docker.HostConfig) {
+		hc.AutoRemove = true
+		hc.Tmpfs = tmpfsConfig
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not run container %s: %w", resourceId, err)
+	}
+
+	return r.newContainer(request, resource, lease.name)
+}
+
+func (r *containerRunnerLocal) newContainer(request ContainerRequest, resource *dockertest.Resource, name string) (*Container, error) {
+	config := request.ContainerDescription.ContainerConfig
+
 	resolvedBindings, err := r.resolveBindings(resource, config.PortBindings)
 	if err != nil {
 		return nil, fmt.Errorf("can not resolve bindings: %w", err)
@@ -152,14 +240,12 @@ func (r *containerRunnerLocal) runContainer(request ContainerRequest, options *d
 	// Resolve internal bindings for container-to-container communication
 	internalBindings := r.resolveInternalBindings(resource, config.PortBindings)
 
-	resourceContainer = &Container{
+	return &Container{
 		typ:              request.ComponentType,
-		name:             options.Name,
+		name:             name,
 		bindings:         resolvedBindings,
 		internalBindings: internalBindings,
-	}
-
-	return resourceContainer, nil
+	}, nil
 }
 
 func (r *containerRunnerLocal) pullContainerImage(description *ComponentContainerDescription) error {
@@ -306,5 +392,14 @@ func (r *containerRunnerLocal) Stop(ctx context.Context) error {
 		r.logger.Debug(ctx, "stopping container %s", name)
 	}
 
+	// reused containers keep running, they only become available for the next environment
+	for _, lease := range r.leases {
+		if err := lease.release(); err != nil {
+			return err
+		}
+	}
+
+	r.leases = nil
+
 	return nil
 }
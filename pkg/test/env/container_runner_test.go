@@ -0,0 +1,46 @@
+package env_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/test/env"
+	"github.com/stretchr/testify/assert"
+)
+
+func newHashTestContainerConfig() env.ContainerConfig {
+	return env.ContainerConfig{
+		Repository: "mysql",
+		Tag:        "8.0.42",
+		Env: map[string]string{
+			"MYSQL_ROOT_PASSWORD": "gosoline",
+			"MYSQL_DATABASE":      "gosoline",
+		},
+		Cmd: []string{"--sql_mode=NO_ENGINE_SUBSTITUTION"},
+		PortBindings: env.PortBindings{
+			"main": {ContainerPort: 3306, Protocol: "tcp"},
+		},
+	}
+}
+
+func TestContainerConfig_Hash(t *testing.T) {
+	config := newHashTestContainerConfig()
+	hash := config.Hash()
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, newHashTestContainerConfig().Hash(), "equal configs should have the same hash")
+
+	config.Auth.Username = "user"
+	assert.Equal(t, hash, config.Hash(), "the credentials should not be part of the hash")
+
+	config = newHashTestContainerConfig()
+	config.Tag = "8.4"
+	assert.NotEqual(t, hash, config.Hash(), "the tag should be part of the hash")
+
+	config = newHashTestContainerConfig()
+	config.Env["MYSQL_DATABASE"] = "other"
+	assert.NotEqual(t, hash, config.Hash(), "the env should be part of the hash")
+
+	config = newHashTestContainerConfig()
+	config.PortBindings["main"] = env.PortBinding{ContainerPort: 3306, HostPort: 3306, Protocol: "tcp"}
+	assert.NotEqual(t, hash, config.Hash(), "the port bindings should be part of the hash")
+}
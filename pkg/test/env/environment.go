@@ -121,6 +121,10 @@ func (e *Environment) init(options ...Option) error {
 		}
 	}
 
+	if err = applyUniqueResourceNames(config); err != nil {
+		return fmt.Errorf("can not apply unique resource names: %w", err)
+	}
+
 	if cfgPostProcessors, err = cfg.ApplyPostProcessors(config); err != nil {
 		return fmt.Errorf("can not apply post processor on config: %w", err)
 	}
@@ -224,6 +228,10 @@ func (e *Environment) MySql(name string) *mysqlComponent {
 	return e.Component(componentMySql, name).(*mysqlComponent)
 }
 
+func (e *Environment) Postgres(name string) *PostgresComponent {
+	return e.Component(componentPostgres, name).(*PostgresComponent)
+}
+
 func (e *Environment) Wiremock(name string) *wiremockComponent {
 	return e.Component(componentWiremock, name).(*wiremockComponent)
 }
@@ -252,6 +260,24 @@ func (e *Environment) LifeCyleCreate() error {
 	return nil
 }
 
+// SetIsolationSuffix sets the suffix fixture sets append to the names of their resources, see fixtures.IsolatedName. An
+// empty suffix disables the isolation again.
+func (e *Environment) SetIsolationSuffix(suffix string) {
+	e.ctx = fixtures.WithIsolationSuffix(e.ctx, suffix)
+}
+
+// PurgeResources purges all resources registered by the components and fixture sets of the environment, e.g. to start a
+// test case without the seed data of the test cases before.
+func (e *Environment) PurgeResources() error {
+	e.resManager.ResetPurged()
+
+	if err := e.resManager.Purge(e.ctx); err != nil {
+		return fmt.Errorf("can not handle the purge lifecycle: %w", err)
+	}
+
+	return nil
+}
+
 func (e *Environment) LoadFixtureSets(factories []fixtures.FixtureSetsFactory, postProcessorFactories ...fixtures.PostProcessorFactory) error {
 	if len(factories) == 0 {
 		return nil
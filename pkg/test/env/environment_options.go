@@ -70,6 +70,28 @@ func WithContainerExpireAfter(expireAfter time.Duration) Option {
 	}
 }
 
+// WithContainerReuse keeps the mysql, redis and localstack containers alive after the environment is stopped, so the
+// next environment with the same container config - in this or in another test binary - can use them after resetting
+// their state. Set TEST_CONTAINER_MANAGER_REUSE_ENABLED=true to enable it for all suites, e.g. in the ci.
+func WithContainerReuse() Option {
+	return func(env *Environment) {
+		env.addConfigOption(func(config cfg.GosoConf) error {
+			return config.Option(cfg.WithConfigSetting("test.container_manager.reuse.enabled", true))
+		})
+	}
+}
+
+// WithUniqueResourceNames suffixes the app.namespace, and by that the names of all queues, topics, tables, buckets, etc.,
+// with a token unique to the test run. Set TEST_RESOURCE_NAMES_UNIQUE=true to enable it for all suites and
+// TEST_RESOURCE_NAMES_RUN_ID to use e.g. the id of the ci job as token.
+func WithUniqueResourceNames() Option {
+	return func(env *Environment) {
+		env.addConfigOption(func(config cfg.GosoConf) error {
+			return config.Option(cfg.WithConfigSetting("test.resource_names.unique", true))
+		})
+	}
+}
+
 func WithLoggerLevel(level string) Option {
 	return func(env *Environment) {
 		env.addLoggerOption(func(settings *LoggerSettings) error {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	kafkaAdmin "github.com/justtrackio/gosoline/pkg/kafka/admin"
@@ -24,6 +25,11 @@ type kafkaSettings struct {
 	ComponentContainerSettings
 	BrokerPort         int `cfg:"broker_port" default:"9092"` // we can't set this to 0 to get a random port because we need a specific port in the container run config
 	SchemaRegistryPort int `cfg:"schema_registry_port" default:"0"`
+	// Topics are created with the given number of partitions when the component starts, topics written by the
+	// application are created by the kafka lifecycle manager anyway.
+	Topics         []string      `cfg:"topics"`
+	Partitions     int32         `cfg:"partitions"      default:"1"`
+	ConsumeTimeout time.Duration `cfg:"consume_timeout" default:"10s"`
 }
 
 var _ componentFactory = &kafkaFactory{}
@@ -151,7 +157,8 @@ func (f *kafkaFactory) schemaRegistryAddress(container *Container) string {
 	return address
 }
 
-func (f *kafkaFactory) Component(_ cfg.Config, logger log.Logger, containers map[string]*Container, _ any) (Component, error) {
+func (f *kafkaFactory) Component(config cfg.Config, logger log.Logger, containers map[string]*Container, settings any) (Component, error) {
+	s := settings.(*kafkaSettings)
 	main := containers["main"]
 
 	adminClient, err := kafkaAdmin.NewClient(context.Background(), logger, []string{f.brokerAddress(main)})
@@ -164,11 +171,21 @@ func (f *kafkaFactory) Component(_ cfg.Config, logger log.Logger, containers map
 		return nil, fmt.Errorf("failed to create kafka admin client: %w", err)
 	}
 
-	return &KafkaComponent{
+	component := &KafkaComponent{
 		baseComponent:         baseComponent{},
+		config:                config,
 		adminClient:           adminClient,
 		schemaRegistryClient:  schemaRegistryClient,
 		brokerAddress:         f.brokerAddress(main),
 		schemaRegistryAddress: f.schemaRegistryAddress(main),
-	}, nil
+		consumeTimeout:        s.ConsumeTimeout,
+	}
+
+	for _, topic := range s.Topics {
+		if err = component.createTopic(context.Background(), topic, s.Partitions); err != nil {
+			return nil, err
+		}
+	}
+
+	return component, nil
 }
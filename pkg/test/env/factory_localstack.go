@@ -75,6 +75,7 @@ func (f *localstackFactory) DescribeContainers(settings any) ComponentContainerD
 		"main": {
 			ContainerConfig: f.configureContainer(settings),
 			HealthCheck:     f.healthCheck(settings),
+			ResetCallback:   f.reset(),
 		},
 	}
 
@@ -136,6 +137,27 @@ func (f *localstackFactory) healthCheck(settings any) ComponentHealthCheck {
 	}
 }
 
+// reset removes all resources of all services, see https://docs.localstack.cloud/references/internal-endpoints/
+func (f *localstackFactory) reset() ComponentResetCallback {
+	return func(container *Container) error {
+		url := fmt.Sprintf("http://%s/_localstack/state/reset", container.bindings["main"].getAddress())
+
+		resp, err := http.Post(url, "application/json", nil)
+		if err != nil {
+			return fmt.Errorf("can not reset localstack state: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+
+			return fmt.Errorf("can not reset localstack state: status %d: %s", resp.StatusCode, body)
+		}
+
+		return nil
+	}
+}
+
 func (f *localstackFactory) Component(config cfg.Config, logger log.Logger, containers map[string]*Container, settings any) (Component, error) {
 	var err error
 	var proxy *toxiproxy.Proxy
@@ -144,18 +166,8 @@ func (f *localstackFactory) Component(config cfg.Config, logger log.Logger, cont
 	endpoint := containers["main"].bindings["main"].getAddress()
 
 	if s.ToxiproxyEnabled {
-		toxiproxyClient := f.toxiproxyFactory.client(containers["toxiproxy"])
-
-		// Use the internal address for container-to-container communication
-		// This allows toxiproxy to reach localstack on the Docker network
-		localstackInternalAddress := containers["main"].getInternalAddress("main")
-		if localstackInternalAddress == "" {
-			// Fallback to external binding if internal is not available
-			localstackInternalAddress = endpoint
-		}
-
-		if proxy, err = toxiproxyClient.CreateProxy("localstack", ":56248", localstackInternalAddress); err != nil {
-			return nil, fmt.Errorf("can not create toxiproxy proxy for localstack component: %w", err)
+		if proxy, err = f.toxiproxyFactory.createProxy("localstack", containers); err != nil {
+			return nil, err
 		}
 
 		endpoint = containers["toxiproxy"].bindings["main"].getAddress()
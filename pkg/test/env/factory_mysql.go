@@ -101,6 +101,7 @@ func (f *mysqlFactory) DescribeContainers(settings any) ComponentContainerDescri
 			ContainerConfig:  f.configureContainer(settings),
 			HealthCheck:      f.healthCheck(settings),
 			ShutdownCallback: f.dropDatabase(settings),
+			ResetCallback:    f.reset(settings),
 		},
 	}
 
@@ -192,18 +193,8 @@ func (f *mysqlFactory) Component(config cfg.Config, _ log.Logger, containers map
 	connectionBinding := directMysqlBinding
 
 	if s.ToxiproxyEnabled {
-		toxiproxyClient := f.toxiproxyFactory.client(containers["toxiproxy"])
-
-		// Use the internal address for container-to-container communication
-		// This allows toxiproxy to reach mysql on the Docker network
-		mysqlInternalAddress := containers["main"].getInternalAddress("main")
-		if mysqlInternalAddress == "" {
-			// Fallback to external binding if internal is not available
-			mysqlInternalAddress = directMysqlBinding.getAddress()
-		}
-
-		if proxy, err = toxiproxyClient.CreateProxy("mysql", ":56248", mysqlInternalAddress); err != nil {
-			return nil, fmt.Errorf("cannot create toxiproxy proxy for mysql component: %w", err)
+		if proxy, err = f.toxiproxyFactory.createProxy("mysql", containers); err != nil {
+			return nil, err
 		}
 
 		connectionBinding = containers["toxiproxy"].bindings["main"]
@@ -321,23 +312,30 @@ func (f *mysqlFactory) setup(settings *mysqlSettings, binding ContainerBinding)
 func (f *mysqlFactory) dropDatabase(settings any) ComponentShutdownCallback {
 	return func(container *Container) func() error {
 		return func() error {
-			s := settings.(*mysqlSettings)
-			binding := container.bindings["main"]
+			return f.reset(settings)(container)
+		}
+	}
+}
 
-			client, err := f.connection(s, binding)
-			if err != nil {
-				return fmt.Errorf("can not connect to database: %w", err)
-			}
+// reset drops the database, the component creates it again
+func (f *mysqlFactory) reset(settings any) ComponentResetCallback {
+	return func(container *Container) error {
+		s := settings.(*mysqlSettings)
+		binding := container.bindings["main"]
 
-			dropDatabase := fmt.Sprintf("DROP DATABASE IF EXISTS `%s`", s.Credentials.DatabaseName)
+		client, err := f.connection(s, binding)
+		if err != nil {
+			return fmt.Errorf("can not connect to database: %w", err)
+		}
 
-			_, err = client.Exec(dropDatabase)
-			if err != nil {
-				return fmt.Errorf("can not drop database: %w", err)
-			}
+		dropDatabase := fmt.Sprintf("DROP DATABASE IF EXISTS `%s`", s.Credentials.DatabaseName)
 
-			return nil
+		_, err = client.Exec(dropDatabase)
+		if err != nil {
+			return fmt.Errorf("can not drop database: %w", err)
 		}
+
+		return nil
 	}
 }
 
@@ -0,0 +1,252 @@
+package env
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	_ "github.com/lib/pq"
+)
+
+func init() {
+	componentFactories[componentPostgres] = new(postgresFactory)
+}
+
+const (
+	componentPostgres        = "postgres"
+	postgresMaintenanceDb    = "postgres"
+	postgresDriverName       = "postgres"
+	postgresDefaultDataMount = "/var/lib/postgresql/data"
+)
+
+type postgresCredentials struct {
+	DatabaseName string `cfg:"database_name" default:"gosoline"`
+	UserName     string `cfg:"user_name"     default:"gosoline"`
+	UserPassword string `cfg:"user_password" default:"gosoline"`
+}
+
+type postgresSettings struct {
+	ComponentBaseSettings
+	ComponentContainerSettings
+	ContainerBindingSettings
+	Credentials postgresCredentials `cfg:"credentials"`
+}
+
+type postgresFactory struct {
+	lck         sync.Mutex
+	connections map[string]*sqlx.DB
+}
+
+func (f *postgresFactory) Detect(config cfg.Config, manager *ComponentsConfigManager) error {
+	if !config.IsSet("db") {
+		return nil
+	}
+
+	if !manager.ShouldAutoDetect(componentPostgres) {
+		return nil
+	}
+
+	if has, err := manager.HasType(componentPostgres); err != nil {
+		return fmt.Errorf("failed to check if component exists: %w", err)
+	} else if has {
+		return nil
+	}
+
+	components, err := config.GetStringMap("db")
+	if err != nil {
+		return fmt.Errorf("can not get db components: %w", err)
+	}
+
+	for name := range components {
+		driver, err := config.Get(fmt.Sprintf("db.%s.driver", name))
+		if err != nil {
+			return fmt.Errorf("can not get driver for component %s: %w", name, err)
+		}
+
+		if driver != componentPostgres {
+			continue
+		}
+
+		settings := &postgresSettings{}
+		if err := UnmarshalSettings(config, settings, componentPostgres, "default"); err != nil {
+			return fmt.Errorf("can not unmarshal postgres settings for component %s: %w", name, err)
+		}
+		settings.Type = componentPostgres
+		settings.Name = name
+
+		if err := manager.Add(settings); err != nil {
+			return fmt.Errorf("can not add default postgres component: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (f *postgresFactory) GetSettingsSchema() ComponentBaseSettingsAware {
+	return &postgresSettings{}
+}
+
+func (f *postgresFactory) DescribeContainers(settings any) ComponentContainerDescriptions {
+	return ComponentContainerDescriptions{
+		"main": {
+			ContainerConfig: f.configureContainer(settings),
+			HealthCheck:     f.healthCheck(settings),
+			ResetCallback:   f.reset(settings),
+		},
+	}
+}
+
+func (f *postgresFactory) configureContainer(settings any) *ContainerConfig {
+	s := settings.(*postgresSettings)
+
+	env := map[string]string{
+		"POSTGRES_DB":       s.Credentials.DatabaseName,
+		"POSTGRES_USER":     s.Credentials.UserName,
+		"POSTGRES_PASSWORD": s.Credentials.UserPassword,
+	}
+
+	if len(s.Tmpfs) == 0 {
+		s.Tmpfs = append(s.Tmpfs, TmpfsSettings{
+			Path: postgresDefaultDataMount,
+		})
+	}
+
+	return &ContainerConfig{
+		Auth:       s.Image.Auth,
+		Repository: s.Image.Repository,
+		Tag:        s.Image.Tag,
+		Tmpfs:      s.Tmpfs,
+		Env:        env,
+		// the data is thrown away with the container, so there is no need to wait for it to be written to disk
+		Cmd: []string{"-c", "fsync=off", "-c", "synchronous_commit=off", "-c", "full_page_writes=off", "-c", "max_connections=1000"},
+		PortBindings: PortBindings{
+			"main": {
+				ContainerPort: 5432,
+				HostPort:      s.Port,
+				Protocol:      "tcp",
+			},
+		},
+	}
+}
+
+func (f *postgresFactory) healthCheck(settings any) ComponentHealthCheck {
+	return func(container *Container) error {
+		s := settings.(*postgresSettings)
+
+		client, err := sqlx.Open(postgresDriverName, f.dsn(s, container.bindings["main"], postgresMaintenanceDb))
+		if err != nil {
+			return fmt.Errorf("can not create client: %w", err)
+		}
+		defer client.Close()
+
+		return client.Ping()
+	}
+}
+
+func (f *postgresFactory) Component(_ cfg.Config, _ log.Logger, containers map[string]*Container, settings any) (Component, error) {
+	s := settings.(*postgresSettings)
+	binding := containers["main"].bindings["main"]
+
+	client, err := f.connection(s, binding)
+	if err != nil {
+		return nil, fmt.Errorf("can not create client: %w", err)
+	}
+
+	component := &PostgresComponent{
+		baseComponent: baseComponent{
+			name: s.Name,
+		},
+		client:      client,
+		credentials: s.Credentials,
+		binding:     binding,
+	}
+
+	return component, nil
+}
+
+func (f *postgresFactory) connection(settings *postgresSettings, binding ContainerBinding) (*sqlx.DB, error) {
+	dsn := f.dsn(settings, binding, settings.Credentials.DatabaseName)
+
+	f.lck.Lock()
+	defer f.lck.Unlock()
+
+	if f.connections == nil {
+		f.connections = make(map[string]*sqlx.DB)
+	}
+
+	if _, ok := f.connections[dsn]; !ok {
+		// the database is missing if the container was reset
+		if err := f.createDatabase(settings, binding); err != nil {
+			return nil, fmt.Errorf("can not prepare database: %w", err)
+		}
+
+		client, err := sqlx.Open(postgresDriverName, dsn)
+		if err != nil {
+			return nil, fmt.Errorf("can not create client: %w", err)
+		}
+
+		f.connections[dsn] = client
+	}
+
+	return f.connections[dsn], nil
+}
+
+func (f *postgresFactory) createDatabase(settings *postgresSettings, binding ContainerBinding) error {
+	var exists bool
+
+	client, err := sqlx.Open(postgresDriverName, f.dsn(settings, binding, postgresMaintenanceDb))
+	if err != nil {
+		return fmt.Errorf("can not create client: %w", err)
+	}
+	defer client.Close()
+
+	if err = client.Get(&exists, "SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = $1)", settings.Credentials.DatabaseName); err != nil {
+		return fmt.Errorf("can not check if database exists: %w", err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	// postgres has no CREATE DATABASE IF NOT EXISTS
+	if _, err = client.Exec(fmt.Sprintf(`CREATE DATABASE "%s"`, settings.Credentials.DatabaseName)); err != nil {
+		return fmt.Errorf("can not create database: %w", err)
+	}
+
+	return nil
+}
+
+// reset drops the database, the component creates it again
+func (f *postgresFactory) reset(settings any) ComponentResetCallback {
+	return func(container *Container) error {
+		s := settings.(*postgresSettings)
+
+		client, err := sqlx.Open(postgresDriverName, f.dsn(s, container.bindings["main"], postgresMaintenanceDb))
+		if err != nil {
+			return fmt.Errorf("can not create client: %w", err)
+		}
+		defer client.Close()
+
+		// force closes the connections of earlier test runs, which would block dropping the database otherwise
+		if _, err = client.Exec(fmt.Sprintf(`DROP DATABASE IF EXISTS "%s" WITH (FORCE)`, s.Credentials.DatabaseName)); err != nil {
+			return fmt.Errorf("can not drop database: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func (f *postgresFactory) dsn(settings *postgresSettings, binding ContainerBinding, database string) string {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(settings.Credentials.UserName, settings.Credentials.UserPassword),
+		Host:     binding.getAddress(),
+		Path:     database,
+		RawQuery: "sslmode=disable",
+	}
+
+	return dsn.String()
+}
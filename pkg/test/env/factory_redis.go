@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	toxiproxy "github.com/Shopify/toxiproxy/v2/client"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/log"
 	baseRedis "github.com/redis/go-redis/v9"
@@ -19,12 +20,14 @@ const componentRedis = "redis"
 type redisSettings struct {
 	ComponentBaseSettings
 	ComponentContainerSettings
-	Port int `cfg:"port" default:"0"`
+	Port             int  `cfg:"port"              default:"0"`
+	ToxiproxyEnabled bool `cfg:"toxiproxy_enabled" default:"false"`
 }
 
 type redisFactory struct {
-	lck     sync.Mutex
-	clients map[string]*baseRedis.Client
+	lck              sync.Mutex
+	clients          map[string]*baseRedis.Client
+	toxiproxyFactory toxiproxyFactory
 }
 
 func (f *redisFactory) Detect(config cfg.Config, manager *ComponentsConfigManager) error {
@@ -60,12 +63,21 @@ func (f *redisFactory) GetSettingsSchema() ComponentBaseSettingsAware {
 }
 
 func (f *redisFactory) DescribeContainers(settings any) ComponentContainerDescriptions {
-	return ComponentContainerDescriptions{
+	s := settings.(*redisSettings)
+
+	descriptions := ComponentContainerDescriptions{
 		"main": {
 			ContainerConfig: f.configureContainer(settings),
 			HealthCheck:     f.healthCheck(),
+			ResetCallback:   f.reset(),
 		},
 	}
+
+	if s.ToxiproxyEnabled {
+		descriptions["toxiproxy"] = f.toxiproxyFactory.describeContainer()
+	}
+
+	return descriptions
 }
 
 func (f *redisFactory) configureContainer(settings any) *ContainerConfig {
@@ -87,17 +99,46 @@ func (f *redisFactory) configureContainer(settings any) *ContainerConfig {
 
 func (f *redisFactory) healthCheck() ComponentHealthCheck {
 	return func(container *Container) error {
-		client := f.client(container)
+		client := f.client(f.address(container))
 		err := client.Ping(context.Background()).Err()
 
 		return err
 	}
 }
 
-func (f *redisFactory) Component(_ cfg.Config, _ log.Logger, containers map[string]*Container, _ any) (Component, error) {
+func (f *redisFactory) reset() ComponentResetCallback {
+	return func(container *Container) error {
+		client := f.client(f.address(container))
+
+		if err := client.FlushAll(context.Background()).Err(); err != nil {
+			return fmt.Errorf("can not flush redis: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func (f *redisFactory) Component(_ cfg.Config, _ log.Logger, containers map[string]*Container, settings any) (Component, error) {
+	s := settings.(*redisSettings)
+
+	var err error
+	var proxy *toxiproxy.Proxy
+
+	// The application and the client of the component connect through toxiproxy if it is enabled
+	address := f.address(containers["main"])
+
+	if s.ToxiproxyEnabled {
+		if proxy, err = f.toxiproxyFactory.createProxy("redis", containers); err != nil {
+			return nil, err
+		}
+
+		address = f.address(containers["toxiproxy"])
+	}
+
 	component := &RedisComponent{
-		address: f.address(containers["main"]),
-		client:  f.client(containers["main"]),
+		address:   address,
+		client:    f.client(address),
+		toxiproxy: proxy,
 	}
 
 	return component, nil
@@ -110,9 +151,7 @@ func (f *redisFactory) address(container *Container) string {
 	return address
 }
 
-func (f *redisFactory) client(container *Container) *baseRedis.Client {
-	address := f.address(container)
-
+func (f *redisFactory) client(address string) *baseRedis.Client {
 	f.lck.Lock()
 	defer f.lck.Unlock()
 
@@ -0,0 +1,84 @@
+package env
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/spf13/cast"
+)
+
+const resourceNamesRunTag = "test_run"
+
+var (
+	resourceNamesRunIdSanitizer = regexp.MustCompile(`[^a-z0-9]+`)
+	resourceNamesGeneratedRunId = sync.OnceValue(func() string {
+		bytes := make([]byte, 4)
+		_, _ = rand.Read(bytes)
+
+		return hex.EncodeToString(bytes)
+	})
+)
+
+// ResourceNamesSettings configure if the queues, topics, tables, buckets and all other resources named by the
+// {app.namespace} placeholder get a suffix which is unique per test run. This allows multiple ci jobs to share one
+// localstack or kafka without their resources colliding. The run id defaults to a random token generated once per test
+// binary, set it to e.g. the id of the ci job to get reproducible names.
+type ResourceNamesSettings struct {
+	Unique bool   `cfg:"unique" default:"false"`
+	RunId  string `cfg:"run_id"`
+}
+
+// applyUniqueResourceNames stores the run id as the app tag test_run and appends it as the last part of the configured
+// app.namespace. As every identity is padded with the tags and the namespace of the config, the suffix is part of the
+// names of the app under test as well as of the identities of other apps (e.g. the sources of subscriptions). An empty
+// app.namespace is an error, as the names wouldn't be unique.
+func applyUniqueResourceNames(config cfg.GosoConf) error {
+	var err error
+	var raw any
+	var namespace string
+
+	settings := &ResourceNamesSettings{}
+	if err = config.UnmarshalKey("test.resource_names", settings); err != nil {
+		return fmt.Errorf("can not unmarshal resource names settings: %w", err)
+	}
+
+	if !settings.Unique {
+		return nil
+	}
+
+	runId := resourceNamesRunIdSanitizer.ReplaceAllString(strings.ToLower(settings.RunId), "")
+	if runId == "" {
+		runId = resourceNamesGeneratedRunId()
+	}
+
+	options := []cfg.Option{
+		cfg.WithConfigSetting(fmt.Sprintf("app.tags.%s", resourceNamesRunTag), runId),
+	}
+
+	// the namespace has to be read raw, GetString would already replace the placeholders in it
+	if raw, err = config.Get("app.namespace", ""); err != nil {
+		return fmt.Errorf("can not get app.namespace: %w", err)
+	}
+
+	if namespace, err = cast.ToStringE(raw); err != nil {
+		return fmt.Errorf("app.namespace %q is not a string: %w", raw, err)
+	}
+
+	// without a namespace, there is nothing to append the run id to and the names would silently stay the same
+	if namespace == "" {
+		return fmt.Errorf("unique resource names need app.namespace to be set, as the run id is appended to it")
+	}
+
+	options = append(options, cfg.WithConfigSetting("app.namespace", fmt.Sprintf("%s.{app.tags.%s}", namespace, resourceNamesRunTag)))
+
+	if err = config.Option(options...); err != nil {
+		return fmt.Errorf("can not apply unique resource names: %w", err)
+	}
+
+	return nil
+}
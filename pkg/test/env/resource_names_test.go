@@ -0,0 +1,95 @@
+package env_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/cloud/aws/sqs"
+	"github.com/justtrackio/gosoline/pkg/test/env"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newResourceNamesTestEnvironment(t *testing.T, options ...env.Option) *env.Environment {
+	options = append([]env.Option{
+		env.WithConfigMap(map[string]any{
+			"app": map[string]any{
+				"env":       "test",
+				"name":      "app",
+				"namespace": "{app.tags.project}.{app.env}",
+				"tags": map[string]any{
+					"project": "gosoline",
+				},
+			},
+		}),
+		env.WithoutAutoDetectedComponents(env.ComponentLocalstack),
+	}, options...)
+
+	environment, err := env.NewEnvironment(t, options...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, environment.Stop())
+	})
+
+	return environment
+}
+
+func getResourceNamesTestQueueName(t *testing.T, environment *env.Environment, identity cfg.Identity) string {
+	name, err := sqs.GetQueueName(environment.Config(), sqs.QueueNameSettings{
+		Identity:   identity,
+		ClientName: "default",
+		QueueId:    "events",
+	})
+	require.NoError(t, err)
+
+	return name
+}
+
+func TestUniqueResourceNames_Disabled(t *testing.T) {
+	environment := newResourceNamesTestEnvironment(t)
+
+	name := getResourceNamesTestQueueName(t, environment, cfg.Identity{})
+	assert.Equal(t, "gosoline-test-events", name)
+}
+
+func TestUniqueResourceNames_RunId(t *testing.T) {
+	environment := newResourceNamesTestEnvironment(t,
+		env.WithUniqueResourceNames(),
+		env.WithConfigSetting("test.resource_names.run_id", "CI-Job_42"),
+	)
+
+	name := getResourceNamesTestQueueName(t, environment, cfg.Identity{})
+	assert.Equal(t, "gosoline-test-cijob42-events", name)
+
+	name = getResourceNamesTestQueueName(t, environment, cfg.Identity{
+		Name: "other",
+		Tags: cfg.Tags{
+			"project": "other",
+		},
+	})
+	assert.Equal(t, "other-test-cijob42-events", name, "identities of other apps should get the run id while padding")
+}
+
+func TestUniqueResourceNames_GeneratedRunId(t *testing.T) {
+	first := newResourceNamesTestEnvironment(t, env.WithUniqueResourceNames())
+	second := newResourceNamesTestEnvironment(t, env.WithUniqueResourceNames())
+
+	name := getResourceNamesTestQueueName(t, first, cfg.Identity{})
+	assert.Regexp(t, `^gosoline-test-[0-9a-f]{8}-events$`, name)
+	assert.Equal(t, name, getResourceNamesTestQueueName(t, second, cfg.Identity{}), "all environments of a test run should share the run id")
+}
+
+func TestUniqueResourceNames_WithoutNamespace(t *testing.T) {
+	_, err := env.NewEnvironment(t,
+		env.WithConfigMap(map[string]any{
+			"app": map[string]any{
+				"env":  "test",
+				"name": "app",
+			},
+		}),
+		env.WithoutAutoDetectedComponents(env.ComponentLocalstack),
+		env.WithUniqueResourceNames(),
+	)
+	assert.ErrorContains(t, err, "unique resource names need app.namespace to be set")
+}
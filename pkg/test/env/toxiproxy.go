@@ -1,11 +1,21 @@
 package env
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	toxiproxy "github.com/Shopify/toxiproxy/v2/client"
 )
 
+const (
+	toxicLatency    = "latency"
+	toxicBandwidth  = "bandwidth"
+	toxicResetPeer  = "reset_peer"
+	toxicTimeout    = "timeout"
+	toxicDownstream = "downstream"
+)
+
 type toxiproxyFactory struct{}
 
 func (f *toxiproxyFactory) describeContainer() *ComponentContainerDescription {
@@ -31,6 +41,26 @@ func (f *toxiproxyFactory) describeContainer() *ComponentContainerDescription {
 	}
 }
 
+// createProxy places toxiproxy in front of the main port of the main container. Clients have to connect to the main
+// binding of the toxiproxy container afterward.
+func (f *toxiproxyFactory) createProxy(name string, containers map[string]*Container) (*toxiproxy.Proxy, error) {
+	client := f.client(containers["toxiproxy"])
+
+	// Use the internal address for container-to-container communication, so toxiproxy reaches the component on the
+	// Docker network. Fall back to the external binding if the internal one is not available.
+	upstream := containers["main"].getInternalAddress("main")
+	if upstream == "" {
+		upstream = containers["main"].bindings["main"].getAddress()
+	}
+
+	proxy, err := client.CreateProxy(name, ":56248", upstream)
+	if err != nil {
+		return nil, fmt.Errorf("can not create toxiproxy proxy for %s component: %w", name, err)
+	}
+
+	return proxy, nil
+}
+
 func (f *toxiproxyFactory) client(container *Container) *toxiproxy.Client {
 	binding := container.bindings["admin"]
 	address := fmt.Sprintf("%s:%s", binding.host, binding.port)
@@ -38,3 +68,109 @@ func (f *toxiproxyFactory) client(container *Container) *toxiproxy.Client {
 
 	return client
 }
+
+// ProxyToxics injects faults into the connections of a component running behind toxiproxy, e.g. to test retries or
+// circuit breakers. Every kind of toxic exists at most once, adding it again replaces it. Call Reset, e.g. in the
+// TearDownTest of a suite, to remove all toxics again.
+type ProxyToxics struct {
+	proxy *toxiproxy.Proxy
+}
+
+func NewProxyToxics(proxy *toxiproxy.Proxy) *ProxyToxics {
+	return &ProxyToxics{
+		proxy: proxy,
+	}
+}
+
+// Latency delays all data sent from the component by latency plus a random jitter.
+func (t *ProxyToxics) Latency(latency time.Duration, jitter time.Duration) error {
+	return t.add(toxicLatency, toxiproxy.Attributes{
+		"latency": latency.Milliseconds(),
+		"jitter":  jitter.Milliseconds(),
+	})
+}
+
+// Bandwidth limits the data sent from the component to the given rate in KB/s.
+func (t *ProxyToxics) Bandwidth(rateKbPerSecond int64) error {
+	return t.add(toxicBandwidth, toxiproxy.Attributes{
+		"rate": rateKbPerSecond,
+	})
+}
+
+// ResetPeer resets all connections with a TCP RST after the timeout, simulating a crashing component.
+func (t *ProxyToxics) ResetPeer(timeout time.Duration) error {
+	return t.add(toxicResetPeer, toxiproxy.Attributes{
+		"timeout": timeout.Milliseconds(),
+	})
+}
+
+// Timeout stops all data from the component and closes the connections after the timeout. With a timeout of 0, the
+// connections stay open until the toxic is removed.
+func (t *ProxyToxics) Timeout(timeout time.Duration) error {
+	return t.add(toxicTimeout, toxiproxy.Attributes{
+		"timeout": timeout.Milliseconds(),
+	})
+}
+
+// Disable closes all connections and refuses new ones until Enable or Reset is called.
+func (t *ProxyToxics) Disable() error {
+	if err := t.proxy.Disable(); err != nil {
+		return fmt.Errorf("can not disable proxy %s: %w", t.proxy.Name, err)
+	}
+
+	return nil
+}
+
+func (t *ProxyToxics) Enable() error {
+	if err := t.proxy.Enable(); err != nil {
+		return fmt.Errorf("can not enable proxy %s: %w", t.proxy.Name, err)
+	}
+
+	return nil
+}
+
+// Reset removes all toxics and enables the proxy again.
+func (t *ProxyToxics) Reset() error {
+	toxics, err := t.proxy.Toxics()
+	if err != nil {
+		return fmt.Errorf("can not list the toxics of proxy %s: %w", t.proxy.Name, err)
+	}
+
+	var errs error
+	for _, toxic := range toxics {
+		if err = t.proxy.RemoveToxic(toxic.Name); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("can not remove toxic %s: %w", toxic.Name, err))
+		}
+	}
+
+	if errs != nil {
+		return errs
+	}
+
+	return t.Enable()
+}
+
+func (t *ProxyToxics) add(typeName string, attributes toxiproxy.Attributes) error {
+	toxics, err := t.proxy.Toxics()
+	if err != nil {
+		return fmt.Errorf("can not list the toxics of proxy %s: %w", t.proxy.Name, err)
+	}
+
+	for _, toxic := range toxics {
+		if toxic.Name != typeName {
+			continue
+		}
+
+		if _, err = t.proxy.UpdateToxic(typeName, 1, attributes); err != nil {
+			return fmt.Errorf("can not update toxic %s of proxy %s: %w", typeName, t.proxy.Name, err)
+		}
+
+		return nil
+	}
+
+	if _, err = t.proxy.AddToxic(typeName, typeName, toxicDownstream, 1, attributes); err != nil {
+		return fmt.Errorf("can not add toxic %s to proxy %s: %w", typeName, t.proxy.Name, err)
+	}
+
+	return nil
+}
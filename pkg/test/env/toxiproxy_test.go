@@ -0,0 +1,170 @@
+package env_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	toxiproxy "github.com/Shopify/toxiproxy/v2/client"
+	"github.com/justtrackio/gosoline/pkg/test/env"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeToxiproxyServer implements the parts of the toxiproxy api used by the ProxyToxics for a single proxy.
+type fakeToxiproxyServer struct {
+	lck     sync.Mutex
+	proxy   toxiproxy.Proxy
+	toxics  map[string]*toxiproxy.Toxic
+	updates []string
+}
+
+func newFakeToxiproxyServer() *fakeToxiproxyServer {
+	return &fakeToxiproxyServer{
+		proxy: toxiproxy.Proxy{
+			Name:     "redis",
+			Listen:   "[::]:56248",
+			Upstream: "redis:6379",
+			Enabled:  true,
+		},
+		toxics: map[string]*toxiproxy.Toxic{},
+	}
+}
+
+func (f *fakeToxiproxyServer) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /proxies/redis", func(w http.ResponseWriter, _ *http.Request) {
+		f.write(w, f.proxy)
+	})
+	mux.HandleFunc("POST /proxies/redis", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&f.proxy)
+		f.write(w, f.proxy)
+	})
+	mux.HandleFunc("GET /proxies/redis/toxics", func(w http.ResponseWriter, _ *http.Request) {
+		toxics := make(toxiproxy.Toxics, 0, len(f.toxics))
+		for _, toxic := range f.toxics {
+			toxics = append(toxics, *toxic)
+		}
+
+		f.write(w, toxics)
+	})
+	mux.HandleFunc("POST /proxies/redis/toxics", func(w http.ResponseWriter, r *http.Request) {
+		toxic := &toxiproxy.Toxic{}
+		_ = json.NewDecoder(r.Body).Decode(toxic)
+
+		f.toxics[toxic.Name] = toxic
+		f.write(w, toxic)
+	})
+	mux.HandleFunc("PATCH /proxies/redis/toxics/{name}", func(w http.ResponseWriter, r *http.Request) {
+		toxic, ok := f.toxics[r.PathValue("name")]
+		if !ok {
+			http.Error(w, `{"error":"toxic not found","status":404}`, http.StatusNotFound)
+
+			return
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(toxic)
+
+		f.updates = append(f.updates, toxic.Name)
+		f.write(w, toxic)
+	})
+	mux.HandleFunc("DELETE /proxies/redis/toxics/{name}", func(w http.ResponseWriter, r *http.Request) {
+		delete(f.toxics, r.PathValue("name"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.lck.Lock()
+		defer f.lck.Unlock()
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (f *fakeToxiproxyServer) write(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+type ProxyToxicsTestSuite struct {
+	suite.Suite
+
+	server *fakeToxiproxyServer
+	toxics *env.ProxyToxics
+}
+
+func (s *ProxyToxicsTestSuite) SetupTest() {
+	s.server = newFakeToxiproxyServer()
+
+	srv := httptest.NewServer(s.server.handler())
+	s.T().Cleanup(srv.Close)
+
+	proxy, err := toxiproxy.NewClient(srv.URL).Proxy("redis")
+	s.Require().NoError(err)
+
+	s.toxics = env.NewProxyToxics(proxy)
+}
+
+func (s *ProxyToxicsTestSuite) TestLatency() {
+	err := s.toxics.Latency(100*time.Millisecond, 10*time.Millisecond)
+	s.NoError(err)
+
+	s.Require().Contains(s.server.toxics, "latency")
+	toxic := s.server.toxics["latency"]
+
+	s.Equal("latency", toxic.Type)
+	s.Equal("downstream", toxic.Stream)
+	s.Equal(float32(1), toxic.Toxicity)
+	s.Equal(toxiproxy.Attributes{"latency": float64(100), "jitter": float64(10)}, toxic.Attributes)
+}
+
+func (s *ProxyToxicsTestSuite) TestAddTwiceUpdates() {
+	s.NoError(s.toxics.Bandwidth(64))
+	s.NoError(s.toxics.Bandwidth(16))
+
+	s.Len(s.server.toxics, 1)
+	s.Equal([]string{"bandwidth"}, s.server.updates)
+	s.Equal(toxiproxy.Attributes{"rate": float64(16)}, s.server.toxics["bandwidth"].Attributes)
+}
+
+func (s *ProxyToxicsTestSuite) TestReset() {
+	s.NoError(s.toxics.ResetPeer(time.Second))
+	s.NoError(s.toxics.Timeout(0))
+	s.NoError(s.toxics.Disable())
+
+	s.Len(s.server.toxics, 2)
+	s.False(s.server.proxy.Enabled)
+
+	err := s.toxics.Reset()
+	s.NoError(err)
+
+	s.Empty(s.server.toxics)
+	s.True(s.server.proxy.Enabled)
+}
+
+func TestProxyToxicsTestSuite(t *testing.T) {
+	suite.Run(t, new(ProxyToxicsTestSuite))
+}
+
+func TestProxyToxics_AddError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && r.URL.Path == "/proxies/redis" {
+			_, _ = w.Write([]byte(`{"name":"redis","enabled":true}`))
+
+			return
+		}
+
+		http.Error(w, `{"error":"proxy not found","status":404}`, http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	proxy, err := toxiproxy.NewClient(srv.URL).Proxy("redis")
+	assert.NoError(t, err)
+
+	err = env.NewProxyToxics(proxy).Latency(time.Second, 0)
+	assert.ErrorContains(t, err, "can not list the toxics of proxy redis")
+}
@@ -0,0 +1,301 @@
+package harness
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/justtrackio/gosoline/pkg/smpl"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/stream/health"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const MalformedBody = "{malformed"
+
+type consumerHarnessSettings struct {
+	consumer    stream.ConsumerSettings
+	nativeRetry bool
+	waitTimeout time.Duration
+}
+
+type ConsumerHarnessOption func(settings *consumerHarnessSettings)
+
+// WithConsumerSettings replaces the settings of the consumer under test. The input of the settings is ignored.
+func WithConsumerSettings(settings stream.ConsumerSettings) ConsumerHarnessOption {
+	return func(s *consumerHarnessSettings) {
+		s.consumer = settings
+	}
+}
+
+// WithNativeRetry makes the input retry messages itself like the sqs input does: the consumer doesn't put failed
+// messages into its retry handler, they are delivered again once ExpireVisibilityTimeout is called.
+func WithNativeRetry() ConsumerHarnessOption {
+	return func(s *consumerHarnessSettings) {
+		s.nativeRetry = true
+	}
+}
+
+// WithWaitTimeout sets how long Wait waits for the consumer to process all messages. It defaults to 5 seconds.
+func WithWaitTimeout(timeout time.Duration) ConsumerHarnessOption {
+	return func(s *consumerHarnessSettings) {
+		s.waitTimeout = timeout
+	}
+}
+
+// ConsumerHarness runs a consumer callback like the stream consumer module does, but reads the messages from an input
+// controlled by the test. It records the acknowledged and retried messages, the metrics written by the consumer and
+// the messages written to the outputs of the harness, so tests can assert on all of them.
+type ConsumerHarness struct {
+	t           *testing.T
+	settings    *consumerHarnessSettings
+	encoder     stream.MessageEncoder
+	consumer    *stream.Consumer
+	input       *harnessInput
+	retryInput  *harnessInput
+	retried     *messageRecorder
+	metrics     *metricRecorder
+	lck         sync.Mutex
+	outputs     map[string]*stream.InMemoryOutput
+	startOnce   sync.Once
+	stopOnce    sync.Once
+	cancel      context.CancelFunc
+	runFinished chan error
+}
+
+// NewTypedConsumerHarness creates a harness for the callback of a consumer created with stream.NewConsumer.
+func NewTypedConsumerHarness[M any](t *testing.T, callback stream.ConsumerCallback[M], options ...ConsumerHarnessOption) *ConsumerHarness {
+	return NewConsumerHarness(t, stream.EraseConsumerCallbackTypes(callback), options...)
+}
+
+func NewConsumerHarness(t *testing.T, callback stream.UntypedConsumerCallback, options ...ConsumerHarnessOption) *ConsumerHarness {
+	settings := &consumerHarnessSettings{
+		consumer: stream.ConsumerSettings{
+			RunnerCount:          1,
+			Encoding:             stream.EncodingJson,
+			IdleTimeout:          time.Minute,
+			AcknowledgeGraceTime: time.Second,
+			ConsumeGraceTime:     time.Second,
+			Healthcheck: health.HealthCheckSettings{
+				Timeout: time.Minute,
+			},
+			AggregateMessageMode: stream.AggregateMessageModeAtMostOnce,
+		},
+		waitTimeout: 5 * time.Second,
+	}
+
+	for _, opt := range options {
+		opt(settings)
+	}
+
+	settings.consumer.Input = "harness"
+	settings.consumer.Retry.Enabled = true
+
+	h := &ConsumerHarness{
+		t:           t,
+		settings:    settings,
+		encoder:     stream.NewMessageEncoder(&stream.MessageEncoderSettings{Encoding: settings.consumer.Encoding}),
+		retried:     &messageRecorder{},
+		metrics:     &metricRecorder{},
+		outputs:     map[string]*stream.InMemoryOutput{},
+		runFinished: make(chan error, 1),
+	}
+
+	h.input = newHarnessInput()
+	h.retryInput = newHarnessInput()
+
+	var input stream.Input = h.input
+	if settings.nativeRetry {
+		input = &harnessRetryingInput{harnessInput: h.input}
+	}
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	healthCheckTimer := clock.NewHealthCheckTimerWithInterfaces(clock.NewRealClock(), settings.consumer.Healthcheck.Timeout)
+	samplingDecider := smpl.NewDeciderWithInterfaces(nil, &smpl.Settings{}, h.metrics)
+
+	base := stream.NewBaseConsumerWithInterfaces(
+		uuid.New(),
+		logger,
+		h.metrics,
+		tracing.NewLocalTracer(),
+		input,
+		h.encoder,
+		h.retryInput,
+		h.retried,
+		callback,
+		settings.consumer,
+		"harness",
+		cfg.Identity{},
+	)
+	h.consumer = stream.NewUntypedConsumerWithInterfaces(base, callback, healthCheckTimer, samplingDecider)
+
+	t.Cleanup(h.Stop)
+
+	return h
+}
+
+// Output returns an in-memory output with the given name. Hand it to the callback under test (e.g. with a producer
+// created by stream.NewProducerWithInterfaces) to assert the messages it writes.
+func (h *ConsumerHarness) Output(name string) *stream.InMemoryOutput {
+	h.lck.Lock()
+	defer h.lck.Unlock()
+
+	if _, ok := h.outputs[name]; !ok {
+		h.outputs[name] = stream.NewInMemoryOutput()
+	}
+
+	return h.outputs[name]
+}
+
+// OutputMessages returns all messages written to the output with the given name.
+func (h *ConsumerHarness) OutputMessages(name string) []*stream.Message {
+	output := h.Output(name)
+	messages := make([]*stream.Message, 0, output.Len())
+
+	for i := range output.Len() {
+		msg, _ := output.Get(i)
+		messages = append(messages, msg)
+	}
+
+	return messages
+}
+
+// UnmarshalOutput decodes the i-th message of the output into model and returns its attributes.
+func (h *ConsumerHarness) UnmarshalOutput(name string, i int, model any) map[string]string {
+	msg, ok := h.Output(name).Get(i)
+	require.True(h.t, ok, "there is no message with index %d in output %s", i, name)
+
+	_, attributes, err := h.encoder.Decode(h.t.Context(), msg, model)
+	require.NoError(h.t, err, "can not decode message %d of output %s", i, name)
+
+	return attributes
+}
+
+// Publish encodes the body with the encoding of the consumer and hands it to the consumer.
+func (h *ConsumerHarness) Publish(body any, attributes ...map[string]string) *stream.Message {
+	msg, err := h.encoder.Encode(h.t.Context(), body, attributes...)
+	require.NoError(h.t, err, "can not encode message")
+
+	return h.PublishMessage(msg)
+}
+
+// PublishMalformed hands a message to the consumer which can't be decoded, to test how unmarshal failures are handled.
+func (h *ConsumerHarness) PublishMalformed(attributes ...map[string]string) *stream.Message {
+	return h.PublishMessage(stream.NewJsonMessage(MalformedBody, attributes...))
+}
+
+// PublishMessage hands the message as is to the consumer.
+func (h *ConsumerHarness) PublishMessage(msg *stream.Message) *stream.Message {
+	h.start()
+	h.input.deliver(msg)
+
+	return msg
+}
+
+// Wait blocks until the consumer acknowledged or rejected every message published so far and fails the test if that
+// takes longer than the wait timeout.
+func (h *ConsumerHarness) Wait() {
+	h.t.Helper()
+
+	h.start()
+
+	assert.Eventually(h.t, func() bool {
+		return h.input.pending() == 0 && h.retryInput.pending() == 0
+	}, h.settings.waitTimeout, time.Millisecond, "the consumer did not process all messages in time")
+}
+
+// Stop shuts the consumer down and fails the test if it returned an error. It is called automatically at the end of the
+// test.
+func (h *ConsumerHarness) Stop() {
+	h.stopOnce.Do(func() {
+		if h.cancel == nil {
+			return
+		}
+
+		h.cancel()
+
+		select {
+		case err := <-h.runFinished:
+			assert.NoError(h.t, err, "the consumer should stop without an error")
+		case <-time.After(h.settings.waitTimeout):
+			assert.Fail(h.t, "the consumer did not stop in time")
+		}
+	})
+}
+
+// Acked returns the messages the consumer acknowledged, i.e. which are done.
+func (h *ConsumerHarness) Acked() []*stream.Message {
+	return append(h.input.acked.all(), h.retryInput.acked.all()...)
+}
+
+// Nacked returns the messages the consumer did not acknowledge, which the queue would deliver again once their
+// visibility timeout expires.
+func (h *ConsumerHarness) Nacked() []*stream.Message {
+	return append(h.input.nacked.all(), h.retryInput.nacked.all()...)
+}
+
+// Retried returns the messages the consumer put into its retry handler and which were not redelivered yet.
+func (h *ConsumerHarness) Retried() []*stream.Message {
+	return h.retried.all()
+}
+
+// RedeliverRetries hands all retried messages to the consumer again, like the retry handler does after the retry delay.
+// It returns the number of redelivered messages.
+func (h *ConsumerHarness) RedeliverRetries() int {
+	h.start()
+
+	messages := h.retried.drain()
+	for _, msg := range messages {
+		h.retryInput.deliver(msg)
+	}
+
+	return len(messages)
+}
+
+// ExpireVisibilityTimeout hands all messages the consumer did not acknowledge to it again, like a queue does once their
+// visibility timeout expires. It returns the number of redelivered messages.
+func (h *ConsumerHarness) ExpireVisibilityTimeout() int {
+	h.start()
+
+	messages := h.input.nacked.drain()
+	for _, msg := range messages {
+		h.input.deliver(msg)
+	}
+
+	return len(messages)
+}
+
+// Metrics returns all data written by the consumer for the metric with the given name, e.g. ProcessedCount, Error,
+// RetryPutCount, RetryGetCount or UnknownModelError.
+func (h *ConsumerHarness) Metrics(name string) metric.Data {
+	return h.metrics.get(name)
+}
+
+// MetricSum returns the sum of all values written for the metric with the given name.
+func (h *ConsumerHarness) MetricSum(name string) float64 {
+	sum := 0.0
+	for _, datum := range h.metrics.get(name) {
+		sum += datum.Value
+	}
+
+	return sum
+}
+
+func (h *ConsumerHarness) start() {
+	h.startOnce.Do(func() {
+		var ctx context.Context
+		ctx, h.cancel = context.WithCancel(h.t.Context())
+
+		go func() {
+			h.runFinished <- h.consumer.Run(ctx)
+		}()
+	})
+}
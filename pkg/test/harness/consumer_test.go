@@ -0,0 +1,117 @@
+package harness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/test/harness"
+	"github.com/stretchr/testify/assert"
+)
+
+type order struct {
+	Id     int  `json:"id"`
+	Amount int  `json:"amount"`
+	Failed bool `json:"failed"`
+}
+
+// orderCallback forwards valid orders to its producer and fails the first attempt of orders marked as failed.
+type orderCallback struct {
+	producer stream.Producer
+	attempts map[int]int
+}
+
+func (c *orderCallback) Consume(ctx context.Context, model order, attributes map[string]string) (bool, error) {
+	c.attempts[model.Id]++
+
+	if model.Failed && c.attempts[model.Id] == 1 {
+		return false, fmt.Errorf("order %d failed", model.Id)
+	}
+
+	if err := c.producer.WriteOne(ctx, model, map[string]string{"source": attributes["source"]}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func newOrderHarness(t *testing.T, options ...harness.ConsumerHarnessOption) (*harness.ConsumerHarness, *orderCallback) {
+	callback := &orderCallback{
+		attempts: map[int]int{},
+	}
+
+	h := harness.NewTypedConsumerHarness[order](t, callback, options...)
+	callback.producer = stream.NewProducerWithInterfaces(stream.NewMessageEncoder(&stream.MessageEncoderSettings{}), h.Output("orders"))
+
+	return h, callback
+}
+
+func TestConsumerHarness_Consume(t *testing.T) {
+	h, _ := newOrderHarness(t)
+
+	h.Publish(order{Id: 1, Amount: 10}, map[string]string{"source": "api"})
+	h.Publish(order{Id: 2, Amount: 20})
+	h.Wait()
+
+	assert.Len(t, h.Acked(), 2)
+	assert.Empty(t, h.Nacked())
+	assert.Empty(t, h.Retried())
+	assert.Equal(t, 2.0, h.MetricSum("ProcessedCount"))
+	assert.Len(t, h.OutputMessages("orders"), 2)
+
+	model := order{}
+	attributes := h.UnmarshalOutput("orders", 0, &model)
+	assert.Equal(t, order{Id: 1, Amount: 10}, model)
+	assert.Equal(t, "api", attributes["source"])
+}
+
+func TestConsumerHarness_Retry(t *testing.T) {
+	h, callback := newOrderHarness(t)
+
+	h.Publish(order{Id: 1, Failed: true})
+	h.Wait()
+
+	assert.Empty(t, h.Acked())
+	assert.Len(t, h.Retried(), 1)
+	assert.Equal(t, 1.0, h.MetricSum("Error"))
+	assert.Equal(t, 1.0, h.MetricSum("RetryPutCount"))
+	assert.Empty(t, h.OutputMessages("orders"))
+
+	assert.Equal(t, 1, h.RedeliverRetries())
+	h.Wait()
+
+	assert.Len(t, h.Acked(), 1)
+	assert.Empty(t, h.Retried())
+	assert.Equal(t, 1.0, h.MetricSum("RetryGetCount"))
+	assert.Equal(t, 2, callback.attempts[1])
+	assert.Len(t, h.OutputMessages("orders"), 1)
+}
+
+func TestConsumerHarness_VisibilityTimeout(t *testing.T) {
+	h, callback := newOrderHarness(t, harness.WithNativeRetry())
+
+	h.Publish(order{Id: 1, Failed: true})
+	h.Wait()
+
+	assert.Len(t, h.Nacked(), 1)
+	assert.Empty(t, h.Retried())
+
+	assert.Equal(t, 1, h.ExpireVisibilityTimeout())
+	h.Wait()
+
+	assert.Len(t, h.Acked(), 1)
+	assert.Empty(t, h.Nacked())
+	assert.Equal(t, 2, callback.attempts[1])
+}
+
+func TestConsumerHarness_Malformed(t *testing.T) {
+	h, callback := newOrderHarness(t, harness.WithNativeRetry())
+
+	h.PublishMalformed()
+	h.Wait()
+
+	assert.Len(t, h.Nacked(), 1)
+	assert.Equal(t, 1.0, h.MetricSum("Error"))
+	assert.Empty(t, callback.attempts)
+}
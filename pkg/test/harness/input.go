@@ -0,0 +1,140 @@
+package harness
+
+import (
+	"context"
+	"sync"
+
+	"github.com/justtrackio/gosoline/pkg/stream"
+)
+
+var (
+	_ stream.AcknowledgeableInput = &harnessInput{}
+	_ stream.RetryingInput        = &harnessRetryingInput{}
+	_ stream.RetryHandler         = &messageRecorder{}
+)
+
+// harnessInput delivers the published messages to the consumer and records whether the consumer acknowledged them.
+type harnessInput struct {
+	lck        sync.Mutex
+	channel    chan *stream.Message
+	stopped    chan struct{}
+	stopOnce   sync.Once
+	inProgress int
+	acked      *messageRecorder
+	nacked     *messageRecorder
+}
+
+func newHarnessInput() *harnessInput {
+	return &harnessInput{
+		channel: make(chan *stream.Message, 1024),
+		stopped: make(chan struct{}),
+		acked:   &messageRecorder{},
+		nacked:  &messageRecorder{},
+	}
+}
+
+func (i *harnessInput) deliver(msg *stream.Message) {
+	i.lck.Lock()
+	i.inProgress++
+	i.lck.Unlock()
+
+	i.channel <- msg
+}
+
+func (i *harnessInput) pending() int {
+	i.lck.Lock()
+	defer i.lck.Unlock()
+
+	return i.inProgress
+}
+
+func (i *harnessInput) Run(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+	case <-i.stopped:
+	}
+
+	close(i.channel)
+
+	return nil
+}
+
+func (i *harnessInput) Stop(_ context.Context) {
+	i.stopOnce.Do(func() {
+		close(i.stopped)
+	})
+}
+
+func (i *harnessInput) Data() <-chan *stream.Message {
+	return i.channel
+}
+
+func (i *harnessInput) IsHealthy() bool {
+	return true
+}
+
+func (i *harnessInput) Ack(_ context.Context, msg *stream.Message, ack bool) error {
+	if ack {
+		i.acked.add(msg)
+	} else {
+		i.nacked.add(msg)
+	}
+
+	i.lck.Lock()
+	i.inProgress--
+	i.lck.Unlock()
+
+	return nil
+}
+
+func (i *harnessInput) AckBatch(ctx context.Context, msgs []*stream.Message, acks []bool) error {
+	for j, msg := range msgs {
+		_ = i.Ack(ctx, msg, acks[j])
+	}
+
+	return nil
+}
+
+// harnessRetryingInput retries messages itself, so the consumer doesn't use its retry handler.
+type harnessRetryingInput struct {
+	*harnessInput
+}
+
+func (i *harnessRetryingInput) GetRetryHandler() (stream.Input, stream.RetryHandler) {
+	return stream.NewNoopInput(), stream.NewRetryHandlerNoopWithInterfaces()
+}
+
+type messageRecorder struct {
+	lck      sync.Mutex
+	messages []*stream.Message
+}
+
+func (r *messageRecorder) Put(_ context.Context, msg *stream.Message) error {
+	r.add(msg)
+
+	return nil
+}
+
+func (r *messageRecorder) add(msg *stream.Message) {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	r.messages = append(r.messages, msg)
+}
+
+func (r *messageRecorder) all() []*stream.Message {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	return append([]*stream.Message{}, r.messages...)
+}
+
+func (r *messageRecorder) drain() []*stream.Message {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	messages := r.messages
+	r.messages = nil
+
+	return messages
+}
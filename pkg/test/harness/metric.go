@@ -0,0 +1,44 @@
+package harness
+
+import (
+	"context"
+	"sync"
+
+	"github.com/justtrackio/gosoline/pkg/metric"
+)
+
+var _ metric.Writer = &metricRecorder{}
+
+type metricRecorder struct {
+	lck  sync.Mutex
+	data metric.Data
+}
+
+func (r *metricRecorder) GetPriority() int {
+	return metric.PriorityLow
+}
+
+func (r *metricRecorder) Write(_ context.Context, batch metric.Data) {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	r.data = append(r.data, batch...)
+}
+
+func (r *metricRecorder) WriteOne(ctx context.Context, data *metric.Datum) {
+	r.Write(ctx, metric.Data{data})
+}
+
+func (r *metricRecorder) get(name string) metric.Data {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	data := make(metric.Data, 0)
+	for _, datum := range r.data {
+		if datum.MetricName == name {
+			data = append(data, datum)
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,285 @@
+package suite
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/justtrackio/gosoline/pkg/httpserver/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// HttpserverClient is a client for the http server of the application under test. Cookies set by the server are stored
+// and sent with all following requests, as are the credentials and headers configured on the client. Use it as last
+// argument of a httpserver test case instead of a *resty.Client:
+//
+// func (s *Suite) TestXXX(app suite.AppUnderTest, client *suite.HttpserverClient) error
+type HttpserverClient struct {
+	t      require.TestingT
+	client *resty.Client
+}
+
+func NewHttpserverClient(t require.TestingT, client *resty.Client) *HttpserverClient {
+	return &HttpserverClient{
+		t:      t,
+		client: client,
+	}
+}
+
+// Resty returns the underlying client for everything not covered by the HttpserverClient.
+func (c *HttpserverClient) Resty() *resty.Client {
+	return c.client
+}
+
+// WithBearerToken sends the token in the Authorization header of all following requests.
+func (c *HttpserverClient) WithBearerToken(token string) *HttpserverClient {
+	c.client.SetAuthToken(token)
+
+	return c
+}
+
+// WithApiKey sends the key in the X-API-KEY header of all following requests, as expected by the api key authenticator.
+func (c *HttpserverClient) WithApiKey(key string) *HttpserverClient {
+	return c.WithHeader(auth.HeaderApiKey, key)
+}
+
+func (c *HttpserverClient) WithBasicAuth(user string, password string) *HttpserverClient {
+	c.client.SetBasicAuth(user, password)
+
+	return c
+}
+
+func (c *HttpserverClient) WithHeader(key string, value string) *HttpserverClient {
+	c.client.SetHeader(key, value)
+
+	return c
+}
+
+// WithCookie stores the cookie as if it was set by the server.
+func (c *HttpserverClient) WithCookie(cookie *http.Cookie) *HttpserverClient {
+	c.client.GetClient().Jar.SetCookies(c.baseUrl(), []*http.Cookie{cookie})
+
+	return c
+}
+
+// Cookies returns the cookies which are sent with the next request.
+func (c *HttpserverClient) Cookies() []*http.Cookie {
+	return c.client.GetClient().Jar.Cookies(c.baseUrl())
+}
+
+// Cookie returns the value of the cookie with the given name or an empty string if there is no such cookie.
+func (c *HttpserverClient) Cookie(name string) string {
+	for _, cookie := range c.Cookies() {
+		if cookie.Name == name {
+			return cookie.Value
+		}
+	}
+
+	return ""
+}
+
+// Reset removes all cookies, credentials and headers of the client, e.g. to log out.
+func (c *HttpserverClient) Reset() *HttpserverClient {
+	jar, err := cookiejar.New(nil)
+	require.NoError(c.t, err, "can not create cookie jar")
+
+	c.client.SetCookieJar(jar)
+	c.client.Cookies = nil
+	c.client.Token = ""
+	c.client.UserInfo = nil
+	c.client.Header = http.Header{}
+
+	return c
+}
+
+// R creates a new request. The request fails the test if it can not be performed or the response has an unexpected
+// status code.
+func (c *HttpserverClient) R() *HttpserverRequest {
+	return &HttpserverRequest{
+		t:       c.t,
+		request: c.client.R(),
+	}
+}
+
+func (c *HttpserverClient) baseUrl() *url.URL {
+	baseUrl, err := url.Parse(c.client.BaseURL)
+	require.NoError(c.t, err, "can not parse base url of the client")
+
+	return baseUrl
+}
+
+type HttpserverRequest struct {
+	t              require.TestingT
+	request        *resty.Request
+	expectedStatus int
+}
+
+// Resty returns the underlying request for everything not covered by the HttpserverRequest.
+func (r *HttpserverRequest) Resty() *resty.Request {
+	return r.request
+}
+
+func (r *HttpserverRequest) WithHeader(key string, value string) *HttpserverRequest {
+	r.request.SetHeader(key, value)
+
+	return r
+}
+
+func (r *HttpserverRequest) WithQueryParam(key string, value string) *HttpserverRequest {
+	r.request.SetQueryParam(key, value)
+
+	return r
+}
+
+func (r *HttpserverRequest) WithPathParam(key string, value string) *HttpserverRequest {
+	r.request.SetPathParam(key, value)
+
+	return r
+}
+
+// WithBearerToken overwrites the token of the client for this request.
+func (r *HttpserverRequest) WithBearerToken(token string) *HttpserverRequest {
+	r.request.SetAuthToken(token)
+
+	return r
+}
+
+func (r *HttpserverRequest) WithApiKey(key string) *HttpserverRequest {
+	return r.WithHeader(auth.HeaderApiKey, key)
+}
+
+func (r *HttpserverRequest) WithCookie(cookie *http.Cookie) *HttpserverRequest {
+	r.request.SetCookie(cookie)
+
+	return r
+}
+
+// WithJsonBody encodes the body as json and sets the content type accordingly.
+func (r *HttpserverRequest) WithJsonBody(body any) *HttpserverRequest {
+	bytes, err := json.Marshal(body)
+	require.NoError(r.t, err, "can not encode request body")
+
+	r.request.SetHeader("Content-Type", "application/json")
+	r.request.SetBody(bytes)
+
+	return r
+}
+
+// WithBody sends the body as is, see resty.Request.SetBody for the supported types.
+func (r *HttpserverRequest) WithBody(body any) *HttpserverRequest {
+	r.request.SetBody(body)
+
+	return r
+}
+
+// WithMultipartField adds a form field to a multipart body.
+func (r *HttpserverRequest) WithMultipartField(name string, value string) *HttpserverRequest {
+	r.request.SetMultipartFormData(map[string]string{
+		name: value,
+	})
+
+	return r
+}
+
+// WithMultipartFile adds a file with the given content to a multipart body.
+func (r *HttpserverRequest) WithMultipartFile(param string, fileName string, content io.Reader) *HttpserverRequest {
+	r.request.SetFileReader(param, fileName, content)
+
+	return r
+}
+
+// ExpectStatus fails the test if the response doesn't have the given status code.
+func (r *HttpserverRequest) ExpectStatus(statusCode int) *HttpserverRequest {
+	r.expectedStatus = statusCode
+
+	return r
+}
+
+func (r *HttpserverRequest) Get(url string) *HttpserverResponse {
+	return r.Execute(http.MethodGet, url)
+}
+
+func (r *HttpserverRequest) Post(url string) *HttpserverResponse {
+	return r.Execute(http.MethodPost, url)
+}
+
+func (r *HttpserverRequest) Put(url string) *HttpserverResponse {
+	return r.Execute(http.MethodPut, url)
+}
+
+func (r *HttpserverRequest) Patch(url string) *HttpserverResponse {
+	return r.Execute(http.MethodPatch, url)
+}
+
+func (r *HttpserverRequest) Delete(url string) *HttpserverResponse {
+	return r.Execute(http.MethodDelete, url)
+}
+
+func (r *HttpserverRequest) Execute(method string, url string) *HttpserverResponse {
+	response, err := r.request.Execute(method, url)
+	require.NoError(r.t, err, "can not perform request %s %s", method, url)
+
+	if r.expectedStatus != 0 {
+		require.Equal(r.t, r.expectedStatus, response.StatusCode(), "unexpected status code of %s %s: %s", method, url, response.String())
+	}
+
+	return &HttpserverResponse{
+		t:        r.t,
+		response: response,
+	}
+}
+
+type HttpserverResponse struct {
+	t        require.TestingT
+	response *resty.Response
+}
+
+// Resty returns the underlying response for everything not covered by the HttpserverResponse.
+func (r *HttpserverResponse) Resty() *resty.Response {
+	return r.response
+}
+
+func (r *HttpserverResponse) StatusCode() int {
+	return r.response.StatusCode()
+}
+
+func (r *HttpserverResponse) Header() http.Header {
+	return r.response.Header()
+}
+
+func (r *HttpserverResponse) Cookies() []*http.Cookie {
+	return r.response.Cookies()
+}
+
+func (r *HttpserverResponse) Body() []byte {
+	return r.response.Body()
+}
+
+func (r *HttpserverResponse) String() string {
+	return r.response.String()
+}
+
+// AssertStatus fails the test if the response doesn't have the given status code.
+func (r *HttpserverResponse) AssertStatus(statusCode int) *HttpserverResponse {
+	assert.Equal(r.t, statusCode, r.response.StatusCode(), "unexpected status code: %s", r.response.String())
+
+	return r
+}
+
+// Decode decodes the json body of the response into target and fails the test if that is not possible.
+func (r *HttpserverResponse) Decode(target any) {
+	err := json.Unmarshal(r.response.Body(), target)
+	require.NoError(r.t, err, "can not decode response body %q", r.response.String())
+}
+
+// DecodeHttpserverResponse decodes the json body of the response into a new value of type T.
+func DecodeHttpserverResponse[T any](response *HttpserverResponse) T {
+	var result T
+	response.Decode(&result)
+
+	return result
+}
@@ -0,0 +1,130 @@
+package suite_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/justtrackio/gosoline/pkg/test/suite"
+	"github.com/stretchr/testify/assert"
+)
+
+type httpserverClientTestUser struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func newHttpserverClientTestServer(t *testing.T) *suite.HttpserverClient {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "abc", Path: "/"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /me", func(w http.ResponseWriter, r *http.Request) {
+		session, err := r.Cookie("session")
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"session":       session.Value,
+			"authorization": r.Header.Get("Authorization"),
+			"apiKey":        r.Header.Get("X-API-KEY"),
+		})
+	})
+	mux.HandleFunc("POST /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		user := httpserverClientTestUser{}
+		_ = json.NewDecoder(r.Body).Decode(&user)
+		user.Name = user.Name + " " + r.PathValue("id") + " " + r.URL.Query().Get("suffix")
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(user)
+	})
+	mux.HandleFunc("POST /upload", func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		content, _ := io.ReadAll(file)
+
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"description": r.FormValue("description"),
+			"file":        header.Filename,
+			"content":     string(content),
+		})
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return suite.NewHttpserverClient(t, resty.New().SetBaseURL(srv.URL))
+}
+
+func TestHttpserverClient_CookiesAndAuth(t *testing.T) {
+	client := newHttpserverClientTestServer(t)
+
+	client.R().ExpectStatus(http.StatusUnauthorized).Get("/me")
+	client.R().ExpectStatus(http.StatusNoContent).Post("/login")
+	assert.Equal(t, "abc", client.Cookie("session"))
+
+	client.WithBearerToken("token").WithApiKey("key")
+
+	response := client.R().ExpectStatus(http.StatusOK).Get("/me")
+	assert.Equal(t, map[string]string{
+		"session":       "abc",
+		"authorization": "Bearer token",
+		"apiKey":        "key",
+	}, suite.DecodeHttpserverResponse[map[string]string](response))
+
+	client.Reset().WithCookie(&http.Cookie{Name: "session", Value: "def"})
+
+	response = client.R().WithBearerToken("other").Get("/me")
+	response.AssertStatus(http.StatusOK)
+	assert.Equal(t, map[string]string{
+		"session":       "def",
+		"authorization": "Bearer other",
+		"apiKey":        "",
+	}, suite.DecodeHttpserverResponse[map[string]string](response))
+}
+
+func TestHttpserverClient_JsonBody(t *testing.T) {
+	client := newHttpserverClientTestServer(t)
+
+	response := client.R().
+		WithPathParam("id", "3").
+		WithQueryParam("suffix", "jr").
+		WithJsonBody(httpserverClientTestUser{Id: 3, Name: "john"}).
+		ExpectStatus(http.StatusCreated).
+		Post("/users/{id}")
+
+	user := httpserverClientTestUser{}
+	response.Decode(&user)
+
+	assert.Equal(t, httpserverClientTestUser{Id: 3, Name: "john 3 jr"}, user)
+}
+
+func TestHttpserverClient_Multipart(t *testing.T) {
+	client := newHttpserverClientTestServer(t)
+
+	response := client.R().
+		WithMultipartField("description", "a report").
+		WithMultipartFile("file", "report.csv", strings.NewReader("id,name\n")).
+		ExpectStatus(http.StatusOK).
+		Post("/upload")
+
+	assert.Equal(t, map[string]string{
+		"description": "a report",
+		"file":        "report.csv",
+		"content":     "id,name\n",
+	}, suite.DecodeHttpserverResponse[map[string]string](response))
+}
@@ -0,0 +1,240 @@
+package suite
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	SnapshotPlaceholderTimestamp = "<timestamp>"
+	SnapshotPlaceholderUuid      = "<uuid>"
+	envUpdateSnapshots           = "GOSOLINE_UPDATE_SNAPSHOTS"
+)
+
+var (
+	updateSnapshots   = flag.Bool("update-snapshots", false, "write the actual values of snapshot assertions to their golden files instead of comparing them")
+	snapshotTimestamp = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})?`)
+	snapshotUuid      = regexp.MustCompile(`(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+)
+
+// A SnapshotNormalizer replaces values which change between test runs, like timestamps or generated ids, before the
+// actual value is compared with or written to its golden file. It is called for every value of the decoded json
+// document with the dot separated path of the value (e.g. "items.0.createdAt") and returns the value to use instead.
+type SnapshotNormalizer func(path string, value any) any
+
+type snapshotSettings struct {
+	name        string
+	directory   string
+	normalizers []SnapshotNormalizer
+}
+
+type SnapshotOption func(settings *snapshotSettings)
+
+// WithSnapshotName sets the name of the golden file. It defaults to the name of the test.
+func WithSnapshotName(name string) SnapshotOption {
+	return func(settings *snapshotSettings) {
+		settings.name = name
+	}
+}
+
+// WithSnapshotDirectory sets the directory of the golden files. It defaults to testdata/snapshots.
+func WithSnapshotDirectory(directory string) SnapshotOption {
+	return func(settings *snapshotSettings) {
+		settings.directory = directory
+	}
+}
+
+func WithSnapshotNormalizers(normalizers ...SnapshotNormalizer) SnapshotOption {
+	return func(settings *snapshotSettings) {
+		settings.normalizers = append(settings.normalizers, normalizers...)
+	}
+}
+
+// NormalizeSnapshotFields replaces the values of the given fields with the replacement. A field matches either the
+// full path of a value or its last segment, so "id" matches the ids of all nested objects, too.
+func NormalizeSnapshotFields(replacement any, fields ...string) SnapshotNormalizer {
+	return func(path string, value any) any {
+		key := path[strings.LastIndex(path, ".")+1:]
+
+		if slices.Contains(fields, path) || slices.Contains(fields, key) {
+			return replacement
+		}
+
+		return value
+	}
+}
+
+// NormalizeSnapshotPattern replaces all matches of the pattern in string values with the replacement.
+func NormalizeSnapshotPattern(pattern *regexp.Regexp, replacement string) SnapshotNormalizer {
+	return func(_ string, value any) any {
+		if s, ok := value.(string); ok {
+			return pattern.ReplaceAllString(s, replacement)
+		}
+
+		return value
+	}
+}
+
+// NormalizeSnapshotTimestamps replaces RFC 3339 and sql formatted timestamps in string values with <timestamp>.
+func NormalizeSnapshotTimestamps() SnapshotNormalizer {
+	return NormalizeSnapshotPattern(snapshotTimestamp, SnapshotPlaceholderTimestamp)
+}
+
+// NormalizeSnapshotUuids replaces uuids in string values with <uuid>.
+func NormalizeSnapshotUuids() SnapshotNormalizer {
+	return NormalizeSnapshotPattern(snapshotUuid, SnapshotPlaceholderUuid)
+}
+
+// UpdateSnapshots reports whether snapshot assertions write their golden files instead of comparing them. Run the tests
+// with -update-snapshots or set GOSOLINE_UPDATE_SNAPSHOTS=true to update them.
+func UpdateSnapshots() bool {
+	if *updateSnapshots {
+		return true
+	}
+
+	update, _ := strconv.ParseBool(os.Getenv(envUpdateSnapshots))
+
+	return update
+}
+
+// SnapshotPath returns the golden file of a snapshot assertion with the given options in the test t.
+func SnapshotPath(t *testing.T, options ...SnapshotOption) string {
+	settings := newSnapshotSettings(t, options)
+
+	return filepath.Join(settings.directory, filepath.FromSlash(settings.name)+".json")
+}
+
+// AssertJsonSnapshot compares actual with the json document of its golden file after normalizing it. Actual values of
+// type []byte, string or json.RawMessage have to contain json, all other values are encoded as json first. If snapshots
+// are updated (see UpdateSnapshots), the normalized value is written to the golden file instead.
+func AssertJsonSnapshot(t *testing.T, actual any, options ...SnapshotOption) bool {
+	t.Helper()
+
+	var err error
+	var normalized []byte
+	var expected []byte
+
+	settings := newSnapshotSettings(t, options)
+	path := SnapshotPath(t, options...)
+
+	if normalized, err = normalizeSnapshot(actual, settings.normalizers); err != nil {
+		return assert.Fail(t, err.Error(), "can not normalize the actual value of snapshot %s", path)
+	}
+
+	if UpdateSnapshots() {
+		if err = writeSnapshot(path, normalized); err != nil {
+			return assert.Fail(t, err.Error(), "can not update snapshot %s", path)
+		}
+
+		return true
+	}
+
+	if expected, err = os.ReadFile(path); err != nil {
+		return assert.Fail(t, err.Error(), "can not read snapshot %s, run the test with -update-snapshots to create it", path)
+	}
+
+	return assert.JSONEq(t, string(expected), string(normalized), "the actual value doesn't match snapshot %s", path)
+}
+
+func newSnapshotSettings(t *testing.T, options []SnapshotOption) *snapshotSettings {
+	settings := &snapshotSettings{
+		name:      t.Name(),
+		directory: filepath.Join("testdata", "snapshots"),
+	}
+
+	for _, opt := range options {
+		opt(settings)
+	}
+
+	return settings
+}
+
+func normalizeSnapshot(actual any, normalizers []SnapshotNormalizer) ([]byte, error) {
+	var err error
+	var raw []byte
+	var document any
+
+	switch value := actual.(type) {
+	case []byte:
+		raw = value
+	case json.RawMessage:
+		raw = value
+	case string:
+		raw = []byte(value)
+	default:
+		if raw, err = json.Marshal(value); err != nil {
+			return nil, fmt.Errorf("can not marshal value of type %T: %w", actual, err)
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	if err = decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("can not decode json: %w", err)
+	}
+
+	document = normalizeSnapshotValue("", document, normalizers)
+
+	// golden files are meant to be read, so placeholders like <uuid> must not be escaped
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err = encoder.Encode(document); err != nil {
+		return nil, fmt.Errorf("can not marshal normalized value: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func normalizeSnapshotValue(path string, value any, normalizers []SnapshotNormalizer) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, elem := range v {
+			v[key] = normalizeSnapshotValue(joinSnapshotPath(path, key), elem, normalizers)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = normalizeSnapshotValue(joinSnapshotPath(path, strconv.Itoa(i)), elem, normalizers)
+		}
+	}
+
+	for _, normalizer := range normalizers {
+		value = normalizer(path, value)
+	}
+
+	return value
+}
+
+func joinSnapshotPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func writeSnapshot(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("can not create directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("can not write file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package suite_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/test/suite"
+	"github.com/stretchr/testify/assert"
+)
+
+type snapshotTestResponse struct {
+	Id        string            `json:"id"`
+	CreatedAt string            `json:"createdAt"`
+	Amount    float64           `json:"amount"`
+	Items     []snapshotTestRow `json:"items"`
+}
+
+type snapshotTestRow struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func TestSnapshotPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("testdata", "snapshots", "TestSnapshotPath.json"), suite.SnapshotPath(t))
+	assert.Equal(t, filepath.Join("dir", "custom", "name.json"), suite.SnapshotPath(t, suite.WithSnapshotDirectory("dir"), suite.WithSnapshotName("custom/name")))
+
+	t.Run("sub test", func(t *testing.T) {
+		assert.Equal(t, filepath.Join("testdata", "snapshots", "TestSnapshotPath", "sub_test.json"), suite.SnapshotPath(t))
+	})
+}
+
+func TestAssertJsonSnapshot_UpdateAndCompare(t *testing.T) {
+	directory := t.TempDir()
+	options := []suite.SnapshotOption{
+		suite.WithSnapshotDirectory(directory),
+		suite.WithSnapshotNormalizers(
+			suite.NormalizeSnapshotUuids(),
+			suite.NormalizeSnapshotTimestamps(),
+			suite.NormalizeSnapshotFields(0, "items.1.id"),
+		),
+	}
+
+	actual := snapshotTestResponse{
+		Id:        "0d0b7a41-7e3c-4b8a-9e51-0c8f6f4b5a7e",
+		CreatedAt: "2024-03-01T12:13:14.123Z",
+		Amount:    12.5,
+		Items: []snapshotTestRow{
+			{Id: 1, Name: "foo"},
+			{Id: 2, Name: "bar"},
+		},
+	}
+
+	t.Setenv("GOSOLINE_UPDATE_SNAPSHOTS", "true")
+	assert.True(t, suite.AssertJsonSnapshot(t, actual, options...))
+
+	content, err := os.ReadFile(suite.SnapshotPath(t, options...))
+	assert.NoError(t, err)
+	assert.Equal(t, `{
+  "amount": 12.5,
+  "createdAt": "<timestamp>",
+  "id": "<uuid>",
+  "items": [
+    {
+      "id": 1,
+      "name": "foo"
+    },
+    {
+      "id": 0,
+      "name": "bar"
+    }
+  ]
+}
+`, string(content))
+
+	t.Setenv("GOSOLINE_UPDATE_SNAPSHOTS", "false")
+
+	actual.Id = "9f3ab8f4-2a6e-4c1b-8d57-3e2f1a0b9c8d"
+	actual.CreatedAt = "2025-10-17 08:00:00"
+	actual.Items[1].Id = 3
+	assert.True(t, suite.AssertJsonSnapshot(t, actual, options...))
+
+	body := []byte(`{"id":"0D0B7A41-7E3C-4B8A-9E51-0C8F6F4B5A7E","createdAt":"2024-01-01T00:00:00+02:00","amount":12.5,"items":[{"id":1,"name":"foo"},{"id":42,"name":"bar"}]}`)
+	assert.True(t, suite.AssertJsonSnapshot(t, body, options...))
+}
+
+func TestNormalizeSnapshotFields(t *testing.T) {
+	normalizer := suite.NormalizeSnapshotFields("<id>", "id", "user.name")
+
+	assert.Equal(t, "<id>", normalizer("id", 1))
+	assert.Equal(t, "<id>", normalizer("items.3.id", 2))
+	assert.Equal(t, "<id>", normalizer("user.name", "foo"))
+	assert.Equal(t, "foo", normalizer("name", "foo"))
+	assert.Equal(t, 3, normalizer("userId", 3))
+}
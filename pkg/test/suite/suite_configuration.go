@@ -1,6 +1,7 @@
 package suite
 
 import (
+	"fmt"
 	"slices"
 
 	"github.com/justtrackio/gosoline/pkg/application"
@@ -16,6 +17,8 @@ type SuiteConfiguration struct {
 
 	fixtureSetFactories              []fixtures.FixtureSetsFactory
 	fixtureSetPostProcessorFactories []fixtures.PostProcessorFactory
+	namedFixtureSets                 map[string][]fixtures.FixtureSetsFactory
+	testCaseFixtureSets              map[string][]string
 
 	appOptions   []application.Option
 	appModules   map[string]kernel.ModuleFactory
@@ -29,6 +32,8 @@ func newSuiteConfiguration(options []Option) *SuiteConfiguration {
 	conf := &SuiteConfiguration{
 		envOptions:          make([]env.Option, 0),
 		envSetup:            make([]func() error, 0),
+		namedFixtureSets:    make(map[string][]fixtures.FixtureSetsFactory),
+		testCaseFixtureSets: make(map[string][]string),
 		appOptions:          make([]application.Option, 0),
 		appModules:          make(map[string]kernel.ModuleFactory),
 		appFactories:        make([]kernel.ModuleMultiFactory, 0),
@@ -53,3 +58,19 @@ func (s *SuiteConfiguration) addEnvOption(opt env.Option) {
 func (s *SuiteConfiguration) shouldSkip(name string) bool {
 	return len(s.testCaseWhitelist) > 0 && !slices.Contains(s.testCaseWhitelist, name)
 }
+
+// fixtureSetsOfTestCase returns the factories of the named fixture sets the test case loads on its own.
+func (s *SuiteConfiguration) fixtureSetsOfTestCase(name string) ([]fixtures.FixtureSetsFactory, error) {
+	factories := make([]fixtures.FixtureSetsFactory, 0)
+
+	for _, setName := range s.testCaseFixtureSets[name] {
+		set, ok := s.namedFixtureSets[setName]
+		if !ok {
+			return nil, fmt.Errorf("there is no fixture set named %q", setName)
+		}
+
+		factories = append(factories, set...)
+	}
+
+	return factories, nil
+}
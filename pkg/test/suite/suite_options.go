@@ -79,6 +79,12 @@ func WithContainerExpireAfter(expireAfter time.Duration) Option {
 	}
 }
 
+func WithUniqueResourceNames() Option {
+	return func(s *SuiteConfiguration) {
+		s.addEnvOption(env.WithUniqueResourceNames())
+	}
+}
+
 func WithUntypedConsumer(callback stream.UntypedConsumerCallbackFactory) Option {
 	return WithModule("consumer-default", stream.NewUntypedConsumer("default", callback))
 }
@@ -113,6 +119,23 @@ func WithFixtureSetFactories(factories []fixtures.FixtureSetsFactory, postProces
 	}
 }
 
+// WithNamedFixtureSet registers fixture sets under the given name. Unlike the fixture sets of WithFixtureSetFactory, they
+// are only loaded for the test cases which ask for them with WithTestCaseFixtureSets.
+func WithNamedFixtureSet(name string, factories ...fixtures.FixtureSetsFactory) Option {
+	return func(s *SuiteConfiguration) {
+		s.namedFixtureSets[name] = append(s.namedFixtureSets[name], factories...)
+	}
+}
+
+// WithTestCaseFixtureSets loads the named fixture sets for the test case. All resources are purged before, and the names
+// of the resources written by the sets are suffixed with the name of the test case (see fixtures.IsolatedName), so test
+// cases using the same containers don't overwrite each other's seed data.
+func WithTestCaseFixtureSets(testCase string, names ...string) Option {
+	return func(s *SuiteConfiguration) {
+		s.testCaseFixtureSets[testCase] = append(s.testCaseFixtureSets[testCase], names...)
+	}
+}
+
 func WithLogLevel(level string) Option {
 	return func(s *SuiteConfiguration) {
 		s.addEnvOption(env.WithLoggerLevel(level))
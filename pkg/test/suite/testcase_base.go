@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/iancoleman/strcase"
 	"github.com/justtrackio/gosoline/pkg/test/env"
 )
 
@@ -43,14 +44,48 @@ func buildTestCaseBase(_ TestingSuite, method reflect.Method) (TestCaseRunner, e
 			return
 		}
 
+		isolatedFixtureSets, err := suiteConf.fixtureSetsOfTestCase(method.Name)
+		if err != nil {
+			t.Fatalf("failed to find the fixture sets of the test case: %v", err)
+
+			return
+		}
+
+		if len(isolatedFixtureSets) > 0 {
+			if err := environment.PurgeResources(); err != nil {
+				t.Fatalf("failed to purge the resources of the test case: %v", err)
+
+				return
+			}
+		}
+
 		start := time.Now()
 		if err := environment.LoadFixtureSets(suiteConf.fixtureSetFactories, suiteConf.fixtureSetPostProcessorFactories...); err != nil {
 			t.Fatalf("failed to load fixtures from factories: %v", err)
 
 			return
 		}
+
+		if len(isolatedFixtureSets) > 0 {
+			// the fixture sets of the suite are shared by all test cases, only the ones of the test case are isolated
+			environment.SetIsolationSuffix(isolationSuffix(method.Name))
+			defer environment.SetIsolationSuffix("")
+
+			if err := environment.LoadFixtureSets(isolatedFixtureSets); err != nil {
+				t.Fatalf("failed to load the fixture sets of the test case: %v", err)
+
+				return
+			}
+		}
+
 		environment.Logger().WithChannel("fixtures").Debug(environment.Context(), "loaded fixtures in %s", time.Since(start))
 
 		method.Func.Call([]reflect.Value{reflect.ValueOf(suite)})
 	}, nil
 }
+
+// isolationSuffix turns the name of a test case into a suffix usable in resource names, e.g. TestCreateUser becomes
+// test-create-user.
+func isolationSuffix(testCase string) string {
+	return strcase.ToKebab(testCase)
+}
@@ -1,8 +1,12 @@
 package suite_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/test/suite"
 	"github.com/stretchr/testify/assert"
 )
@@ -28,3 +32,46 @@ func (s *BaseTestSuite) SetupSuite() []suite.Option {
 func (s *BaseTestSuite) TestBase() {
 	s.called = true
 }
+
+type NamedFixtureSetTestSuite struct {
+	suite.Suite
+	loaded map[string][]string
+}
+
+func TestNamedFixtureSetTestSuite(t *testing.T) {
+	s := &NamedFixtureSetTestSuite{
+		loaded: map[string][]string{},
+	}
+	suite.Run(t, s)
+
+	assert.Equal(t, map[string][]string{
+		"suite": {"", ""},
+		"users": {"test-with-users"},
+	}, s.loaded)
+}
+
+func (s *NamedFixtureSetTestSuite) SetupSuite() []suite.Option {
+	return []suite.Option{
+		suite.WithLogLevel("info"),
+		suite.WithSharedEnvironment(),
+		suite.WithFixtureSetFactory(s.recordingFixtureSetFactory("suite")),
+		suite.WithNamedFixtureSet("users", s.recordingFixtureSetFactory("users")),
+		suite.WithTestCaseFixtureSets("TestWithUsers", "users"),
+	}
+}
+
+func (s *NamedFixtureSetTestSuite) TestWithUsers() {
+	s.Equal("users-test-with-users", fixtures.IsolatedName(s.Env().Context(), "users"))
+}
+
+func (s *NamedFixtureSetTestSuite) TestWithoutUsers() {
+	s.Equal("users", fixtures.IsolatedName(s.Env().Context(), "users"))
+}
+
+func (s *NamedFixtureSetTestSuite) recordingFixtureSetFactory(name string) fixtures.FixtureSetsFactory {
+	return func(ctx context.Context, _ cfg.Config, _ log.Logger, _ string) ([]fixtures.FixtureSet, error) {
+		s.loaded[name] = append(s.loaded[name], fixtures.IsolationSuffix(ctx))
+
+		return nil, nil
+	}
+}
@@ -0,0 +1,163 @@
+package suite
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/application"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/grpcserver"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/test/env"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func init() {
+	RegisterTestCaseDefinition("grpcserver", isTestCaseGrpcserver, buildTestCaseGrpcserver)
+}
+
+const expectedTestCaseGrpcserverSignature = "func (s TestingSuite) TestFunc(AppUnderTest, *grpc.ClientConn) error"
+
+type TestingSuiteGrpcDefinitionsAware interface {
+	SetupGrpcDefinitions() grpcserver.ServiceDefiner
+}
+
+// TestingSuiteGrpcMiddlewaresAware can be implemented by suites with grpcserver test cases to run the server with the
+// same middlewares as the application.
+type TestingSuiteGrpcMiddlewaresAware interface {
+	SetupGrpcMiddlewares() []grpcserver.MiddlewareFactory
+}
+
+func isTestCaseGrpcserver(s TestingSuite, method reflect.Method) error {
+	if _, ok := s.(TestingSuiteGrpcDefinitionsAware); !ok {
+		return fmt.Errorf("the suite has to implement the TestingSuiteGrpcDefinitionsAware interface to be able to run grpcserver test cases")
+	}
+
+	if method.Func.Type().NumIn() != 3 {
+		return fmt.Errorf("expected %q, but function has %d arguments", expectedTestCaseGrpcserverSignature, method.Func.Type().NumIn())
+	}
+
+	if method.Func.Type().NumOut() != 1 {
+		return fmt.Errorf("expected %q, but function has %d return values", expectedTestCaseGrpcserverSignature, method.Func.Type().NumOut())
+	}
+
+	actualType0 := method.Func.Type().In(0)
+	expectedType0 := reflect.TypeOf((*TestingSuite)(nil)).Elem()
+
+	if !actualType0.Implements(expectedType0) {
+		return fmt.Errorf("expected %q, but first argument type/receiver type is %s", expectedTestCaseGrpcserverSignature, actualType0.String())
+	}
+
+	actualType1 := method.Func.Type().In(1)
+	expectedType1 := reflect.TypeOf((*AppUnderTest)(nil)).Elem()
+
+	if actualType1 != expectedType1 {
+		return fmt.Errorf("expected %q, but first argument type is %s", expectedTestCaseGrpcserverSignature, actualType1.String())
+	}
+
+	actualType2 := method.Func.Type().In(2)
+	expectedType2 := reflect.TypeOf((*grpc.ClientConn)(nil))
+
+	if actualType2 != expectedType2 {
+		return fmt.Errorf("expected %q, but last argument type is %s", expectedTestCaseGrpcserverSignature, actualType2.String())
+	}
+
+	actualTypeResult := method.Func.Type().Out(0)
+	expectedTypeResult := reflect.TypeOf((*error)(nil)).Elem()
+
+	if actualTypeResult != expectedTypeResult {
+		return fmt.Errorf("expected %q, but return type is %s", expectedTestCaseGrpcserverSignature, actualTypeResult.String())
+	}
+
+	return nil
+}
+
+func buildTestCaseGrpcserver(suite TestingSuite, method reflect.Method) (TestCaseRunner, error) {
+	return runTestCaseGrpcserver(suite, func(suite TestingSuite, app AppUnderTest, conn *grpc.ClientConn) {
+		out := method.Func.Call([]reflect.Value{
+			reflect.ValueOf(suite),
+			reflect.ValueOf(app),
+			reflect.ValueOf(conn),
+		})
+
+		result := out[0].Interface()
+
+		if result == nil {
+			return
+		}
+
+		if err := result.(error); err != nil {
+			assert.FailNow(suite.T(), err.Error(), "testcase %s returned an unexpected error: %s", method.Name, err)
+		}
+	})
+}
+
+func runTestCaseGrpcserver(suite TestingSuite, testCase func(suite TestingSuite, app AppUnderTest, conn *grpc.ClientConn)) (TestCaseRunner, error) {
+	var ok bool
+	var grpcDefinitionAware TestingSuiteGrpcDefinitionsAware
+	var server *grpcserver.Server
+
+	if grpcDefinitionAware, ok = suite.(TestingSuiteGrpcDefinitionsAware); !ok {
+		return nil, fmt.Errorf("the suite has to implement the TestingSuiteGrpcDefinitionsAware interface to be able to run grpcserver test cases")
+	}
+
+	return func(t *testing.T, suite TestingSuite, suiteConf *SuiteConfiguration, environment *env.Environment) {
+		// we first have to set up t, otherwise the test suite can't assert that there are no errors when setting up
+		// service definitions or test cases
+		suite.SetT(t)
+
+		definer := grpcDefinitionAware.SetupGrpcDefinitions()
+
+		var middlewares []grpcserver.MiddlewareFactory
+		if middlewaresAware, ok := suite.(TestingSuiteGrpcMiddlewaresAware); ok {
+			middlewares = middlewaresAware.SetupGrpcMiddlewares()
+		}
+
+		suiteConf.appModules["grpc"] = func(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+			module, err := grpcserver.New("default", definer, middlewares...)(ctx, config, logger)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create test grpc server: %w", err)
+			}
+
+			server = module.(*grpcserver.Server)
+
+			return server, nil
+		}
+
+		suiteConf.addAppOption(application.WithConfigMap(map[string]any{
+			"grpc_server": map[string]any{
+				"default": map[string]any{
+					"port": "0",
+				},
+			},
+		}))
+
+		RunTestCaseApplication(t, suite, suiteConf, environment, func(app AppUnderTest) {
+			_, port, err := net.SplitHostPort(server.Addr().String())
+			if err != nil {
+				assert.FailNow(t, err.Error(), "can not get port of server")
+
+				return
+			}
+
+			conn, err := grpc.NewClient(net.JoinHostPort("127.0.0.1", port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				assert.FailNow(t, err.Error(), "can not create client connection to server")
+
+				return
+			}
+
+			defer func() {
+				assert.NoError(t, conn.Close(), "can not close client connection to server")
+			}()
+
+			testCase(suite, app, conn)
+		})
+	}, nil
+}
@@ -0,0 +1,105 @@
+package suite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/grpcserver"
+	protobuf "github.com/justtrackio/gosoline/pkg/grpcserver/proto/helloworld/v1"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/test/env"
+	"github.com/justtrackio/gosoline/pkg/test/suite"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type greeterServer struct {
+	protobuf.UnimplementedGreeterServiceServer
+	clock clock.Clock
+}
+
+func (g *greeterServer) SayHello(_ context.Context, req *protobuf.HelloRequest) (*protobuf.HelloReply, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty name is not allowed")
+	}
+
+	return &protobuf.HelloReply{
+		Message: fmt.Sprintf("Hello %s, it is %s", req.GetName(), g.clock.Now().Format(time.DateOnly)),
+	}, nil
+}
+
+type GrpcserverTestSuite struct {
+	suite.Suite
+	calls int
+}
+
+func TestGrpcserverTestSuite(t *testing.T) {
+	var s GrpcserverTestSuite
+	suite.Run(t, &s)
+	assert.Equal(t, 2, s.calls)
+}
+
+func (s *GrpcserverTestSuite) SetupSuite() []suite.Option {
+	return []suite.Option{
+		suite.WithLogLevel("info"),
+		suite.WithClockProviderAt("2024-03-01T10:00:00Z"),
+		suite.WithoutAutoDetectedComponents(env.ComponentLocalstack),
+	}
+}
+
+func (s *GrpcserverTestSuite) SetupGrpcDefinitions() grpcserver.ServiceDefiner {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (*grpcserver.Definitions, error) {
+		definitions := &grpcserver.Definitions{}
+		definitions.Add("greeter", func(server *grpc.Server) error {
+			protobuf.RegisterGreeterServiceServer(server, &greeterServer{
+				clock: clock.Provider,
+			})
+
+			return nil
+		})
+
+		return definitions, nil
+	}
+}
+
+func (s *GrpcserverTestSuite) SetupGrpcMiddlewares() []grpcserver.MiddlewareFactory {
+	return []grpcserver.MiddlewareFactory{
+		func(logger log.Logger) grpcserver.Middleware {
+			return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				s.calls++
+
+				return handler(ctx, req)
+			}
+		},
+	}
+}
+
+func (s *GrpcserverTestSuite) TestSayHello(app suite.AppUnderTest, conn *grpc.ClientConn) error {
+	defer app.WaitDone()
+	defer app.Stop()
+
+	reply, err := protobuf.NewGreeterServiceClient(conn).SayHello(s.T().Context(), &protobuf.HelloRequest{Name: "gosoline"})
+	if err != nil {
+		return err
+	}
+
+	s.Equal("Hello gosoline, it is 2024-03-01", reply.GetMessage())
+
+	return nil
+}
+
+func (s *GrpcserverTestSuite) TestSayHelloInvalid(app suite.AppUnderTest, conn *grpc.ClientConn) error {
+	defer app.WaitDone()
+	defer app.Stop()
+
+	_, err := protobuf.NewGreeterServiceClient(conn).SayHello(s.T().Context(), &protobuf.HelloRequest{})
+	s.Equal(codes.InvalidArgument, status.Code(err))
+
+	return nil
+}
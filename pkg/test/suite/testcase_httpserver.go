@@ -20,7 +20,7 @@ func init() {
 	RegisterTestCaseDefinition("httpserver", isTestCaseHttpserver, buildTestCaseHttpserver)
 }
 
-const expectedTestCaseHttpserverSignature = "func (s TestingSuite) TestFunc(AppUnderTest, *resty.Client) error"
+const expectedTestCaseHttpserverSignature = "func (s TestingSuite) TestFunc(AppUnderTest, *resty.Client | *HttpserverClient) error"
 
 type TestingSuiteApiDefinitionsAware interface {
 	SetupApiDefinitions() httpserver.Definer
@@ -55,8 +55,9 @@ func isTestCaseHttpserver(s TestingSuite, method reflect.Method) error {
 
 	actualType2 := method.Func.Type().In(2)
 	expectedType2 := reflect.TypeOf((*resty.Client)(nil))
+	expectedClientType2 := reflect.TypeOf((*HttpserverClient)(nil))
 
-	if actualType2 != expectedType2 {
+	if actualType2 != expectedType2 && actualType2 != expectedClientType2 {
 		return fmt.Errorf("expected %q, but last argument type is %s", expectedTestCaseHttpserverSignature, actualType2.String())
 	}
 
@@ -72,10 +73,16 @@ func isTestCaseHttpserver(s TestingSuite, method reflect.Method) error {
 
 func buildTestCaseHttpserver(suite TestingSuite, method reflect.Method) (TestCaseRunner, error) {
 	return runTestCaseHttpserver(suite, func(suite TestingSuite, app AppUnderTest, client *resty.Client) {
+		clientValue := reflect.ValueOf(client)
+
+		if method.Func.Type().In(2) == reflect.TypeOf((*HttpserverClient)(nil)) {
+			clientValue = reflect.ValueOf(NewHttpserverClient(suite.T(), client))
+		}
+
 		out := method.Func.Call([]reflect.Value{
 			reflect.ValueOf(suite),
 			reflect.ValueOf(app),
-			reflect.ValueOf(client),
+			clientValue,
 		})
 
 		result := out[0].Interface()
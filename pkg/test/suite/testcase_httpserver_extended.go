@@ -64,6 +64,12 @@ type HttpserverTestCase struct {
 	// AssertResultFile can be used to read the expected response body from a file, which will be used to check equality
 	// with the actual response body. If the file name extension is .json, the equality check is done via assert.JSONEq.
 	AssertResultFile string
+	// AssertResultSnapshot compares the json response body with a golden file below testdata/snapshots, named after the
+	// test and, if the test returns more than one test case, the index of the test case. Use -update-snapshots to write
+	// the golden files.
+	AssertResultSnapshot bool
+	// SnapshotOptions configure the name and normalizers of the result snapshot.
+	SnapshotOptions []SnapshotOption
 }
 
 // A ToHttpserverTestCaseList can be converted into a list of test cases. You can use this interface instead of concrete
@@ -385,5 +391,15 @@ func verifyHttpServerExtendedResponses(suite TestingSuite, testCases []*Httpserv
 				assert.Equal(suite.T(), bytes, actual, "response doesn't match")
 			}
 		}
+
+		if tc.AssertResultSnapshot {
+			options := tc.SnapshotOptions
+
+			if len(testCases) > 1 {
+				options = append([]SnapshotOption{WithSnapshotName(fmt.Sprintf("%s/%d", suite.T().Name(), i))}, options...)
+			}
+
+			AssertJsonSnapshot(suite.T(), responses[i].Body(), options...)
+		}
 	}
 }
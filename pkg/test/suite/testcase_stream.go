@@ -31,7 +31,17 @@ type StreamTestCaseOutput struct {
 type StreamTestCase struct {
 	Input  map[string][]StreamTestCaseInput
 	Output map[string][]StreamTestCaseOutput
-	Assert func() error
+	// OutputSnapshot compares the attributes and json bodies of all messages written to the given outputs with a golden
+	// file below testdata/snapshots, named after the test. Use -update-snapshots to write the golden file.
+	OutputSnapshot []string
+	// SnapshotOptions configure the name and normalizers of the output snapshot.
+	SnapshotOptions []SnapshotOption
+	Assert          func() error
+}
+
+type streamTestCaseSnapshotMessage struct {
+	Attributes map[string]string `json:"attributes"`
+	Body       any               `json:"body"`
 }
 
 type ToStreamTestCase interface {
@@ -205,7 +215,28 @@ func assertStreamTestOutputs(tc *StreamTestCase, suite TestingSuite, t *testing.
 		}
 	}
 
+	if len(tc.OutputSnapshot) > 0 {
+		assertStreamTestOutputSnapshot(tc, suite, t)
+	}
+
 	if tc.Assert != nil {
 		assert.NoError(t, tc.Assert(), "there should be no error happening on assert")
 	}
 }
+
+func assertStreamTestOutputSnapshot(tc *StreamTestCase, suite TestingSuite, t *testing.T) {
+	outputs := make(map[string][]streamTestCaseSnapshotMessage, len(tc.OutputSnapshot))
+
+	for _, outputName := range tc.OutputSnapshot {
+		output := suite.Env().StreamOutput(outputName)
+		messages := make([]streamTestCaseSnapshotMessage, output.Len())
+
+		for i := range messages {
+			messages[i].Attributes = output.Unmarshal(i, &messages[i].Body)
+		}
+
+		outputs[outputName] = messages
+	}
+
+	AssertJsonSnapshot(t, outputs, tc.SnapshotOptions...)
+}
@@ -0,0 +1,212 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+const (
+	// BaggageHeader is the W3C header propagating baggage between http services.
+	BaggageHeader = "baggage"
+	// AttributeBaggage is the message attribute propagating baggage between stream producers and consumers.
+	AttributeBaggage = "baggage"
+)
+
+// ContextWithBaggage adds the values to the baggage of the context, e.g. the tenant of a request, so they are
+// propagated to all http calls and messages downstream. Existing values with the same key are replaced.
+func ContextWithBaggage(ctx context.Context, values map[string]string) (context.Context, error) {
+	bag := baggage.FromContext(ctx)
+
+	for key, value := range values {
+		if !isBaggageKey(key) {
+			return ctx, fmt.Errorf("invalid baggage member %s: the key has to be a token as defined by RFC 7230", key)
+		}
+
+		member, err := baggage.NewMemberRaw(key, value)
+		if err != nil {
+			return ctx, fmt.Errorf("invalid baggage member %s: %w", key, err)
+		}
+
+		if bag, err = bag.SetMember(member); err != nil {
+			return ctx, fmt.Errorf("can not add baggage member %s: %w", key, err)
+		}
+	}
+
+	return baggage.ContextWithBaggage(ctx, bag), nil
+}
+
+// ContextWithBaggageValue adds a single value to the baggage of the context, see ContextWithBaggage.
+func ContextWithBaggageValue(ctx context.Context, key string, value string) (context.Context, error) {
+	return ContextWithBaggage(ctx, map[string]string{key: value})
+}
+
+// GetBaggage returns all baggage values of the context.
+func GetBaggage(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return map[string]string{}
+	}
+
+	members := baggage.FromContext(ctx).Members()
+	values := make(map[string]string, len(members))
+
+	for _, member := range members {
+		values[member.Key()] = member.Value()
+	}
+
+	return values
+}
+
+// GetBaggageValue returns the baggage value with the given key and whether it is present.
+func GetBaggageValue(ctx context.Context, key string) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	member := baggage.FromContext(ctx).Member(key)
+
+	return member.Value(), member.Key() != ""
+}
+
+// contextWithEncodedBaggage merges the baggage encoded in the W3C format into the baggage of the context. Values
+// already present in the context take precedence. If keys are given, only members with one of them are merged.
+func contextWithEncodedBaggage(ctx context.Context, encoded string, keys []string) (context.Context, error) {
+	received, err := baggage.Parse(encoded)
+	if err != nil {
+		return ctx, fmt.Errorf("can not parse baggage: %w", err)
+	}
+
+	if keys != nil {
+		for _, member := range received.Members() {
+			if !slices.Contains(keys, member.Key()) {
+				received = received.DeleteMember(member.Key())
+			}
+		}
+	}
+
+	for _, member := range baggage.FromContext(ctx).Members() {
+		if received, err = received.SetMember(member); err != nil {
+			return ctx, fmt.Errorf("can not merge baggage member %s: %w", member.Key(), err)
+		}
+	}
+
+	return baggage.ContextWithBaggage(ctx, received), nil
+}
+
+// BaggageHttpHandler adds the baggage of the request header to the request context. The header is sent by the caller
+// and thus not trusted: only members with one of the given keys are accepted and all others are dropped, so without keys
+// the header is ignored. Invalid baggage is ignored, as it should not fail the request.
+func BaggageHttpHandler(h http.Handler, keys []string) http.Handler {
+	if len(keys) == 0 {
+		return h
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if encoded := r.Header.Get(BaggageHeader); encoded != "" {
+			if ctx, err := contextWithEncodedBaggage(r.Context(), encoded, keys); err == nil {
+				r = r.WithContext(ctx)
+			}
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
+type baggageTransport struct {
+	base  http.RoundTripper
+	hosts []string
+}
+
+// BaggageHttpTransport sets the baggage header of outgoing requests from the baggage of the request context. The
+// baggage is only sent to the given hosts, as it would otherwise leak e.g. the tenant of a request to third parties.
+func BaggageHttpTransport(base http.RoundTripper, hosts []string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &baggageTransport{
+		base:  base,
+		hosts: hosts,
+	}
+}
+
+func (t *baggageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	bag := baggage.FromContext(req.Context())
+
+	if bag.Len() == 0 || req.Header.Get(BaggageHeader) != "" || !slices.Contains(t.hosts, req.URL.Hostname()) {
+		return t.base.RoundTrip(req)
+	}
+
+	// a round tripper must not modify the request it was given
+	req = req.Clone(req.Context())
+	req.Header.Set(BaggageHeader, bag.String())
+
+	return t.base.RoundTrip(req)
+}
+
+// MessageWithBaggageEncoder propagates the baggage of the context via the baggage attribute of stream messages.
+type MessageWithBaggageEncoder struct{}
+
+func NewMessageWithBaggageEncoder() *MessageWithBaggageEncoder {
+	return &MessageWithBaggageEncoder{}
+}
+
+func (m MessageWithBaggageEncoder) Encode(ctx context.Context, _ any, attributes map[string]string) (context.Context, map[string]string, error) {
+	if bag := baggage.FromContext(ctx); bag.Len() > 0 {
+		attributes[AttributeBaggage] = bag.String()
+	}
+
+	return ctx, attributes, nil
+}
+
+// Decode adds the baggage of the message to the context. In contrast to http requests, all members are accepted, as
+// messages are only produced by services with access to the queues and topics. Invalid baggage is dropped instead of
+// failing the message, like for http requests.
+func (m MessageWithBaggageEncoder) Decode(ctx context.Context, _ any, attributes map[string]string) (context.Context, map[string]string, error) {
+	encoded, ok := attributes[AttributeBaggage]
+	if !ok {
+		return ctx, attributes, nil
+	}
+
+	if decodedCtx, err := contextWithEncodedBaggage(ctx, encoded, nil); err == nil {
+		ctx = decodedCtx
+	}
+
+	delete(attributes, AttributeBaggage)
+
+	return ctx, attributes, nil
+}
+
+// ContextBaggageFieldsResolver adds the baggage of the context as log fields, see log.WithContextFieldsResolver.
+func ContextBaggageFieldsResolver(ctx context.Context) map[string]any {
+	values := GetBaggage(ctx)
+	if len(values) == 0 {
+		return map[string]any{}
+	}
+
+	return map[string]any{
+		"baggage": values,
+	}
+}
+
+// isBaggageKey checks that the key is a token as required by the W3C baggage header.
+func isBaggageKey(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for _, c := range key {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", c):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
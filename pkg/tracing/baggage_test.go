@@ -0,0 +1,147 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextWithBaggage(t *testing.T) {
+	ctx, err := tracing.ContextWithBaggage(t.Context(), map[string]string{"tenant_id": "justtrack", "region": "eu"})
+	require.NoError(t, err)
+
+	ctx, err = tracing.ContextWithBaggageValue(ctx, "region", "us west")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"tenant_id": "justtrack", "region": "us west"}, tracing.GetBaggage(ctx))
+
+	value, ok := tracing.GetBaggageValue(ctx, "tenant_id")
+	assert.True(t, ok)
+	assert.Equal(t, "justtrack", value)
+
+	_, ok = tracing.GetBaggageValue(ctx, "missing")
+	assert.False(t, ok)
+
+	_, err = tracing.ContextWithBaggageValue(ctx, "invalid key", "value")
+	assert.Error(t, err)
+}
+
+func TestBaggageHttp(t *testing.T) {
+	var received map[string]string
+
+	server := httptest.NewServer(tracing.BaggageHttpHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = tracing.GetBaggage(r.Context())
+	}), []string{"tenant_id", "note"}))
+	defer server.Close()
+
+	serverUrl, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := &http.Client{Transport: tracing.BaggageHttpTransport(nil, []string{serverUrl.Hostname()})}
+
+	ctx, err := tracing.ContextWithBaggage(t.Context(), map[string]string{"tenant_id": "justtrack", "note": "a;b,c", "role": "admin"})
+	require.NoError(t, err)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, map[string]string{"tenant_id": "justtrack", "note": "a;b,c"}, received, "only the accepted keys should be read")
+	assert.Empty(t, request.Header.Get(tracing.BaggageHeader), "the request of the caller should not be modified")
+
+	request, err = http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	request.Header.Set(tracing.BaggageHeader, "invalid baggage;;")
+
+	response, err = client.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode, "invalid baggage should not fail the request")
+	assert.Empty(t, received)
+}
+
+func TestBaggageHttp_Restricted(t *testing.T) {
+	var received map[string]string
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = tracing.GetBaggage(r.Context())
+	})
+
+	ignoring := httptest.NewServer(tracing.BaggageHttpHandler(handler, nil))
+	defer ignoring.Close()
+
+	request, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ignoring.URL, nil)
+	require.NoError(t, err)
+	request.Header.Set(tracing.BaggageHeader, "tenant_id=justtrack")
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Empty(t, received, "the header should be ignored without accepted keys")
+
+	accepting := httptest.NewServer(tracing.BaggageHttpHandler(handler, []string{"tenant_id"}))
+	defer accepting.Close()
+
+	client := &http.Client{Transport: tracing.BaggageHttpTransport(nil, []string{"internal.example.com"})}
+
+	ctx, err := tracing.ContextWithBaggageValue(t.Context(), "tenant_id", "justtrack")
+	require.NoError(t, err)
+
+	request, err = http.NewRequestWithContext(ctx, http.MethodGet, accepting.URL, nil)
+	require.NoError(t, err)
+
+	response, err = client.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	assert.Empty(t, received, "the baggage should not be sent to other hosts")
+}
+
+func TestMessageWithBaggageEncoder(t *testing.T) {
+	encoder := tracing.NewMessageWithBaggageEncoder()
+
+	ctx, err := tracing.ContextWithBaggageValue(t.Context(), "tenant_id", "justtrack")
+	require.NoError(t, err)
+
+	_, attributes, err := encoder.Encode(ctx, nil, map[string]string{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{tracing.AttributeBaggage: "tenant_id=justtrack"}, attributes)
+
+	// values already present in the context of the consumer take precedence
+	consumerCtx, err := tracing.ContextWithBaggageValue(t.Context(), "region", "eu")
+	require.NoError(t, err)
+
+	decodedCtx, attributes, err := encoder.Decode(consumerCtx, nil, attributes)
+	require.NoError(t, err)
+	assert.Empty(t, attributes)
+	assert.Equal(t, map[string]string{"tenant_id": "justtrack", "region": "eu"}, tracing.GetBaggage(decodedCtx))
+
+	decodedCtx, attributes, err = encoder.Decode(context.Background(), nil, map[string]string{tracing.AttributeBaggage: "invalid;;"})
+	require.NoError(t, err)
+	assert.Empty(t, attributes)
+	assert.Empty(t, tracing.GetBaggage(decodedCtx))
+
+	_, attributes, err = encoder.Encode(t.Context(), nil, map[string]string{})
+	require.NoError(t, err)
+	assert.Empty(t, attributes)
+}
+
+func TestContextBaggageFieldsResolver(t *testing.T) {
+	assert.Empty(t, tracing.ContextBaggageFieldsResolver(t.Context()))
+
+	ctx, err := tracing.ContextWithBaggageValue(t.Context(), "tenant_id", "justtrack")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"baggage": map[string]string{"tenant_id": "justtrack"}}, tracing.ContextBaggageFieldsResolver(ctx))
+}
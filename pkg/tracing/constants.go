@@ -1,8 +1,9 @@
 package tracing
 
 const (
-	ProviderNoop  = "noop"
-	ProviderLocal = "local"
-	ProviderXray  = "xray"
-	ProviderOtel  = "otel"
+	ProviderNoop    = "noop"
+	ProviderLocal   = "local"
+	ProviderXray    = "xray"
+	ProviderOtel    = "otel"
+	ProviderConsole = "console"
 )
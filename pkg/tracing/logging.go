@@ -7,24 +7,41 @@ import (
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
+const (
+	LogFieldTraceId = "trace_id"
+	LogFieldSpanId  = "span_id"
+)
+
+// ContextTraceFieldsResolver adds the id of the trace found in the context and, if the context carries an active span,
+// the id of the span as log fields, so log records can be correlated with their traces. See log.WithContextFieldsResolver.
 func ContextTraceFieldsResolver(ctx context.Context) map[string]any {
 	var trace *Trace
+	var spanId string
 
 	if span := GetSpanFromContext(ctx); span != nil {
-		trace = span.GetTrace()
+		if trace = span.GetTrace(); trace != nil {
+			spanId = trace.GetId()
+		}
 	}
 
-	if trace == nil {
+	if trace == nil || trace.GetTraceId() == "" {
 		trace = GetTraceFromContext(ctx)
+		spanId = ""
 	}
 
-	if trace != nil {
-		return map[string]any{
-			"trace_id": trace.GetTraceId(),
-		}
+	if trace == nil {
+		return map[string]any{}
+	}
+
+	fields := map[string]any{
+		LogFieldTraceId: trace.GetTraceId(),
+	}
+
+	if spanId != "" {
+		fields[LogFieldSpanId] = spanId
 	}
 
-	return map[string]any{}
+	return fields
 }
 
 type LoggerErrorHandler struct{}
@@ -37,8 +37,24 @@ func (s *LoggingSuite) TestContextTraceFieldsResolver_FromSpan() {
 
 	fields := tracing.ContextTraceFieldsResolver(s.ctx)
 
-	s.Contains(fields, "trace_id")
-	s.Equal("1-5e3d5273-7f0bd984ad68e2d290caeb84", fields["trace_id"])
+	s.Equal(map[string]any{
+		"trace_id": "1-5e3d5273-7f0bd984ad68e2d290caeb84",
+		"span_id":  "b1e67e41debe0b65",
+	}, fields)
+}
+
+func (s *LoggingSuite) TestContextTraceFieldsResolver_FromContextTrace_DisabledSpan() {
+	trace := &tracing.Trace{
+		TraceId: "goso:83268bc4-dc7a-4674-8e71-d8b6e0e01543",
+	}
+	s.span.EXPECT().GetTrace().Return(&tracing.Trace{}).Once()
+	ctx := tracing.ContextWithTrace(s.ctx, trace)
+
+	fields := tracing.ContextTraceFieldsResolver(ctx)
+
+	s.Equal(map[string]any{
+		"trace_id": "goso:83268bc4-dc7a-4674-8e71-d8b6e0e01543",
+	}, fields)
 }
 
 func (s *LoggingSuite) TestContextTraceFieldsResolver_FromContextTrace_EmptySpan() {
@@ -17,7 +17,7 @@ func NewMessageWithTraceEncoder(strategy TraceIdErrorStrategy) *MessageWithTrace
 
 func (m MessageWithTraceEncoder) Encode(ctx context.Context, _ any, attributes map[string]string) (context.Context, map[string]string, error) {
 	if traceId := GetTraceIdFromContext(ctx); traceId != nil {
-		attributes["traceId"] = *traceId
+		attributes[AttributeTraceId] = *traceId
 	}
 
 	return ctx, attributes, nil
@@ -26,11 +26,11 @@ func (m MessageWithTraceEncoder) Encode(ctx context.Context, _ any, attributes m
 func (m MessageWithTraceEncoder) Decode(ctx context.Context, _ any, attributes map[string]string) (context.Context, map[string]string, error) {
 	var ok bool
 
-	if _, ok = attributes["traceId"]; !ok {
+	if _, ok = attributes[AttributeTraceId]; !ok {
 		return ctx, attributes, nil
 	}
 
-	trace, err := StringToTrace(attributes["traceId"])
+	trace, err := StringToTrace(attributes[AttributeTraceId])
 	if err != nil {
 		err := fmt.Errorf("the traceId attribute is invalid: %w", err)
 		err = m.strategy.TraceIdInvalid(ctx, err)
@@ -39,7 +39,7 @@ func (m MessageWithTraceEncoder) Decode(ctx context.Context, _ any, attributes m
 	}
 
 	ctx = ContextWithTrace(ctx, trace)
-	delete(attributes, "traceId")
+	delete(attributes, AttributeTraceId)
 
 	return ctx, attributes, nil
 }
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+)
+
+const (
+	// AttributeTraceId is the message attribute carrying the trace of the span which produced a message.
+	AttributeTraceId = "traceId"
+	// AnnotationLinkedTraceId is added to spans linked to another trace by tracers without native span links.
+	AnnotationLinkedTraceId = "linked_trace_id"
+)
+
+// messagingTracer is implemented by tracers supporting span kinds and span links natively.
+type messagingTracer interface {
+	startProducerSpan(ctx context.Context, name string) (context.Context, Span)
+	startConsumerSpan(ctx context.Context, name string, producer *Trace) (context.Context, Span)
+	startLinkedSubSpan(ctx context.Context, name string, links []*Trace) (context.Context, Span)
+}
+
+// GetMessageTrace returns the trace of the span which produced a message from its attributes or nil if the message
+// doesn't carry a valid one.
+func GetMessageTrace(attributes map[string]string) *Trace {
+	traceId, ok := attributes[AttributeTraceId]
+	if !ok {
+		return nil
+	}
+
+	trace, err := StringToTrace(traceId)
+	if err != nil {
+		return nil
+	}
+
+	return trace
+}
+
+// StartProducerSpan starts a sub span of the span in the context for publishing messages. Messages encoded with the
+// returned context reference the producer span, so their consumers are connected to it.
+func StartProducerSpan(ctx context.Context, tracer Tracer, name string) (context.Context, Span) {
+	if messaging, ok := tracer.(messagingTracer); ok {
+		return messaging.startProducerSpan(ctx, name)
+	}
+
+	return tracer.StartSubSpan(ctx, name)
+}
+
+// StartConsumerSpan starts the span processing a message as child of the span which produced it, see GetMessageTrace.
+// Tracers supporting span links additionally link the consumer span to the producer span. Without a producer trace,
+// a new trace is started.
+func StartConsumerSpan(ctx context.Context, tracer Tracer, name string, producer *Trace) (context.Context, Span) {
+	if messaging, ok := tracer.(messagingTracer); ok {
+		return messaging.startConsumerSpan(ctx, name, producer)
+	}
+
+	if producer != nil {
+		ctx = ContextWithTrace(ctx, producer)
+	}
+
+	return tracer.StartSpanFromContext(ctx, name)
+}
+
+// StartLinkedSubSpan starts a sub span of the span in the context linked to the spans of other traces, e.g. the span
+// processing a single message of a batch linked to the span which produced the message. Tracers without native span
+// links annotate the span with the id of the first linked trace instead.
+func StartLinkedSubSpan(ctx context.Context, tracer Tracer, name string, links ...*Trace) (context.Context, Span) {
+	if messaging, ok := tracer.(messagingTracer); ok {
+		return messaging.startLinkedSubSpan(ctx, name, links)
+	}
+
+	ctx, span := tracer.StartSubSpan(ctx, name)
+
+	if len(links) > 0 && links[0] != nil {
+		span.AddAnnotation(AnnotationLinkedTraceId, links[0].TraceId)
+	}
+
+	return ctx, span
+}
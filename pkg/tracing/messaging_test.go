@@ -0,0 +1,107 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/justtrackio/gosoline/pkg/tracing/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestGetMessageTrace(t *testing.T) {
+	producer := tracing.GetMessageTrace(map[string]string{
+		tracing.AttributeTraceId: "Root=1-5e3d83c1-e6a0db584850d61342823d5a;Parent=7a2c0f2d4c3b2a11;Sampled=1",
+	})
+
+	assert.Equal(t, &tracing.Trace{
+		TraceId:  "1-5e3d83c1-e6a0db584850d61342823d5a",
+		ParentId: "7a2c0f2d4c3b2a11",
+		Sampled:  true,
+	}, producer)
+
+	assert.Nil(t, tracing.GetMessageTrace(map[string]string{}))
+	assert.Nil(t, tracing.GetMessageTrace(map[string]string{tracing.AttributeTraceId: "garbage"}))
+}
+
+func TestStartConsumerSpan_Fallback(t *testing.T) {
+	producer := &tracing.Trace{
+		TraceId:  "1-5e3d83c1-e6a0db584850d61342823d5a",
+		ParentId: "7a2c0f2d4c3b2a11",
+	}
+
+	tracer := mocks.NewTracer(t)
+	tracer.EXPECT().StartSpanFromContext(mock.Anything, "consumer").
+		RunAndReturn(func(ctx context.Context, _ string) (context.Context, tracing.Span) {
+			assert.Equal(t, producer, tracing.GetTraceFromContext(ctx))
+
+			return ctx, mocks.NewSpan(t)
+		}).Once()
+
+	tracing.StartConsumerSpan(context.Background(), tracer, "consumer", producer)
+}
+
+func TestStartLinkedSubSpan_Fallback(t *testing.T) {
+	span := mocks.NewSpan(t)
+	span.EXPECT().AddAnnotation(tracing.AnnotationLinkedTraceId, "1-5e3d83c1-e6a0db584850d61342823d5a").Once()
+
+	tracer := mocks.NewTracer(t)
+	tracer.EXPECT().StartSubSpan(mock.Anything, "message").Return(context.Background(), span).Once()
+
+	tracing.StartLinkedSubSpan(context.Background(), tracer, "message", &tracing.Trace{
+		TraceId:  "1-5e3d83c1-e6a0db584850d61342823d5a",
+		ParentId: "7a2c0f2d4c3b2a11",
+	})
+}
+
+func TestOtelTracer_Messaging(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := tracing.NewOtelTracerWithInterfaces(logMocks.NewLoggerMock(logMocks.WithTestingT(t)), provider.Tracer("test"))
+
+	ctx, root := tracer.StartSpan("request")
+	ctx, producerSpan := tracing.StartProducerSpan(ctx, tracer, "produce")
+	producerSpan.Finish()
+	root.Finish()
+
+	attributes := map[string]string{
+		tracing.AttributeTraceId: *tracing.GetTraceIdFromContext(ctx),
+	}
+	producer := tracing.GetMessageTrace(attributes)
+	require.NotNil(t, producer)
+
+	_, consumerSpan := tracing.StartConsumerSpan(context.Background(), tracer, "consume", producer)
+	consumerSpan.Finish()
+
+	batchCtx, batchSpan := tracer.StartSpan("batch")
+	_, messageSpan := tracing.StartLinkedSubSpan(batchCtx, tracer, "message", producer)
+	messageSpan.Finish()
+	batchSpan.Finish()
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	producerCtx := spans["produce"].SpanContext()
+	assert.Equal(t, trace.SpanKindProducer, spans["produce"].SpanKind())
+
+	consumer := spans["consume"]
+	assert.Equal(t, trace.SpanKindConsumer, consumer.SpanKind())
+	assert.Equal(t, producerCtx.TraceID(), consumer.SpanContext().TraceID())
+	assert.Equal(t, producerCtx.SpanID(), consumer.Parent().SpanID())
+	require.Len(t, consumer.Links(), 1)
+	assert.Equal(t, producerCtx.SpanID(), consumer.Links()[0].SpanContext.SpanID())
+
+	message := spans["message"]
+	assert.Equal(t, spans["batch"].SpanContext().SpanID(), message.Parent().SpanID())
+	require.Len(t, message.Links(), 1)
+	assert.Equal(t, producerCtx.TraceID(), message.Links()[0].SpanContext.TraceID())
+	assert.Equal(t, producerCtx.SpanID(), message.Links()[0].SpanContext.SpanID())
+}
@@ -0,0 +1,170 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/log"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ sdktrace.SpanExporter = &consoleSpanExporter{}
+
+// consoleSpanExporter logs the finished spans of a trace as tree once the local root span of the trace ended. Spans
+// are collected per trace until then, the oldest trace is logged incomplete if more than maxPendingTraces are pending.
+type consoleSpanExporter struct {
+	logger           log.Logger
+	attributes       bool
+	maxPendingTraces int
+
+	lck     sync.Mutex
+	pending map[trace.TraceID][]sdktrace.ReadOnlySpan
+	order   []trace.TraceID
+}
+
+func newConsoleSpanExporter(logger log.Logger, settings ConsoleSettings) *consoleSpanExporter {
+	return &consoleSpanExporter{
+		logger:           logger,
+		attributes:       settings.Attributes,
+		maxPendingTraces: max(settings.MaxPendingTraces, 1),
+		pending:          map[trace.TraceID][]sdktrace.ReadOnlySpan{},
+	}
+}
+
+func (e *consoleSpanExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.lck.Lock()
+	defer e.lck.Unlock()
+
+	for _, span := range spans {
+		traceId := span.SpanContext().TraceID()
+
+		if _, ok := e.pending[traceId]; !ok {
+			e.order = append(e.order, traceId)
+		}
+
+		e.pending[traceId] = append(e.pending[traceId], span)
+
+		if isLocalRoot(span) {
+			e.print(ctx, traceId)
+		}
+	}
+
+	for len(e.order) > e.maxPendingTraces {
+		e.print(ctx, e.order[0])
+	}
+
+	return nil
+}
+
+func (e *consoleSpanExporter) Shutdown(ctx context.Context) error {
+	e.lck.Lock()
+	defer e.lck.Unlock()
+
+	for len(e.order) > 0 {
+		e.print(ctx, e.order[0])
+	}
+
+	return nil
+}
+
+func (e *consoleSpanExporter) print(ctx context.Context, traceId trace.TraceID) {
+	spans := e.pending[traceId]
+
+	delete(e.pending, traceId)
+	e.order = slices.DeleteFunc(e.order, func(id trace.TraceID) bool {
+		return id == traceId
+	})
+
+	e.logger.Info(ctx, "trace %s\n%s", traceId, e.formatTree(spans))
+}
+
+// formatTree renders the spans as tree ordered by their start time. Spans whose parent is not part of the spans are
+// rendered at the top level.
+func (e *consoleSpanExporter) formatTree(spans []sdktrace.ReadOnlySpan) string {
+	known := make(map[trace.SpanID]bool, len(spans))
+	for _, span := range spans {
+		known[span.SpanContext().SpanID()] = true
+	}
+
+	children := map[trace.SpanID][]sdktrace.ReadOnlySpan{}
+	roots := make([]sdktrace.ReadOnlySpan, 0, 1)
+
+	for _, span := range spans {
+		if parent := span.Parent().SpanID(); span.Parent().IsValid() && known[parent] {
+			children[parent] = append(children[parent], span)
+		} else {
+			roots = append(roots, span)
+		}
+	}
+
+	builder := &strings.Builder{}
+	e.formatSpans(builder, roots, children, "")
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func (e *consoleSpanExporter) formatSpans(builder *strings.Builder, spans []sdktrace.ReadOnlySpan, children map[trace.SpanID][]sdktrace.ReadOnlySpan, indent string) {
+	slices.SortFunc(spans, func(a, b sdktrace.ReadOnlySpan) int {
+		return a.StartTime().Compare(b.StartTime())
+	})
+
+	for i, span := range spans {
+		branch, nested := "├─ ", "│  "
+		if i == len(spans)-1 {
+			branch, nested = "└─ ", "   "
+		}
+
+		builder.WriteString(indent)
+		builder.WriteString(branch)
+		e.formatSpan(builder, span)
+		builder.WriteString("\n")
+
+		e.formatSpans(builder, children[span.SpanContext().SpanID()], children, indent+nested)
+	}
+}
+
+func (e *consoleSpanExporter) formatSpan(builder *strings.Builder, span sdktrace.ReadOnlySpan) {
+	duration := span.EndTime().Sub(span.StartTime()).Round(time.Microsecond)
+	fmt.Fprintf(builder, "%s %s", span.Name(), duration)
+
+	if kind := span.SpanKind(); kind != trace.SpanKindInternal && kind != trace.SpanKindUnspecified {
+		fmt.Fprintf(builder, " [%s]", kind)
+	}
+
+	if e.attributes && len(span.Attributes()) > 0 {
+		attributes := make([]string, 0, len(span.Attributes()))
+		for _, attribute := range span.Attributes() {
+			attributes = append(attributes, fmt.Sprintf("%s=%s", attribute.Key, attribute.Value.Emit()))
+		}
+
+		fmt.Fprintf(builder, " {%s}", strings.Join(attributes, ", "))
+	}
+
+	if links := len(span.Links()); links > 0 {
+		fmt.Fprintf(builder, " links=%d", links)
+	}
+
+	for _, event := range span.Events() {
+		if event.Name == "exception" {
+			for _, attribute := range event.Attributes {
+				if attribute.Key == "exception.message" {
+					fmt.Fprintf(builder, " error=%q", attribute.Value.Emit())
+				}
+			}
+		}
+	}
+
+	if status := span.Status(); status.Code == codes.Error && status.Description != "" {
+		fmt.Fprintf(builder, " status=%q", status.Description)
+	}
+}
+
+func isLocalRoot(span sdktrace.ReadOnlySpan) bool {
+	return !span.Parent().IsValid() || span.Parent().IsRemote()
+}
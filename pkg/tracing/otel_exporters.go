@@ -8,6 +8,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 )
 
@@ -20,6 +21,17 @@ type OtelExporterSettings struct {
 	Retry       RetryConfig   `cfg:"retry"`
 }
 
+// OtelGrpcExporterSettings configures the "otel_grpc" exporter, which exports spans via OTLP/gRPC, e.g. to a local
+// OpenTelemetry collector.
+type OtelGrpcExporterSettings struct {
+	Endpoint    string            `cfg:"endpoint" default:"localhost:4317"`
+	Compression bool              `cfg:"compression" default:"true"`
+	Insecure    bool              `cfg:"insecure" default:"false"`
+	Headers     map[string]string `cfg:"headers"`
+	Timeout     time.Duration     `cfg:"timeout" default:"10s"`
+	Retry       RetryConfig       `cfg:"retry"`
+}
+
 type RetryConfig struct {
 	Enabled         bool          `cfg:"enabled" default:"false"`
 	InitialInterval time.Duration `cfg:"initial_interval" default:"5s"`
@@ -35,6 +47,7 @@ func AddOtelTraceExporter(name string, exporter OtelExporterFactory) {
 
 var otelTraceExporters = map[string]OtelExporterFactory{
 	"otel_http": NewOtelHttpTracer,
+	"otel_grpc": NewOtelGrpcTracer,
 }
 
 func NewOtelHttpTracer(ctx context.Context, config cfg.Config, _ log.Logger) (*otlptrace.Exporter, error) {
@@ -68,3 +81,38 @@ func NewOtelHttpTracer(ctx context.Context, config cfg.Config, _ log.Logger) (*o
 
 	return otlptracehttp.New(ctx, opts...)
 }
+
+func NewOtelGrpcTracer(ctx context.Context, config cfg.Config, _ log.Logger) (*otlptrace.Exporter, error) {
+	settings := &OtelGrpcExporterSettings{}
+	if err := config.UnmarshalKey("tracing.otel.grpc", settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal otel grpc exporter settings: %w", err)
+	}
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(settings.Endpoint),
+		otlptracegrpc.WithTimeout(settings.Timeout),
+	}
+
+	if settings.Compression {
+		opts = append(opts, otlptracegrpc.WithCompressor("gzip"))
+	}
+
+	if settings.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	if len(settings.Headers) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(settings.Headers))
+	}
+
+	if settings.Retry.Enabled {
+		opts = append(opts, otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{
+			Enabled:         settings.Retry.Enabled,
+			InitialInterval: settings.Retry.InitialInterval,
+			MaxInterval:     settings.Retry.MaxInterval,
+			MaxElapsedTime:  settings.Retry.MaxElapsedTime,
+		}))
+	}
+
+	return otlptracegrpc.New(ctx, opts...)
+}
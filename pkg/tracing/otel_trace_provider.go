@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/funk"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -17,12 +19,29 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+const (
+	OtelSpanProcessorBatch  = "batch"
+	OtelSpanProcessorSimple = "simple"
+)
+
 type OtelSettings struct {
-	Exporter      string  `cfg:"exporter" default:"otel_http"`
-	SamplingRatio float64 `cfg:"sampling_ratio" default:"0.05"`
+	Exporter      string                    `cfg:"exporter" default:"otel_http"`
+	SamplingRatio float64                   `cfg:"sampling_ratio" default:"0.05"`
+	SpanProcessor OtelSpanProcessorSettings `cfg:"span_processor"`
 	SpanLimits
 }
 
+// OtelSpanProcessorSettings configures how finished spans are handed to the exporter. The batch processor exports spans
+// in the background, the simple processor exports every span synchronously once it ends and should only be used for
+// debugging.
+type OtelSpanProcessorSettings struct {
+	Type               string        `cfg:"type" default:"batch"`
+	MaxQueueSize       int           `cfg:"max_queue_size" default:"2048"`
+	MaxExportBatchSize int           `cfg:"max_export_batch_size" default:"512"`
+	BatchTimeout       time.Duration `cfg:"batch_timeout" default:"5s"`
+	ExportTimeout      time.Duration `cfg:"export_timeout" default:"30s"`
+}
+
 type SpanLimits struct {
 	AttributeValueLengthLimit   int `cfg:"attribute_value_length_limit" default:"-1"`
 	AttributeCountLimit         int `cfg:"attribute_count_limit" default:"128"`
@@ -65,12 +84,19 @@ func newOtelTraceProvider(ctx context.Context, config cfg.Config, logger log.Log
 		return nil, err
 	}
 
+	spanProcessor, err := newOtelSpanProcessor(settings.SpanProcessor, exporter)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := newOtelResource(config, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
 	tracerProvider := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exporter),
-		sdktrace.WithResource(resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceName(serviceName),
-		)),
+		sdktrace.WithSpanProcessor(spanProcessor),
+		sdktrace.WithResource(res),
 		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(settings.SamplingRatio))),
 		sdktrace.WithRawSpanLimits(sdktrace.SpanLimits{
 			AttributeValueLengthLimit:   settings.AttributeValueLengthLimit,
@@ -87,3 +113,51 @@ func newOtelTraceProvider(ctx context.Context, config cfg.Config, logger log.Log
 
 	return otel.GetTracerProvider(), nil
 }
+
+func newOtelSpanProcessor(settings OtelSpanProcessorSettings, exporter sdktrace.SpanExporter) (sdktrace.SpanProcessor, error) {
+	switch settings.Type {
+	case OtelSpanProcessorBatch:
+		return sdktrace.NewBatchSpanProcessor(
+			exporter,
+			sdktrace.WithMaxQueueSize(settings.MaxQueueSize),
+			sdktrace.WithMaxExportBatchSize(settings.MaxExportBatchSize),
+			sdktrace.WithBatchTimeout(settings.BatchTimeout),
+			sdktrace.WithExportTimeout(settings.ExportTimeout),
+		), nil
+	case OtelSpanProcessorSimple:
+		return sdktrace.NewSimpleSpanProcessor(exporter), nil
+	default:
+		return nil, fmt.Errorf("unknown otel span processor %s, available processors: %s, %s", settings.Type, OtelSpanProcessorBatch, OtelSpanProcessorSimple)
+	}
+}
+
+// newOtelResource describes the application emitting the spans with the same attributes as the OTLP log handler, so
+// spans and logs of an application can be correlated.
+func newOtelResource(config cfg.Config, serviceName string) (*resource.Resource, error) {
+	var err error
+	var identity cfg.Identity
+	var namespace string
+
+	if identity, err = cfg.GetAppIdentity(config); err != nil {
+		return nil, fmt.Errorf("could not get app identity from config: %w", err)
+	}
+
+	if namespace, err = identity.FormatNamespace("."); err != nil {
+		return nil, fmt.Errorf("failed to format namespace: %w", err)
+	}
+
+	attributes := []attribute.KeyValue{
+		semconv.ServiceName(serviceName),
+		semconv.DeploymentEnvironment(identity.Env),
+	}
+
+	if namespace != "" {
+		attributes = append(attributes, semconv.ServiceNamespace(namespace))
+	}
+
+	for key, value := range identity.Tags {
+		attributes = append(attributes, attribute.String(fmt.Sprintf("app.tags.%s", key), value))
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL, attributes...), nil
+}
@@ -0,0 +1,70 @@
+package tracing_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func otelTestConfig(processor string) cfg.Config {
+	return cfg.New(map[string]any{
+		"app": map[string]any{
+			"env":       "test",
+			"name":      "tracing",
+			"namespace": "{app.env}.{app.tags.project}",
+			"tags": map[string]any{
+				"project": "gosoline",
+			},
+		},
+		"tracing": map[string]any{
+			"otel": map[string]any{
+				"exporter":       "otel_grpc",
+				"sampling_ratio": 1,
+				"grpc": map[string]any{
+					"endpoint": "localhost:4317",
+					"insecure": true,
+				},
+				"span_processor": map[string]any{
+					"type": processor,
+				},
+			},
+		},
+	})
+}
+
+func TestProvideOtelTraceProvider_GrpcExporter(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	ctx := appctx.WithContainer(t.Context())
+
+	provider, err := tracing.ProvideOtelTraceProvider(ctx, otelTestConfig(tracing.OtelSpanProcessorBatch), logger)
+	require.NoError(t, err)
+
+	_, span := provider.Tracer("test").Start(ctx, "span")
+	readOnly, ok := span.(sdktrace.ReadOnlySpan)
+	require.True(t, ok, "the span should be recorded")
+
+	attributes := map[attribute.Key]string{}
+	for _, kv := range readOnly.Resource().Attributes() {
+		attributes[kv.Key] = kv.Value.Emit()
+	}
+
+	assert.Equal(t, "test-gosoline-tracing", attributes["service.name"])
+	assert.Equal(t, "test", attributes["deployment.environment"])
+	assert.Equal(t, "test.gosoline", attributes["service.namespace"])
+	assert.Equal(t, "gosoline", attributes["app.tags.project"])
+}
+
+func TestProvideOtelTraceProvider_UnknownSpanProcessor(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	ctx := appctx.WithContainer(t.Context())
+
+	_, err := tracing.ProvideOtelTraceProvider(ctx, otelTestConfig("unknown"), logger)
+	assert.EqualError(t, err, "unknown otel span processor unknown, available processors: batch, simple")
+}
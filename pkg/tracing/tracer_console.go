@@ -0,0 +1,99 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func init() {
+	AddTracerProvider(ProviderConsole, NewConsoleTracer)
+	AddInstrumentorProvider(ProviderConsole, NewConsoleInstrumentor)
+}
+
+// ConsoleSettings configures the "console" provider, which records every span with OpenTelemetry and logs the spans
+// of a trace as tree once it finished. It is meant for local development without any collector.
+type ConsoleSettings struct {
+	// Attributes adds the attributes of the spans to the logged trees.
+	Attributes bool `cfg:"attributes" default:"true"`
+	// MaxPendingTraces is the amount of unfinished traces kept in memory, the oldest one is logged incomplete if exceeded.
+	MaxPendingTraces int `cfg:"max_pending_traces" default:"1000"`
+}
+
+type consoleTraceProviderKey struct{}
+
+func NewConsoleTracer(ctx context.Context, config cfg.Config, logger log.Logger) (Tracer, error) {
+	logger = logger.WithChannel("tracing")
+
+	traceProvider, err := ProvideConsoleTraceProvider(ctx, config, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer := traceProvider.Tracer(
+		instrumentationName,
+		trace.WithInstrumentationVersion(instrumentationVersion),
+		trace.WithSchemaURL(semconv.SchemaURL),
+	)
+
+	return NewOtelTracerWithInterfaces(logger, tracer), nil
+}
+
+func NewConsoleInstrumentor(ctx context.Context, config cfg.Config, logger log.Logger) (Instrumentor, error) {
+	identity, err := cfg.GetAppIdentity(config)
+	if err != nil {
+		return nil, fmt.Errorf("could not get app identity from config: %w", err)
+	}
+
+	name, err := resolveAppId(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to format service name: %w", err)
+	}
+
+	// used to set the global trace provider and text map propagator.
+	if _, err = ProvideConsoleTraceProvider(ctx, config, logger.WithChannel("tracing")); err != nil {
+		return nil, err
+	}
+
+	return NewOtelInstrumentorWithInterfaces(identity, name), nil
+}
+
+// ProvideConsoleTraceProvider provides the trace provider of the console provider and sets it as global otel trace
+// provider. All spans are sampled and exported synchronously.
+func ProvideConsoleTraceProvider(ctx context.Context, config cfg.Config, logger log.Logger) (trace.TracerProvider, error) {
+	return appctx.Provide(ctx, consoleTraceProviderKey{}, func() (trace.TracerProvider, error) {
+		settings := &ConsoleSettings{}
+		if err := config.UnmarshalKey("tracing.console", settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal console tracing settings: %w", err)
+		}
+
+		serviceName, err := resolveAppId(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to format service name: %w", err)
+		}
+
+		res, err := newOtelResource(config, serviceName)
+		if err != nil {
+			return nil, err
+		}
+
+		tracerProvider := sdktrace.NewTracerProvider(
+			sdktrace.WithSpanProcessor(sdktrace.NewSimpleSpanProcessor(newConsoleSpanExporter(logger, *settings))),
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		)
+
+		otel.SetTracerProvider(tracerProvider)
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		return otel.GetTracerProvider(), nil
+	})
+}
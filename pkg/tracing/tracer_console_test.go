@@ -0,0 +1,51 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsoleTracer(t *testing.T) {
+	var trees []string
+
+	logger := logMocks.NewLogger(t)
+	logger.EXPECT().WithChannel("tracing").Return(logger)
+	logger.EXPECT().Info(mock.Anything, "trace %s\n%s", mock.Anything, mock.Anything).Run(func(_ context.Context, _ string, args ...any) {
+		trees = append(trees, fmt.Sprintf("trace %s\n%s", args...))
+	})
+
+	ctx := appctx.WithContainer(t.Context())
+
+	tracer, err := tracing.NewConsoleTracer(ctx, otelTestConfig(tracing.OtelSpanProcessorSimple), logger)
+	require.NoError(t, err)
+
+	ctx, root := tracer.StartSpan("request")
+	root.AddAnnotation("route", "/orders")
+
+	_, query := tracer.StartSubSpan(ctx, "db.query")
+	query.AddError(errors.New("deadlock"))
+	query.Finish()
+
+	_, publish := tracing.StartProducerSpan(ctx, tracer, "publish")
+	publish.Finish()
+
+	assert.Empty(t, trees, "the trace should only be logged once its root span finished")
+
+	root.Finish()
+
+	require.Len(t, trees, 1)
+	assert.Regexp(t, regexp.MustCompile(`^trace [0-9a-f]{32}
+└─ request \S+ \{route=/orders\}
+   ├─ db\.query \S+ error="deadlock"
+   └─ publish \S+ \[producer\]$`), trees[0])
+}
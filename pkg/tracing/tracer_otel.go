@@ -18,6 +18,8 @@ const (
 	instrumentationVersion = "v0.8.0"
 )
 
+var _ messagingTracer = &otelTracer{}
+
 type otelTracer struct {
 	logger log.Logger
 	tracer trace.Tracer
@@ -72,11 +74,6 @@ func (t *otelTracer) StartSpanFromContext(ctx context.Context, name string) (con
 }
 
 func (t *otelTracer) spanFromTrace(ctx context.Context, trc *Trace, name string) (context.Context, Span) {
-	var tFlags trace.TraceFlags
-	if trc.GetSampled() {
-		tFlags = trace.FlagsSampled
-	}
-
 	// The Trace ID is expected to be compliant with the W3C trace-context specification. If it is not
 	// an empty traceID will be used for the new span.
 	tID, err := trace.TraceIDFromHex(trc.GetTraceId())
@@ -93,12 +90,76 @@ func (t *otelTracer) spanFromTrace(ctx context.Context, trc *Trace, name string)
 		sID = trace.SpanID{}
 	}
 
-	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+	ctx = trace.ContextWithRemoteSpanContext(ctx, newOtelSpanContext(tID, sID, trc.GetSampled()))
+
+	return t.StartSubSpan(ctx, name)
+}
+
+func (t *otelTracer) startProducerSpan(ctx context.Context, name string) (context.Context, Span) {
+	ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindProducer))
+
+	return newOtelSpan(ctx, span)
+}
+
+func (t *otelTracer) startConsumerSpan(ctx context.Context, name string, producer *Trace) (context.Context, Span) {
+	options := []trace.SpanStartOption{trace.WithSpanKind(trace.SpanKindConsumer)}
+
+	if spanCtx, ok := producerSpanContext(producer); ok {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, spanCtx)
+		options = append(options, trace.WithLinks(trace.Link{SpanContext: spanCtx}))
+	} else {
+		options = append(options, trace.WithNewRoot())
+	}
+
+	ctx, span := t.tracer.Start(ctx, name, options...)
+
+	return newOtelSpan(ctx, span)
+}
+
+func (t *otelTracer) startLinkedSubSpan(ctx context.Context, name string, links []*Trace) (context.Context, Span) {
+	options := []trace.SpanStartOption{trace.WithSpanKind(trace.SpanKindConsumer)}
+
+	for _, link := range links {
+		if spanCtx, ok := producerSpanContext(link); ok {
+			options = append(options, trace.WithLinks(trace.Link{SpanContext: spanCtx}))
+		}
+	}
+
+	ctx, span := t.tracer.Start(ctx, name, options...)
+
+	return newOtelSpan(ctx, span)
+}
+
+// producerSpanContext returns the span context of the span which produced a message. The trace of a message is
+// forwarded with the producer span as parent, see TraceToString.
+func producerSpanContext(producer *Trace) (trace.SpanContext, bool) {
+	if producer == nil {
+		return trace.SpanContext{}, false
+	}
+
+	tID, err := trace.TraceIDFromHex(producer.GetTraceId())
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+
+	sID, err := trace.SpanIDFromHex(producer.GetParentId())
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+
+	return newOtelSpanContext(tID, sID, producer.GetSampled()), true
+}
+
+func newOtelSpanContext(tID trace.TraceID, sID trace.SpanID, sampled bool) trace.SpanContext {
+	var tFlags trace.TraceFlags
+	if sampled {
+		tFlags = trace.FlagsSampled
+	}
+
+	return trace.NewSpanContext(trace.SpanContextConfig{
 		TraceID:    tID,
 		SpanID:     sID,
 		TraceFlags: tFlags,
+		Remote:     true,
 	})
-	ctx = trace.ContextWithRemoteSpanContext(ctx, spanCtx)
-
-	return t.StartSubSpan(ctx, name)
 }
@@ -0,0 +1,182 @@
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+const (
+	// maxTimestamp is the largest unix timestamp in milliseconds fitting into the 48 bits of a UUID v7 or ULID.
+	maxTimestamp = 1<<48 - 1
+	// v7RandomBits are the 12 bits of rand_a and the 62 bits of rand_b of a UUID v7.
+	v7RandomBits = 74
+	// ulidRandomBits are the random bits following the timestamp of a ULID.
+	ulidRandomBits = 80
+)
+
+// TimeOrdered generates ids which sort by the time of their creation, both as string and as bytes. This keeps
+// related items close to each other in db indexes and allows to range query ddb sort keys by time.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name TimeOrdered
+type TimeOrdered interface {
+	// NewV7 returns a UUID v7 string, e.g. 01920e5c-7b8a-7cc3-9a5b-2c1d0e3f4a5b.
+	NewV7() string
+	// NewUlid returns a ULID string, e.g. 01J8Z5RYWAFK1SMPS0BGQZMJTV.
+	NewUlid() string
+}
+
+type timeOrdered struct {
+	clock  clock.Clock
+	random io.Reader
+
+	lck       sync.Mutex
+	v7State   monotonicState
+	ulidState monotonicState
+}
+
+// monotonicState holds the timestamp and random bits of the last generated id. If the clock doesn't move forward, the
+// next id reuses the timestamp and increments the random bits instead (RFC 9562, section 6.2, method 2).
+type monotonicState struct {
+	timestamp int64
+	hi        uint64
+	lo        uint64
+}
+
+// NewTimeOrdered returns a TimeOrdered using the current time and crypto/rand. Ids generated by the same TimeOrdered are
+// strictly increasing, even if they are created in the same millisecond or the clock goes backwards.
+func NewTimeOrdered() TimeOrdered {
+	return NewTimeOrderedWithInterfaces(clock.Provider, rand.Reader)
+}
+
+// NewTimeOrderedWithInterfaces returns a TimeOrdered reading the time from the clock and the random bits from random,
+// e.g. a clock.FakeClock and a seeded reader to generate the same ids in every test run.
+func NewTimeOrderedWithInterfaces(clock clock.Clock, random io.Reader) TimeOrdered {
+	return &timeOrdered{
+		clock:  clock,
+		random: random,
+	}
+}
+
+func (t *timeOrdered) NewV7() string {
+	t.lck.Lock()
+	defer t.lck.Unlock()
+
+	t.next(&t.v7State, v7RandomBits)
+
+	var bytes [16]byte
+	putTimestamp(bytes[:], t.v7State.timestamp)
+
+	// the upper 12 random bits are stored in rand_a, the lower 62 bits in rand_b
+	binary.BigEndian.PutUint16(bytes[6:8], uint16(t.v7State.hi<<2|t.v7State.lo>>62)|0x7000)
+	binary.BigEndian.PutUint64(bytes[8:16], t.v7State.lo&(1<<62-1)|0x8000000000000000)
+
+	return formatUuid(bytes)
+}
+
+func (t *timeOrdered) NewUlid() string {
+	t.lck.Lock()
+	defer t.lck.Unlock()
+
+	t.next(&t.ulidState, ulidRandomBits)
+
+	var bytes [16]byte
+	putTimestamp(bytes[:], t.ulidState.timestamp)
+	binary.BigEndian.PutUint16(bytes[6:8], uint16(t.ulidState.hi))
+	binary.BigEndian.PutUint64(bytes[8:16], t.ulidState.lo)
+
+	return encodeUlid(bytes)
+}
+
+// next advances the state to the current time with new random bits or, if the time didn't move forward, increments the
+// random bits of the last id. If they overflow, the timestamp is moved forward by a millisecond.
+func (t *timeOrdered) next(state *monotonicState, randomBits int) {
+	now := t.clock.Now().UnixMilli()
+
+	if now > state.timestamp {
+		state.timestamp = now
+		t.readRandom(state, randomBits)
+
+		return
+	}
+
+	state.lo++
+
+	if state.lo == 0 {
+		state.hi++
+	}
+
+	if state.hi == 1<<(randomBits-64) {
+		state.timestamp++
+		t.readRandom(state, randomBits)
+	}
+}
+
+func (t *timeOrdered) readRandom(state *monotonicState, randomBits int) {
+	var buf [16]byte
+
+	if _, err := io.ReadFull(t.random, buf[:]); err != nil {
+		panic(fmt.Errorf("can not read random bytes: %w", err))
+	}
+
+	// the highest random bit stays unset, which leaves room to increment the random bits within the same millisecond
+	state.hi = binary.BigEndian.Uint64(buf[:8]) & (1<<(randomBits-64-1) - 1)
+	state.lo = binary.BigEndian.Uint64(buf[8:])
+}
+
+func putTimestamp(bytes []byte, timestamp int64) {
+	if timestamp < 0 || timestamp > maxTimestamp {
+		panic(fmt.Errorf("the timestamp %d can not be stored in 48 bits", timestamp))
+	}
+
+	bytes[0] = byte(timestamp >> 40)
+	bytes[1] = byte(timestamp >> 32)
+	bytes[2] = byte(timestamp >> 24)
+	bytes[3] = byte(timestamp >> 16)
+	bytes[4] = byte(timestamp >> 8)
+	bytes[5] = byte(timestamp)
+}
+
+func readTimestamp(bytes []byte) time.Time {
+	timestamp := int64(bytes[0])<<40 | int64(bytes[1])<<32 | int64(bytes[2])<<24 | int64(bytes[3])<<16 | int64(bytes[4])<<8 | int64(bytes[5])
+
+	return time.UnixMilli(timestamp)
+}
+
+func formatUuid(bytes [16]byte) string {
+	hex := BytesToHex(bytes[:])
+
+	return hex[0:8] + "-" + hex[8:12] + "-" + hex[12:16] + "-" + hex[16:20] + "-" + hex[20:32]
+}
+
+// ValidV7 checks if the given string has a valid xxxxxxxx-xxxx-7xxx-[89ab]xxx-xxxxxxxxxxxx uuid format.
+func ValidV7(s string) bool {
+	if len(s) != 36 || s[14] != '7' {
+		return false
+	}
+
+	// same layout as a v4 uuid besides the version
+	v4 := []byte(s)
+	v4[14] = '4'
+
+	return ValidV4(string(v4))
+}
+
+// TimeFromV7 returns the time a UUID v7 was created at with millisecond precision.
+func TimeFromV7(s string) (time.Time, error) {
+	if !ValidV7(s) {
+		return time.Time{}, fmt.Errorf("the uuid %q is not a valid v7 uuid", s)
+	}
+
+	bytes, err := ToBytes(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can not decode uuid %q: %w", s, err)
+	}
+
+	return readTimestamp(bytes), nil
+}
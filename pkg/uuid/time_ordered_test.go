@@ -0,0 +1,121 @@
+package uuid_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	googleUuid "github.com/google/uuid"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var timeOrderedNow = time.Date(2016, 7, 30, 22, 36, 16, 385_000_000, time.UTC)
+
+func newTimeOrdered() (uuid.TimeOrdered, clock.FakeClock) {
+	fakeClock := clock.NewFakeClockAt(timeOrderedNow)
+	random := rand.NewChaCha8([32]byte{1, 2, 3})
+
+	return uuid.NewTimeOrderedWithInterfaces(fakeClock, random), fakeClock
+}
+
+func TestTimeOrdered_NewV7(t *testing.T) {
+	gen, fakeClock := newTimeOrdered()
+
+	id := gen.NewV7()
+	assert.True(t, uuid.ValidV7(id), id)
+	assert.False(t, uuid.ValidV4(id))
+
+	parsed, err := googleUuid.Parse(id)
+	assert.NoError(t, err)
+	assert.Equal(t, googleUuid.Version(7), parsed.Version())
+	assert.Equal(t, googleUuid.RFC4122, parsed.Variant())
+
+	createdAt, err := uuid.TimeFromV7(id)
+	assert.NoError(t, err)
+	assert.Equal(t, timeOrderedNow, createdAt.UTC())
+
+	fakeClock.Advance(time.Second)
+	createdAt, err = uuid.TimeFromV7(gen.NewV7())
+	assert.NoError(t, err)
+	assert.Equal(t, timeOrderedNow.Add(time.Second), createdAt.UTC())
+
+	_, err = uuid.TimeFromV7(uuid.New().NewV4())
+	assert.Error(t, err)
+}
+
+func TestTimeOrdered_NewUlid(t *testing.T) {
+	gen, _ := newTimeOrdered()
+
+	id := gen.NewUlid()
+	assert.Len(t, id, 26)
+	assert.True(t, uuid.ValidUlid(id), id)
+	// example timestamp from the ulid spec
+	assert.Equal(t, "01ARYZ6S41", id[:10])
+
+	createdAt, err := uuid.TimeFromUlid(id)
+	assert.NoError(t, err)
+	assert.Equal(t, timeOrderedNow, createdAt.UTC())
+
+	createdAt, err = uuid.TimeFromUlid(strings.ToLower(id))
+	assert.NoError(t, err)
+	assert.Equal(t, timeOrderedNow, createdAt.UTC())
+}
+
+func TestValidUlid(t *testing.T) {
+	assert.True(t, uuid.ValidUlid("00000000000000000000000000"))
+	assert.True(t, uuid.ValidUlid("7ZZZZZZZZZZZZZZZZZZZZZZZZZ"))
+	assert.False(t, uuid.ValidUlid("8ZZZZZZZZZZZZZZZZZZZZZZZZZ"), "exceeds 128 bits")
+	assert.False(t, uuid.ValidUlid("01ARYZ6S41TSV4RRFFQ69G5FA"), "too short")
+	assert.False(t, uuid.ValidUlid("01ARYZ6S41TSV4RRFFQ69G5FAU"), "invalid character")
+}
+
+func TestTimeOrdered_Monotonic(t *testing.T) {
+	for name, next := range map[string]func(gen uuid.TimeOrdered) string{
+		"v7":   uuid.TimeOrdered.NewV7,
+		"ulid": uuid.TimeOrdered.NewUlid,
+	} {
+		t.Run(name, func(t *testing.T) {
+			gen, fakeClock := newTimeOrdered()
+			ids := make([]string, 0, 3000)
+
+			for i := 0; i < 1000; i++ {
+				ids = append(ids, next(gen))
+			}
+
+			// ids stay ordered if the clock goes backwards
+			fakeClock.Advance(-time.Minute)
+
+			for i := 0; i < 1000; i++ {
+				ids = append(ids, next(gen))
+			}
+
+			fakeClock.Advance(time.Hour)
+
+			for i := 0; i < 1000; i++ {
+				ids = append(ids, next(gen))
+			}
+
+			assert.True(t, slices.IsSorted(ids))
+			assert.Len(t, slices.Compact(slices.Clone(ids)), len(ids))
+		})
+	}
+}
+
+func TestTimeOrdered_Deterministic(t *testing.T) {
+	first, _ := newTimeOrdered()
+	second, _ := newTimeOrdered()
+
+	assert.Equal(t, first.NewV7(), second.NewV7())
+	assert.Equal(t, first.NewUlid(), second.NewUlid())
+}
+
+func TestTimeOrdered_Real(t *testing.T) {
+	gen := uuid.NewTimeOrdered()
+
+	assert.True(t, uuid.ValidV7(gen.NewV7()))
+	assert.True(t, uuid.ValidUlid(gen.NewUlid()))
+}
@@ -0,0 +1,93 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ulidAlphabet is Crockford's base32 alphabet, which sorts in the same order as the encoded bytes.
+const ulidAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+var ulidDecoding = func() [256]byte {
+	decoding := [256]byte{}
+	for i := range decoding {
+		decoding[i] = 0xFF
+	}
+
+	for i := 0; i < len(ulidAlphabet); i++ {
+		decoding[ulidAlphabet[i]] = byte(i)
+		decoding[strings.ToLower(ulidAlphabet)[i]] = byte(i)
+	}
+
+	return decoding
+}()
+
+// encodeUlid encodes the 128 bits of a ULID as 26 characters of 5 bits each, the first character holds the upper 3 bits.
+func encodeUlid(bytes [16]byte) string {
+	result := make([]byte, 26)
+
+	for i := 25; i >= 0; i-- {
+		// bit offset of the current character from the end of the bytes
+		offset := (25 - i) * 5
+		index := 15 - offset/8
+		shift := offset % 8
+
+		value := uint16(bytes[index]) >> shift
+		if index > 0 {
+			value |= uint16(bytes[index-1]) << (8 - shift)
+		}
+
+		result[i] = ulidAlphabet[value&0x1F]
+	}
+
+	return string(result)
+}
+
+func decodeUlid(s string) ([16]byte, error) {
+	var bytes [16]byte
+
+	if len(s) != 26 {
+		return bytes, fmt.Errorf("the ulid should be exactly 26 characters long, but was: %d", len(s))
+	}
+
+	if ulidDecoding[s[0]] > 7 {
+		return bytes, fmt.Errorf("the ulid %q exceeds 128 bits", s)
+	}
+
+	for i := 0; i < 26; i++ {
+		value := ulidDecoding[s[i]]
+		if value == 0xFF {
+			return bytes, fmt.Errorf("invalid character at position %d: %c", i, s[i])
+		}
+
+		offset := (25 - i) * 5
+		index := 15 - offset/8
+		shift := offset % 8
+
+		bytes[index] |= value << shift
+		if index > 0 && shift > 3 {
+			bytes[index-1] |= value >> (8 - shift)
+		}
+	}
+
+	return bytes, nil
+}
+
+// ValidUlid checks if the given string is a valid ULID, i.e. 26 characters of Crockford's base32 alphabet (case
+// insensitive) not exceeding 128 bits.
+func ValidUlid(s string) bool {
+	_, err := decodeUlid(s)
+
+	return err == nil
+}
+
+// TimeFromUlid returns the time a ULID was created at with millisecond precision.
+func TimeFromUlid(s string) (time.Time, error) {
+	bytes, err := decodeUlid(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can not decode ulid %q: %w", s, err)
+	}
+
+	return readTimestamp(bytes[:]), nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/stream"
 	"github.com/justtrackio/gosoline/pkg/test/suite"
 	"github.com/justtrackio/gosoline/test/stream/kafka"
 	"github.com/justtrackio/gosoline/test/stream/kafka/producer"
@@ -33,4 +34,10 @@ func (s *testSuite) SetupSuite() []suite.Option {
 
 func (s *testSuite) TestProduce(app suite.AppUnderTest) {
 	producer.CheckExpectedKafkaEndOffset(s, app, int64(s.produceCount))
+
+	component := s.Env().Kafka("default")
+	messages := component.ConsumeMessages(component.TopicName("testEvent"), s.produceCount)
+
+	s.Len(messages, s.produceCount)
+	s.Equal(string(stream.EncodingJson), messages[0].Attributes[stream.AttributeEncoding])
 }